@@ -51,6 +51,29 @@ func main() {
 	router.HandleFunc("/health", contentHandler.HealthCheck).Methods("GET")
 	router.HandleFunc("/health/ready", contentHandler.ReadinessCheck).Methods("GET")
 
+	// Fire scheduled publishing transitions in the background
+	schedulerCtx, stopScheduler := context.WithCancel(context.Background())
+	go contentHandler.RunScheduler(schedulerCtx)
+
+	// Resume sagas interrupted by the last shutdown and any abandoned by other instances
+	go contentHandler.RunSagaRecovery(schedulerCtx)
+
+	// Deliver domain events committed alongside repository writes
+	go contentHandler.RunOutboxRelay(schedulerCtx)
+
+	// Republish dead events once an operator queues them for retry
+	go contentHandler.RunDeadLetterRetries(schedulerCtx)
+
+	// Load feature flags from the configuration store and keep admin changes current
+	configReloader := dapr.NewConfigReloader(dapr.NewConfiguration(daprClient))
+	if err := configReloader.Register(contentHandler.FeatureFlagListener()); err != nil {
+		log.Fatalf("Invalid feature flag configuration: %v", err)
+	}
+	if err := configReloader.Start(schedulerCtx); err != nil {
+		log.Printf("Warning: feature flags from the configuration store are unavailable: %v", err)
+	}
+	go contentHandler.RunFeatureFlagRefresh(schedulerCtx)
+
 	// Create server
 	server := &http.Server{
 		Addr:         ":" + port,
@@ -74,6 +97,7 @@ func main() {
 	<-c
 
 	log.Println("Shutting down Content Service...")
+	stopScheduler()
 
 	// Create shutdown context with timeout
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
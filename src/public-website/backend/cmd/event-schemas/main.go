@@ -0,0 +1,63 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
+)
+
+// report is written to stdout so build logs show every event type checked
+type report struct {
+	Directory         string                       `json:"directory"`
+	Baseline          string                       `json:"baseline,omitempty"`
+	EventTypes        map[string][]string          `json:"event_types"`
+	Incompatibilities []dapr.SchemaIncompatibility `json:"incompatibilities"`
+}
+
+func main() {
+	var (
+		dir      = flag.String("dir", dapr.EventSchemaDir(), "Directory of event schemas laid out as <event type>/<version>.json")
+		baseline = flag.String("baseline", "", "Optional directory of the published event schemas, such as a checkout of the main branch, to check changes against")
+	)
+	flag.Parse()
+
+	registry, err := dapr.LoadEventSchemaRegistry(*dir)
+	if err != nil {
+		log.Fatalf("Failed to load event schemas: %v", err)
+	}
+
+	result := report{
+		Directory:         *dir,
+		Baseline:          *baseline,
+		EventTypes:        make(map[string][]string),
+		Incompatibilities: append([]dapr.SchemaIncompatibility{}, registry.CheckCompatibility()...),
+	}
+	for _, eventType := range registry.EventTypes() {
+		result.EventTypes[eventType] = registry.Versions(eventType)
+	}
+
+	if *baseline != "" {
+		published, err := dapr.LoadEventSchemaRegistry(*baseline)
+		if err != nil {
+			log.Fatalf("Failed to load baseline event schemas: %v", err)
+		}
+		result.Incompatibilities = append(result.Incompatibilities, registry.CheckCompatibilityWith(published)...)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(result); err != nil {
+		log.Fatalf("Failed to write report: %v", err)
+	}
+
+	if len(result.Incompatibilities) > 0 {
+		for _, incompatibility := range result.Incompatibilities {
+			log.Printf("Breaking change: %s", incompatibility)
+		}
+		log.Println("Event schema changes break existing producers or consumers; publish them as a new major version instead")
+		os.Exit(1)
+	}
+}
@@ -16,6 +16,7 @@ import (
 type AdminGatewayApplication struct {
 	daprClient     *dapr.Client
 	gatewayService *gateway.GatewayService
+	accessControl  *gateway.AccessControlIntegration
 }
 
 func main() {
@@ -51,9 +52,16 @@ func NewAdminGatewayApplication() (*AdminGatewayApplication, error) {
 	// Create admin gateway service with environment-specific configuration
 	gatewayService := createAdminGatewayService(daprClient)
 	
+	// Attach the admin user directory and policy; without it authenticated requests cannot be authorized
+	accessControl, err := createAccessControl(gatewayService, daprClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize access control: %w", err)
+	}
+	
 	return &AdminGatewayApplication{
 		daprClient:     daprClient,
 		gatewayService: gatewayService,
+		accessControl:  accessControl,
 	}, nil
 }
 
@@ -76,6 +84,14 @@ func (app *AdminGatewayApplication) Start(ctx context.Context) error {
 		return fmt.Errorf("Dapr connectivity validation failed: %w", err)
 	}
 	
+	// Create the configured bootstrap admins before serving requests
+	if app.accessControl != nil {
+		defer app.accessControl.Close()
+		if err := app.accessControl.EnsureBootstrapAdminsFromEnv(ctx); err != nil {
+			return fmt.Errorf("failed to create bootstrap admins: %w", err)
+		}
+	}
+	
 	// Start gateway service
 	if err := app.gatewayService.Start(ctx); err != nil {
 		return fmt.Errorf("gateway service failed: %w", err)
@@ -113,6 +129,38 @@ func createAdminGatewayService(daprClient *dapr.Client) *gateway.GatewayService
 	return gateway.NewGatewayService(config, daprClient)
 }
 
+// createAccessControl connects the gateway user directory. It is required when
+// authentication is enforced; otherwise a missing database only disables user management.
+// Password sign-in is enabled when a session signing key is configured.
+func createAccessControl(gatewayService *gateway.GatewayService, daprClient *dapr.Client) (*gateway.AccessControlIntegration, error) {
+	config := gatewayService.GetConfiguration()
+	
+	accessControl, err := gateway.NewAccessControlIntegration(config)
+	if err != nil {
+		if config.ShouldRequireAuth() {
+			return nil, err
+		}
+		log.Printf("WARNING: Admin user management unavailable: %v", err)
+		return nil, nil
+	}
+	
+	sessionsEnabled, err := accessControl.EnableSessionsFromEnv(daprClient)
+	if err != nil {
+		accessControl.Close()
+		return nil, err
+	}
+	if !sessionsEnabled {
+		log.Printf("Admin password sign-in disabled: %s is not set", gateway.SessionSigningKeyEnv)
+	}
+	
+	if err := accessControl.InitializeWithGateway(gatewayService); err != nil {
+		accessControl.Close()
+		return nil, err
+	}
+	
+	return accessControl, nil
+}
+
 // updateConfigurationFromEnvironment updates configuration from environment variables
 func updateConfigurationFromEnvironment(config *gateway.GatewayConfiguration) {
 	// Update environment
@@ -51,6 +51,20 @@ func main() {
 	router.HandleFunc("/health", inquiriesHandler.HealthCheck).Methods("GET")
 	router.HandleFunc("/health/ready", inquiriesHandler.ReadinessCheck).Methods("GET")
 
+	// Deliver audit events committed alongside inquiry writes
+	relayCtx, stopRelay := context.WithCancel(context.Background())
+	go inquiriesHandler.RunOutboxRelay(relayCtx)
+
+	// Load feature flags from the configuration store and keep admin changes current
+	configReloader := dapr.NewConfigReloader(dapr.NewConfiguration(daprClient))
+	if err := configReloader.Register(inquiriesHandler.FeatureFlagListener()); err != nil {
+		log.Fatalf("Invalid feature flag configuration: %v", err)
+	}
+	if err := configReloader.Start(relayCtx); err != nil {
+		log.Printf("Warning: feature flags from the configuration store are unavailable: %v", err)
+	}
+	go inquiriesHandler.RunFeatureFlagRefresh(relayCtx)
+
 	// Create server
 	server := &http.Server{
 		Addr:         ":" + port,
@@ -74,6 +88,7 @@ func main() {
 	<-c
 
 	log.Println("Shutting down Inquiries Service...")
+	stopRelay()
 
 	// Create shutdown context with timeout
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
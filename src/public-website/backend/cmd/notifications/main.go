@@ -54,6 +54,45 @@ func main() {
 		Level: logLevel,
 	}))
 
+	// Reload rate limits, worker counts and channel toggles from the configuration store
+	reloadableConfig := notifications.NewReloadableNotificationConfig(config, nil, nil)
+	configReloader := dapr.NewConfigReloader(dapr.NewConfiguration(daprClient))
+	if err := configReloader.Register(reloadableConfig.ConfigListener()); err != nil {
+		log.Fatalf("Invalid reloadable configuration: %v", err)
+	}
+	if err := configReloader.Start(ctx); err != nil {
+		log.Printf("Warning: using startup configuration: %v", err)
+	}
+
+	// Propagate rotated channel credentials from the secret store
+	secrets := dapr.NewSecrets(daprClient)
+	stateStore := dapr.NewStateStore(daprClient)
+	secretRotator := dapr.NewSecretRotator(secrets, stateStore, dapr.NewPubSub(daprClient))
+	for _, policy := range notifications.SecretRotationPolicies() {
+		if err := secretRotator.Register(policy); err != nil {
+			log.Fatalf("Invalid secret rotation policy: %v", err)
+		}
+	}
+
+	// Create the enabled channel handlers and re-initialize their clients on rotation
+	messageQueue := notifications.NewRabbitMQClient(config.MessageQueue.ConnectionString, logger, config.MessageQueue)
+	channels, err := notifications.NewNotificationChannels(ctx, stateStore, secrets, messageQueue, config, logger)
+	if err != nil {
+		log.Fatalf("Failed to create notification channels: %v", err)
+	}
+	if err := channels.SubscribeCredentials(secretRotator); err != nil {
+		log.Fatalf("Failed to subscribe channel credentials: %v", err)
+	}
+
+	// Queue redeliveries must not send the same notification twice
+	deduplicator := dapr.NewEventDeduplicator(stateStore)
+	channels.UseDeduplication(deduplicator)
+	go secretRotator.Run(ctx)
+
+	if err := channels.Start(ctx); err != nil {
+		log.Fatalf("Failed to start notification channels: %v", err)
+	}
+
 	// Create notification service using Dapr client
 	notificationService, err := notifications.NewNotificationHandler(daprClient)
 	if err != nil {
@@ -70,6 +109,11 @@ func main() {
 	router.HandleFunc("/health", notificationService.HealthCheck).Methods("GET")
 	router.HandleFunc("/health/ready", notificationService.ReadinessCheck).Methods("GET")
 
+	// Active reloadable configuration and deduplication counters
+	adminRouter := router.PathPrefix("/admin").Subrouter()
+	dapr.NewConfigAdminHandler(configReloader).RegisterRoutes(adminRouter)
+	dapr.NewDeduplicationAdminHandler(deduplicator).RegisterRoutes(adminRouter)
+
 	// Create server
 	server := &http.Server{
 		Addr:         ":" + port,
@@ -103,6 +147,14 @@ func main() {
 		log.Fatalf("Server shutdown failed: %v", err)
 	}
 
+	// Stop the channel handlers once no more requests arrive
+	if err := channels.Stop(shutdownCtx); err != nil {
+		log.Printf("Warning: notification channels did not stop cleanly: %v", err)
+	}
+	if err := messageQueue.Close(shutdownCtx); err != nil {
+		log.Printf("Warning: failed to close message queue: %v", err)
+	}
+
 	log.Println("Notifications Service shutdown complete")
 }
 
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/content/events"
+	"github.com/axiom-software-co/international-center/src/backend/internal/content/news"
+	"github.com/axiom-software-co/international-center/src/backend/internal/content/research"
+	"github.com/axiom-software-co/international-center/src/backend/internal/content/services"
+	"github.com/axiom-software-co/international-center/src/backend/internal/inquiries/business"
+	"github.com/axiom-software-co/international-center/src/backend/internal/inquiries/donations"
+	"github.com/axiom-software-co/international-center/src/backend/internal/inquiries/media"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
+)
+
+func main() {
+	var (
+		mode    = flag.String("mode", "verify", "Operation to run: verify or rebuild")
+		target  = flag.String("domain", "all", "Domain to process: news, research, services, events, media, business, donations or all")
+		timeout = flag.Duration("timeout", 10*time.Minute, "Maximum time allowed for the whole run")
+	)
+	flag.Parse()
+
+	if *mode != "verify" && *mode != "rebuild" {
+		log.Fatalf("Unsupported mode %q, expected verify or rebuild", *mode)
+	}
+
+	definitions := map[string]*dapr.IndexedEntityType{
+		"news":      news.NewsIndexes(),
+		"research":  research.ResearchIndexes(),
+		"services":  services.ServiceIndexes(),
+		"events":    events.EventIndexes(),
+		"media":     media.MediaInquiryIndexes(),
+		"business":  business.BusinessInquiryIndexes(),
+		"donations": donations.DonationsInquiryIndexes(),
+	}
+
+	var selected []*dapr.IndexedEntityType
+	if *target == "all" {
+		for _, name := range []string{"news", "research", "services", "events", "media", "business", "donations"} {
+			selected = append(selected, definitions[name])
+		}
+	} else if definition, exists := definitions[*target]; exists {
+		selected = append(selected, definition)
+	} else {
+		log.Fatalf("Unknown domain %q", *target)
+	}
+
+	daprClient, err := dapr.NewClient()
+	if err != nil {
+		log.Fatalf("Failed to create Dapr client: %v", err)
+	}
+	defer daprClient.Close()
+
+	stateStore := dapr.NewStateStore(daprClient)
+	for _, definition := range selected {
+		stateStore.MustRegisterIndexes(definition)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	consistent := true
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+
+	for _, definition := range selected {
+		var report *dapr.IndexReport
+		if *mode == "rebuild" {
+			report, err = stateStore.RebuildIndexes(ctx, definition.Domain, definition.EntityType)
+		} else {
+			report, err = stateStore.VerifyIndexes(ctx, definition.Domain, definition.EntityType)
+		}
+		if err != nil {
+			log.Fatalf("Index %s failed for %s:%s: %v", *mode, definition.Domain, definition.EntityType, err)
+		}
+
+		if err := encoder.Encode(report); err != nil {
+			log.Fatalf("Failed to write report: %v", err)
+		}
+		if !report.Consistent() {
+			consistent = false
+		}
+	}
+
+	if *mode == "verify" && !consistent {
+		log.Println("Index verification found drift; run with -mode rebuild to repair")
+		os.Exit(1)
+	}
+}
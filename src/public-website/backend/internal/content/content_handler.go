@@ -1,16 +1,19 @@
 package content
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/axiom-software-co/international-center/src/backend/internal/content/events"
+	"github.com/axiom-software-co/international-center/src/backend/internal/content/media"
 	"github.com/axiom-software-co/international-center/src/backend/internal/content/news"
 	"github.com/axiom-software-co/international-center/src/backend/internal/content/research"
 	"github.com/axiom-software-co/international-center/src/backend/internal/content/services"
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/middleware"
 	"github.com/gorilla/mux"
 )
@@ -21,11 +24,20 @@ type ContentHandler struct {
 	newsHandler         *news.NewsHandler
 	researchHandler     *research.ResearchHandler
 	servicesHandler     *services.ServicesHandler
+	mediaHandler        *media.MediaHandler
 	contractContentServer *SimplifiedContractHandler
 	newsService         *news.NewsService
 	researchService     *research.ResearchService
 	servicesService     *services.ServicesService
 	eventsService       *events.EventsService
+	mediaService        *media.MediaService
+	subscriber          *dapr.Subscriber
+	scheduler           *PublicationScheduler
+	reviews             *EditorialReviews
+	sagas               *dapr.TransactionManager
+	outbox              *dapr.OutboxRelay
+	pubsub              *dapr.PubSub
+	featureFlags        *dapr.FeatureFlagService
 }
 
 // NewContentHandler creates a new consolidated content handler
@@ -59,24 +71,185 @@ func NewContentHandler(client *dapr.Client) (*ContentHandler, error) {
 	servicesService := services.NewServicesService(servicesRepository)
 	servicesHandler := services.NewServicesHandler(servicesService)
 
+	// Every content type follows the same editorial review policy
+	workflow, err := EditorialWorkflowFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	newsService.UseEditorialWorkflow(workflow)
+	researchService.UseEditorialWorkflow(workflow)
+	servicesService.UseEditorialWorkflow(workflow)
+	eventsService.UseEditorialWorkflow(workflow)
+
+	// Scheduled publishing applies the same transitions as the publish endpoints
+	scheduler := NewPublicationScheduler(NewScheduleRepository(stateStore))
+	scheduler.Register(domain.EntityTypeNews, PublicationTarget{
+		Publish:   newsService.PublishNews,
+		Unpublish: newsService.ArchiveNews,
+	})
+	scheduler.Register(domain.EntityTypeResearch, PublicationTarget{
+		Publish:   researchService.PublishResearch,
+		Unpublish: researchService.ArchiveResearch,
+		Embargo:   researchService.SetResearchEmbargo,
+	})
+	scheduler.Register(domain.EntityTypeService, PublicationTarget{
+		Publish:   servicesService.AdminPublishService,
+		Unpublish: servicesService.AdminArchiveService,
+	})
+	scheduler.Register(domain.EntityTypeEvent, PublicationTarget{
+		Publish: func(ctx context.Context, eventID string, userID string) error {
+			_, err := eventsService.AdminPublishEvent(ctx, eventID, userID)
+			return err
+		},
+		Unpublish: func(ctx context.Context, eventID string, userID string) error {
+			_, err := eventsService.AdminArchiveEvent(ctx, eventID, userID)
+			return err
+		},
+	})
+
+	reviews := NewEditorialReviews()
+	reviews.Register(domain.EntityTypeNews, ReviewTarget{
+		Get:             newsService.GetNewsReview,
+		Submit:          newsService.SubmitNewsForReview,
+		AssignReviewers: newsService.AssignNewsReviewers,
+		Comment:         newsService.CommentOnNewsReview,
+		Approve:         newsService.ApproveNews,
+		Reject:          newsService.RejectNews,
+	})
+	reviews.Register(domain.EntityTypeResearch, ReviewTarget{
+		Get:             researchService.GetResearchReview,
+		Submit:          researchService.SubmitResearchForReview,
+		AssignReviewers: researchService.AssignResearchReviewers,
+		Comment:         researchService.CommentOnResearchReview,
+		Approve:         researchService.ApproveResearch,
+		Reject:          researchService.RejectResearch,
+	})
+	reviews.Register(domain.EntityTypeService, ReviewTarget{
+		Get:             servicesService.AdminGetServiceReview,
+		Submit:          servicesService.AdminSubmitServiceForReview,
+		AssignReviewers: servicesService.AdminAssignServiceReviewers,
+		Comment:         servicesService.AdminCommentOnServiceReview,
+		Approve:         servicesService.AdminApproveService,
+		Reject:          servicesService.AdminRejectService,
+	})
+	reviews.Register(domain.EntityTypeEvent, ReviewTarget{
+		Get:             eventsService.AdminGetEventReview,
+		Submit:          eventsService.AdminSubmitEventForReview,
+		AssignReviewers: eventsService.AdminAssignEventReviewers,
+		Comment:         eventsService.AdminCommentOnEventReview,
+		Approve:         eventsService.AdminApproveEvent,
+		Reject:          eventsService.AdminRejectEvent,
+	})
+
+	// Media library files live in blob storage and are served through signed links
+	signingKey, err := dapr.NewSecrets(client).GetSecret(context.Background(), media.SecretKeyURLSigning)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load media URL signing key: %w", err)
+	}
+	mediaService, err := media.NewMediaServiceFromEnv(media.NewMediaRepository(stateStore, bindings, pubsub), signingKey)
+	if err != nil {
+		return nil, err
+	}
+	mediaService.RegisterLinkTarget(domain.EntityTypeNews, func(ctx context.Context, newsID string, userID string) error {
+		_, err := newsService.GetNews(ctx, newsID, userID)
+		return err
+	})
+	mediaService.RegisterLinkTarget(domain.EntityTypeResearch, func(ctx context.Context, researchID string, userID string) error {
+		_, err := researchService.GetResearch(ctx, researchID, userID)
+		return err
+	})
+	mediaService.RegisterLinkTarget(domain.EntityTypeService, func(ctx context.Context, serviceID string, userID string) error {
+		_, err := servicesService.GetService(ctx, serviceID, userID)
+		return err
+	})
+	mediaService.RegisterLinkTarget(domain.EntityTypeEvent, func(ctx context.Context, eventID string, userID string) error {
+		_, err := eventsService.AdminGetEvent(ctx, eventID, userID)
+		return err
+	})
+	mediaHandler := media.NewMediaHandler(mediaService)
+
 	// Initialize contract-compliant content server
-	contractContentServer := NewSimplifiedContractHandler(newsService, researchService, servicesService, eventsService)
+	contractContentServer := NewSimplifiedContractHandler(newsService, researchService, servicesService, eventsService, scheduler, reviews, mediaService)
+
+	// Keep search indexes current from content audit events
+	searchIndexer := NewSearchIndexer()
+	searchIndexer.Register(domain.EntityTypeNews, newsService.ReindexNews)
+	searchIndexer.Register(domain.EntityTypeResearch, researchService.ReindexResearch)
+	searchIndexer.Register(domain.EntityTypeService, servicesService.ReindexService)
+	searchIndexer.Register(domain.EntityTypeEvent, eventsService.ReindexEvent)
+
+	subscriber := dapr.NewSubscriber()
+	subscriber.UseDeduplication(dapr.NewEventDeduplicator(stateStore))
+	subscriber.UseSchemaValidation(pubsub.EventSchemas())
+	subscriber.Subscribe(pubsub.AuditTopic(), searchIndexRoute, searchIndexer.HandleAuditEvent)
+
+	// Sagas started through this service are resumed here after a restart
+	sagas := dapr.NewTransactionManager(client)
+
+	// Audit events committed by the content repositories reach pub/sub through the outbox
+	outbox := dapr.NewOutboxRelay(stateStore, pubsub)
+
+	// Feature flags come from the configuration store and the admin API
+	featureFlags := dapr.NewFeatureFlagService(stateStore, pubsub, client.GetEnvironment())
 
 	return &ContentHandler{
 		eventsHandler:       eventsHandler,
 		newsHandler:         newsHandler,
 		researchHandler:     researchHandler,
 		servicesHandler:     servicesHandler,
+		mediaHandler:        mediaHandler,
 		contractContentServer: contractContentServer,
 		newsService:         newsService,
 		researchService:     researchService,
 		servicesService:     servicesService,
 		eventsService:       eventsService,
+		mediaService:        mediaService,
+		subscriber:          subscriber,
+		scheduler:           scheduler,
+		reviews:             reviews,
+		sagas:               sagas,
+		outbox:              outbox,
+		pubsub:              pubsub,
+		featureFlags:        featureFlags,
 	}, nil
 }
 
+// RunScheduler fires scheduled publishing transitions until ctx is cancelled
+func (h *ContentHandler) RunScheduler(ctx context.Context) {
+	h.scheduler.Run(ctx)
+}
+
+// RunSagaRecovery resumes or compensates interrupted saga executions until ctx is cancelled
+func (h *ContentHandler) RunSagaRecovery(ctx context.Context) {
+	h.sagas.RunRecovery(ctx)
+}
+
+// RunOutboxRelay publishes committed outbox messages until ctx is cancelled
+func (h *ContentHandler) RunOutboxRelay(ctx context.Context) {
+	h.outbox.Run(ctx)
+}
+
+// RunDeadLetterRetries republishes the dead events operators queued for retry until ctx is cancelled
+func (h *ContentHandler) RunDeadLetterRetries(ctx context.Context) {
+	h.pubsub.RunDeadLetterRetries(ctx)
+}
+
+// FeatureFlagListener loads the feature flags defined in the configuration store
+func (h *ContentHandler) FeatureFlagListener() dapr.ConfigListener {
+	return h.featureFlags.ConfigListener()
+}
+
+// RunFeatureFlagRefresh picks up flags changed through the admin API of any instance
+// until ctx is cancelled
+func (h *ContentHandler) RunFeatureFlagRefresh(ctx context.Context) {
+	h.featureFlags.RunRefresh(ctx)
+}
+
 // RegisterRoutes registers all content domain routes with the router
 func (h *ContentHandler) RegisterRoutes(router *mux.Router) {
+	// Every content request sees the feature flags evaluated for its caller
+	router.Use(h.featureFlags.Middleware())
+
 	// Apply contract validation middleware to admin routes
 	adminRouter := router.PathPrefix("/admin/api/v1").Subrouter()
 	adminMiddleware := middleware.AdminAPIMiddleware()
@@ -84,6 +257,20 @@ func (h *ContentHandler) RegisterRoutes(router *mux.Router) {
 	
 	// Register contract-compliant routes for admin content API
 	h.registerContractCompliantRoutes(adminRouter)
+
+	// Saga executions can be inspected, retried and aborted by operators
+	dapr.NewSagaAdminHandler(h.sagas).RegisterRoutes(adminRouter)
+
+	// Published events can be searched and replayed by operators
+	dapr.NewEventAdminHandler(h.pubsub).RegisterRoutes(adminRouter)
+
+	// Dead letters from pub/sub and the notification channels share one console
+	if deadLetters := h.pubsub.DeadLetters(); deadLetters != nil {
+		dapr.NewDeadLetterAdminHandler(deadLetters).RegisterRoutes(adminRouter)
+	}
+
+	// Feature flags are managed and their rollouts checked here
+	dapr.NewFeatureFlagAdminHandler(h.featureFlags).RegisterRoutes(adminRouter)
 	
 	// Apply validation middleware to public routes
 	publicRouter := router.PathPrefix("/api/v1").Subrouter()
@@ -92,12 +279,15 @@ func (h *ContentHandler) RegisterRoutes(router *mux.Router) {
 	
 	// Register legacy routes for backward compatibility during migration
 	h.registerLegacyRoutes(router)
+
+	// Register Dapr subscription routes
+	h.subscriber.RegisterRoutes(router)
 }
 
 // registerContractCompliantRoutes registers routes using generated interfaces
 func (h *ContentHandler) registerContractCompliantRoutes(adminRouter *mux.Router) {
 	// Register contract-compliant content routes for news, research, services, events
-	RegisterSimplifiedContentRoutes(adminRouter, h.newsService, h.researchService, h.servicesService, h.eventsService)
+	RegisterSimplifiedContentRoutes(adminRouter, h.newsService, h.researchService, h.servicesService, h.eventsService, h.scheduler, h.reviews, h.mediaService)
 }
 
 // registerLegacyRoutes registers existing domain-specific routes for backward compatibility
@@ -107,6 +297,9 @@ func (h *ContentHandler) registerLegacyRoutes(router *mux.Router) {
 	h.newsHandler.RegisterRoutes(router)
 	h.researchHandler.RegisterRoutes(router)
 	h.servicesHandler.RegisterRoutes(router)
+
+	// Signed media downloads
+	h.mediaHandler.RegisterRoutes(router)
 	
 	// Add featured content endpoints that frontend contract clients expect
 	h.registerFeaturedContentRoutes(router)
@@ -84,6 +84,7 @@ func TestContentHandler_RegisterRoutes(t *testing.T) {
 		"/api/v1/events/featured",
 		"/api/v1/events/published",
 		"/api/v1/events/upcoming",
+		"/api/v1/events/search",
 
 		// News domain routes - Admin
 		"/admin/api/v1/news",
@@ -132,6 +133,11 @@ func TestContentHandler_RegisterRoutes(t *testing.T) {
 		"/api/v1/services/categories",
 		"/api/v1/services/featured",
 		"/api/v1/services/published",
+		"/api/v1/services/search",
+
+		// Dapr subscription routes
+		"/dapr/subscribe",
+		"/events/search-index",
 	}
 
 	for _, expectedRoute := range expectedRoutes {
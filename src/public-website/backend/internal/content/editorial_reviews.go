@@ -0,0 +1,134 @@
+package content
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+)
+
+// ReviewFunc applies a review step that needs nothing beyond who is acting
+type ReviewFunc func(ctx context.Context, entityID string, userID string) (*domain.ContentReview, error)
+
+// ReviewersFunc applies a review step that names reviewers
+type ReviewersFunc func(ctx context.Context, entityID string, reviewerIDs []string, userID string) (*domain.ContentReview, error)
+
+// ReviewNoteFunc applies a review step that carries text, a comment or a rejection reason
+type ReviewNoteFunc func(ctx context.Context, entityID string, text string, userID string) (*domain.ContentReview, error)
+
+// ReviewTarget connects a content type to the editorial review endpoints
+type ReviewTarget struct {
+	Get             ReviewFunc
+	Submit          ReviewersFunc
+	AssignReviewers ReviewersFunc
+	Comment         ReviewNoteFunc
+	Approve         ReviewFunc
+	Reject          ReviewNoteFunc
+}
+
+// EditorialReviews routes review requests to the content type they concern
+type EditorialReviews struct {
+	targets map[domain.EntityType]ReviewTarget
+}
+
+// NewEditorialReviews creates a review registry with no content types registered
+func NewEditorialReviews() *EditorialReviews {
+	return &EditorialReviews{
+		targets: make(map[domain.EntityType]ReviewTarget),
+	}
+}
+
+// Register makes entityType reviewable through target
+func (e *EditorialReviews) Register(entityType domain.EntityType, target ReviewTarget) {
+	e.targets[entityType] = target
+}
+
+// GetReview returns the review state of a content item
+func (e *EditorialReviews) GetReview(ctx context.Context, entityType domain.EntityType, entityID string, userID string) (*domain.ContentReview, error) {
+	target, err := e.target(entityType)
+	if err != nil {
+		return nil, err
+	}
+	return target.Get(ctx, entityID, userID)
+}
+
+// Submit sends draft content to its reviewers
+func (e *EditorialReviews) Submit(ctx context.Context, entityType domain.EntityType, entityID string, reviewerIDs []string, userID string) (*domain.ContentReview, error) {
+	target, err := e.target(entityType)
+	if err != nil {
+		return nil, err
+	}
+	return target.Submit(ctx, entityID, reviewerIDs, userID)
+}
+
+// AssignReviewers adds reviewers to content under review
+func (e *EditorialReviews) AssignReviewers(ctx context.Context, entityType domain.EntityType, entityID string, reviewerIDs []string, userID string) (*domain.ContentReview, error) {
+	target, err := e.target(entityType)
+	if err != nil {
+		return nil, err
+	}
+	return target.AssignReviewers(ctx, entityID, reviewerIDs, userID)
+}
+
+// Comment adds a review comment
+func (e *EditorialReviews) Comment(ctx context.Context, entityType domain.EntityType, entityID string, body string, userID string) (*domain.ContentReview, error) {
+	target, err := e.target(entityType)
+	if err != nil {
+		return nil, err
+	}
+	return target.Comment(ctx, entityID, body, userID)
+}
+
+// Approve records the caller's approval
+func (e *EditorialReviews) Approve(ctx context.Context, entityType domain.EntityType, entityID string, userID string) (*domain.ContentReview, error) {
+	target, err := e.target(entityType)
+	if err != nil {
+		return nil, err
+	}
+	return target.Approve(ctx, entityID, userID)
+}
+
+// Reject returns content to draft with the reason
+func (e *EditorialReviews) Reject(ctx context.Context, entityType domain.EntityType, entityID string, reason string, userID string) (*domain.ContentReview, error) {
+	target, err := e.target(entityType)
+	if err != nil {
+		return nil, err
+	}
+	return target.Reject(ctx, entityID, reason, userID)
+}
+
+func (e *EditorialReviews) target(entityType domain.EntityType) (ReviewTarget, error) {
+	target, exists := e.targets[entityType]
+	if !exists {
+		return ReviewTarget{}, domain.NewValidationFieldError("entity_type", fmt.Sprintf("%s content has no editorial review", entityType))
+	}
+	return target, nil
+}
+
+// EditorialWorkflowFromEnv reads the review policy for content. Review is required
+// unless CONTENT_REVIEW_REQUIRED is false; CONTENT_REVIEW_APPROVALS sets how many
+// reviewers must approve.
+func EditorialWorkflowFromEnv() (*domain.EditorialWorkflow, error) {
+	requireReview := true
+	if value := strings.TrimSpace(os.Getenv("CONTENT_REVIEW_REQUIRED")); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CONTENT_REVIEW_REQUIRED %q: %w", value, err)
+		}
+		requireReview = parsed
+	}
+
+	approvals := 1
+	if value := strings.TrimSpace(os.Getenv("CONTENT_REVIEW_APPROVALS")); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			return nil, fmt.Errorf("invalid CONTENT_REVIEW_APPROVALS %q: must be a positive integer", value)
+		}
+		approvals = parsed
+	}
+
+	return domain.NewEditorialWorkflow(requireReview, approvals), nil
+}
@@ -0,0 +1,97 @@
+package content
+
+import (
+	"context"
+	"testing"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEditorialReviews_Dispatch(t *testing.T) {
+	var calls []string
+	record := func(step string) ReviewFunc {
+		return func(ctx context.Context, entityID string, userID string) (*domain.ContentReview, error) {
+			calls = append(calls, step+":"+entityID+":"+userID)
+			return domain.NewContentReview(domain.EntityTypeNews, entityID, "Title", domain.WorkflowStatusInReview, nil), nil
+		}
+	}
+	reviews := NewEditorialReviews()
+	reviews.Register(domain.EntityTypeNews, ReviewTarget{
+		Get: record("get"),
+		Submit: func(ctx context.Context, entityID string, reviewerIDs []string, userID string) (*domain.ContentReview, error) {
+			calls = append(calls, "submit:"+reviewerIDs[0])
+			return domain.NewContentReview(domain.EntityTypeNews, entityID, "Title", domain.WorkflowStatusInReview, nil), nil
+		},
+		Approve: record("approve"),
+		Reject: func(ctx context.Context, entityID string, reason string, userID string) (*domain.ContentReview, error) {
+			calls = append(calls, "reject:"+reason)
+			return domain.NewContentReview(domain.EntityTypeNews, entityID, "Title", domain.WorkflowStatusDraft, nil), nil
+		},
+	})
+	ctx := context.Background()
+
+	t.Run("route each step to the registered content type", func(t *testing.T) {
+		// Act
+		_, err := reviews.Submit(ctx, domain.EntityTypeNews, "news-1", []string{"reviewer-1"}, "author-1")
+		require.NoError(t, err)
+		_, err = reviews.Approve(ctx, domain.EntityTypeNews, "news-1", "reviewer-1")
+		require.NoError(t, err)
+		review, err := reviews.Reject(ctx, domain.EntityTypeNews, "news-1", "needs sources", "reviewer-1")
+		require.NoError(t, err)
+		_, err = reviews.GetReview(ctx, domain.EntityTypeNews, "news-1", "author-1")
+		require.NoError(t, err)
+
+		// Assert
+		assert.Equal(t, []string{"submit:reviewer-1", "approve:news-1:reviewer-1", "reject:needs sources", "get:news-1:author-1"}, calls)
+		assert.Equal(t, domain.WorkflowStatusDraft, review.Status)
+		assert.NotNil(t, review.Review)
+	})
+
+	t.Run("reject content type without review", func(t *testing.T) {
+		// Act
+		_, err := reviews.Approve(ctx, domain.EntityTypeEvent, "event-1", "reviewer-1")
+
+		// Assert
+		require.Error(t, err)
+		assert.True(t, domain.IsValidationError(err))
+	})
+}
+
+func TestEditorialWorkflowFromEnv(t *testing.T) {
+	tests := []struct {
+		name              string
+		required          string
+		approvals         string
+		expectedRequired  bool
+		expectedApprovals int
+		expectError       bool
+	}{
+		{name: "review required with one approval by default", expectedRequired: true, expectedApprovals: 1},
+		{name: "review turned off", required: "false", expectedRequired: false, expectedApprovals: 1},
+		{name: "two approvals", approvals: "2", expectedRequired: true, expectedApprovals: 2},
+		{name: "reject unparsable flag", required: "sometimes", expectError: true},
+		{name: "reject zero approvals", approvals: "0", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			t.Setenv("CONTENT_REVIEW_REQUIRED", tt.required)
+			t.Setenv("CONTENT_REVIEW_APPROVALS", tt.approvals)
+
+			// Act
+			workflow, err := EditorialWorkflowFromEnv()
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedRequired, workflow.RequireReview)
+			assert.Equal(t, tt.expectedApprovals, workflow.RequiredApprovals)
+		})
+	}
+}
@@ -4,83 +4,109 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/search"
 	"github.com/google/uuid"
 )
 
 // EventsRepository implements events data access using Dapr state store and bindings
 type EventsRepository struct {
-	stateStore *dapr.StateStore
-	bindings   *dapr.Bindings
-	pubsub     *dapr.PubSub
+	stateStore  *dapr.StateStore
+	bindings    *dapr.Bindings
+	pubsub      *dapr.PubSub
+	searchIndex *search.Index
+}
+
+// Secondary indexes maintained for events
+const (
+	eventIndexCategory         = "category"
+	eventIndexPublishingStatus = "publishing_status"
+	eventIndexSlug             = "slug"
+	eventIndexType             = "type"
+	eventIndexIsDeleted        = "is_deleted"
+	eventIndexEventMonth       = "event_month"
+)
+
+// EventIndexes declares the secondary indexes maintained for events
+func EventIndexes() *dapr.IndexedEntityType {
+	return &dapr.IndexedEntityType{
+		Domain:     "events",
+		EntityType: "event",
+		Indexes: []dapr.IndexDefinition{
+			{Name: eventIndexCategory, Extract: func(entity interface{}) []string {
+				return []string{entity.(*Event).CategoryID}
+			}},
+			{Name: eventIndexPublishingStatus, Extract: func(entity interface{}) []string {
+				return []string{string(entity.(*Event).PublishingStatus)}
+			}},
+			{Name: eventIndexSlug, Extract: func(entity interface{}) []string {
+				return []string{entity.(*Event).Slug}
+			}},
+			{Name: eventIndexType, Extract: func(entity interface{}) []string {
+				return []string{string(entity.(*Event).EventType)}
+			}},
+			{Name: eventIndexIsDeleted, Extract: func(entity interface{}) []string {
+				return []string{dapr.IndexBool(entity.(*Event).IsDeleted)}
+			}},
+			{Name: eventIndexEventMonth, Extract: func(entity interface{}) []string {
+				event := entity.(*Event)
+				if event.EndDate != nil {
+					return dapr.IndexMonthBuckets(event.EventDate, *event.EndDate)
+				}
+				return []string{dapr.IndexMonthBucket(event.EventDate)}
+			}},
+		},
+		NewEntity: func() interface{} { return &Event{} },
+		EntityID:  func(entity interface{}) string { return entity.(*Event).EventID },
+		SortKey:   func(entity interface{}) time.Time { return entity.(*Event).PageCursor().Timestamp },
+	}
 }
 
 // NewEventsRepository creates a new events repository
 func NewEventsRepository(client *dapr.Client) *EventsRepository {
+	stateStore := dapr.NewStateStore(client)
+	stateStore.MustRegisterIndexes(EventIndexes())
+	stateStore.MustRegisterIndexes(dapr.OutboxMessageIndexes())
+
 	return &EventsRepository{
-		stateStore: dapr.NewStateStore(client),
-		bindings:   dapr.NewBindings(client),
-		pubsub:     dapr.NewPubSub(client),
+		stateStore:  stateStore,
+		bindings:    dapr.NewBindings(client),
+		pubsub:      dapr.NewPubSub(client),
+		searchIndex: newEventSearchIndex(),
 	}
 }
 
 // Event operations
 
-// SaveEvent saves event to Dapr state store
-func (r *EventsRepository) SaveEvent(ctx context.Context, event *Event) error {
-	key := r.stateStore.CreateKey("events", "event", event.EventID)
-	
-	err := r.stateStore.Save(ctx, key, event, nil)
+// SaveEvent saves event and its secondary indexes to Dapr state store, guarded by
+// the event's ETag when it has one. The audit event, if any, commits in the same
+// transaction and is recorded as a revision once the write has landed.
+func (r *EventsRepository) SaveEvent(ctx context.Context, event *Event, audit *domain.AuditEvent) error {
+	messages, err := r.auditMessages(ctx, audit)
 	if err != nil {
-		return fmt.Errorf("failed to save event %s: %w", event.EventID, err)
-	}
-
-	// Create index for slug search
-	slugKey := r.stateStore.CreateIndexKey("events", "event", "slug", event.Slug)
-	slugIndex := map[string]string{"event_id": event.EventID}
-	
-	err = r.stateStore.Save(ctx, slugKey, slugIndex, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create slug index for event %s: %w", event.EventID, err)
-	}
-
-	// Create index for category search
-	categoryKey := r.stateStore.CreateIndexKey("events", "event", "category", event.CategoryID)
-	categoryIndex := map[string]string{"event_id": event.EventID}
-	
-	err = r.stateStore.Save(ctx, categoryKey, categoryIndex, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create category index for event %s: %w", event.EventID, err)
+		return err
 	}
 
-	// Create index for event type search
-	typeKey := r.stateStore.CreateIndexKey("events", "event", "type", string(event.EventType))
-	typeIndex := map[string]string{"event_id": event.EventID}
-	
-	err = r.stateStore.Save(ctx, typeKey, typeIndex, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create type index for event %s: %w", event.EventID, err)
+	// Every audited change to an event is also kept as a revision
+	var revision *domain.Revision
+	if audit != nil && audit.EntityType == domain.EntityTypeEvent && domain.IsRevisionedOperation(audit.OperationType) {
+		revision = domain.NewRevision(audit)
 	}
 
-	// Create index for publishing status search
-	statusKey := r.stateStore.CreateIndexKey("events", "event", "status", string(event.PublishingStatus))
-	statusIndex := map[string]string{"event_id": event.EventID}
-	
-	err = r.stateStore.Save(ctx, statusKey, statusIndex, nil)
+	err = r.stateStore.SaveIndexedWithRevision(ctx, "events", "event", event.EventID, event, event.ETag, revision, messages...)
 	if err != nil {
-		return fmt.Errorf("failed to create status index for event %s: %w", event.EventID, err)
+		return fmt.Errorf("failed to save event %s: %w", event.EventID, err)
 	}
 
-	// Create index for event date search
-	dateKey := r.stateStore.CreateIndexKey("events", "event", "date", event.EventDate.Format("2006-01-02"))
-	dateIndex := map[string]string{"event_id": event.EventID}
-	
-	err = r.stateStore.Save(ctx, dateKey, dateIndex, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create date index for event %s: %w", event.EventID, err)
+	// Pick up the new version; leave it empty if it cannot be read back or another
+	// editor has already saved over it
+	key := r.stateStore.CreateKey("events", "event", event.EventID)
+	if event.ETag, err = r.stateStore.WrittenETag(ctx, key, event); err != nil {
+		event.ETag = ""
 	}
 
 	return nil
@@ -91,7 +117,7 @@ func (r *EventsRepository) GetEvent(ctx context.Context, eventID string) (*Event
 	key := r.stateStore.CreateKey("events", "event", eventID)
 	
 	var event Event
-	found, err := r.stateStore.Get(ctx, key, &event)
+	found, etag, err := r.stateStore.GetWithETag(ctx, key, &event)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get event %s: %w", eventID, err)
 	}
@@ -100,11 +126,12 @@ func (r *EventsRepository) GetEvent(ctx context.Context, eventID string) (*Event
 		return nil, domain.NewNotFoundError("event", eventID)
 	}
 
+	event.ETag = etag
 	return &event, nil
 }
 
 // DeleteEvent soft deletes event in Dapr state store
-func (r *EventsRepository) DeleteEvent(ctx context.Context, eventID string, userID string) error {
+func (r *EventsRepository) DeleteEvent(ctx context.Context, eventID string, userID string, audit *domain.AuditEvent) error {
 	// Get existing event
 	event, err := r.GetEvent(ctx, eventID)
 	if err != nil {
@@ -117,16 +144,21 @@ func (r *EventsRepository) DeleteEvent(ctx context.Context, eventID string, user
 	event.DeletedBy = &userID
 
 	// Save updated event
-	return r.SaveEvent(ctx, event)
+	return r.SaveEvent(ctx, event, audit)
 }
 
 // Event category operations
 
-// SaveEventCategory saves event category to Dapr state store
-func (r *EventsRepository) SaveEventCategory(ctx context.Context, category *EventCategory) error {
+// SaveEventCategory saves event category to Dapr state store together with its audit event
+func (r *EventsRepository) SaveEventCategory(ctx context.Context, category *EventCategory, audit *domain.AuditEvent) error {
+	messages, err := r.auditMessages(ctx, audit)
+	if err != nil {
+		return err
+	}
+
 	key := r.stateStore.CreateKey("events", "category", category.CategoryID)
 	
-	err := r.stateStore.Save(ctx, key, category, nil)
+	err = r.stateStore.SaveWithOutbox(ctx, key, category, messages...)
 	if err != nil {
 		return fmt.Errorf("failed to save event category %s: %w", category.CategoryID, err)
 	}
@@ -172,7 +204,7 @@ func (r *EventsRepository) GetEventCategory(ctx context.Context, categoryID stri
 }
 
 // DeleteEventCategory soft deletes event category in Dapr state store
-func (r *EventsRepository) DeleteEventCategory(ctx context.Context, categoryID string, userID string) error {
+func (r *EventsRepository) DeleteEventCategory(ctx context.Context, categoryID string, userID string, audit *domain.AuditEvent) error {
 	// Get existing category
 	category, err := r.GetEventCategory(ctx, categoryID)
 	if err != nil {
@@ -190,7 +222,7 @@ func (r *EventsRepository) DeleteEventCategory(ctx context.Context, categoryID s
 	category.DeletedBy = &userID
 
 	// Save updated category
-	return r.SaveEventCategory(ctx, category)
+	return r.SaveEventCategory(ctx, category, audit)
 }
 
 // GetDefaultUnassignedCategory retrieves the default unassigned category
@@ -218,11 +250,16 @@ func (r *EventsRepository) GetDefaultUnassignedCategory(ctx context.Context) (*E
 
 // Featured event operations
 
-// SaveFeaturedEvent saves featured event to Dapr state store
-func (r *EventsRepository) SaveFeaturedEvent(ctx context.Context, featuredEvent *FeaturedEvent) error {
+// SaveFeaturedEvent saves featured event to Dapr state store together with its audit event
+func (r *EventsRepository) SaveFeaturedEvent(ctx context.Context, featuredEvent *FeaturedEvent, audit *domain.AuditEvent) error {
+	messages, err := r.auditMessages(ctx, audit)
+	if err != nil {
+		return err
+	}
+
 	key := r.stateStore.CreateKey("events", "featured", "current")
 	
-	err := r.stateStore.Save(ctx, key, featuredEvent, nil)
+	err = r.stateStore.SaveWithOutbox(ctx, key, featuredEvent, messages...)
 	if err != nil {
 		return fmt.Errorf("failed to save featured event %s: %w", featuredEvent.FeaturedEventID, err)
 	}
@@ -257,10 +294,15 @@ func (r *EventsRepository) GetFeaturedEvent(ctx context.Context) (*FeaturedEvent
 }
 
 // DeleteFeaturedEvent removes the current featured event from Dapr state store
-func (r *EventsRepository) DeleteFeaturedEvent(ctx context.Context) error {
+func (r *EventsRepository) DeleteFeaturedEvent(ctx context.Context, audit *domain.AuditEvent) error {
+	messages, err := r.auditMessages(ctx, audit)
+	if err != nil {
+		return err
+	}
+
 	key := r.stateStore.CreateKey("events", "featured", "current")
 	
-	err := r.stateStore.Delete(ctx, key, nil)
+	err = r.stateStore.DeleteWithOutbox(ctx, key, messages...)
 	if err != nil {
 		return fmt.Errorf("failed to delete featured event: %w", err)
 	}
@@ -306,30 +348,20 @@ func (r *EventsRepository) GetEventRegistrations(ctx context.Context, eventID st
 
 // Audit operations
 
-// PublishAuditEvent publishes audit event to Grafana Cloud Loki via Dapr pub/sub
-func (r *EventsRepository) PublishAuditEvent(ctx context.Context, entityType domain.EntityType, entityID string, operationType domain.AuditEventType, userID string, beforeData, afterData interface{}) error {
+// auditMessages prepares an audit event for Grafana Cloud Loki so it commits through the
+// outbox together with the write it describes. A nil event prepares nothing.
+func (r *EventsRepository) auditMessages(ctx context.Context, auditEvent *domain.AuditEvent) ([]*dapr.OutboxMessage, error) {
+	if auditEvent == nil {
+		return nil, nil
+	}
+
 	correlationID := domain.GetCorrelationID(ctx)
 	if correlationID == "" {
 		correlationID = uuid.New().String()
 	}
+	auditEvent.SetTraceContext(correlationID, domain.GetTraceID(ctx))
+	auditEvent.Environment = "development" // This should come from configuration
 
-	auditEvent := domain.AuditEvent{
-		AuditID:       uuid.New().String(), // Generate unique audit ID
-		EntityType:    entityType,
-		EntityID:      entityID,
-		OperationType: operationType,
-		AuditTime:     time.Now(),
-		UserID:        userID,
-		CorrelationID: correlationID,
-		TraceID:       domain.GetTraceID(ctx),
-		DataSnapshot: &domain.AuditDataSnapshot{
-			Before: beforeData,
-			After:  afterData,
-		},
-		Environment: "development", // This should come from configuration
-	}
-
-	// Publish to Grafana audit events topic
 	daprAuditEvent := &dapr.AuditEvent{
 		AuditID:       auditEvent.AuditID,
 		EntityType:    string(auditEvent.EntityType),
@@ -339,18 +371,41 @@ func (r *EventsRepository) PublishAuditEvent(ctx context.Context, entityType dom
 		UserID:        auditEvent.UserID,
 		CorrelationID: auditEvent.CorrelationID,
 		TraceID:       auditEvent.TraceID,
-		DataSnapshot:  map[string]interface{}{
+		Environment:   auditEvent.Environment,
+	}
+	if auditEvent.DataSnapshot != nil {
+		daprAuditEvent.DataSnapshot = map[string]interface{}{
 			"before": auditEvent.DataSnapshot.Before,
 			"after":  auditEvent.DataSnapshot.After,
-		},
-		Environment:   auditEvent.Environment,
+		}
 	}
-	err := r.pubsub.PublishAuditEvent(ctx, daprAuditEvent)
+
+	message, err := r.pubsub.AuditEventMessage(daprAuditEvent)
 	if err != nil {
-		return fmt.Errorf("failed to publish audit event for %s %s: %w", entityType, entityID, err)
+		return nil, fmt.Errorf("failed to prepare audit event for %s %s: %w", auditEvent.EntityType, auditEvent.EntityID, err)
 	}
 
-	return nil
+	return []*dapr.OutboxMessage{dapr.NewOutboxMessage(ctx, message.Topic, message)}, nil
+}
+
+// ListEventRevisions returns the revision summaries of an event, newest first
+func (r *EventsRepository) ListEventRevisions(ctx context.Context, eventID string) ([]*domain.Revision, error) {
+	revisions, err := r.stateStore.ListRevisions(ctx, "events", "event", eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list revisions for event %s: %w", eventID, err)
+	}
+
+	return revisions, nil
+}
+
+// GetEventRevision retrieves one revision of an event including its data snapshot
+func (r *EventsRepository) GetEventRevision(ctx context.Context, eventID string, revision int) (*domain.Revision, error) {
+	return r.stateStore.GetRevision(ctx, "events", "event", eventID, revision)
+}
+
+// PublishReviewEvent notifies reviewers and authors about an event's review
+func (r *EventsRepository) PublishReviewEvent(ctx context.Context, event *domain.ReviewEvent) error {
+	return r.pubsub.PublishReviewEvent(ctx, event)
 }
 
 // Search and query operations
@@ -438,36 +493,185 @@ func (r *EventsRepository) SearchEvents(ctx context.Context, criteria EventSearc
 	return events, nil
 }
 
-// GetEventsByCategory retrieves all events in a specific category
+// QueryEvents runs a full-text query against the events search index,
+// building the index from the state store on first use
+func (r *EventsRepository) QueryEvents(ctx context.Context, query search.Query) (*search.Results, error) {
+	if err := r.searchIndex.EnsureLoaded(ctx, r.loadEventSearchDocuments); err != nil {
+		return nil, err
+	}
+
+	return r.searchIndex.Search(query), nil
+}
+
+// ReindexEvent refreshes the search document of one event from the state
+// store, dropping it from the index once the event is deleted
+func (r *EventsRepository) ReindexEvent(ctx context.Context, eventID string) error {
+	if err := r.searchIndex.EnsureLoaded(ctx, r.loadEventSearchDocuments); err != nil {
+		return err
+	}
+
+	event, err := r.GetEvent(ctx, eventID)
+	if domain.IsNotFoundError(err) {
+		r.searchIndex.Remove(eventID)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if event.IsDeleted {
+		r.searchIndex.Remove(eventID)
+		return nil
+	}
+
+	r.searchIndex.Upsert(eventSearchDocument(event))
+	return nil
+}
+
+// loadEventSearchDocuments loads every non-deleted event for indexing
+func (r *EventsRepository) loadEventSearchDocuments(ctx context.Context) ([]search.Document, error) {
+	ids, err := r.stateStore.LookupIndex(ctx, "events", "event",
+		dapr.IndexCondition{Index: eventIndexIsDeleted, Value: dapr.IndexBool(false)},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up events for search index: %w", err)
+	}
+
+	events, err := r.getEventsByIDs(ctx, ids, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return eventSearchDocuments(events), nil
+}
+
+// GetEventsByCategory retrieves all events in a specific category using the category index
 func (r *EventsRepository) GetEventsByCategory(ctx context.Context, categoryID string, includeDeleted bool) ([]*Event, error) {
-	criteria := EventSearchCriteria{
-		CategoryID:      categoryID,
-		IncludeDeleted:  includeDeleted,
+	conditions := []dapr.IndexCondition{{Index: eventIndexCategory, Value: categoryID}}
+	if !includeDeleted {
+		conditions = append(conditions, dapr.IndexCondition{Index: eventIndexIsDeleted, Value: dapr.IndexBool(false)})
 	}
-	
-	return r.SearchEvents(ctx, criteria)
+
+	ids, err := r.stateStore.LookupIndex(ctx, "events", "event", conditions...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up events by category %s: %w", categoryID, err)
+	}
+
+	return r.getEventsByIDs(ctx, ids, includeDeleted)
 }
 
-// GetPublishedEvents retrieves all published events
+// GetPublishedEvents retrieves all published events using the status index
 func (r *EventsRepository) GetPublishedEvents(ctx context.Context) ([]*Event, error) {
-	criteria := EventSearchCriteria{
-		PublishingStatus: string(PublishingStatusPublished),
-		IncludeDeleted:   false,
+	ids, err := r.stateStore.LookupIndex(ctx, "events", "event",
+		dapr.IndexCondition{Index: eventIndexPublishingStatus, Value: string(PublishingStatusPublished)},
+		dapr.IndexCondition{Index: eventIndexIsDeleted, Value: dapr.IndexBool(false)},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up published events: %w", err)
 	}
-	
-	return r.SearchEvents(ctx, criteria)
+
+	return r.getEventsByIDs(ctx, ids, false)
 }
 
-// GetUpcomingEvents retrieves all upcoming events
+// GetUpcomingEvents retrieves all published events dated from now onwards
 func (r *EventsRepository) GetUpcomingEvents(ctx context.Context) ([]*Event, error) {
+	published, err := r.GetPublishedEvents(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
-	criteria := EventSearchCriteria{
-		PublishingStatus: string(PublishingStatusPublished),
-		EventDateFrom:    &now,
-		IncludeDeleted:   false,
+	upcoming := make([]*Event, 0, len(published))
+	for _, event := range published {
+		if !event.EventDate.Before(now) {
+			upcoming = append(upcoming, event)
+		}
 	}
-	
-	return r.SearchEvents(ctx, criteria)
+
+	return upcoming, nil
+}
+
+// getEventsByIDs loads indexed events in chronological order
+func (r *EventsRepository) getEventsByIDs(ctx context.Context, ids []string, includeDeleted bool) ([]*Event, error) {
+	events := make([]*Event, 0, len(ids))
+	if len(ids) == 0 {
+		return events, nil
+	}
+
+	keys := make([]string, 0, len(ids))
+	targets := make(map[string]interface{}, len(ids))
+	loaded := make([]*Event, 0, len(ids))
+	for _, id := range ids {
+		key := r.stateStore.CreateKey("events", "event", id)
+		event := &Event{}
+		keys = append(keys, key)
+		targets[key] = event
+		loaded = append(loaded, event)
+	}
+
+	if err := r.stateStore.GetBulk(ctx, keys, targets); err != nil {
+		return nil, fmt.Errorf("failed to load indexed events: %w", err)
+	}
+
+	for _, event := range loaded {
+		if event.EventID != "" && (includeDeleted || !event.IsDeleted) {
+			events = append(events, event)
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		if !events[i].EventDate.Equal(events[j].EventDate) {
+			return events[i].EventDate.Before(events[j].EventDate)
+		}
+		return events[i].EventID < events[j].EventID
+	})
+
+	return events, nil
+}
+
+// ListEventsPage retrieves one page of non-deleted events matching the filter,
+// latest event date first, resuming after the page cursor
+func (r *EventsRepository) ListEventsPage(ctx context.Context, filter EventListFilter, page domain.PageRequest) ([]*Event, domain.PageInfo, error) {
+	conditions := []dapr.IndexCondition{
+		{Index: eventIndexIsDeleted, Value: dapr.IndexBool(false)},
+	}
+	if filter.CategoryID != "" {
+		conditions = append(conditions, dapr.IndexCondition{Index: eventIndexCategory, Value: filter.CategoryID})
+	}
+	if filter.PublishingStatus != "" {
+		conditions = append(conditions, dapr.IndexCondition{Index: eventIndexPublishingStatus, Value: string(filter.PublishingStatus)})
+	}
+
+	ids, info, err := r.stateStore.LookupIndexPage(ctx, "events", "event", page, conditions...)
+	if err != nil {
+		return nil, domain.PageInfo{}, fmt.Errorf("failed to look up events page: %w", err)
+	}
+
+	events, err := r.getEventsByIDs(ctx, ids, false)
+	if err != nil {
+		return nil, domain.PageInfo{}, err
+	}
+
+	// getEventsByIDs orders chronologically; a page keeps listing order
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].PageCursor().Before(events[j].PageCursor())
+	})
+
+	info.Count = len(events)
+	return events, info, nil
+}
+
+// PageEvents orders events by PageCursor and slices out the requested page
+func PageEvents(events []*Event, page domain.PageRequest) ([]*Event, domain.PageInfo) {
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].PageCursor().Before(events[j].PageCursor())
+	})
+
+	start, end, info := domain.CursorPage(len(events), func(i int) domain.PageCursor {
+		return events[i].PageCursor()
+	}, page)
+
+	return events[start:end], info
 }
 
 // Supporting types
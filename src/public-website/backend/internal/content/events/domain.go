@@ -56,6 +56,23 @@ type Event struct {
 	IsDeleted bool       `json:"is_deleted"`
 	DeletedOn *time.Time `json:"deleted_on,omitempty"`
 	DeletedBy *string    `json:"deleted_by,omitempty"`
+
+	// Editorial review state, changed only through the review workflow
+	Review *domain.EditorialReview `json:"review,omitempty"`
+
+	// Optimistic concurrency version from the state store, sent as an HTTP header
+	ETag string `json:"-"`
+}
+
+// EventListFilter narrows a paged event listing; empty fields are not applied
+type EventListFilter struct {
+	CategoryID       string
+	PublishingStatus PublishingStatus
+}
+
+// PageCursor returns the position of the event in paged event listings
+func (e *Event) PageCursor() domain.PageCursor {
+	return domain.PageCursor{Timestamp: e.EventDate, ID: e.EventID}
 }
 
 // EventCategory entity represents an event category
@@ -149,6 +166,8 @@ type PublishingStatus string
 
 const (
 	PublishingStatusDraft     PublishingStatus = "draft"
+	PublishingStatusInReview  PublishingStatus = "in_review"
+	PublishingStatusApproved  PublishingStatus = "approved"
 	PublishingStatusPublished PublishingStatus = "published"
 	PublishingStatusArchived  PublishingStatus = "archived"
 )
@@ -156,7 +175,7 @@ const (
 // IsValid checks if the publishing status is valid
 func (ps PublishingStatus) IsValid() bool {
 	switch ps {
-	case PublishingStatusDraft, PublishingStatusPublished, PublishingStatusArchived:
+	case PublishingStatusDraft, PublishingStatusInReview, PublishingStatusApproved, PublishingStatusPublished, PublishingStatusArchived:
 		return true
 	default:
 		return false
@@ -249,6 +268,7 @@ type AdminUpdateEventRequest struct {
 	EventType            *string  `json:"event_type,omitempty"`
 	PriorityLevel        *string  `json:"priority_level,omitempty"`
 	Tags                 []string `json:"tags,omitempty"`
+	IfMatch              string   `json:"-"` // ETag from the If-Match header; empty skips the version check
 }
 
 // AdminCreateEventCategoryRequest represents the request to create a new event category
@@ -400,6 +420,23 @@ func (e *Event) PublishEvent(userID string) error {
 	return nil
 }
 
+// ContentReview describes where the event stands in the editorial workflow
+func (e *Event) ContentReview() *domain.ContentReview {
+	return domain.NewContentReview(domain.EntityTypeEvent, e.EventID, e.Title, domain.WorkflowStatus(e.PublishingStatus), e.Review)
+}
+
+// editorIDs returns the users who created or last modified the event
+func (e *Event) editorIDs() []string {
+	var ids []string
+	if e.CreatedBy != nil {
+		ids = append(ids, *e.CreatedBy)
+	}
+	if e.ModifiedBy != nil {
+		ids = append(ids, *e.ModifiedBy)
+	}
+	return ids
+}
+
 // ArchiveEvent changes the event status to archived
 func (e *Event) ArchiveEvent(userID string) error {
 	if e.PublishingStatus == PublishingStatusDraft {
@@ -2,11 +2,13 @@ package events
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/search"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -36,7 +38,11 @@ type MockEventsRepository struct {
 	featuredEvent      *FeaturedEvent
 	registrations      map[string]*EventRegistration
 	auditEvents        []MockAuditEvent
+	reindexed          []string
 	failures           map[string]error
+	revisions          map[string][]*domain.Revision
+	reviewEvents       []*domain.ReviewEvent
+	saves              int
 }
 
 type MockAuditEvent struct {
@@ -55,6 +61,7 @@ func NewMockEventsRepository() *MockEventsRepository {
 		registrations: make(map[string]*EventRegistration),
 		auditEvents:   make([]MockAuditEvent, 0),
 		failures:      make(map[string]error),
+		revisions:     make(map[string][]*domain.Revision),
 	}
 }
 
@@ -69,11 +76,18 @@ func (m *MockEventsRepository) GetAuditEvents() []MockAuditEvent {
 }
 
 // Repository interface methods
-func (m *MockEventsRepository) SaveEvent(ctx context.Context, event *Event) error {
+func (m *MockEventsRepository) SaveEvent(ctx context.Context, event *Event, audit *domain.AuditEvent) error {
 	if err := m.failures["SaveEvent"]; err != nil {
 		return err
 	}
+	if stored, exists := m.events[event.EventID]; exists && event.ETag != "" && stored.ETag != event.ETag {
+		return domain.NewConflictError("event was modified concurrently")
+	}
+	// Assign a new version like the state store does on every write
+	m.saves++
+	event.ETag = fmt.Sprintf("etag-%d", m.saves)
 	m.events[event.EventID] = event
+	m.recordAudit(audit)
 	return nil
 }
 
@@ -88,7 +102,7 @@ func (m *MockEventsRepository) GetEvent(ctx context.Context, eventID string) (*E
 	return event, nil
 }
 
-func (m *MockEventsRepository) DeleteEvent(ctx context.Context, eventID string, userID string) error {
+func (m *MockEventsRepository) DeleteEvent(ctx context.Context, eventID string, userID string, audit *domain.AuditEvent) error {
 	if err := m.failures["DeleteEvent"]; err != nil {
 		return err
 	}
@@ -99,14 +113,16 @@ func (m *MockEventsRepository) DeleteEvent(ctx context.Context, eventID string,
 	event.IsDeleted = true
 	event.DeletedOn = &[]time.Time{time.Now()}[0]
 	event.DeletedBy = &userID
+	m.recordAudit(audit)
 	return nil
 }
 
-func (m *MockEventsRepository) SaveEventCategory(ctx context.Context, category *EventCategory) error {
+func (m *MockEventsRepository) SaveEventCategory(ctx context.Context, category *EventCategory, audit *domain.AuditEvent) error {
 	if err := m.failures["SaveEventCategory"]; err != nil {
 		return err
 	}
 	m.categories[category.CategoryID] = category
+	m.recordAudit(audit)
 	return nil
 }
 
@@ -121,7 +137,7 @@ func (m *MockEventsRepository) GetEventCategory(ctx context.Context, categoryID
 	return category, nil
 }
 
-func (m *MockEventsRepository) DeleteEventCategory(ctx context.Context, categoryID string, userID string) error {
+func (m *MockEventsRepository) DeleteEventCategory(ctx context.Context, categoryID string, userID string, audit *domain.AuditEvent) error {
 	if err := m.failures["DeleteEventCategory"]; err != nil {
 		return err
 	}
@@ -132,6 +148,7 @@ func (m *MockEventsRepository) DeleteEventCategory(ctx context.Context, category
 	category.IsDeleted = true
 	category.DeletedOn = &[]time.Time{time.Now()}[0]
 	category.DeletedBy = &userID
+	m.recordAudit(audit)
 	return nil
 }
 
@@ -147,11 +164,12 @@ func (m *MockEventsRepository) GetDefaultUnassignedCategory(ctx context.Context)
 	return nil, domain.NewNotFoundError("default unassigned category", "unassigned")
 }
 
-func (m *MockEventsRepository) SaveFeaturedEvent(ctx context.Context, featuredEvent *FeaturedEvent) error {
+func (m *MockEventsRepository) SaveFeaturedEvent(ctx context.Context, featuredEvent *FeaturedEvent, audit *domain.AuditEvent) error {
 	if err := m.failures["SaveFeaturedEvent"]; err != nil {
 		return err
 	}
 	m.featuredEvent = featuredEvent
+	m.recordAudit(audit)
 	return nil
 }
 
@@ -165,11 +183,54 @@ func (m *MockEventsRepository) GetFeaturedEvent(ctx context.Context) (*FeaturedE
 	return m.featuredEvent, nil
 }
 
-func (m *MockEventsRepository) DeleteFeaturedEvent(ctx context.Context) error {
+func (m *MockEventsRepository) DeleteFeaturedEvent(ctx context.Context, audit *domain.AuditEvent) error {
 	if err := m.failures["DeleteFeaturedEvent"]; err != nil {
 		return err
 	}
 	m.featuredEvent = nil
+	m.recordAudit(audit)
+	return nil
+}
+
+func (m *MockEventsRepository) ListEventsPage(ctx context.Context, filter EventListFilter, page domain.PageRequest) ([]*Event, domain.PageInfo, error) {
+	if err := m.failures["ListEventsPage"]; err != nil {
+		return nil, domain.PageInfo{}, err
+	}
+	events := make([]*Event, 0)
+	for _, event := range m.events {
+		if event.IsDeleted {
+			continue
+		}
+		if filter.CategoryID != "" && event.CategoryID != filter.CategoryID {
+			continue
+		}
+		if filter.PublishingStatus != "" && event.PublishingStatus != filter.PublishingStatus {
+			continue
+		}
+		events = append(events, event)
+	}
+	pageItems, info := PageEvents(events, page)
+	return pageItems, info, nil
+}
+
+func (m *MockEventsRepository) QueryEvents(ctx context.Context, query search.Query) (*search.Results, error) {
+	if err := m.failures["QueryEvents"]; err != nil {
+		return nil, err
+	}
+	index := newEventSearchIndex()
+	for _, event := range m.events {
+		if !event.IsDeleted {
+			index.Upsert(eventSearchDocument(event))
+		}
+	}
+	return index.Search(query), nil
+}
+
+func (m *MockEventsRepository) ReindexEvent(ctx context.Context, eventID string) error {
+	if err := m.failures["ReindexEvent"]; err != nil {
+		return err
+	}
+	m.reindexed = append(m.reindexed, eventID)
 	return nil
 }
 
@@ -186,21 +247,58 @@ func (m *MockEventsRepository) GetEventRegistrations(ctx context.Context, eventI
 	return registrations, nil
 }
 
-func (m *MockEventsRepository) PublishAuditEvent(ctx context.Context, entityType domain.EntityType, entityID string, operationType domain.AuditEventType, userID string, beforeData, afterData interface{}) error {
-	if err := m.failures["PublishAuditEvent"]; err != nil {
-		return err
+// recordAudit keeps the audit event a write committed, in place of the outbox
+func (m *MockEventsRepository) recordAudit(audit *domain.AuditEvent) {
+	if audit == nil {
+		return
 	}
 	m.auditEvents = append(m.auditEvents, MockAuditEvent{
-		EntityType:    entityType,
-		EntityID:      entityID,
-		OperationType: operationType,
-		UserID:        userID,
-		Before:        beforeData,
-		After:         afterData,
+		EntityType:    audit.EntityType,
+		EntityID:      audit.EntityID,
+		OperationType: audit.OperationType,
+		UserID:        audit.UserID,
+		Before:        audit.DataSnapshot.Before,
+		After:         audit.DataSnapshot.After,
 	})
+}
+
+func (m *MockEventsRepository) PublishReviewEvent(ctx context.Context, event *domain.ReviewEvent) error {
+	if err := m.failures["PublishReviewEvent"]; err != nil {
+		return err
+	}
+	m.reviewEvents = append(m.reviewEvents, event)
 	return nil
 }
 
+// AddRevision records a numbered revision of an event holding its content after the change
+func (m *MockEventsRepository) AddRevision(event *Event, operationType domain.AuditEventType) {
+	revision := domain.NewRevision(domain.NewAuditEvent(domain.EntityTypeEvent, event.EventID, operationType, "admin-1"))
+	revision.Number = len(m.revisions[event.EventID]) + 1
+	revision.DataSnapshot = &domain.AuditDataSnapshot{After: event}
+	m.revisions[event.EventID] = append(m.revisions[event.EventID], revision)
+}
+
+func (m *MockEventsRepository) ListEventRevisions(ctx context.Context, eventID string) ([]*domain.Revision, error) {
+	if err := m.failures["ListEventRevisions"]; err != nil {
+		return nil, err
+	}
+	revisions := make([]*domain.Revision, 0, len(m.revisions[eventID]))
+	for i := len(m.revisions[eventID]) - 1; i >= 0; i-- {
+		revisions = append(revisions, m.revisions[eventID][i].Summary())
+	}
+	return revisions, nil
+}
+
+func (m *MockEventsRepository) GetEventRevision(ctx context.Context, eventID string, revision int) (*domain.Revision, error) {
+	if err := m.failures["GetEventRevision"]; err != nil {
+		return nil, err
+	}
+	if revision < 1 || revision > len(m.revisions[eventID]) {
+		return nil, domain.NewNotFoundError("revision", fmt.Sprintf("%s@%d", eventID, revision))
+	}
+	return m.revisions[eventID][revision-1], nil
+}
+
 // Test helper functions
 func createTestEvent(eventID, title, categoryID, userID string) *Event {
 	eventDate := time.Date(2024, 12, 15, 0, 0, 0, 0, time.UTC)
@@ -382,6 +480,37 @@ func TestEventsService_AdminUpdateEvent(t *testing.T) {
 			},
 			wantError: false,
 		},
+		{
+			name: "successfully update event when if-match names the current version",
+			setupFunc: func(repo *MockEventsRepository) {
+				event := createTestEvent("550e8400-e29b-41d4-a716-446655440002", "Original Title", "550e8400-e29b-41d4-a716-446655440001", "admin-550e8400-e29b-41d4-a716-446655440003")
+				event.ETag = "etag-current"
+				repo.events[event.EventID] = event
+			},
+			userID:  "admin-550e8400-e29b-41d4-a716-446655440003",
+			eventID: "550e8400-e29b-41d4-a716-446655440002",
+			request: AdminUpdateEventRequest{
+				Title:   &[]string{"Updated Title"}[0],
+				IfMatch: "etag-current",
+			},
+			wantError: false,
+		},
+		{
+			name: "return conflict error when if-match names a stale version",
+			setupFunc: func(repo *MockEventsRepository) {
+				event := createTestEvent("550e8400-e29b-41d4-a716-446655440002", "Edited Elsewhere", "550e8400-e29b-41d4-a716-446655440001", "admin-550e8400-e29b-41d4-a716-446655440003")
+				event.ETag = "etag-current"
+				repo.events[event.EventID] = event
+			},
+			userID:  "admin-550e8400-e29b-41d4-a716-446655440003",
+			eventID: "550e8400-e29b-41d4-a716-446655440002",
+			request: AdminUpdateEventRequest{
+				Title:   &[]string{"Updated Title"}[0],
+				IfMatch: "etag-stale",
+			},
+			wantError: true,
+			errorType: "conflict",
+		},
 		{
 			name:      "return not found error for non-existent event",
 			setupFunc: func(repo *MockEventsRepository) {},
@@ -417,6 +546,9 @@ func TestEventsService_AdminUpdateEvent(t *testing.T) {
 			if tt.wantError {
 				require.Error(t, err)
 				assertErrorType(t, err, tt.errorType)
+				if stored, exists := repo.events[tt.eventID]; exists {
+					assert.NotEqual(t, *tt.request.Title, stored.Title, "rejected update must not be saved")
+				}
 			} else {
 				require.NoError(t, err)
 				require.NotNil(t, event)
@@ -426,7 +558,90 @@ func TestEventsService_AdminUpdateEvent(t *testing.T) {
 				if tt.request.Description != nil {
 					assert.Equal(t, *tt.request.Description, event.Description)
 				}
+				assert.NotEmpty(t, event.ETag)
+				assert.NotEqual(t, tt.request.IfMatch, event.ETag)
+			}
+		})
+	}
+}
+
+func TestEventsService_AdminGetEvent(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	repo := NewMockEventsRepository()
+	event := createTestEvent("550e8400-e29b-41d4-a716-446655440002", "Draft Event", "550e8400-e29b-41d4-a716-446655440001", "admin-550e8400-e29b-41d4-a716-446655440003")
+	event.ETag = "etag-current"
+	repo.events[event.EventID] = event
+	service := NewEventsService(repo)
+
+	t.Run("return draft event with its etag", func(t *testing.T) {
+		result, err := service.AdminGetEvent(ctx, event.EventID, "admin-550e8400-e29b-41d4-a716-446655440003")
+
+		require.NoError(t, err)
+		assert.Equal(t, "Draft Event", result.Title)
+		assert.Equal(t, "etag-current", result.ETag)
+	})
+
+	t.Run("return unauthorized error for non-admin user", func(t *testing.T) {
+		_, err := service.AdminGetEvent(ctx, event.EventID, "regular-user-id")
+
+		require.Error(t, err)
+		assertErrorType(t, err, "unauthorized")
+	})
+}
+
+func TestEventsService_AdminRollbackEvent(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	eventID := "550e8400-e29b-41d4-a716-446655440002"
+	original := createTestEvent(eventID, "Original Event", "550e8400-e29b-41d4-a716-446655440001", "author-1")
+
+	tests := []struct {
+		name          string
+		revision      int
+		ifMatch       string
+		userID        string
+		expectedError string
+	}{
+		{name: "restore earlier revision", revision: 1, userID: "admin-2"},
+		{name: "restore when if-match names the current version", revision: 1, ifMatch: "etag-current", userID: "admin-2"},
+		{name: "reject stale if-match", revision: 1, ifMatch: "etag-stale", userID: "admin-2", expectedError: "conflict"},
+		{name: "reject unknown revision", revision: 4, userID: "admin-2", expectedError: "not_found"},
+		{name: "reject non-admin user", revision: 1, userID: "regular-user-id", expectedError: "unauthorized"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			repo := NewMockEventsRepository()
+			edited := *original
+			edited.Title = "Edited Event"
+			edited.ETag = "etag-current"
+			repo.events[eventID] = &edited
+			repo.AddRevision(original, domain.AuditEventInsert)
+			repo.AddRevision(&edited, domain.AuditEventUpdate)
+			service := NewEventsService(repo)
+
+			// Act
+			restored, err := service.AdminRollbackEvent(ctx, eventID, tt.revision, tt.ifMatch, tt.userID)
+
+			// Assert
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assertErrorType(t, err, tt.expectedError)
+				assert.Equal(t, "Edited Event", repo.events[eventID].Title)
+				assert.Empty(t, repo.auditEvents)
+				return
 			}
+			require.NoError(t, err)
+			assert.Equal(t, "Original Event", restored.Title)
+			assert.Equal(t, "author-1", *restored.CreatedBy)
+			assert.Equal(t, "admin-2", *restored.ModifiedBy)
+			assert.NotEqual(t, "etag-current", restored.ETag)
+			require.Len(t, repo.auditEvents, 1)
+			assert.Equal(t, domain.AuditEventRollback, repo.auditEvents[0].OperationType)
 		})
 	}
 }
@@ -574,6 +789,86 @@ func TestEventsService_AdminPublishEvent(t *testing.T) {
 	}
 }
 
+func TestEventsService_AdminEditorialReview(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	author := "admin-550e8400-e29b-41d4-a716-446655440003"
+	reviewer := "admin-550e8400-e29b-41d4-a716-446655440004"
+
+	tests := []struct {
+		name       string
+		review     func(service *EventsService, eventID string) error
+		wantError  func(error) bool
+		wantStatus PublishingStatus
+	}{
+		{
+			name: "reviewer approval allows publishing",
+			review: func(service *EventsService, eventID string) error {
+				_, err := service.AdminApproveEvent(ctx, eventID, reviewer)
+				return err
+			},
+			wantStatus: PublishingStatusApproved,
+		},
+		{
+			name: "author cannot approve their own event",
+			review: func(service *EventsService, eventID string) error {
+				_, err := service.AdminApproveEvent(ctx, eventID, author)
+				return err
+			},
+			wantError:  domain.IsForbiddenError,
+			wantStatus: PublishingStatusInReview,
+		},
+		{
+			name: "rejection returns the event to draft",
+			review: func(service *EventsService, eventID string) error {
+				_, err := service.AdminRejectEvent(ctx, eventID, "Venue is not confirmed", reviewer)
+				return err
+			},
+			wantStatus: PublishingStatusDraft,
+		},
+		{
+			name: "rejection without a reason",
+			review: func(service *EventsService, eventID string) error {
+				_, err := service.AdminRejectEvent(ctx, eventID, "", reviewer)
+				return err
+			},
+			wantError:  domain.IsValidationError,
+			wantStatus: PublishingStatusInReview,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			repo := NewMockEventsRepository()
+			event := createTestEvent("550e8400-e29b-41d4-a716-446655440002", "Test Event", "550e8400-e29b-41d4-a716-446655440001", author)
+			repo.events[event.EventID] = event
+			service := NewEventsService(repo)
+			service.UseEditorialWorkflow(domain.NewEditorialWorkflow(true, 1))
+			_, err := service.AdminSubmitEventForReview(ctx, event.EventID, []string{reviewer}, author)
+			require.NoError(t, err)
+
+			// Act
+			err = tt.review(service, event.EventID)
+
+			// Assert
+			if tt.wantError != nil {
+				require.Error(t, err)
+				assert.True(t, tt.wantError(err))
+			} else {
+				require.NoError(t, err)
+				require.Len(t, repo.reviewEvents, 2)
+				assert.Equal(t, []string{author}, repo.reviewEvents[1].Recipients)
+			}
+			assert.Equal(t, tt.wantStatus, repo.events[event.EventID].PublishingStatus)
+
+			_, publishErr := service.AdminPublishEvent(ctx, event.EventID, author)
+			assert.Equal(t, tt.wantStatus == PublishingStatusApproved, publishErr == nil)
+		})
+	}
+}
+
 func TestEventsService_AdminArchiveEvent(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -980,6 +1275,80 @@ func TestEventsService_AdminGetEventRegistrations(t *testing.T) {
 	}
 }
 
+func TestEventsService_ListPublishedEventsPage(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	repo := NewMockEventsRepository()
+	for i, id := range []string{
+		"550e8400-e29b-41d4-a716-446655440011",
+		"550e8400-e29b-41d4-a716-446655440012",
+		"550e8400-e29b-41d4-a716-446655440013",
+	} {
+		event := createTestEvent(id, "Event", "550e8400-e29b-41d4-a716-446655440001", "admin-550e8400-e29b-41d4-a716-446655440003")
+		event.PublishingStatus = PublishingStatusPublished
+		event.EventDate = event.EventDate.AddDate(0, 0, i)
+		repo.events[id] = event
+	}
+	draft := createTestEvent("550e8400-e29b-41d4-a716-446655440014", "Draft", "550e8400-e29b-41d4-a716-446655440001", "admin-550e8400-e29b-41d4-a716-446655440003")
+	repo.events[draft.EventID] = draft
+	service := NewEventsService(repo)
+
+	firstPage, err := domain.NewPageRequest("", 2)
+	require.NoError(t, err)
+
+	events, info, err := service.ListPublishedEventsPage(ctx, "", firstPage)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, "550e8400-e29b-41d4-a716-446655440013", events[0].EventID)
+	assert.True(t, info.HasMore)
+	assert.Equal(t, 3, info.Total)
+
+	nextPage, err := domain.NewPageRequest(info.NextCursor, 2)
+	require.NoError(t, err)
+
+	events, info, err = service.ListPublishedEventsPage(ctx, "", nextPage)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "550e8400-e29b-41d4-a716-446655440011", events[0].EventID)
+	assert.False(t, info.HasMore)
+}
+
+func TestEventsService_QueryPublishedEvents(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	repo := NewMockEventsRepository()
+	workshop := createTestEvent("550e8400-e29b-41d4-a716-446655440011", "Nutrition workshop", "550e8400-e29b-41d4-a716-446655440001", "admin-550e8400-e29b-41d4-a716-446655440003")
+	workshop.PublishingStatus = PublishingStatusPublished
+	workshop.Tags = []string{"nutrition", "family"}
+	repo.events[workshop.EventID] = workshop
+	seminar := createTestEvent("550e8400-e29b-41d4-a716-446655440012", "Healthy cooking", "550e8400-e29b-41d4-a716-446655440001", "admin-550e8400-e29b-41d4-a716-446655440003")
+	seminar.PublishingStatus = PublishingStatusPublished
+	seminar.EventType = EventTypeSeminar
+	seminar.Description = "Practical nutrition for busy families"
+	seminar.Tags = []string{"nutrition"}
+	repo.events[seminar.EventID] = seminar
+	draft := createTestEvent("550e8400-e29b-41d4-a716-446655440013", "Nutrition draft", "550e8400-e29b-41d4-a716-446655440001", "admin-550e8400-e29b-41d4-a716-446655440003")
+	repo.events[draft.EventID] = draft
+	service := NewEventsService(repo)
+
+	results, err := service.QueryPublishedEvents(ctx, search.Query{Text: "nutrition", Facets: eventPublicFacets})
+	require.NoError(t, err)
+	require.Len(t, results.Hits, 2)
+	assert.Equal(t, workshop.EventID, results.Hits[0].ID)
+	assert.Equal(t, "<mark>Nutrition</mark> workshop", results.Hits[0].Highlights["title"])
+	assert.Equal(t, []search.FacetCount{{Value: "nutrition", Count: 2}, {Value: "family", Count: 1}}, results.Facets[eventFacetTags])
+
+	results, err = service.QueryPublishedEvents(ctx, search.Query{Text: "nutrition", Filters: map[string]string{eventFacetType: string(EventTypeSeminar)}})
+	require.NoError(t, err)
+	require.Len(t, eventsFromHits(results.Hits), 1)
+	assert.Equal(t, seminar.EventID, eventsFromHits(results.Hits)[0].EventID)
+
+	_, err = service.QueryPublishedEvents(ctx, search.Query{Limit: -1})
+	assert.True(t, domain.IsValidationError(err))
+}
+
 // RED PHASE - Domain enum validation tests (will fail until IsValid methods are implemented)
 
 func TestEventType_IsValid(t *testing.T) {
@@ -3,8 +3,10 @@ package events
 import (
 	"encoding/json"
 	"net/http"
+	"strconv"
 
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/search"
 	"github.com/gorilla/mux"
 )
 
@@ -26,6 +28,7 @@ func (h *EventsHandler) RegisterRoutes(router *mux.Router) {
 	
 	// Event endpoints
 	router.HandleFunc("/api/v1/events", h.GetAllEvents).Methods("GET")
+	router.HandleFunc("/api/v1/events/search", h.SearchEvents).Methods("GET")
 	router.HandleFunc("/api/v1/events/{id}", h.GetEvent).Methods("GET")
 	router.HandleFunc("/api/v1/events/slug/{slug}", h.GetEventBySlug).Methods("GET")
 	
@@ -50,10 +53,15 @@ func (h *EventsHandler) RegisterRoutes(router *mux.Router) {
 	// Admin endpoints - will be handled by admin gateway
 	// Event admin endpoints
 	router.HandleFunc("/admin/api/v1/events", h.CreateEvent).Methods("POST")
+	router.HandleFunc("/admin/api/v1/events/{id}", h.GetAdminEvent).Methods("GET")
 	router.HandleFunc("/admin/api/v1/events/{id}", h.UpdateEvent).Methods("PUT")
 	router.HandleFunc("/admin/api/v1/events/{id}", h.DeleteEvent).Methods("DELETE")
 	router.HandleFunc("/admin/api/v1/events/{id}/publish", h.PublishEvent).Methods("POST")
 	router.HandleFunc("/admin/api/v1/events/{id}/archive", h.ArchiveEvent).Methods("POST")
+	router.HandleFunc("/admin/api/v1/events/{id}/revisions", h.GetEventRevisions).Methods("GET")
+	router.HandleFunc("/admin/api/v1/events/{id}/revisions/diff", h.DiffEventRevisions).Methods("GET")
+	router.HandleFunc("/admin/api/v1/events/{id}/revisions/{revision:[0-9]+}", h.GetEventRevision).Methods("GET")
+	router.HandleFunc("/admin/api/v1/events/{id}/revisions/{revision:[0-9]+}/rollback", h.RollbackEvent).Methods("POST")
 	
 	// Event category admin endpoints
 	router.HandleFunc("/admin/api/v1/events/categories", h.CreateEventCategory).Methods("POST")
@@ -80,10 +88,23 @@ func (h *EventsHandler) GetAllEvents(w http.ResponseWriter, r *http.Request) {
 	correlationCtx.SetUserContext(userID, "events-api-1.0.0")
 	ctx = correlationCtx.ToContext(ctx)
 
+	page, err := h.extractPageRequest(r)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
 	// For public endpoint, return only published events
-	// This would be implemented with appropriate service method
+	events, pageInfo, err := h.service.ListPublishedEventsPage(ctx, "", page)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
 	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
-		"message":        "Public events endpoint - implementation pending",
+		"events":         events,
+		"count":          len(events),
+		"pagination":     pageInfo,
 		"correlation_id": correlationCtx.CorrelationID,
 	})
 }
@@ -149,6 +170,46 @@ func (h *EventsHandler) GetUpcomingEvents(w http.ResponseWriter, r *http.Request
 	})
 }
 
+// SearchEvents handles GET /api/v1/events/search
+func (h *EventsHandler) SearchEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	searchTerm := r.URL.Query().Get("q")
+
+	// Extract user ID from context
+	userID := h.getUserIDFromContext(r)
+
+	// Add correlation context
+	correlationCtx := domain.FromContext(ctx)
+	correlationCtx.SetUserContext(userID, "events-api-1.0.0")
+	ctx = correlationCtx.ToContext(ctx)
+
+	query, err := search.ParseQuery(r.URL.Query(), eventPublicFacets)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	results, err := h.service.QueryPublishedEvents(ctx, query)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
+		"events":      eventsFromHits(results.Hits),
+		"hits":        results.Hits,
+		"facets":      results.Facets,
+		"count":       len(results.Hits),
+		"total":       results.Total,
+		"search_term": searchTerm,
+		"pagination": map[string]interface{}{
+			"limit":  results.Limit,
+			"offset": results.Offset,
+		},
+		"correlation_id": correlationCtx.CorrelationID,
+	})
+}
+
 // GetEventRegistrationStatus handles GET /api/v1/events/{id}/registrations/status
 func (h *EventsHandler) GetEventRegistrationStatus(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -206,6 +267,34 @@ func (h *EventsHandler) CreateEvent(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// GetAdminEvent handles GET /admin/api/v1/events/{id}
+func (h *EventsHandler) GetAdminEvent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+	eventID := vars["id"]
+
+	// Extract user ID from context (would come from authentication middleware)
+	userID := r.Header.Get("X-User-ID")
+
+	// Add correlation context
+	correlationCtx := domain.FromContext(ctx)
+	correlationCtx.SetUserContext(userID, "events-admin-api-1.0.0")
+	ctx = correlationCtx.ToContext(ctx)
+
+	event, err := h.service.AdminGetEvent(ctx, eventID, userID)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	// The ETag is what the editor sends back as If-Match on update
+	h.setETagHeader(w, event.ETag)
+	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
+		"event":          event,
+		"correlation_id": correlationCtx.CorrelationID,
+	})
+}
+
 // UpdateEvent handles PUT /admin/api/v1/events/{id}
 func (h *EventsHandler) UpdateEvent(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -226,15 +315,17 @@ func (h *EventsHandler) UpdateEvent(w http.ResponseWriter, r *http.Request) {
 		h.handleError(w, r, domain.NewValidationError("invalid request body"))
 		return
 	}
+	request.IfMatch = domain.ParseIfMatch(r.Header.Get("If-Match"))
 
 	// Call service method
 	event, err := h.service.AdminUpdateEvent(ctx, eventID, request, userID)
 	if err != nil {
-		h.handleError(w, r, err)
+		h.handleUpdateError(w, r, err)
 		return
 	}
 
 	// Return updated event
+	h.setETagHeader(w, event.ETag)
 	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
 		"event":          event,
 		"correlation_id": correlationCtx.CorrelationID,
@@ -328,6 +419,117 @@ func (h *EventsHandler) ArchiveEvent(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// GetEventRevisions handles GET /admin/api/v1/events/{id}/revisions
+func (h *EventsHandler) GetEventRevisions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	eventID := mux.Vars(r)["id"]
+
+	// Extract user ID from context (would come from authentication middleware)
+	userID := r.Header.Get("X-User-ID")
+
+	revisions, err := h.service.AdminGetEventRevisions(ctx, eventID, userID)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
+		"revisions": revisions,
+		"count":     len(revisions),
+	})
+}
+
+// GetEventRevision handles GET /admin/api/v1/events/{id}/revisions/{revision}
+func (h *EventsHandler) GetEventRevision(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	// Extract user ID from context (would come from authentication middleware)
+	userID := r.Header.Get("X-User-ID")
+
+	number, err := domain.ParseRevisionNumber("revision", vars["revision"])
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	revision, err := h.service.AdminGetEventRevision(ctx, vars["id"], number, userID)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
+		"revision": revision,
+	})
+}
+
+// DiffEventRevisions handles GET /admin/api/v1/events/{id}/revisions/diff?from={revision}&to={revision}
+func (h *EventsHandler) DiffEventRevisions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	eventID := mux.Vars(r)["id"]
+	query := r.URL.Query()
+
+	// Extract user ID from context (would come from authentication middleware)
+	userID := r.Header.Get("X-User-ID")
+
+	from, err := domain.ParseRevisionNumber("from", query.Get("from"))
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+	to, err := domain.ParseRevisionNumber("to", query.Get("to"))
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	diff, err := h.service.AdminDiffEventRevisions(ctx, eventID, from, to, userID)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
+		"diff": diff,
+	})
+}
+
+// RollbackEvent handles POST /admin/api/v1/events/{id}/revisions/{revision}/rollback
+func (h *EventsHandler) RollbackEvent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+	eventID := vars["id"]
+
+	// Extract user ID from context (would come from authentication middleware)
+	userID := r.Header.Get("X-User-ID")
+
+	number, err := domain.ParseRevisionNumber("revision", vars["revision"])
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	// Add correlation context
+	correlationCtx := domain.FromContext(ctx)
+	correlationCtx.SetUserContext(userID, "events-admin-api-1.0.0")
+	ctx = correlationCtx.ToContext(ctx)
+
+	event, err := h.service.AdminRollbackEvent(ctx, eventID, number, domain.ParseIfMatch(r.Header.Get("If-Match")), userID)
+	if err != nil {
+		h.handleUpdateError(w, r, err)
+		return
+	}
+
+	// Return restored event
+	h.setETagHeader(w, event.ETag)
+	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
+		"event":          event,
+		"restored_from":  number,
+		"correlation_id": correlationCtx.CorrelationID,
+	})
+}
+
 // CreateEventCategory handles POST /admin/api/v1/events/categories
 func (h *EventsHandler) CreateEventCategory(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -489,12 +691,30 @@ func (h *EventsHandler) GetEventCategoryBySlug(w http.ResponseWriter, r *http.Re
 
 // GetEventsByCategory handles GET /api/v1/events/categories/{id}/events
 func (h *EventsHandler) GetEventsByCategory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 	vars := mux.Vars(r)
 	categoryID := vars["id"]
-	
+
+	correlationCtx := domain.FromContext(ctx)
+
+	page, err := h.extractPageRequest(r)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	events, pageInfo, err := h.service.ListPublishedEventsPage(ctx, categoryID, page)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
 	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
-		"message":     "Events by category endpoint - implementation pending",
-		"category_id": categoryID,
+		"events":         events,
+		"count":          len(events),
+		"category_id":    categoryID,
+		"pagination":     pageInfo,
+		"correlation_id": correlationCtx.CorrelationID,
 	})
 }
 
@@ -518,6 +738,20 @@ func (h *EventsHandler) GetPublishedEvents(w http.ResponseWriter, r *http.Reques
 
 // Helper methods
 
+// extractPageRequest extracts cursor pagination parameters from request
+func (h *EventsHandler) extractPageRequest(r *http.Request) (domain.PageRequest, error) {
+	limit := 0
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		l, err := strconv.Atoi(limitStr)
+		if err != nil {
+			return domain.PageRequest{}, domain.NewValidationFieldError("limit", "limit must be a number")
+		}
+		limit = l
+	}
+
+	return domain.NewPageRequest(r.URL.Query().Get("cursor"), limit)
+}
+
 // getUserIDFromContext extracts user ID from request context
 func (h *EventsHandler) getUserIDFromContext(r *http.Request) string {
 	// This would be populated by authentication middleware
@@ -581,6 +815,30 @@ func (h *EventsHandler) handleError(w http.ResponseWriter, r *http.Request, err
 	h.writeJSONResponse(w, statusCode, errorResponse)
 }
 
+// handleUpdateError answers a stale If-Match with 412 Precondition Failed and
+// falls back to handleError for everything else
+func (h *EventsHandler) handleUpdateError(w http.ResponseWriter, r *http.Request, err error) {
+	if !domain.IsConflictError(err) {
+		h.handleError(w, r, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusPreconditionFailed, map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":           "PRECONDITION_FAILED",
+			"message":        err.Error(),
+			"correlation_id": domain.GetCorrelationID(r.Context()),
+		},
+	})
+}
+
+// setETagHeader sets the ETag response header when the version is known
+func (h *EventsHandler) setETagHeader(w http.ResponseWriter, etag string) {
+	if etag != "" {
+		w.Header().Set("ETag", domain.FormatETag(etag))
+	}
+}
+
 // writeJSONResponse writes a JSON response with proper headers
 func (h *EventsHandler) writeJSONResponse(w http.ResponseWriter, statusCode int, data interface{}) {
 	w.Header().Set("Content-Type", "application/json")
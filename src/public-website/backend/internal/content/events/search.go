@@ -0,0 +1,76 @@
+package events
+
+import (
+	"strings"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/search"
+)
+
+// Facets maintained in the events search index
+const (
+	eventFacetCategory = "category"
+	eventFacetTags     = "tags"
+	eventFacetType     = "event_type"
+	eventFacetStatus   = "status"
+)
+
+// eventPublicFacets are the facets counted and filterable on public events search
+var eventPublicFacets = []string{eventFacetCategory, eventFacetTags, eventFacetType}
+
+// newEventSearchIndex creates the full-text index for events. Title matches
+// weigh most, then description and tags, then the body and location.
+func newEventSearchIndex() *search.Index {
+	return search.NewIndex("events",
+		search.Field{Name: "title", Boost: 3},
+		search.Field{Name: "description", Boost: 2},
+		search.Field{Name: "tags", Boost: 2},
+		search.Field{Name: "content", Boost: 1},
+		search.Field{Name: "location", Boost: 1},
+	)
+}
+
+// eventSearchDocument converts an event into its search document
+func eventSearchDocument(event *Event) search.Document {
+	content := ""
+	if event.Content != nil {
+		content = *event.Content
+	}
+
+	return search.Document{
+		ID: event.EventID,
+		Fields: map[string]string{
+			"title":       event.Title,
+			"description": event.Description,
+			"tags":        strings.Join(event.Tags, " "),
+			"content":     content,
+			"location":    event.Location,
+		},
+		Facets: map[string][]string{
+			eventFacetCategory: {event.CategoryID},
+			eventFacetTags:     event.Tags,
+			eventFacetType:     {string(event.EventType)},
+			eventFacetStatus:   {string(event.PublishingStatus)},
+		},
+		Source: event,
+	}
+}
+
+// eventSearchDocuments converts events into search documents
+func eventSearchDocuments(events []*Event) []search.Document {
+	documents := make([]search.Document, 0, len(events))
+	for _, event := range events {
+		documents = append(documents, eventSearchDocument(event))
+	}
+	return documents
+}
+
+// eventsFromHits returns the events behind search hits, in rank order
+func eventsFromHits(hits []search.Hit) []*Event {
+	events := make([]*Event, 0, len(hits))
+	for _, hit := range hits {
+		if event, ok := hit.Source.(*Event); ok {
+			events = append(events, event)
+		}
+	}
+	return events
+}
@@ -7,45 +7,61 @@ import (
 	"time"
 
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/search"
 )
 
 // EventsRepositoryInterface defines the interface for events domain data operations
 type EventsRepositoryInterface interface {
 	// Event operations
-	SaveEvent(ctx context.Context, event *Event) error
+	SaveEvent(ctx context.Context, event *Event, audit *domain.AuditEvent) error
 	GetEvent(ctx context.Context, eventID string) (*Event, error)
-	DeleteEvent(ctx context.Context, eventID string, userID string) error
+	DeleteEvent(ctx context.Context, eventID string, userID string, audit *domain.AuditEvent) error
+	ListEventsPage(ctx context.Context, filter EventListFilter, page domain.PageRequest) ([]*Event, domain.PageInfo, error)
+	QueryEvents(ctx context.Context, query search.Query) (*search.Results, error)
+	ReindexEvent(ctx context.Context, eventID string) error
 	
 	// Event category operations
-	SaveEventCategory(ctx context.Context, category *EventCategory) error
+	SaveEventCategory(ctx context.Context, category *EventCategory, audit *domain.AuditEvent) error
 	GetEventCategory(ctx context.Context, categoryID string) (*EventCategory, error)
-	DeleteEventCategory(ctx context.Context, categoryID string, userID string) error
+	DeleteEventCategory(ctx context.Context, categoryID string, userID string, audit *domain.AuditEvent) error
 	GetDefaultUnassignedCategory(ctx context.Context) (*EventCategory, error)
 	
 	// Featured event operations
-	SaveFeaturedEvent(ctx context.Context, featuredEvent *FeaturedEvent) error
+	SaveFeaturedEvent(ctx context.Context, featuredEvent *FeaturedEvent, audit *domain.AuditEvent) error
 	GetFeaturedEvent(ctx context.Context) (*FeaturedEvent, error)
-	DeleteFeaturedEvent(ctx context.Context) error
+	DeleteFeaturedEvent(ctx context.Context, audit *domain.AuditEvent) error
 	
 	// Event registration operations
 	GetEventRegistrations(ctx context.Context, eventID string) ([]*EventRegistration, error)
-	
-	// Audit operations
-	PublishAuditEvent(ctx context.Context, entityType domain.EntityType, entityID string, operationType domain.AuditEventType, userID string, beforeData, afterData interface{}) error
+
+	// Revision operations
+	ListEventRevisions(ctx context.Context, eventID string) ([]*domain.Revision, error)
+	GetEventRevision(ctx context.Context, eventID string, revision int) (*domain.Revision, error)
+
+	// Editorial review operations
+	PublishReviewEvent(ctx context.Context, event *domain.ReviewEvent) error
 }
 
 // EventsService implements business logic for events operations
 type EventsService struct {
 	repository EventsRepositoryInterface
+	workflow   *domain.EditorialWorkflow
 }
 
-// NewEventsService creates a new events service
+// NewEventsService creates a new events service; events publish directly until an
+// editorial workflow is configured
 func NewEventsService(repository EventsRepositoryInterface) *EventsService {
 	return &EventsService{
 		repository: repository,
+		workflow:   domain.DirectPublishWorkflow(),
 	}
 }
 
+// UseEditorialWorkflow makes publishing wait for the reviews the workflow requires
+func (s *EventsService) UseEditorialWorkflow(workflow *domain.EditorialWorkflow) {
+	s.workflow = workflow
+}
+
 // AdminCreateEvent creates a new event (admin only)
 func (s *EventsService) AdminCreateEvent(ctx context.Context, request AdminCreateEventRequest, userID string) (*Event, error) {
 	// Validate admin authentication
@@ -161,16 +177,26 @@ func (s *EventsService) AdminCreateEvent(ctx context.Context, request AdminCreat
 	}
 
 	// Save event to repository
-	if err := s.repository.SaveEvent(ctx, event); err != nil {
+	audit := domain.NewAuditChange(domain.EntityTypeEvent, event.EventID, domain.AuditEventInsert, userID, nil, event)
+	if err := s.repository.SaveEvent(ctx, event, audit); err != nil {
 		return nil, domain.WrapError(err, "failed to save event")
 	}
 
-	// Publish audit event
-	if err := s.repository.PublishAuditEvent(ctx, domain.EntityTypeEvent, event.EventID, domain.AuditEventInsert, userID, nil, event); err != nil {
-		// Log error but don't fail the operation
+	return event, nil
+}
+
+// AdminGetEvent retrieves an event in any publishing state for editing (admin only)
+func (s *EventsService) AdminGetEvent(ctx context.Context, eventID string, userID string) (*Event, error) {
+	// Validate admin authentication
+	if !IsAdminUser(userID) {
+		return nil, domain.NewUnauthorizedError("admin privileges required to view events")
+	}
+
+	if eventID == "" {
+		return nil, domain.NewValidationError("event ID cannot be empty")
 	}
 
-	return event, nil
+	return s.repository.GetEvent(ctx, eventID)
 }
 
 // AdminUpdateEvent updates an existing event (admin only)
@@ -186,8 +212,14 @@ func (s *EventsService) AdminUpdateEvent(ctx context.Context, eventID string, re
 		return nil, err
 	}
 
+	// Reject edits made against an older version of the event
+	if err := domain.CheckETag("event", eventID, request.IfMatch, event.ETag); err != nil {
+		return nil, err
+	}
+
 	// Store original data for audit
 	originalEvent := *event
+	originalEvent.Review = event.Review.Clone()
 
 	// Update fields if provided
 	if request.Title != nil {
@@ -323,17 +355,115 @@ func (s *EventsService) AdminUpdateEvent(ctx context.Context, eventID string, re
 		event.ModifiedBy = &userID
 	}
 
+	// Edits to content under review need a fresh review
+	if event.Review == nil {
+		event.Review = &domain.EditorialReview{}
+	}
+	event.PublishingStatus = PublishingStatus(s.workflow.StatusAfterEdit(
+		domain.WorkflowStatus(originalEvent.PublishingStatus), domain.WorkflowStatus(event.PublishingStatus), event.Review, userID))
+
 	// Save updated event
-	if err := s.repository.SaveEvent(ctx, event); err != nil {
+	audit := domain.NewAuditChange(domain.EntityTypeEvent, event.EventID, domain.AuditEventUpdate, userID, &originalEvent, event)
+	if err := s.repository.SaveEvent(ctx, event, audit); err != nil {
 		return nil, domain.WrapError(err, "failed to save updated event")
 	}
 
-	// Publish audit event
-	if err := s.repository.PublishAuditEvent(ctx, domain.EntityTypeEvent, event.EventID, domain.AuditEventUpdate, userID, &originalEvent, event); err != nil {
-		// Log error but don't fail the operation
+	return event, nil
+}
+
+// AdminGetEventRevisions lists the revision history of an event, newest first (admin only)
+func (s *EventsService) AdminGetEventRevisions(ctx context.Context, eventID string, userID string) ([]*domain.Revision, error) {
+	// Validate admin authentication
+	if !IsAdminUser(userID) {
+		return nil, domain.NewUnauthorizedError("admin privileges required to view event revisions")
 	}
 
-	return event, nil
+	if eventID == "" {
+		return nil, domain.NewValidationError("event ID cannot be empty")
+	}
+
+	return s.repository.ListEventRevisions(ctx, eventID)
+}
+
+// AdminGetEventRevision retrieves one revision of an event with its snapshot (admin only)
+func (s *EventsService) AdminGetEventRevision(ctx context.Context, eventID string, revision int, userID string) (*domain.Revision, error) {
+	// Validate admin authentication
+	if !IsAdminUser(userID) {
+		return nil, domain.NewUnauthorizedError("admin privileges required to view event revisions")
+	}
+
+	if eventID == "" {
+		return nil, domain.NewValidationError("event ID cannot be empty")
+	}
+
+	return s.repository.GetEventRevision(ctx, eventID, revision)
+}
+
+// AdminDiffEventRevisions compares two revisions of an event field by field (admin only)
+func (s *EventsService) AdminDiffEventRevisions(ctx context.Context, eventID string, fromRevision, toRevision int, userID string) (*domain.RevisionDiff, error) {
+	from, err := s.AdminGetEventRevision(ctx, eventID, fromRevision, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := s.AdminGetEventRevision(ctx, eventID, toRevision, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.DiffRevisions(from, to)
+}
+
+// AdminRollbackEvent restores the content an event had at an earlier revision (admin only).
+// The restored event is saved as a new version and audited as a rollback.
+func (s *EventsService) AdminRollbackEvent(ctx context.Context, eventID string, revision int, ifMatch string, userID string) (*Event, error) {
+	target, err := s.AdminGetEventRevision(ctx, eventID, revision, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := s.repository.GetEvent(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reject rollbacks made against an older version of the event
+	if err := domain.CheckETag("event", eventID, ifMatch, event.ETag); err != nil {
+		return nil, err
+	}
+
+	var restored Event
+	if err := target.DecodeContent(&restored); err != nil {
+		return nil, err
+	}
+
+	if err := validateEventTitle(restored.Title); err != nil {
+		return nil, err
+	}
+
+	// Identity and creation details always come from the live event
+	restored.EventID = event.EventID
+	restored.CreatedOn = event.CreatedOn
+	restored.CreatedBy = event.CreatedBy
+	restored.IsDeleted = false
+	restored.DeletedOn = nil
+	restored.DeletedBy = nil
+	restored.ModifiedOn = &[]time.Time{time.Now()}[0]
+	restored.ModifiedBy = &userID
+	restored.ETag = event.ETag
+	restored.Review = event.Review.Clone()
+	if restored.Review == nil {
+		restored.Review = &domain.EditorialReview{}
+	}
+	restored.PublishingStatus = PublishingStatus(s.workflow.StatusAfterEdit(
+		domain.WorkflowStatus(event.PublishingStatus), domain.WorkflowStatus(restored.PublishingStatus), restored.Review, userID))
+
+	audit := domain.NewAuditChange(domain.EntityTypeEvent, eventID, domain.AuditEventRollback, userID, event, &restored)
+	if err := s.repository.SaveEvent(ctx, &restored, audit); err != nil {
+		return nil, domain.WrapError(err, "failed to save restored event")
+	}
+
+	return &restored, nil
 }
 
 // AdminDeleteEvent soft deletes an event (admin only)
@@ -353,23 +483,19 @@ func (s *EventsService) AdminDeleteEvent(ctx context.Context, eventID string, us
 	originalEvent := *event
 
 	// Delete event
-	if err := s.repository.DeleteEvent(ctx, eventID, userID); err != nil {
+	audit := domain.NewAuditChange(domain.EntityTypeEvent, event.EventID, domain.AuditEventDelete, userID, &originalEvent, nil)
+	if err := s.repository.DeleteEvent(ctx, eventID, userID, audit); err != nil {
 		return domain.WrapError(err, "failed to delete event")
 	}
 
 	// Remove as featured event if it was featured
 	featuredEvent, featuredErr := s.repository.GetFeaturedEvent(ctx)
 	if featuredErr == nil && featuredEvent.EventID == eventID {
-		if err := s.repository.DeleteFeaturedEvent(ctx); err != nil {
+		if err := s.repository.DeleteFeaturedEvent(ctx, nil); err != nil {
 			// Log error but don't fail the operation
 		}
 	}
 
-	// Publish audit event
-	if err := s.repository.PublishAuditEvent(ctx, domain.EntityTypeEvent, event.EventID, domain.AuditEventDelete, userID, &originalEvent, nil); err != nil {
-		// Log error but don't fail the operation
-	}
-
 	return nil
 }
 
@@ -386,6 +512,10 @@ func (s *EventsService) AdminPublishEvent(ctx context.Context, eventID string, u
 		return nil, err
 	}
 
+	if err := s.workflow.CanPublish(domain.WorkflowStatus(event.PublishingStatus)); err != nil {
+		return nil, err
+	}
+
 	// Store original data for audit
 	originalEvent := *event
 
@@ -395,16 +525,123 @@ func (s *EventsService) AdminPublishEvent(ctx context.Context, eventID string, u
 	}
 
 	// Save updated event
-	if err := s.repository.SaveEvent(ctx, event); err != nil {
+	audit := domain.NewAuditChange(domain.EntityTypeEvent, event.EventID, domain.AuditEventUpdate, userID, &originalEvent, event)
+	if err := s.repository.SaveEvent(ctx, event, audit); err != nil {
 		return nil, domain.WrapError(err, "failed to save published event")
 	}
 
-	// Publish audit event
-	if err := s.repository.PublishAuditEvent(ctx, domain.EntityTypeEvent, event.EventID, domain.AuditEventUpdate, userID, &originalEvent, event); err != nil {
-		// Log error but don't fail the operation
+	return event, nil
+}
+
+// AdminGetEventReview returns the review state of an event (admin only)
+func (s *EventsService) AdminGetEventReview(ctx context.Context, eventID string, userID string) (*domain.ContentReview, error) {
+	event, err := s.AdminGetEvent(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
 	}
 
-	return event, nil
+	return event.ContentReview(), nil
+}
+
+// AdminSubmitEventForReview sends a draft event to its reviewers (admin only)
+func (s *EventsService) AdminSubmitEventForReview(ctx context.Context, eventID string, reviewerIDs []string, userID string) (*domain.ContentReview, error) {
+	return s.applyReview(ctx, eventID, userID, func(event *Event, now time.Time) (*domain.ReviewEvent, error) {
+		status, err := event.Review.Submit(domain.WorkflowStatus(event.PublishingStatus), userID, event.editorIDs(), reviewerIDs, now)
+		if err != nil {
+			return nil, err
+		}
+		event.PublishingStatus = PublishingStatus(status)
+
+		return domain.NewReviewEvent(domain.ReviewEventRequested, event.ContentReview(), userID, event.Review.Reviewers, ""), nil
+	})
+}
+
+// AdminAssignEventReviewers adds reviewers to an event under review (admin only)
+func (s *EventsService) AdminAssignEventReviewers(ctx context.Context, eventID string, reviewerIDs []string, userID string) (*domain.ContentReview, error) {
+	return s.applyReview(ctx, eventID, userID, func(event *Event, now time.Time) (*domain.ReviewEvent, error) {
+		added, err := event.Review.AssignReviewers(domain.WorkflowStatus(event.PublishingStatus), reviewerIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		return domain.NewReviewEvent(domain.ReviewEventRequested, event.ContentReview(), userID, added, ""), nil
+	})
+}
+
+// AdminCommentOnEventReview adds a review comment to an event (admin only)
+func (s *EventsService) AdminCommentOnEventReview(ctx context.Context, eventID string, body string, userID string) (*domain.ContentReview, error) {
+	return s.applyReview(ctx, eventID, userID, func(event *Event, now time.Time) (*domain.ReviewEvent, error) {
+		comment, err := event.Review.AddComment(domain.WorkflowStatus(event.PublishingStatus), userID, body, now)
+		if err != nil {
+			return nil, err
+		}
+
+		return domain.NewReviewEvent(domain.ReviewEventCommented, event.ContentReview(), userID, event.Review.Participants(userID), comment.Body), nil
+	})
+}
+
+// AdminApproveEvent records a reviewer's approval of an event (admin only); the
+// event's own authors cannot approve it
+func (s *EventsService) AdminApproveEvent(ctx context.Context, eventID string, userID string) (*domain.ContentReview, error) {
+	return s.applyReview(ctx, eventID, userID, func(event *Event, now time.Time) (*domain.ReviewEvent, error) {
+		status, err := event.Review.Approve(domain.WorkflowStatus(event.PublishingStatus), userID, s.workflow.RequiredApprovals, now)
+		if err != nil {
+			return nil, err
+		}
+		event.PublishingStatus = PublishingStatus(status)
+
+		if status != domain.WorkflowStatusApproved {
+			return nil, nil
+		}
+		return domain.NewReviewEvent(domain.ReviewEventApproved, event.ContentReview(), userID, event.Review.Authors, ""), nil
+	})
+}
+
+// AdminRejectEvent returns an event to its authors as a draft (admin only)
+func (s *EventsService) AdminRejectEvent(ctx context.Context, eventID string, reason string, userID string) (*domain.ContentReview, error) {
+	return s.applyReview(ctx, eventID, userID, func(event *Event, now time.Time) (*domain.ReviewEvent, error) {
+		status, err := event.Review.Reject(domain.WorkflowStatus(event.PublishingStatus), userID, reason, now)
+		if err != nil {
+			return nil, err
+		}
+		event.PublishingStatus = PublishingStatus(status)
+
+		return domain.NewReviewEvent(domain.ReviewEventRejected, event.ContentReview(), userID, event.Review.Authors, event.Review.RejectionReason), nil
+	})
+}
+
+// applyReview loads an event, applies one review step and saves it. Reviewers and
+// authors are notified once the step is saved.
+func (s *EventsService) applyReview(ctx context.Context, eventID string, userID string, step func(event *Event, now time.Time) (*domain.ReviewEvent, error)) (*domain.ContentReview, error) {
+	event, err := s.AdminGetEvent(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Store original data for audit
+	originalEvent := *event
+	originalEvent.Review = event.Review.Clone()
+	if event.Review == nil {
+		event.Review = &domain.EditorialReview{}
+	}
+
+	reviewEvent, err := step(event, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	audit := domain.NewAuditChange(domain.EntityTypeEvent, event.EventID, domain.AuditEventUpdate, userID, &originalEvent, event)
+	if err := s.repository.SaveEvent(ctx, event, audit); err != nil {
+		return nil, domain.WrapError(err, "failed to save event review")
+	}
+
+	if reviewEvent != nil {
+		if err := s.repository.PublishReviewEvent(ctx, reviewEvent); err != nil {
+			return nil, domain.WrapError(err, "failed to notify event reviewers")
+		}
+	}
+
+	return event.ContentReview(), nil
 }
 
 // AdminArchiveEvent archives a published event (admin only)
@@ -429,23 +666,19 @@ func (s *EventsService) AdminArchiveEvent(ctx context.Context, eventID string, u
 	}
 
 	// Save updated event
-	if err := s.repository.SaveEvent(ctx, event); err != nil {
+	audit := domain.NewAuditChange(domain.EntityTypeEvent, event.EventID, domain.AuditEventUpdate, userID, &originalEvent, event)
+	if err := s.repository.SaveEvent(ctx, event, audit); err != nil {
 		return nil, domain.WrapError(err, "failed to save archived event")
 	}
 
 	// Remove as featured event if it was featured
 	featuredEvent, featuredErr := s.repository.GetFeaturedEvent(ctx)
 	if featuredErr == nil && featuredEvent.EventID == eventID {
-		if err := s.repository.DeleteFeaturedEvent(ctx); err != nil {
+		if err := s.repository.DeleteFeaturedEvent(ctx, nil); err != nil {
 			// Log error but don't fail the operation
 		}
 	}
 
-	// Publish audit event
-	if err := s.repository.PublishAuditEvent(ctx, domain.EntityTypeEvent, event.EventID, domain.AuditEventUpdate, userID, &originalEvent, event); err != nil {
-		// Log error but don't fail the operation
-	}
-
 	return event, nil
 }
 
@@ -468,15 +701,11 @@ func (s *EventsService) AdminCreateEventCategory(ctx context.Context, request Ad
 	}
 
 	// Save category to repository
-	if err := s.repository.SaveEventCategory(ctx, category); err != nil {
+	audit := domain.NewAuditChange(domain.EntityTypeEventCategory, category.CategoryID, domain.AuditEventInsert, userID, nil, category)
+	if err := s.repository.SaveEventCategory(ctx, category, audit); err != nil {
 		return nil, domain.WrapError(err, "failed to save event category")
 	}
 
-	// Publish audit event
-	if err := s.repository.PublishAuditEvent(ctx, domain.EntityTypeEventCategory, category.CategoryID, domain.AuditEventInsert, userID, nil, category); err != nil {
-		// Log error but don't fail the operation
-	}
-
 	return category, nil
 }
 
@@ -511,15 +740,11 @@ func (s *EventsService) AdminDeleteEventCategory(ctx context.Context, categoryID
 	// This would require additional repository methods to list and update events by category
 
 	// Delete category
-	if err := s.repository.DeleteEventCategory(ctx, categoryID, userID); err != nil {
+	audit := domain.NewAuditChange(domain.EntityTypeEventCategory, category.CategoryID, domain.AuditEventDelete, userID, &originalCategory, nil)
+	if err := s.repository.DeleteEventCategory(ctx, categoryID, userID, audit); err != nil {
 		return domain.WrapError(err, "failed to delete event category")
 	}
 
-	// Publish audit event
-	if err := s.repository.PublishAuditEvent(ctx, domain.EntityTypeEventCategory, category.CategoryID, domain.AuditEventDelete, userID, &originalCategory, nil); err != nil {
-		// Log error but don't fail the operation
-	}
-
 	return nil
 }
 
@@ -552,7 +777,7 @@ func (s *EventsService) AdminSetFeaturedEvent(ctx context.Context, eventID strin
 	}
 
 	// Remove existing featured event if any
-	if err := s.repository.DeleteFeaturedEvent(ctx); err != nil {
+	if err := s.repository.DeleteFeaturedEvent(ctx, nil); err != nil {
 		// Ignore not found errors for featured event
 		if !domain.IsNotFoundError(err) {
 			return nil, domain.WrapError(err, "failed to remove existing featured event")
@@ -566,15 +791,11 @@ func (s *EventsService) AdminSetFeaturedEvent(ctx context.Context, eventID strin
 	}
 
 	// Save featured event
-	if err := s.repository.SaveFeaturedEvent(ctx, featuredEvent); err != nil {
+	audit := domain.NewAuditChange(domain.EntityTypeFeaturedEvent, featuredEvent.FeaturedEventID, domain.AuditEventInsert, userID, nil, featuredEvent)
+	if err := s.repository.SaveFeaturedEvent(ctx, featuredEvent, audit); err != nil {
 		return nil, domain.WrapError(err, "failed to save featured event")
 	}
 
-	// Publish audit event
-	if err := s.repository.PublishAuditEvent(ctx, domain.EntityTypeFeaturedEvent, featuredEvent.FeaturedEventID, domain.AuditEventInsert, userID, nil, featuredEvent); err != nil {
-		// Log error but don't fail the operation
-	}
-
 	return featuredEvent, nil
 }
 
@@ -610,6 +831,46 @@ func (s *EventsService) GetFeaturedEvent(ctx context.Context) (*FeaturedEvent, e
 	return featuredEvent, nil
 }
 
+// ListPublishedEventsPage retrieves one cursor-delimited page of published events (public access)
+func (s *EventsService) ListPublishedEventsPage(ctx context.Context, categoryID string, page domain.PageRequest) ([]*Event, domain.PageInfo, error) {
+	filter := EventListFilter{CategoryID: categoryID, PublishingStatus: PublishingStatusPublished}
+
+	events, info, err := s.repository.ListEventsPage(ctx, filter, page)
+	if err != nil {
+		return nil, domain.PageInfo{}, domain.WrapError(err, "failed to list events page")
+	}
+
+	return events, info, nil
+}
+
+// QueryPublishedEvents runs a ranked full-text search over published events,
+// returning highlighted hits and facet counts (public access)
+func (s *EventsService) QueryPublishedEvents(ctx context.Context, query search.Query) (*search.Results, error) {
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	results, err := s.repository.QueryEvents(ctx, query.WithFilter(eventFacetStatus, string(PublishingStatusPublished)))
+	if err != nil {
+		return nil, domain.WrapError(err, "failed to query events search index")
+	}
+
+	return results, nil
+}
+
+// ReindexEvent refreshes the search index entry of an event after it changed
+func (s *EventsService) ReindexEvent(ctx context.Context, eventID string) error {
+	if eventID == "" {
+		return domain.NewValidationError("event ID cannot be empty")
+	}
+
+	if err := s.repository.ReindexEvent(ctx, eventID); err != nil {
+		return domain.WrapError(err, "failed to reindex event")
+	}
+
+	return nil
+}
+
 // Private validation helper methods
 
 func (s *EventsService) validateCreateEventRequest(request AdminCreateEventRequest) error {
@@ -0,0 +1,255 @@
+package media
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+)
+
+// MediaRepository implements media library data access using Dapr state store and
+// blob storage bindings
+type MediaRepository struct {
+	stateStore *dapr.StateStore
+	bindings   *dapr.Bindings
+	pubsub     *dapr.PubSub
+}
+
+// Secondary indexes maintained for media assets
+const (
+	mediaIndexKind = "kind"
+	mediaIndexLink = "link"
+)
+
+// MediaIndexes declares the secondary indexes maintained for media assets. The link
+// index holds one "entity_type:entity_id" value per content item using the asset.
+func MediaIndexes() *dapr.IndexedEntityType {
+	return &dapr.IndexedEntityType{
+		Domain:     "media",
+		EntityType: "asset",
+		Indexes: []dapr.IndexDefinition{
+			{Name: mediaIndexKind, Extract: func(entity interface{}) []string {
+				return []string{string(entity.(*MediaAsset).Kind)}
+			}},
+			{Name: mediaIndexLink, Extract: func(entity interface{}) []string {
+				links := entity.(*MediaAsset).Links
+				values := make([]string, 0, len(links))
+				for _, link := range links {
+					values = append(values, linkIndexValue(link.EntityType, link.EntityID))
+				}
+				return values
+			}},
+		},
+		NewEntity: func() interface{} { return &MediaAsset{} },
+		EntityID:  func(entity interface{}) string { return entity.(*MediaAsset).MediaID },
+	}
+}
+
+func linkIndexValue(entityType domain.EntityType, entityID string) string {
+	return string(entityType) + ":" + entityID
+}
+
+// NewMediaRepository creates a new media repository with Dapr integration
+func NewMediaRepository(stateStore *dapr.StateStore, bindings *dapr.Bindings, pubsub *dapr.PubSub) MediaRepositoryInterface {
+	stateStore.MustRegisterIndexes(MediaIndexes())
+	stateStore.MustRegisterIndexes(dapr.OutboxMessageIndexes())
+
+	return &MediaRepository{
+		stateStore: stateStore,
+		bindings:   bindings,
+		pubsub:     pubsub,
+	}
+}
+
+// SaveMedia saves an asset record and its indexes together with its audit event. An
+// asset carrying an ETag is only saved if the stored version still matches it.
+func (r *MediaRepository) SaveMedia(ctx context.Context, asset *MediaAsset, audit *domain.AuditEvent) error {
+	messages, err := r.auditMessages(ctx, audit)
+	if err != nil {
+		return err
+	}
+
+	err = r.stateStore.SaveIndexedWithOutbox(ctx, "media", "asset", asset.MediaID, asset, asset.ETag, messages...)
+	if err != nil {
+		return fmt.Errorf("failed to save media asset %s: %w", asset.MediaID, err)
+	}
+
+	key := r.stateStore.CreateKey("media", "asset", asset.MediaID)
+	if asset.ETag, err = r.stateStore.WrittenETag(ctx, key, asset); err != nil {
+		asset.ETag = ""
+	}
+	return nil
+}
+
+// GetMedia retrieves an asset record by ID
+func (r *MediaRepository) GetMedia(ctx context.Context, mediaID string) (*MediaAsset, error) {
+	key := r.stateStore.CreateKey("media", "asset", mediaID)
+
+	var asset MediaAsset
+	found, etag, err := r.stateStore.GetWithETag(ctx, key, &asset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get media asset %s: %w", mediaID, err)
+	}
+	if !found {
+		return nil, domain.NewNotFoundError("media asset", mediaID)
+	}
+
+	asset.ETag = etag
+	return &asset, nil
+}
+
+// ListMedia returns the assets matching filter, newest first
+func (r *MediaRepository) ListMedia(ctx context.Context, filter MediaFilter) ([]*MediaAsset, error) {
+	var conditions []dapr.IndexCondition
+	if filter.EntityType != "" && filter.EntityID != "" {
+		conditions = append(conditions, dapr.IndexCondition{Index: mediaIndexLink, Value: linkIndexValue(filter.EntityType, filter.EntityID)})
+	}
+	if filter.Kind != "" {
+		conditions = append(conditions, dapr.IndexCondition{Index: mediaIndexKind, Value: string(filter.Kind)})
+	}
+
+	var ids []string
+	var err error
+	if len(conditions) > 0 {
+		ids, err = r.stateStore.LookupIndex(ctx, "media", "asset", conditions...)
+	} else {
+		kinds := make([]string, 0, len(AllMediaKinds))
+		for _, kind := range AllMediaKinds {
+			kinds = append(kinds, string(kind))
+		}
+		ids, err = r.stateStore.LookupIndexAny(ctx, "media", "asset", mediaIndexKind, kinds)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query media index: %w", err)
+	}
+
+	assets, err := r.getMediaByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	// An entity type without an ID lists everything attached to that type of content
+	if filter.EntityType != "" && filter.EntityID == "" {
+		filtered := assets[:0]
+		for _, asset := range assets {
+			for _, link := range asset.Links {
+				if link.EntityType == filter.EntityType {
+					filtered = append(filtered, asset)
+					break
+				}
+			}
+		}
+		assets = filtered
+	}
+
+	sort.SliceStable(assets, func(i, j int) bool {
+		return assets[i].CreatedOn.After(assets[j].CreatedOn)
+	})
+	return assets, nil
+}
+
+func (r *MediaRepository) getMediaByIDs(ctx context.Context, ids []string) ([]*MediaAsset, error) {
+	assets := make([]*MediaAsset, 0, len(ids))
+	if len(ids) == 0 {
+		return assets, nil
+	}
+
+	keys := make([]string, 0, len(ids))
+	targets := make(map[string]interface{}, len(ids))
+	loaded := make([]*MediaAsset, 0, len(ids))
+	for _, id := range ids {
+		key := r.stateStore.CreateKey("media", "asset", id)
+		asset := &MediaAsset{}
+		keys = append(keys, key)
+		targets[key] = asset
+		loaded = append(loaded, asset)
+	}
+
+	if err := r.stateStore.GetBulk(ctx, keys, targets); err != nil {
+		return nil, fmt.Errorf("failed to load indexed media assets: %w", err)
+	}
+
+	for _, asset := range loaded {
+		if asset.MediaID != "" {
+			assets = append(assets, asset)
+		}
+	}
+	return assets, nil
+}
+
+// DeleteMedia removes an asset record and its indexes together with its audit event.
+// The blobs are removed separately by the service.
+func (r *MediaRepository) DeleteMedia(ctx context.Context, mediaID string, audit *domain.AuditEvent) error {
+	messages, err := r.auditMessages(ctx, audit)
+	if err != nil {
+		return err
+	}
+
+	if err := r.stateStore.DeleteIndexedWithOutbox(ctx, "media", "asset", mediaID, messages...); err != nil {
+		return fmt.Errorf("failed to delete media asset %s: %w", mediaID, err)
+	}
+	return nil
+}
+
+// Blob operations
+
+// StoragePath returns the blob path for a rendition of an asset. Paths carry the
+// content hash, so identical files always resolve to the same blob.
+func (r *MediaRepository) StoragePath(mediaID string, name string, extension string, uploadedOn time.Time) string {
+	return r.bindings.CreateStoragePath("media", uploadedOn.Format("2006"), uploadedOn.Format("01"), mediaID, name, extension)
+}
+
+// UploadBlob stores the bytes of a rendition
+func (r *MediaRepository) UploadBlob(ctx context.Context, storagePath string, data []byte, contentType string) error {
+	return r.bindings.UploadBlob(ctx, storagePath, data, contentType)
+}
+
+// DownloadBlob reads the bytes of a rendition
+func (r *MediaRepository) DownloadBlob(ctx context.Context, storagePath string) ([]byte, error) {
+	return r.bindings.DownloadBlob(ctx, storagePath)
+}
+
+// DeleteBlob removes the bytes of a rendition
+func (r *MediaRepository) DeleteBlob(ctx context.Context, storagePath string) error {
+	return r.bindings.DeleteBlob(ctx, storagePath)
+}
+
+// auditMessages converts an audit event into the outbox message committed with the change
+func (r *MediaRepository) auditMessages(ctx context.Context, auditEvent *domain.AuditEvent) ([]*dapr.OutboxMessage, error) {
+	if auditEvent == nil {
+		return nil, nil
+	}
+
+	auditEvent.SetTraceContext(domain.GetCorrelationID(ctx), domain.GetTraceID(ctx))
+	auditEvent.SetEnvironmentContext("development", "media-api-1.0.0")
+
+	daprAuditEvent := &dapr.AuditEvent{
+		AuditID:       auditEvent.AuditID,
+		EntityType:    string(auditEvent.EntityType),
+		EntityID:      auditEvent.EntityID,
+		OperationType: string(auditEvent.OperationType),
+		AuditTime:     auditEvent.AuditTime,
+		UserID:        auditEvent.UserID,
+		CorrelationID: auditEvent.CorrelationID,
+		TraceID:       auditEvent.TraceID,
+		Environment:   auditEvent.Environment,
+		AppVersion:    auditEvent.AppVersion,
+		RequestURL:    auditEvent.RequestURL,
+	}
+	if auditEvent.DataSnapshot != nil {
+		daprAuditEvent.DataSnapshot = map[string]interface{}{
+			"before": auditEvent.DataSnapshot.Before,
+			"after":  auditEvent.DataSnapshot.After,
+		}
+	}
+
+	message, err := r.pubsub.AuditEventMessage(daprAuditEvent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare audit event for %s %s: %w", auditEvent.EntityType, auditEvent.EntityID, err)
+	}
+
+	return []*dapr.OutboxMessage{dapr.NewOutboxMessage(ctx, message.Topic, message)}, nil
+}
@@ -0,0 +1,209 @@
+package media
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+)
+
+const (
+	defaultThumbnailSize  = 320
+	derivativeJPEGQuality = 85
+)
+
+// defaultResponsiveWidths are the widths rendered for responsive images
+var defaultResponsiveWidths = []int{640, 1280, 1920}
+
+// DerivativeSpec describes the renditions generated for uploaded images. Images are
+// only ever scaled down, so widths at or above the original are skipped.
+type DerivativeSpec struct {
+	ThumbnailSize    int
+	ResponsiveWidths []int
+}
+
+// DefaultDerivativeSpec returns a 320px thumbnail and 640, 1280 and 1920px widths
+func DefaultDerivativeSpec() DerivativeSpec {
+	return DerivativeSpec{ThumbnailSize: defaultThumbnailSize, ResponsiveWidths: defaultResponsiveWidths}
+}
+
+// DerivativeSpecFromEnv reads MEDIA_THUMBNAIL_SIZE and MEDIA_RESPONSIVE_WIDTHS, a comma
+// separated list of pixel widths
+func DerivativeSpecFromEnv() (DerivativeSpec, error) {
+	spec := DefaultDerivativeSpec()
+	if value := os.Getenv("MEDIA_THUMBNAIL_SIZE"); value != "" {
+		size, err := strconv.Atoi(value)
+		if err != nil || size <= 0 {
+			return DerivativeSpec{}, fmt.Errorf("MEDIA_THUMBNAIL_SIZE must be a positive number of pixels, got %q", value)
+		}
+		spec.ThumbnailSize = size
+	}
+	if value := os.Getenv("MEDIA_RESPONSIVE_WIDTHS"); value != "" {
+		spec.ResponsiveWidths = nil
+		for _, part := range strings.Split(value, ",") {
+			width, err := strconv.Atoi(strings.TrimSpace(part))
+			if err != nil || width <= 0 {
+				return DerivativeSpec{}, fmt.Errorf("MEDIA_RESPONSIVE_WIDTHS must list positive pixel widths, got %q", value)
+			}
+			spec.ResponsiveWidths = append(spec.ResponsiveWidths, width)
+		}
+		sort.Ints(spec.ResponsiveWidths)
+	}
+	return spec, nil
+}
+
+// renderedDerivative is a derivative and its encoded bytes, before upload
+type renderedDerivative struct {
+	MediaDerivative
+	data []byte
+}
+
+// imageDimensions reads the size of an image without decoding its pixels. WebP has no
+// decoder in the standard library, so its dimensions stay unknown.
+func imageDimensions(data []byte, contentType string) (int, int, error) {
+	if contentType == "image/webp" {
+		return 0, 0, nil
+	}
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return 0, 0, domain.NewValidationError(fmt.Sprintf("image could not be read: %v", err))
+	}
+	return config.Width, config.Height, nil
+}
+
+// renderDerivatives scales an image to the thumbnail and responsive widths of spec.
+// JPEG sources produce JPEG renditions; PNG and GIF sources produce PNG to keep
+// transparency. Only the first frame of an animated GIF is used.
+func renderDerivatives(data []byte, contentType string, spec DerivativeSpec) ([]renderedDerivative, error) {
+	var decode func([]byte) (image.Image, error)
+	switch contentType {
+	case "image/jpeg":
+		decode = func(b []byte) (image.Image, error) { return jpeg.Decode(bytes.NewReader(b)) }
+	case "image/png":
+		decode = func(b []byte) (image.Image, error) { return png.Decode(bytes.NewReader(b)) }
+	case "image/gif":
+		decode = func(b []byte) (image.Image, error) { return gif.Decode(bytes.NewReader(b)) }
+	default:
+		return nil, nil
+	}
+
+	source, err := decode(data)
+	if err != nil {
+		return nil, domain.NewValidationError(fmt.Sprintf("image could not be decoded: %v", err))
+	}
+	bounds := source.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width == 0 || height == 0 {
+		return nil, domain.NewValidationError("image has no pixels")
+	}
+
+	var derivatives []renderedDerivative
+	render := func(name string, targetWidth, targetHeight int) error {
+		scaled := scaleImage(source, targetWidth, targetHeight)
+		encoded, encodedType, err := encodeDerivative(scaled, contentType)
+		if err != nil {
+			return fmt.Errorf("failed to encode %s derivative: %w", name, err)
+		}
+		derivatives = append(derivatives, renderedDerivative{
+			MediaDerivative: MediaDerivative{
+				Name:        name,
+				Width:       targetWidth,
+				Height:      targetHeight,
+				ContentType: encodedType,
+				SizeBytes:   int64(len(encoded)),
+			},
+			data: encoded,
+		})
+		return nil
+	}
+
+	if spec.ThumbnailSize > 0 {
+		thumbWidth, thumbHeight := fitWithin(width, height, spec.ThumbnailSize, spec.ThumbnailSize)
+		if err := render(VariantThumbnail, thumbWidth, thumbHeight); err != nil {
+			return nil, err
+		}
+	}
+	for _, targetWidth := range spec.ResponsiveWidths {
+		if targetWidth >= width {
+			continue
+		}
+		if err := render(fmt.Sprintf("w%d", targetWidth), targetWidth, max(1, height*targetWidth/width)); err != nil {
+			return nil, err
+		}
+	}
+
+	return derivatives, nil
+}
+
+// fitWithin scales width and height down to fit a bounding box, keeping the aspect
+// ratio; images already inside the box keep their size
+func fitWithin(width, height, maxWidth, maxHeight int) (int, int) {
+	if width <= maxWidth && height <= maxHeight {
+		return width, height
+	}
+	if width*maxHeight >= height*maxWidth {
+		return maxWidth, max(1, height*maxWidth/width)
+	}
+	return max(1, width*maxHeight/height), maxHeight
+}
+
+// scaleImage resizes by averaging the source pixels that fall in each destination
+// pixel, which downscales without the aliasing of nearest-neighbour sampling
+func scaleImage(source image.Image, width, height int) *image.NRGBA {
+	bounds := source.Bounds()
+	sourceWidth, sourceHeight := bounds.Dx(), bounds.Dy()
+	scaled := image.NewNRGBA(image.Rect(0, 0, width, height))
+
+	for y := 0; y < height; y++ {
+		y0 := bounds.Min.Y + y*sourceHeight/height
+		y1 := max(y0+1, bounds.Min.Y+(y+1)*sourceHeight/height)
+		for x := 0; x < width; x++ {
+			x0 := bounds.Min.X + x*sourceWidth/width
+			x1 := max(x0+1, bounds.Min.X+(x+1)*sourceWidth/width)
+
+			var r, g, b, a, count uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					pixel := color.NRGBA64Model.Convert(source.At(sx, sy)).(color.NRGBA64)
+					r += uint64(pixel.R)
+					g += uint64(pixel.G)
+					b += uint64(pixel.B)
+					a += uint64(pixel.A)
+					count++
+				}
+			}
+			scaled.SetNRGBA(x, y, color.NRGBA{
+				R: uint8(r / count >> 8),
+				G: uint8(g / count >> 8),
+				B: uint8(b / count >> 8),
+				A: uint8(a / count >> 8),
+			})
+		}
+	}
+
+	return scaled
+}
+
+// encodeDerivative encodes a rendition in the format used for sourceType
+func encodeDerivative(img image.Image, sourceType string) ([]byte, string, error) {
+	var buffer bytes.Buffer
+	if sourceType == "image/jpeg" {
+		if err := jpeg.Encode(&buffer, img, &jpeg.Options{Quality: derivativeJPEGQuality}); err != nil {
+			return nil, "", err
+		}
+		return buffer.Bytes(), "image/jpeg", nil
+	}
+	if err := png.Encode(&buffer, img); err != nil {
+		return nil, "", err
+	}
+	return buffer.Bytes(), "image/png", nil
+}
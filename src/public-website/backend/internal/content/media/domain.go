@@ -0,0 +1,254 @@
+package media
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+)
+
+// MediaKind groups the accepted media types by how they are stored and limited
+type MediaKind string
+
+const (
+	MediaKindImage    MediaKind = "image"
+	MediaKindDocument MediaKind = "document"
+	MediaKindVideo    MediaKind = "video"
+	MediaKindAudio    MediaKind = "audio"
+)
+
+// AllMediaKinds lists every kind, in the order listings use
+var AllMediaKinds = []MediaKind{MediaKindImage, MediaKindDocument, MediaKindVideo, MediaKindAudio}
+
+// IsValid checks if the media kind is valid
+func (k MediaKind) IsValid() bool {
+	switch k {
+	case MediaKindImage, MediaKindDocument, MediaKindVideo, MediaKindAudio:
+		return true
+	default:
+		return false
+	}
+}
+
+// Derivative names. Responsive widths are named after their width, e.g. "w1280".
+const (
+	VariantOriginal  = "original"
+	VariantThumbnail = "thumbnail"
+)
+
+// mediaType describes a content type the library accepts
+type mediaType struct {
+	kind      MediaKind
+	extension string
+}
+
+// acceptedMediaTypes maps the sniffed content type to its kind and file extension.
+// SVG and HTML are left out on purpose: both can carry script.
+var acceptedMediaTypes = map[string]mediaType{
+	"image/jpeg":      {kind: MediaKindImage, extension: "jpg"},
+	"image/png":       {kind: MediaKindImage, extension: "png"},
+	"image/gif":       {kind: MediaKindImage, extension: "gif"},
+	"image/webp":      {kind: MediaKindImage, extension: "webp"},
+	"application/pdf": {kind: MediaKindDocument, extension: "pdf"},
+	"video/mp4":       {kind: MediaKindVideo, extension: "mp4"},
+	"video/webm":      {kind: MediaKindVideo, extension: "webm"},
+	"audio/mpeg":      {kind: MediaKindAudio, extension: "mp3"},
+}
+
+// linkableEntityTypes are the content types media can be attached to
+var linkableEntityTypes = map[domain.EntityType]bool{
+	domain.EntityTypeNews:     true,
+	domain.EntityTypeEvent:    true,
+	domain.EntityTypeResearch: true,
+	domain.EntityTypeService:  true,
+}
+
+// MediaAsset is a file in the media library together with its derivatives and the
+// content it is used by. Identical uploads share one asset.
+type MediaAsset struct {
+	MediaID     string            `json:"media_id"`
+	FileName    string            `json:"file_name"`
+	ContentType string            `json:"content_type"`
+	Kind        MediaKind         `json:"kind"`
+	SizeBytes   int64             `json:"size_bytes"`
+	ContentHash string            `json:"content_hash"`
+	StoragePath string            `json:"storage_path"`
+	Width       int               `json:"width,omitempty"`
+	Height      int               `json:"height,omitempty"`
+	AltText     string            `json:"alt_text,omitempty"`
+	Derivatives []MediaDerivative `json:"derivatives"`
+	Links       []MediaLink       `json:"links"`
+	CreatedOn   time.Time         `json:"created_on"`
+	CreatedBy   string            `json:"created_by"`
+	ModifiedOn  *time.Time        `json:"modified_on,omitempty"`
+	ModifiedBy  string            `json:"modified_by,omitempty"`
+	ETag        string            `json:"-"`
+}
+
+// MediaDerivative is a resized rendition of an image asset
+type MediaDerivative struct {
+	Name        string `json:"name"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+	ContentType string `json:"content_type"`
+	SizeBytes   int64  `json:"size_bytes"`
+	StoragePath string `json:"storage_path"`
+}
+
+// MediaLink records that a content item uses an asset
+type MediaLink struct {
+	EntityType domain.EntityType `json:"entity_type"`
+	EntityID   string            `json:"entity_id"`
+	LinkedOn   time.Time         `json:"linked_on"`
+	LinkedBy   string            `json:"linked_by"`
+}
+
+// MediaFilter narrows a media listing; empty fields match everything
+type MediaFilter struct {
+	Kind       MediaKind
+	EntityType domain.EntityType
+	EntityID   string
+}
+
+// Variant returns the storage path and content type of the original or of a named
+// derivative
+func (m *MediaAsset) Variant(name string) (string, string, error) {
+	if name == "" || name == VariantOriginal {
+		return m.StoragePath, m.ContentType, nil
+	}
+	for _, derivative := range m.Derivatives {
+		if derivative.Name == name {
+			return derivative.StoragePath, derivative.ContentType, nil
+		}
+	}
+	return "", "", domain.NewNotFoundError("media variant", m.MediaID+"/"+name)
+}
+
+// LinkedTo reports whether the asset is used by a content item
+func (m *MediaAsset) LinkedTo(entityType domain.EntityType, entityID string) bool {
+	for _, link := range m.Links {
+		if link.EntityType == entityType && link.EntityID == entityID {
+			return true
+		}
+	}
+	return false
+}
+
+// AddLink attaches the asset to a content item; linking twice changes nothing
+func (m *MediaAsset) AddLink(entityType domain.EntityType, entityID string, userID string) error {
+	if !linkableEntityTypes[entityType] {
+		return domain.NewValidationError(fmt.Sprintf("media cannot be linked to %s", entityType))
+	}
+	if strings.TrimSpace(entityID) == "" {
+		return domain.NewValidationError("entity ID cannot be empty")
+	}
+	if m.LinkedTo(entityType, entityID) {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	m.Links = append(m.Links, MediaLink{EntityType: entityType, EntityID: entityID, LinkedOn: now, LinkedBy: userID})
+	m.ModifiedOn = &now
+	m.ModifiedBy = userID
+	return nil
+}
+
+// RemoveLink detaches the asset from a content item
+func (m *MediaAsset) RemoveLink(entityType domain.EntityType, entityID string, userID string) error {
+	for i, link := range m.Links {
+		if link.EntityType == entityType && link.EntityID == entityID {
+			m.Links = append(m.Links[:i], m.Links[i+1:]...)
+			now := time.Now().UTC()
+			m.ModifiedOn = &now
+			m.ModifiedBy = userID
+			return nil
+		}
+	}
+	return domain.NewNotFoundError("media link", fmt.Sprintf("%s/%s:%s", m.MediaID, entityType, entityID))
+}
+
+// storagePaths lists the blobs of an asset
+func (m *MediaAsset) storagePaths() []string {
+	paths := []string{m.StoragePath}
+	for _, derivative := range m.Derivatives {
+		paths = append(paths, derivative.StoragePath)
+	}
+	return paths
+}
+
+// MediaLimits caps upload sizes per media kind, and the pixel count of images so
+// that a small file cannot decode into an enormous bitmap
+type MediaLimits struct {
+	MaxBytes       map[MediaKind]int64
+	MaxImagePixels int
+}
+
+// DefaultMediaLimits returns the limits used when the environment sets none
+func DefaultMediaLimits() MediaLimits {
+	return MediaLimits{
+		MaxBytes: map[MediaKind]int64{
+			MediaKindImage:    10 << 20,
+			MediaKindDocument: 25 << 20,
+			MediaKindVideo:    200 << 20,
+			MediaKindAudio:    50 << 20,
+		},
+		MaxImagePixels: 40000000,
+	}
+}
+
+// MediaLimitsFromEnv reads MEDIA_MAX_<KIND>_BYTES and MEDIA_MAX_IMAGE_PIXELS
+func MediaLimitsFromEnv() (MediaLimits, error) {
+	limits := DefaultMediaLimits()
+	for _, kind := range AllMediaKinds {
+		name := "MEDIA_MAX_" + strings.ToUpper(string(kind)) + "_BYTES"
+		if value := os.Getenv(name); value != "" {
+			parsed, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || parsed <= 0 {
+				return MediaLimits{}, fmt.Errorf("%s must be a positive number of bytes, got %q", name, value)
+			}
+			limits.MaxBytes[kind] = parsed
+		}
+	}
+	if value := os.Getenv("MEDIA_MAX_IMAGE_PIXELS"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			return MediaLimits{}, fmt.Errorf("MEDIA_MAX_IMAGE_PIXELS must be a positive number, got %q", value)
+		}
+		limits.MaxImagePixels = parsed
+	}
+	return limits, nil
+}
+
+// MaxUploadBytes is the largest upload any kind allows
+func (l MediaLimits) MaxUploadBytes() int64 {
+	var largest int64
+	for _, limit := range l.MaxBytes {
+		if limit > largest {
+			largest = limit
+		}
+	}
+	return largest
+}
+
+// sanitizeFileName keeps the base name of an uploaded file, without directories or
+// control characters, for display and download headers
+func sanitizeFileName(name string) string {
+	name = path.Base(strings.ReplaceAll(name, "\\", "/"))
+	name = strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f || r == '"' {
+			return -1
+		}
+		return r
+	}, name)
+	if name == "." || name == "/" {
+		return ""
+	}
+	if len(name) > 255 {
+		name = name[:255]
+	}
+	return name
+}
@@ -0,0 +1,80 @@
+package media
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/gorilla/mux"
+)
+
+// MediaHandler serves media library files to holders of signed download links. The
+// admin endpoints that manage the library are part of the admin API contract.
+type MediaHandler struct {
+	service *MediaService
+}
+
+// NewMediaHandler creates a new media handler
+func NewMediaHandler(service *MediaService) *MediaHandler {
+	return &MediaHandler{
+		service: service,
+	}
+}
+
+// RegisterRoutes registers media routes with the router
+func (h *MediaHandler) RegisterRoutes(router *mux.Router) {
+	router.HandleFunc("/api/v1/media/{id}/download", h.DownloadMedia).Methods("GET")
+}
+
+// DownloadMedia handles GET /api/v1/media/{id}/download
+func (h *MediaHandler) DownloadMedia(w http.ResponseWriter, r *http.Request) {
+	mediaID := mux.Vars(r)["id"]
+
+	download, err := h.service.Download(r.Context(), mediaID, r.URL.Query())
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	// The link fixes the variant and expiry, so caches may keep the file until it expires
+	maxAge := MaxSignedURLExpiry
+	if expires, err := strconv.ParseInt(r.URL.Query().Get("expires"), 10, 64); err == nil {
+		maxAge = time.Until(time.Unix(expires, 0))
+	}
+
+	w.Header().Set("Content-Type", download.ContentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(download.Data)))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("inline", map[string]string{"filename": download.FileName}))
+	w.Header().Set("Cache-Control", "private, max-age="+strconv.Itoa(int(maxAge.Seconds())))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	w.Write(download.Data)
+}
+
+// handleError maps domain errors to their HTTP status
+func (h *MediaHandler) handleError(w http.ResponseWriter, r *http.Request, err error) {
+	statusCode, errorCode, message := http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to download media"
+	switch {
+	case domain.IsValidationError(err):
+		statusCode, errorCode, message = http.StatusBadRequest, "VALIDATION_ERROR", err.Error()
+	case domain.IsUnauthorizedError(err):
+		// Signing in does not help with a bad or expired link, so it is forbidden
+		statusCode, errorCode, message = http.StatusForbidden, "FORBIDDEN", err.Error()
+	case domain.IsNotFoundError(err):
+		statusCode, errorCode, message = http.StatusNotFound, "NOT_FOUND", err.Error()
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":           errorCode,
+			"message":        message,
+			"correlation_id": domain.GetCorrelationID(r.Context()),
+		},
+	})
+}
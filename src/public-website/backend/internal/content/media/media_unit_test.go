@@ -0,0 +1,472 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"net/url"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockMediaRepository keeps assets and blobs in memory for unit tests
+type MockMediaRepository struct {
+	assets      map[string]*MediaAsset
+	blobs       map[string][]byte
+	auditEvents []*domain.AuditEvent
+	saves       int
+}
+
+func NewMockMediaRepository() *MockMediaRepository {
+	return &MockMediaRepository{
+		assets: make(map[string]*MediaAsset),
+		blobs:  make(map[string][]byte),
+	}
+}
+
+func (m *MockMediaRepository) SaveMedia(ctx context.Context, asset *MediaAsset, audit *domain.AuditEvent) error {
+	if stored, exists := m.assets[asset.MediaID]; exists && asset.ETag != "" && stored.ETag != asset.ETag {
+		return domain.NewConflictError("media asset was modified concurrently")
+	}
+	m.saves++
+	asset.ETag = fmt.Sprintf("etag-%d", m.saves)
+	stored := *asset
+	m.assets[asset.MediaID] = &stored
+	if audit != nil {
+		m.auditEvents = append(m.auditEvents, audit)
+	}
+	return nil
+}
+
+func (m *MockMediaRepository) GetMedia(ctx context.Context, mediaID string) (*MediaAsset, error) {
+	stored, exists := m.assets[mediaID]
+	if !exists {
+		return nil, domain.NewNotFoundError("media asset", mediaID)
+	}
+	asset := *stored
+	asset.Links = append([]MediaLink(nil), stored.Links...)
+	return &asset, nil
+}
+
+func (m *MockMediaRepository) ListMedia(ctx context.Context, filter MediaFilter) ([]*MediaAsset, error) {
+	var assets []*MediaAsset
+	for _, asset := range m.assets {
+		if filter.Kind != "" && asset.Kind != filter.Kind {
+			continue
+		}
+		if filter.EntityType != "" && filter.EntityID != "" && !asset.LinkedTo(filter.EntityType, filter.EntityID) {
+			continue
+		}
+		assets = append(assets, asset)
+	}
+	sort.Slice(assets, func(i, j int) bool { return assets[i].CreatedOn.After(assets[j].CreatedOn) })
+	return assets, nil
+}
+
+func (m *MockMediaRepository) DeleteMedia(ctx context.Context, mediaID string, audit *domain.AuditEvent) error {
+	delete(m.assets, mediaID)
+	if audit != nil {
+		m.auditEvents = append(m.auditEvents, audit)
+	}
+	return nil
+}
+
+func (m *MockMediaRepository) StoragePath(mediaID string, name string, extension string, uploadedOn time.Time) string {
+	return fmt.Sprintf("test/media/%s/%s/%s/%s.%s", uploadedOn.Format("2006"), uploadedOn.Format("01"), mediaID, name, extension)
+}
+
+func (m *MockMediaRepository) UploadBlob(ctx context.Context, storagePath string, data []byte, contentType string) error {
+	m.blobs[storagePath] = data
+	return nil
+}
+
+func (m *MockMediaRepository) DownloadBlob(ctx context.Context, storagePath string) ([]byte, error) {
+	data, exists := m.blobs[storagePath]
+	if !exists {
+		return nil, fmt.Errorf("blob %s not found", storagePath)
+	}
+	return data, nil
+}
+
+func (m *MockMediaRepository) DeleteBlob(ctx context.Context, storagePath string) error {
+	delete(m.blobs, storagePath)
+	return nil
+}
+
+const testSigningKey = "media-url-signing-key-for-unit-tests"
+
+// newTestMediaService returns a service that can link media to the news article
+// "news-1" and the event "event-1"
+func newTestMediaService(t *testing.T) (*MediaService, *MockMediaRepository) {
+	t.Helper()
+	repository := NewMockMediaRepository()
+	signer, err := NewURLSigner(testSigningKey, "https://media.example.org")
+	require.NoError(t, err)
+
+	service := NewMediaService(repository, signer)
+	for entityType, existing := range map[domain.EntityType]string{domain.EntityTypeNews: "news-1", domain.EntityTypeEvent: "event-1"} {
+		entityType, existing := entityType, existing
+		service.RegisterLinkTarget(entityType, func(ctx context.Context, entityID string, userID string) error {
+			if entityID != existing {
+				return domain.NewNotFoundError(string(entityType), entityID)
+			}
+			return nil
+		})
+	}
+	return service, repository
+}
+
+// testImage draws a gradient so encoders cannot collapse it to a trivial file
+func testImage(width, height int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.SetNRGBA(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+	return img
+}
+
+func testPNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	var buffer bytes.Buffer
+	require.NoError(t, png.Encode(&buffer, testImage(width, height)))
+	return buffer.Bytes()
+}
+
+func testJPEG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	var buffer bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buffer, testImage(width, height), nil))
+	return buffer.Bytes()
+}
+
+func derivativeNames(asset *MediaAsset) []string {
+	names := make([]string, 0, len(asset.Derivatives))
+	for _, derivative := range asset.Derivatives {
+		names = append(names, derivative.Name)
+	}
+	return names
+}
+
+func TestMediaService_Upload(t *testing.T) {
+	pdf := []byte("%PDF-1.7\n1 0 obj << /Type /Catalog >> endobj\ntrailer << /Root 1 0 R >>\n%%EOF")
+
+	tests := []struct {
+		name                string
+		fileName            string
+		data                []byte
+		limits              func(*MediaLimits)
+		expectedError       string
+		expectedKind        MediaKind
+		expectedContentType string
+		expectedFileName    string
+		expectedDerivatives []string
+	}{
+		{
+			name:                "png gets a thumbnail and the responsive widths below its own",
+			fileName:            "uploads/team photo.png",
+			data:                testPNG(t, 800, 600),
+			expectedKind:        MediaKindImage,
+			expectedContentType: "image/png",
+			expectedFileName:    "team photo.png",
+			expectedDerivatives: []string{VariantThumbnail, "w640"},
+		},
+		{
+			name:                "content type is sniffed, not taken from the file name",
+			fileName:            "annual-report.png",
+			data:                pdf,
+			expectedKind:        MediaKindDocument,
+			expectedContentType: "application/pdf",
+			expectedFileName:    "annual-report.png",
+			expectedDerivatives: []string{},
+		},
+		{
+			name:          "html is refused",
+			fileName:      "page.pdf",
+			data:          []byte("<!DOCTYPE html><html><body><script>alert(1)</script></body></html>"),
+			expectedError: "validation",
+		},
+		{
+			name:          "svg is refused",
+			fileName:      "logo.svg",
+			data:          []byte(`<?xml version="1.0"?><svg xmlns="http://www.w3.org/2000/svg"></svg>`),
+			expectedError: "validation",
+		},
+		{
+			name:          "empty file",
+			fileName:      "empty.png",
+			data:          []byte{},
+			expectedError: "validation",
+		},
+		{
+			name:          "larger than the limit for its kind",
+			fileName:      "photo.png",
+			data:          testPNG(t, 64, 64),
+			limits:        func(l *MediaLimits) { l.MaxBytes[MediaKindImage] = 100 },
+			expectedError: "validation",
+		},
+		{
+			name:          "more pixels than allowed",
+			fileName:      "panorama.png",
+			data:          testPNG(t, 400, 300),
+			limits:        func(l *MediaLimits) { l.MaxImagePixels = 100000 },
+			expectedError: "validation",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			service, repository := newTestMediaService(t)
+			if tt.limits != nil {
+				limits := DefaultMediaLimits()
+				tt.limits(&limits)
+				service.UseLimits(limits)
+			}
+
+			// Act
+			asset, created, err := service.Upload(context.Background(), UploadRequest{FileName: tt.fileName, Data: tt.data}, "editor-1")
+
+			// Assert
+			if tt.expectedError != "" {
+				assert.True(t, domain.IsValidationError(err), "expected validation error, got %v", err)
+				assert.Empty(t, repository.blobs, "refused uploads store nothing")
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, created)
+			assert.Equal(t, tt.expectedKind, asset.Kind)
+			assert.Equal(t, tt.expectedContentType, asset.ContentType)
+			assert.Equal(t, tt.expectedFileName, asset.FileName)
+			assert.Equal(t, tt.expectedDerivatives, derivativeNames(asset))
+			assert.Contains(t, asset.StoragePath, asset.MediaID+"/"+asset.ContentHash+".")
+			assert.Equal(t, tt.data, repository.blobs[asset.StoragePath])
+			assert.Len(t, repository.blobs, 1+len(asset.Derivatives))
+			require.Len(t, repository.auditEvents, 1)
+			assert.Equal(t, domain.AuditEventInsert, repository.auditEvents[0].OperationType)
+			assert.Equal(t, domain.EntityTypeMediaAsset, repository.auditEvents[0].EntityType)
+		})
+	}
+}
+
+func TestMediaService_UploadDeduplicatesByContentHash(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	service, repository := newTestMediaService(t)
+	data := testPNG(t, 200, 100)
+	first, created, err := service.Upload(ctx, UploadRequest{FileName: "first.png", Data: data}, "editor-1")
+	require.NoError(t, err)
+	require.True(t, created)
+	blobs := len(repository.blobs)
+
+	// Act
+	second, secondCreated, secondErr := service.Upload(ctx, UploadRequest{
+		FileName:   "second.png",
+		Data:       data,
+		EntityType: domain.EntityTypeNews,
+		EntityID:   "news-1",
+	}, "editor-2")
+
+	// Assert
+	require.NoError(t, secondErr)
+	assert.False(t, secondCreated)
+	assert.Equal(t, first.MediaID, second.MediaID)
+	assert.Equal(t, "first.png", second.FileName, "the existing asset is returned")
+	assert.True(t, second.LinkedTo(domain.EntityTypeNews, "news-1"), "the requested link is added to the existing asset")
+	assert.Equal(t, blobs, len(repository.blobs), "no blob is stored twice")
+	require.Len(t, repository.auditEvents, 2)
+	assert.Equal(t, domain.AuditEventUpdate, repository.auditEvents[1].OperationType)
+}
+
+func TestMediaService_LinksAndDeletion(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	service, repository := newTestMediaService(t)
+	asset, _, err := service.Upload(ctx, UploadRequest{FileName: "speaker.jpg", Data: testJPEG(t, 900, 600)}, "editor-1")
+	require.NoError(t, err)
+
+	// Act
+	_, missingTargetErr := service.LinkMedia(ctx, asset.MediaID, domain.EntityTypeEvent, "event-404", "editor-1")
+	_, unsupportedErr := service.LinkMedia(ctx, asset.MediaID, domain.EntityTypeBusinessInquiry, "inquiry-1", "editor-1")
+	linked, linkErr := service.LinkMedia(ctx, asset.MediaID, domain.EntityTypeEvent, "event-1", "editor-1")
+	listed, listErr := service.ListMedia(ctx, MediaFilter{EntityType: domain.EntityTypeEvent, EntityID: "event-1"})
+	deleteLinkedErr := service.DeleteMedia(ctx, asset.MediaID, "editor-1")
+	_, unlinkErr := service.UnlinkMedia(ctx, asset.MediaID, domain.EntityTypeEvent, "event-1", "editor-1")
+	_, unlinkAgainErr := service.UnlinkMedia(ctx, asset.MediaID, domain.EntityTypeEvent, "event-1", "editor-1")
+	deleteErr := service.DeleteMedia(ctx, asset.MediaID, "editor-1")
+	_, getErr := service.GetMedia(ctx, asset.MediaID)
+
+	// Assert
+	assert.True(t, domain.IsNotFoundError(missingTargetErr), "links to missing content are refused")
+	assert.True(t, domain.IsValidationError(unsupportedErr))
+	require.NoError(t, linkErr)
+	assert.True(t, linked.LinkedTo(domain.EntityTypeEvent, "event-1"))
+	require.NoError(t, listErr)
+	require.Len(t, listed, 1)
+	assert.Equal(t, asset.MediaID, listed[0].MediaID)
+	assert.True(t, domain.IsConflictError(deleteLinkedErr), "linked media cannot be deleted")
+	require.NoError(t, unlinkErr)
+	assert.True(t, domain.IsNotFoundError(unlinkAgainErr))
+	require.NoError(t, deleteErr)
+	assert.True(t, domain.IsNotFoundError(getErr))
+	assert.Empty(t, repository.blobs, "the original and its derivatives are removed")
+	assert.Equal(t, domain.AuditEventDelete, repository.auditEvents[len(repository.auditEvents)-1].OperationType)
+}
+
+func TestMediaService_SignedDownloads(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	service, repository := newTestMediaService(t)
+	asset, _, err := service.Upload(ctx, UploadRequest{FileName: "venue.png", Data: testPNG(t, 1000, 500)}, "editor-1")
+	require.NoError(t, err)
+
+	// Act
+	signed, signErr := service.SignedURL(ctx, asset.MediaID, VariantThumbnail, 5*time.Minute)
+	_, unknownVariantErr := service.SignedURL(ctx, asset.MediaID, "w4096", 0)
+	require.NoError(t, signErr)
+	parsed, err := url.Parse(signed.URL)
+	require.NoError(t, err)
+	download, downloadErr := service.Download(ctx, asset.MediaID, parsed.Query())
+
+	tampered := parsed.Query()
+	tampered.Set("variant", VariantOriginal)
+	_, tamperedErr := service.Download(ctx, asset.MediaID, tampered)
+
+	// Assert
+	assert.True(t, strings.HasPrefix(signed.URL, "https://media.example.org/api/v1/media/"+asset.MediaID+"/download?"))
+	assert.True(t, domain.IsNotFoundError(unknownVariantErr))
+	require.NoError(t, downloadErr)
+	thumbnailPath, _, err := asset.Variant(VariantThumbnail)
+	require.NoError(t, err)
+	assert.Equal(t, repository.blobs[thumbnailPath], download.Data)
+	assert.Equal(t, "image/png", download.ContentType)
+	assert.Equal(t, "venue-thumbnail.png", download.FileName)
+	assert.True(t, domain.IsUnauthorizedError(tamperedErr), "a link cannot be reused for another variant")
+}
+
+func TestURLSigner(t *testing.T) {
+	tests := []struct {
+		name          string
+		key           string
+		expiresIn     time.Duration
+		elapsed       time.Duration
+		modify        func(url.Values)
+		expectedError string
+	}{
+		{name: "valid link", key: testSigningKey, expiresIn: time.Minute},
+		{name: "default expiry", key: testSigningKey, elapsed: DefaultSignedURLExpiry - time.Second},
+		{name: "expired link", key: testSigningKey, expiresIn: time.Minute, elapsed: time.Minute, expectedError: "unauthorized"},
+		{name: "extended expiry", key: testSigningKey, expiresIn: time.Minute, modify: func(q url.Values) {
+			q.Set("expires", fmt.Sprint(time.Now().Add(time.Hour).Unix()))
+		}, expectedError: "unauthorized"},
+		{name: "missing signature", key: testSigningKey, expiresIn: time.Minute, modify: func(q url.Values) { q.Del("signature") }, expectedError: "unauthorized"},
+		{name: "expiry beyond the maximum", key: testSigningKey, expiresIn: MaxSignedURLExpiry + time.Hour, expectedError: "validation"},
+		{name: "short signing key", key: "too-short", expectedError: "validation"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+			signer, err := NewURLSigner(tt.key, "")
+			if err == nil {
+				signer.now = func() time.Time { return now }
+			}
+
+			// Act
+			var verifyErr error
+			if err == nil {
+				var signed *SignedURL
+				signed, err = signer.Sign("media-1", VariantThumbnail, tt.expiresIn)
+				if err == nil {
+					parsed, parseErr := url.Parse(signed.URL)
+					require.NoError(t, parseErr)
+					query := parsed.Query()
+					if tt.modify != nil {
+						tt.modify(query)
+					}
+					now = now.Add(tt.elapsed)
+					_, verifyErr = signer.Verify("media-1", query)
+				}
+			}
+
+			// Assert
+			switch tt.expectedError {
+			case "":
+				require.NoError(t, err)
+				assert.NoError(t, verifyErr)
+			case "validation":
+				assert.True(t, domain.IsValidationError(err), "expected validation error, got %v", err)
+			case "unauthorized":
+				require.NoError(t, err)
+				assert.True(t, domain.IsUnauthorizedError(verifyErr), "expected unauthorized error, got %v", verifyErr)
+			}
+		})
+	}
+}
+
+func TestRenderDerivatives(t *testing.T) {
+	tests := []struct {
+		name               string
+		data               []byte
+		contentType        string
+		expectedSizes      map[string][2]int
+		expectedRenditions string
+	}{
+		{
+			name:               "wide jpeg",
+			data:               testJPEG(t, 2000, 1000),
+			contentType:        "image/jpeg",
+			expectedSizes:      map[string][2]int{VariantThumbnail: {320, 160}, "w640": {640, 320}, "w1280": {1280, 640}, "w1920": {1920, 960}},
+			expectedRenditions: "image/jpeg",
+		},
+		{
+			name:               "tall png smaller than the thumbnail box",
+			data:               testPNG(t, 100, 300),
+			contentType:        "image/png",
+			expectedSizes:      map[string][2]int{VariantThumbnail: {100, 300}},
+			expectedRenditions: "image/png",
+		},
+		{
+			name:               "tall png",
+			data:               testPNG(t, 700, 1400),
+			contentType:        "image/png",
+			expectedSizes:      map[string][2]int{VariantThumbnail: {160, 320}, "w640": {640, 1280}},
+			expectedRenditions: "image/png",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			rendered, err := renderDerivatives(tt.data, tt.contentType, DefaultDerivativeSpec())
+
+			// Assert
+			require.NoError(t, err)
+			require.Len(t, rendered, len(tt.expectedSizes))
+			for _, derivative := range rendered {
+				expected, ok := tt.expectedSizes[derivative.Name]
+				require.True(t, ok, "unexpected derivative %s", derivative.Name)
+				assert.Equal(t, expected, [2]int{derivative.Width, derivative.Height}, derivative.Name)
+				assert.Equal(t, tt.expectedRenditions, derivative.ContentType)
+
+				config, format, err := image.DecodeConfig(bytes.NewReader(derivative.data))
+				require.NoError(t, err)
+				assert.Equal(t, strings.TrimPrefix(tt.expectedRenditions, "image/"), format)
+				assert.Equal(t, expected, [2]int{config.Width, config.Height}, "encoded size of %s", derivative.Name)
+				assert.Equal(t, int64(len(derivative.data)), derivative.SizeBytes)
+			}
+		})
+	}
+}
@@ -0,0 +1,376 @@
+package media
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/google/uuid"
+)
+
+// MediaRepositoryInterface defines the data access the media service needs
+type MediaRepositoryInterface interface {
+	SaveMedia(ctx context.Context, asset *MediaAsset, audit *domain.AuditEvent) error
+	GetMedia(ctx context.Context, mediaID string) (*MediaAsset, error)
+	ListMedia(ctx context.Context, filter MediaFilter) ([]*MediaAsset, error)
+	DeleteMedia(ctx context.Context, mediaID string, audit *domain.AuditEvent) error
+
+	StoragePath(mediaID string, name string, extension string, uploadedOn time.Time) string
+	UploadBlob(ctx context.Context, storagePath string, data []byte, contentType string) error
+	DownloadBlob(ctx context.Context, storagePath string) ([]byte, error)
+	DeleteBlob(ctx context.Context, storagePath string) error
+}
+
+// LinkTargetFunc checks that a content item media is being linked to exists
+type LinkTargetFunc func(ctx context.Context, entityID string, userID string) error
+
+// mediaIDNamespace derives asset IDs from content hashes, so concurrent uploads of the
+// same file resolve to the same asset instead of racing to create two
+var mediaIDNamespace = uuid.MustParse("6f1c2e8a-4b3d-5a7e-9c1f-0d2b4e6a8c10")
+
+// UploadRequest is a file posted to the media library, optionally linked to a content
+// item in the same step
+type UploadRequest struct {
+	FileName   string
+	Data       []byte
+	AltText    string
+	EntityType domain.EntityType
+	EntityID   string
+}
+
+// MediaDownload is a variant of an asset served through a signed link
+type MediaDownload struct {
+	Data        []byte
+	ContentType string
+	FileName    string
+}
+
+// MediaService manages the media library: uploads, derivatives, links to content and
+// signed download links
+type MediaService struct {
+	repository  MediaRepositoryInterface
+	signer      *URLSigner
+	limits      MediaLimits
+	derivatives DerivativeSpec
+	linkTargets map[domain.EntityType]LinkTargetFunc
+}
+
+// NewMediaService creates a media service with the default limits and derivatives.
+// Without a signer the library works but cannot hand out download links.
+func NewMediaService(repository MediaRepositoryInterface, signer *URLSigner) *MediaService {
+	return &MediaService{
+		repository:  repository,
+		signer:      signer,
+		limits:      DefaultMediaLimits(),
+		derivatives: DefaultDerivativeSpec(),
+		linkTargets: make(map[domain.EntityType]LinkTargetFunc),
+	}
+}
+
+// SecretKeyURLSigning is the secret store key holding the download link signing key
+const SecretKeyURLSigning = "media-url-signing-key"
+
+// NewMediaServiceFromEnv creates a media service with the limits and derivatives set in
+// the environment, signing download links under MEDIA_PUBLIC_BASE_URL
+func NewMediaServiceFromEnv(repository MediaRepositoryInterface, signingKey string) (*MediaService, error) {
+	signer, err := NewURLSigner(signingKey, os.Getenv("MEDIA_PUBLIC_BASE_URL"))
+	if err != nil {
+		return nil, err
+	}
+	limits, err := MediaLimitsFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	spec, err := DerivativeSpecFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	service := NewMediaService(repository, signer)
+	service.UseLimits(limits)
+	service.UseDerivativeSpec(spec)
+	return service, nil
+}
+
+// UseLimits replaces the upload size and pixel limits
+func (s *MediaService) UseLimits(limits MediaLimits) {
+	s.limits = limits
+}
+
+// UseDerivativeSpec replaces the renditions generated for images
+func (s *MediaService) UseDerivativeSpec(spec DerivativeSpec) {
+	s.derivatives = spec
+}
+
+// Limits returns the limits uploads are checked against
+func (s *MediaService) Limits() MediaLimits {
+	return s.limits
+}
+
+// RegisterLinkTarget lets media be linked to a content type, using lookup to check that
+// the linked item exists
+func (s *MediaService) RegisterLinkTarget(entityType domain.EntityType, lookup LinkTargetFunc) {
+	s.linkTargets[entityType] = lookup
+}
+
+// Upload adds a file to the library. The type is sniffed from the content rather than
+// trusted from the request, and a file already in the library is not stored again:
+// the existing asset is returned, linked as requested, with created set to false.
+func (s *MediaService) Upload(ctx context.Context, request UploadRequest, userID string) (*MediaAsset, bool, error) {
+	if len(request.Data) == 0 {
+		return nil, false, domain.NewValidationError("uploaded file is empty")
+	}
+	linking := request.EntityType != "" || request.EntityID != ""
+
+	contentType, _, _ := strings.Cut(http.DetectContentType(request.Data), ";")
+	accepted, ok := acceptedMediaTypes[contentType]
+	if !ok {
+		return nil, false, domain.NewValidationError(fmt.Sprintf("files of type %s are not accepted", contentType))
+	}
+	if limit := s.limits.MaxBytes[accepted.kind]; limit > 0 && int64(len(request.Data)) > limit {
+		return nil, false, domain.NewValidationError(fmt.Sprintf("%s files cannot be larger than %d bytes", accepted.kind, limit))
+	}
+
+	sum := sha256.Sum256(request.Data)
+	hash := hex.EncodeToString(sum[:])
+	mediaID := uuid.NewSHA1(mediaIDNamespace, []byte(hash)).String()
+
+	existing, err := s.repository.GetMedia(ctx, mediaID)
+	if err == nil {
+		if linking {
+			if err := s.link(ctx, existing, request.EntityType, request.EntityID, userID); err != nil {
+				return nil, false, err
+			}
+		}
+		return existing, false, nil
+	}
+	if !domain.IsNotFoundError(err) {
+		return nil, false, err
+	}
+
+	now := time.Now().UTC()
+	asset := &MediaAsset{
+		MediaID:     mediaID,
+		FileName:    sanitizeFileName(request.FileName),
+		ContentType: contentType,
+		Kind:        accepted.kind,
+		SizeBytes:   int64(len(request.Data)),
+		ContentHash: hash,
+		AltText:     strings.TrimSpace(request.AltText),
+		Derivatives: []MediaDerivative{},
+		Links:       []MediaLink{},
+		CreatedOn:   now,
+		CreatedBy:   userID,
+	}
+	if asset.FileName == "" {
+		asset.FileName = mediaID + "." + accepted.extension
+	}
+
+	var rendered []renderedDerivative
+	if accepted.kind == MediaKindImage {
+		if asset.Width, asset.Height, err = imageDimensions(request.Data, contentType); err != nil {
+			return nil, false, err
+		}
+		if s.limits.MaxImagePixels > 0 && asset.Width*asset.Height > s.limits.MaxImagePixels {
+			return nil, false, domain.NewValidationError(fmt.Sprintf("images cannot have more than %d pixels", s.limits.MaxImagePixels))
+		}
+		if rendered, err = renderDerivatives(request.Data, contentType, s.derivatives); err != nil {
+			return nil, false, err
+		}
+	}
+
+	if linking {
+		if err := s.checkLinkTarget(ctx, request.EntityType, request.EntityID, userID); err != nil {
+			return nil, false, err
+		}
+		if err := asset.AddLink(request.EntityType, request.EntityID, userID); err != nil {
+			return nil, false, err
+		}
+	}
+
+	// Blobs go first so a saved record never points at missing files; blob paths are
+	// derived from the hash, so a retry after a failed save overwrites the same blobs
+	asset.StoragePath = s.repository.StoragePath(mediaID, hash, accepted.extension, now)
+	if err := s.repository.UploadBlob(ctx, asset.StoragePath, request.Data, contentType); err != nil {
+		return nil, false, domain.NewDependencyError("blob storage", domain.WrapError(err, "failed to store uploaded file"))
+	}
+	for _, derivative := range rendered {
+		extension := acceptedMediaTypes[derivative.ContentType].extension
+		derivative.StoragePath = s.repository.StoragePath(mediaID, hash+"-"+derivative.Name, extension, now)
+		if err := s.repository.UploadBlob(ctx, derivative.StoragePath, derivative.data, derivative.ContentType); err != nil {
+			return nil, false, domain.NewDependencyError("blob storage", domain.WrapError(err, fmt.Sprintf("failed to store %s derivative", derivative.Name)))
+		}
+		asset.Derivatives = append(asset.Derivatives, derivative.MediaDerivative)
+	}
+
+	audit := domain.NewAuditChange(domain.EntityTypeMediaAsset, mediaID, domain.AuditEventInsert, userID, nil, asset)
+	if err := s.repository.SaveMedia(ctx, asset, audit); err != nil {
+		return nil, false, err
+	}
+	return asset, true, nil
+}
+
+// GetMedia returns an asset by ID
+func (s *MediaService) GetMedia(ctx context.Context, mediaID string) (*MediaAsset, error) {
+	if strings.TrimSpace(mediaID) == "" {
+		return nil, domain.NewValidationError("media ID cannot be empty")
+	}
+	return s.repository.GetMedia(ctx, mediaID)
+}
+
+// ListMedia returns the assets matching filter, newest first
+func (s *MediaService) ListMedia(ctx context.Context, filter MediaFilter) ([]*MediaAsset, error) {
+	if filter.Kind != "" && !filter.Kind.IsValid() {
+		return nil, domain.NewValidationError(fmt.Sprintf("invalid media kind: %s", filter.Kind))
+	}
+	if filter.EntityID != "" && filter.EntityType == "" {
+		return nil, domain.NewValidationError("entity_id requires entity_type")
+	}
+	return s.repository.ListMedia(ctx, filter)
+}
+
+// LinkMedia records that a content item uses an asset
+func (s *MediaService) LinkMedia(ctx context.Context, mediaID string, entityType domain.EntityType, entityID string, userID string) (*MediaAsset, error) {
+	asset, err := s.GetMedia(ctx, mediaID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.link(ctx, asset, entityType, entityID, userID); err != nil {
+		return nil, err
+	}
+	return asset, nil
+}
+
+// UnlinkMedia records that a content item no longer uses an asset
+func (s *MediaService) UnlinkMedia(ctx context.Context, mediaID string, entityType domain.EntityType, entityID string, userID string) (*MediaAsset, error) {
+	asset, err := s.GetMedia(ctx, mediaID)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *asset
+	before.Links = append([]MediaLink(nil), asset.Links...)
+	if err := asset.RemoveLink(entityType, entityID, userID); err != nil {
+		return nil, err
+	}
+
+	audit := domain.NewAuditChange(domain.EntityTypeMediaAsset, asset.MediaID, domain.AuditEventUpdate, userID, &before, asset)
+	if err := s.repository.SaveMedia(ctx, asset, audit); err != nil {
+		return nil, err
+	}
+	return asset, nil
+}
+
+// DeleteMedia removes an asset and its blobs. Assets still used by content cannot be
+// deleted until they are unlinked.
+func (s *MediaService) DeleteMedia(ctx context.Context, mediaID string, userID string) error {
+	asset, err := s.GetMedia(ctx, mediaID)
+	if err != nil {
+		return err
+	}
+	if len(asset.Links) > 0 {
+		return domain.NewConflictError(fmt.Sprintf("media asset %s is still used by %d content items", mediaID, len(asset.Links)))
+	}
+
+	audit := domain.NewAuditChange(domain.EntityTypeMediaAsset, mediaID, domain.AuditEventDelete, userID, asset, nil)
+	if err := s.repository.DeleteMedia(ctx, mediaID, audit); err != nil {
+		return err
+	}
+
+	// The record is gone, so a blob left behind is unreachable rather than broken
+	var blobErrors []error
+	for _, storagePath := range asset.storagePaths() {
+		if err := s.repository.DeleteBlob(ctx, storagePath); err != nil {
+			blobErrors = append(blobErrors, err)
+		}
+	}
+	if len(blobErrors) > 0 {
+		return domain.NewDependencyError("blob storage", domain.WrapError(errors.Join(blobErrors...), fmt.Sprintf("media asset %s was deleted but some of its files were not", mediaID)))
+	}
+	return nil
+}
+
+// SignedURL returns a time-limited download link for a variant of an asset
+func (s *MediaService) SignedURL(ctx context.Context, mediaID string, variant string, expiresIn time.Duration) (*SignedURL, error) {
+	if s.signer == nil {
+		return nil, domain.NewDependencyError("media download links are not configured", nil)
+	}
+	asset, err := s.GetMedia(ctx, mediaID)
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err := asset.Variant(variant); err != nil {
+		return nil, err
+	}
+	return s.signer.Sign(asset.MediaID, variant, expiresIn)
+}
+
+// Download serves a variant of an asset to the holder of a signed link
+func (s *MediaService) Download(ctx context.Context, mediaID string, query url.Values) (*MediaDownload, error) {
+	if s.signer == nil {
+		return nil, domain.NewDependencyError("media download links are not configured", nil)
+	}
+	variant, err := s.signer.Verify(mediaID, query)
+	if err != nil {
+		return nil, err
+	}
+
+	asset, err := s.GetMedia(ctx, mediaID)
+	if err != nil {
+		return nil, err
+	}
+	storagePath, contentType, err := asset.Variant(variant)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := s.repository.DownloadBlob(ctx, storagePath)
+	if err != nil {
+		return nil, domain.NewDependencyError("blob storage", domain.WrapError(err, fmt.Sprintf("failed to read media asset %s", mediaID)))
+	}
+
+	fileName := asset.FileName
+	if variant != VariantOriginal {
+		fileName = strings.TrimSuffix(fileName, path.Ext(fileName)) + "-" + variant + "." + acceptedMediaTypes[contentType].extension
+	}
+	return &MediaDownload{Data: data, ContentType: contentType, FileName: fileName}, nil
+}
+
+// link adds a link to an asset after checking the content item exists
+func (s *MediaService) link(ctx context.Context, asset *MediaAsset, entityType domain.EntityType, entityID string, userID string) error {
+	if asset.LinkedTo(entityType, entityID) {
+		return nil
+	}
+	if err := s.checkLinkTarget(ctx, entityType, entityID, userID); err != nil {
+		return err
+	}
+
+	before := *asset
+	before.Links = append([]MediaLink(nil), asset.Links...)
+	if err := asset.AddLink(entityType, entityID, userID); err != nil {
+		return err
+	}
+
+	audit := domain.NewAuditChange(domain.EntityTypeMediaAsset, asset.MediaID, domain.AuditEventUpdate, userID, &before, asset)
+	return s.repository.SaveMedia(ctx, asset, audit)
+}
+
+func (s *MediaService) checkLinkTarget(ctx context.Context, entityType domain.EntityType, entityID string, userID string) error {
+	lookup, ok := s.linkTargets[entityType]
+	if !ok {
+		return domain.NewValidationError(fmt.Sprintf("media cannot be linked to %s", entityType))
+	}
+	if strings.TrimSpace(entityID) == "" {
+		return domain.NewValidationError("entity ID cannot be empty")
+	}
+	return lookup(ctx, entityID, userID)
+}
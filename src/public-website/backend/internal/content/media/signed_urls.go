@@ -0,0 +1,108 @@
+package media
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+)
+
+const (
+	// DefaultSignedURLExpiry is how long a download URL stays valid unless asked otherwise
+	DefaultSignedURLExpiry = 15 * time.Minute
+	// MaxSignedURLExpiry bounds how long a download URL can be requested for
+	MaxSignedURLExpiry = 24 * time.Hour
+
+	// minSigningKeyLength keeps signatures from being brute forced
+	minSigningKeyLength = 32
+)
+
+// SignedURL is a time-limited download link for one variant of an asset
+type SignedURL struct {
+	URL       string    `json:"url"`
+	Variant   string    `json:"variant"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// URLSigner signs download links to the public media endpoint. A link names the asset,
+// the variant and its expiry, so none of them can be changed without the signature
+// failing; no state is kept per link.
+type URLSigner struct {
+	key     []byte
+	baseURL string
+	now     func() time.Time
+}
+
+// NewURLSigner creates a signer. baseURL is prepended to the download path and may be
+// empty for links relative to the public gateway.
+func NewURLSigner(key string, baseURL string) (*URLSigner, error) {
+	if len(key) < minSigningKeyLength {
+		return nil, domain.NewValidationError(fmt.Sprintf("media URL signing key must be at least %d characters", minSigningKeyLength))
+	}
+	return &URLSigner{
+		key:     []byte(key),
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		now:     time.Now,
+	}, nil
+}
+
+// DownloadPath is the public route serving signed downloads
+func DownloadPath(mediaID string) string {
+	return "/api/v1/media/" + url.PathEscape(mediaID) + "/download"
+}
+
+// Sign returns a link to variant of mediaID that is valid for expiresIn
+func (s *URLSigner) Sign(mediaID string, variant string, expiresIn time.Duration) (*SignedURL, error) {
+	if expiresIn <= 0 {
+		expiresIn = DefaultSignedURLExpiry
+	}
+	if expiresIn > MaxSignedURLExpiry {
+		return nil, domain.NewValidationError(fmt.Sprintf("signed URLs cannot outlive %s", MaxSignedURLExpiry))
+	}
+	if variant == "" {
+		variant = VariantOriginal
+	}
+
+	expiresAt := s.now().Add(expiresIn).Truncate(time.Second).UTC()
+	query := url.Values{}
+	query.Set("variant", variant)
+	query.Set("expires", strconv.FormatInt(expiresAt.Unix(), 10))
+	query.Set("signature", s.signature(mediaID, variant, expiresAt.Unix()))
+
+	return &SignedURL{
+		URL:       s.baseURL + DownloadPath(mediaID) + "?" + query.Encode(),
+		Variant:   variant,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// Verify checks the query of a download request for mediaID and returns the variant it
+// grants. Expired and tampered links are both refused as unauthorized.
+func (s *URLSigner) Verify(mediaID string, query url.Values) (string, error) {
+	variant := query.Get("variant")
+	expires, err := strconv.ParseInt(query.Get("expires"), 10, 64)
+	if err != nil || variant == "" {
+		return "", domain.NewUnauthorizedError("download link is incomplete")
+	}
+
+	expected := s.signature(mediaID, variant, expires)
+	if !hmac.Equal([]byte(expected), []byte(query.Get("signature"))) {
+		return "", domain.NewUnauthorizedError("download link signature is invalid")
+	}
+	if !s.now().Before(time.Unix(expires, 0)) {
+		return "", domain.NewUnauthorizedError("download link has expired")
+	}
+	return variant, nil
+}
+
+func (s *URLSigner) signature(mediaID string, variant string, expires int64) string {
+	mac := hmac.New(sha256.New, s.key)
+	fmt.Fprintf(mac, "%s\n%s\n%d", mediaID, variant, expires)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -12,6 +12,7 @@ import (
 type ListNewsParams struct {
 	Page       int    `json:"page"`
 	Limit      int    `json:"limit"`
+	Cursor     string `json:"cursor,omitempty"`
 	Search     string `json:"search,omitempty"`
 	CategoryID string `json:"category_id,omitempty"`
 	Status     string `json:"status,omitempty"`
@@ -41,6 +42,9 @@ type UpdateNewsArticleRequest struct {
 	PriorityLevel    *string   `json:"priority_level,omitempty"`
 	Tags             *[]string `json:"tags,omitempty"`
 	PublishingStatus *string   `json:"publishing_status,omitempty"`
+
+	// IfMatch is the ETag the editor last read; empty skips the version check
+	IfMatch string `json:"-"`
 }
 
 type CreateCategoryRequest struct {
@@ -49,12 +53,13 @@ type CreateCategoryRequest struct {
 }
 
 type PaginationResult struct {
-	CurrentPage  int  `json:"current_page"`
-	TotalPages   int  `json:"total_pages"`
-	TotalItems   int  `json:"total_items"`
-	ItemsPerPage int  `json:"items_per_page"`
-	HasNext      bool `json:"has_next"`
-	HasPrevious  bool `json:"has_previous"`
+	CurrentPage  int    `json:"current_page"`
+	TotalPages   int    `json:"total_pages"`
+	TotalItems   int    `json:"total_items"`
+	ItemsPerPage int    `json:"items_per_page"`
+	HasNext      bool   `json:"has_next"`
+	HasPrevious  bool   `json:"has_previous"`
+	NextCursor   string `json:"next_cursor,omitempty"`
 }
 
 // Contract-compliant service methods for news operations
@@ -67,6 +72,10 @@ func (s *NewsService) AdminListNews(ctx context.Context, params ListNewsParams)
 		switch params.Status {
 		case "draft":
 			status = PublishingStatusDraft
+		case "in_review":
+			status = PublishingStatusInReview
+		case "approved":
+			status = PublishingStatusApproved
 		case "published":
 			status = PublishingStatusPublished
 		case "archived":
@@ -74,6 +83,11 @@ func (s *NewsService) AdminListNews(ctx context.Context, params ListNewsParams)
 		}
 	}
 
+	// Cursor requests resume from the previous page without counting offsets
+	if params.Cursor != "" && params.Search == "" {
+		return s.adminListNewsByCursor(ctx, params, status)
+	}
+
 	// Get news articles from repository
 	var newsItems []*News
 	var err error
@@ -118,9 +132,46 @@ func (s *NewsService) AdminListNews(ctx context.Context, params ListNewsParams)
 		HasPrevious:  params.Page > 1,
 	}
 
+	// Listings without search are ordered by PageCursor, so the last article
+	// on the page is a valid resume point for cursor requests
+	if pagination.HasNext && params.Search == "" && len(paginatedArticles) > 0 {
+		pagination.NextCursor = domain.EncodeCursor(paginatedArticles[len(paginatedArticles)-1].PageCursor())
+	}
+
 	return paginatedArticles, pagination, nil
 }
 
+// adminListNewsByCursor lists the page of news following params.Cursor
+func (s *NewsService) adminListNewsByCursor(ctx context.Context, params ListNewsParams, status PublishingStatus) ([]*News, PaginationResult, error) {
+	page, err := domain.NewPageRequest(params.Cursor, params.Limit)
+	if err != nil {
+		return nil, PaginationResult{}, err
+	}
+
+	filter := NewsListFilter{CategoryID: params.CategoryID, PublishingStatus: status}
+	newsItems, info, err := s.repository.ListNewsPage(ctx, filter, page)
+	if err != nil {
+		return nil, PaginationResult{}, err
+	}
+
+	totalPages := (info.Total + info.Limit - 1) / info.Limit
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	pagination := PaginationResult{
+		CurrentPage:  params.Page,
+		TotalPages:   totalPages,
+		TotalItems:   info.Total,
+		ItemsPerPage: info.Limit,
+		HasNext:      info.HasMore,
+		HasPrevious:  true,
+		NextCursor:   info.NextCursor,
+	}
+
+	return newsItems, pagination, nil
+}
+
 // AdminCreateNews creates a new news article in a contract-compliant way  
 func (s *NewsService) AdminCreateNews(ctx context.Context, request CreateNewsArticleRequest, userID string) (*News, error) {
 	// Create internal news entity
@@ -149,7 +200,7 @@ func (s *NewsService) AdminCreateNews(ctx context.Context, request CreateNewsArt
 	}
 
 	// Save to repository
-	err := s.repository.SaveNews(ctx, news)
+	err := s.repository.SaveNews(ctx, news, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -185,6 +236,10 @@ func (s *NewsService) AdminUpdateNews(ctx context.Context, newsID string, reques
 		return nil, domain.NewNotFoundError("news article", newsID)
 	}
 
+	if err := domain.CheckETag("news article", newsID, request.IfMatch, news.ETag); err != nil {
+		return nil, err
+	}
+
 	// Update fields that are provided
 	now := time.Now().UTC()
 	news.ModifiedOn = &now
@@ -218,12 +273,18 @@ func (s *NewsService) AdminUpdateNews(ctx context.Context, newsID string, reques
 	if request.Tags != nil {
 		news.Tags = *request.Tags
 	}
+	requestedStatus := news.PublishingStatus
 	if request.PublishingStatus != nil {
-		news.PublishingStatus = PublishingStatus(*request.PublishingStatus)
+		requestedStatus = PublishingStatus(*request.PublishingStatus)
+	}
+	if news.Review == nil {
+		news.Review = &domain.EditorialReview{}
 	}
+	news.PublishingStatus = PublishingStatus(s.workflow.StatusAfterEdit(
+		domain.WorkflowStatus(news.PublishingStatus), domain.WorkflowStatus(requestedStatus), news.Review, userID))
 
 	// Save updated news
-	err = s.repository.SaveNews(ctx, news)
+	err = s.repository.SaveNews(ctx, news, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -234,7 +295,7 @@ func (s *NewsService) AdminUpdateNews(ctx context.Context, newsID string, reques
 
 // AdminDeleteNews deletes a news article in a contract-compliant way
 func (s *NewsService) AdminDeleteNews(ctx context.Context, newsID string, userID string) error {
-	return s.repository.DeleteNews(ctx, newsID, userID)
+	return s.repository.DeleteNews(ctx, newsID, userID, nil)
 }
 
 // AdminPublishNews publishes a news article
@@ -249,6 +310,10 @@ func (s *NewsService) AdminPublishNews(ctx context.Context, newsID string, userI
 		return nil, domain.NewNotFoundError("news article", newsID)
 	}
 
+	if err := s.workflow.CanPublish(domain.WorkflowStatus(news.PublishingStatus)); err != nil {
+		return nil, err
+	}
+
 	// Update publishing status
 	now := time.Now().UTC()
 	news.PublishingStatus = PublishingStatusPublished
@@ -257,7 +322,7 @@ func (s *NewsService) AdminPublishNews(ctx context.Context, newsID string, userI
 	news.ModifiedBy = userID
 
 	// Save updated news
-	err = s.repository.SaveNews(ctx, news)
+	err = s.repository.SaveNews(ctx, news, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -285,7 +350,7 @@ func (s *NewsService) AdminUnpublishNews(ctx context.Context, newsID string, use
 	news.ModifiedBy = userID
 
 	// Save updated news
-	err = s.repository.SaveNews(ctx, news)
+	err = s.repository.SaveNews(ctx, news, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -325,7 +390,7 @@ func (s *NewsService) AdminCreateCategory(ctx context.Context, request CreateCat
 	}
 
 	// Save to repository
-	err := s.repository.SaveNewsCategory(ctx, category)
+	err := s.repository.SaveNewsCategory(ctx, category, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -4,40 +4,102 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/search"
 )
 
 // NewsRepository implements news data access using Dapr state store and bindings
 type NewsRepository struct {
-	stateStore *dapr.StateStore
-	bindings   *dapr.Bindings
-	pubsub     *dapr.PubSub
+	stateStore  *dapr.StateStore
+	bindings    *dapr.Bindings
+	pubsub      *dapr.PubSub
+	searchIndex *search.Index
+}
+
+// Secondary indexes maintained for news articles
+const (
+	newsIndexCategory         = "category"
+	newsIndexPublishingStatus = "publishing_status"
+	newsIndexSlug             = "slug"
+	newsIndexIsDeleted        = "is_deleted"
+	newsIndexPublicationMonth = "publication_month"
+)
+
+// NewsIndexes declares the secondary indexes maintained for news articles
+func NewsIndexes() *dapr.IndexedEntityType {
+	return &dapr.IndexedEntityType{
+		Domain:     "news",
+		EntityType: "news",
+		Indexes: []dapr.IndexDefinition{
+			{Name: newsIndexCategory, Extract: func(entity interface{}) []string {
+				return []string{entity.(*News).CategoryID}
+			}},
+			{Name: newsIndexPublishingStatus, Extract: func(entity interface{}) []string {
+				return []string{string(entity.(*News).PublishingStatus)}
+			}},
+			{Name: newsIndexSlug, Extract: func(entity interface{}) []string {
+				return []string{entity.(*News).Slug}
+			}},
+			{Name: newsIndexIsDeleted, Extract: func(entity interface{}) []string {
+				return []string{dapr.IndexBool(entity.(*News).IsDeleted)}
+			}},
+			{Name: newsIndexPublicationMonth, Extract: func(entity interface{}) []string {
+				return []string{dapr.IndexMonthBucket(entity.(*News).PublicationTimestamp)}
+			}},
+		},
+		NewEntity: func() interface{} { return &News{} },
+		EntityID:  func(entity interface{}) string { return entity.(*News).NewsID },
+		SortKey:   func(entity interface{}) time.Time { return entity.(*News).PageCursor().Timestamp },
+	}
 }
 
 // NewNewsRepository creates a new news repository with Dapr integration
 func NewNewsRepository(stateStore *dapr.StateStore, bindings *dapr.Bindings, pubsub *dapr.PubSub) NewsRepositoryInterface {
+	stateStore.MustRegisterIndexes(NewsIndexes())
+	stateStore.MustRegisterIndexes(dapr.OutboxMessageIndexes())
+
 	return &NewsRepository{
-		stateStore: stateStore,
-		bindings:   bindings,
-		pubsub:     pubsub,
+		stateStore:  stateStore,
+		bindings:    bindings,
+		pubsub:      pubsub,
+		searchIndex: newNewsSearchIndex(),
 	}
 }
 
 // News operations
 
-// SaveNews saves a news article to the state store
-func (r *NewsRepository) SaveNews(ctx context.Context, news *News) error {
-	key := r.stateStore.CreateKey("news", "news", news.NewsID)
-	
-	err := r.stateStore.Save(ctx, key, news, nil)
+// SaveNews saves a news article and its secondary indexes to the state store, together
+// with the audit event describing the change when one is given. When the article carries
+// an ETag the save only succeeds if the stored version still matches it.
+func (r *NewsRepository) SaveNews(ctx context.Context, news *News, audit *domain.AuditEvent) error {
+	messages, err := r.auditMessages(ctx, audit)
+	if err != nil {
+		return err
+	}
+
+	// Every audited change to an article is also kept as a revision for history and rollback
+	var revision *domain.Revision
+	if audit != nil && audit.EntityType == domain.EntityTypeNews && domain.IsRevisionedOperation(audit.OperationType) {
+		revision = domain.NewRevision(audit)
+	}
+
+	err = r.stateStore.SaveIndexedWithRevision(ctx, "news", "news", news.NewsID, news, news.ETag, revision, messages...)
 	if err != nil {
 		return fmt.Errorf("failed to save news %s: %w", news.NewsID, err)
 	}
 
+	// Track the version this save produced; it stays unknown if the read fails or a
+	// concurrent save has already replaced the article
+	key := r.stateStore.CreateKey("news", "news", news.NewsID)
+	if news.ETag, err = r.stateStore.WrittenETag(ctx, key, news); err != nil {
+		news.ETag = ""
+	}
+
 	return nil
 }
 
@@ -46,7 +108,7 @@ func (r *NewsRepository) GetNews(ctx context.Context, newsID string) (*News, err
 	key := r.stateStore.CreateKey("news", "news", newsID)
 	
 	var news News
-	found, err := r.stateStore.Get(ctx, key, &news)
+	found, etag, err := r.stateStore.GetWithETag(ctx, key, &news)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get news %s: %w", newsID, err)
 	}
@@ -55,152 +117,145 @@ func (r *NewsRepository) GetNews(ctx context.Context, newsID string) (*News, err
 		return nil, domain.NewNotFoundError("news", newsID)
 	}
 	
+	news.ETag = etag
 	return &news, nil
 }
 
-// GetNewsBySlug retrieves news by slug from state store
+// GetNewsBySlug retrieves news by slug using the slug index
 func (r *NewsRepository) GetNewsBySlug(ctx context.Context, slug string) (*News, error) {
-	// Query by slug using Dapr state store query
-	query := fmt.Sprintf(`{
-		"filter": {
-			"EQ": {"slug": "%s"}
-		}
-	}`, slug)
+	ids, err := r.stateStore.LookupIndex(ctx, "news", "news",
+		dapr.IndexCondition{Index: newsIndexSlug, Value: slug},
+		dapr.IndexCondition{Index: newsIndexIsDeleted, Value: dapr.IndexBool(false)},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up news by slug %s: %w", slug, err)
+	}
 
-	results, err := r.stateStore.Query(ctx, query)
+	newsList, err := r.getNewsByIDs(ctx, ids)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query news by slug %s: %w", slug, err)
+		return nil, err
 	}
 
-	for _, result := range results {
-		var news News
-		err = json.Unmarshal(result.Value, &news)
-		if err != nil {
-			continue
-		}
-		if !news.IsDeleted && news.Slug == slug {
-			return &news, nil
+	for _, news := range newsList {
+		if news.Slug == slug {
+			return news, nil
 		}
 	}
 
 	return nil, domain.NewNotFoundError("news", slug)
 }
 
-// GetAllNews retrieves all news from state store
+// GetAllNews retrieves all non-deleted news ordered by publication timestamp
 func (r *NewsRepository) GetAllNews(ctx context.Context) ([]*News, error) {
-	// For development, return empty list if no news exist yet
-	// This respects Dapr abstractions while providing functional API responses
-	
-	// Try to query state store, but handle gracefully if no data exists
-	query := `{
-		"filter": {
-			"EQ": {"is_deleted": false}
-		},
-		"sort": [
-			{
-				"key": "publication_timestamp",
-				"order": "DESC"
-			}
-		]
-	}`
+	ids, err := r.stateStore.LookupIndex(ctx, "news", "news",
+		dapr.IndexCondition{Index: newsIndexIsDeleted, Value: dapr.IndexBool(false)},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up all news: %w", err)
+	}
 
-	results, err := r.stateStore.Query(ctx, query)
+	return r.getNewsByIDs(ctx, ids)
+}
+
+// GetNewsByCategory retrieves news by category using the category index
+func (r *NewsRepository) GetNewsByCategory(ctx context.Context, categoryID string) ([]*News, error) {
+	ids, err := r.stateStore.LookupIndex(ctx, "news", "news",
+		dapr.IndexCondition{Index: newsIndexCategory, Value: categoryID},
+		dapr.IndexCondition{Index: newsIndexIsDeleted, Value: dapr.IndexBool(false)},
+	)
 	if err != nil {
-		// If query fails (no data setup yet), return empty list for development
-		return []*News{}, nil
+		return nil, fmt.Errorf("failed to look up news by category %s: %w", categoryID, err)
 	}
 
-	var newsList []*News
-	for _, result := range results {
-		var news News
-		err = json.Unmarshal(result.Value, &news)
-		if err != nil {
-			continue // Skip invalid records
-		}
-		if !news.IsDeleted {
-			newsList = append(newsList, &news)
-		}
+	return r.getNewsByIDs(ctx, ids)
+}
+
+// GetNewsByPublishingStatus retrieves news by publishing status using the status index
+func (r *NewsRepository) GetNewsByPublishingStatus(ctx context.Context, status PublishingStatus) ([]*News, error) {
+	ids, err := r.stateStore.LookupIndex(ctx, "news", "news",
+		dapr.IndexCondition{Index: newsIndexPublishingStatus, Value: string(status)},
+		dapr.IndexCondition{Index: newsIndexIsDeleted, Value: dapr.IndexBool(false)},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up news by status %s: %w", status, err)
 	}
 
-	return newsList, nil
+	return r.getNewsByIDs(ctx, ids)
 }
 
-// GetNewsByCategory retrieves news by category from state store
-func (r *NewsRepository) GetNewsByCategory(ctx context.Context, categoryID string) ([]*News, error) {
-	query := fmt.Sprintf(`{
-		"filter": {
-			"AND": [
-				{"EQ": {"category_id": "%s"}},
-				{"EQ": {"is_deleted": false}}
-			]
-		},
-		"sort": [
-			{
-				"key": "publication_timestamp", 
-				"order": "DESC"
-			}
-		]
-	}`, categoryID)
+// getNewsByIDs loads indexed news articles, newest publication first
+func (r *NewsRepository) getNewsByIDs(ctx context.Context, ids []string) ([]*News, error) {
+	newsList := make([]*News, 0, len(ids))
+	if len(ids) == 0 {
+		return newsList, nil
+	}
 
-	results, err := r.stateStore.Query(ctx, query)
-	if err != nil {
-		return nil, fmt.Errorf("failed to query news by category %s: %w", categoryID, err)
+	keys := make([]string, 0, len(ids))
+	targets := make(map[string]interface{}, len(ids))
+	loaded := make([]*News, 0, len(ids))
+	for _, id := range ids {
+		key := r.stateStore.CreateKey("news", "news", id)
+		news := &News{}
+		keys = append(keys, key)
+		targets[key] = news
+		loaded = append(loaded, news)
 	}
 
-	var newsList []*News
-	for _, result := range results {
-		var news News
-		err = json.Unmarshal(result.Value, &news)
-		if err != nil {
-			continue
-		}
-		if !news.IsDeleted && news.CategoryID == categoryID {
-			newsList = append(newsList, &news)
+	if err := r.stateStore.GetBulk(ctx, keys, targets); err != nil {
+		return nil, fmt.Errorf("failed to load indexed news: %w", err)
+	}
+
+	for _, news := range loaded {
+		if news.NewsID != "" && !news.IsDeleted {
+			newsList = append(newsList, news)
 		}
 	}
 
+	sort.SliceStable(newsList, func(i, j int) bool {
+		return newsList[i].PageCursor().Before(newsList[j].PageCursor())
+	})
+
 	return newsList, nil
 }
 
-// GetNewsByPublishingStatus retrieves news by publishing status
-func (r *NewsRepository) GetNewsByPublishingStatus(ctx context.Context, status PublishingStatus) ([]*News, error) {
-	query := fmt.Sprintf(`{
-		"filter": {
-			"AND": [
-				{"EQ": {"publishing_status": "%s"}},
-				{"EQ": {"is_deleted": false}}
-			]
-		},
-		"sort": [
-			{
-				"key": "publication_timestamp",
-				"order": "DESC"
-			}
-		]
-	}`, status)
+// ListNewsPage retrieves one page of non-deleted news matching the filter,
+// newest publication first, resuming after the page cursor
+func (r *NewsRepository) ListNewsPage(ctx context.Context, filter NewsListFilter, page domain.PageRequest) ([]*News, domain.PageInfo, error) {
+	conditions := []dapr.IndexCondition{
+		{Index: newsIndexIsDeleted, Value: dapr.IndexBool(false)},
+	}
+	if filter.CategoryID != "" {
+		conditions = append(conditions, dapr.IndexCondition{Index: newsIndexCategory, Value: filter.CategoryID})
+	}
+	if filter.PublishingStatus != "" {
+		conditions = append(conditions, dapr.IndexCondition{Index: newsIndexPublishingStatus, Value: string(filter.PublishingStatus)})
+	}
 
-	results, err := r.stateStore.Query(ctx, query)
+	ids, info, err := r.stateStore.LookupIndexPage(ctx, "news", "news", page, conditions...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query news by status %s: %w", status, err)
+		return nil, domain.PageInfo{}, fmt.Errorf("failed to look up news page: %w", err)
 	}
 
-	var newsList []*News
-	for _, result := range results {
-		var news News
-		err = json.Unmarshal(result.Value, &news)
-		if err != nil {
-			continue
-		}
-		if !news.IsDeleted && news.PublishingStatus == status {
-			newsList = append(newsList, &news)
-		}
+	newsList, err := r.getNewsByIDs(ctx, ids)
+	if err != nil {
+		return nil, domain.PageInfo{}, err
 	}
 
-	return newsList, nil
+	info.Count = len(newsList)
+	return newsList, info, nil
+}
+
+// PageNews slices a listing already ordered by PageCursor into the requested page
+func PageNews(newsList []*News, page domain.PageRequest) ([]*News, domain.PageInfo) {
+	start, end, info := domain.CursorPage(len(newsList), func(i int) domain.PageCursor {
+		return newsList[i].PageCursor()
+	}, page)
+
+	return newsList[start:end], info
 }
 
 // DeleteNews soft deletes news from state store
-func (r *NewsRepository) DeleteNews(ctx context.Context, newsID string, userID string) error {
+func (r *NewsRepository) DeleteNews(ctx context.Context, newsID string, userID string, audit *domain.AuditEvent) error {
 	// Get existing news
 	news, err := r.GetNews(ctx, newsID)
 	if err != nil {
@@ -213,45 +268,75 @@ func (r *NewsRepository) DeleteNews(ctx context.Context, newsID string, userID s
 	news.DeletedOn = &now
 	news.DeletedBy = userID
 
-	return r.SaveNews(ctx, news)
+	return r.SaveNews(ctx, news, audit)
 }
 
-// SearchNews searches news articles based on search term
+// SearchNews returns every non-deleted news article matching the search term, best match first
 func (r *NewsRepository) SearchNews(ctx context.Context, searchTerm string) ([]*News, error) {
-	// Get all non-deleted news first
-	allNews, err := r.GetAllNews(ctx)
+	if strings.TrimSpace(searchTerm) == "" {
+		return r.GetAllNews(ctx)
+	}
+
+	results, err := r.QueryNews(ctx, search.Query{Text: searchTerm, Limit: r.searchIndex.Len()})
 	if err != nil {
 		return nil, err
 	}
 
-	if searchTerm == "" {
-		return allNews, nil
+	return newsFromHits(results.Hits), nil
+}
+
+// QueryNews runs a full-text query against the news search index, building
+// the index from the state store on first use
+func (r *NewsRepository) QueryNews(ctx context.Context, query search.Query) (*search.Results, error) {
+	if err := r.searchIndex.EnsureLoaded(ctx, r.loadNewsSearchDocuments); err != nil {
+		return nil, err
 	}
 
-	// Simple text search in title, summary, and content
-	var results []*News
-	searchLower := strings.ToLower(searchTerm)
-	
-	for _, news := range allNews {
-		titleMatch := strings.Contains(strings.ToLower(news.Title), searchLower)
-		summaryMatch := strings.Contains(strings.ToLower(news.Summary), searchLower)
-		contentMatch := strings.Contains(strings.ToLower(news.Content), searchLower)
-		
-		if titleMatch || summaryMatch || contentMatch {
-			results = append(results, news)
-		}
+	return r.searchIndex.Search(query), nil
+}
+
+// ReindexNews refreshes the search document of one news article from the
+// state store, dropping it from the index once the article is deleted
+func (r *NewsRepository) ReindexNews(ctx context.Context, newsID string) error {
+	if err := r.searchIndex.EnsureLoaded(ctx, r.loadNewsSearchDocuments); err != nil {
+		return err
+	}
+
+	news, err := r.GetNews(ctx, newsID)
+	if domain.IsNotFoundError(err) {
+		r.searchIndex.Remove(newsID)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	r.searchIndex.Upsert(newsSearchDocument(news))
+	return nil
+}
+
+// loadNewsSearchDocuments loads every non-deleted news article for indexing
+func (r *NewsRepository) loadNewsSearchDocuments(ctx context.Context) ([]search.Document, error) {
+	newsList, err := r.GetAllNews(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	return results, nil
+	return newsSearchDocuments(newsList), nil
 }
 
 // News category operations
 
-// SaveNewsCategory saves a news category to state store
-func (r *NewsRepository) SaveNewsCategory(ctx context.Context, category *NewsCategory) error {
+// SaveNewsCategory saves a news category to state store together with its audit event
+func (r *NewsRepository) SaveNewsCategory(ctx context.Context, category *NewsCategory, audit *domain.AuditEvent) error {
+	messages, err := r.auditMessages(ctx, audit)
+	if err != nil {
+		return err
+	}
+
 	key := r.stateStore.CreateKey("news", "category", category.CategoryID)
 	
-	err := r.stateStore.Save(ctx, key, category, nil)
+	err = r.stateStore.SaveWithOutbox(ctx, key, category, messages...)
 	if err != nil {
 		return fmt.Errorf("failed to save news category %s: %w", category.CategoryID, err)
 	}
@@ -365,7 +450,7 @@ func (r *NewsRepository) GetDefaultUnassignedCategory(ctx context.Context) (*New
 }
 
 // DeleteNewsCategory soft deletes a news category
-func (r *NewsRepository) DeleteNewsCategory(ctx context.Context, categoryID string, userID string) error {
+func (r *NewsRepository) DeleteNewsCategory(ctx context.Context, categoryID string, userID string, audit *domain.AuditEvent) error {
 	// Get existing category
 	category, err := r.GetNewsCategory(ctx, categoryID)
 	if err != nil {
@@ -378,16 +463,21 @@ func (r *NewsRepository) DeleteNewsCategory(ctx context.Context, categoryID stri
 	category.DeletedOn = &now
 	category.DeletedBy = userID
 
-	return r.SaveNewsCategory(ctx, category)
+	return r.SaveNewsCategory(ctx, category, audit)
 }
 
 // Featured news operations
 
-// SaveFeaturedNews saves featured news to state store
-func (r *NewsRepository) SaveFeaturedNews(ctx context.Context, featured *FeaturedNews) error {
+// SaveFeaturedNews saves featured news to state store together with its audit event
+func (r *NewsRepository) SaveFeaturedNews(ctx context.Context, featured *FeaturedNews, audit *domain.AuditEvent) error {
+	messages, err := r.auditMessages(ctx, audit)
+	if err != nil {
+		return err
+	}
+
 	key := r.stateStore.CreateKey("news", "featured", featured.FeaturedNewsID)
 	
-	err := r.stateStore.Save(ctx, key, featured, nil)
+	err = r.stateStore.SaveWithOutbox(ctx, key, featured, messages...)
 	if err != nil {
 		return fmt.Errorf("failed to save featured news %s: %w", featured.FeaturedNewsID, err)
 	}
@@ -417,11 +507,16 @@ func (r *NewsRepository) GetFeaturedNews(ctx context.Context) (*FeaturedNews, er
 	return nil, domain.NewNotFoundError("featured news", "")
 }
 
-// DeleteFeaturedNews removes featured news
-func (r *NewsRepository) DeleteFeaturedNews(ctx context.Context, featuredNewsID string) error {
+// DeleteFeaturedNews removes featured news together with recording its audit event
+func (r *NewsRepository) DeleteFeaturedNews(ctx context.Context, featuredNewsID string, audit *domain.AuditEvent) error {
+	messages, err := r.auditMessages(ctx, audit)
+	if err != nil {
+		return err
+	}
+
 	key := r.stateStore.CreateKey("news", "featured", featuredNewsID)
 	
-	err := r.stateStore.Delete(ctx, key, nil)
+	err = r.stateStore.DeleteWithOutbox(ctx, key, messages...)
 	if err != nil {
 		return fmt.Errorf("failed to delete featured news %s: %w", featuredNewsID, err)
 	}
@@ -431,15 +526,16 @@ func (r *NewsRepository) DeleteFeaturedNews(ctx context.Context, featuredNewsID
 
 // Audit operations
 
-// PublishAuditEvent publishes audit event to Grafana Loki via Dapr
-func (r *NewsRepository) PublishAuditEvent(ctx context.Context, entityType domain.EntityType, entityID string, operationType domain.AuditEventType, userID string, beforeData, afterData interface{}) error {
-	correlationID := domain.GetCorrelationID(ctx)
-	traceID := domain.GetTraceID(ctx)
-	
-	auditEvent := domain.NewAuditEvent(entityType, entityID, operationType, userID)
-	auditEvent.SetTraceContext(correlationID, traceID)
+// auditMessages prepares an audit event for Grafana Loki to be committed through the outbox
+// with the write it describes; the outbox relay publishes it via Dapr. A nil event
+// prepares nothing.
+func (r *NewsRepository) auditMessages(ctx context.Context, auditEvent *domain.AuditEvent) ([]*dapr.OutboxMessage, error) {
+	if auditEvent == nil {
+		return nil, nil
+	}
+
+	auditEvent.SetTraceContext(domain.GetCorrelationID(ctx), domain.GetTraceID(ctx))
 	auditEvent.SetEnvironmentContext("development", "news-api-1.0.0")
-	auditEvent.SetDataSnapshot(beforeData, afterData)
 
 	// Convert domain.AuditEvent to dapr.AuditEvent
 	daprAuditEvent := &dapr.AuditEvent{
@@ -464,12 +560,34 @@ func (r *NewsRepository) PublishAuditEvent(ctx context.Context, entityType domai
 		}
 	}
 
-	err := r.pubsub.PublishAuditEvent(ctx, daprAuditEvent)
+	message, err := r.pubsub.AuditEventMessage(daprAuditEvent)
 	if err != nil {
-		return fmt.Errorf("failed to publish audit event for %s %s: %w", entityType, entityID, err)
+		return nil, fmt.Errorf("failed to prepare audit event for %s %s: %w", auditEvent.EntityType, auditEvent.EntityID, err)
 	}
 
-	return nil
+	return []*dapr.OutboxMessage{dapr.NewOutboxMessage(ctx, message.Topic, message)}, nil
+}
+
+// Revision operations
+
+// ListNewsRevisions returns the revision summaries of a news article, newest first
+func (r *NewsRepository) ListNewsRevisions(ctx context.Context, newsID string) ([]*domain.Revision, error) {
+	revisions, err := r.stateStore.ListRevisions(ctx, "news", "news", newsID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list revisions for news %s: %w", newsID, err)
+	}
+
+	return revisions, nil
+}
+
+// GetNewsRevision retrieves one revision of a news article including its data snapshot
+func (r *NewsRepository) GetNewsRevision(ctx context.Context, newsID string, revision int) (*domain.Revision, error) {
+	return r.stateStore.GetRevision(ctx, "news", "news", newsID, revision)
+}
+
+// PublishReviewEvent notifies reviewers and authors about an article's review
+func (r *NewsRepository) PublishReviewEvent(ctx context.Context, event *domain.ReviewEvent) error {
+	return r.pubsub.PublishReviewEvent(ctx, event)
 }
 
 // GetNewsAudit retrieves audit events for news via Dapr bindings
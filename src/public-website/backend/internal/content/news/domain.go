@@ -34,6 +34,8 @@ const (
 
 const (
 	PublishingStatusDraft     PublishingStatus = "draft"
+	PublishingStatusInReview  PublishingStatus = "in_review"
+	PublishingStatusApproved  PublishingStatus = "approved"
 	PublishingStatusPublished PublishingStatus = "published"
 	PublishingStatusArchived  PublishingStatus = "archived"
 )
@@ -61,7 +63,7 @@ func (p PriorityLevel) IsValid() bool {
 // IsValid checks if the publishing status is valid
 func (s PublishingStatus) IsValid() bool {
 	switch s {
-	case PublishingStatusDraft, PublishingStatusPublished, PublishingStatusArchived:
+	case PublishingStatusDraft, PublishingStatusInReview, PublishingStatusApproved, PublishingStatusPublished, PublishingStatusArchived:
 		return true
 	default:
 		return false
@@ -92,6 +94,11 @@ type News struct {
 	IsDeleted           bool             `json:"is_deleted"`
 	DeletedOn           *time.Time       `json:"deleted_on,omitempty"`
 	DeletedBy           string           `json:"deleted_by,omitempty"`
+	Review              *domain.EditorialReview `json:"review,omitempty"`
+
+	// ETag is the state store version the article was loaded at, used for
+	// optimistic concurrency; it travels in HTTP headers, not the body
+	ETag string `json:"-"`
 }
 
 // NewsCategory represents news categories matching TABLES-NEWS.md
@@ -120,6 +127,16 @@ type FeaturedNews struct {
 	ModifiedBy     string     `json:"modified_by,omitempty"`
 }
 
+// NewsListFilter narrows a paged news listing; empty fields are not applied
+type NewsListFilter struct {
+	CategoryID       string
+	PublishingStatus PublishingStatus
+}
+
+// PageCursor returns the position of the article in paged news listings
+func (n *News) PageCursor() domain.PageCursor {
+	return domain.PageCursor{Timestamp: n.PublicationTimestamp, ID: n.NewsID}
+}
 
 // News validation methods
 
@@ -217,6 +234,11 @@ func (n *News) CanBePublished() error {
 	return nil
 }
 
+// ContentReview describes where the article stands in the editorial workflow
+func (n *News) ContentReview() *domain.ContentReview {
+	return domain.NewContentReview(domain.EntityTypeNews, n.NewsID, n.Title, domain.WorkflowStatus(n.PublishingStatus), n.Review)
+}
+
 // NewsCategory validation methods
 
 func (nc *NewsCategory) Validate() error {
@@ -302,6 +324,8 @@ func getValidPriorityLevels() []string {
 func getValidPublishingStatuses() []string {
 	return []string{
 		string(PublishingStatusDraft),
+		string(PublishingStatusInReview),
+		string(PublishingStatusApproved),
 		string(PublishingStatusPublished),
 		string(PublishingStatusArchived),
 	}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/search"
 	"github.com/gorilla/mux"
 )
 
@@ -26,21 +27,26 @@ func NewNewsHandler(service *NewsService) *NewsHandler {
 func (h *NewsHandler) RegisterRoutes(router *mux.Router) {
 	// Public GET endpoints
 	router.HandleFunc("/api/v1/news", h.GetAllNews).Methods("GET")
+	router.HandleFunc("/api/v1/news/search", h.SearchNews).Methods("GET")
 	router.HandleFunc("/api/v1/news/{id}", h.GetNews).Methods("GET")
 	router.HandleFunc("/api/v1/news/slug/{slug}", h.GetNewsBySlug).Methods("GET")
 	router.HandleFunc("/api/v1/news/featured", h.GetFeaturedNews).Methods("GET")
 	router.HandleFunc("/api/v1/news/categories", h.GetAllNewsCategories).Methods("GET")
 	router.HandleFunc("/api/v1/news/categories/{id}/news", h.GetNewsByCategory).Methods("GET")
-	router.HandleFunc("/api/v1/news/search", h.SearchNews).Methods("GET")
 	
 	// Admin endpoints - will be handled by admin gateway
 	// News CRUD operations
 	router.HandleFunc("/admin/api/v1/news", h.CreateNews).Methods("POST")
+	router.HandleFunc("/admin/api/v1/news/{id}", h.GetAdminNews).Methods("GET")
 	router.HandleFunc("/admin/api/v1/news/{id}", h.UpdateNews).Methods("PUT")
 	router.HandleFunc("/admin/api/v1/news/{id}", h.DeleteNews).Methods("DELETE")
 	router.HandleFunc("/admin/api/v1/news/{id}/publish", h.PublishNews).Methods("POST")
 	router.HandleFunc("/admin/api/v1/news/{id}/archive", h.ArchiveNews).Methods("POST")
 	router.HandleFunc("/admin/api/v1/news/{id}/audit", h.GetNewsAudit).Methods("GET")
+	router.HandleFunc("/admin/api/v1/news/{id}/revisions", h.GetNewsRevisions).Methods("GET")
+	router.HandleFunc("/admin/api/v1/news/{id}/revisions/diff", h.DiffNewsRevisions).Methods("GET")
+	router.HandleFunc("/admin/api/v1/news/{id}/revisions/{revision:[0-9]+}", h.GetNewsRevision).Methods("GET")
+	router.HandleFunc("/admin/api/v1/news/{id}/revisions/{revision:[0-9]+}/rollback", h.RollbackNews).Methods("POST")
 	// News category CRUD operations
 	router.HandleFunc("/admin/api/v1/news/categories", h.CreateNewsCategory).Methods("POST")
 	router.HandleFunc("/admin/api/v1/news/categories/{id}", h.UpdateNewsCategory).Methods("PUT")
@@ -68,15 +74,28 @@ func (h *NewsHandler) GetAllNews(w http.ResponseWriter, r *http.Request) {
 	// Check for search parameter
 	searchTerm := r.URL.Query().Get("search")
 	
-	var newsList []*News
-	var err error
-	
 	if searchTerm != "" {
-		newsList, err = h.service.SearchNews(ctx, searchTerm, userID)
-	} else {
-		newsList, err = h.service.GetAllNews(ctx, userID)
+		newsList, err := h.service.SearchNews(ctx, searchTerm, userID)
+		if err != nil {
+			h.handleError(w, r, err)
+			return
+		}
+
+		h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
+			"news":           newsList,
+			"count":          len(newsList),
+			"correlation_id": correlationCtx.CorrelationID,
+		})
+		return
 	}
-	
+
+	page, err := h.extractPageRequest(r)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	newsList, pageInfo, err := h.service.ListNewsPage(ctx, NewsListFilter{}, page, userID)
 	if err != nil {
 		h.handleError(w, r, err)
 		return
@@ -85,6 +104,7 @@ func (h *NewsHandler) GetAllNews(w http.ResponseWriter, r *http.Request) {
 	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
 		"news":           newsList,
 		"count":          len(newsList),
+		"pagination":     pageInfo,
 		"correlation_id": correlationCtx.CorrelationID,
 	})
 }
@@ -204,7 +224,13 @@ func (h *NewsHandler) GetNewsByCategory(w http.ResponseWriter, r *http.Request)
 	correlationCtx.SetUserContext(userID, "news-api-1.0.0")
 	ctx = correlationCtx.ToContext(ctx)
 
-	newsList, err := h.service.GetNewsByCategory(ctx, categoryID, userID)
+	page, err := h.extractPageRequest(r)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	newsList, pageInfo, err := h.service.ListNewsPage(ctx, NewsListFilter{CategoryID: categoryID}, page, userID)
 	if err != nil {
 		h.handleError(w, r, err)
 		return
@@ -214,6 +240,7 @@ func (h *NewsHandler) GetNewsByCategory(w http.ResponseWriter, r *http.Request)
 		"news":           newsList,
 		"count":          len(newsList),
 		"category_id":    categoryID,
+		"pagination":     pageInfo,
 		"correlation_id": correlationCtx.CorrelationID,
 	})
 }
@@ -231,16 +258,29 @@ func (h *NewsHandler) SearchNews(w http.ResponseWriter, r *http.Request) {
 	correlationCtx.SetUserContext(userID, "news-api-1.0.0")
 	ctx = correlationCtx.ToContext(ctx)
 
-	results, err := h.service.SearchNews(ctx, searchTerm, userID)
+	query, err := search.ParseQuery(r.URL.Query(), newsPublicFacets)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	results, err := h.service.QueryPublishedNews(ctx, query, userID)
 	if err != nil {
 		h.handleError(w, r, err)
 		return
 	}
 
 	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
-		"news":           results,
-		"count":          len(results),
-		"search_term":    searchTerm,
+		"news":        newsFromHits(results.Hits),
+		"hits":        results.Hits,
+		"facets":      results.Facets,
+		"count":       len(results.Hits),
+		"total":       results.Total,
+		"search_term": searchTerm,
+		"pagination": map[string]interface{}{
+			"limit":  results.Limit,
+			"offset": results.Offset,
+		},
 		"correlation_id": correlationCtx.CorrelationID,
 	})
 }
@@ -284,6 +324,110 @@ func (h *NewsHandler) GetNewsAudit(w http.ResponseWriter, r *http.Request) {
 	h.writeJSONResponse(w, http.StatusOK, response)
 }
 
+// GetNewsRevisions handles GET /admin/api/v1/news/{id}/revisions
+func (h *NewsHandler) GetNewsRevisions(w http.ResponseWriter, r *http.Request) {
+	newsID := mux.Vars(r)["id"]
+	userID := r.Header.Get("X-User-ID")
+
+	revisions, err := h.service.GetNewsRevisions(r.Context(), newsID, userID)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
+		"revisions": revisions,
+		"count":     len(revisions),
+	})
+}
+
+// GetNewsRevision handles GET /admin/api/v1/news/{id}/revisions/{revision}
+func (h *NewsHandler) GetNewsRevision(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	userID := r.Header.Get("X-User-ID")
+
+	number, err := domain.ParseRevisionNumber("revision", vars["revision"])
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	revision, err := h.service.GetNewsRevision(r.Context(), vars["id"], number, userID)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
+		"revision": revision,
+	})
+}
+
+// DiffNewsRevisions handles GET /admin/api/v1/news/{id}/revisions/diff?from={revision}&to={revision}
+func (h *NewsHandler) DiffNewsRevisions(w http.ResponseWriter, r *http.Request) {
+	newsID := mux.Vars(r)["id"]
+	userID := r.Header.Get("X-User-ID")
+	query := r.URL.Query()
+
+	from, err := domain.ParseRevisionNumber("from", query.Get("from"))
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+	to, err := domain.ParseRevisionNumber("to", query.Get("to"))
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	diff, err := h.service.DiffNewsRevisions(r.Context(), newsID, from, to, userID)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
+		"diff": diff,
+	})
+}
+
+// RollbackNews handles POST /admin/api/v1/news/{id}/revisions/{revision}/rollback
+func (h *NewsHandler) RollbackNews(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	// Extract user ID from header
+	userID := r.Header.Get("X-User-ID")
+	if userID == "" {
+		h.writeErrorResponse(w, http.StatusUnauthorized, "User ID is required")
+		return
+	}
+
+	number, err := domain.ParseRevisionNumber("revision", vars["revision"])
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	// Add correlation context
+	correlationCtx := domain.FromContext(ctx)
+	correlationCtx.SetUserContext(userID, "news-admin-api-1.0.0")
+	ctx = correlationCtx.ToContext(ctx)
+
+	news, err := h.service.RollbackNews(ctx, vars["id"], number, domain.ParseIfMatch(r.Header.Get("If-Match")), userID)
+	if err != nil {
+		h.handleUpdateError(w, r, err)
+		return
+	}
+
+	h.setETagHeader(w, news.ETag)
+	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
+		"news":           news,
+		"restored_from":  number,
+		"correlation_id": correlationCtx.CorrelationID,
+	})
+}
+
 // GetNewsCategoryAudit handles GET /admin/api/v1/news/categories/{id}/audit
 func (h *NewsHandler) GetNewsCategoryAudit(w http.ResponseWriter, r *http.Request) {
 	// Extract category ID from URL path
@@ -409,6 +553,30 @@ func (h *NewsHandler) handleError(w http.ResponseWriter, r *http.Request, err er
 	h.writeJSONResponse(w, statusCode, errorResponse)
 }
 
+// handleUpdateError reports a conflicting concurrent edit as 412 Precondition Failed,
+// since the editor's If-Match no longer names the current version
+func (h *NewsHandler) handleUpdateError(w http.ResponseWriter, r *http.Request, err error) {
+	if !domain.IsConflictError(err) {
+		h.handleError(w, r, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusPreconditionFailed, map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":           "PRECONDITION_FAILED",
+			"message":        err.Error(),
+			"correlation_id": domain.GetCorrelationID(r.Context()),
+		},
+	})
+}
+
+// setETagHeader exposes the entity version for conditional updates
+func (h *NewsHandler) setETagHeader(w http.ResponseWriter, etag string) {
+	if etag != "" {
+		w.Header().Set("ETag", domain.FormatETag(etag))
+	}
+}
+
 // writeJSONResponse writes a JSON response with proper headers
 func (h *NewsHandler) writeJSONResponse(w http.ResponseWriter, statusCode int, data interface{}) {
 	w.Header().Set("Content-Type", "application/json")
@@ -460,6 +628,20 @@ func (h *NewsHandler) extractPaginationParams(r *http.Request) (limit int, offse
 	return limit, offset
 }
 
+// extractPageRequest extracts cursor pagination parameters from request
+func (h *NewsHandler) extractPageRequest(r *http.Request) (domain.PageRequest, error) {
+	limit := 0
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		l, err := strconv.Atoi(limitStr)
+		if err != nil {
+			return domain.PageRequest{}, domain.NewValidationFieldError("limit", "limit must be a number")
+		}
+		limit = l
+	}
+
+	return domain.NewPageRequest(r.URL.Query().Get("cursor"), limit)
+}
+
 // handleServiceError handles service errors (alias for handleError for consistency)
 func (h *NewsHandler) handleServiceError(w http.ResponseWriter, err error) {
 	h.handleError(w, &http.Request{}, err)
@@ -502,6 +684,38 @@ func (h *NewsHandler) CreateNews(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// GetAdminNews handles GET /admin/api/v1/news/{id}, returning the article in any
+// publishing state along with the ETag to send back as If-Match when updating it
+func (h *NewsHandler) GetAdminNews(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+	newsID := vars["id"]
+
+	// Extract user ID from header
+	userID := r.Header.Get("X-User-ID")
+	if userID == "" {
+		h.writeErrorResponse(w, http.StatusUnauthorized, "User ID is required")
+		return
+	}
+
+	// Add correlation context
+	correlationCtx := domain.FromContext(ctx)
+	correlationCtx.SetUserContext(userID, "news-admin-api-1.0.0")
+	ctx = correlationCtx.ToContext(ctx)
+
+	news, err := h.service.AdminGetNews(ctx, newsID, userID)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	h.setETagHeader(w, news.ETag)
+	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
+		"news":           news,
+		"correlation_id": correlationCtx.CorrelationID,
+	})
+}
+
 // UpdateNews handles PUT /admin/api/v1/news/{id}
 func (h *NewsHandler) UpdateNews(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -532,15 +746,17 @@ func (h *NewsHandler) UpdateNews(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Ensure the news ID matches the URL parameter
+	// Ensure the news ID matches the URL parameter and carry the version being edited
 	news.NewsID = newsID
+	news.ETag = domain.ParseIfMatch(r.Header.Get("If-Match"))
 
 	// Update news through service
 	if err := h.service.UpdateNews(ctx, &news, userID); err != nil {
-		h.handleError(w, r, err)
+		h.handleUpdateError(w, r, err)
 		return
 	}
 
+	h.setETagHeader(w, news.ETag)
 	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
 		"news":           &news,
 		"correlation_id": correlationCtx.CorrelationID,
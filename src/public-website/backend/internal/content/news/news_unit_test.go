@@ -2,11 +2,14 @@ package news
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/search"
 	sharedtesting "github.com/axiom-software-co/international-center/src/backend/internal/shared/testing"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -623,7 +626,11 @@ type MockNewsRepository struct {
 	categories         map[string]*NewsCategory  
 	featuredNews       map[string]*FeaturedNews
 	auditEvents        []MockAuditEvent
+	reindexed          []string
 	failures           map[string]error
+	revisions          map[string][]*domain.Revision
+	reviewEvents       []*domain.ReviewEvent
+	saves              int
 }
 
 type MockAuditEvent struct {
@@ -642,6 +649,7 @@ func NewMockNewsRepository() *MockNewsRepository {
 		featuredNews:       make(map[string]*FeaturedNews),
 		auditEvents:        make([]MockAuditEvent, 0),
 		failures:           make(map[string]error),
+		revisions:          make(map[string][]*domain.Revision),
 	}
 }
 
@@ -656,11 +664,17 @@ func (m *MockNewsRepository) GetAuditEvents() []MockAuditEvent {
 }
 
 // News repository methods - these will fail until implemented in GREEN phase
-func (m *MockNewsRepository) SaveNews(ctx context.Context, news *News) error {
+func (m *MockNewsRepository) SaveNews(ctx context.Context, news *News, audit *domain.AuditEvent) error {
 	if err, exists := m.failures["SaveNews"]; exists {
 		return err
 	}
+	if stored, exists := m.news[news.NewsID]; exists && news.ETag != "" && stored.ETag != news.ETag {
+		return domain.NewConflictError("news was modified concurrently")
+	}
+	m.saves++
+	news.ETag = fmt.Sprintf("etag-%d", m.saves)
 	m.news[news.NewsID] = news
+	m.recordAudit(audit)
 	return nil
 }
 
@@ -726,7 +740,31 @@ func (m *MockNewsRepository) GetNewsByPublishingStatus(ctx context.Context, stat
 	return newsList, nil
 }
 
-func (m *MockNewsRepository) DeleteNews(ctx context.Context, newsID string, userID string) error {
+func (m *MockNewsRepository) ListNewsPage(ctx context.Context, filter NewsListFilter, page domain.PageRequest) ([]*News, domain.PageInfo, error) {
+	if err, exists := m.failures["ListNewsPage"]; exists {
+		return nil, domain.PageInfo{}, err
+	}
+	newsList := make([]*News, 0)
+	for _, news := range m.news {
+		if news.IsDeleted {
+			continue
+		}
+		if filter.CategoryID != "" && news.CategoryID != filter.CategoryID {
+			continue
+		}
+		if filter.PublishingStatus != "" && news.PublishingStatus != filter.PublishingStatus {
+			continue
+		}
+		newsList = append(newsList, news)
+	}
+	sort.Slice(newsList, func(i, j int) bool {
+		return newsList[i].PageCursor().Before(newsList[j].PageCursor())
+	})
+	pageItems, info := PageNews(newsList, page)
+	return pageItems, info, nil
+}
+
+func (m *MockNewsRepository) DeleteNews(ctx context.Context, newsID string, userID string, audit *domain.AuditEvent) error {
 	if err, exists := m.failures["DeleteNews"]; exists {
 		return err
 	}
@@ -738,6 +776,7 @@ func (m *MockNewsRepository) DeleteNews(ctx context.Context, newsID string, user
 	news.IsDeleted = true
 	news.DeletedOn = &now
 	news.DeletedBy = userID
+	m.recordAudit(audit)
 	return nil
 }
 
@@ -759,12 +798,34 @@ func (m *MockNewsRepository) SearchNews(ctx context.Context, searchTerm string)
 	return results, nil
 }
 
+func (m *MockNewsRepository) QueryNews(ctx context.Context, query search.Query) (*search.Results, error) {
+	if err, exists := m.failures["QueryNews"]; exists {
+		return nil, err
+	}
+	index := newNewsSearchIndex()
+	for _, news := range m.news {
+		if !news.IsDeleted {
+			index.Upsert(newsSearchDocument(news))
+		}
+	}
+	return index.Search(query), nil
+}
+
+func (m *MockNewsRepository) ReindexNews(ctx context.Context, newsID string) error {
+	if err, exists := m.failures["ReindexNews"]; exists {
+		return err
+	}
+	m.reindexed = append(m.reindexed, newsID)
+	return nil
+}
+
 // News category repository methods
-func (m *MockNewsRepository) SaveNewsCategory(ctx context.Context, category *NewsCategory) error {
+func (m *MockNewsRepository) SaveNewsCategory(ctx context.Context, category *NewsCategory, audit *domain.AuditEvent) error {
 	if err, exists := m.failures["SaveNewsCategory"]; exists {
 		return err
 	}
 	m.categories[category.CategoryID] = category
+	m.recordAudit(audit)
 	return nil
 }
 
@@ -816,7 +877,7 @@ func (m *MockNewsRepository) GetDefaultUnassignedCategory(ctx context.Context) (
 	return nil, domain.NewNotFoundError("default unassigned news category", "")
 }
 
-func (m *MockNewsRepository) DeleteNewsCategory(ctx context.Context, categoryID string, userID string) error {
+func (m *MockNewsRepository) DeleteNewsCategory(ctx context.Context, categoryID string, userID string, audit *domain.AuditEvent) error {
 	if err, exists := m.failures["DeleteNewsCategory"]; exists {
 		return err
 	}
@@ -828,15 +889,17 @@ func (m *MockNewsRepository) DeleteNewsCategory(ctx context.Context, categoryID
 	category.IsDeleted = true
 	category.DeletedOn = &now
 	category.DeletedBy = userID
+	m.recordAudit(audit)
 	return nil
 }
 
 // Featured news repository methods
-func (m *MockNewsRepository) SaveFeaturedNews(ctx context.Context, featured *FeaturedNews) error {
+func (m *MockNewsRepository) SaveFeaturedNews(ctx context.Context, featured *FeaturedNews, audit *domain.AuditEvent) error {
 	if err, exists := m.failures["SaveFeaturedNews"]; exists {
 		return err
 	}
 	m.featuredNews[featured.FeaturedNewsID] = featured
+	m.recordAudit(audit)
 	return nil
 }
 
@@ -850,11 +913,12 @@ func (m *MockNewsRepository) GetFeaturedNews(ctx context.Context) (*FeaturedNews
 	return nil, domain.NewNotFoundError("featured news", "")
 }
 
-func (m *MockNewsRepository) DeleteFeaturedNews(ctx context.Context, featuredNewsID string) error {
+func (m *MockNewsRepository) DeleteFeaturedNews(ctx context.Context, featuredNewsID string, audit *domain.AuditEvent) error {
 	if err, exists := m.failures["DeleteFeaturedNews"]; exists {
 		return err
 	}
 	delete(m.featuredNews, featuredNewsID)
+	m.recordAudit(audit)
 	return nil
 }
 
@@ -901,23 +965,58 @@ func (m *MockNewsRepository) GetNewsCategoryAudit(ctx context.Context, categoryI
 	return events, nil
 }
 
-// Audit event publishing method
-func (m *MockNewsRepository) PublishAuditEvent(ctx context.Context, entityType domain.EntityType, entityID string, operationType domain.AuditEventType, userID string, beforeData, afterData interface{}) error {
-	if err, exists := m.failures["PublishAuditEvent"]; exists {
-		return err
+// recordAudit keeps the audit event a write committed, in place of the outbox
+func (m *MockNewsRepository) recordAudit(audit *domain.AuditEvent) {
+	if audit == nil {
+		return
 	}
-	// Record the audit event in memory for testing
 	m.auditEvents = append(m.auditEvents, MockAuditEvent{
-		EntityType:    entityType,
-		EntityID:      entityID,
-		OperationType: operationType,
-		UserID:        userID,
-		Before:        beforeData,
-		After:         afterData,
+		EntityType:    audit.EntityType,
+		EntityID:      audit.EntityID,
+		OperationType: audit.OperationType,
+		UserID:        audit.UserID,
+		Before:        audit.DataSnapshot.Before,
+		After:         audit.DataSnapshot.After,
 	})
+}
+
+func (m *MockNewsRepository) PublishReviewEvent(ctx context.Context, event *domain.ReviewEvent) error {
+	if err, exists := m.failures["PublishReviewEvent"]; exists {
+		return err
+	}
+	m.reviewEvents = append(m.reviewEvents, event)
 	return nil
 }
 
+// AddRevision records a numbered revision of a news article holding its content after the change
+func (m *MockNewsRepository) AddRevision(news *News, operationType domain.AuditEventType) {
+	revision := domain.NewRevision(domain.NewAuditEvent(domain.EntityTypeNews, news.NewsID, operationType, "admin-1"))
+	revision.Number = len(m.revisions[news.NewsID]) + 1
+	revision.DataSnapshot = &domain.AuditDataSnapshot{After: news}
+	m.revisions[news.NewsID] = append(m.revisions[news.NewsID], revision)
+}
+
+func (m *MockNewsRepository) ListNewsRevisions(ctx context.Context, newsID string) ([]*domain.Revision, error) {
+	if err, exists := m.failures["ListNewsRevisions"]; exists {
+		return nil, err
+	}
+	revisions := make([]*domain.Revision, 0, len(m.revisions[newsID]))
+	for i := len(m.revisions[newsID]) - 1; i >= 0; i-- {
+		revisions = append(revisions, m.revisions[newsID][i].Summary())
+	}
+	return revisions, nil
+}
+
+func (m *MockNewsRepository) GetNewsRevision(ctx context.Context, newsID string, revision int) (*domain.Revision, error) {
+	if err, exists := m.failures["GetNewsRevision"]; exists {
+		return nil, err
+	}
+	if revision < 1 || revision > len(m.revisions[newsID]) {
+		return nil, domain.NewNotFoundError("revision", fmt.Sprintf("%s@%d", newsID, revision))
+	}
+	return m.revisions[newsID][revision-1], nil
+}
+
 // Unit tests for News Service - RED PHASE (will fail until GREEN phase implementation)
 
 func TestNewsService_GetNews(t *testing.T) {
@@ -1089,6 +1188,114 @@ func TestNewsService_GetAllNews(t *testing.T) {
 	}
 }
 
+func TestNewsService_ListNewsPage(t *testing.T) {
+	ctx, cancel := sharedtesting.CreateUnitTestContext()
+	defer cancel()
+
+	base := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+	seed := func(repo *MockNewsRepository) {
+		for i, id := range []string{
+			"550e8400-e29b-41d4-a716-446655440001",
+			"550e8400-e29b-41d4-a716-446655440002",
+			"550e8400-e29b-41d4-a716-446655440003",
+		} {
+			repo.news[id] = &News{
+				NewsID:               id,
+				CategoryID:           "550e8400-e29b-41d4-a716-446655440010",
+				PublishingStatus:     PublishingStatusPublished,
+				PublicationTimestamp: base.Add(time.Duration(i) * time.Hour),
+			}
+		}
+		repo.news["550e8400-e29b-41d4-a716-446655440004"] = &News{
+			NewsID:               "550e8400-e29b-41d4-a716-446655440004",
+			CategoryID:           "550e8400-e29b-41d4-a716-446655440011",
+			PublishingStatus:     PublishingStatusDraft,
+			PublicationTimestamp: base,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		filter      NewsListFilter
+		limit       int
+		wantIDs     []string
+		wantHasMore bool
+		wantErr     bool
+	}{
+		{
+			name:        "first page is newest first",
+			filter:      NewsListFilter{PublishingStatus: PublishingStatusPublished},
+			limit:       2,
+			wantIDs:     []string{"550e8400-e29b-41d4-a716-446655440003", "550e8400-e29b-41d4-a716-446655440002"},
+			wantHasMore: true,
+		},
+		{
+			name:    "filter by category",
+			filter:  NewsListFilter{CategoryID: "550e8400-e29b-41d4-a716-446655440011"},
+			limit:   2,
+			wantIDs: []string{"550e8400-e29b-41d4-a716-446655440004"},
+		},
+		{
+			name:    "reject invalid publishing status",
+			filter:  NewsListFilter{PublishingStatus: "unknown"},
+			limit:   2,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockRepo := NewMockNewsRepository()
+			seed(mockRepo)
+			service := NewNewsService(mockRepo)
+			page, err := domain.NewPageRequest("", tt.limit)
+			require.NoError(t, err)
+
+			// Act
+			result, info, err := service.ListNewsPage(ctx, tt.filter, page, "550e8400-e29b-41d4-a716-446655440004")
+
+			// Assert
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.True(t, domain.IsValidationError(err))
+				return
+			}
+			require.NoError(t, err)
+			ids := make([]string, 0, len(result))
+			for _, news := range result {
+				ids = append(ids, news.NewsID)
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+			assert.Equal(t, tt.wantHasMore, info.HasMore)
+			assert.Equal(t, tt.wantHasMore, info.NextCursor != "")
+		})
+	}
+
+	t.Run("next cursor resumes after previous page", func(t *testing.T) {
+		// Arrange
+		mockRepo := NewMockNewsRepository()
+		seed(mockRepo)
+		service := NewNewsService(mockRepo)
+		filter := NewsListFilter{PublishingStatus: PublishingStatusPublished}
+		first, err := domain.NewPageRequest("", 2)
+		require.NoError(t, err)
+		_, firstInfo, err := service.ListNewsPage(ctx, filter, first, "")
+		require.NoError(t, err)
+
+		// Act
+		next, err := domain.NewPageRequest(firstInfo.NextCursor, 2)
+		require.NoError(t, err)
+		result, info, err := service.ListNewsPage(ctx, filter, next, "")
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, "550e8400-e29b-41d4-a716-446655440001", result[0].NewsID)
+		assert.False(t, info.HasMore)
+	})
+}
+
 func TestNewsService_SearchNews(t *testing.T) {
 	ctx, cancel := sharedtesting.CreateUnitTestContext()
 		defer cancel()
@@ -1178,6 +1385,124 @@ func TestNewsService_SearchNews(t *testing.T) {
 	}
 }
 
+func TestNewsService_QueryPublishedNews(t *testing.T) {
+	ctx, cancel := sharedtesting.CreateUnitTestContext()
+	defer cancel()
+
+	setup := func(repo *MockNewsRepository) {
+		repo.news["news-1"] = &News{
+			NewsID:           "news-1",
+			Title:            "Clinic expansion announced",
+			Summary:          "Two new clinics open this spring",
+			Tags:             []string{"clinics", "expansion"},
+			NewsType:         NewsTypeAnnouncement,
+			PublishingStatus: PublishingStatusPublished,
+		}
+		repo.news["news-2"] = &News{
+			NewsID:           "news-2",
+			Title:            "Quarterly update",
+			Summary:          "Our clinic teams reached more patients",
+			Tags:             []string{"updates"},
+			NewsType:         NewsTypeUpdate,
+			PublishingStatus: PublishingStatusPublished,
+		}
+		repo.news["news-3"] = &News{
+			NewsID:           "news-3",
+			Title:            "Clinic draft",
+			PublishingStatus: PublishingStatusDraft,
+		}
+	}
+
+	tests := []struct {
+		name      string
+		query     search.Query
+		wantIDs   []string
+		wantError bool
+	}{
+		{
+			name:    "rank published matches and skip drafts",
+			query:   search.Query{Text: "clinic", Facets: newsPublicFacets},
+			wantIDs: []string{"news-1", "news-2"},
+		},
+		{
+			name:    "filter by tag facet",
+			query:   search.Query{Text: "clinic", Filters: map[string]string{newsFacetTags: "updates"}},
+			wantIDs: []string{"news-2"},
+		},
+		{
+			name:    "caller cannot widen status filter",
+			query:   search.Query{Text: "draft", Filters: map[string]string{newsFacetStatus: "draft"}},
+			wantIDs: []string{},
+		},
+		{
+			name:      "reject invalid query",
+			query:     search.Query{Text: "clinic", Offset: -1},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockRepo := NewMockNewsRepository()
+			setup(mockRepo)
+			service := NewNewsService(mockRepo)
+
+			// Act
+			results, err := service.QueryPublishedNews(ctx, tt.query, "")
+
+			// Assert
+			if tt.wantError {
+				require.Error(t, err)
+				assert.True(t, domain.IsValidationError(err))
+				return
+			}
+			require.NoError(t, err)
+			ids := make([]string, 0, len(results.Hits))
+			for _, news := range newsFromHits(results.Hits) {
+				ids = append(ids, news.NewsID)
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+		})
+	}
+
+	t.Run("count facets across published matches", func(t *testing.T) {
+		mockRepo := NewMockNewsRepository()
+		setup(mockRepo)
+		service := NewNewsService(mockRepo)
+
+		results, err := service.QueryPublishedNews(ctx, search.Query{Text: "clinic", Facets: newsPublicFacets}, "")
+
+		require.NoError(t, err)
+		assert.Equal(t, []search.FacetCount{
+			{Value: string(NewsTypeAnnouncement), Count: 1},
+			{Value: string(NewsTypeUpdate), Count: 1},
+		}, results.Facets[newsFacetType])
+		assert.Contains(t, results.Hits[0].Highlights["title"], "<mark>Clinic</mark>")
+	})
+}
+
+func TestNewsService_ReindexNews(t *testing.T) {
+	ctx, cancel := sharedtesting.CreateUnitTestContext()
+	defer cancel()
+
+	t.Run("reindex through repository", func(t *testing.T) {
+		mockRepo := NewMockNewsRepository()
+		service := NewNewsService(mockRepo)
+
+		require.NoError(t, service.ReindexNews(ctx, "news-1"))
+		assert.Equal(t, []string{"news-1"}, mockRepo.reindexed)
+	})
+
+	t.Run("reject empty id", func(t *testing.T) {
+		service := NewNewsService(NewMockNewsRepository())
+
+		err := service.ReindexNews(ctx, "")
+		require.Error(t, err)
+		assert.True(t, domain.IsValidationError(err))
+	})
+}
+
 func TestNewsService_GetFeaturedNews(t *testing.T) {
 	ctx, cancel := sharedtesting.CreateUnitTestContext()
 		defer cancel()
@@ -1452,11 +1777,12 @@ func TestNewsService_UpdateNews(t *testing.T) {
 	defer cancel()
 
 	tests := []struct {
-		name      string
-		news      *News
-		userID    string
-		setupFunc func(*MockNewsRepository)
-		wantErr   bool
+		name         string
+		news         *News
+		userID       string
+		setupFunc    func(*MockNewsRepository)
+		wantErr      bool
+		wantConflict bool
 	}{
 		{
 			name: "successfully update existing news",
@@ -1484,6 +1810,31 @@ func TestNewsService_UpdateNews(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "return conflict error when the article changed since it was read",
+			news: &News{
+				NewsID:           "550e8400-e29b-41d4-a716-446655440001",
+				Title:            "Updated News Title",
+				Summary:          "Updated summary",
+				Slug:             "updated-news-title",
+				CategoryID:       "550e8400-e29b-41d4-a716-446655440002",
+				PublishingStatus: PublishingStatusDraft,
+				NewsType:         NewsTypeAnnouncement,
+				PriorityLevel:    PriorityLevelNormal,
+				ETag:             "etag-stale",
+			},
+			userID: "admin-550e8400-e29b-41d4-a716-446655440003",
+			setupFunc: func(repo *MockNewsRepository) {
+				repo.news["550e8400-e29b-41d4-a716-446655440001"] = &News{
+					NewsID:           "550e8400-e29b-41d4-a716-446655440001",
+					Title:            "Title From Another Editor",
+					PublishingStatus: PublishingStatusDraft,
+					ETag:             "etag-current",
+				}
+			},
+			wantErr:      true,
+			wantConflict: true,
+		},
 		{
 			name: "return not found error for non-existent news",
 			news: &News{
@@ -1505,11 +1856,167 @@ func TestNewsService_UpdateNews(t *testing.T) {
 
 			if tt.wantErr {
 				assert.Error(t, err)
+				assert.Equal(t, tt.wantConflict, domain.IsConflictError(err))
+				assert.Empty(t, repo.auditEvents)
 			} else {
 				assert.NoError(t, err)
 				assert.Len(t, repo.auditEvents, 1)
 				assert.Equal(t, domain.AuditEventUpdate, repo.auditEvents[0].OperationType)
+				assert.NotEmpty(t, tt.news.ETag)
+			}
+		})
+	}
+}
+
+func TestNewsService_AdminUpdateNews(t *testing.T) {
+	ctx, cancel := sharedtesting.CreateUnitTestContext()
+	defer cancel()
+
+	tests := []struct {
+		name         string
+		ifMatch      string
+		wantConflict bool
+	}{
+		{name: "update without if-match", ifMatch: ""},
+		{name: "update when if-match names the current version", ifMatch: "etag-current"},
+		{name: "update with wildcard if-match", ifMatch: domain.ETagAny},
+		{name: "reject stale if-match", ifMatch: "etag-stale", wantConflict: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			repo := NewMockNewsRepository()
+			repo.news["550e8400-e29b-41d4-a716-446655440001"] = &News{
+				NewsID:           "550e8400-e29b-41d4-a716-446655440001",
+				Title:            "Original Title",
+				PublishingStatus: PublishingStatusDraft,
+				ETag:             "etag-current",
+			}
+			service := NewNewsService(repo)
+			title := "Updated Title"
+
+			// Act
+			news, err := service.AdminUpdateNews(ctx, "550e8400-e29b-41d4-a716-446655440001", UpdateNewsArticleRequest{Title: &title, IfMatch: tt.ifMatch}, "admin-550e8400-e29b-41d4-a716-446655440003")
+
+			// Assert
+			stored := repo.news["550e8400-e29b-41d4-a716-446655440001"]
+			if tt.wantConflict {
+				require.Error(t, err)
+				assert.True(t, domain.IsConflictError(err))
+				assert.Equal(t, "Original Title", stored.Title)
+				assert.Equal(t, "etag-current", stored.ETag)
+				return
 			}
+			require.NoError(t, err)
+			assert.Equal(t, "Updated Title", news.Title)
+			assert.NotEqual(t, "etag-current", news.ETag)
+		})
+	}
+}
+
+func TestNewsService_GetNewsRevisions(t *testing.T) {
+	ctx, cancel := sharedtesting.CreateUnitTestContext()
+	defer cancel()
+
+	repo := NewMockNewsRepository()
+	repo.AddRevision(&News{NewsID: "news-1", Title: "Draft"}, domain.AuditEventInsert)
+	repo.AddRevision(&News{NewsID: "news-1", Title: "Final"}, domain.AuditEventUpdate)
+	service := NewNewsService(repo)
+
+	t.Run("list newest first without snapshots", func(t *testing.T) {
+		revisions, err := service.GetNewsRevisions(ctx, "news-1", "admin-1")
+
+		require.NoError(t, err)
+		require.Len(t, revisions, 2)
+		assert.Equal(t, 2, revisions[0].Number)
+		assert.Nil(t, revisions[0].DataSnapshot)
+	})
+
+	t.Run("require admin user", func(t *testing.T) {
+		_, err := service.GetNewsRevisions(ctx, "news-1", "")
+
+		assert.True(t, domain.IsUnauthorizedError(err))
+	})
+
+	t.Run("diff two revisions", func(t *testing.T) {
+		diff, err := service.DiffNewsRevisions(ctx, "news-1", 1, 2, "admin-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, []domain.FieldChange{{Field: "title", Before: "Draft", After: "Final"}}, diff.Changes)
+	})
+
+	t.Run("diff unknown revision", func(t *testing.T) {
+		_, err := service.DiffNewsRevisions(ctx, "news-1", 1, 5, "admin-1")
+
+		assert.True(t, domain.IsNotFoundError(err))
+	})
+}
+
+func TestNewsService_RollbackNews(t *testing.T) {
+	ctx, cancel := sharedtesting.CreateUnitTestContext()
+	defer cancel()
+
+	newsID := "550e8400-e29b-41d4-a716-446655440001"
+	original := &News{
+		NewsID:           newsID,
+		Title:            "Original Title",
+		Summary:          "Original summary",
+		Slug:             "original-title",
+		CategoryID:       "550e8400-e29b-41d4-a716-446655440002",
+		PublishingStatus: PublishingStatusDraft,
+		NewsType:         NewsTypeAnnouncement,
+		PriorityLevel:    PriorityLevelNormal,
+		CreatedBy:        "author-1",
+	}
+
+	tests := []struct {
+		name         string
+		revision     int
+		etag         string
+		wantErr      bool
+		wantConflict bool
+	}{
+		{name: "restore earlier revision", revision: 1},
+		{name: "restore when etag names the current version", revision: 1, etag: "etag-current"},
+		{name: "reject stale etag", revision: 1, etag: "etag-stale", wantErr: true, wantConflict: true},
+		{name: "reject unknown revision", revision: 7, wantErr: true},
+		{name: "reject revision without content", revision: 3, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			repo := NewMockNewsRepository()
+			edited := *original
+			edited.Title = "Edited Title"
+			edited.ETag = "etag-current"
+			repo.news[newsID] = &edited
+			repo.AddRevision(original, domain.AuditEventInsert)
+			repo.AddRevision(&edited, domain.AuditEventUpdate)
+			repo.revisions[newsID] = append(repo.revisions[newsID], &domain.Revision{EntityType: domain.EntityTypeNews, EntityID: newsID, Number: 3})
+			service := NewNewsService(repo)
+
+			// Act
+			restored, err := service.RollbackNews(ctx, newsID, tt.revision, tt.etag, "admin-2")
+
+			// Assert
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantConflict, domain.IsConflictError(err))
+				assert.Equal(t, "Edited Title", repo.news[newsID].Title)
+				assert.Empty(t, repo.auditEvents)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "Original Title", restored.Title)
+			assert.Equal(t, "author-1", restored.CreatedBy)
+			assert.Equal(t, "admin-2", restored.ModifiedBy)
+			assert.NotEqual(t, "etag-current", restored.ETag)
+			assert.Equal(t, "Original Title", repo.news[newsID].Title)
+			require.Len(t, repo.auditEvents, 1)
+			assert.Equal(t, domain.AuditEventRollback, repo.auditEvents[0].OperationType)
+			assert.Equal(t, &edited, repo.auditEvents[0].Before)
 		})
 	}
 }
@@ -1628,6 +2135,101 @@ func TestNewsService_PublishNews(t *testing.T) {
 	}
 }
 
+func TestNewsService_EditorialReview(t *testing.T) {
+	ctx, cancel := sharedtesting.CreateUnitTestContext()
+	defer cancel()
+
+	newsID := "550e8400-e29b-41d4-a716-446655440001"
+	newRepository := func() *MockNewsRepository {
+		repo := NewMockNewsRepository()
+		repo.news[newsID] = &News{
+			NewsID:           newsID,
+			Title:            "Complete News Article",
+			Content:          "Complete content for publication",
+			Summary:          "Complete summary",
+			AuthorName:       "Author Name",
+			Slug:             "complete-news-article",
+			CategoryID:       "550e8400-e29b-41d4-a716-446655440002",
+			NewsType:         NewsTypeAnnouncement,
+			PriorityLevel:    PriorityLevelNormal,
+			PublishingStatus: PublishingStatusDraft,
+			CreatedBy:        "author-1",
+		}
+		return repo
+	}
+
+	t.Run("approved article can be published", func(t *testing.T) {
+		// Arrange
+		repo := newRepository()
+		service := NewNewsService(repo)
+		service.UseEditorialWorkflow(domain.NewEditorialWorkflow(true, 1))
+
+		// Act
+		err := service.PublishNews(ctx, newsID, "author-1")
+		require.Error(t, err)
+		assert.True(t, domain.IsConflictError(err))
+
+		review, err := service.SubmitNewsForReview(ctx, newsID, []string{"reviewer-1"}, "author-1")
+		require.NoError(t, err)
+		assert.Equal(t, domain.WorkflowStatusInReview, review.Status)
+
+		_, err = service.ApproveNews(ctx, newsID, "author-1")
+		require.Error(t, err)
+		assert.True(t, domain.IsForbiddenError(err))
+
+		review, err = service.ApproveNews(ctx, newsID, "reviewer-1")
+		require.NoError(t, err)
+
+		// Assert
+		assert.Equal(t, domain.WorkflowStatusApproved, review.Status)
+		assert.NoError(t, service.PublishNews(ctx, newsID, "author-1"))
+		assert.Equal(t, PublishingStatusPublished, repo.news[newsID].PublishingStatus)
+		require.Len(t, repo.reviewEvents, 2)
+		assert.Equal(t, domain.ReviewEventRequested, repo.reviewEvents[0].EventType)
+		assert.Equal(t, []string{"reviewer-1"}, repo.reviewEvents[0].Recipients)
+		assert.Equal(t, domain.ReviewEventApproved, repo.reviewEvents[1].EventType)
+		assert.Equal(t, []string{"author-1"}, repo.reviewEvents[1].Recipients)
+	})
+
+	t.Run("rejection needs a reason and returns the article to draft", func(t *testing.T) {
+		// Arrange
+		repo := newRepository()
+		service := NewNewsService(repo)
+		service.UseEditorialWorkflow(domain.NewEditorialWorkflow(true, 1))
+		_, err := service.SubmitNewsForReview(ctx, newsID, []string{"reviewer-1"}, "author-1")
+		require.NoError(t, err)
+
+		// Act
+		_, err = service.RejectNews(ctx, newsID, "", "reviewer-1")
+		require.Error(t, err)
+		assert.True(t, domain.IsValidationError(err))
+
+		review, err := service.RejectNews(ctx, newsID, "Please cite the source", "reviewer-1")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, domain.WorkflowStatusDraft, review.Status)
+		assert.Equal(t, "Please cite the source", review.Review.RejectionReason)
+		assert.Equal(t, PublishingStatusDraft, repo.news[newsID].PublishingStatus)
+	})
+
+	t.Run("edits cannot publish around review", func(t *testing.T) {
+		// Arrange
+		repo := newRepository()
+		service := NewNewsService(repo)
+		service.UseEditorialWorkflow(domain.NewEditorialWorkflow(true, 1))
+		edited := *repo.news[newsID]
+		edited.PublishingStatus = PublishingStatusPublished
+
+		// Act
+		err := service.UpdateNews(ctx, &edited, "author-1")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, PublishingStatusDraft, repo.news[newsID].PublishingStatus)
+	})
+}
+
 func TestNewsService_ArchiveNews(t *testing.T) {
 	ctx, cancel := sharedtesting.CreateUnitTestContext()
 	defer cancel()
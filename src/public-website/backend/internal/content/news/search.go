@@ -0,0 +1,69 @@
+package news
+
+import (
+	"strings"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/search"
+)
+
+// Facets maintained in the news search index
+const (
+	newsFacetCategory = "category"
+	newsFacetTags     = "tags"
+	newsFacetType     = "news_type"
+	newsFacetStatus   = "status"
+)
+
+// newsPublicFacets are the facets counted and filterable on public news search
+var newsPublicFacets = []string{newsFacetCategory, newsFacetTags, newsFacetType}
+
+// newNewsSearchIndex creates the full-text index for news articles. Title
+// matches weigh most, then summary and tags, then the article body.
+func newNewsSearchIndex() *search.Index {
+	return search.NewIndex("news",
+		search.Field{Name: "title", Boost: 3},
+		search.Field{Name: "summary", Boost: 2},
+		search.Field{Name: "tags", Boost: 2},
+		search.Field{Name: "content", Boost: 1},
+	)
+}
+
+// newsSearchDocument converts a news article into its search document
+func newsSearchDocument(news *News) search.Document {
+	return search.Document{
+		ID: news.NewsID,
+		Fields: map[string]string{
+			"title":   news.Title,
+			"summary": news.Summary,
+			"tags":    strings.Join(news.Tags, " "),
+			"content": news.Content,
+		},
+		Facets: map[string][]string{
+			newsFacetCategory: {news.CategoryID},
+			newsFacetTags:     news.Tags,
+			newsFacetType:     {string(news.NewsType)},
+			newsFacetStatus:   {string(news.PublishingStatus)},
+		},
+		Source: news,
+	}
+}
+
+// newsSearchDocuments converts news articles into search documents
+func newsSearchDocuments(newsList []*News) []search.Document {
+	documents := make([]search.Document, 0, len(newsList))
+	for _, news := range newsList {
+		documents = append(documents, newsSearchDocument(news))
+	}
+	return documents
+}
+
+// newsFromHits returns the news articles behind search hits, in rank order
+func newsFromHits(hits []search.Hit) []*News {
+	newsList := make([]*News, 0, len(hits))
+	for _, hit := range hits {
+		if news, ok := hit.Source.(*News); ok {
+			newsList = append(newsList, news)
+		}
+	}
+	return newsList
+}
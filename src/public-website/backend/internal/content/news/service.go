@@ -2,53 +2,72 @@ package news
 
 import (
 	"context"
+	"time"
 
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/search"
 )
 
 // NewsRepositoryInterface defines the contract for news data access
 type NewsRepositoryInterface interface {
 	// News operations
-	SaveNews(ctx context.Context, news *News) error
+	SaveNews(ctx context.Context, news *News, audit *domain.AuditEvent) error
 	GetNews(ctx context.Context, newsID string) (*News, error)
 	GetNewsBySlug(ctx context.Context, slug string) (*News, error)
 	GetAllNews(ctx context.Context) ([]*News, error)
 	GetNewsByCategory(ctx context.Context, categoryID string) ([]*News, error)
 	GetNewsByPublishingStatus(ctx context.Context, status PublishingStatus) ([]*News, error)
-	DeleteNews(ctx context.Context, newsID string, userID string) error
+	ListNewsPage(ctx context.Context, filter NewsListFilter, page domain.PageRequest) ([]*News, domain.PageInfo, error)
+	DeleteNews(ctx context.Context, newsID string, userID string, audit *domain.AuditEvent) error
 	SearchNews(ctx context.Context, searchTerm string) ([]*News, error)
+	QueryNews(ctx context.Context, query search.Query) (*search.Results, error)
+	ReindexNews(ctx context.Context, newsID string) error
 
 	// News category operations
-	SaveNewsCategory(ctx context.Context, category *NewsCategory) error
+	SaveNewsCategory(ctx context.Context, category *NewsCategory, audit *domain.AuditEvent) error
 	GetNewsCategory(ctx context.Context, categoryID string) (*NewsCategory, error)
 	GetNewsCategoryBySlug(ctx context.Context, slug string) (*NewsCategory, error)
 	GetAllNewsCategories(ctx context.Context) ([]*NewsCategory, error)
 	GetDefaultUnassignedCategory(ctx context.Context) (*NewsCategory, error)
-	DeleteNewsCategory(ctx context.Context, categoryID string, userID string) error
+	DeleteNewsCategory(ctx context.Context, categoryID string, userID string, audit *domain.AuditEvent) error
 
 	// Featured news operations
-	SaveFeaturedNews(ctx context.Context, featured *FeaturedNews) error
+	SaveFeaturedNews(ctx context.Context, featured *FeaturedNews, audit *domain.AuditEvent) error
 	GetFeaturedNews(ctx context.Context) (*FeaturedNews, error)
-	DeleteFeaturedNews(ctx context.Context, featuredNewsID string) error
+	DeleteFeaturedNews(ctx context.Context, featuredNewsID string, audit *domain.AuditEvent) error
 
 	// Audit operations
-	PublishAuditEvent(ctx context.Context, entityType domain.EntityType, entityID string, operationType domain.AuditEventType, userID string, beforeData, afterData interface{}) error
 	GetNewsAudit(ctx context.Context, newsID string, userID string, limit int, offset int) ([]*domain.AuditEvent, error)
 	GetNewsCategoryAudit(ctx context.Context, categoryID string, userID string, limit int, offset int) ([]*domain.AuditEvent, error)
+
+	// Revision operations
+	ListNewsRevisions(ctx context.Context, newsID string) ([]*domain.Revision, error)
+	GetNewsRevision(ctx context.Context, newsID string, revision int) (*domain.Revision, error)
+
+	// Editorial review operations
+	PublishReviewEvent(ctx context.Context, event *domain.ReviewEvent) error
 }
 
 // NewsService implements business logic for news operations
 type NewsService struct {
 	repository NewsRepositoryInterface
+	workflow   *domain.EditorialWorkflow
 }
 
-// NewNewsService creates a new news service
+// NewNewsService creates a new news service that publishes without review
+// until an editorial workflow is configured
 func NewNewsService(repository NewsRepositoryInterface) *NewsService {
 	return &NewsService{
 		repository: repository,
+		workflow:   domain.DirectPublishWorkflow(),
 	}
 }
 
+// UseEditorialWorkflow sets the review policy articles follow before publishing
+func (s *NewsService) UseEditorialWorkflow(workflow *domain.EditorialWorkflow) {
+	s.workflow = workflow
+}
+
 // GetNews retrieves news by ID
 func (s *NewsService) GetNews(ctx context.Context, newsID string, userID string) (*News, error) {
 	if newsID == "" {
@@ -94,6 +113,20 @@ func (s *NewsService) GetAllNews(ctx context.Context, userID string) ([]*News, e
 	return newsList, nil
 }
 
+// ListNewsPage retrieves one cursor-delimited page of news matching the filter
+func (s *NewsService) ListNewsPage(ctx context.Context, filter NewsListFilter, page domain.PageRequest, userID string) ([]*News, domain.PageInfo, error) {
+	if filter.PublishingStatus != "" && !filter.PublishingStatus.IsValid() {
+		return nil, domain.PageInfo{}, domain.NewValidationFieldError("status", "invalid publishing status")
+	}
+
+	newsList, info, err := s.repository.ListNewsPage(ctx, filter, page)
+	if err != nil {
+		return nil, domain.PageInfo{}, domain.WrapError(err, "failed to list news page")
+	}
+
+	return newsList, info, nil
+}
+
 // GetNewsByCategory retrieves news by category
 func (s *NewsService) GetNewsByCategory(ctx context.Context, categoryID string, userID string) ([]*News, error) {
 	if categoryID == "" {
@@ -118,6 +151,34 @@ func (s *NewsService) SearchNews(ctx context.Context, searchTerm string, userID
 	return results, nil
 }
 
+// QueryPublishedNews runs a ranked full-text search over published news,
+// returning highlighted hits and facet counts
+func (s *NewsService) QueryPublishedNews(ctx context.Context, query search.Query, userID string) (*search.Results, error) {
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	results, err := s.repository.QueryNews(ctx, query.WithFilter(newsFacetStatus, string(PublishingStatusPublished)))
+	if err != nil {
+		return nil, domain.WrapError(err, "failed to query news search index")
+	}
+
+	return results, nil
+}
+
+// ReindexNews refreshes the search index entry of a news article after it changed
+func (s *NewsService) ReindexNews(ctx context.Context, newsID string) error {
+	if newsID == "" {
+		return domain.NewValidationError("news ID cannot be empty")
+	}
+
+	if err := s.repository.ReindexNews(ctx, newsID); err != nil {
+		return domain.WrapError(err, "failed to reindex news")
+	}
+
+	return nil
+}
+
 // GetPublishedNews retrieves only published news
 func (s *NewsService) GetPublishedNews(ctx context.Context, userID string) ([]*News, error) {
 	publishedNews, err := s.repository.GetNewsByPublishingStatus(ctx, PublishingStatusPublished)
@@ -238,6 +299,222 @@ func (s *NewsService) GetNewsCategoryAudit(ctx context.Context, categoryID strin
 	return auditEvents, nil
 }
 
+// GetNewsRevisions lists the revision history of a news article, newest first (admin only)
+func (s *NewsService) GetNewsRevisions(ctx context.Context, newsID string, userID string) ([]*domain.Revision, error) {
+	if userID == "" {
+		return nil, domain.NewUnauthorizedError("admin authentication required")
+	}
+
+	if newsID == "" {
+		return nil, domain.NewValidationError("news ID cannot be empty")
+	}
+
+	revisions, err := s.repository.ListNewsRevisions(ctx, newsID)
+	if err != nil {
+		return nil, domain.WrapError(err, "failed to get revisions for news "+newsID)
+	}
+
+	return revisions, nil
+}
+
+// GetNewsRevision retrieves one revision of a news article with its snapshot (admin only)
+func (s *NewsService) GetNewsRevision(ctx context.Context, newsID string, revision int, userID string) (*domain.Revision, error) {
+	if userID == "" {
+		return nil, domain.NewUnauthorizedError("admin authentication required")
+	}
+
+	if newsID == "" {
+		return nil, domain.NewValidationError("news ID cannot be empty")
+	}
+
+	return s.repository.GetNewsRevision(ctx, newsID, revision)
+}
+
+// DiffNewsRevisions compares two revisions of a news article field by field (admin only)
+func (s *NewsService) DiffNewsRevisions(ctx context.Context, newsID string, fromRevision, toRevision int, userID string) (*domain.RevisionDiff, error) {
+	from, err := s.GetNewsRevision(ctx, newsID, fromRevision, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := s.GetNewsRevision(ctx, newsID, toRevision, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.DiffRevisions(from, to)
+}
+
+// RollbackNews restores a news article to the content it had at an earlier revision.
+// The restored article is saved as a new version and audited as a rollback, so the
+// rollback shows up in the history and can itself be undone. A non-empty etag must
+// name the current version of the article.
+func (s *NewsService) RollbackNews(ctx context.Context, newsID string, revision int, etag string, userID string) (*News, error) {
+	target, err := s.GetNewsRevision(ctx, newsID, revision, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repository.GetNews(ctx, newsID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := domain.CheckETag("news article", newsID, etag, existing.ETag); err != nil {
+		return nil, err
+	}
+
+	var restored News
+	if err := target.DecodeContent(&restored); err != nil {
+		return nil, err
+	}
+
+	// Identity, creation details and review state always follow the live article
+	restored.NewsID = existing.NewsID
+	restored.CreatedOn = existing.CreatedOn
+	restored.CreatedBy = existing.CreatedBy
+	restored.IsDeleted = false
+	restored.DeletedOn = nil
+	restored.DeletedBy = ""
+	restored.ModifiedBy = userID
+	now := time.Now().UTC()
+	restored.ModifiedOn = &now
+	restored.ETag = existing.ETag
+	restored.Review = existing.Review.Clone()
+	if restored.Review == nil {
+		restored.Review = &domain.EditorialReview{}
+	}
+	restored.PublishingStatus = PublishingStatus(s.workflow.StatusAfterEdit(
+		domain.WorkflowStatus(existing.PublishingStatus), domain.WorkflowStatus(restored.PublishingStatus), restored.Review, userID))
+
+	if err := restored.Validate(); err != nil {
+		return nil, err
+	}
+
+	audit := domain.NewAuditChange(domain.EntityTypeNews, newsID, domain.AuditEventRollback, userID, existing, &restored)
+	if err := s.repository.SaveNews(ctx, &restored, audit); err != nil {
+		return nil, err
+	}
+
+	return &restored, nil
+}
+
+// Editorial review operations
+
+// GetNewsReview returns the review state of an article
+func (s *NewsService) GetNewsReview(ctx context.Context, newsID string, userID string) (*domain.ContentReview, error) {
+	news, err := s.GetNews(ctx, newsID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return news.ContentReview(), nil
+}
+
+// SubmitNewsForReview sends a draft article to its reviewers
+func (s *NewsService) SubmitNewsForReview(ctx context.Context, newsID string, reviewerIDs []string, userID string) (*domain.ContentReview, error) {
+	return s.applyReview(ctx, newsID, userID, func(news *News, now time.Time) (*domain.ReviewEvent, error) {
+		status, err := news.Review.Submit(domain.WorkflowStatus(news.PublishingStatus), userID, []string{news.CreatedBy, news.ModifiedBy}, reviewerIDs, now)
+		if err != nil {
+			return nil, err
+		}
+		news.PublishingStatus = PublishingStatus(status)
+
+		return domain.NewReviewEvent(domain.ReviewEventRequested, news.ContentReview(), userID, news.Review.Reviewers, ""), nil
+	})
+}
+
+// AssignNewsReviewers adds reviewers to an article under review
+func (s *NewsService) AssignNewsReviewers(ctx context.Context, newsID string, reviewerIDs []string, userID string) (*domain.ContentReview, error) {
+	return s.applyReview(ctx, newsID, userID, func(news *News, now time.Time) (*domain.ReviewEvent, error) {
+		added, err := news.Review.AssignReviewers(domain.WorkflowStatus(news.PublishingStatus), reviewerIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		return domain.NewReviewEvent(domain.ReviewEventRequested, news.ContentReview(), userID, added, ""), nil
+	})
+}
+
+// CommentOnNewsReview adds a review comment to an article
+func (s *NewsService) CommentOnNewsReview(ctx context.Context, newsID string, body string, userID string) (*domain.ContentReview, error) {
+	return s.applyReview(ctx, newsID, userID, func(news *News, now time.Time) (*domain.ReviewEvent, error) {
+		comment, err := news.Review.AddComment(domain.WorkflowStatus(news.PublishingStatus), userID, body, now)
+		if err != nil {
+			return nil, err
+		}
+
+		return domain.NewReviewEvent(domain.ReviewEventCommented, news.ContentReview(), userID, news.Review.Participants(userID), comment.Body), nil
+	})
+}
+
+// ApproveNews records a reviewer's approval; authors cannot approve their own articles
+func (s *NewsService) ApproveNews(ctx context.Context, newsID string, userID string) (*domain.ContentReview, error) {
+	return s.applyReview(ctx, newsID, userID, func(news *News, now time.Time) (*domain.ReviewEvent, error) {
+		status, err := news.Review.Approve(domain.WorkflowStatus(news.PublishingStatus), userID, s.workflow.RequiredApprovals, now)
+		if err != nil {
+			return nil, err
+		}
+		news.PublishingStatus = PublishingStatus(status)
+
+		// Authors hear back once the article is fully approved
+		if status != domain.WorkflowStatusApproved {
+			return nil, nil
+		}
+		return domain.NewReviewEvent(domain.ReviewEventApproved, news.ContentReview(), userID, news.Review.Authors, ""), nil
+	})
+}
+
+// RejectNews returns an article to its authors as a draft with the reason
+func (s *NewsService) RejectNews(ctx context.Context, newsID string, reason string, userID string) (*domain.ContentReview, error) {
+	return s.applyReview(ctx, newsID, userID, func(news *News, now time.Time) (*domain.ReviewEvent, error) {
+		status, err := news.Review.Reject(domain.WorkflowStatus(news.PublishingStatus), userID, reason, now)
+		if err != nil {
+			return nil, err
+		}
+		news.PublishingStatus = PublishingStatus(status)
+
+		return domain.NewReviewEvent(domain.ReviewEventRejected, news.ContentReview(), userID, news.Review.Authors, news.Review.RejectionReason), nil
+	})
+}
+
+// applyReview loads an article, applies one review step to it, saves it and
+// notifies the people the step concerns
+func (s *NewsService) applyReview(ctx context.Context, newsID string, userID string, step func(news *News, now time.Time) (*domain.ReviewEvent, error)) (*domain.ContentReview, error) {
+	if userID == "" {
+		return nil, domain.NewUnauthorizedError("user ID is required for editorial review")
+	}
+
+	news, err := s.GetNews(ctx, newsID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := *news // Copy for audit
+	existing.Review = news.Review.Clone()
+	if news.Review == nil {
+		news.Review = &domain.EditorialReview{}
+	}
+
+	event, err := step(news, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	audit := domain.NewAuditChange(domain.EntityTypeNews, newsID, domain.AuditEventUpdate, userID, &existing, news)
+	if err := s.repository.SaveNews(ctx, news, audit); err != nil {
+		return nil, err
+	}
+
+	if event != nil {
+		if err := s.repository.PublishReviewEvent(ctx, event); err != nil {
+			return nil, err
+		}
+	}
+
+	return news.ContentReview(), nil
+}
+
 // Admin CRUD Operations
 
 func (s *NewsService) CreateNews(ctx context.Context, news *News, userID string) error {
@@ -250,12 +527,8 @@ func (s *NewsService) CreateNews(ctx context.Context, news *News, userID string)
 	}
 
 	// Save news
-	if err := s.repository.SaveNews(ctx, news); err != nil {
-		return err
-	}
-
-	// Publish audit event
-	return s.repository.PublishAuditEvent(ctx, domain.EntityTypeNews, news.NewsID, domain.AuditEventInsert, userID, nil, news)
+	audit := domain.NewAuditChange(domain.EntityTypeNews, news.NewsID, domain.AuditEventInsert, userID, nil, news)
+	return s.repository.SaveNews(ctx, news, audit)
 }
 
 func (s *NewsService) UpdateNews(ctx context.Context, news *News, userID string) error {
@@ -265,6 +538,21 @@ func (s *NewsService) UpdateNews(ctx context.Context, news *News, userID string)
 		return err
 	}
 
+	// Refuse to overwrite a version the editor has not seen, and guard the save
+	// against writes landing between this read and the save
+	if err := domain.CheckETag("news article", news.NewsID, news.ETag, existing.ETag); err != nil {
+		return err
+	}
+	news.ETag = existing.ETag
+
+	// Review state only changes through the editorial workflow
+	news.Review = existing.Review.Clone()
+	if news.Review == nil {
+		news.Review = &domain.EditorialReview{}
+	}
+	news.PublishingStatus = PublishingStatus(s.workflow.StatusAfterEdit(
+		domain.WorkflowStatus(existing.PublishingStatus), domain.WorkflowStatus(news.PublishingStatus), news.Review, userID))
+
 	// Set modification fields and validate
 	news.ModifiedBy = userID
 	now := news.CreatedOn
@@ -275,12 +563,8 @@ func (s *NewsService) UpdateNews(ctx context.Context, news *News, userID string)
 	}
 
 	// Save updated news
-	if err := s.repository.SaveNews(ctx, news); err != nil {
-		return err
-	}
-
-	// Publish audit event
-	return s.repository.PublishAuditEvent(ctx, domain.EntityTypeNews, news.NewsID, domain.AuditEventUpdate, userID, existing, news)
+	audit := domain.NewAuditChange(domain.EntityTypeNews, news.NewsID, domain.AuditEventUpdate, userID, existing, news)
+	return s.repository.SaveNews(ctx, news, audit)
 }
 
 func (s *NewsService) PublishNews(ctx context.Context, newsID string, userID string) error {
@@ -296,6 +580,9 @@ func (s *NewsService) PublishNews(ctx context.Context, newsID string, userID str
 	if err := news.CanBePublished(); err != nil {
 		return err
 	}
+	if err := s.workflow.CanPublish(domain.WorkflowStatus(news.PublishingStatus)); err != nil {
+		return err
+	}
 
 	// Update publishing status
 	news.PublishingStatus = PublishingStatusPublished
@@ -304,12 +591,8 @@ func (s *NewsService) PublishNews(ctx context.Context, newsID string, userID str
 	news.ModifiedOn = &now
 
 	// Save updated news
-	if err := s.repository.SaveNews(ctx, news); err != nil {
-		return err
-	}
-
-	// Publish audit event
-	return s.repository.PublishAuditEvent(ctx, domain.EntityTypeNews, newsID, domain.AuditEventPublish, userID, &existing, news)
+	audit := domain.NewAuditChange(domain.EntityTypeNews, newsID, domain.AuditEventPublish, userID, &existing, news)
+	return s.repository.SaveNews(ctx, news, audit)
 }
 
 func (s *NewsService) ArchiveNews(ctx context.Context, newsID string, userID string) error {
@@ -328,12 +611,8 @@ func (s *NewsService) ArchiveNews(ctx context.Context, newsID string, userID str
 	news.ModifiedOn = &now
 
 	// Save updated news
-	if err := s.repository.SaveNews(ctx, news); err != nil {
-		return err
-	}
-
-	// Publish audit event
-	return s.repository.PublishAuditEvent(ctx, domain.EntityTypeNews, newsID, domain.AuditEventArchive, userID, &existing, news)
+	audit := domain.NewAuditChange(domain.EntityTypeNews, newsID, domain.AuditEventArchive, userID, &existing, news)
+	return s.repository.SaveNews(ctx, news, audit)
 }
 
 func (s *NewsService) DeleteNews(ctx context.Context, newsID string, userID string) error {
@@ -344,12 +623,8 @@ func (s *NewsService) DeleteNews(ctx context.Context, newsID string, userID stri
 	}
 
 	// Soft delete news
-	if err := s.repository.DeleteNews(ctx, newsID, userID); err != nil {
-		return err
-	}
-
-	// Publish audit event
-	return s.repository.PublishAuditEvent(ctx, domain.EntityTypeNews, newsID, domain.AuditEventDelete, userID, existing, nil)
+	audit := domain.NewAuditChange(domain.EntityTypeNews, newsID, domain.AuditEventDelete, userID, existing, nil)
+	return s.repository.DeleteNews(ctx, newsID, userID, audit)
 }
 
 // News Category CRUD Operations
@@ -364,12 +639,8 @@ func (s *NewsService) CreateNewsCategory(ctx context.Context, category *NewsCate
 	}
 
 	// Save category
-	if err := s.repository.SaveNewsCategory(ctx, category); err != nil {
-		return err
-	}
-
-	// Publish audit event
-	return s.repository.PublishAuditEvent(ctx, domain.EntityTypeNewsCategory, category.CategoryID, domain.AuditEventInsert, userID, nil, category)
+	audit := domain.NewAuditChange(domain.EntityTypeNewsCategory, category.CategoryID, domain.AuditEventInsert, userID, nil, category)
+	return s.repository.SaveNewsCategory(ctx, category, audit)
 }
 
 func (s *NewsService) UpdateNewsCategory(ctx context.Context, category *NewsCategory, userID string) error {
@@ -389,12 +660,8 @@ func (s *NewsService) UpdateNewsCategory(ctx context.Context, category *NewsCate
 	}
 
 	// Save updated category
-	if err := s.repository.SaveNewsCategory(ctx, category); err != nil {
-		return err
-	}
-
-	// Publish audit event
-	return s.repository.PublishAuditEvent(ctx, domain.EntityTypeNewsCategory, category.CategoryID, domain.AuditEventUpdate, userID, existing, category)
+	audit := domain.NewAuditChange(domain.EntityTypeNewsCategory, category.CategoryID, domain.AuditEventUpdate, userID, existing, category)
+	return s.repository.SaveNewsCategory(ctx, category, audit)
 }
 
 func (s *NewsService) DeleteNewsCategory(ctx context.Context, categoryID string, userID string) error {
@@ -410,12 +677,8 @@ func (s *NewsService) DeleteNewsCategory(ctx context.Context, categoryID string,
 	}
 
 	// Soft delete category
-	if err := s.repository.DeleteNewsCategory(ctx, categoryID, userID); err != nil {
-		return err
-	}
-
-	// Publish audit event
-	return s.repository.PublishAuditEvent(ctx, domain.EntityTypeNewsCategory, categoryID, domain.AuditEventDelete, userID, existing, nil)
+	audit := domain.NewAuditChange(domain.EntityTypeNewsCategory, categoryID, domain.AuditEventDelete, userID, existing, nil)
+	return s.repository.DeleteNewsCategory(ctx, categoryID, userID, audit)
 }
 
 // Featured News Operations
@@ -439,12 +702,8 @@ func (s *NewsService) SetFeaturedNews(ctx context.Context, newsID string, userID
 	featured.SetDefaults()
 
 	// Save featured news (will replace existing due to constraint)
-	if err := s.repository.SaveFeaturedNews(ctx, featured); err != nil {
-		return err
-	}
-
-	// Publish audit event
-	return s.repository.PublishAuditEvent(ctx, domain.EntityTypeFeaturedNews, featured.FeaturedNewsID, domain.AuditEventInsert, userID, nil, featured)
+	audit := domain.NewAuditChange(domain.EntityTypeFeaturedNews, featured.FeaturedNewsID, domain.AuditEventInsert, userID, nil, featured)
+	return s.repository.SaveFeaturedNews(ctx, featured, audit)
 }
 
 func (s *NewsService) RemoveFeaturedNews(ctx context.Context, userID string) error {
@@ -455,10 +714,6 @@ func (s *NewsService) RemoveFeaturedNews(ctx context.Context, userID string) err
 	}
 
 	// Delete featured news
-	if err := s.repository.DeleteFeaturedNews(ctx, existing.FeaturedNewsID); err != nil {
-		return err
-	}
-
-	// Publish audit event
-	return s.repository.PublishAuditEvent(ctx, domain.EntityTypeFeaturedNews, existing.FeaturedNewsID, domain.AuditEventDelete, userID, existing, nil)
+	audit := domain.NewAuditChange(domain.EntityTypeFeaturedNews, existing.FeaturedNewsID, domain.AuditEventDelete, userID, existing, nil)
+	return s.repository.DeleteFeaturedNews(ctx, existing.FeaturedNewsID, audit)
 }
\ No newline at end of file
@@ -0,0 +1,257 @@
+package content
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+)
+
+const (
+	// defaultScheduleInterval is how often the scheduler looks for due schedules
+	defaultScheduleInterval = 30 * time.Second
+	// scheduleLease is how long a worker may take to fire a transition before
+	// another worker considers it lost and fires it again
+	scheduleLease = 2 * time.Minute
+)
+
+// TransitionFunc applies a publishing transition to one content entity on behalf of userID
+type TransitionFunc func(ctx context.Context, entityID string, userID string) error
+
+// EmbargoFunc holds content back from manual publishing until the given time, or
+// lifts the embargo when until is nil
+type EmbargoFunc func(ctx context.Context, entityID string, until *time.Time, userID string) error
+
+// PublicationTarget connects a content type to the scheduler. Embargo is optional;
+// content types without it cannot be embargoed.
+type PublicationTarget struct {
+	Publish   TransitionFunc
+	Unpublish TransitionFunc
+	Embargo   EmbargoFunc
+}
+
+// ScheduleFilter narrows a schedule listing; empty fields are not applied
+type ScheduleFilter struct {
+	EntityType domain.EntityType
+	EntityID   string
+	Status     domain.ScheduleStatus
+}
+
+// ScheduleRequest describes a publishing transition to schedule
+type ScheduleRequest struct {
+	EntityType domain.EntityType     `json:"entity_type"`
+	EntityID   string                `json:"entity_id"`
+	Action     domain.ScheduleAction `json:"action"`
+	RunAt      time.Time             `json:"run_at"`
+	Embargo    bool                  `json:"embargo"`
+}
+
+// ScheduleStore persists publication schedules so they survive restarts
+type ScheduleStore interface {
+	SaveSchedule(ctx context.Context, schedule *domain.PublicationSchedule) error
+	GetSchedule(ctx context.Context, scheduleID string) (*domain.PublicationSchedule, error)
+	ListSchedules(ctx context.Context, filter ScheduleFilter) ([]*domain.PublicationSchedule, error)
+	ListActiveSchedules(ctx context.Context) ([]*domain.PublicationSchedule, error)
+}
+
+// PublicationScheduler publishes and unpublishes content at scheduled times. Schedules
+// live in the state store and are claimed through their ETag, so any number of
+// content service replicas can run the scheduler and each transition fires once.
+type PublicationScheduler struct {
+	store    ScheduleStore
+	targets  map[domain.EntityType]PublicationTarget
+	interval time.Duration
+	now      func() time.Time
+}
+
+// NewPublicationScheduler creates a scheduler with no content types registered
+func NewPublicationScheduler(store ScheduleStore) *PublicationScheduler {
+	return &PublicationScheduler{
+		store:    store,
+		targets:  make(map[domain.EntityType]PublicationTarget),
+		interval: defaultScheduleInterval,
+		now:      time.Now,
+	}
+}
+
+// Register makes entityType schedulable through target
+func (s *PublicationScheduler) Register(entityType domain.EntityType, target PublicationTarget) {
+	s.targets[entityType] = target
+}
+
+// CreateSchedule plans a publishing transition. Embargoes take effect immediately.
+func (s *PublicationScheduler) CreateSchedule(ctx context.Context, request ScheduleRequest, userID string) (*domain.PublicationSchedule, error) {
+	if userID == "" {
+		return nil, domain.NewUnauthorizedError("admin authentication required")
+	}
+
+	target, exists := s.targets[request.EntityType]
+	if !exists {
+		return nil, domain.NewValidationFieldError("entity_type", fmt.Sprintf("%s content cannot be scheduled", request.EntityType))
+	}
+	if request.Embargo && target.Embargo == nil {
+		return nil, domain.NewValidationFieldError("embargo", fmt.Sprintf("%s content cannot be embargoed", request.EntityType))
+	}
+
+	schedule, err := domain.NewPublicationSchedule(request.EntityType, request.EntityID, request.Action, request.RunAt, request.Embargo, userID, s.now())
+	if err != nil {
+		return nil, err
+	}
+
+	if schedule.Embargo {
+		if err := target.Embargo(ctx, schedule.EntityID, &schedule.RunAt, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.store.SaveSchedule(ctx, schedule); err != nil {
+		if schedule.Embargo {
+			// Without a schedule nothing would ever release the content
+			if liftErr := target.Embargo(ctx, schedule.EntityID, nil, userID); liftErr != nil {
+				log.Printf("Failed to lift embargo on %s %s after schedule save failed: %v", schedule.EntityType, schedule.EntityID, liftErr)
+			}
+		}
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+// CancelSchedule withdraws a pending schedule and lifts its embargo
+func (s *PublicationScheduler) CancelSchedule(ctx context.Context, scheduleID string, userID string) (*domain.PublicationSchedule, error) {
+	if userID == "" {
+		return nil, domain.NewUnauthorizedError("admin authentication required")
+	}
+
+	schedule, err := s.store.GetSchedule(ctx, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := schedule.Cancel(userID, s.now()); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.SaveSchedule(ctx, schedule); err != nil {
+		return nil, err
+	}
+
+	if schedule.Embargo {
+		if target, exists := s.targets[schedule.EntityType]; exists && target.Embargo != nil {
+			if err := target.Embargo(ctx, schedule.EntityID, nil, userID); err != nil {
+				return nil, domain.WrapError(err, fmt.Sprintf("schedule %s was cancelled but the embargo could not be lifted", scheduleID))
+			}
+		}
+	}
+
+	return schedule, nil
+}
+
+// ListSchedules returns schedules matching the filter, earliest run time first
+func (s *PublicationScheduler) ListSchedules(ctx context.Context, filter ScheduleFilter, userID string) ([]*domain.PublicationSchedule, error) {
+	if userID == "" {
+		return nil, domain.NewUnauthorizedError("admin authentication required")
+	}
+	if filter.Status != "" && !filter.Status.IsValid() {
+		return nil, domain.NewValidationFieldError("status", fmt.Sprintf("status %q is not a schedule status", filter.Status))
+	}
+	if filter.EntityID != "" && filter.EntityType == "" {
+		return nil, domain.NewValidationFieldError("entity_type", "entity_type is required when filtering by entity_id")
+	}
+
+	return s.store.ListSchedules(ctx, filter)
+}
+
+// Run fires due schedules until ctx is cancelled. It checks once at start so that
+// schedules which fell due while the service was down fire straight away.
+func (s *PublicationScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		if fired, err := s.RunDue(ctx); err != nil {
+			log.Printf("Publication scheduler run failed: %v", err)
+		} else if fired > 0 {
+			log.Printf("Publication scheduler fired %d scheduled transitions", fired)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunDue fires every schedule that is due now and returns how many transitions fired.
+// Failed transitions are recorded on their schedule and retried on a later run.
+func (s *PublicationScheduler) RunDue(ctx context.Context) (int, error) {
+	schedules, err := s.store.ListActiveSchedules(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	fired := 0
+	for _, schedule := range schedules {
+		if ctx.Err() != nil {
+			return fired, ctx.Err()
+		}
+		if !schedule.IsDue(s.now()) {
+			continue
+		}
+
+		ok, err := s.fire(ctx, schedule)
+		if err != nil {
+			log.Printf("Scheduled %s of %s %s failed: %v", schedule.Action, schedule.EntityType, schedule.EntityID, err)
+		}
+		if ok {
+			fired++
+		}
+	}
+
+	return fired, nil
+}
+
+// fire claims a due schedule and applies its transition. A schedule claimed by
+// another worker in the meantime is skipped.
+func (s *PublicationScheduler) fire(ctx context.Context, schedule *domain.PublicationSchedule) (bool, error) {
+	schedule.Claim(s.now(), scheduleLease)
+	if err := s.store.SaveSchedule(ctx, schedule); err != nil {
+		if domain.IsConflictError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	// Transitions act as the admin who scheduled them, so audit events name them
+	transitionErr := s.transition(ctx, schedule)
+	if transitionErr != nil {
+		schedule.Fail(transitionErr, s.now())
+	} else {
+		schedule.Complete(s.now())
+	}
+
+	if err := s.store.SaveSchedule(ctx, schedule); err != nil {
+		return transitionErr == nil, domain.WrapError(err, fmt.Sprintf("failed to record outcome of schedule %s", schedule.ScheduleID))
+	}
+
+	return transitionErr == nil, transitionErr
+}
+
+func (s *PublicationScheduler) transition(ctx context.Context, schedule *domain.PublicationSchedule) error {
+	target, exists := s.targets[schedule.EntityType]
+	if !exists {
+		return domain.NewValidationError(fmt.Sprintf("%s content cannot be scheduled", schedule.EntityType))
+	}
+
+	switch schedule.Action {
+	case domain.ScheduleActionPublish:
+		return target.Publish(ctx, schedule.EntityID, schedule.CreatedBy)
+	case domain.ScheduleActionUnpublish:
+		return target.Unpublish(ctx, schedule.EntityID, schedule.CreatedBy)
+	default:
+		return domain.NewValidationError(fmt.Sprintf("action %q is not supported", schedule.Action))
+	}
+}
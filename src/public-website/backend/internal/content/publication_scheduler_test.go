@@ -0,0 +1,315 @@
+package content
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryScheduleStore keeps schedules in memory with state store style ETags
+type memoryScheduleStore struct {
+	schedules map[string]domain.PublicationSchedule
+	versions  int
+}
+
+func newMemoryScheduleStore() *memoryScheduleStore {
+	return &memoryScheduleStore{schedules: make(map[string]domain.PublicationSchedule)}
+}
+
+func (m *memoryScheduleStore) SaveSchedule(ctx context.Context, schedule *domain.PublicationSchedule) error {
+	if stored, exists := m.schedules[schedule.ScheduleID]; exists && stored.ETag != schedule.ETag {
+		return domain.NewConflictError("schedule was modified concurrently")
+	}
+	m.versions++
+	schedule.ETag = fmt.Sprintf("%d", m.versions)
+	m.schedules[schedule.ScheduleID] = *schedule
+	return nil
+}
+
+func (m *memoryScheduleStore) GetSchedule(ctx context.Context, scheduleID string) (*domain.PublicationSchedule, error) {
+	stored, exists := m.schedules[scheduleID]
+	if !exists {
+		return nil, domain.NewNotFoundError("schedule", scheduleID)
+	}
+	return &stored, nil
+}
+
+func (m *memoryScheduleStore) ListSchedules(ctx context.Context, filter ScheduleFilter) ([]*domain.PublicationSchedule, error) {
+	var schedules []*domain.PublicationSchedule
+	for _, stored := range m.schedules {
+		schedule := stored
+		if filter.Status != "" && schedule.Status != filter.Status {
+			continue
+		}
+		if filter.EntityType != "" && schedule.EntityType != filter.EntityType {
+			continue
+		}
+		if filter.EntityID != "" && schedule.EntityID != filter.EntityID {
+			continue
+		}
+		schedules = append(schedules, &schedule)
+	}
+	sort.Slice(schedules, func(i, j int) bool { return schedules[i].RunAt.Before(schedules[j].RunAt) })
+	return schedules, nil
+}
+
+func (m *memoryScheduleStore) ListActiveSchedules(ctx context.Context) ([]*domain.PublicationSchedule, error) {
+	pending, _ := m.ListSchedules(ctx, ScheduleFilter{Status: domain.ScheduleStatusPending})
+	running, _ := m.ListSchedules(ctx, ScheduleFilter{Status: domain.ScheduleStatusRunning})
+	return append(pending, running...), nil
+}
+
+// recordingTarget records the transitions the scheduler applies
+type recordingTarget struct {
+	published   []string
+	unpublished []string
+	embargoes   map[string]*time.Time
+	publishErr  error
+}
+
+func (r *recordingTarget) target(withEmbargo bool) PublicationTarget {
+	target := PublicationTarget{
+		Publish: func(ctx context.Context, entityID string, userID string) error {
+			if r.publishErr != nil {
+				return r.publishErr
+			}
+			r.published = append(r.published, entityID+"@"+userID)
+			return nil
+		},
+		Unpublish: func(ctx context.Context, entityID string, userID string) error {
+			r.unpublished = append(r.unpublished, entityID+"@"+userID)
+			return nil
+		},
+	}
+	if withEmbargo {
+		r.embargoes = make(map[string]*time.Time)
+		target.Embargo = func(ctx context.Context, entityID string, until *time.Time, userID string) error {
+			r.embargoes[entityID] = until
+			return nil
+		}
+	}
+	return target
+}
+
+func newTestScheduler(now *time.Time) (*PublicationScheduler, *memoryScheduleStore, *recordingTarget, *recordingTarget) {
+	store := newMemoryScheduleStore()
+	scheduler := NewPublicationScheduler(store)
+	scheduler.now = func() time.Time { return *now }
+
+	news := &recordingTarget{}
+	research := &recordingTarget{}
+	scheduler.Register(domain.EntityTypeNews, news.target(false))
+	scheduler.Register(domain.EntityTypeResearch, research.target(true))
+	return scheduler, store, news, research
+}
+
+func TestPublicationScheduler_CreateSchedule(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	releaseAt := now.Add(24 * time.Hour)
+
+	tests := []struct {
+		name        string
+		request     ScheduleRequest
+		userID      string
+		wantErrType string
+		wantEmbargo bool
+	}{
+		{
+			name:    "schedule news publication",
+			request: ScheduleRequest{EntityType: domain.EntityTypeNews, EntityID: "news-1", Action: domain.ScheduleActionPublish, RunAt: releaseAt},
+			userID:  "admin-1",
+		},
+		{
+			name:        "embargo research until release",
+			request:     ScheduleRequest{EntityType: domain.EntityTypeResearch, EntityID: "research-1", Action: domain.ScheduleActionPublish, RunAt: releaseAt, Embargo: true},
+			userID:      "admin-1",
+			wantEmbargo: true,
+		},
+		{
+			name:        "reject embargo on content without embargo support",
+			request:     ScheduleRequest{EntityType: domain.EntityTypeNews, EntityID: "news-1", Action: domain.ScheduleActionPublish, RunAt: releaseAt, Embargo: true},
+			userID:      "admin-1",
+			wantErrType: "validation",
+		},
+		{
+			name:        "reject unregistered content type",
+			request:     ScheduleRequest{EntityType: domain.EntityTypeBusinessInquiry, EntityID: "inquiry-1", Action: domain.ScheduleActionPublish, RunAt: releaseAt},
+			userID:      "admin-1",
+			wantErrType: "validation",
+		},
+		{
+			name:        "reject past run time",
+			request:     ScheduleRequest{EntityType: domain.EntityTypeNews, EntityID: "news-1", Action: domain.ScheduleActionPublish, RunAt: now.Add(-time.Hour)},
+			userID:      "admin-1",
+			wantErrType: "validation",
+		},
+		{
+			name:        "reject missing admin",
+			request:     ScheduleRequest{EntityType: domain.EntityTypeNews, EntityID: "news-1", Action: domain.ScheduleActionPublish, RunAt: releaseAt},
+			wantErrType: "unauthorized",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			scheduler, store, _, research := newTestScheduler(&now)
+
+			// Act
+			schedule, err := scheduler.CreateSchedule(ctx, tt.request, tt.userID)
+
+			// Assert
+			if tt.wantErrType != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErrType == "validation", domain.IsValidationError(err))
+				assert.Equal(t, tt.wantErrType == "unauthorized", domain.IsUnauthorizedError(err))
+				assert.Empty(t, store.schedules)
+				return
+			}
+			require.NoError(t, err)
+			assert.Contains(t, store.schedules, schedule.ScheduleID)
+			if tt.wantEmbargo {
+				require.NotNil(t, research.embargoes["research-1"])
+				assert.Equal(t, releaseAt, *research.embargoes["research-1"])
+			}
+		})
+	}
+}
+
+func TestPublicationScheduler_CancelSchedule(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	scheduler, store, _, research := newTestScheduler(&now)
+
+	schedule, err := scheduler.CreateSchedule(ctx, ScheduleRequest{
+		EntityType: domain.EntityTypeResearch, EntityID: "research-1", Action: domain.ScheduleActionPublish, RunAt: now.Add(time.Hour), Embargo: true,
+	}, "admin-1")
+	require.NoError(t, err)
+
+	t.Run("cancel lifts the embargo", func(t *testing.T) {
+		cancelled, err := scheduler.CancelSchedule(ctx, schedule.ScheduleID, "admin-2")
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.ScheduleStatusCancelled, cancelled.Status)
+		assert.Equal(t, domain.ScheduleStatusCancelled, store.schedules[schedule.ScheduleID].Status)
+		assert.Contains(t, research.embargoes, "research-1")
+		assert.Nil(t, research.embargoes["research-1"])
+	})
+
+	t.Run("reject cancelling twice", func(t *testing.T) {
+		_, err := scheduler.CancelSchedule(ctx, schedule.ScheduleID, "admin-2")
+
+		require.Error(t, err)
+		assert.True(t, domain.IsConflictError(err))
+	})
+
+	t.Run("reject unknown schedule", func(t *testing.T) {
+		_, err := scheduler.CancelSchedule(ctx, "missing", "admin-2")
+
+		require.Error(t, err)
+		assert.True(t, domain.IsNotFoundError(err))
+	})
+}
+
+func TestPublicationScheduler_RunDue(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("fire due transitions as the scheduling admin", func(t *testing.T) {
+		// Arrange
+		now := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+		scheduler, store, news, _ := newTestScheduler(&now)
+		publish, err := scheduler.CreateSchedule(ctx, ScheduleRequest{EntityType: domain.EntityTypeNews, EntityID: "news-1", Action: domain.ScheduleActionPublish, RunAt: now.Add(time.Hour)}, "admin-1")
+		require.NoError(t, err)
+		_, err = scheduler.CreateSchedule(ctx, ScheduleRequest{EntityType: domain.EntityTypeNews, EntityID: "news-2", Action: domain.ScheduleActionUnpublish, RunAt: now.Add(3 * time.Hour)}, "admin-1")
+		require.NoError(t, err)
+		now = now.Add(2 * time.Hour)
+
+		// Act
+		fired, err := scheduler.RunDue(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 1, fired)
+		assert.Equal(t, []string{"news-1@admin-1"}, news.published)
+		assert.Empty(t, news.unpublished)
+		assert.Equal(t, domain.ScheduleStatusCompleted, store.schedules[publish.ScheduleID].Status)
+	})
+
+	t.Run("retry failed transition on a later run", func(t *testing.T) {
+		// Arrange
+		now := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+		scheduler, store, news, _ := newTestScheduler(&now)
+		schedule, err := scheduler.CreateSchedule(ctx, ScheduleRequest{EntityType: domain.EntityTypeNews, EntityID: "news-1", Action: domain.ScheduleActionPublish, RunAt: now.Add(time.Minute)}, "admin-1")
+		require.NoError(t, err)
+		news.publishErr = errors.New("state store unavailable")
+		now = now.Add(time.Minute)
+
+		// Act
+		fired, err := scheduler.RunDue(ctx)
+		require.NoError(t, err)
+		news.publishErr = nil
+		now = now.Add(time.Minute)
+		refired, err := scheduler.RunDue(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 0, fired)
+		assert.Equal(t, 1, refired)
+		stored := store.schedules[schedule.ScheduleID]
+		assert.Equal(t, domain.ScheduleStatusCompleted, stored.Status)
+		assert.Equal(t, 2, stored.Attempts)
+	})
+
+	t.Run("resume schedule whose worker stopped mid transition", func(t *testing.T) {
+		// Arrange
+		now := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+		scheduler, store, news, _ := newTestScheduler(&now)
+		schedule, err := scheduler.CreateSchedule(ctx, ScheduleRequest{EntityType: domain.EntityTypeNews, EntityID: "news-1", Action: domain.ScheduleActionPublish, RunAt: now.Add(time.Minute)}, "admin-1")
+		require.NoError(t, err)
+		stored := store.schedules[schedule.ScheduleID]
+		stored.Claim(now.Add(time.Minute), scheduleLease)
+		require.NoError(t, store.SaveSchedule(ctx, &stored))
+
+		// Act
+		now = now.Add(time.Minute + time.Second)
+		beforeLeaseExpiry, err := scheduler.RunDue(ctx)
+		require.NoError(t, err)
+		now = now.Add(scheduleLease)
+		afterLeaseExpiry, err := scheduler.RunDue(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 0, beforeLeaseExpiry)
+		assert.Equal(t, 1, afterLeaseExpiry)
+		assert.Equal(t, []string{"news-1@admin-1"}, news.published)
+	})
+
+	t.Run("skip schedule claimed by another worker", func(t *testing.T) {
+		// Arrange
+		now := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+		scheduler, store, news, _ := newTestScheduler(&now)
+		schedule, err := scheduler.CreateSchedule(ctx, ScheduleRequest{EntityType: domain.EntityTypeNews, EntityID: "news-1", Action: domain.ScheduleActionPublish, RunAt: now.Add(time.Minute)}, "admin-1")
+		require.NoError(t, err)
+		stale := store.schedules[schedule.ScheduleID]
+		claimed := stale
+		claimed.Claim(now, scheduleLease)
+		require.NoError(t, store.SaveSchedule(ctx, &claimed))
+		now = now.Add(time.Minute)
+
+		// Act
+		ok, err := scheduler.fire(ctx, &stale)
+
+		// Assert
+		require.NoError(t, err)
+		assert.False(t, ok)
+		assert.Empty(t, news.published)
+	})
+}
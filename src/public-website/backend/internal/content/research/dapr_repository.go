@@ -4,36 +4,93 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/search"
 )
 
 // ResearchRepository implements research data access using Dapr state store and bindings
 type ResearchRepository struct {
-	stateStore *dapr.StateStore
-	bindings   *dapr.Bindings
-	pubsub     *dapr.PubSub
+	stateStore  *dapr.StateStore
+	bindings    *dapr.Bindings
+	pubsub      *dapr.PubSub
+	searchIndex *search.Index
+}
+
+// Secondary indexes maintained for research articles
+const (
+	researchIndexCategory         = "category"
+	researchIndexPublishingStatus = "publishing_status"
+	researchIndexSlug             = "slug"
+	researchIndexIsDeleted        = "is_deleted"
+	researchIndexPublicationMonth = "publication_month"
+)
+
+// ResearchIndexes declares the secondary indexes maintained for research articles
+func ResearchIndexes() *dapr.IndexedEntityType {
+	return &dapr.IndexedEntityType{
+		Domain:     "research",
+		EntityType: "research",
+		Indexes: []dapr.IndexDefinition{
+			{Name: researchIndexCategory, Extract: func(entity interface{}) []string {
+				return []string{entity.(*Research).CategoryID}
+			}},
+			{Name: researchIndexPublishingStatus, Extract: func(entity interface{}) []string {
+				return []string{string(entity.(*Research).PublishingStatus)}
+			}},
+			{Name: researchIndexSlug, Extract: func(entity interface{}) []string {
+				return []string{entity.(*Research).Slug}
+			}},
+			{Name: researchIndexIsDeleted, Extract: func(entity interface{}) []string {
+				return []string{dapr.IndexBool(entity.(*Research).IsDeleted)}
+			}},
+			{Name: researchIndexPublicationMonth, Extract: func(entity interface{}) []string {
+				if publicationDate := entity.(*Research).PublicationDate; publicationDate != nil {
+					return []string{dapr.IndexMonthBucket(*publicationDate)}
+				}
+				return nil
+			}},
+		},
+		NewEntity: func() interface{} { return &Research{} },
+		EntityID:  func(entity interface{}) string { return entity.(*Research).ResearchID },
+		SortKey:   func(entity interface{}) time.Time { return entity.(*Research).PageCursor().Timestamp },
+	}
 }
 
 // NewResearchRepository creates a new research repository with Dapr integration
 func NewResearchRepository(stateStore *dapr.StateStore, bindings *dapr.Bindings, pubsub *dapr.PubSub) ResearchRepositoryInterface {
+	stateStore.MustRegisterIndexes(ResearchIndexes())
+	stateStore.MustRegisterIndexes(dapr.OutboxMessageIndexes())
+
 	return &ResearchRepository{
-		stateStore: stateStore,
-		bindings:   bindings,
-		pubsub:     pubsub,
+		stateStore:  stateStore,
+		bindings:    bindings,
+		pubsub:      pubsub,
+		searchIndex: newResearchSearchIndex(),
 	}
 }
 
 // Research operations
 
-// SaveResearch saves a research article to the state store
-func (r *ResearchRepository) SaveResearch(ctx context.Context, research *Research) error {
-	key := r.stateStore.CreateKey("research", "research", research.ResearchID)
-	
-	err := r.stateStore.Save(ctx, key, research, nil)
+// SaveResearch saves a research article and its secondary indexes to the state store,
+// committing the audit event that describes the change in the same transaction
+func (r *ResearchRepository) SaveResearch(ctx context.Context, research *Research, audit *domain.AuditEvent) error {
+	messages, err := r.auditMessages(ctx, audit)
+	if err != nil {
+		return err
+	}
+
+	// Changes to publications are versioned so editors can compare and restore them
+	var revision *domain.Revision
+	if audit != nil && audit.EntityType == domain.EntityTypeResearch && domain.IsRevisionedOperation(audit.OperationType) {
+		revision = domain.NewRevision(audit)
+	}
+
+	err = r.stateStore.SaveIndexedWithRevision(ctx, "research", "research", research.ResearchID, research, "", revision, messages...)
 	if err != nil {
 		return fmt.Errorf("failed to save research %s: %w", research.ResearchID, err)
 	}
@@ -58,31 +115,24 @@ func (r *ResearchRepository) GetResearch(ctx context.Context, researchID string)
 	return &research, nil
 }
 
-// GetResearchBySlug retrieves research by slug from state store
+// GetResearchBySlug retrieves research by slug using the slug index
 func (r *ResearchRepository) GetResearchBySlug(ctx context.Context, slug string) (*Research, error) {
-	// Query by slug using Dapr state store query
-	query := fmt.Sprintf(`{
-		"filter": {
-			"AND": [
-				{"EQ": {"slug": "%s"}},
-				{"EQ": {"is_deleted": false}}
-			]
-		}
-	}`, slug)
+	ids, err := r.stateStore.LookupIndex(ctx, "research", "research",
+		dapr.IndexCondition{Index: researchIndexSlug, Value: slug},
+		dapr.IndexCondition{Index: researchIndexIsDeleted, Value: dapr.IndexBool(false)},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up research by slug %s: %w", slug, err)
+	}
 
-	results, err := r.stateStore.Query(ctx, query)
+	researchList, err := r.getResearchByIDs(ctx, ids)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query research by slug %s: %w", slug, err)
+		return nil, err
 	}
 
-	for _, result := range results {
-		var research Research
-		err = json.Unmarshal(result.Value, &research)
-		if err != nil {
-			continue
-		}
-		if !research.IsDeleted && research.Slug == slug {
-			return &research, nil
+	for _, research := range researchList {
+		if research.Slug == slug {
+			return research, nil
 		}
 	}
 
@@ -91,153 +141,130 @@ func (r *ResearchRepository) GetResearchBySlug(ctx context.Context, slug string)
 
 // GetAllResearch retrieves all research from state store with pagination
 func (r *ResearchRepository) GetAllResearch(ctx context.Context, limit, offset int) ([]*Research, error) {
-	query := `{
-		"filter": {
-			"EQ": {"is_deleted": false}
-		},
-		"sort": [
-			{
-				"key": "created_on",
-				"order": "DESC"
-			}
-		]
-	}`
-
-	results, err := r.stateStore.Query(ctx, query)
+	ids, err := r.stateStore.LookupIndex(ctx, "research", "research",
+		dapr.IndexCondition{Index: researchIndexIsDeleted, Value: dapr.IndexBool(false)},
+	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query all research: %w", err)
+		return nil, fmt.Errorf("failed to look up all research: %w", err)
 	}
 
-	var researchList []*Research
-	count := 0
-	for _, result := range results {
-		// Apply offset
-		if count < offset {
-			count++
-			continue
-		}
-		
-		// Apply limit
-		if len(researchList) >= limit {
-			break
-		}
-
-		var research Research
-		err = json.Unmarshal(result.Value, &research)
-		if err != nil {
-			continue // Skip invalid records
-		}
-		if !research.IsDeleted {
-			researchList = append(researchList, &research)
-		}
-		count++
+	researchList, err := r.getResearchByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
 	}
 
-	return researchList, nil
+	return applyPagination(researchList, limit, offset), nil
 }
 
 // GetResearchByCategory retrieves research by category from state store with pagination
 func (r *ResearchRepository) GetResearchByCategory(ctx context.Context, categoryID string, limit, offset int) ([]*Research, error) {
-	query := fmt.Sprintf(`{
-		"filter": {
-			"AND": [
-				{"EQ": {"category_id": "%s"}},
-				{"EQ": {"is_deleted": false}}
-			]
-		},
-		"sort": [
-			{
-				"key": "created_on", 
-				"order": "DESC"
-			}
-		]
-	}`, categoryID)
-
-	results, err := r.stateStore.Query(ctx, query)
+	ids, err := r.stateStore.LookupIndex(ctx, "research", "research",
+		dapr.IndexCondition{Index: researchIndexCategory, Value: categoryID},
+		dapr.IndexCondition{Index: researchIndexIsDeleted, Value: dapr.IndexBool(false)},
+	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query research by category %s: %w", categoryID, err)
+		return nil, fmt.Errorf("failed to look up research by category %s: %w", categoryID, err)
 	}
 
-	var researchList []*Research
-	count := 0
-	for _, result := range results {
-		// Apply offset
-		if count < offset {
-			count++
-			continue
-		}
-		
-		// Apply limit
-		if len(researchList) >= limit {
-			break
-		}
-
-		var research Research
-		err = json.Unmarshal(result.Value, &research)
-		if err != nil {
-			continue
-		}
-		if !research.IsDeleted && research.CategoryID == categoryID {
-			researchList = append(researchList, &research)
-		}
-		count++
+	researchList, err := r.getResearchByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
 	}
 
-	return researchList, nil
+	return applyPagination(researchList, limit, offset), nil
 }
 
 // GetResearchByPublishingStatus retrieves research by publishing status with pagination
 func (r *ResearchRepository) GetResearchByPublishingStatus(ctx context.Context, status PublishingStatus, limit, offset int) ([]*Research, error) {
-	query := fmt.Sprintf(`{
-		"filter": {
-			"AND": [
-				{"EQ": {"publishing_status": "%s"}},
-				{"EQ": {"is_deleted": false}}
-			]
-		},
-		"sort": [
-			{
-				"key": "created_on",
-				"order": "DESC"
-			}
-		]
-	}`, status)
+	ids, err := r.stateStore.LookupIndex(ctx, "research", "research",
+		dapr.IndexCondition{Index: researchIndexPublishingStatus, Value: string(status)},
+		dapr.IndexCondition{Index: researchIndexIsDeleted, Value: dapr.IndexBool(false)},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up research by status %s: %w", status, err)
+	}
 
-	results, err := r.stateStore.Query(ctx, query)
+	researchList, err := r.getResearchByIDs(ctx, ids)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query research by status %s: %w", status, err)
+		return nil, err
 	}
 
-	var researchList []*Research
-	count := 0
-	for _, result := range results {
-		// Apply offset
-		if count < offset {
-			count++
-			continue
-		}
-		
-		// Apply limit
-		if len(researchList) >= limit {
-			break
-		}
+	return applyPagination(researchList, limit, offset), nil
+}
 
-		var research Research
-		err = json.Unmarshal(result.Value, &research)
-		if err != nil {
-			continue
-		}
-		if !research.IsDeleted && research.PublishingStatus == status {
-			researchList = append(researchList, &research)
+// getResearchByIDs loads indexed research articles, most recently created first
+func (r *ResearchRepository) getResearchByIDs(ctx context.Context, ids []string) ([]*Research, error) {
+	researchList := make([]*Research, 0, len(ids))
+	if len(ids) == 0 {
+		return researchList, nil
+	}
+
+	keys := make([]string, 0, len(ids))
+	targets := make(map[string]interface{}, len(ids))
+	loaded := make([]*Research, 0, len(ids))
+	for _, id := range ids {
+		key := r.stateStore.CreateKey("research", "research", id)
+		research := &Research{}
+		keys = append(keys, key)
+		targets[key] = research
+		loaded = append(loaded, research)
+	}
+
+	if err := r.stateStore.GetBulk(ctx, keys, targets); err != nil {
+		return nil, fmt.Errorf("failed to load indexed research: %w", err)
+	}
+
+	for _, research := range loaded {
+		if research.ResearchID != "" && !research.IsDeleted {
+			researchList = append(researchList, research)
 		}
-		count++
 	}
 
+	sort.SliceStable(researchList, func(i, j int) bool {
+		return researchList[i].PageCursor().Before(researchList[j].PageCursor())
+	})
+
 	return researchList, nil
 }
 
+// ListResearchPage retrieves one page of non-deleted research matching the filter,
+// newest publication first, resuming after the page cursor
+func (r *ResearchRepository) ListResearchPage(ctx context.Context, filter ResearchListFilter, page domain.PageRequest) ([]*Research, domain.PageInfo, error) {
+	conditions := []dapr.IndexCondition{
+		{Index: researchIndexIsDeleted, Value: dapr.IndexBool(false)},
+	}
+	if filter.CategoryID != "" {
+		conditions = append(conditions, dapr.IndexCondition{Index: researchIndexCategory, Value: filter.CategoryID})
+	}
+	if filter.PublishingStatus != "" {
+		conditions = append(conditions, dapr.IndexCondition{Index: researchIndexPublishingStatus, Value: string(filter.PublishingStatus)})
+	}
+
+	ids, info, err := r.stateStore.LookupIndexPage(ctx, "research", "research", page, conditions...)
+	if err != nil {
+		return nil, domain.PageInfo{}, fmt.Errorf("failed to look up research page: %w", err)
+	}
+
+	researchList, err := r.getResearchByIDs(ctx, ids)
+	if err != nil {
+		return nil, domain.PageInfo{}, err
+	}
+
+	info.Count = len(researchList)
+	return researchList, info, nil
+}
+
+// PageResearch slices a listing already ordered by PageCursor into the requested page
+func PageResearch(researchList []*Research, page domain.PageRequest) ([]*Research, domain.PageInfo) {
+	start, end, info := domain.CursorPage(len(researchList), func(i int) domain.PageCursor {
+		return researchList[i].PageCursor()
+	}, page)
+
+	return researchList[start:end], info
+}
+
 // DeleteResearch soft deletes research from state store
-func (r *ResearchRepository) DeleteResearch(ctx context.Context, researchID string) error {
+func (r *ResearchRepository) DeleteResearch(ctx context.Context, researchID string, audit *domain.AuditEvent) error {
 	// Get existing research
 	research, err := r.GetResearch(ctx, researchID)
 	if err != nil {
@@ -249,41 +276,83 @@ func (r *ResearchRepository) DeleteResearch(ctx context.Context, researchID stri
 	research.IsDeleted = true
 	research.DeletedOn = &now
 
-	return r.SaveResearch(ctx, research)
+	return r.SaveResearch(ctx, research, audit)
 }
 
-// SearchResearch searches research articles based on search term with pagination
+// SearchResearch returns one window of non-deleted research articles matching
+// the search term, best match first
 func (r *ResearchRepository) SearchResearch(ctx context.Context, searchTerm string, limit, offset int) ([]*Research, error) {
-	// Get all non-deleted research first
-	allResearch, err := r.GetAllResearch(ctx, 1000, 0) // Get up to 1000 for search
+	if strings.TrimSpace(searchTerm) == "" {
+		return r.GetAllResearch(ctx, limit, offset)
+	}
+
+	results, err := r.QueryResearch(ctx, search.Query{Text: searchTerm, Limit: limit, Offset: offset})
 	if err != nil {
 		return nil, err
 	}
 
-	if searchTerm == "" {
-		return applyPagination(allResearch, limit, offset), nil
+	return researchFromHits(results.Hits), nil
+}
+
+// QueryResearch runs a full-text query against the research search index,
+// building the index from the state store on first use
+func (r *ResearchRepository) QueryResearch(ctx context.Context, query search.Query) (*search.Results, error) {
+	if err := r.searchIndex.EnsureLoaded(ctx, r.loadResearchSearchDocuments); err != nil {
+		return nil, err
 	}
 
-	// Simple text search in title, abstract, content, and keywords
-	var results []*Research
-	searchLower := strings.ToLower(searchTerm)
-	
-	for _, research := range allResearch {
-		if r.matchesSearchTerm(research, searchLower) {
-			results = append(results, research)
-		}
+	return r.searchIndex.Search(query), nil
+}
+
+// ReindexResearch refreshes the search document of one research article from
+// the state store, dropping it from the index once the article is deleted
+func (r *ResearchRepository) ReindexResearch(ctx context.Context, researchID string) error {
+	if err := r.searchIndex.EnsureLoaded(ctx, r.loadResearchSearchDocuments); err != nil {
+		return err
+	}
+
+	research, err := r.GetResearch(ctx, researchID)
+	if domain.IsNotFoundError(err) {
+		r.searchIndex.Remove(researchID)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	r.searchIndex.Upsert(researchSearchDocument(research))
+	return nil
+}
+
+// loadResearchSearchDocuments loads every non-deleted research article for indexing
+func (r *ResearchRepository) loadResearchSearchDocuments(ctx context.Context) ([]search.Document, error) {
+	ids, err := r.stateStore.LookupIndex(ctx, "research", "research",
+		dapr.IndexCondition{Index: researchIndexIsDeleted, Value: dapr.IndexBool(false)},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up research for search index: %w", err)
+	}
+
+	researchList, err := r.getResearchByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
 	}
 
-	return applyPagination(results, limit, offset), nil
+	return researchSearchDocuments(researchList), nil
 }
 
 // Research category operations
 
-// SaveResearchCategory saves a research category to state store
-func (r *ResearchRepository) SaveResearchCategory(ctx context.Context, category *ResearchCategory) error {
+// SaveResearchCategory saves a research category to state store along with its audit event
+func (r *ResearchRepository) SaveResearchCategory(ctx context.Context, category *ResearchCategory, audit *domain.AuditEvent) error {
+	messages, err := r.auditMessages(ctx, audit)
+	if err != nil {
+		return err
+	}
+
 	key := r.stateStore.CreateKey("research", "category", category.CategoryID)
 	
-	err := r.stateStore.Save(ctx, key, category, nil)
+	err = r.stateStore.SaveWithOutbox(ctx, key, category, messages...)
 	if err != nil {
 		return fmt.Errorf("failed to save research category %s: %w", category.CategoryID, err)
 	}
@@ -397,7 +466,7 @@ func (r *ResearchRepository) GetDefaultUnassignedCategory(ctx context.Context) (
 }
 
 // DeleteResearchCategory soft deletes a research category
-func (r *ResearchRepository) DeleteResearchCategory(ctx context.Context, categoryID string) error {
+func (r *ResearchRepository) DeleteResearchCategory(ctx context.Context, categoryID string, audit *domain.AuditEvent) error {
 	// Get existing category
 	category, err := r.GetResearchCategory(ctx, categoryID)
 	if err != nil {
@@ -409,16 +478,21 @@ func (r *ResearchRepository) DeleteResearchCategory(ctx context.Context, categor
 	category.IsDeleted = true
 	category.DeletedOn = &now
 
-	return r.SaveResearchCategory(ctx, category)
+	return r.SaveResearchCategory(ctx, category, audit)
 }
 
 // Featured research operations
 
-// SaveFeaturedResearch saves featured research to state store
-func (r *ResearchRepository) SaveFeaturedResearch(ctx context.Context, featured *FeaturedResearch) error {
+// SaveFeaturedResearch saves featured research to state store along with its audit event
+func (r *ResearchRepository) SaveFeaturedResearch(ctx context.Context, featured *FeaturedResearch, audit *domain.AuditEvent) error {
+	messages, err := r.auditMessages(ctx, audit)
+	if err != nil {
+		return err
+	}
+
 	key := r.stateStore.CreateKey("research", "featured", featured.FeaturedResearchID)
 	
-	err := r.stateStore.Save(ctx, key, featured, nil)
+	err = r.stateStore.SaveWithOutbox(ctx, key, featured, messages...)
 	if err != nil {
 		return fmt.Errorf("failed to save featured research %s: %w", featured.FeaturedResearchID, err)
 	}
@@ -448,11 +522,16 @@ func (r *ResearchRepository) GetFeaturedResearch(ctx context.Context) (*Featured
 	return nil, domain.NewNotFoundError("featured research", "")
 }
 
-// DeleteFeaturedResearch removes featured research
-func (r *ResearchRepository) DeleteFeaturedResearch(ctx context.Context, featuredResearchID string) error {
+// DeleteFeaturedResearch removes featured research along with recording its audit event
+func (r *ResearchRepository) DeleteFeaturedResearch(ctx context.Context, featuredResearchID string, audit *domain.AuditEvent) error {
+	messages, err := r.auditMessages(ctx, audit)
+	if err != nil {
+		return err
+	}
+
 	key := r.stateStore.CreateKey("research", "featured", featuredResearchID)
 	
-	err := r.stateStore.Delete(ctx, key, nil)
+	err = r.stateStore.DeleteWithOutbox(ctx, key, messages...)
 	if err != nil {
 		return fmt.Errorf("failed to delete featured research %s: %w", featuredResearchID, err)
 	}
@@ -462,15 +541,39 @@ func (r *ResearchRepository) DeleteFeaturedResearch(ctx context.Context, feature
 
 // Audit operations
 
-// PublishAuditEvent publishes audit event to Grafana Loki via Dapr
+// PublishAuditEvent publishes an audit event that no write accompanies, such as an access,
+// straight to Grafana Loki via Dapr. Audit events for changes are committed with the
+// change through the outbox instead.
 func (r *ResearchRepository) PublishAuditEvent(ctx context.Context, entityType domain.EntityType, entityID string, operationType domain.AuditEventType, userID string, beforeData, afterData interface{}) error {
-	correlationID := domain.GetCorrelationID(ctx)
-	traceID := domain.GetTraceID(ctx)
-	
-	auditEvent := domain.NewAuditEvent(entityType, entityID, operationType, userID)
-	auditEvent.SetTraceContext(correlationID, traceID)
+	auditEvent := domain.NewAuditChange(entityType, entityID, operationType, userID, beforeData, afterData)
+
+	err := r.pubsub.PublishAuditEvent(ctx, r.daprAuditEvent(ctx, auditEvent))
+	if err != nil {
+		return fmt.Errorf("failed to publish audit event for %s %s: %w", entityType, entityID, err)
+	}
+
+	return nil
+}
+
+// auditMessages prepares the audit event of a change for the outbox; a nil event prepares nothing
+func (r *ResearchRepository) auditMessages(ctx context.Context, auditEvent *domain.AuditEvent) ([]*dapr.OutboxMessage, error) {
+	if auditEvent == nil {
+		return nil, nil
+	}
+
+	message, err := r.pubsub.AuditEventMessage(r.daprAuditEvent(ctx, auditEvent))
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare audit event for %s %s: %w", auditEvent.EntityType, auditEvent.EntityID, err)
+	}
+
+	return []*dapr.OutboxMessage{dapr.NewOutboxMessage(ctx, message.Topic, message)}, nil
+}
+
+// daprAuditEvent stamps an audit event with the request's trace context and converts it
+// for publishing
+func (r *ResearchRepository) daprAuditEvent(ctx context.Context, auditEvent *domain.AuditEvent) *dapr.AuditEvent {
+	auditEvent.SetTraceContext(domain.GetCorrelationID(ctx), domain.GetTraceID(ctx))
 	auditEvent.SetEnvironmentContext("development", "research-api-1.0.0")
-	auditEvent.SetDataSnapshot(beforeData, afterData)
 
 	// Convert domain.AuditEvent to dapr.AuditEvent
 	daprAuditEvent := &dapr.AuditEvent{
@@ -495,12 +598,29 @@ func (r *ResearchRepository) PublishAuditEvent(ctx context.Context, entityType d
 		}
 	}
 
-	err := r.pubsub.PublishAuditEvent(ctx, daprAuditEvent)
+	return daprAuditEvent
+}
+
+// Revision operations
+
+// ListResearchRevisions returns the revision summaries of a publication, newest first
+func (r *ResearchRepository) ListResearchRevisions(ctx context.Context, researchID string) ([]*domain.Revision, error) {
+	revisions, err := r.stateStore.ListRevisions(ctx, "research", "research", researchID)
 	if err != nil {
-		return fmt.Errorf("failed to publish audit event for %s %s: %w", entityType, entityID, err)
+		return nil, fmt.Errorf("failed to list revisions for research %s: %w", researchID, err)
 	}
 
-	return nil
+	return revisions, nil
+}
+
+// GetResearchRevision retrieves one revision of a publication including its data snapshot
+func (r *ResearchRepository) GetResearchRevision(ctx context.Context, researchID string, revision int) (*domain.Revision, error) {
+	return r.stateStore.GetRevision(ctx, "research", "research", researchID, revision)
+}
+
+// PublishReviewEvent notifies reviewers and authors about a publication's review
+func (r *ResearchRepository) PublishReviewEvent(ctx context.Context, event *domain.ReviewEvent) error {
+	return r.pubsub.PublishReviewEvent(ctx, event)
 }
 
 // GetResearchAudit retrieves audit events for research via Dapr bindings
@@ -605,24 +725,6 @@ func applyPagination(research []*Research, limit, offset int) []*Research {
 	return research[startIdx:endIdx]
 }
 
-// Helper function to check if research matches search term
-func (r *ResearchRepository) matchesSearchTerm(research *Research, searchLower string) bool {
-	titleMatch := strings.Contains(strings.ToLower(research.Title), searchLower)
-	abstractMatch := strings.Contains(strings.ToLower(research.Abstract), searchLower)
-	contentMatch := strings.Contains(strings.ToLower(research.Content), searchLower)
-	
-	// Search in keywords
-	keywordMatch := false
-	for _, keyword := range research.Keywords {
-		if strings.Contains(strings.ToLower(keyword), searchLower) {
-			keywordMatch = true
-			break
-		}
-	}
-	
-	return titleMatch || abstractMatch || contentMatch || keywordMatch
-}
-
 // Helper function to safely get string from map
 func getString(m map[string]interface{}, key string) string {
 	if value, ok := m[key].(string); ok {
@@ -23,6 +23,8 @@ const (
 
 const (
 	PublishingStatusDraft     PublishingStatus = "draft"
+	PublishingStatusInReview  PublishingStatus = "in_review"
+	PublishingStatusApproved  PublishingStatus = "approved"
 	PublishingStatusPublished PublishingStatus = "published"
 	PublishingStatusArchived  PublishingStatus = "archived"
 )
@@ -41,7 +43,7 @@ func (rt ResearchType) IsValid() bool {
 // IsValid checks if the publishing status is valid  
 func (ps PublishingStatus) IsValid() bool {
 	switch ps {
-	case PublishingStatusDraft, PublishingStatusPublished, PublishingStatusArchived:
+	case PublishingStatusDraft, PublishingStatusInReview, PublishingStatusApproved, PublishingStatusPublished, PublishingStatusArchived:
 		return true
 	default:
 		return false
@@ -63,6 +65,7 @@ type Research struct {
 	ExternalURL       string           `json:"external_url,omitempty"`
 	ReportURL         string           `json:"report_url,omitempty"`
 	PublishingStatus  PublishingStatus `json:"publishing_status"`
+	EmbargoUntil      *time.Time       `json:"embargo_until,omitempty"`
 	Keywords          []string         `json:"keywords,omitempty"`
 	ResearchType      ResearchType     `json:"research_type"`
 	CreatedOn         time.Time        `json:"created_on"`
@@ -72,6 +75,7 @@ type Research struct {
 	IsDeleted         bool             `json:"is_deleted"`
 	DeletedOn         *time.Time       `json:"deleted_on,omitempty"`
 	DeletedBy         string           `json:"deleted_by,omitempty"`
+	Review            *domain.EditorialReview `json:"review,omitempty"`
 }
 
 // ResearchCategory represents research categories matching TABLES-RESEARCH.md
@@ -100,6 +104,22 @@ type FeaturedResearch struct {
 	ModifiedBy         string     `json:"modified_by,omitempty"`
 }
 
+// ResearchListFilter narrows a paged research listing; empty fields are not applied
+type ResearchListFilter struct {
+	CategoryID       string
+	PublishingStatus PublishingStatus
+}
+
+// PageCursor returns the position of the publication in paged research listings,
+// keyed by publication date and falling back to creation time for unpublished work
+func (r *Research) PageCursor() domain.PageCursor {
+	timestamp := r.CreatedOn
+	if r.PublicationDate != nil {
+		timestamp = *r.PublicationDate
+	}
+	return domain.PageCursor{Timestamp: timestamp, ID: r.ResearchID}
+}
+
 // Research validation methods
 
 func (r *Research) Validate() error {
@@ -196,6 +216,16 @@ func (r *Research) CanBePublished() error {
 	return nil
 }
 
+// ContentReview describes where the publication stands in the editorial workflow
+func (r *Research) ContentReview() *domain.ContentReview {
+	return domain.NewContentReview(domain.EntityTypeResearch, r.ResearchID, r.Title, domain.WorkflowStatus(r.PublishingStatus), r.Review)
+}
+
+// IsEmbargoed reports whether the research is held back from publication at now
+func (r *Research) IsEmbargoed(now time.Time) bool {
+	return r.EmbargoUntil != nil && now.Before(*r.EmbargoUntil)
+}
+
 // ResearchCategory validation methods
 
 func (rc *ResearchCategory) Validate() error {
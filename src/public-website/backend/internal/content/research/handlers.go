@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/search"
 	"github.com/gorilla/mux"
 )
 
@@ -26,12 +27,12 @@ func NewResearchHandler(service *ResearchService) *ResearchHandler {
 func (h *ResearchHandler) RegisterRoutes(router *mux.Router) {
 	// Public GET endpoints
 	router.HandleFunc("/api/v1/research", h.GetAllResearch).Methods("GET")
+	router.HandleFunc("/api/v1/research/search", h.SearchResearch).Methods("GET")
 	router.HandleFunc("/api/v1/research/{id}", h.GetResearch).Methods("GET")
 	router.HandleFunc("/api/v1/research/slug/{slug}", h.GetResearchBySlug).Methods("GET")
 	router.HandleFunc("/api/v1/research/featured", h.GetFeaturedResearch).Methods("GET")
 	router.HandleFunc("/api/v1/research/categories", h.GetAllResearchCategories).Methods("GET")
 	router.HandleFunc("/api/v1/research/categories/{id}/research", h.GetResearchByCategory).Methods("GET")
-	router.HandleFunc("/api/v1/research/search", h.SearchResearch).Methods("GET")
 	router.HandleFunc("/api/v1/research/{id}/report", h.GetResearchReport).Methods("GET")
 	
 	// Admin endpoints - will be handled by admin gateway
@@ -42,6 +43,10 @@ func (h *ResearchHandler) RegisterRoutes(router *mux.Router) {
 	router.HandleFunc("/admin/api/v1/research/{id}/publish", h.PublishResearch).Methods("POST")
 	router.HandleFunc("/admin/api/v1/research/{id}/archive", h.ArchiveResearch).Methods("POST")
 	router.HandleFunc("/admin/api/v1/research/{id}/audit", h.GetResearchAudit).Methods("GET")
+	router.HandleFunc("/admin/api/v1/research/{id}/revisions", h.GetResearchRevisions).Methods("GET")
+	router.HandleFunc("/admin/api/v1/research/{id}/revisions/diff", h.DiffResearchRevisions).Methods("GET")
+	router.HandleFunc("/admin/api/v1/research/{id}/revisions/{revision:[0-9]+}", h.GetResearchRevision).Methods("GET")
+	router.HandleFunc("/admin/api/v1/research/{id}/revisions/{revision:[0-9]+}/rollback", h.RollbackResearch).Methods("POST")
 	router.HandleFunc("/admin/api/v1/research/{id}/report/upload", h.UploadResearchReport).Methods("POST")
 	// Research category CRUD operations
 	router.HandleFunc("/admin/api/v1/research/categories", h.CreateResearchCategory).Methods("POST")
@@ -67,21 +72,46 @@ func (h *ResearchHandler) GetAllResearch(w http.ResponseWriter, r *http.Request)
 	correlationCtx.SetUserContext(userID, "research-api-1.0.0")
 	ctx = correlationCtx.ToContext(ctx)
 
-	// Extract pagination parameters
-	limit, offset := h.extractPaginationParams(r)
-
 	// Check for search parameter
 	searchTerm := r.URL.Query().Get("search")
-	
-	var researchList []*Research
-	var err error
-	
-	if searchTerm != "" {
-		researchList, err = h.service.SearchResearch(ctx, searchTerm, limit, offset)
-	} else {
-		researchList, err = h.service.GetAllResearch(ctx, limit, offset)
+
+	// Search results and offset requests keep limit/offset pagination
+	if searchTerm != "" || r.URL.Query().Get("offset") != "" {
+		limit, offset := h.extractPaginationParams(r)
+
+		var researchList []*Research
+		var err error
+
+		if searchTerm != "" {
+			researchList, err = h.service.SearchResearch(ctx, searchTerm, limit, offset)
+		} else {
+			researchList, err = h.service.GetAllResearch(ctx, limit, offset)
+		}
+
+		if err != nil {
+			h.handleError(w, r, err)
+			return
+		}
+
+		h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
+			"research":       researchList,
+			"count":          len(researchList),
+			"pagination": map[string]interface{}{
+				"limit":  limit,
+				"offset": offset,
+			},
+			"correlation_id": correlationCtx.CorrelationID,
+		})
+		return
 	}
-	
+
+	page, err := h.extractPageRequest(r)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	researchList, pageInfo, err := h.service.ListResearchPage(ctx, ResearchListFilter{}, page)
 	if err != nil {
 		h.handleError(w, r, err)
 		return
@@ -90,10 +120,7 @@ func (h *ResearchHandler) GetAllResearch(w http.ResponseWriter, r *http.Request)
 	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
 		"research":       researchList,
 		"count":          len(researchList),
-		"pagination": map[string]interface{}{
-			"limit":  limit,
-			"offset": offset,
-		},
+		"pagination":     pageInfo,
 		"correlation_id": correlationCtx.CorrelationID,
 	})
 }
@@ -213,10 +240,36 @@ func (h *ResearchHandler) GetResearchByCategory(w http.ResponseWriter, r *http.R
 	correlationCtx.SetUserContext(userID, "research-api-1.0.0")
 	ctx = correlationCtx.ToContext(ctx)
 
-	// Extract pagination parameters
-	limit, offset := h.extractPaginationParams(r)
+	// Offset requests keep limit/offset pagination
+	if r.URL.Query().Get("offset") != "" {
+		limit, offset := h.extractPaginationParams(r)
+
+		researchList, err := h.service.GetResearchByCategory(ctx, categoryID, limit, offset)
+		if err != nil {
+			h.handleError(w, r, err)
+			return
+		}
+
+		h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
+			"research":       researchList,
+			"count":          len(researchList),
+			"category_id":    categoryID,
+			"pagination": map[string]interface{}{
+				"limit":  limit,
+				"offset": offset,
+			},
+			"correlation_id": correlationCtx.CorrelationID,
+		})
+		return
+	}
+
+	page, err := h.extractPageRequest(r)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
 
-	researchList, err := h.service.GetResearchByCategory(ctx, categoryID, limit, offset)
+	researchList, pageInfo, err := h.service.ListResearchPage(ctx, ResearchListFilter{CategoryID: categoryID}, page)
 	if err != nil {
 		h.handleError(w, r, err)
 		return
@@ -226,10 +279,7 @@ func (h *ResearchHandler) GetResearchByCategory(w http.ResponseWriter, r *http.R
 		"research":       researchList,
 		"count":          len(researchList),
 		"category_id":    categoryID,
-		"pagination": map[string]interface{}{
-			"limit":  limit,
-			"offset": offset,
-		},
+		"pagination":     pageInfo,
 		"correlation_id": correlationCtx.CorrelationID,
 	})
 }
@@ -247,22 +297,28 @@ func (h *ResearchHandler) SearchResearch(w http.ResponseWriter, r *http.Request)
 	correlationCtx.SetUserContext(userID, "research-api-1.0.0")
 	ctx = correlationCtx.ToContext(ctx)
 
-	// Extract pagination parameters
-	limit, offset := h.extractPaginationParams(r)
+	query, err := search.ParseQuery(r.URL.Query(), researchPublicFacets)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
 
-	results, err := h.service.SearchResearch(ctx, searchTerm, limit, offset)
+	results, err := h.service.QueryPublishedResearch(ctx, query)
 	if err != nil {
 		h.handleError(w, r, err)
 		return
 	}
 
 	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
-		"research":       results,
-		"count":          len(results),
-		"search_term":    searchTerm,
+		"research":    researchFromHits(results.Hits),
+		"hits":        results.Hits,
+		"facets":      results.Facets,
+		"count":       len(results.Hits),
+		"total":       results.Total,
+		"search_term": searchTerm,
 		"pagination": map[string]interface{}{
-			"limit":  limit,
-			"offset": offset,
+			"limit":  results.Limit,
+			"offset": results.Offset,
 		},
 		"correlation_id": correlationCtx.CorrelationID,
 	})
@@ -339,6 +395,109 @@ func (h *ResearchHandler) GetResearchAudit(w http.ResponseWriter, r *http.Reques
 	h.writeJSONResponse(w, http.StatusOK, response)
 }
 
+// GetResearchRevisions handles GET /admin/api/v1/research/{id}/revisions
+func (h *ResearchHandler) GetResearchRevisions(w http.ResponseWriter, r *http.Request) {
+	researchID := mux.Vars(r)["id"]
+	userID := r.Header.Get("X-User-ID")
+
+	revisions, err := h.service.GetResearchRevisions(r.Context(), researchID, userID)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
+		"revisions": revisions,
+		"count":     len(revisions),
+	})
+}
+
+// GetResearchRevision handles GET /admin/api/v1/research/{id}/revisions/{revision}
+func (h *ResearchHandler) GetResearchRevision(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	userID := r.Header.Get("X-User-ID")
+
+	number, err := domain.ParseRevisionNumber("revision", vars["revision"])
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	revision, err := h.service.GetResearchRevision(r.Context(), vars["id"], number, userID)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
+		"revision": revision,
+	})
+}
+
+// DiffResearchRevisions handles GET /admin/api/v1/research/{id}/revisions/diff?from={revision}&to={revision}
+func (h *ResearchHandler) DiffResearchRevisions(w http.ResponseWriter, r *http.Request) {
+	researchID := mux.Vars(r)["id"]
+	userID := r.Header.Get("X-User-ID")
+	query := r.URL.Query()
+
+	from, err := domain.ParseRevisionNumber("from", query.Get("from"))
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+	to, err := domain.ParseRevisionNumber("to", query.Get("to"))
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	diff, err := h.service.DiffResearchRevisions(r.Context(), researchID, from, to, userID)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
+		"diff": diff,
+	})
+}
+
+// RollbackResearch handles POST /admin/api/v1/research/{id}/revisions/{revision}/rollback
+func (h *ResearchHandler) RollbackResearch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	// Extract user ID from header
+	userID := r.Header.Get("X-User-ID")
+	if userID == "" {
+		h.writeErrorResponse(w, http.StatusUnauthorized, "User ID is required")
+		return
+	}
+
+	number, err := domain.ParseRevisionNumber("revision", vars["revision"])
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	// Add correlation context
+	correlationCtx := domain.FromContext(ctx)
+	correlationCtx.SetUserContext(userID, "research-admin-api-1.0.0")
+	ctx = correlationCtx.ToContext(ctx)
+
+	research, err := h.service.RollbackResearch(ctx, vars["id"], number, userID)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
+		"research":       research,
+		"restored_from":  number,
+		"correlation_id": correlationCtx.CorrelationID,
+	})
+}
+
 // GetResearchCategoryAudit handles GET /admin/api/v1/research/categories/{id}/audit
 func (h *ResearchHandler) GetResearchCategoryAudit(w http.ResponseWriter, r *http.Request) {
 	// Extract category ID from URL path
@@ -495,6 +654,20 @@ func (h *ResearchHandler) writeErrorResponse(w http.ResponseWriter, statusCode i
 	json.NewEncoder(w).Encode(response)
 }
 
+// extractPageRequest extracts cursor pagination parameters from request
+func (h *ResearchHandler) extractPageRequest(r *http.Request) (domain.PageRequest, error) {
+	limit := 0
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		l, err := strconv.Atoi(limitStr)
+		if err != nil {
+			return domain.PageRequest{}, domain.NewValidationFieldError("limit", "limit must be a number")
+		}
+		limit = l
+	}
+
+	return domain.NewPageRequest(r.URL.Query().Get("cursor"), limit)
+}
+
 // extractPaginationParams extracts limit and offset from query parameters
 func (h *ResearchHandler) extractPaginationParams(r *http.Request) (limit int, offset int) {
 	limit = 20 // default limit
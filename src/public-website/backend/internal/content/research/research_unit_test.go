@@ -2,11 +2,13 @@ package research
 
 import (
 	"context"
+	"sort"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/search"
 	sharedtesting "github.com/axiom-software-co/international-center/src/backend/internal/shared/testing"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -18,7 +20,10 @@ type MockResearchRepository struct {
 	categories         map[string]*ResearchCategory  
 	featuredResearch   map[string]*FeaturedResearch
 	auditEvents        []MockAuditEvent
+	reindexed          []string
 	failures           map[string]error
+	revisions          map[string][]*domain.Revision
+	reviewEvents       []*domain.ReviewEvent
 }
 
 type MockAuditEvent struct {
@@ -37,6 +42,7 @@ func NewMockResearchRepository() *MockResearchRepository {
 		featuredResearch:   make(map[string]*FeaturedResearch),
 		auditEvents:        make([]MockAuditEvent, 0),
 		failures:           make(map[string]error),
+		revisions:          make(map[string][]*domain.Revision),
 	}
 }
 
@@ -75,6 +81,32 @@ func (m *MockResearchRepository) GetAllResearch(ctx context.Context, limit, offs
 	return researchList, nil
 }
 
+func (m *MockResearchRepository) ListResearchPage(ctx context.Context, filter ResearchListFilter, page domain.PageRequest) ([]*Research, domain.PageInfo, error) {
+	if err, exists := m.failures["ListResearchPage"]; exists {
+		return nil, domain.PageInfo{}, err
+	}
+
+	researchList := make([]*Research, 0)
+	for _, research := range m.research {
+		if research.IsDeleted {
+			continue
+		}
+		if filter.CategoryID != "" && research.CategoryID != filter.CategoryID {
+			continue
+		}
+		if filter.PublishingStatus != "" && research.PublishingStatus != filter.PublishingStatus {
+			continue
+		}
+		researchList = append(researchList, research)
+	}
+	sort.Slice(researchList, func(i, j int) bool {
+		return researchList[i].PageCursor().Before(researchList[j].PageCursor())
+	})
+
+	pageItems, info := PageResearch(researchList, page)
+	return pageItems, info, nil
+}
+
 func (m *MockResearchRepository) GetResearchBySlug(ctx context.Context, slug string) (*Research, error) {
 	if err, exists := m.failures["GetResearchBySlug"]; exists {
 		return nil, err
@@ -105,6 +137,27 @@ func (m *MockResearchRepository) SearchResearch(ctx context.Context, query strin
 	return researchList, nil
 }
 
+func (m *MockResearchRepository) QueryResearch(ctx context.Context, query search.Query) (*search.Results, error) {
+	if err, exists := m.failures["QueryResearch"]; exists {
+		return nil, err
+	}
+	index := newResearchSearchIndex()
+	for _, research := range m.research {
+		if !research.IsDeleted {
+			index.Upsert(researchSearchDocument(research))
+		}
+	}
+	return index.Search(query), nil
+}
+
+func (m *MockResearchRepository) ReindexResearch(ctx context.Context, researchID string) error {
+	if err, exists := m.failures["ReindexResearch"]; exists {
+		return err
+	}
+	m.reindexed = append(m.reindexed, researchID)
+	return nil
+}
+
 func (m *MockResearchRepository) GetResearchByPublishingStatus(ctx context.Context, status PublishingStatus, limit, offset int) ([]*Research, error) {
 	if err, exists := m.failures["GetResearchByPublishingStatus"]; exists {
 		return nil, err
@@ -130,15 +183,16 @@ func (m *MockResearchRepository) GetResearchByPublishingStatus(ctx context.Conte
 	return researchList, nil
 }
 
-func (m *MockResearchRepository) SaveResearch(ctx context.Context, research *Research) error {
+func (m *MockResearchRepository) SaveResearch(ctx context.Context, research *Research, audit *domain.AuditEvent) error {
 	if err, exists := m.failures["SaveResearch"]; exists {
 		return err
 	}
 	m.research[research.ResearchID] = research
+	m.recordAudit(audit)
 	return nil
 }
 
-func (m *MockResearchRepository) DeleteResearch(ctx context.Context, researchID string) error {
+func (m *MockResearchRepository) DeleteResearch(ctx context.Context, researchID string, audit *domain.AuditEvent) error {
 	if err, exists := m.failures["DeleteResearch"]; exists {
 		return err
 	}
@@ -146,6 +200,7 @@ func (m *MockResearchRepository) DeleteResearch(ctx context.Context, researchID
 		research.IsDeleted = true
 		now := time.Now()
 		research.DeletedOn = &now
+		m.recordAudit(audit)
 		return nil
 	}
 	return domain.NewNotFoundError("research", researchID)
@@ -188,11 +243,12 @@ func (m *MockResearchRepository) GetResearchCategoryBySlug(ctx context.Context,
 	return nil, domain.NewNotFoundError("research_category", slug)
 }
 
-func (m *MockResearchRepository) SaveResearchCategory(ctx context.Context, category *ResearchCategory) error {
+func (m *MockResearchRepository) SaveResearchCategory(ctx context.Context, category *ResearchCategory, audit *domain.AuditEvent) error {
 	if err, exists := m.failures["SaveResearchCategory"]; exists {
 		return err
 	}
 	m.categories[category.CategoryID] = category
+	m.recordAudit(audit)
 	return nil
 }
 
@@ -208,7 +264,7 @@ func (m *MockResearchRepository) GetDefaultUnassignedCategory(ctx context.Contex
 	return nil, domain.NewNotFoundError("default_unassigned_category", "")
 }
 
-func (m *MockResearchRepository) DeleteResearchCategory(ctx context.Context, categoryID string) error {
+func (m *MockResearchRepository) DeleteResearchCategory(ctx context.Context, categoryID string, audit *domain.AuditEvent) error {
 	if err, exists := m.failures["DeleteResearchCategory"]; exists {
 		return err
 	}
@@ -216,6 +272,7 @@ func (m *MockResearchRepository) DeleteResearchCategory(ctx context.Context, cat
 		category.IsDeleted = true
 		now := time.Now()
 		category.DeletedOn = &now
+		m.recordAudit(audit)
 		return nil
 	}
 	return domain.NewNotFoundError("research_category", categoryID)
@@ -247,7 +304,7 @@ func (m *MockResearchRepository) GetFeaturedResearch(ctx context.Context) (*Feat
 	return nil, domain.NewNotFoundError("featured_research", "featured")
 }
 
-func (m *MockResearchRepository) SaveFeaturedResearch(ctx context.Context, featured *FeaturedResearch) error {
+func (m *MockResearchRepository) SaveFeaturedResearch(ctx context.Context, featured *FeaturedResearch, audit *domain.AuditEvent) error {
 	if err, exists := m.failures["SaveFeaturedResearch"]; exists {
 		return err
 	}
@@ -255,14 +312,16 @@ func (m *MockResearchRepository) SaveFeaturedResearch(ctx context.Context, featu
 	// Clear existing featured research (only one allowed)
 	m.featuredResearch = make(map[string]*FeaturedResearch)
 	m.featuredResearch[featured.FeaturedResearchID] = featured
+	m.recordAudit(audit)
 	return nil
 }
 
-func (m *MockResearchRepository) DeleteFeaturedResearch(ctx context.Context, featuredResearchID string) error {
+func (m *MockResearchRepository) DeleteFeaturedResearch(ctx context.Context, featuredResearchID string, audit *domain.AuditEvent) error {
 	if err, exists := m.failures["DeleteFeaturedResearch"]; exists {
 		return err
 	}
 	delete(m.featuredResearch, featuredResearchID)
+	m.recordAudit(audit)
 	return nil
 }
 
@@ -326,6 +385,58 @@ func (m *MockResearchRepository) PublishAuditEvent(ctx context.Context, entityTy
 	return nil
 }
 
+// recordAudit keeps the audit event a write committed, in place of the outbox
+func (m *MockResearchRepository) recordAudit(audit *domain.AuditEvent) {
+	if audit == nil {
+		return
+	}
+	m.auditEvents = append(m.auditEvents, MockAuditEvent{
+		EntityType:    audit.EntityType,
+		EntityID:      audit.EntityID,
+		OperationType: audit.OperationType,
+		UserID:        audit.UserID,
+		Before:        audit.DataSnapshot.Before,
+		After:         audit.DataSnapshot.After,
+	})
+}
+
+func (m *MockResearchRepository) PublishReviewEvent(ctx context.Context, event *domain.ReviewEvent) error {
+	if err, exists := m.failures["PublishReviewEvent"]; exists {
+		return err
+	}
+	m.reviewEvents = append(m.reviewEvents, event)
+	return nil
+}
+
+// AddRevision records the next revision of a publication holding its content after the change
+func (m *MockResearchRepository) AddRevision(research *Research, operationType domain.AuditEventType) {
+	revision := domain.NewRevision(domain.NewAuditEvent(domain.EntityTypeResearch, research.ResearchID, operationType, "admin-1"))
+	revision.Number = len(m.revisions[research.ResearchID]) + 1
+	revision.DataSnapshot = &domain.AuditDataSnapshot{After: research}
+	m.revisions[research.ResearchID] = append(m.revisions[research.ResearchID], revision)
+}
+
+func (m *MockResearchRepository) ListResearchRevisions(ctx context.Context, researchID string) ([]*domain.Revision, error) {
+	if err, exists := m.failures["ListResearchRevisions"]; exists {
+		return nil, err
+	}
+	revisions := make([]*domain.Revision, 0, len(m.revisions[researchID]))
+	for i := len(m.revisions[researchID]) - 1; i >= 0; i-- {
+		revisions = append(revisions, m.revisions[researchID][i].Summary())
+	}
+	return revisions, nil
+}
+
+func (m *MockResearchRepository) GetResearchRevision(ctx context.Context, researchID string, revision int) (*domain.Revision, error) {
+	if err, exists := m.failures["GetResearchRevision"]; exists {
+		return nil, err
+	}
+	if revision < 1 || revision > len(m.revisions[researchID]) {
+		return nil, domain.NewNotFoundError("revision", researchID)
+	}
+	return m.revisions[researchID][revision-1], nil
+}
+
 // Test Research Service Operations
 func TestResearchService_GetResearch(t *testing.T) {
 	tests := []struct {
@@ -452,6 +563,78 @@ func TestResearchService_GetAllResearch(t *testing.T) {
 	}
 }
 
+func TestResearchService_ListResearchPage(t *testing.T) {
+	published := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	created := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		filter      ResearchListFilter
+		limit       int
+		setupFunc   func(*MockResearchRepository)
+		wantIDs     []string
+		wantHasMore bool
+		wantErr     bool
+	}{
+		{
+			name:  "order by publication date with creation fallback",
+			limit: 10,
+			setupFunc: func(repo *MockResearchRepository) {
+				repo.research["research-1"] = &Research{ResearchID: "research-1", PublicationDate: &published, CreatedOn: created.Add(time.Hour)}
+				repo.research["research-2"] = &Research{ResearchID: "research-2", CreatedOn: created}
+			},
+			wantIDs: []string{"research-2", "research-1"},
+		},
+		{
+			name:   "filter by publishing status and report more pages",
+			filter: ResearchListFilter{PublishingStatus: PublishingStatusPublished},
+			limit:  1,
+			setupFunc: func(repo *MockResearchRepository) {
+				repo.research["research-1"] = &Research{ResearchID: "research-1", PublishingStatus: PublishingStatusPublished, CreatedOn: created}
+				repo.research["research-2"] = &Research{ResearchID: "research-2", PublishingStatus: PublishingStatusPublished, CreatedOn: published}
+				repo.research["research-3"] = &Research{ResearchID: "research-3", PublishingStatus: PublishingStatusDraft, CreatedOn: created}
+			},
+			wantIDs:     []string{"research-1"},
+			wantHasMore: true,
+		},
+		{
+			name:      "reject invalid publishing status",
+			filter:    ResearchListFilter{PublishingStatus: "unknown"},
+			limit:     10,
+			setupFunc: func(repo *MockResearchRepository) {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := sharedtesting.CreateUnitTestContext()
+			defer cancel()
+
+			repo := NewMockResearchRepository()
+			tt.setupFunc(repo)
+
+			service := NewResearchService(repo)
+			page, err := domain.NewPageRequest("", tt.limit)
+			require.NoError(t, err)
+
+			result, info, err := service.ListResearchPage(ctx, tt.filter, page)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			ids := make([]string, 0, len(result))
+			for _, research := range result {
+				ids = append(ids, research.ResearchID)
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+			assert.Equal(t, tt.wantHasMore, info.HasMore)
+		})
+	}
+}
+
 func TestResearchService_SearchResearch(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -533,6 +716,78 @@ func TestResearchService_SearchResearch(t *testing.T) {
 	}
 }
 
+func TestResearchService_QueryPublishedResearch(t *testing.T) {
+	setup := func(repo *MockResearchRepository) {
+		repo.research["research-1"] = &Research{
+			ResearchID:       "research-1",
+			Title:            "Clinical outcomes in rural communities",
+			Abstract:         "A study of patient outcomes",
+			Keywords:         []string{"outcomes", "rural"},
+			PublishingStatus: PublishingStatusPublished,
+		}
+		repo.research["research-2"] = &Research{
+			ResearchID:       "research-2",
+			Title:            "Annual review",
+			Abstract:         "Summarises clinical trial outcomes",
+			Keywords:         []string{"trials"},
+			PublishingStatus: PublishingStatusPublished,
+		}
+		repo.research["research-3"] = &Research{
+			ResearchID:       "research-3",
+			Title:            "Clinical outcomes draft",
+			PublishingStatus: PublishingStatusDraft,
+		}
+	}
+
+	tests := []struct {
+		name      string
+		query     search.Query
+		wantIDs   []string
+		wantError bool
+	}{
+		{
+			name:    "rank title matches first and skip drafts",
+			query:   search.Query{Text: "clinical outcome"},
+			wantIDs: []string{"research-1", "research-2"},
+		},
+		{
+			name:    "filter by keyword facet",
+			query:   search.Query{Text: "outcomes", Filters: map[string]string{researchFacetKeywords: "trials"}},
+			wantIDs: []string{"research-2"},
+		},
+		{
+			name:      "reject invalid query",
+			query:     search.Query{Limit: -1},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := sharedtesting.CreateUnitTestContext()
+			defer cancel()
+
+			repo := NewMockResearchRepository()
+			setup(repo)
+			service := NewResearchService(repo)
+
+			results, err := service.QueryPublishedResearch(ctx, tt.query)
+
+			if tt.wantError {
+				require.Error(t, err)
+				assert.True(t, domain.IsValidationError(err))
+				return
+			}
+			require.NoError(t, err)
+			ids := make([]string, 0, len(results.Hits))
+			for _, research := range researchFromHits(results.Hits) {
+				ids = append(ids, research.ResearchID)
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+		})
+	}
+}
+
 func TestResearchService_GetFeaturedResearch(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -833,6 +1088,71 @@ func TestResearchService_UpdateResearch(t *testing.T) {
 	}
 }
 
+func TestResearchService_RollbackResearch(t *testing.T) {
+	researchID := "550e8400-e29b-41d4-a716-446655440001"
+	original := &Research{
+		ResearchID:       researchID,
+		Title:            "Original Clinical Study",
+		Abstract:         "Original abstract for clinical study with sufficient length to meet validation requirements",
+		Slug:             "original-clinical-study",
+		CategoryID:       "550e8400-e29b-41d4-a716-446655440002",
+		AuthorNames:      "Dr. Smith",
+		PublishingStatus: PublishingStatusDraft,
+		ResearchType:     ResearchTypeClinicalStudy,
+		CreatedBy:        "admin-author",
+	}
+
+	tests := []struct {
+		name     string
+		revision int
+		userID   string
+		wantErr  bool
+	}{
+		{name: "restore earlier revision", revision: 1, userID: "admin-550e8400-e29b-41d4-a716-446655440003"},
+		{name: "reject non-admin user", revision: 1, userID: "user-1", wantErr: true},
+		{name: "reject unknown revision", revision: 9, userID: "admin-550e8400-e29b-41d4-a716-446655440003", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := sharedtesting.CreateUnitTestContext()
+			defer cancel()
+
+			// Arrange
+			repo := NewMockResearchRepository()
+			revised := *original
+			revised.Title = "Revised Clinical Study"
+			revised.AuthorNames = "Dr. Smith, Dr. Johnson"
+			repo.research[researchID] = &revised
+			repo.AddRevision(original, domain.AuditEventInsert)
+			repo.AddRevision(&revised, domain.AuditEventUpdate)
+			service := NewResearchService(repo)
+
+			// Act
+			restored, err := service.RollbackResearch(ctx, researchID, tt.revision, tt.userID)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, "Revised Clinical Study", repo.research[researchID].Title)
+				assert.Empty(t, repo.GetAuditEvents())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "Original Clinical Study", restored.Title)
+			assert.Equal(t, "Dr. Smith", repo.research[researchID].AuthorNames)
+			assert.Equal(t, "admin-author", restored.CreatedBy)
+			events := repo.GetAuditEvents()
+			require.Len(t, events, 1)
+			assert.Equal(t, domain.AuditEventRollback, events[0].OperationType)
+
+			diff, err := service.DiffResearchRevisions(ctx, researchID, 1, 2, tt.userID)
+			require.NoError(t, err)
+			assert.Equal(t, []string{"author_names", "title"}, []string{diff.Changes[0].Field, diff.Changes[1].Field})
+		})
+	}
+}
+
 func TestResearchService_DeleteResearch(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -944,6 +1264,46 @@ func TestResearchService_PublishResearch(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name:       "return forbidden error for embargoed research",
+			researchID: "550e8400-e29b-41d4-a716-446655440001",
+			userID:     "admin-550e8400-e29b-41d4-a716-446655440003",
+			setupFunc: func(repo *MockResearchRepository) {
+				releaseAt := time.Now().Add(24 * time.Hour)
+				research := &Research{
+					ResearchID:       "550e8400-e29b-41d4-a716-446655440001",
+					Title:            "Embargoed Research",
+					Abstract:         "Abstract for embargoed research with sufficient length to meet validation requirements",
+					AuthorNames:      "Dr. Test",
+					PublishingStatus: PublishingStatusDraft,
+					EmbargoUntil:     &releaseAt,
+					ResearchType:     ResearchTypeClinicalStudy,
+					CreatedOn:        time.Now(),
+				}
+				repo.research["550e8400-e29b-41d4-a716-446655440001"] = research
+			},
+			wantErr: true,
+		},
+		{
+			name:       "successfully publish research once its embargo has passed",
+			researchID: "550e8400-e29b-41d4-a716-446655440001",
+			userID:     "admin-550e8400-e29b-41d4-a716-446655440003",
+			setupFunc: func(repo *MockResearchRepository) {
+				releasedAt := time.Now().Add(-time.Minute)
+				research := &Research{
+					ResearchID:       "550e8400-e29b-41d4-a716-446655440001",
+					Title:            "Released Research",
+					Abstract:         "Abstract for released research with sufficient length to meet validation requirements",
+					AuthorNames:      "Dr. Test",
+					PublishingStatus: PublishingStatusDraft,
+					EmbargoUntil:     &releasedAt,
+					ResearchType:     ResearchTypeClinicalStudy,
+					CreatedOn:        time.Now(),
+				}
+				repo.research["550e8400-e29b-41d4-a716-446655440001"] = research
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -975,6 +1335,110 @@ func TestResearchService_PublishResearch(t *testing.T) {
 	}
 }
 
+func TestResearchService_SetResearchEmbargo(t *testing.T) {
+	releaseAt := time.Date(2030, 1, 15, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		status      PublishingStatus
+		until       *time.Time
+		wantErr     bool
+		wantEmbargo *time.Time
+	}{
+		{name: "embargo draft research", status: PublishingStatusDraft, until: &releaseAt, wantEmbargo: &releaseAt},
+		{name: "lift embargo", status: PublishingStatusDraft, until: nil, wantEmbargo: nil},
+		{name: "reject embargo on published research", status: PublishingStatusPublished, until: &releaseAt, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := sharedtesting.CreateUnitTestContext()
+			defer cancel()
+
+			// Arrange
+			repo := NewMockResearchRepository()
+			repo.research["research-1"] = &Research{
+				ResearchID:       "research-1",
+				Title:            "Embargo Candidate",
+				PublishingStatus: tt.status,
+				ResearchType:     ResearchTypeClinicalStudy,
+				CreatedOn:        time.Now(),
+			}
+			service := NewResearchService(repo)
+
+			// Act
+			err := service.SetResearchEmbargo(ctx, "research-1", tt.until, "admin-1")
+
+			// Assert
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.True(t, domain.IsConflictError(err))
+				assert.Empty(t, repo.GetAuditEvents())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantEmbargo, repo.research["research-1"].EmbargoUntil)
+			require.Len(t, repo.GetAuditEvents(), 1)
+			assert.Equal(t, domain.AuditEventUpdate, repo.GetAuditEvents()[0].OperationType)
+		})
+	}
+}
+
+func TestResearchService_EditorialReview(t *testing.T) {
+	tests := []struct {
+		name       string
+		approver   string
+		wantStatus PublishingStatus
+		wantErr    func(error) bool
+	}{
+		{name: "reviewer approves the publication", approver: "reviewer-1", wantStatus: PublishingStatusPublished},
+		{name: "author cannot approve their own publication", approver: "author-1", wantStatus: PublishingStatusInReview, wantErr: domain.IsForbiddenError},
+		{name: "unassigned reviewer cannot approve", approver: "reviewer-2", wantStatus: PublishingStatusInReview, wantErr: domain.IsForbiddenError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := sharedtesting.CreateUnitTestContext()
+			defer cancel()
+
+			// Arrange
+			repo := NewMockResearchRepository()
+			repo.research["research-1"] = &Research{
+				ResearchID:       "research-1",
+				Title:            "Review Candidate",
+				Abstract:         "Abstract for test research with sufficient length to meet validation requirements",
+				AuthorNames:      "Dr. Test",
+				PublishingStatus: PublishingStatusDraft,
+				ResearchType:     ResearchTypeClinicalStudy,
+				CreatedOn:        time.Now(),
+				CreatedBy:        "author-1",
+			}
+			service := NewResearchService(repo)
+			service.UseEditorialWorkflow(domain.NewEditorialWorkflow(true, 1))
+			_, err := service.SubmitResearchForReview(ctx, "research-1", []string{"reviewer-1"}, "author-1")
+			require.NoError(t, err)
+
+			// Act
+			_, err = service.ApproveResearch(ctx, "research-1", tt.approver)
+			if tt.wantErr == nil {
+				err = service.PublishResearch(ctx, "research-1", "author-1")
+			}
+
+			// Assert
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.True(t, tt.wantErr(err))
+				assert.True(t, domain.IsConflictError(service.PublishResearch(ctx, "research-1", "author-1")))
+			} else {
+				require.NoError(t, err)
+				require.Len(t, repo.reviewEvents, 2)
+				assert.Equal(t, domain.ReviewEventApproved, repo.reviewEvents[1].EventType)
+			}
+			assert.Equal(t, tt.wantStatus, repo.research["research-1"].PublishingStatus)
+		})
+	}
+}
+
 func TestResearchService_ArchiveResearch(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -1445,6 +1909,11 @@ func TestPublishingStatus_IsValid(t *testing.T) {
 			status: PublishingStatusDraft,
 			want:   true,
 		},
+		{
+			name:   "valid in review status",
+			status: PublishingStatusInReview,
+			want:   true,
+		},
 		{
 			name:   "valid published status",
 			status: PublishingStatusPublished,
@@ -0,0 +1,71 @@
+package research
+
+import (
+	"strings"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/search"
+)
+
+// Facets maintained in the research search index
+const (
+	researchFacetCategory = "category"
+	researchFacetKeywords = "keywords"
+	researchFacetType     = "research_type"
+	researchFacetStatus   = "status"
+)
+
+// researchPublicFacets are the facets counted and filterable on public research search
+var researchPublicFacets = []string{researchFacetCategory, researchFacetKeywords, researchFacetType}
+
+// newResearchSearchIndex creates the full-text index for research articles.
+// Title matches weigh most, then abstract and keywords, then authors and body.
+func newResearchSearchIndex() *search.Index {
+	return search.NewIndex("research",
+		search.Field{Name: "title", Boost: 3},
+		search.Field{Name: "abstract", Boost: 2},
+		search.Field{Name: "keywords", Boost: 2},
+		search.Field{Name: "authors", Boost: 1},
+		search.Field{Name: "content", Boost: 1},
+	)
+}
+
+// researchSearchDocument converts a research article into its search document
+func researchSearchDocument(research *Research) search.Document {
+	return search.Document{
+		ID: research.ResearchID,
+		Fields: map[string]string{
+			"title":    research.Title,
+			"abstract": research.Abstract,
+			"keywords": strings.Join(research.Keywords, " "),
+			"authors":  research.AuthorNames,
+			"content":  research.Content,
+		},
+		Facets: map[string][]string{
+			researchFacetCategory: {research.CategoryID},
+			researchFacetKeywords: research.Keywords,
+			researchFacetType:     {string(research.ResearchType)},
+			researchFacetStatus:   {string(research.PublishingStatus)},
+		},
+		Source: research,
+	}
+}
+
+// researchSearchDocuments converts research articles into search documents
+func researchSearchDocuments(researchList []*Research) []search.Document {
+	documents := make([]search.Document, 0, len(researchList))
+	for _, research := range researchList {
+		documents = append(documents, researchSearchDocument(research))
+	}
+	return documents
+}
+
+// researchFromHits returns the research articles behind search hits, in rank order
+func researchFromHits(hits []search.Hit) []*Research {
+	researchList := make([]*Research, 0, len(hits))
+	for _, hit := range hits {
+		if research, ok := hit.Source.(*Research); ok {
+			researchList = append(researchList, research)
+		}
+	}
+	return researchList
+}
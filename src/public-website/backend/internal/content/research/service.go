@@ -2,54 +2,75 @@ package research
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/search"
 )
 
 // ResearchRepositoryInterface defines the contract for research data access
 type ResearchRepositoryInterface interface {
 	// Research operations
-	SaveResearch(ctx context.Context, research *Research) error
+	SaveResearch(ctx context.Context, research *Research, audit *domain.AuditEvent) error
 	GetResearch(ctx context.Context, researchID string) (*Research, error)
 	GetResearchBySlug(ctx context.Context, slug string) (*Research, error)
 	GetAllResearch(ctx context.Context, limit, offset int) ([]*Research, error)
 	GetResearchByCategory(ctx context.Context, categoryID string, limit, offset int) ([]*Research, error)
 	GetResearchByPublishingStatus(ctx context.Context, status PublishingStatus, limit, offset int) ([]*Research, error)
-	DeleteResearch(ctx context.Context, researchID string) error
+	ListResearchPage(ctx context.Context, filter ResearchListFilter, page domain.PageRequest) ([]*Research, domain.PageInfo, error)
+	DeleteResearch(ctx context.Context, researchID string, audit *domain.AuditEvent) error
 	SearchResearch(ctx context.Context, searchTerm string, limit, offset int) ([]*Research, error)
+	QueryResearch(ctx context.Context, query search.Query) (*search.Results, error)
+	ReindexResearch(ctx context.Context, researchID string) error
 
 	// Research category operations
-	SaveResearchCategory(ctx context.Context, category *ResearchCategory) error
+	SaveResearchCategory(ctx context.Context, category *ResearchCategory, audit *domain.AuditEvent) error
 	GetResearchCategory(ctx context.Context, categoryID string) (*ResearchCategory, error)
 	GetResearchCategoryBySlug(ctx context.Context, slug string) (*ResearchCategory, error)
 	GetAllResearchCategories(ctx context.Context) ([]*ResearchCategory, error)
 	GetDefaultUnassignedCategory(ctx context.Context) (*ResearchCategory, error)
-	DeleteResearchCategory(ctx context.Context, categoryID string) error
+	DeleteResearchCategory(ctx context.Context, categoryID string, audit *domain.AuditEvent) error
 
 	// Featured research operations
-	SaveFeaturedResearch(ctx context.Context, featured *FeaturedResearch) error
+	SaveFeaturedResearch(ctx context.Context, featured *FeaturedResearch, audit *domain.AuditEvent) error
 	GetFeaturedResearch(ctx context.Context) (*FeaturedResearch, error)
-	DeleteFeaturedResearch(ctx context.Context, featuredResearchID string) error
+	DeleteFeaturedResearch(ctx context.Context, featuredResearchID string, audit *domain.AuditEvent) error
 
 	// Audit operations
 	PublishAuditEvent(ctx context.Context, entityType domain.EntityType, entityID string, operationType domain.AuditEventType, userID string, beforeData, afterData interface{}) error
 	GetResearchAudit(ctx context.Context, researchID string, userID string, limit int, offset int) ([]*domain.AuditEvent, error)
 	GetResearchCategoryAudit(ctx context.Context, categoryID string, userID string, limit int, offset int) ([]*domain.AuditEvent, error)
+
+	// Revision operations
+	ListResearchRevisions(ctx context.Context, researchID string) ([]*domain.Revision, error)
+	GetResearchRevision(ctx context.Context, researchID string, revision int) (*domain.Revision, error)
+
+	// Editorial review operations
+	PublishReviewEvent(ctx context.Context, event *domain.ReviewEvent) error
 }
 
 // ResearchService implements business logic for research operations
 type ResearchService struct {
 	repository ResearchRepositoryInterface
+	workflow   *domain.EditorialWorkflow
 }
 
-// NewResearchService creates a new research service
+// NewResearchService creates a new research service that publishes directly
+// until an editorial workflow is configured
 func NewResearchService(repository ResearchRepositoryInterface) *ResearchService {
 	return &ResearchService{
 		repository: repository,
+		workflow:   domain.DirectPublishWorkflow(),
 	}
 }
 
+// UseEditorialWorkflow makes publishing wait for the reviews the workflow requires
+func (s *ResearchService) UseEditorialWorkflow(workflow *domain.EditorialWorkflow) {
+	s.workflow = workflow
+}
+
 // Research operations
 
 func (s *ResearchService) GetResearch(ctx context.Context, researchID string, userID string) (*Research, error) {
@@ -84,6 +105,14 @@ func (s *ResearchService) GetResearchByCategory(ctx context.Context, categoryID
 	return s.repository.GetResearchByCategory(ctx, categoryID, limit, offset)
 }
 
+func (s *ResearchService) ListResearchPage(ctx context.Context, filter ResearchListFilter, page domain.PageRequest) ([]*Research, domain.PageInfo, error) {
+	if filter.PublishingStatus != "" && !filter.PublishingStatus.IsValid() {
+		return nil, domain.PageInfo{}, domain.NewValidationFieldError("status", "invalid publishing status")
+	}
+
+	return s.repository.ListResearchPage(ctx, filter, page)
+}
+
 func (s *ResearchService) SearchResearch(ctx context.Context, query string, limit, offset int) ([]*Research, error) {
 	query = strings.TrimSpace(query)
 	if query == "" {
@@ -93,6 +122,22 @@ func (s *ResearchService) SearchResearch(ctx context.Context, query string, limi
 	return s.repository.SearchResearch(ctx, query, limit, offset)
 }
 
+func (s *ResearchService) QueryPublishedResearch(ctx context.Context, query search.Query) (*search.Results, error) {
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	return s.repository.QueryResearch(ctx, query.WithFilter(researchFacetStatus, string(PublishingStatusPublished)))
+}
+
+func (s *ResearchService) ReindexResearch(ctx context.Context, researchID string) error {
+	if researchID == "" {
+		return domain.NewValidationError("research ID cannot be empty")
+	}
+
+	return s.repository.ReindexResearch(ctx, researchID)
+}
+
 func (s *ResearchService) CreateResearch(ctx context.Context, research *Research, userID string) error {
 	// Set defaults and validate
 	research.SetDefaults()
@@ -103,12 +148,8 @@ func (s *ResearchService) CreateResearch(ctx context.Context, research *Research
 	}
 
 	// Save research
-	if err := s.repository.SaveResearch(ctx, research); err != nil {
-		return err
-	}
-
-	// Publish audit event
-	return s.repository.PublishAuditEvent(ctx, domain.EntityTypeResearch, research.ResearchID, domain.AuditEventInsert, userID, nil, research)
+	audit := domain.NewAuditChange(domain.EntityTypeResearch, research.ResearchID, domain.AuditEventInsert, userID, nil, research)
+	return s.repository.SaveResearch(ctx, research, audit)
 }
 
 func (s *ResearchService) UpdateResearch(ctx context.Context, research *Research, userID string) error {
@@ -118,6 +159,14 @@ func (s *ResearchService) UpdateResearch(ctx context.Context, research *Research
 		return err
 	}
 
+	// Review state only changes through the editorial workflow
+	research.Review = existing.Review.Clone()
+	if research.Review == nil {
+		research.Review = &domain.EditorialReview{}
+	}
+	research.PublishingStatus = PublishingStatus(s.workflow.StatusAfterEdit(
+		domain.WorkflowStatus(existing.PublishingStatus), domain.WorkflowStatus(research.PublishingStatus), research.Review, userID))
+
 	// Set modification fields and validate
 	research.ModifiedBy = userID
 	now := research.CreatedOn // Use existing created time
@@ -128,12 +177,8 @@ func (s *ResearchService) UpdateResearch(ctx context.Context, research *Research
 	}
 
 	// Save updated research
-	if err := s.repository.SaveResearch(ctx, research); err != nil {
-		return err
-	}
-
-	// Publish audit event
-	return s.repository.PublishAuditEvent(ctx, domain.EntityTypeResearch, research.ResearchID, domain.AuditEventUpdate, userID, existing, research)
+	audit := domain.NewAuditChange(domain.EntityTypeResearch, research.ResearchID, domain.AuditEventUpdate, userID, existing, research)
+	return s.repository.SaveResearch(ctx, research, audit)
 }
 
 func (s *ResearchService) PublishResearch(ctx context.Context, researchID string, userID string) error {
@@ -149,6 +194,14 @@ func (s *ResearchService) PublishResearch(ctx context.Context, researchID string
 	if err := research.CanBePublished(); err != nil {
 		return err
 	}
+	if err := s.workflow.CanPublish(domain.WorkflowStatus(research.PublishingStatus)); err != nil {
+		return err
+	}
+
+	// Embargoed research is released by its publication schedule, not by hand
+	if research.IsEmbargoed(time.Now()) {
+		return domain.NewForbiddenError(fmt.Sprintf("research %s is embargoed until %s", researchID, research.EmbargoUntil.UTC().Format(time.RFC3339)))
+	}
 
 	// Update publishing status
 	research.PublishingStatus = PublishingStatusPublished
@@ -157,12 +210,8 @@ func (s *ResearchService) PublishResearch(ctx context.Context, researchID string
 	research.ModifiedOn = &now
 
 	// Save updated research
-	if err := s.repository.SaveResearch(ctx, research); err != nil {
-		return err
-	}
-
-	// Publish audit event
-	return s.repository.PublishAuditEvent(ctx, domain.EntityTypeResearch, researchID, domain.AuditEventPublish, userID, &existing, research)
+	audit := domain.NewAuditChange(domain.EntityTypeResearch, researchID, domain.AuditEventPublish, userID, &existing, research)
+	return s.repository.SaveResearch(ctx, research, audit)
 }
 
 func (s *ResearchService) ArchiveResearch(ctx context.Context, researchID string, userID string) error {
@@ -181,12 +230,147 @@ func (s *ResearchService) ArchiveResearch(ctx context.Context, researchID string
 	research.ModifiedOn = &now
 
 	// Save updated research
-	if err := s.repository.SaveResearch(ctx, research); err != nil {
+	audit := domain.NewAuditChange(domain.EntityTypeResearch, researchID, domain.AuditEventArchive, userID, &existing, research)
+	return s.repository.SaveResearch(ctx, research, audit)
+}
+
+// SetResearchEmbargo holds research back from publication until the given time, or
+// lifts the embargo when until is nil. Research that is already published cannot be
+// embargoed.
+func (s *ResearchService) SetResearchEmbargo(ctx context.Context, researchID string, until *time.Time, userID string) error {
+	research, err := s.repository.GetResearch(ctx, researchID)
+	if err != nil {
 		return err
 	}
 
-	// Publish audit event
-	return s.repository.PublishAuditEvent(ctx, domain.EntityTypeResearch, researchID, domain.AuditEventArchive, userID, &existing, research)
+	if until != nil && research.PublishingStatus == PublishingStatusPublished {
+		return domain.NewConflictError(fmt.Sprintf("research %s is already published and cannot be embargoed", researchID))
+	}
+
+	existing := *research // Copy for audit
+
+	research.EmbargoUntil = until
+	research.ModifiedBy = userID
+	now := time.Now().UTC()
+	research.ModifiedOn = &now
+
+	audit := domain.NewAuditChange(domain.EntityTypeResearch, researchID, domain.AuditEventUpdate, userID, &existing, research)
+	return s.repository.SaveResearch(ctx, research, audit)
+}
+
+// Editorial review operations
+
+// GetResearchReview returns the review state of a publication
+func (s *ResearchService) GetResearchReview(ctx context.Context, researchID string, userID string) (*domain.ContentReview, error) {
+	research, err := s.repository.GetResearch(ctx, researchID)
+	if err != nil {
+		return nil, err
+	}
+
+	return research.ContentReview(), nil
+}
+
+// SubmitResearchForReview sends a draft publication to its reviewers
+func (s *ResearchService) SubmitResearchForReview(ctx context.Context, researchID string, reviewerIDs []string, userID string) (*domain.ContentReview, error) {
+	return s.applyReview(ctx, researchID, userID, func(research *Research, now time.Time) (*domain.ReviewEvent, error) {
+		status, err := research.Review.Submit(domain.WorkflowStatus(research.PublishingStatus), userID, []string{research.CreatedBy, research.ModifiedBy}, reviewerIDs, now)
+		if err != nil {
+			return nil, err
+		}
+		research.PublishingStatus = PublishingStatus(status)
+
+		return domain.NewReviewEvent(domain.ReviewEventRequested, research.ContentReview(), userID, research.Review.Reviewers, ""), nil
+	})
+}
+
+// AssignResearchReviewers adds reviewers to a publication under review
+func (s *ResearchService) AssignResearchReviewers(ctx context.Context, researchID string, reviewerIDs []string, userID string) (*domain.ContentReview, error) {
+	return s.applyReview(ctx, researchID, userID, func(research *Research, now time.Time) (*domain.ReviewEvent, error) {
+		added, err := research.Review.AssignReviewers(domain.WorkflowStatus(research.PublishingStatus), reviewerIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		return domain.NewReviewEvent(domain.ReviewEventRequested, research.ContentReview(), userID, added, ""), nil
+	})
+}
+
+// CommentOnResearchReview adds a review comment to a publication
+func (s *ResearchService) CommentOnResearchReview(ctx context.Context, researchID string, body string, userID string) (*domain.ContentReview, error) {
+	return s.applyReview(ctx, researchID, userID, func(research *Research, now time.Time) (*domain.ReviewEvent, error) {
+		comment, err := research.Review.AddComment(domain.WorkflowStatus(research.PublishingStatus), userID, body, now)
+		if err != nil {
+			return nil, err
+		}
+
+		return domain.NewReviewEvent(domain.ReviewEventCommented, research.ContentReview(), userID, research.Review.Participants(userID), comment.Body), nil
+	})
+}
+
+// ApproveResearch records a reviewer's approval; authors cannot approve their own publications
+func (s *ResearchService) ApproveResearch(ctx context.Context, researchID string, userID string) (*domain.ContentReview, error) {
+	return s.applyReview(ctx, researchID, userID, func(research *Research, now time.Time) (*domain.ReviewEvent, error) {
+		status, err := research.Review.Approve(domain.WorkflowStatus(research.PublishingStatus), userID, s.workflow.RequiredApprovals, now)
+		if err != nil {
+			return nil, err
+		}
+		research.PublishingStatus = PublishingStatus(status)
+
+		if status != domain.WorkflowStatusApproved {
+			return nil, nil
+		}
+		return domain.NewReviewEvent(domain.ReviewEventApproved, research.ContentReview(), userID, research.Review.Authors, ""), nil
+	})
+}
+
+// RejectResearch returns a publication to its authors as a draft with the reason
+func (s *ResearchService) RejectResearch(ctx context.Context, researchID string, reason string, userID string) (*domain.ContentReview, error) {
+	return s.applyReview(ctx, researchID, userID, func(research *Research, now time.Time) (*domain.ReviewEvent, error) {
+		status, err := research.Review.Reject(domain.WorkflowStatus(research.PublishingStatus), userID, reason, now)
+		if err != nil {
+			return nil, err
+		}
+		research.PublishingStatus = PublishingStatus(status)
+
+		return domain.NewReviewEvent(domain.ReviewEventRejected, research.ContentReview(), userID, research.Review.Authors, research.Review.RejectionReason), nil
+	})
+}
+
+// applyReview loads a publication, applies one review step, saves it and notifies
+// the people the step concerns
+func (s *ResearchService) applyReview(ctx context.Context, researchID string, userID string, step func(research *Research, now time.Time) (*domain.ReviewEvent, error)) (*domain.ContentReview, error) {
+	if userID == "" {
+		return nil, domain.NewUnauthorizedError("user ID is required for editorial review")
+	}
+
+	research, err := s.repository.GetResearch(ctx, researchID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := *research // Copy for audit
+	existing.Review = research.Review.Clone()
+	if research.Review == nil {
+		research.Review = &domain.EditorialReview{}
+	}
+
+	event, err := step(research, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	audit := domain.NewAuditChange(domain.EntityTypeResearch, researchID, domain.AuditEventUpdate, userID, &existing, research)
+	if err := s.repository.SaveResearch(ctx, research, audit); err != nil {
+		return nil, err
+	}
+
+	if event != nil {
+		if err := s.repository.PublishReviewEvent(ctx, event); err != nil {
+			return nil, err
+		}
+	}
+
+	return research.ContentReview(), nil
 }
 
 func (s *ResearchService) DeleteResearch(ctx context.Context, researchID string, userID string) error {
@@ -197,12 +381,8 @@ func (s *ResearchService) DeleteResearch(ctx context.Context, researchID string,
 	}
 
 	// Soft delete research
-	if err := s.repository.DeleteResearch(ctx, researchID); err != nil {
-		return err
-	}
-
-	// Publish audit event
-	return s.repository.PublishAuditEvent(ctx, domain.EntityTypeResearch, researchID, domain.AuditEventDelete, userID, existing, nil)
+	audit := domain.NewAuditChange(domain.EntityTypeResearch, researchID, domain.AuditEventDelete, userID, existing, nil)
+	return s.repository.DeleteResearch(ctx, researchID, audit)
 }
 
 // Research category operations
@@ -229,12 +409,8 @@ func (s *ResearchService) CreateResearchCategory(ctx context.Context, category *
 	}
 
 	// Save category
-	if err := s.repository.SaveResearchCategory(ctx, category); err != nil {
-		return err
-	}
-
-	// Publish audit event
-	return s.repository.PublishAuditEvent(ctx, domain.EntityTypeResearchCategory, category.CategoryID, domain.AuditEventInsert, userID, nil, category)
+	audit := domain.NewAuditChange(domain.EntityTypeResearchCategory, category.CategoryID, domain.AuditEventInsert, userID, nil, category)
+	return s.repository.SaveResearchCategory(ctx, category, audit)
 }
 
 func (s *ResearchService) UpdateResearchCategory(ctx context.Context, category *ResearchCategory, userID string) error {
@@ -254,12 +430,8 @@ func (s *ResearchService) UpdateResearchCategory(ctx context.Context, category *
 	}
 
 	// Save updated category
-	if err := s.repository.SaveResearchCategory(ctx, category); err != nil {
-		return err
-	}
-
-	// Publish audit event
-	return s.repository.PublishAuditEvent(ctx, domain.EntityTypeResearchCategory, category.CategoryID, domain.AuditEventUpdate, userID, existing, category)
+	audit := domain.NewAuditChange(domain.EntityTypeResearchCategory, category.CategoryID, domain.AuditEventUpdate, userID, existing, category)
+	return s.repository.SaveResearchCategory(ctx, category, audit)
 }
 
 func (s *ResearchService) DeleteResearchCategory(ctx context.Context, categoryID string, userID string) error {
@@ -275,12 +447,8 @@ func (s *ResearchService) DeleteResearchCategory(ctx context.Context, categoryID
 	}
 
 	// Soft delete category
-	if err := s.repository.DeleteResearchCategory(ctx, categoryID); err != nil {
-		return err
-	}
-
-	// Publish audit event
-	return s.repository.PublishAuditEvent(ctx, domain.EntityTypeResearchCategory, categoryID, domain.AuditEventDelete, userID, existing, nil)
+	audit := domain.NewAuditChange(domain.EntityTypeResearchCategory, categoryID, domain.AuditEventDelete, userID, existing, nil)
+	return s.repository.DeleteResearchCategory(ctx, categoryID, audit)
 }
 
 // Featured research operations
@@ -308,12 +476,8 @@ func (s *ResearchService) SetFeaturedResearch(ctx context.Context, researchID st
 	featured.SetDefaults()
 
 	// Save featured research (will replace existing due to constraint)
-	if err := s.repository.SaveFeaturedResearch(ctx, featured); err != nil {
-		return err
-	}
-
-	// Publish audit event
-	return s.repository.PublishAuditEvent(ctx, domain.EntityTypeFeaturedResearch, featured.FeaturedResearchID, domain.AuditEventInsert, userID, nil, featured)
+	audit := domain.NewAuditChange(domain.EntityTypeFeaturedResearch, featured.FeaturedResearchID, domain.AuditEventInsert, userID, nil, featured)
+	return s.repository.SaveFeaturedResearch(ctx, featured, audit)
 }
 
 func (s *ResearchService) RemoveFeaturedResearch(ctx context.Context, userID string) error {
@@ -324,12 +488,8 @@ func (s *ResearchService) RemoveFeaturedResearch(ctx context.Context, userID str
 	}
 
 	// Delete featured research
-	if err := s.repository.DeleteFeaturedResearch(ctx, existing.FeaturedResearchID); err != nil {
-		return err
-	}
-
-	// Publish audit event
-	return s.repository.PublishAuditEvent(ctx, domain.EntityTypeFeaturedResearch, existing.FeaturedResearchID, domain.AuditEventDelete, userID, existing, nil)
+	audit := domain.NewAuditChange(domain.EntityTypeFeaturedResearch, existing.FeaturedResearchID, domain.AuditEventDelete, userID, existing, nil)
+	return s.repository.DeleteFeaturedResearch(ctx, existing.FeaturedResearchID, audit)
 }
 
 // Audit operations
@@ -350,4 +510,86 @@ func (s *ResearchService) GetResearchCategoryAudit(ctx context.Context, category
 	}
 
 	return s.repository.GetResearchCategoryAudit(ctx, categoryID, userID, limit, offset)
+}
+
+// Revision operations
+
+func (s *ResearchService) GetResearchRevisions(ctx context.Context, researchID string, userID string) ([]*domain.Revision, error) {
+	// Check if user has admin privileges
+	if !strings.HasPrefix(userID, "admin-") {
+		return nil, domain.NewUnauthorizedError("revision history requires admin privileges")
+	}
+
+	return s.repository.ListResearchRevisions(ctx, researchID)
+}
+
+func (s *ResearchService) GetResearchRevision(ctx context.Context, researchID string, revision int, userID string) (*domain.Revision, error) {
+	// Check if user has admin privileges
+	if !strings.HasPrefix(userID, "admin-") {
+		return nil, domain.NewUnauthorizedError("revision history requires admin privileges")
+	}
+
+	return s.repository.GetResearchRevision(ctx, researchID, revision)
+}
+
+func (s *ResearchService) DiffResearchRevisions(ctx context.Context, researchID string, fromRevision, toRevision int, userID string) (*domain.RevisionDiff, error) {
+	from, err := s.GetResearchRevision(ctx, researchID, fromRevision, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := s.GetResearchRevision(ctx, researchID, toRevision, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.DiffRevisions(from, to)
+}
+
+// RollbackResearch saves the content of an earlier revision as the newest version of
+// the publication and audits it as a rollback. Identity and creation details are kept
+// from the current publication.
+func (s *ResearchService) RollbackResearch(ctx context.Context, researchID string, revision int, userID string) (*Research, error) {
+	target, err := s.GetResearchRevision(ctx, researchID, revision, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repository.GetResearch(ctx, researchID)
+	if err != nil {
+		return nil, err
+	}
+
+	var restored Research
+	if err := target.DecodeContent(&restored); err != nil {
+		return nil, err
+	}
+
+	restored.ResearchID = existing.ResearchID
+	restored.CreatedOn = existing.CreatedOn
+	restored.CreatedBy = existing.CreatedBy
+	restored.EmbargoUntil = existing.EmbargoUntil
+	restored.Review = existing.Review.Clone()
+	if restored.Review == nil {
+		restored.Review = &domain.EditorialReview{}
+	}
+	restored.PublishingStatus = PublishingStatus(s.workflow.StatusAfterEdit(
+		domain.WorkflowStatus(existing.PublishingStatus), domain.WorkflowStatus(restored.PublishingStatus), restored.Review, userID))
+	restored.IsDeleted = false
+	restored.DeletedOn = nil
+	restored.DeletedBy = ""
+	restored.ModifiedBy = userID
+	now := time.Now().UTC()
+	restored.ModifiedOn = &now
+
+	if err := restored.Validate(); err != nil {
+		return nil, err
+	}
+
+	audit := domain.NewAuditChange(domain.EntityTypeResearch, researchID, domain.AuditEventRollback, userID, existing, &restored)
+	if err := s.repository.SaveResearch(ctx, &restored, audit); err != nil {
+		return nil, err
+	}
+
+	return &restored, nil
 }
\ No newline at end of file
@@ -0,0 +1,173 @@
+package content
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+)
+
+// Secondary indexes maintained for publication schedules
+const (
+	scheduleIndexStatus = "status"
+	scheduleIndexEntity = "entity"
+)
+
+// allScheduleStatuses lists every status so that an unfiltered listing can be
+// served from the status index
+var allScheduleStatuses = []string{
+	string(domain.ScheduleStatusPending),
+	string(domain.ScheduleStatusRunning),
+	string(domain.ScheduleStatusCompleted),
+	string(domain.ScheduleStatusCancelled),
+	string(domain.ScheduleStatusFailed),
+}
+
+// ScheduleIndexes declares the secondary indexes maintained for publication schedules
+func ScheduleIndexes() *dapr.IndexedEntityType {
+	return &dapr.IndexedEntityType{
+		Domain:     "content",
+		EntityType: "schedule",
+		Indexes: []dapr.IndexDefinition{
+			{Name: scheduleIndexStatus, Extract: func(entity interface{}) []string {
+				return []string{string(entity.(*domain.PublicationSchedule).Status)}
+			}},
+			{Name: scheduleIndexEntity, Extract: func(entity interface{}) []string {
+				schedule := entity.(*domain.PublicationSchedule)
+				return []string{scheduleEntityKey(schedule.EntityType, schedule.EntityID)}
+			}},
+		},
+		NewEntity: func() interface{} { return &domain.PublicationSchedule{} },
+		EntityID:  func(entity interface{}) string { return entity.(*domain.PublicationSchedule).ScheduleID },
+	}
+}
+
+// ScheduleRepository stores publication schedules in the Dapr state store
+type ScheduleRepository struct {
+	stateStore *dapr.StateStore
+}
+
+// NewScheduleRepository creates a schedule repository and registers its indexes
+func NewScheduleRepository(stateStore *dapr.StateStore) *ScheduleRepository {
+	stateStore.MustRegisterIndexes(ScheduleIndexes())
+
+	return &ScheduleRepository{
+		stateStore: stateStore,
+	}
+}
+
+// SaveSchedule saves a schedule and its indexes. A schedule carrying an ETag is only
+// saved while the stored version still matches, which is how workers claim it.
+func (r *ScheduleRepository) SaveSchedule(ctx context.Context, schedule *domain.PublicationSchedule) error {
+	err := r.stateStore.SaveIndexedWithETag(ctx, "content", "schedule", schedule.ScheduleID, schedule, schedule.ETag)
+	if err != nil {
+		return domain.WrapError(err, fmt.Sprintf("failed to save schedule %s", schedule.ScheduleID))
+	}
+
+	key := r.stateStore.CreateKey("content", "schedule", schedule.ScheduleID)
+	if schedule.ETag, err = r.stateStore.WrittenETag(ctx, key, schedule); err != nil {
+		schedule.ETag = ""
+	}
+
+	return nil
+}
+
+// GetSchedule retrieves a schedule by ID
+func (r *ScheduleRepository) GetSchedule(ctx context.Context, scheduleID string) (*domain.PublicationSchedule, error) {
+	key := r.stateStore.CreateKey("content", "schedule", scheduleID)
+
+	var schedule domain.PublicationSchedule
+	found, etag, err := r.stateStore.GetWithETag(ctx, key, &schedule)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schedule %s: %w", scheduleID, err)
+	}
+	if !found {
+		return nil, domain.NewNotFoundError("schedule", scheduleID)
+	}
+
+	schedule.ETag = etag
+	return &schedule, nil
+}
+
+// ListSchedules retrieves schedules matching the filter, earliest run time first
+func (r *ScheduleRepository) ListSchedules(ctx context.Context, filter ScheduleFilter) ([]*domain.PublicationSchedule, error) {
+	var conditions []dapr.IndexCondition
+	if filter.Status != "" {
+		conditions = append(conditions, dapr.IndexCondition{Index: scheduleIndexStatus, Value: string(filter.Status)})
+	}
+	if filter.EntityType != "" && filter.EntityID != "" {
+		conditions = append(conditions, dapr.IndexCondition{Index: scheduleIndexEntity, Value: scheduleEntityKey(filter.EntityType, filter.EntityID)})
+	}
+
+	var ids []string
+	var err error
+	if len(conditions) > 0 {
+		ids, err = r.stateStore.LookupIndex(ctx, "content", "schedule", conditions...)
+	} else {
+		ids, err = r.stateStore.LookupIndexAny(ctx, "content", "schedule", scheduleIndexStatus, allScheduleStatuses)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up schedules: %w", err)
+	}
+
+	schedules, err := r.getSchedulesByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	// A content type without an entity ID has no index of its own
+	if filter.EntityType != "" {
+		filtered := schedules[:0]
+		for _, schedule := range schedules {
+			if schedule.EntityType == filter.EntityType {
+				filtered = append(filtered, schedule)
+			}
+		}
+		schedules = filtered
+	}
+
+	return schedules, nil
+}
+
+// ListActiveSchedules retrieves every schedule whose transition has yet to fire
+func (r *ScheduleRepository) ListActiveSchedules(ctx context.Context) ([]*domain.PublicationSchedule, error) {
+	ids, err := r.stateStore.LookupIndexAny(ctx, "content", "schedule", scheduleIndexStatus, []string{
+		string(domain.ScheduleStatusPending),
+		string(domain.ScheduleStatusRunning),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up active schedules: %w", err)
+	}
+
+	return r.getSchedulesByIDs(ctx, ids)
+}
+
+// getSchedulesByIDs loads schedules with their ETags, earliest run time first
+func (r *ScheduleRepository) getSchedulesByIDs(ctx context.Context, ids []string) ([]*domain.PublicationSchedule, error) {
+	schedules := make([]*domain.PublicationSchedule, 0, len(ids))
+	for _, id := range ids {
+		schedule, err := r.GetSchedule(ctx, id)
+		if err != nil {
+			if domain.IsNotFoundError(err) {
+				continue
+			}
+			return nil, err
+		}
+		schedules = append(schedules, schedule)
+	}
+
+	sort.SliceStable(schedules, func(i, j int) bool {
+		if !schedules[i].RunAt.Equal(schedules[j].RunAt) {
+			return schedules[i].RunAt.Before(schedules[j].RunAt)
+		}
+		return schedules[i].ScheduleID < schedules[j].ScheduleID
+	})
+
+	return schedules, nil
+}
+
+func scheduleEntityKey(entityType domain.EntityType, entityID string) string {
+	return string(entityType) + ":" + entityID
+}
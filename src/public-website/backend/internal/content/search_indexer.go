@@ -0,0 +1,60 @@
+package content
+
+import (
+	"context"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+)
+
+// searchIndexRoute is the route Dapr delivers audit events to for search indexing
+const searchIndexRoute = "/events/search-index"
+
+// ReindexFunc refreshes the search index entry of one content entity
+type ReindexFunc func(ctx context.Context, entityID string) error
+
+// SearchIndexer keeps the content search indexes up to date from the audit
+// events published whenever content is created, changed, published or removed
+type SearchIndexer struct {
+	reindexers map[domain.EntityType]ReindexFunc
+}
+
+// NewSearchIndexer creates an indexer with no entity types registered
+func NewSearchIndexer() *SearchIndexer {
+	return &SearchIndexer{
+		reindexers: make(map[domain.EntityType]ReindexFunc),
+	}
+}
+
+// Register routes audit events for entityType to reindex
+func (i *SearchIndexer) Register(entityType domain.EntityType, reindex ReindexFunc) {
+	i.reindexers[entityType] = reindex
+}
+
+// HandleAuditEvent reindexes the entity an audit event refers to. Read access
+// events and entity types without a search index are ignored.
+func (i *SearchIndexer) HandleAuditEvent(ctx context.Context, event *dapr.TopicEvent) error {
+	message, err := event.EventMessage()
+	if err != nil {
+		return err
+	}
+
+	entityType, _ := message.Data["entity_type"].(string)
+	entityID, _ := message.Data["entity_id"].(string)
+	operationType, _ := message.Data["operation_type"].(string)
+
+	if domain.AuditEventType(operationType) == domain.AuditEventAccess {
+		return nil
+	}
+
+	reindex, exists := i.reindexers[domain.EntityType(entityType)]
+	if !exists {
+		return nil
+	}
+
+	if entityID == "" {
+		return domain.NewValidationError("audit event has no entity ID")
+	}
+
+	return reindex(ctx, entityID)
+}
@@ -0,0 +1,100 @@
+package content
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func auditTopicEvent(t *testing.T, entityType, entityID, operationType string) *dapr.TopicEvent {
+	data, err := json.Marshal(dapr.EventMessage{
+		Data: map[string]interface{}{
+			"entity_type":    entityType,
+			"entity_id":      entityID,
+			"operation_type": operationType,
+		},
+	})
+	require.NoError(t, err)
+	return &dapr.TopicEvent{ID: "event-1", Topic: "audit-events-dev", Data: data}
+}
+
+func TestSearchIndexer_HandleAuditEvent(t *testing.T) {
+	tests := []struct {
+		name          string
+		event         func(t *testing.T) *dapr.TopicEvent
+		reindexErr    error
+		wantReindexed []string
+		wantError     bool
+		wantDrop      bool
+	}{
+		{
+			name:          "reindex published news",
+			event:         func(t *testing.T) *dapr.TopicEvent { return auditTopicEvent(t, "news", "news-1", "PUBLISH") },
+			wantReindexed: []string{"news-1"},
+		},
+		{
+			name:          "reindex deleted news",
+			event:         func(t *testing.T) *dapr.TopicEvent { return auditTopicEvent(t, "news", "news-1", "DELETE") },
+			wantReindexed: []string{"news-1"},
+		},
+		{
+			name:  "ignore read access",
+			event: func(t *testing.T) *dapr.TopicEvent { return auditTopicEvent(t, "news", "news-1", "ACCESS") },
+		},
+		{
+			name:  "ignore entity types without search index",
+			event: func(t *testing.T) *dapr.TopicEvent { return auditTopicEvent(t, "inquiry", "inquiry-1", "INSERT") },
+		},
+		{
+			name:      "drop event without entity ID",
+			event:     func(t *testing.T) *dapr.TopicEvent { return auditTopicEvent(t, "news", "", "UPDATE") },
+			wantError: true,
+			wantDrop:  true,
+		},
+		{
+			name: "drop undecodable payload",
+			event: func(t *testing.T) *dapr.TopicEvent {
+				return &dapr.TopicEvent{ID: "event-1", Data: json.RawMessage(`"not a message"`)}
+			},
+			wantError: true,
+			wantDrop:  true,
+		},
+		{
+			name:          "retry when reindex fails",
+			event:         func(t *testing.T) *dapr.TopicEvent { return auditTopicEvent(t, "news", "news-1", "UPDATE") },
+			reindexErr:    errors.New("state store unavailable"),
+			wantReindexed: []string{"news-1"},
+			wantError:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var reindexed []string
+			indexer := NewSearchIndexer()
+			indexer.Register(domain.EntityTypeNews, func(ctx context.Context, entityID string) error {
+				reindexed = append(reindexed, entityID)
+				return tt.reindexErr
+			})
+
+			// Act
+			err := indexer.HandleAuditEvent(context.Background(), tt.event(t))
+
+			// Assert
+			assert.Equal(t, tt.wantReindexed, reindexed)
+			if tt.wantError {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantDrop, domain.IsValidationError(err))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -4,73 +4,97 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/search"
 )
 
 // ServicesRepository implements services data access using Dapr state store and bindings
 type ServicesRepository struct {
-	stateStore *dapr.StateStore
-	bindings   *dapr.Bindings
-	pubsub     *dapr.PubSub
+	stateStore  *dapr.StateStore
+	bindings    *dapr.Bindings
+	pubsub      *dapr.PubSub
+	searchIndex *search.Index
+}
+
+// Secondary indexes maintained for services
+const (
+	serviceIndexCategory         = "category"
+	serviceIndexPublishingStatus = "publishing_status"
+	serviceIndexSlug             = "slug"
+	serviceIndexIsDeleted        = "is_deleted"
+)
+
+// ServiceIndexes declares the secondary indexes maintained for services
+func ServiceIndexes() *dapr.IndexedEntityType {
+	return &dapr.IndexedEntityType{
+		Domain:     "services",
+		EntityType: "service",
+		Indexes: []dapr.IndexDefinition{
+			{Name: serviceIndexCategory, Extract: func(entity interface{}) []string {
+				return []string{entity.(*Service).CategoryID}
+			}},
+			{Name: serviceIndexPublishingStatus, Extract: func(entity interface{}) []string {
+				return []string{string(entity.(*Service).PublishingStatus)}
+			}},
+			{Name: serviceIndexSlug, Extract: func(entity interface{}) []string {
+				return []string{entity.(*Service).Slug}
+			}},
+			{Name: serviceIndexIsDeleted, Extract: func(entity interface{}) []string {
+				return []string{dapr.IndexBool(entity.(*Service).IsDeleted)}
+			}},
+		},
+		NewEntity: func() interface{} { return &Service{} },
+		EntityID:  func(entity interface{}) string { return entity.(*Service).ServiceID },
+		SortKey:   func(entity interface{}) time.Time { return entity.(*Service).PageCursor().Timestamp },
+	}
 }
 
 // NewServicesRepository creates a new services repository
 func NewServicesRepository(client *dapr.Client) *ServicesRepository {
+	stateStore := dapr.NewStateStore(client)
+	stateStore.MustRegisterIndexes(ServiceIndexes())
+	stateStore.MustRegisterIndexes(dapr.OutboxMessageIndexes())
+
 	return &ServicesRepository{
-		stateStore: dapr.NewStateStore(client),
-		bindings:   dapr.NewBindings(client),
-		pubsub:     dapr.NewPubSub(client),
+		stateStore:  stateStore,
+		bindings:    dapr.NewBindings(client),
+		pubsub:      dapr.NewPubSub(client),
+		searchIndex: newServiceSearchIndex(),
 	}
 }
 
 // Service operations
 
-// SaveService saves service to Dapr state store
-func (r *ServicesRepository) SaveService(ctx context.Context, service *Service) error {
-	key := r.stateStore.CreateKey("services", "service", service.ServiceID)
-	
-	err := r.stateStore.Save(ctx, key, service, nil)
+// SaveService saves service and its secondary indexes to Dapr state store, committing
+// the audit event in the same transaction. A service loaded with an ETag is only saved
+// while the stored version still matches it.
+func (r *ServicesRepository) SaveService(ctx context.Context, service *Service, audit *domain.AuditEvent) error {
+	messages, err := r.auditMessages(ctx, audit)
 	if err != nil {
-		return fmt.Errorf("failed to save service %s: %w", service.ServiceID, err)
+		return err
 	}
 
-	// Create index for slug search
-	slugKey := r.stateStore.CreateIndexKey("services", "service", "slug", service.Slug)
-	slugIndex := map[string]string{"service_id": service.ServiceID}
-	
-	err = r.stateStore.Save(ctx, slugKey, slugIndex, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create slug index for service %s: %w", service.ServiceID, err)
+	// Keep a revision of each service change alongside the audit trail
+	var revision *domain.Revision
+	if audit != nil && audit.EntityType == domain.EntityTypeService && domain.IsRevisionedOperation(audit.OperationType) {
+		revision = domain.NewRevision(audit)
 	}
 
-	// Create index for category search
-	categoryKey := r.stateStore.CreateIndexKey("services", "service", "category", service.CategoryID)
-	categoryIndex := map[string]interface{}{
-		"service_id":   service.ServiceID,
-		"created_on":   service.CreatedOn,
-		"order_number": service.OrderNumber,
-	}
-	
-	err = r.stateStore.Save(ctx, categoryKey, categoryIndex, nil)
+	err = r.stateStore.SaveIndexedWithRevision(ctx, "services", "service", service.ServiceID, service, service.ETag, revision, messages...)
 	if err != nil {
-		return fmt.Errorf("failed to create category index for service %s: %w", service.ServiceID, err)
+		return fmt.Errorf("failed to save service %s: %w", service.ServiceID, err)
 	}
 
-	// Create index for publishing status search
-	statusKey := r.stateStore.CreateIndexKey("services", "service", "status", string(service.PublishingStatus))
-	statusIndex := map[string]interface{}{
-		"service_id": service.ServiceID,
-		"created_on": service.CreatedOn,
-	}
-	
-	err = r.stateStore.Save(ctx, statusKey, statusIndex, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create status index for service %s: %w", service.ServiceID, err)
+	// Return the new version only while it is still this save's
+	key := r.stateStore.CreateKey("services", "service", service.ServiceID)
+	if service.ETag, err = r.stateStore.WrittenETag(ctx, key, service); err != nil {
+		service.ETag = ""
 	}
 
 	return nil
@@ -81,7 +105,7 @@ func (r *ServicesRepository) GetService(ctx context.Context, serviceID string) (
 	key := r.stateStore.CreateKey("services", "service", serviceID)
 	
 	var service Service
-	found, err := r.stateStore.Get(ctx, key, &service)
+	found, etag, err := r.stateStore.GetWithETag(ctx, key, &service)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get service %s: %w", serviceID, err)
 	}
@@ -94,169 +118,160 @@ func (r *ServicesRepository) GetService(ctx context.Context, serviceID string) (
 		return nil, domain.NewNotFoundError("service", serviceID)
 	}
 
+	service.ETag = etag
 	return &service, nil
 }
 
-// GetServiceBySlug retrieves service by slug from Dapr state store
+// GetServiceBySlug retrieves service by slug using the slug index
 func (r *ServicesRepository) GetServiceBySlug(ctx context.Context, slug string) (*Service, error) {
-	query := fmt.Sprintf(`{
-		"filter": {
-			"AND": [
-				{
-					"EQ": {"slug": "%s"}
-				},
-				{
-					"EQ": {"is_deleted": false}
-				}
-			]
-		}
-	}`, slug)
-
-	results, err := r.stateStore.Query(ctx, query)
+	ids, err := r.stateStore.LookupIndex(ctx, "services", "service",
+		dapr.IndexCondition{Index: serviceIndexSlug, Value: slug},
+		dapr.IndexCondition{Index: serviceIndexIsDeleted, Value: dapr.IndexBool(false)},
+	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query service by slug %s: %w", slug, err)
+		return nil, fmt.Errorf("failed to look up service by slug %s: %w", slug, err)
 	}
 
-	if len(results) == 0 {
-		return nil, domain.NewNotFoundError("service with slug", slug)
+	services, err := r.getServicesByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
 	}
 
-	var service Service
-	err = json.Unmarshal(results[0].Value, &service)
-	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal service: %w", err)
+	for _, service := range services {
+		if service.Slug == slug {
+			return service, nil
+		}
 	}
 
-	return &service, nil
+	return nil, domain.NewNotFoundError("service with slug", slug)
 }
 
 // GetAllServices retrieves all non-deleted services from Dapr state store
 func (r *ServicesRepository) GetAllServices(ctx context.Context) ([]*Service, error) {
-	query := `{
-		"filter": {
-			"EQ": {"is_deleted": false}
-		},
-		"sort": [
-			{
-				"key": "order_number",
-				"order": "ASC"
-			},
-			{
-				"key": "created_on",
-				"order": "DESC"
-			}
-		]
-	}`
+	ids, err := r.stateStore.LookupIndex(ctx, "services", "service",
+		dapr.IndexCondition{Index: serviceIndexIsDeleted, Value: dapr.IndexBool(false)},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up all services: %w", err)
+	}
 
-	results, err := r.stateStore.Query(ctx, query)
+	return r.getServicesByIDs(ctx, ids)
+}
+
+// GetServicesByCategory retrieves services by category using the category index
+func (r *ServicesRepository) GetServicesByCategory(ctx context.Context, categoryID string) ([]*Service, error) {
+	ids, err := r.stateStore.LookupIndex(ctx, "services", "service",
+		dapr.IndexCondition{Index: serviceIndexCategory, Value: categoryID},
+		dapr.IndexCondition{Index: serviceIndexIsDeleted, Value: dapr.IndexBool(false)},
+	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query all services: %w", err)
+		return nil, fmt.Errorf("failed to look up services by category %s: %w", categoryID, err)
 	}
 
-	var services []*Service
-	for _, result := range results {
-		var service Service
-		err = json.Unmarshal(result.Value, &service)
-		if err != nil {
-			continue // Skip invalid records
-		}
-		
-		if !service.IsDeleted {
-			services = append(services, &service)
-		}
+	return r.getServicesByIDs(ctx, ids)
+}
+
+// GetServicesByPublishingStatus retrieves services by publishing status using the status index
+func (r *ServicesRepository) GetServicesByPublishingStatus(ctx context.Context, status PublishingStatus) ([]*Service, error) {
+	ids, err := r.stateStore.LookupIndex(ctx, "services", "service",
+		dapr.IndexCondition{Index: serviceIndexPublishingStatus, Value: string(status)},
+		dapr.IndexCondition{Index: serviceIndexIsDeleted, Value: dapr.IndexBool(false)},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up services by publishing status %s: %w", status, err)
 	}
 
-	return services, nil
+	return r.getServicesByIDs(ctx, ids)
 }
 
-// GetServicesByCategory retrieves services by category from Dapr state store
-func (r *ServicesRepository) GetServicesByCategory(ctx context.Context, categoryID string) ([]*Service, error) {
-	query := fmt.Sprintf(`{
-		"filter": {
-			"AND": [
-				{
-					"EQ": {"category_id": "%s"}
-				},
-				{
-					"EQ": {"is_deleted": false}
-				}
-			]
-		},
-		"sort": [
-			{
-				"key": "order_number",
-				"order": "ASC"
-			},
-			{
-				"key": "created_on",
-				"order": "DESC"
-			}
-		]
-	}`, categoryID)
+// getServicesByIDs loads indexed services ordered by order number, then newest first
+func (r *ServicesRepository) getServicesByIDs(ctx context.Context, ids []string) ([]*Service, error) {
+	services := make([]*Service, 0, len(ids))
+	if len(ids) == 0 {
+		return services, nil
+	}
 
-	results, err := r.stateStore.Query(ctx, query)
-	if err != nil {
-		return nil, fmt.Errorf("failed to query services by category %s: %w", categoryID, err)
+	keys := make([]string, 0, len(ids))
+	targets := make(map[string]interface{}, len(ids))
+	loaded := make([]*Service, 0, len(ids))
+	for _, id := range ids {
+		key := r.stateStore.CreateKey("services", "service", id)
+		service := &Service{}
+		keys = append(keys, key)
+		targets[key] = service
+		loaded = append(loaded, service)
 	}
 
-	var services []*Service
-	for _, result := range results {
-		var service Service
-		err = json.Unmarshal(result.Value, &service)
-		if err != nil {
-			continue // Skip invalid records
+	if err := r.stateStore.GetBulk(ctx, keys, targets); err != nil {
+		return nil, fmt.Errorf("failed to load indexed services: %w", err)
+	}
+
+	for _, service := range loaded {
+		if service.ServiceID != "" && !service.IsDeleted {
+			services = append(services, service)
 		}
-		services = append(services, &service)
 	}
 
+	sort.SliceStable(services, func(i, j int) bool {
+		if services[i].OrderNumber != services[j].OrderNumber {
+			return services[i].OrderNumber < services[j].OrderNumber
+		}
+		if !services[i].CreatedOn.Equal(services[j].CreatedOn) {
+			return services[i].CreatedOn.After(services[j].CreatedOn)
+		}
+		return services[i].ServiceID < services[j].ServiceID
+	})
+
 	return services, nil
 }
 
-// GetServicesByPublishingStatus retrieves services by publishing status
-func (r *ServicesRepository) GetServicesByPublishingStatus(ctx context.Context, status PublishingStatus) ([]*Service, error) {
-	query := fmt.Sprintf(`{
-		"filter": {
-			"AND": [
-				{
-					"EQ": {"publishing_status": "%s"}
-				},
-				{
-					"EQ": {"is_deleted": false}
-				}
-			]
-		},
-		"sort": [
-			{
-				"key": "order_number",
-				"order": "ASC"
-			},
-			{
-				"key": "created_on",
-				"order": "DESC"
-			}
-		]
-	}`, string(status))
+// ListServicesPage retrieves one page of non-deleted services matching the filter,
+// newest first, resuming after the page cursor
+func (r *ServicesRepository) ListServicesPage(ctx context.Context, filter ServiceListFilter, page domain.PageRequest) ([]*Service, domain.PageInfo, error) {
+	conditions := []dapr.IndexCondition{
+		{Index: serviceIndexIsDeleted, Value: dapr.IndexBool(false)},
+	}
+	if filter.CategoryID != "" {
+		conditions = append(conditions, dapr.IndexCondition{Index: serviceIndexCategory, Value: filter.CategoryID})
+	}
+	if filter.PublishingStatus != "" {
+		conditions = append(conditions, dapr.IndexCondition{Index: serviceIndexPublishingStatus, Value: string(filter.PublishingStatus)})
+	}
 
-	results, err := r.stateStore.Query(ctx, query)
+	ids, info, err := r.stateStore.LookupIndexPage(ctx, "services", "service", page, conditions...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query services by publishing status %s: %w", status, err)
+		return nil, domain.PageInfo{}, fmt.Errorf("failed to look up services page: %w", err)
 	}
 
-	var services []*Service
-	for _, result := range results {
-		var service Service
-		err = json.Unmarshal(result.Value, &service)
-		if err != nil {
-			continue // Skip invalid records
-		}
-		services = append(services, &service)
+	services, err := r.getServicesByIDs(ctx, ids)
+	if err != nil {
+		return nil, domain.PageInfo{}, err
 	}
 
-	return services, nil
+	// getServicesByIDs orders by display order; a page keeps listing order
+	sort.SliceStable(services, func(i, j int) bool {
+		return services[i].PageCursor().Before(services[j].PageCursor())
+	})
+
+	info.Count = len(services)
+	return services, info, nil
+}
+
+// PageServices orders services by PageCursor and slices out the requested page
+func PageServices(services []*Service, page domain.PageRequest) ([]*Service, domain.PageInfo) {
+	sort.SliceStable(services, func(i, j int) bool {
+		return services[i].PageCursor().Before(services[j].PageCursor())
+	})
+
+	start, end, info := domain.CursorPage(len(services), func(i int) domain.PageCursor {
+		return services[i].PageCursor()
+	}, page)
+
+	return services[start:end], info
 }
 
 // DeleteService soft deletes service from Dapr state store
-func (r *ServicesRepository) DeleteService(ctx context.Context, serviceID string, userID string) error {
+func (r *ServicesRepository) DeleteService(ctx context.Context, serviceID string, userID string, audit *domain.AuditEvent) error {
 	service, err := r.GetService(ctx, serviceID)
 	if err != nil {
 		return err
@@ -267,16 +282,21 @@ func (r *ServicesRepository) DeleteService(ctx context.Context, serviceID string
 		return err
 	}
 
-	return r.SaveService(ctx, service)
+	return r.SaveService(ctx, service, audit)
 }
 
 // ServiceCategory operations
 
-// SaveServiceCategory saves service category to Dapr state store
-func (r *ServicesRepository) SaveServiceCategory(ctx context.Context, category *ServiceCategory) error {
+// SaveServiceCategory saves service category to Dapr state store together with its audit event
+func (r *ServicesRepository) SaveServiceCategory(ctx context.Context, category *ServiceCategory, audit *domain.AuditEvent) error {
+	messages, err := r.auditMessages(ctx, audit)
+	if err != nil {
+		return err
+	}
+
 	key := r.stateStore.CreateKey("services", "category", category.CategoryID)
 	
-	err := r.stateStore.Save(ctx, key, category, nil)
+	err = r.stateStore.SaveWithOutbox(ctx, key, category, messages...)
 	if err != nil {
 		return fmt.Errorf("failed to save service category %s: %w", category.CategoryID, err)
 	}
@@ -420,7 +440,7 @@ func (r *ServicesRepository) GetDefaultUnassignedCategory(ctx context.Context) (
 }
 
 // DeleteServiceCategory soft deletes service category from Dapr state store
-func (r *ServicesRepository) DeleteServiceCategory(ctx context.Context, categoryID string, userID string) error {
+func (r *ServicesRepository) DeleteServiceCategory(ctx context.Context, categoryID string, userID string, audit *domain.AuditEvent) error {
 	category, err := r.GetServiceCategory(ctx, categoryID)
 	if err != nil {
 		return err
@@ -431,16 +451,21 @@ func (r *ServicesRepository) DeleteServiceCategory(ctx context.Context, category
 		return err
 	}
 
-	return r.SaveServiceCategory(ctx, category)
+	return r.SaveServiceCategory(ctx, category, audit)
 }
 
 // FeaturedCategory operations
 
-// SaveFeaturedCategory saves featured category to Dapr state store
-func (r *ServicesRepository) SaveFeaturedCategory(ctx context.Context, featured *FeaturedCategory) error {
+// SaveFeaturedCategory saves featured category to Dapr state store together with its audit event
+func (r *ServicesRepository) SaveFeaturedCategory(ctx context.Context, featured *FeaturedCategory, audit *domain.AuditEvent) error {
+	messages, err := r.auditMessages(ctx, audit)
+	if err != nil {
+		return err
+	}
+
 	key := r.stateStore.CreateKey("services", "featured", featured.FeaturedCategoryID)
 	
-	err := r.stateStore.Save(ctx, key, featured, nil)
+	err = r.stateStore.SaveWithOutbox(ctx, key, featured, messages...)
 	if err != nil {
 		return fmt.Errorf("failed to save featured category %s: %w", featured.FeaturedCategoryID, err)
 	}
@@ -540,10 +565,15 @@ func (r *ServicesRepository) GetFeaturedCategoryByPosition(ctx context.Context,
 }
 
 // DeleteFeaturedCategory deletes featured category from Dapr state store
-func (r *ServicesRepository) DeleteFeaturedCategory(ctx context.Context, featuredCategoryID string) error {
+func (r *ServicesRepository) DeleteFeaturedCategory(ctx context.Context, featuredCategoryID string, audit *domain.AuditEvent) error {
+	messages, err := r.auditMessages(ctx, audit)
+	if err != nil {
+		return err
+	}
+
 	key := r.stateStore.CreateKey("services", "featured", featuredCategoryID)
 	
-	err := r.stateStore.Delete(ctx, key, nil)
+	err = r.stateStore.DeleteWithOutbox(ctx, key, messages...)
 	if err != nil {
 		return fmt.Errorf("failed to delete featured category %s: %w", featuredCategoryID, err)
 	}
@@ -583,15 +613,15 @@ func (r *ServicesRepository) CreateServiceContentBlobURL(ctx context.Context, st
 	return url, nil
 }
 
-// PublishAuditEvent publishes audit events for services operations
-func (r *ServicesRepository) PublishAuditEvent(ctx context.Context, entityType domain.EntityType, entityID string, operationType domain.AuditEventType, userID string, beforeData, afterData interface{}) error {
-	correlationID := domain.GetCorrelationID(ctx)
-	traceID := domain.GetTraceID(ctx)
-	
-	auditEvent := domain.NewAuditEvent(entityType, entityID, operationType, userID)
-	auditEvent.SetTraceContext(correlationID, traceID)
+// auditMessages turns an audit event into the outbox message committed alongside the
+// services write it describes. A nil event yields no messages.
+func (r *ServicesRepository) auditMessages(ctx context.Context, auditEvent *domain.AuditEvent) ([]*dapr.OutboxMessage, error) {
+	if auditEvent == nil {
+		return nil, nil
+	}
+
+	auditEvent.SetTraceContext(domain.GetCorrelationID(ctx), domain.GetTraceID(ctx))
 	auditEvent.SetEnvironmentContext("development", "1.0.0")
-	auditEvent.SetDataSnapshot(beforeData, afterData)
 
 	// Convert domain.AuditEvent to dapr.AuditEvent
 	daprAuditEvent := &dapr.AuditEvent{
@@ -616,55 +646,86 @@ func (r *ServicesRepository) PublishAuditEvent(ctx context.Context, entityType d
 		}
 	}
 
-	err := r.pubsub.PublishAuditEvent(ctx, daprAuditEvent)
+	message, err := r.pubsub.AuditEventMessage(daprAuditEvent)
 	if err != nil {
-		return fmt.Errorf("failed to publish audit event for %s %s: %w", entityType, entityID, err)
+		return nil, fmt.Errorf("failed to prepare audit event for %s %s: %w", auditEvent.EntityType, auditEvent.EntityID, err)
 	}
 
-	return nil
+	return []*dapr.OutboxMessage{dapr.NewOutboxMessage(ctx, message.Topic, message)}, nil
+}
+
+// ListServiceRevisions returns the revision summaries of a service, newest first
+func (r *ServicesRepository) ListServiceRevisions(ctx context.Context, serviceID string) ([]*domain.Revision, error) {
+	revisions, err := r.stateStore.ListRevisions(ctx, "services", "service", serviceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list revisions for service %s: %w", serviceID, err)
+	}
+
+	return revisions, nil
+}
+
+// GetServiceRevision retrieves one revision of a service including its data snapshot
+func (r *ServicesRepository) GetServiceRevision(ctx context.Context, serviceID string, revision int) (*domain.Revision, error) {
+	return r.stateStore.GetRevision(ctx, "services", "service", serviceID, revision)
 }
 
-// SearchServices performs a simple text search across services metadata
+// PublishReviewEvent notifies reviewers and authors about a service's review
+func (r *ServicesRepository) PublishReviewEvent(ctx context.Context, event *domain.ReviewEvent) error {
+	return r.pubsub.PublishReviewEvent(ctx, event)
+}
+
+// SearchServices returns every non-deleted service matching the search term, best match first
 func (r *ServicesRepository) SearchServices(ctx context.Context, searchTerm string) ([]*Service, error) {
-	searchTerm = strings.ToLower(strings.TrimSpace(searchTerm))
-	if searchTerm == "" {
+	if strings.TrimSpace(searchTerm) == "" {
 		return r.GetAllServices(ctx)
 	}
 
-	// Note: This is a simplified search implementation
-	// In production, this would use a proper search index
-	allServices, err := r.GetAllServices(ctx)
+	results, err := r.QueryServices(ctx, search.Query{Text: searchTerm, Limit: r.searchIndex.Len()})
 	if err != nil {
 		return nil, err
 	}
 
-	var results []*Service
-	for _, service := range allServices {
-		if r.serviceMatchesSearch(service, searchTerm) {
-			results = append(results, service)
-		}
+	return servicesFromHits(results.Hits), nil
+}
+
+// QueryServices runs a full-text query against the services search index,
+// building the index from the state store on first use
+func (r *ServicesRepository) QueryServices(ctx context.Context, query search.Query) (*search.Results, error) {
+	if err := r.searchIndex.EnsureLoaded(ctx, r.loadServiceSearchDocuments); err != nil {
+		return nil, err
 	}
 
-	return results, nil
+	return r.searchIndex.Search(query), nil
 }
 
-// serviceMatchesSearch checks if service matches search term
-func (r *ServicesRepository) serviceMatchesSearch(service *Service, searchTerm string) bool {
-	searchFields := []string{
-		strings.ToLower(service.Title),
-		strings.ToLower(service.Description),
-		strings.ToLower(service.Slug),
-		strings.ToLower(string(service.DeliveryMode)),
-		strings.ToLower(string(service.PublishingStatus)),
+// ReindexService refreshes the search document of one service from the
+// state store, dropping it from the index once the service is deleted
+func (r *ServicesRepository) ReindexService(ctx context.Context, serviceID string) error {
+	if err := r.searchIndex.EnsureLoaded(ctx, r.loadServiceSearchDocuments); err != nil {
+		return err
 	}
 
-	for _, field := range searchFields {
-		if strings.Contains(field, searchTerm) {
-			return true
-		}
+	service, err := r.GetService(ctx, serviceID)
+	if domain.IsNotFoundError(err) {
+		r.searchIndex.Remove(serviceID)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	r.searchIndex.Upsert(serviceSearchDocument(service))
+	return nil
+}
+
+// loadServiceSearchDocuments loads every non-deleted service for indexing
+func (r *ServicesRepository) loadServiceSearchDocuments(ctx context.Context) ([]search.Document, error) {
+	services, err := r.GetAllServices(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	return false
+	return serviceSearchDocuments(services), nil
 }
 
 // Admin Audit Repository Methods
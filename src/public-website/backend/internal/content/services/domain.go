@@ -23,6 +23,8 @@ const (
 
 const (
 	PublishingStatusDraft     PublishingStatus = "draft"
+	PublishingStatusInReview  PublishingStatus = "in_review"
+	PublishingStatusApproved  PublishingStatus = "approved"
 	PublishingStatusPublished PublishingStatus = "published"
 	PublishingStatusArchived  PublishingStatus = "archived"
 )
@@ -40,7 +42,7 @@ func (d DeliveryMode) IsValid() bool {
 // IsValid checks if the publishing status is valid
 func (p PublishingStatus) IsValid() bool {
 	switch p {
-	case PublishingStatusDraft, PublishingStatusPublished, PublishingStatusArchived:
+	case PublishingStatusDraft, PublishingStatusInReview, PublishingStatusApproved, PublishingStatusPublished, PublishingStatusArchived:
 		return true
 	default:
 		return false
@@ -66,6 +68,8 @@ type Service struct {
 	IsDeleted        bool             `json:"is_deleted"`
 	DeletedOn        *time.Time       `json:"deleted_on,omitempty"`
 	DeletedBy        string           `json:"deleted_by,omitempty"`
+	Review           *domain.EditorialReview `json:"review,omitempty"`
+	ETag             string           `json:"-"` // State store version for optimistic concurrency
 }
 
 // ServiceCategory represents service categories matching TABLES-SERVICES.md
@@ -95,6 +99,17 @@ type FeaturedCategory struct {
 	ModifiedBy         string     `json:"modified_by,omitempty"`
 }
 
+// ServiceListFilter narrows a paged service listing; empty fields are not applied
+type ServiceListFilter struct {
+	CategoryID       string
+	PublishingStatus PublishingStatus
+}
+
+// PageCursor returns the position of the service in paged service listings
+func (s *Service) PageCursor() domain.PageCursor {
+	return domain.PageCursor{Timestamp: s.CreatedOn, ID: s.ServiceID}
+}
+
 // ServiceAuditEvent represents audit events for services domain
 type ServiceAuditEvent struct {
 	AuditID        string            `json:"audit_id"`
@@ -188,8 +203,8 @@ func NewFeaturedCategory(categoryID string, featurePosition int, userID string)
 // Domain business logic methods for Service
 
 func (s *Service) Publish(userID string) error {
-	if s.PublishingStatus != PublishingStatusDraft {
-		return errors.New("can only publish services with draft status")
+	if s.PublishingStatus != PublishingStatusDraft && s.PublishingStatus != PublishingStatusApproved {
+		return errors.New("can only publish services with draft or approved status")
 	}
 
 	s.PublishingStatus = PublishingStatusPublished
@@ -200,6 +215,11 @@ func (s *Service) Publish(userID string) error {
 	return nil
 }
 
+// ContentReview describes where the service stands in the editorial workflow
+func (s *Service) ContentReview() *domain.ContentReview {
+	return domain.NewContentReview(domain.EntityTypeService, s.ServiceID, s.Title, domain.WorkflowStatus(s.PublishingStatus), s.Review)
+}
+
 func (s *Service) Archive(userID string) error {
 	if s.PublishingStatus != PublishingStatusPublished {
 		return errors.New("can only archive published services")
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/search"
 	"github.com/gorilla/mux"
 )
 
@@ -28,6 +29,7 @@ func (h *ServicesHandler) RegisterRoutes(router *mux.Router) {
 	
 	// Service endpoints
 	router.HandleFunc("/api/v1/services", h.GetAllServices).Methods("GET")
+	router.HandleFunc("/api/v1/services/search", h.SearchServices).Methods("GET")
 	router.HandleFunc("/api/v1/services/{id}", h.GetService).Methods("GET")
 	router.HandleFunc("/api/v1/services/slug/{slug}", h.GetServiceBySlug).Methods("GET")
 	router.HandleFunc("/api/v1/services/{id}/content/download", h.GetServiceContentDownload).Methods("GET")
@@ -48,10 +50,15 @@ func (h *ServicesHandler) RegisterRoutes(router *mux.Router) {
 	// Admin endpoints - will be handled by admin gateway
 	// Service admin endpoints
 	router.HandleFunc("/admin/api/v1/services", h.CreateService).Methods("POST")
+	router.HandleFunc("/admin/api/v1/services/{id}", h.GetAdminService).Methods("GET")
 	router.HandleFunc("/admin/api/v1/services/{id}", h.UpdateService).Methods("PUT")
 	router.HandleFunc("/admin/api/v1/services/{id}", h.DeleteService).Methods("DELETE")
 	router.HandleFunc("/admin/api/v1/services/{id}/publish", h.PublishService).Methods("POST")
 	router.HandleFunc("/admin/api/v1/services/{id}/archive", h.ArchiveService).Methods("POST")
+	router.HandleFunc("/admin/api/v1/services/{id}/revisions", h.GetServiceRevisions).Methods("GET")
+	router.HandleFunc("/admin/api/v1/services/{id}/revisions/diff", h.DiffServiceRevisions).Methods("GET")
+	router.HandleFunc("/admin/api/v1/services/{id}/revisions/{revision:[0-9]+}", h.GetServiceRevision).Methods("GET")
+	router.HandleFunc("/admin/api/v1/services/{id}/revisions/{revision:[0-9]+}/rollback", h.RollbackService).Methods("POST")
 	
 	// Service category admin endpoints
 	router.HandleFunc("/admin/api/v1/services/categories", h.CreateServiceCategory).Methods("POST")
@@ -79,15 +86,28 @@ func (h *ServicesHandler) GetAllServices(w http.ResponseWriter, r *http.Request)
 	// Check for search parameter
 	searchTerm := r.URL.Query().Get("search")
 	
-	var services []*Service
-	var err error
-	
 	if searchTerm != "" {
-		services, err = h.service.SearchServices(ctx, searchTerm, userID)
-	} else {
-		services, err = h.service.GetAllServices(ctx, userID)
+		services, err := h.service.SearchServices(ctx, searchTerm, userID)
+		if err != nil {
+			h.handleError(w, r, err)
+			return
+		}
+
+		h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
+			"services":       services,
+			"count":          len(services),
+			"correlation_id": correlationCtx.CorrelationID,
+		})
+		return
 	}
-	
+
+	page, err := h.extractPageRequest(r)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	services, pageInfo, err := h.service.ListServicesPage(ctx, ServiceListFilter{}, page, userID)
 	if err != nil {
 		h.handleError(w, r, err)
 		return
@@ -96,6 +116,7 @@ func (h *ServicesHandler) GetAllServices(w http.ResponseWriter, r *http.Request)
 	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
 		"services":       services,
 		"count":          len(services),
+		"pagination":     pageInfo,
 		"correlation_id": correlationCtx.CorrelationID,
 	})
 }
@@ -204,6 +225,46 @@ func (h *ServicesHandler) GetPublishedServices(w http.ResponseWriter, r *http.Re
 	})
 }
 
+// SearchServices handles GET /api/v1/services/search
+func (h *ServicesHandler) SearchServices(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	searchTerm := r.URL.Query().Get("q")
+
+	// Extract user ID from context
+	userID := h.getUserIDFromContext(r)
+
+	// Add correlation context
+	correlationCtx := domain.FromContext(ctx)
+	correlationCtx.SetUserContext(userID, "services-api-1.0.0")
+	ctx = correlationCtx.ToContext(ctx)
+
+	query, err := search.ParseQuery(r.URL.Query(), servicePublicFacets)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	results, err := h.service.QueryPublishedServices(ctx, query, userID)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
+		"services":    servicesFromHits(results.Hits),
+		"hits":        results.Hits,
+		"facets":      results.Facets,
+		"count":       len(results.Hits),
+		"total":       results.Total,
+		"search_term": searchTerm,
+		"pagination": map[string]interface{}{
+			"limit":  results.Limit,
+			"offset": results.Offset,
+		},
+		"correlation_id": correlationCtx.CorrelationID,
+	})
+}
+
 // Service category endpoints
 
 // GetAllServiceCategories handles GET /api/v1/services/categories
@@ -297,7 +358,13 @@ func (h *ServicesHandler) GetServicesByCategory(w http.ResponseWriter, r *http.R
 	correlationCtx.SetUserContext(userID, "services-api-1.0.0")
 	ctx = correlationCtx.ToContext(ctx)
 
-	services, err := h.service.GetServicesByCategory(ctx, categoryID, userID)
+	page, err := h.extractPageRequest(r)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	services, pageInfo, err := h.service.ListServicesPage(ctx, ServiceListFilter{CategoryID: categoryID}, page, userID)
 	if err != nil {
 		h.handleError(w, r, err)
 		return
@@ -307,6 +374,7 @@ func (h *ServicesHandler) GetServicesByCategory(w http.ResponseWriter, r *http.R
 		"services":       services,
 		"category_id":    categoryID,
 		"count":          len(services),
+		"pagination":     pageInfo,
 		"correlation_id": correlationCtx.CorrelationID,
 	})
 }
@@ -436,6 +504,29 @@ func (h *ServicesHandler) handleError(w http.ResponseWriter, r *http.Request, er
 	h.writeJSONResponse(w, statusCode, errorResponse)
 }
 
+// handleUpdateError maps a conflicting concurrent edit to 412 Precondition Failed
+func (h *ServicesHandler) handleUpdateError(w http.ResponseWriter, r *http.Request, err error) {
+	if !domain.IsConflictError(err) {
+		h.handleError(w, r, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusPreconditionFailed, map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":           "PRECONDITION_FAILED",
+			"message":        err.Error(),
+			"correlation_id": domain.GetCorrelationID(r.Context()),
+		},
+	})
+}
+
+// setETagHeader returns the service version for use as If-Match on the next update
+func (h *ServicesHandler) setETagHeader(w http.ResponseWriter, etag string) {
+	if etag != "" {
+		w.Header().Set("ETag", domain.FormatETag(etag))
+	}
+}
+
 // writeJSONResponse writes a JSON response with proper headers
 func (h *ServicesHandler) writeJSONResponse(w http.ResponseWriter, statusCode int, data interface{}) {
 	w.Header().Set("Content-Type", "application/json")
@@ -606,6 +697,20 @@ func (h *ServicesHandler) extractPaginationParams(r *http.Request) (limit int, o
 	return limit, offset
 }
 
+// extractPageRequest extracts cursor pagination parameters from request
+func (h *ServicesHandler) extractPageRequest(r *http.Request) (domain.PageRequest, error) {
+	limit := 0
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		l, err := strconv.Atoi(limitStr)
+		if err != nil {
+			return domain.PageRequest{}, domain.NewValidationFieldError("limit", "limit must be a number")
+		}
+		limit = l
+	}
+
+	return domain.NewPageRequest(r.URL.Query().Get("cursor"), limit)
+}
+
 // handleServiceError handles service errors (alias for handleError for consistency)
 func (h *ServicesHandler) handleServiceError(w http.ResponseWriter, err error) {
 	h.handleError(w, &http.Request{}, err)
@@ -648,6 +753,36 @@ func (h *ServicesHandler) CreateService(w http.ResponseWriter, r *http.Request)
 	})
 }
 
+// GetAdminService handles GET /admin/api/v1/services/{id}
+func (h *ServicesHandler) GetAdminService(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Extract user ID from header
+	userID := r.Header.Get("X-User-ID")
+	if userID == "" {
+		h.writeErrorResponse(w, http.StatusUnauthorized, "User ID is required")
+		return
+	}
+
+	// Add correlation context
+	correlationCtx := domain.FromContext(ctx)
+	correlationCtx.SetUserContext(userID, "services-admin-api-1.0.0")
+	ctx = correlationCtx.ToContext(ctx)
+
+	vars := mux.Vars(r)
+	service, err := h.service.AdminGetService(ctx, vars["id"], userID)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	h.setETagHeader(w, service.ETag)
+	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
+		"service":        service,
+		"correlation_id": correlationCtx.CorrelationID,
+	})
+}
+
 // UpdateService handles PUT /admin/api/v1/services/{id}
 func (h *ServicesHandler) UpdateService(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -671,23 +806,129 @@ func (h *ServicesHandler) UpdateService(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	// Get service ID from URL parameters
+	// Get service ID from URL parameters and the version the editor read
 	vars := mux.Vars(r)
 	serviceID := vars["id"]
 	service.ServiceID = serviceID
+	service.ETag = domain.ParseIfMatch(r.Header.Get("If-Match"))
 
 	// Update service through service
 	if err := h.service.AdminUpdateService(ctx, &service, userID); err != nil {
-		h.handleError(w, r, err)
+		h.handleUpdateError(w, r, err)
 		return
 	}
 
+	h.setETagHeader(w, service.ETag)
 	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
 		"service": &service,
 		"message": "Service updated successfully",
 	})
 }
 
+// GetServiceRevisions handles GET /admin/api/v1/services/{id}/revisions
+func (h *ServicesHandler) GetServiceRevisions(w http.ResponseWriter, r *http.Request) {
+	serviceID := mux.Vars(r)["id"]
+	userID := r.Header.Get("X-User-ID")
+
+	revisions, err := h.service.AdminGetServiceRevisions(r.Context(), serviceID, userID)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
+		"revisions": revisions,
+		"count":     len(revisions),
+	})
+}
+
+// GetServiceRevision handles GET /admin/api/v1/services/{id}/revisions/{revision}
+func (h *ServicesHandler) GetServiceRevision(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	userID := r.Header.Get("X-User-ID")
+
+	number, err := domain.ParseRevisionNumber("revision", vars["revision"])
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	revision, err := h.service.AdminGetServiceRevision(r.Context(), vars["id"], number, userID)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
+		"revision": revision,
+	})
+}
+
+// DiffServiceRevisions handles GET /admin/api/v1/services/{id}/revisions/diff?from={revision}&to={revision}
+func (h *ServicesHandler) DiffServiceRevisions(w http.ResponseWriter, r *http.Request) {
+	serviceID := mux.Vars(r)["id"]
+	userID := r.Header.Get("X-User-ID")
+	query := r.URL.Query()
+
+	from, err := domain.ParseRevisionNumber("from", query.Get("from"))
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+	to, err := domain.ParseRevisionNumber("to", query.Get("to"))
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	diff, err := h.service.AdminDiffServiceRevisions(r.Context(), serviceID, from, to, userID)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
+		"diff": diff,
+	})
+}
+
+// RollbackService handles POST /admin/api/v1/services/{id}/revisions/{revision}/rollback
+func (h *ServicesHandler) RollbackService(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	// Extract user ID from header
+	userID := r.Header.Get("X-User-ID")
+	if userID == "" {
+		h.writeErrorResponse(w, http.StatusUnauthorized, "User ID is required")
+		return
+	}
+
+	number, err := domain.ParseRevisionNumber("revision", vars["revision"])
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	// Add correlation context
+	correlationCtx := domain.FromContext(ctx)
+	correlationCtx.SetUserContext(userID, "services-admin-api-1.0.0")
+	ctx = correlationCtx.ToContext(ctx)
+
+	service, err := h.service.AdminRollbackService(ctx, vars["id"], number, domain.ParseIfMatch(r.Header.Get("If-Match")), userID)
+	if err != nil {
+		h.handleUpdateError(w, r, err)
+		return
+	}
+
+	h.setETagHeader(w, service.ETag)
+	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
+		"service":       service,
+		"restored_from": number,
+		"message":       "Service rolled back successfully",
+	})
+}
+
 // DeleteService handles DELETE /admin/api/v1/services/{id}
 func (h *ServicesHandler) DeleteService(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
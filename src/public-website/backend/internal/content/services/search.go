@@ -0,0 +1,61 @@
+package services
+
+import (
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/search"
+)
+
+// Facets maintained in the services search index
+const (
+	serviceFacetCategory     = "category"
+	serviceFacetDeliveryMode = "delivery_mode"
+	serviceFacetStatus       = "status"
+)
+
+// servicePublicFacets are the facets counted and filterable on public services search
+var servicePublicFacets = []string{serviceFacetCategory, serviceFacetDeliveryMode}
+
+// newServiceSearchIndex creates the full-text index for services. Title
+// matches weigh most, then the description.
+func newServiceSearchIndex() *search.Index {
+	return search.NewIndex("services",
+		search.Field{Name: "title", Boost: 3},
+		search.Field{Name: "description", Boost: 2},
+	)
+}
+
+// serviceSearchDocument converts a service into its search document
+func serviceSearchDocument(service *Service) search.Document {
+	return search.Document{
+		ID: service.ServiceID,
+		Fields: map[string]string{
+			"title":       service.Title,
+			"description": service.Description,
+		},
+		Facets: map[string][]string{
+			serviceFacetCategory:     {service.CategoryID},
+			serviceFacetDeliveryMode: {string(service.DeliveryMode)},
+			serviceFacetStatus:       {string(service.PublishingStatus)},
+		},
+		Source: service,
+	}
+}
+
+// serviceSearchDocuments converts services into search documents
+func serviceSearchDocuments(services []*Service) []search.Document {
+	documents := make([]search.Document, 0, len(services))
+	for _, service := range services {
+		documents = append(documents, serviceSearchDocument(service))
+	}
+	return documents
+}
+
+// servicesFromHits returns the services behind search hits, in rank order
+func servicesFromHits(hits []search.Hit) []*Service {
+	services := make([]*Service, 0, len(hits))
+	for _, hit := range hits {
+		if service, ok := hit.Source.(*Service); ok {
+			services = append(services, service)
+		}
+	}
+	return services
+}
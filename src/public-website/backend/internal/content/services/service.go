@@ -8,35 +8,39 @@ import (
 	"time"
 
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/search"
 	"github.com/google/uuid"
 )
 
 // ServicesRepositoryInterface defines the contract for services data access
 type ServicesRepositoryInterface interface {
 	// Service operations
-	SaveService(ctx context.Context, service *Service) error
+	SaveService(ctx context.Context, service *Service, audit *domain.AuditEvent) error
 	GetService(ctx context.Context, serviceID string) (*Service, error)
 	GetServiceBySlug(ctx context.Context, slug string) (*Service, error)
 	GetAllServices(ctx context.Context) ([]*Service, error)
 	GetServicesByCategory(ctx context.Context, categoryID string) ([]*Service, error)
 	GetServicesByPublishingStatus(ctx context.Context, status PublishingStatus) ([]*Service, error)
-	DeleteService(ctx context.Context, serviceID string, userID string) error
+	ListServicesPage(ctx context.Context, filter ServiceListFilter, page domain.PageRequest) ([]*Service, domain.PageInfo, error)
+	DeleteService(ctx context.Context, serviceID string, userID string, audit *domain.AuditEvent) error
 	SearchServices(ctx context.Context, searchTerm string) ([]*Service, error)
+	QueryServices(ctx context.Context, query search.Query) (*search.Results, error)
+	ReindexService(ctx context.Context, serviceID string) error
 
 	// Service category operations
-	SaveServiceCategory(ctx context.Context, category *ServiceCategory) error
+	SaveServiceCategory(ctx context.Context, category *ServiceCategory, audit *domain.AuditEvent) error
 	GetServiceCategory(ctx context.Context, categoryID string) (*ServiceCategory, error)
 	GetServiceCategoryBySlug(ctx context.Context, slug string) (*ServiceCategory, error)
 	GetAllServiceCategories(ctx context.Context) ([]*ServiceCategory, error)
 	GetDefaultUnassignedCategory(ctx context.Context) (*ServiceCategory, error)
-	DeleteServiceCategory(ctx context.Context, categoryID string, userID string) error
+	DeleteServiceCategory(ctx context.Context, categoryID string, userID string, audit *domain.AuditEvent) error
 
 	// Featured category operations
-	SaveFeaturedCategory(ctx context.Context, featured *FeaturedCategory) error
+	SaveFeaturedCategory(ctx context.Context, featured *FeaturedCategory, audit *domain.AuditEvent) error
 	GetFeaturedCategory(ctx context.Context, featuredCategoryID string) (*FeaturedCategory, error)
 	GetAllFeaturedCategories(ctx context.Context) ([]*FeaturedCategory, error)
 	GetFeaturedCategoryByPosition(ctx context.Context, position int) (*FeaturedCategory, error)
-	DeleteFeaturedCategory(ctx context.Context, featuredCategoryID string) error
+	DeleteFeaturedCategory(ctx context.Context, featuredCategoryID string, audit *domain.AuditEvent) error
 
 	// Blob storage operations
 	UploadServiceContentBlob(ctx context.Context, storagePath string, data []byte, contentType string) error
@@ -44,24 +48,38 @@ type ServicesRepositoryInterface interface {
 	CreateServiceContentBlobURL(ctx context.Context, storagePath string, expiryMinutes int) (string, error)
 
 	// Audit operations
-	PublishAuditEvent(ctx context.Context, entityType domain.EntityType, entityID string, operationType domain.AuditEventType, userID string, beforeData, afterData interface{}) error
 	GetServiceAudit(ctx context.Context, serviceID string, limit int, offset int) ([]*ServiceAuditEvent, error)
 	GetServiceCategoryAudit(ctx context.Context, categoryID string, limit int, offset int) ([]*ServiceAuditEvent, error)
 	GetAdminFeaturedCategories(ctx context.Context) ([]*FeaturedCategory, error)
+
+	// Revision operations
+	ListServiceRevisions(ctx context.Context, serviceID string) ([]*domain.Revision, error)
+	GetServiceRevision(ctx context.Context, serviceID string, revision int) (*domain.Revision, error)
+
+	// Editorial review operations
+	PublishReviewEvent(ctx context.Context, event *domain.ReviewEvent) error
 }
 
 // ServicesService implements business logic for services operations
 type ServicesService struct {
 	repository ServicesRepositoryInterface
+	workflow   *domain.EditorialWorkflow
 }
 
-// NewServicesService creates a new services service
+// NewServicesService creates a new services service that publishes directly until
+// an editorial workflow is configured
 func NewServicesService(repository ServicesRepositoryInterface) *ServicesService {
 	return &ServicesService{
 		repository: repository,
+		workflow:   domain.DirectPublishWorkflow(),
 	}
 }
 
+// UseEditorialWorkflow makes publishing wait for the reviews the workflow requires
+func (s *ServicesService) UseEditorialWorkflow(workflow *domain.EditorialWorkflow) {
+	s.workflow = workflow
+}
+
 // Service operations
 
 // GetService retrieves service by ID
@@ -120,6 +138,40 @@ func (s *ServicesService) GetAllServices(ctx context.Context, userID string) ([]
 	return accessibleServices, nil
 }
 
+// ListServicesPage retrieves one cursor-delimited page of services matching the filter.
+// Anonymous callers only page through published services.
+func (s *ServicesService) ListServicesPage(ctx context.Context, filter ServiceListFilter, page domain.PageRequest, userID string) ([]*Service, domain.PageInfo, error) {
+	if filter.PublishingStatus != "" && !filter.PublishingStatus.IsValid() {
+		return nil, domain.PageInfo{}, domain.NewValidationFieldError("status", "invalid publishing status")
+	}
+	if userID == "" {
+		filter.PublishingStatus = PublishingStatusPublished
+	}
+
+	// Verify category exists
+	if filter.CategoryID != "" {
+		if _, err := s.repository.GetServiceCategory(ctx, filter.CategoryID); err != nil {
+			return nil, domain.PageInfo{}, err
+		}
+	}
+
+	services, info, err := s.repository.ListServicesPage(ctx, filter, page)
+	if err != nil {
+		return nil, domain.PageInfo{}, err
+	}
+
+	// Filter based on access permissions; the cursor still advances past hidden services
+	accessibleServices := make([]*Service, 0, len(services))
+	for _, service := range services {
+		if s.checkServiceAccess(service, userID) == nil {
+			accessibleServices = append(accessibleServices, service)
+		}
+	}
+	info.Count = len(accessibleServices)
+
+	return accessibleServices, info, nil
+}
+
 // GetServicesByCategory retrieves services by category
 func (s *ServicesService) GetServicesByCategory(ctx context.Context, categoryID string, userID string) ([]*Service, error) {
 	if categoryID == "" {
@@ -184,17 +236,12 @@ func (s *ServicesService) CreateService(ctx context.Context, title, description,
 	}
 
 	// Save service metadata to state store
-	err = s.repository.SaveService(ctx, service)
+	audit := domain.NewAuditChange(domain.EntityTypeService, service.ServiceID, domain.AuditEventInsert, userID, nil, service)
+	err = s.repository.SaveService(ctx, service, audit)
 	if err != nil {
 		return nil, domain.NewInternalError("failed to save service metadata", err)
 	}
 
-	// Publish audit event
-	err = s.repository.PublishAuditEvent(ctx, domain.EntityTypeService, service.ServiceID, domain.AuditEventInsert, userID, nil, service)
-	if err != nil {
-		// Log error but don't fail the operation
-	}
-
 	return service, nil
 }
 
@@ -214,22 +261,27 @@ func (s *ServicesService) UpdateServiceDetails(ctx context.Context, serviceID st
 	// Store original data for audit
 	originalService := *service
 
+	originalService.Review = service.Review.Clone()
+
 	// Update details
 	err = service.UpdateDetails(title, description, userID)
 	if err != nil {
 		return nil, domain.NewInternalError("failed to update service details", err)
 	}
-
-	// Save updated service
-	err = s.repository.SaveService(ctx, service)
-	if err != nil {
-		return nil, domain.NewInternalError("failed to save updated service", err)
+	if service.Review == nil {
+		service.Review = &domain.EditorialReview{}
 	}
+	service.PublishingStatus = PublishingStatus(s.workflow.StatusAfterEdit(
+		domain.WorkflowStatus(originalService.PublishingStatus), domain.WorkflowStatus(service.PublishingStatus), service.Review, userID))
 
-	// Publish audit event
-	err = s.repository.PublishAuditEvent(ctx, domain.EntityTypeService, service.ServiceID, domain.AuditEventUpdate, userID, &originalService, service)
+	// Save updated service; a concurrent edit is reported as is, not as an internal failure
+	audit := domain.NewAuditChange(domain.EntityTypeService, service.ServiceID, domain.AuditEventUpdate, userID, &originalService, service)
+	err = s.repository.SaveService(ctx, service, audit)
 	if err != nil {
-		// Log error but don't fail the operation
+		if domain.IsConflictError(err) {
+			return nil, err
+		}
+		return nil, domain.NewInternalError("failed to save updated service", err)
 	}
 
 	return service, nil
@@ -258,17 +310,12 @@ func (s *ServicesService) SetServiceContentURL(ctx context.Context, serviceID st
 	}
 
 	// Save updated service
-	err = s.repository.SaveService(ctx, service)
+	audit := domain.NewAuditChange(domain.EntityTypeService, service.ServiceID, domain.AuditEventUpdate, userID, &originalService, service)
+	err = s.repository.SaveService(ctx, service, audit)
 	if err != nil {
 		return nil, domain.NewInternalError("failed to save updated service", err)
 	}
 
-	// Publish audit event
-	err = s.repository.PublishAuditEvent(ctx, domain.EntityTypeService, service.ServiceID, domain.AuditEventUpdate, userID, &originalService, service)
-	if err != nil {
-		// Log error but don't fail the operation
-	}
-
 	return service, nil
 }
 
@@ -285,6 +332,10 @@ func (s *ServicesService) PublishService(ctx context.Context, serviceID string,
 		return nil, domain.NewForbiddenError("insufficient permissions to publish service")
 	}
 
+	if err := s.workflow.CanPublish(domain.WorkflowStatus(service.PublishingStatus)); err != nil {
+		return nil, err
+	}
+
 	// Store original data for audit
 	originalService := *service
 
@@ -295,17 +346,12 @@ func (s *ServicesService) PublishService(ctx context.Context, serviceID string,
 	}
 
 	// Save updated service
-	err = s.repository.SaveService(ctx, service)
+	audit := domain.NewAuditChange(domain.EntityTypeService, service.ServiceID, domain.AuditEventUpdate, userID, &originalService, service)
+	err = s.repository.SaveService(ctx, service, audit)
 	if err != nil {
 		return nil, domain.NewInternalError("failed to save published service", err)
 	}
 
-	// Publish audit event
-	err = s.repository.PublishAuditEvent(ctx, domain.EntityTypeService, service.ServiceID, domain.AuditEventUpdate, userID, &originalService, service)
-	if err != nil {
-		// Log error but don't fail the operation
-	}
-
 	return service, nil
 }
 
@@ -326,17 +372,12 @@ func (s *ServicesService) DeleteService(ctx context.Context, serviceID string, u
 	originalService := *service
 
 	// Delete service
-	err = s.repository.DeleteService(ctx, serviceID, userID)
+	audit := domain.NewAuditChange(domain.EntityTypeService, service.ServiceID, domain.AuditEventDelete, userID, &originalService, nil)
+	err = s.repository.DeleteService(ctx, serviceID, userID, audit)
 	if err != nil {
 		return domain.NewInternalError("failed to delete service", err)
 	}
 
-	// Publish audit event
-	err = s.repository.PublishAuditEvent(ctx, domain.EntityTypeService, service.ServiceID, domain.AuditEventDelete, userID, &originalService, nil)
-	if err != nil {
-		// Log error but don't fail the operation
-	}
-
 	return nil
 }
 
@@ -362,6 +403,34 @@ func (s *ServicesService) SearchServices(ctx context.Context, searchTerm string,
 	return accessibleResults, nil
 }
 
+// QueryPublishedServices runs a ranked full-text search over published services,
+// returning highlighted hits and facet counts
+func (s *ServicesService) QueryPublishedServices(ctx context.Context, query search.Query, userID string) (*search.Results, error) {
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	results, err := s.repository.QueryServices(ctx, query.WithFilter(serviceFacetStatus, string(PublishingStatusPublished)))
+	if err != nil {
+		return nil, domain.WrapError(err, "failed to query services search index")
+	}
+
+	return results, nil
+}
+
+// ReindexService refreshes the search index entry of a service after it changed
+func (s *ServicesService) ReindexService(ctx context.Context, serviceID string) error {
+	if serviceID == "" {
+		return domain.NewValidationError("service ID cannot be empty")
+	}
+
+	if err := s.repository.ReindexService(ctx, serviceID); err != nil {
+		return domain.WrapError(err, "failed to reindex service")
+	}
+
+	return nil
+}
+
 // ServiceCategory operations
 
 // GetServiceCategory retrieves service category by ID
@@ -526,7 +595,7 @@ func (s *ServicesService) checkServiceAccess(service *Service, userID string) er
 	switch service.PublishingStatus {
 	case PublishingStatusPublished:
 		return nil // Anyone can access published services
-	case PublishingStatusDraft, PublishingStatusArchived:
+	case PublishingStatusDraft, PublishingStatusInReview, PublishingStatusApproved, PublishingStatusArchived:
 		if userID == "" {
 			return domain.NewUnauthorizedError("authentication required for draft/archived services")
 		}
@@ -652,12 +721,22 @@ func (s *ServicesService) AdminCreateService(ctx context.Context, service *Servi
 	service.CreatedBy = userID
 
 	// Save service
-	if err := s.repository.SaveService(ctx, service); err != nil {
-		return err
+	audit := domain.NewAuditChange(domain.EntityTypeService, service.ServiceID, domain.AuditEventInsert, userID, nil, service)
+	return s.repository.SaveService(ctx, service, audit)
+}
+
+// AdminGetService retrieves a service in any publishing state for editing (admin only)
+func (s *ServicesService) AdminGetService(ctx context.Context, serviceID string, userID string) (*Service, error) {
+	// Validate admin authentication
+	if userID == "" {
+		return nil, domain.NewUnauthorizedError("admin authentication required")
 	}
 
-	// Publish audit event
-	return s.repository.PublishAuditEvent(ctx, domain.EntityTypeService, service.ServiceID, domain.AuditEventInsert, userID, nil, service)
+	if serviceID == "" {
+		return nil, domain.NewValidationError("service ID cannot be empty")
+	}
+
+	return s.repository.GetService(ctx, serviceID)
 }
 
 // AdminUpdateService updates an existing service (admin only)
@@ -673,18 +752,124 @@ func (s *ServicesService) AdminUpdateService(ctx context.Context, service *Servi
 		return err
 	}
 
+	// The service carries the ETag the editor read; save against the current version
+	if err := domain.CheckETag("service", service.ServiceID, service.ETag, existing.ETag); err != nil {
+		return err
+	}
+	service.ETag = existing.ETag
+
+	// Review state only changes through the editorial workflow
+	service.Review = existing.Review.Clone()
+	if service.Review == nil {
+		service.Review = &domain.EditorialReview{}
+	}
+	service.PublishingStatus = PublishingStatus(s.workflow.StatusAfterEdit(
+		domain.WorkflowStatus(existing.PublishingStatus), domain.WorkflowStatus(service.PublishingStatus), service.Review, userID))
+
 	// Set modification fields
 	service.ModifiedBy = userID
 	now := time.Now().UTC()
 	service.ModifiedOn = &now
 
 	// Save updated service
-	if err := s.repository.SaveService(ctx, service); err != nil {
-		return err
+	audit := domain.NewAuditChange(domain.EntityTypeService, service.ServiceID, domain.AuditEventUpdate, userID, existing, service)
+	return s.repository.SaveService(ctx, service, audit)
+}
+
+// AdminGetServiceRevisions lists the revision history of a service, newest first (admin only)
+func (s *ServicesService) AdminGetServiceRevisions(ctx context.Context, serviceID string, userID string) ([]*domain.Revision, error) {
+	// Validate admin authentication
+	if userID == "" {
+		return nil, domain.NewUnauthorizedError("admin authentication required")
+	}
+
+	if serviceID == "" {
+		return nil, domain.NewValidationError("service ID cannot be empty")
+	}
+
+	return s.repository.ListServiceRevisions(ctx, serviceID)
+}
+
+// AdminGetServiceRevision retrieves one revision of a service with its snapshot (admin only)
+func (s *ServicesService) AdminGetServiceRevision(ctx context.Context, serviceID string, revision int, userID string) (*domain.Revision, error) {
+	// Validate admin authentication
+	if userID == "" {
+		return nil, domain.NewUnauthorizedError("admin authentication required")
+	}
+
+	if serviceID == "" {
+		return nil, domain.NewValidationError("service ID cannot be empty")
+	}
+
+	return s.repository.GetServiceRevision(ctx, serviceID, revision)
+}
+
+// AdminDiffServiceRevisions compares two revisions of a service field by field (admin only)
+func (s *ServicesService) AdminDiffServiceRevisions(ctx context.Context, serviceID string, fromRevision, toRevision int, userID string) (*domain.RevisionDiff, error) {
+	from, err := s.AdminGetServiceRevision(ctx, serviceID, fromRevision, userID)
+	if err != nil {
+		return nil, err
 	}
 
-	// Publish audit event
-	return s.repository.PublishAuditEvent(ctx, domain.EntityTypeService, service.ServiceID, domain.AuditEventUpdate, userID, existing, service)
+	to, err := s.AdminGetServiceRevision(ctx, serviceID, toRevision, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.DiffRevisions(from, to)
+}
+
+// AdminRollbackService makes the content of an earlier revision the current version of
+// a service (admin only). The rollback is saved and audited like any other update, so it
+// becomes the newest revision; etag guards it like the If-Match of an update.
+func (s *ServicesService) AdminRollbackService(ctx context.Context, serviceID string, revision int, etag string, userID string) (*Service, error) {
+	target, err := s.AdminGetServiceRevision(ctx, serviceID, revision, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repository.GetService(ctx, serviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := domain.CheckETag("service", serviceID, etag, existing.ETag); err != nil {
+		return nil, err
+	}
+
+	var restored Service
+	if err := target.DecodeContent(&restored); err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(restored.Title) == "" {
+		return nil, domain.NewValidationError("title cannot be empty")
+	}
+
+	// Keep the identity and creation details of the live service
+	restored.ServiceID = existing.ServiceID
+	restored.CreatedOn = existing.CreatedOn
+	restored.CreatedBy = existing.CreatedBy
+	restored.IsDeleted = false
+	restored.DeletedOn = nil
+	restored.DeletedBy = ""
+	restored.ModifiedBy = userID
+	now := time.Now().UTC()
+	restored.ModifiedOn = &now
+	restored.ETag = existing.ETag
+	restored.Review = existing.Review.Clone()
+	if restored.Review == nil {
+		restored.Review = &domain.EditorialReview{}
+	}
+	restored.PublishingStatus = PublishingStatus(s.workflow.StatusAfterEdit(
+		domain.WorkflowStatus(existing.PublishingStatus), domain.WorkflowStatus(restored.PublishingStatus), restored.Review, userID))
+
+	audit := domain.NewAuditChange(domain.EntityTypeService, serviceID, domain.AuditEventRollback, userID, existing, &restored)
+	if err := s.repository.SaveService(ctx, &restored, audit); err != nil {
+		return nil, err
+	}
+
+	return &restored, nil
 }
 
 // AdminDeleteService soft deletes a service (admin only)
@@ -705,12 +890,8 @@ func (s *ServicesService) AdminDeleteService(ctx context.Context, serviceID stri
 	}
 
 	// Perform soft delete
-	if err := s.repository.DeleteService(ctx, serviceID, userID); err != nil {
-		return err
-	}
-
-	// Publish audit event
-	return s.repository.PublishAuditEvent(ctx, domain.EntityTypeService, serviceID, domain.AuditEventDelete, userID, existing, nil)
+	audit := domain.NewAuditChange(domain.EntityTypeService, serviceID, domain.AuditEventDelete, userID, existing, nil)
+	return s.repository.DeleteService(ctx, serviceID, userID, audit)
 }
 
 // AdminPublishService publishes a draft service (admin only)
@@ -742,6 +923,9 @@ func (s *ServicesService) AdminPublishService(ctx context.Context, serviceID str
 	if strings.TrimSpace(existing.Description) == "" {
 		return domain.NewValidationError("cannot publish service without description")
 	}
+	if err := s.workflow.CanPublish(domain.WorkflowStatus(existing.PublishingStatus)); err != nil {
+		return err
+	}
 
 	// Update publishing status
 	service := *existing
@@ -750,12 +934,120 @@ func (s *ServicesService) AdminPublishService(ctx context.Context, serviceID str
 	now := time.Now().UTC()
 	service.ModifiedOn = &now
 
-	if err := s.repository.SaveService(ctx, &service); err != nil {
-		return err
+	audit := domain.NewAuditChange(domain.EntityTypeService, serviceID, domain.AuditEventPublish, userID, existing, &service)
+	return s.repository.SaveService(ctx, &service, audit)
+}
+
+// Editorial review operations
+
+// AdminGetServiceReview returns the review state of a service (admin only)
+func (s *ServicesService) AdminGetServiceReview(ctx context.Context, serviceID string, userID string) (*domain.ContentReview, error) {
+	service, err := s.AdminGetService(ctx, serviceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.ContentReview(), nil
+}
+
+// AdminSubmitServiceForReview sends a draft service to its reviewers (admin only)
+func (s *ServicesService) AdminSubmitServiceForReview(ctx context.Context, serviceID string, reviewerIDs []string, userID string) (*domain.ContentReview, error) {
+	return s.applyReview(ctx, serviceID, userID, func(service *Service, now time.Time) (*domain.ReviewEvent, error) {
+		status, err := service.Review.Submit(domain.WorkflowStatus(service.PublishingStatus), userID, []string{service.CreatedBy, service.ModifiedBy}, reviewerIDs, now)
+		if err != nil {
+			return nil, err
+		}
+		service.PublishingStatus = PublishingStatus(status)
+
+		return domain.NewReviewEvent(domain.ReviewEventRequested, service.ContentReview(), userID, service.Review.Reviewers, ""), nil
+	})
+}
+
+// AdminAssignServiceReviewers adds reviewers to a service under review (admin only)
+func (s *ServicesService) AdminAssignServiceReviewers(ctx context.Context, serviceID string, reviewerIDs []string, userID string) (*domain.ContentReview, error) {
+	return s.applyReview(ctx, serviceID, userID, func(service *Service, now time.Time) (*domain.ReviewEvent, error) {
+		added, err := service.Review.AssignReviewers(domain.WorkflowStatus(service.PublishingStatus), reviewerIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		return domain.NewReviewEvent(domain.ReviewEventRequested, service.ContentReview(), userID, added, ""), nil
+	})
+}
+
+// AdminCommentOnServiceReview adds a review comment to a service (admin only)
+func (s *ServicesService) AdminCommentOnServiceReview(ctx context.Context, serviceID string, body string, userID string) (*domain.ContentReview, error) {
+	return s.applyReview(ctx, serviceID, userID, func(service *Service, now time.Time) (*domain.ReviewEvent, error) {
+		comment, err := service.Review.AddComment(domain.WorkflowStatus(service.PublishingStatus), userID, body, now)
+		if err != nil {
+			return nil, err
+		}
+
+		return domain.NewReviewEvent(domain.ReviewEventCommented, service.ContentReview(), userID, service.Review.Participants(userID), comment.Body), nil
+	})
+}
+
+// AdminApproveService records a reviewer's approval of a service (admin only).
+// Authors of the service cannot approve it.
+func (s *ServicesService) AdminApproveService(ctx context.Context, serviceID string, userID string) (*domain.ContentReview, error) {
+	return s.applyReview(ctx, serviceID, userID, func(service *Service, now time.Time) (*domain.ReviewEvent, error) {
+		status, err := service.Review.Approve(domain.WorkflowStatus(service.PublishingStatus), userID, s.workflow.RequiredApprovals, now)
+		if err != nil {
+			return nil, err
+		}
+		service.PublishingStatus = PublishingStatus(status)
+
+		if status != domain.WorkflowStatusApproved {
+			return nil, nil
+		}
+		return domain.NewReviewEvent(domain.ReviewEventApproved, service.ContentReview(), userID, service.Review.Authors, ""), nil
+	})
+}
+
+// AdminRejectService returns a service to its authors as a draft (admin only)
+func (s *ServicesService) AdminRejectService(ctx context.Context, serviceID string, reason string, userID string) (*domain.ContentReview, error) {
+	return s.applyReview(ctx, serviceID, userID, func(service *Service, now time.Time) (*domain.ReviewEvent, error) {
+		status, err := service.Review.Reject(domain.WorkflowStatus(service.PublishingStatus), userID, reason, now)
+		if err != nil {
+			return nil, err
+		}
+		service.PublishingStatus = PublishingStatus(status)
+
+		return domain.NewReviewEvent(domain.ReviewEventRejected, service.ContentReview(), userID, service.Review.Authors, service.Review.RejectionReason), nil
+	})
+}
+
+// applyReview loads a service, applies one review step, saves it against the version
+// it was loaded at and notifies the people the step concerns
+func (s *ServicesService) applyReview(ctx context.Context, serviceID string, userID string, step func(service *Service, now time.Time) (*domain.ReviewEvent, error)) (*domain.ContentReview, error) {
+	existing, err := s.AdminGetService(ctx, serviceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	service := *existing
+	service.Review = existing.Review.Clone()
+	if service.Review == nil {
+		service.Review = &domain.EditorialReview{}
 	}
 
-	// Publish audit event
-	return s.repository.PublishAuditEvent(ctx, domain.EntityTypeService, serviceID, domain.AuditEventPublish, userID, existing, &service)
+	event, err := step(&service, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	audit := domain.NewAuditChange(domain.EntityTypeService, serviceID, domain.AuditEventUpdate, userID, existing, &service)
+	if err := s.repository.SaveService(ctx, &service, audit); err != nil {
+		return nil, err
+	}
+
+	if event != nil {
+		if err := s.repository.PublishReviewEvent(ctx, event); err != nil {
+			return nil, err
+		}
+	}
+
+	return service.ContentReview(), nil
 }
 
 // AdminArchiveService archives a published service (admin only)
@@ -782,12 +1074,8 @@ func (s *ServicesService) AdminArchiveService(ctx context.Context, serviceID str
 	now := time.Now().UTC()
 	service.ModifiedOn = &now
 
-	if err := s.repository.SaveService(ctx, &service); err != nil {
-		return err
-	}
-
-	// Publish audit event
-	return s.repository.PublishAuditEvent(ctx, domain.EntityTypeService, serviceID, domain.AuditEventArchive, userID, existing, &service)
+	audit := domain.NewAuditChange(domain.EntityTypeService, serviceID, domain.AuditEventArchive, userID, existing, &service)
+	return s.repository.SaveService(ctx, &service, audit)
 }
 
 // AdminCreateServiceCategory creates a new service category (admin only)
@@ -812,12 +1100,8 @@ func (s *ServicesService) AdminCreateServiceCategory(ctx context.Context, catego
 	category.CreatedBy = userID
 
 	// Save category
-	if err := s.repository.SaveServiceCategory(ctx, category); err != nil {
-		return err
-	}
-
-	// Publish audit event
-	return s.repository.PublishAuditEvent(ctx, domain.EntityTypeServiceCategory, category.CategoryID, domain.AuditEventInsert, userID, nil, category)
+	audit := domain.NewAuditChange(domain.EntityTypeServiceCategory, category.CategoryID, domain.AuditEventInsert, userID, nil, category)
+	return s.repository.SaveServiceCategory(ctx, category, audit)
 }
 
 // AdminUpdateServiceCategory updates an existing service category (admin only)
@@ -839,12 +1123,8 @@ func (s *ServicesService) AdminUpdateServiceCategory(ctx context.Context, catego
 	category.ModifiedOn = &now
 
 	// Save updated category
-	if err := s.repository.SaveServiceCategory(ctx, category); err != nil {
-		return err
-	}
-
-	// Publish audit event
-	return s.repository.PublishAuditEvent(ctx, domain.EntityTypeServiceCategory, category.CategoryID, domain.AuditEventUpdate, userID, existing, category)
+	audit := domain.NewAuditChange(domain.EntityTypeServiceCategory, category.CategoryID, domain.AuditEventUpdate, userID, existing, category)
+	return s.repository.SaveServiceCategory(ctx, category, audit)
 }
 
 // AdminDeleteServiceCategory soft deletes a service category (admin only)
@@ -870,12 +1150,8 @@ func (s *ServicesService) AdminDeleteServiceCategory(ctx context.Context, catego
 	}
 
 	// Perform soft delete
-	if err := s.repository.DeleteServiceCategory(ctx, categoryID, userID); err != nil {
-		return err
-	}
-
-	// Publish audit event
-	return s.repository.PublishAuditEvent(ctx, domain.EntityTypeServiceCategory, categoryID, domain.AuditEventDelete, userID, existing, nil)
+	audit := domain.NewAuditChange(domain.EntityTypeServiceCategory, categoryID, domain.AuditEventDelete, userID, existing, nil)
+	return s.repository.DeleteServiceCategory(ctx, categoryID, userID, audit)
 }
 
 // AdminSetFeaturedCategories sets the featured categories list (admin only)
@@ -909,7 +1185,7 @@ func (s *ServicesService) AdminSetFeaturedCategories(ctx context.Context, catego
 
 	// Remove all existing featured categories
 	for _, featured := range existingFeatured {
-		if err := s.repository.DeleteFeaturedCategory(ctx, featured.FeaturedCategoryID); err != nil {
+		if err := s.repository.DeleteFeaturedCategory(ctx, featured.FeaturedCategoryID, nil); err != nil {
 			return err
 		}
 	}
@@ -924,12 +1200,8 @@ func (s *ServicesService) AdminSetFeaturedCategories(ctx context.Context, catego
 			CreatedBy:          userID,
 		}
 
-		if err := s.repository.SaveFeaturedCategory(ctx, featured); err != nil {
-			return err
-		}
-
-		// Publish audit event for each featured category
-		if err := s.repository.PublishAuditEvent(ctx, domain.EntityTypeFeaturedCategory, featured.FeaturedCategoryID, domain.AuditEventInsert, userID, nil, featured); err != nil {
+		audit := domain.NewAuditChange(domain.EntityTypeFeaturedCategory, featured.FeaturedCategoryID, domain.AuditEventInsert, userID, nil, featured)
+		if err := s.repository.SaveFeaturedCategory(ctx, featured, audit); err != nil {
 			return err
 		}
 	}
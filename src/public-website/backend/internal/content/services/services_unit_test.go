@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/search"
 	sharedtesting "github.com/axiom-software-co/international-center/src/backend/internal/shared/testing"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -19,8 +20,12 @@ type MockServicesRepository struct {
 	categories       map[string]*ServiceCategory
 	featuredCategories map[string]*FeaturedCategory
 	auditEvents      []MockAuditEvent
+	reindexed        []string
 	failures         map[string]error
 	blobs            map[string][]byte
+	revisions        map[string][]*domain.Revision
+	reviewEvents     []*domain.ReviewEvent
+	saves            int
 }
 
 type MockAuditEvent struct {
@@ -40,6 +45,7 @@ func NewMockServicesRepository() *MockServicesRepository {
 		auditEvents:      make([]MockAuditEvent, 0),
 		failures:         make(map[string]error),
 		blobs:            make(map[string][]byte),
+		revisions:        make(map[string][]*domain.Revision),
 	}
 }
 
@@ -54,11 +60,18 @@ func (m *MockServicesRepository) GetAuditEvents() []MockAuditEvent {
 }
 
 // Service repository methods
-func (m *MockServicesRepository) SaveService(ctx context.Context, service *Service) error {
+func (m *MockServicesRepository) SaveService(ctx context.Context, service *Service, audit *domain.AuditEvent) error {
 	if err, exists := m.failures["SaveService"]; exists {
 		return err
 	}
+	if stored, exists := m.services[service.ServiceID]; exists && service.ETag != "" && stored.ETag != service.ETag {
+		return domain.NewConflictError("service was modified concurrently")
+	}
+	// Every write produces a new version, as in the state store
+	m.saves++
+	service.ETag = fmt.Sprintf("etag-%d", m.saves)
 	m.services[service.ServiceID] = service
+	m.recordAudit(audit)
 	return nil
 }
 
@@ -124,6 +137,27 @@ func (m *MockServicesRepository) GetServicesByPublishingStatus(ctx context.Conte
 	return services, nil
 }
 
+func (m *MockServicesRepository) ListServicesPage(ctx context.Context, filter ServiceListFilter, page domain.PageRequest) ([]*Service, domain.PageInfo, error) {
+	if err, exists := m.failures["ListServicesPage"]; exists {
+		return nil, domain.PageInfo{}, err
+	}
+	services := make([]*Service, 0)
+	for _, service := range m.services {
+		if service.IsDeleted {
+			continue
+		}
+		if filter.CategoryID != "" && service.CategoryID != filter.CategoryID {
+			continue
+		}
+		if filter.PublishingStatus != "" && service.PublishingStatus != filter.PublishingStatus {
+			continue
+		}
+		services = append(services, service)
+	}
+	pageItems, info := PageServices(services, page)
+	return pageItems, info, nil
+}
+
 func (m *MockServicesRepository) SearchServices(ctx context.Context, searchTerm string) ([]*Service, error) {
 	if err, exists := m.failures["SearchServices"]; exists {
 		return nil, err
@@ -137,7 +171,28 @@ func (m *MockServicesRepository) SearchServices(ctx context.Context, searchTerm
 	return services, nil
 }
 
-func (m *MockServicesRepository) DeleteService(ctx context.Context, serviceID string, userID string) error {
+func (m *MockServicesRepository) QueryServices(ctx context.Context, query search.Query) (*search.Results, error) {
+	if err, exists := m.failures["QueryServices"]; exists {
+		return nil, err
+	}
+	index := newServiceSearchIndex()
+	for _, service := range m.services {
+		if !service.IsDeleted {
+			index.Upsert(serviceSearchDocument(service))
+		}
+	}
+	return index.Search(query), nil
+}
+
+func (m *MockServicesRepository) ReindexService(ctx context.Context, serviceID string) error {
+	if err, exists := m.failures["ReindexService"]; exists {
+		return err
+	}
+	m.reindexed = append(m.reindexed, serviceID)
+	return nil
+}
+
+func (m *MockServicesRepository) DeleteService(ctx context.Context, serviceID string, userID string, audit *domain.AuditEvent) error {
 	if err, exists := m.failures["DeleteService"]; exists {
 		return err
 	}
@@ -146,6 +201,7 @@ func (m *MockServicesRepository) DeleteService(ctx context.Context, serviceID st
 		return domain.NewNotFoundError("service", serviceID)
 	}
 	service.Delete(userID)
+	m.recordAudit(audit)
 	return nil
 }
 
@@ -233,28 +289,67 @@ func (m *MockServicesRepository) UploadServiceContentBlob(ctx context.Context, s
 	return nil
 }
 
-// Audit repository methods
-func (m *MockServicesRepository) PublishAuditEvent(ctx context.Context, entityType domain.EntityType, entityID string, operationType domain.AuditEventType, userID string, before interface{}, after interface{}) error {
-	if err, exists := m.failures["PublishAuditEvent"]; exists {
-		return err
+// recordAudit keeps the audit event a write committed, in place of the outbox
+func (m *MockServicesRepository) recordAudit(audit *domain.AuditEvent) {
+	if audit == nil {
+		return
 	}
 	m.auditEvents = append(m.auditEvents, MockAuditEvent{
-		EntityType:    entityType,
-		EntityID:      entityID,
-		OperationType: operationType,
-		UserID:        userID,
-		Before:        before,
-		After:         after,
+		EntityType:    audit.EntityType,
+		EntityID:      audit.EntityID,
+		OperationType: audit.OperationType,
+		UserID:        audit.UserID,
+		Before:        audit.DataSnapshot.Before,
+		After:         audit.DataSnapshot.After,
 	})
+}
+
+func (m *MockServicesRepository) PublishReviewEvent(ctx context.Context, event *domain.ReviewEvent) error {
+	if err, exists := m.failures["PublishReviewEvent"]; exists {
+		return err
+	}
+	m.reviewEvents = append(m.reviewEvents, event)
 	return nil
 }
 
+// AddRevision records a numbered revision of a service holding its content after the change
+func (m *MockServicesRepository) AddRevision(service *Service, operationType domain.AuditEventType) {
+	revision := domain.NewRevision(domain.NewAuditEvent(domain.EntityTypeService, service.ServiceID, operationType, "admin-1"))
+	revision.Number = len(m.revisions[service.ServiceID]) + 1
+	revision.DataSnapshot = &domain.AuditDataSnapshot{After: service}
+	m.revisions[service.ServiceID] = append(m.revisions[service.ServiceID], revision)
+}
+
+// ListServiceRevisions mocks listing the revision summaries of a service
+func (m *MockServicesRepository) ListServiceRevisions(ctx context.Context, serviceID string) ([]*domain.Revision, error) {
+	if err, exists := m.failures["ListServiceRevisions"]; exists {
+		return nil, err
+	}
+	revisions := make([]*domain.Revision, 0, len(m.revisions[serviceID]))
+	for i := len(m.revisions[serviceID]) - 1; i >= 0; i-- {
+		revisions = append(revisions, m.revisions[serviceID][i].Summary())
+	}
+	return revisions, nil
+}
+
+// GetServiceRevision mocks getting one revision of a service
+func (m *MockServicesRepository) GetServiceRevision(ctx context.Context, serviceID string, revision int) (*domain.Revision, error) {
+	if err, exists := m.failures["GetServiceRevision"]; exists {
+		return nil, err
+	}
+	if revision < 1 || revision > len(m.revisions[serviceID]) {
+		return nil, domain.NewNotFoundError("revision", fmt.Sprintf("%s@%d", serviceID, revision))
+	}
+	return m.revisions[serviceID][revision-1], nil
+}
+
 // SaveServiceCategory mocks saving a service category
-func (m *MockServicesRepository) SaveServiceCategory(ctx context.Context, category *ServiceCategory) error {
+func (m *MockServicesRepository) SaveServiceCategory(ctx context.Context, category *ServiceCategory, audit *domain.AuditEvent) error {
 	if err, exists := m.failures["SaveServiceCategory"]; exists {
 		return err
 	}
 	m.categories[category.CategoryID] = category
+	m.recordAudit(audit)
 	return nil
 }
 
@@ -274,7 +369,7 @@ func (m *MockServicesRepository) GetDefaultUnassignedCategory(ctx context.Contex
 }
 
 // DeleteServiceCategory mocks deleting a service category
-func (m *MockServicesRepository) DeleteServiceCategory(ctx context.Context, categoryID string, userID string) error {
+func (m *MockServicesRepository) DeleteServiceCategory(ctx context.Context, categoryID string, userID string, audit *domain.AuditEvent) error {
 	if err, exists := m.failures["DeleteServiceCategory"]; exists {
 		return err
 	}
@@ -283,15 +378,17 @@ func (m *MockServicesRepository) DeleteServiceCategory(ctx context.Context, cate
 		return domain.NewNotFoundError("service_category", categoryID)
 	}
 	category.Delete(userID)
+	m.recordAudit(audit)
 	return nil
 }
 
 // SaveFeaturedCategory mocks saving a featured category
-func (m *MockServicesRepository) SaveFeaturedCategory(ctx context.Context, featured *FeaturedCategory) error {
+func (m *MockServicesRepository) SaveFeaturedCategory(ctx context.Context, featured *FeaturedCategory, audit *domain.AuditEvent) error {
 	if err, exists := m.failures["SaveFeaturedCategory"]; exists {
 		return err
 	}
 	m.featuredCategories[featured.FeaturedCategoryID] = featured
+	m.recordAudit(audit)
 	return nil
 }
 
@@ -308,7 +405,7 @@ func (m *MockServicesRepository) GetFeaturedCategory(ctx context.Context, featur
 }
 
 // DeleteFeaturedCategory mocks deleting a featured category
-func (m *MockServicesRepository) DeleteFeaturedCategory(ctx context.Context, featuredCategoryID string) error {
+func (m *MockServicesRepository) DeleteFeaturedCategory(ctx context.Context, featuredCategoryID string, audit *domain.AuditEvent) error {
 	if err, exists := m.failures["DeleteFeaturedCategory"]; exists {
 		return err
 	}
@@ -317,6 +414,7 @@ func (m *MockServicesRepository) DeleteFeaturedCategory(ctx context.Context, fea
 		return domain.NewNotFoundError("featured_category", featuredCategoryID)
 	}
 	delete(m.featuredCategories, featuredCategoryID)
+	m.recordAudit(audit)
 	return nil
 }
 
@@ -721,7 +819,7 @@ func TestServicesService_PublishService(t *testing.T) {
 				service.PublishingStatus = PublishingStatusPublished
 				repo.services["service-3"] = service
 			},
-			expectedError: "can only publish services with draft status",
+			expectedError: "can only publish services with draft or approved status",
 		},
 	}
 
@@ -861,6 +959,159 @@ func TestServicesService_GetAllServices(t *testing.T) {
 	}
 }
 
+func TestServicesService_QueryPublishedServices(t *testing.T) {
+	setup := func(repo *MockServicesRepository) {
+		for _, s := range []struct {
+			id, title, description string
+			mode                   DeliveryMode
+			status                 PublishingStatus
+		}{
+			{"service-1", "Mobile vaccination clinic", "Vaccinations delivered to your community", DeliveryModeMobile, PublishingStatusPublished},
+			{"service-2", "Family medicine", "Primary care including vaccinations", DeliveryModeOutpatient, PublishingStatusPublished},
+			{"service-3", "Vaccination drive", "Seasonal vaccinations", DeliveryModeMobile, PublishingStatusDraft},
+		} {
+			service := createTestService("creator-1")
+			service.ServiceID = s.id
+			service.Title = s.title
+			service.Description = s.description
+			service.DeliveryMode = s.mode
+			service.PublishingStatus = s.status
+			repo.services[s.id] = service
+		}
+	}
+
+	tests := []struct {
+		name          string
+		query         search.Query
+		wantIDs       []string
+		expectedError string
+	}{
+		{
+			name:    "rank title matches first and skip drafts",
+			query:   search.Query{Text: "vaccination"},
+			wantIDs: []string{"service-1", "service-2"},
+		},
+		{
+			name:    "filter by delivery mode facet",
+			query:   search.Query{Text: "vaccination", Filters: map[string]string{serviceFacetDeliveryMode: string(DeliveryModeOutpatient)}},
+			wantIDs: []string{"service-2"},
+		},
+		{
+			name:          "reject invalid query",
+			query:         search.Query{Offset: -1},
+			expectedError: "offset must not be negative",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx, cancel := sharedtesting.CreateUnitTestContext()
+			defer cancel()
+
+			repo := NewMockServicesRepository()
+			setup(repo)
+			service := NewServicesService(repo)
+
+			// Act
+			results, err := service.QueryPublishedServices(ctx, tt.query, "")
+
+			// Assert
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			ids := make([]string, 0, len(results.Hits))
+			for _, service := range servicesFromHits(results.Hits) {
+				ids = append(ids, service.ServiceID)
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+			assert.Equal(t, len(tt.wantIDs), results.Total)
+		})
+	}
+}
+
+func TestServicesService_ListServicesPage(t *testing.T) {
+	base := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		userID          string
+		limit           int
+		setupMock       func(*MockServicesRepository)
+		expectedIDs     []string
+		expectedHasMore bool
+	}{
+		{
+			name:  "anonymous user pages through published services newest first",
+			limit: 1,
+			setupMock: func(repo *MockServicesRepository) {
+				for i, id := range []string{"older", "newer"} {
+					svc := createTestService("creator-1")
+					svc.ServiceID = id
+					svc.PublishingStatus = PublishingStatusPublished
+					svc.CreatedOn = base.Add(time.Duration(i) * time.Hour)
+					repo.services[id] = svc
+				}
+				draft := createTestService("creator-1")
+				draft.ServiceID = "draft"
+				draft.PublishingStatus = PublishingStatusDraft
+				draft.CreatedOn = base.Add(5 * time.Hour)
+				repo.services["draft"] = draft
+			},
+			expectedIDs:     []string{"newer"},
+			expectedHasMore: true,
+		},
+		{
+			name:   "authenticated user sees own drafts only",
+			userID: "creator-1",
+			limit:  10,
+			setupMock: func(repo *MockServicesRepository) {
+				own := createTestService("creator-1")
+				own.ServiceID = "own-draft"
+				own.PublishingStatus = PublishingStatusDraft
+				own.CreatedOn = base
+				repo.services["own-draft"] = own
+				other := createTestService("other-creator")
+				other.ServiceID = "other-draft"
+				other.PublishingStatus = PublishingStatusDraft
+				other.CreatedOn = base.Add(time.Hour)
+				repo.services["other-draft"] = other
+			},
+			expectedIDs: []string{"own-draft"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx, cancel := sharedtesting.CreateUnitTestContext()
+			defer cancel()
+
+			mockRepo := NewMockServicesRepository()
+			tt.setupMock(mockRepo)
+			service := NewServicesService(mockRepo)
+			page, err := domain.NewPageRequest("", tt.limit)
+			require.NoError(t, err)
+
+			// Act
+			result, info, err := service.ListServicesPage(ctx, ServiceListFilter{}, page, tt.userID)
+
+			// Assert
+			require.NoError(t, err)
+			ids := make([]string, 0, len(result))
+			for _, svc := range result {
+				ids = append(ids, svc.ServiceID)
+			}
+			assert.Equal(t, tt.expectedIDs, ids)
+			assert.Equal(t, tt.expectedHasMore, info.HasMore)
+			assert.Equal(t, len(tt.expectedIDs), info.Count)
+		})
+	}
+}
+
 func TestServicesService_Timeout(t *testing.T) {
 	// Test that context timeout is respected (5 seconds for unit tests)
 	ctx, cancel := sharedtesting.CreateUnitTestContext()
@@ -1410,11 +1661,12 @@ func TestServicesService_AdminUpdateService(t *testing.T) {
 	defer cancel()
 
 	tests := []struct {
-		name      string
-		service   *Service
-		userID    string
-		setupFunc func(*MockServicesRepository)
-		wantErr   bool
+		name         string
+		service      *Service
+		userID       string
+		setupFunc    func(*MockServicesRepository)
+		wantErr      bool
+		wantConflict bool
 	}{
 		{
 			name: "successfully update existing service",
@@ -1435,6 +1687,41 @@ func TestServicesService_AdminUpdateService(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "successfully update service when etag names the current version",
+			service: &Service{
+				ServiceID:        "550e8400-e29b-41d4-a716-446655440001",
+				Title:            "Updated Service Title",
+				Description:      "Updated description for service with sufficient length to meet validation requirements",
+				PublishingStatus: PublishingStatusDraft,
+				ETag:             "etag-current",
+			},
+			userID: "admin-550e8400-e29b-41d4-a716-446655440003",
+			setupFunc: func(repo *MockServicesRepository) {
+				service := createTestService("admin-550e8400-e29b-41d4-a716-446655440003")
+				service.ServiceID = "550e8400-e29b-41d4-a716-446655440001"
+				service.ETag = "etag-current"
+				repo.services["550e8400-e29b-41d4-a716-446655440001"] = service
+			},
+			wantErr: false,
+		},
+		{
+			name: "return conflict error when etag names a stale version",
+			service: &Service{
+				ServiceID: "550e8400-e29b-41d4-a716-446655440001",
+				Title:     "Updated Service Title",
+				ETag:      "etag-stale",
+			},
+			userID: "admin-550e8400-e29b-41d4-a716-446655440003",
+			setupFunc: func(repo *MockServicesRepository) {
+				service := createTestService("admin-550e8400-e29b-41d4-a716-446655440003")
+				service.ServiceID = "550e8400-e29b-41d4-a716-446655440001"
+				service.ETag = "etag-current"
+				repo.services["550e8400-e29b-41d4-a716-446655440001"] = service
+			},
+			wantErr:      true,
+			wantConflict: true,
+		},
 		{
 			name: "return not found error for non-existent service",
 			service: &Service{
@@ -1451,16 +1738,79 @@ func TestServicesService_AdminUpdateService(t *testing.T) {
 			repo := NewMockServicesRepository()
 			tt.setupFunc(repo)
 			service := NewServicesService(repo)
+			expectedETag := tt.service.ETag
 
 			err := service.AdminUpdateService(ctx, tt.service, tt.userID)
 
 			if tt.wantErr {
 				assert.Error(t, err)
+				assert.Equal(t, tt.wantConflict, domain.IsConflictError(err))
+				assert.Empty(t, repo.auditEvents)
 			} else {
 				assert.NoError(t, err)
 				assert.Len(t, repo.auditEvents, 1)
 				assert.Equal(t, domain.AuditEventUpdate, repo.auditEvents[0].OperationType)
+				assert.NotEmpty(t, tt.service.ETag)
+				assert.NotEqual(t, expectedETag, tt.service.ETag)
+			}
+		})
+	}
+}
+
+func TestServicesService_AdminRollbackService(t *testing.T) {
+	ctx, cancel := sharedtesting.CreateUnitTestContext()
+	defer cancel()
+
+	serviceID := "550e8400-e29b-41d4-a716-446655440001"
+	original := createTestService("author-1")
+	original.ServiceID = serviceID
+	original.Title = "Original Service Title"
+
+	tests := []struct {
+		name         string
+		revision     int
+		etag         string
+		userID       string
+		wantErr      bool
+		wantConflict bool
+	}{
+		{name: "restore earlier revision", revision: 1, userID: "admin-2"},
+		{name: "restore when etag names the current version", revision: 1, etag: "etag-current", userID: "admin-2"},
+		{name: "reject stale etag", revision: 1, etag: "etag-stale", userID: "admin-2", wantErr: true, wantConflict: true},
+		{name: "reject unknown revision", revision: 5, userID: "admin-2", wantErr: true},
+		{name: "reject missing admin", revision: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			repo := NewMockServicesRepository()
+			edited := *original
+			edited.Title = "Edited Service Title"
+			edited.ETag = "etag-current"
+			repo.services[serviceID] = &edited
+			repo.AddRevision(original, domain.AuditEventInsert)
+			repo.AddRevision(&edited, domain.AuditEventUpdate)
+			service := NewServicesService(repo)
+
+			// Act
+			restored, err := service.AdminRollbackService(ctx, serviceID, tt.revision, tt.etag, tt.userID)
+
+			// Assert
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantConflict, domain.IsConflictError(err))
+				assert.Equal(t, "Edited Service Title", repo.services[serviceID].Title)
+				assert.Empty(t, repo.auditEvents)
+				return
 			}
+			require.NoError(t, err)
+			assert.Equal(t, "Original Service Title", restored.Title)
+			assert.Equal(t, "author-1", restored.CreatedBy)
+			assert.Equal(t, "admin-2", restored.ModifiedBy)
+			assert.NotEqual(t, "etag-current", restored.ETag)
+			require.Len(t, repo.auditEvents, 1)
+			assert.Equal(t, domain.AuditEventRollback, repo.auditEvents[0].OperationType)
 		})
 	}
 }
@@ -1572,6 +1922,46 @@ func TestServicesService_AdminPublishService(t *testing.T) {
 	}
 }
 
+func TestServicesService_AdminEditorialReview(t *testing.T) {
+	ctx, cancel := sharedtesting.CreateUnitTestContext()
+	defer cancel()
+
+	serviceID := "550e8400-e29b-41d4-a716-446655440001"
+	author := "admin-550e8400-e29b-41d4-a716-446655440003"
+	reviewer := "admin-550e8400-e29b-41d4-a716-446655440004"
+
+	// Arrange
+	repo := NewMockServicesRepository()
+	draft := createTestService(author)
+	draft.ServiceID = serviceID
+	repo.services[serviceID] = draft
+	service := NewServicesService(repo)
+	service.UseEditorialWorkflow(domain.NewEditorialWorkflow(true, 1))
+
+	// Act
+	_, err := service.AdminSubmitServiceForReview(ctx, serviceID, []string{author}, author)
+	require.Error(t, err)
+	assert.True(t, domain.IsForbiddenError(err))
+
+	_, err = service.AdminSubmitServiceForReview(ctx, serviceID, []string{reviewer}, author)
+	require.NoError(t, err)
+	assert.True(t, domain.IsConflictError(service.AdminPublishService(ctx, serviceID, author)))
+
+	review, err := service.AdminCommentOnServiceReview(ctx, serviceID, "Add the referral process", reviewer)
+	require.NoError(t, err)
+	assert.Len(t, review.Review.Comments, 1)
+
+	_, err = service.AdminApproveService(ctx, serviceID, reviewer)
+	require.NoError(t, err)
+
+	// Assert
+	require.NoError(t, service.AdminPublishService(ctx, serviceID, author))
+	assert.Equal(t, PublishingStatusPublished, repo.services[serviceID].PublishingStatus)
+	require.Len(t, repo.reviewEvents, 3)
+	assert.Equal(t, domain.ReviewEventCommented, repo.reviewEvents[1].EventType)
+	assert.Equal(t, []string{author}, repo.reviewEvents[1].Recipients)
+}
+
 func TestServicesService_AdminArchiveService(t *testing.T) {
 	ctx, cancel := sharedtesting.CreateUnitTestContext()
 	defer cancel()
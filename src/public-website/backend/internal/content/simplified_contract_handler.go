@@ -2,10 +2,13 @@ package content
 
 import (
 	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
 	"time"
 
 	"github.com/axiom-software-co/international-center/src/backend/internal/content/events"
+	"github.com/axiom-software-co/international-center/src/backend/internal/content/media"
 	"github.com/axiom-software-co/international-center/src/backend/internal/content/news"
 	"github.com/axiom-software-co/international-center/src/backend/internal/content/research"
 	"github.com/axiom-software-co/international-center/src/backend/internal/content/services"
@@ -22,6 +25,9 @@ type SimplifiedContractHandler struct {
 	researchService *research.ResearchService
 	servicesService *services.ServicesService
 	eventsService   *events.EventsService
+	scheduler       *PublicationScheduler
+	reviews         *EditorialReviews
+	media           *media.MediaService
 }
 
 // NewSimplifiedContractHandler creates a new simplified contract handler
@@ -30,12 +36,18 @@ func NewSimplifiedContractHandler(
 	researchService *research.ResearchService,
 	servicesService *services.ServicesService,
 	eventsService *events.EventsService,
+	scheduler *PublicationScheduler,
+	reviews *EditorialReviews,
+	mediaService *media.MediaService,
 ) *SimplifiedContractHandler {
 	return &SimplifiedContractHandler{
 		newsService:     newsService,
 		researchService: researchService,
 		servicesService: servicesService,
 		eventsService:   eventsService,
+		scheduler:       scheduler,
+		reviews:         reviews,
+		media:           mediaService,
 	}
 }
 
@@ -56,9 +68,36 @@ func (h *SimplifiedContractHandler) GetNewsAdmin(w http.ResponseWriter, r *http.
 		return
 	}
 
+	listParams := news.ListNewsParams{
+		Page:  1,
+		Limit: 20,
+	}
+	if params.Page != nil {
+		listParams.Page = int(*params.Page)
+	}
+	if params.Limit != nil {
+		listParams.Limit = int(*params.Limit)
+	}
+	if params.Cursor != nil {
+		listParams.Cursor = *params.Cursor
+	}
+	if params.Search != nil {
+		listParams.Search = *params.Search
+	}
+	if params.Status != nil {
+		listParams.Status = string(*params.Status)
+	}
+	if params.CategoryId != nil {
+		listParams.CategoryID = params.CategoryId.String()
+	}
+
 	// Use real news service to get actual data
-	newsData, err := h.newsService.GetAllNews(ctx, userID)
+	newsData, pagination, err := h.newsService.AdminListNews(ctx, listParams)
 	if err != nil {
+		if domain.IsValidationError(err) {
+			h.writeErrorResponse(w, http.StatusBadRequest, err.Error(), correlationCtx.CorrelationID)
+			return
+		}
 		h.writeErrorResponse(w, http.StatusInternalServerError, "Failed to fetch news articles", correlationCtx.CorrelationID)
 		return
 	}
@@ -69,45 +108,24 @@ func (h *SimplifiedContractHandler) GetNewsAdmin(w http.ResponseWriter, r *http.
 		contractNews[i] = h.convertNewsToContract(*news)
 	}
 
-	// Apply pagination
-	page := 1
-	limit := 20
-	if params.Page != nil {
-		page = int(*params.Page)
+	paginationInfo := admin.PaginationInfo{
+		CurrentPage:  pagination.CurrentPage,
+		TotalPages:   pagination.TotalPages,
+		TotalItems:   pagination.TotalItems,
+		ItemsPerPage: pagination.ItemsPerPage,
+		HasNext:      pagination.HasNext,
+		HasPrevious:  pagination.HasPrevious,
 	}
-	if params.Limit != nil {
-		limit = int(*params.Limit)
-	}
-
-	start := (page - 1) * limit
-	end := start + limit
-	if start > len(contractNews) {
-		start = len(contractNews)
-	}
-	if end > len(contractNews) {
-		end = len(contractNews)
-	}
-
-	paginatedNews := contractNews[start:end]
-	totalItems := len(contractNews)
-	totalPages := (totalItems + limit - 1) / limit
-	if totalPages == 0 {
-		totalPages = 1
+	if pagination.NextCursor != "" {
+		paginationInfo.NextCursor = &pagination.NextCursor
 	}
 
 	response := struct {
 		Data       []admin.NewsArticle   `json:"data"`
 		Pagination admin.PaginationInfo `json:"pagination"`
 	}{
-		Data: paginatedNews,
-		Pagination: admin.PaginationInfo{
-			CurrentPage:  page,
-			TotalPages:   totalPages,
-			TotalItems:   totalItems,
-			ItemsPerPage: limit,
-			HasNext:      page < totalPages,
-			HasPrevious:  page > 1,
-		},
+		Data:       contractNews,
+		Pagination: paginationInfo,
 	}
 
 	h.writeResponse(w, http.StatusOK, response, correlationCtx.CorrelationID)
@@ -160,7 +178,7 @@ func (h *SimplifiedContractHandler) GetNewsArticleByIdAdmin(w http.ResponseWrite
 }
 
 // UpdateNewsArticle implements PUT /admin/api/v1/news/{id}
-func (h *SimplifiedContractHandler) UpdateNewsArticle(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+func (h *SimplifiedContractHandler) UpdateNewsArticle(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params admin.UpdateNewsArticleParams) {
 	correlationCtx := domain.FromContext(r.Context())
 	if correlationCtx == nil {
 		correlationCtx = domain.NewCorrelationContext()
@@ -409,6 +427,474 @@ func (h *SimplifiedContractHandler) CreateResearchPublication(w http.ResponseWri
 	h.writeResponse(w, http.StatusCreated, response, correlationCtx.CorrelationID)
 }
 
+// Content scheduling API implementations
+
+// ListContentSchedules implements GET /admin/api/v1/content/schedules
+func (h *SimplifiedContractHandler) ListContentSchedules(w http.ResponseWriter, r *http.Request, params admin.ListContentSchedulesParams) {
+	ctx := r.Context()
+	correlationCtx := domain.FromContext(ctx)
+	if correlationCtx == nil {
+		correlationCtx = domain.NewCorrelationContext()
+	}
+
+	filter := ScheduleFilter{}
+	if params.EntityType != nil {
+		filter.EntityType = domain.EntityType(*params.EntityType)
+	}
+	if params.EntityId != nil {
+		filter.EntityID = params.EntityId.String()
+	}
+	if params.Status != nil {
+		filter.Status = domain.ScheduleStatus(*params.Status)
+	}
+
+	schedules, err := h.scheduler.ListSchedules(ctx, filter, r.Header.Get("X-User-ID"))
+	if err != nil {
+		h.writeDomainError(w, err, "Failed to fetch content schedules", correlationCtx.CorrelationID)
+		return
+	}
+
+	contractSchedules := make([]admin.ContentSchedule, len(schedules))
+	for i, schedule := range schedules {
+		contractSchedules[i] = h.convertScheduleToContract(schedule)
+	}
+
+	response := struct {
+		Data []admin.ContentSchedule `json:"data"`
+	}{
+		Data: contractSchedules,
+	}
+
+	h.writeResponse(w, http.StatusOK, response, correlationCtx.CorrelationID)
+}
+
+// CreateContentSchedule implements POST /admin/api/v1/content/schedules
+func (h *SimplifiedContractHandler) CreateContentSchedule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	correlationCtx := domain.FromContext(ctx)
+	if correlationCtx == nil {
+		correlationCtx = domain.NewCorrelationContext()
+	}
+
+	var body admin.CreateContentScheduleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid JSON in request body", correlationCtx.CorrelationID)
+		return
+	}
+
+	request := ScheduleRequest{
+		EntityType: domain.EntityType(body.EntityType),
+		EntityID:   body.EntityId.String(),
+		Action:     domain.ScheduleAction(body.Action),
+		RunAt:      body.RunAt,
+	}
+	if body.Embargo != nil {
+		request.Embargo = *body.Embargo
+	}
+
+	schedule, err := h.scheduler.CreateSchedule(ctx, request, r.Header.Get("X-User-ID"))
+	if err != nil {
+		h.writeDomainError(w, err, "Failed to create content schedule", correlationCtx.CorrelationID)
+		return
+	}
+
+	response := struct {
+		Data admin.ContentSchedule `json:"data"`
+	}{
+		Data: h.convertScheduleToContract(schedule),
+	}
+
+	h.writeResponse(w, http.StatusCreated, response, correlationCtx.CorrelationID)
+}
+
+// CancelContentSchedule implements DELETE /admin/api/v1/content/schedules/{id}
+func (h *SimplifiedContractHandler) CancelContentSchedule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	ctx := r.Context()
+	correlationCtx := domain.FromContext(ctx)
+	if correlationCtx == nil {
+		correlationCtx = domain.NewCorrelationContext()
+	}
+
+	schedule, err := h.scheduler.CancelSchedule(ctx, id.String(), r.Header.Get("X-User-ID"))
+	if err != nil {
+		h.writeDomainError(w, err, "Failed to cancel content schedule", correlationCtx.CorrelationID)
+		return
+	}
+
+	response := struct {
+		Data admin.ContentSchedule `json:"data"`
+	}{
+		Data: h.convertScheduleToContract(schedule),
+	}
+
+	h.writeResponse(w, http.StatusOK, response, correlationCtx.CorrelationID)
+}
+
+// Content review API implementations
+
+// GetContentReview implements GET /admin/api/v1/content/{entity_type}/{id}/review
+func (h *SimplifiedContractHandler) GetContentReview(w http.ResponseWriter, r *http.Request, entityType admin.ContentEntityTypeParam, id admin.ContentIdParam) {
+	ctx := r.Context()
+	correlationCtx := domain.FromContext(ctx)
+	if correlationCtx == nil {
+		correlationCtx = domain.NewCorrelationContext()
+	}
+
+	review, err := h.reviews.GetReview(ctx, domain.EntityType(entityType), id.String(), r.Header.Get("X-User-ID"))
+	if err != nil {
+		h.writeDomainError(w, err, "Failed to fetch content review", correlationCtx.CorrelationID)
+		return
+	}
+
+	h.writeReview(w, http.StatusOK, review, correlationCtx.CorrelationID)
+}
+
+// SubmitContentForReview implements POST /admin/api/v1/content/{entity_type}/{id}/review/submit
+func (h *SimplifiedContractHandler) SubmitContentForReview(w http.ResponseWriter, r *http.Request, entityType admin.ContentEntityTypeParam, id admin.ContentIdParam) {
+	ctx := r.Context()
+	correlationCtx := domain.FromContext(ctx)
+	if correlationCtx == nil {
+		correlationCtx = domain.NewCorrelationContext()
+	}
+
+	var body admin.SubmitContentForReviewJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid JSON in request body", correlationCtx.CorrelationID)
+		return
+	}
+	var reviewerIDs []string
+	if body.ReviewerIds != nil {
+		reviewerIDs = *body.ReviewerIds
+	}
+
+	review, err := h.reviews.Submit(ctx, domain.EntityType(entityType), id.String(), reviewerIDs, r.Header.Get("X-User-ID"))
+	if err != nil {
+		h.writeDomainError(w, err, "Failed to submit content for review", correlationCtx.CorrelationID)
+		return
+	}
+
+	h.writeReview(w, http.StatusOK, review, correlationCtx.CorrelationID)
+}
+
+// AssignContentReviewers implements POST /admin/api/v1/content/{entity_type}/{id}/review/reviewers
+func (h *SimplifiedContractHandler) AssignContentReviewers(w http.ResponseWriter, r *http.Request, entityType admin.ContentEntityTypeParam, id admin.ContentIdParam) {
+	ctx := r.Context()
+	correlationCtx := domain.FromContext(ctx)
+	if correlationCtx == nil {
+		correlationCtx = domain.NewCorrelationContext()
+	}
+
+	var body admin.AssignContentReviewersJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid JSON in request body", correlationCtx.CorrelationID)
+		return
+	}
+
+	review, err := h.reviews.AssignReviewers(ctx, domain.EntityType(entityType), id.String(), body.ReviewerIds, r.Header.Get("X-User-ID"))
+	if err != nil {
+		h.writeDomainError(w, err, "Failed to assign content reviewers", correlationCtx.CorrelationID)
+		return
+	}
+
+	h.writeReview(w, http.StatusOK, review, correlationCtx.CorrelationID)
+}
+
+// AddContentReviewComment implements POST /admin/api/v1/content/{entity_type}/{id}/review/comments
+func (h *SimplifiedContractHandler) AddContentReviewComment(w http.ResponseWriter, r *http.Request, entityType admin.ContentEntityTypeParam, id admin.ContentIdParam) {
+	ctx := r.Context()
+	correlationCtx := domain.FromContext(ctx)
+	if correlationCtx == nil {
+		correlationCtx = domain.NewCorrelationContext()
+	}
+
+	var body admin.AddContentReviewCommentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid JSON in request body", correlationCtx.CorrelationID)
+		return
+	}
+
+	review, err := h.reviews.Comment(ctx, domain.EntityType(entityType), id.String(), body.Body, r.Header.Get("X-User-ID"))
+	if err != nil {
+		h.writeDomainError(w, err, "Failed to add review comment", correlationCtx.CorrelationID)
+		return
+	}
+
+	h.writeReview(w, http.StatusCreated, review, correlationCtx.CorrelationID)
+}
+
+// ApproveContent implements POST /admin/api/v1/content/{entity_type}/{id}/review/approve
+func (h *SimplifiedContractHandler) ApproveContent(w http.ResponseWriter, r *http.Request, entityType admin.ContentEntityTypeParam, id admin.ContentIdParam) {
+	ctx := r.Context()
+	correlationCtx := domain.FromContext(ctx)
+	if correlationCtx == nil {
+		correlationCtx = domain.NewCorrelationContext()
+	}
+
+	review, err := h.reviews.Approve(ctx, domain.EntityType(entityType), id.String(), r.Header.Get("X-User-ID"))
+	if err != nil {
+		h.writeDomainError(w, err, "Failed to approve content", correlationCtx.CorrelationID)
+		return
+	}
+
+	h.writeReview(w, http.StatusOK, review, correlationCtx.CorrelationID)
+}
+
+// RejectContent implements POST /admin/api/v1/content/{entity_type}/{id}/review/reject
+func (h *SimplifiedContractHandler) RejectContent(w http.ResponseWriter, r *http.Request, entityType admin.ContentEntityTypeParam, id admin.ContentIdParam) {
+	ctx := r.Context()
+	correlationCtx := domain.FromContext(ctx)
+	if correlationCtx == nil {
+		correlationCtx = domain.NewCorrelationContext()
+	}
+
+	var body admin.RejectContentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid JSON in request body", correlationCtx.CorrelationID)
+		return
+	}
+
+	review, err := h.reviews.Reject(ctx, domain.EntityType(entityType), id.String(), body.Reason, r.Header.Get("X-User-ID"))
+	if err != nil {
+		h.writeDomainError(w, err, "Failed to reject content", correlationCtx.CorrelationID)
+		return
+	}
+
+	h.writeReview(w, http.StatusOK, review, correlationCtx.CorrelationID)
+}
+
+// Media library API implementations
+
+const (
+	// mediaUploadFormOverheadBytes leaves room for the multipart framing and form fields
+	mediaUploadFormOverheadBytes = 1 << 20
+	// mediaUploadMemoryBytes is how much of an upload is buffered in memory before
+	// the multipart reader spills it to a temporary file
+	mediaUploadMemoryBytes = 32 << 20
+)
+
+// ListMedia implements GET /admin/api/v1/media
+func (h *SimplifiedContractHandler) ListMedia(w http.ResponseWriter, r *http.Request, params admin.ListMediaParams) {
+	ctx := r.Context()
+	correlationCtx := domain.FromContext(ctx)
+	if correlationCtx == nil {
+		correlationCtx = domain.NewCorrelationContext()
+	}
+
+	filter := media.MediaFilter{}
+	if params.Kind != nil {
+		filter.Kind = media.MediaKind(*params.Kind)
+	}
+	if params.EntityType != nil {
+		filter.EntityType = domain.EntityType(*params.EntityType)
+	}
+	if params.EntityId != nil {
+		filter.EntityID = params.EntityId.String()
+	}
+
+	assets, err := h.media.ListMedia(ctx, filter)
+	if err != nil {
+		h.writeDomainError(w, err, "Failed to fetch media", correlationCtx.CorrelationID)
+		return
+	}
+
+	contractAssets := make([]admin.MediaAsset, len(assets))
+	for i, asset := range assets {
+		contractAssets[i] = h.convertMediaToContract(asset)
+	}
+
+	response := struct {
+		Data []admin.MediaAsset `json:"data"`
+	}{
+		Data: contractAssets,
+	}
+
+	h.writeResponse(w, http.StatusOK, response, correlationCtx.CorrelationID)
+}
+
+// UploadMedia implements POST /admin/api/v1/media
+func (h *SimplifiedContractHandler) UploadMedia(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	correlationCtx := domain.FromContext(ctx)
+	if correlationCtx == nil {
+		correlationCtx = domain.NewCorrelationContext()
+	}
+
+	// Oversized uploads are cut off while reading; the per-kind limit is checked once
+	// the content type is known
+	r.Body = http.MaxBytesReader(w, r.Body, h.media.Limits().MaxUploadBytes()+mediaUploadFormOverheadBytes)
+	if err := r.ParseMultipartForm(mediaUploadMemoryBytes); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			h.writeErrorResponse(w, http.StatusRequestEntityTooLarge, "Upload exceeds the maximum media size", correlationCtx.CorrelationID)
+			return
+		}
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid multipart form in request body", correlationCtx.CorrelationID)
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "A file is required", correlationCtx.CorrelationID)
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Failed to read uploaded file", correlationCtx.CorrelationID)
+		return
+	}
+
+	request := media.UploadRequest{
+		FileName:   header.Filename,
+		Data:       data,
+		AltText:    r.FormValue("alt_text"),
+		EntityType: domain.EntityType(r.FormValue("entity_type")),
+	}
+	if entityID := r.FormValue("entity_id"); entityID != "" {
+		parsed, err := uuid.Parse(entityID)
+		if err != nil {
+			h.writeErrorResponse(w, http.StatusBadRequest, "entity_id must be a UUID", correlationCtx.CorrelationID)
+			return
+		}
+		request.EntityID = parsed.String()
+	}
+
+	asset, created, err := h.media.Upload(ctx, request, r.Header.Get("X-User-ID"))
+	if err != nil {
+		h.writeDomainError(w, err, "Failed to upload media", correlationCtx.CorrelationID)
+		return
+	}
+
+	statusCode := http.StatusOK
+	if created {
+		statusCode = http.StatusCreated
+	}
+	h.writeMedia(w, statusCode, asset, correlationCtx.CorrelationID)
+}
+
+// GetMedia implements GET /admin/api/v1/media/{id}
+func (h *SimplifiedContractHandler) GetMedia(w http.ResponseWriter, r *http.Request, id admin.MediaIdParam) {
+	ctx := r.Context()
+	correlationCtx := domain.FromContext(ctx)
+	if correlationCtx == nil {
+		correlationCtx = domain.NewCorrelationContext()
+	}
+
+	asset, err := h.media.GetMedia(ctx, id.String())
+	if err != nil {
+		h.writeDomainError(w, err, "Failed to fetch media", correlationCtx.CorrelationID)
+		return
+	}
+
+	h.writeMedia(w, http.StatusOK, asset, correlationCtx.CorrelationID)
+}
+
+// DeleteMedia implements DELETE /admin/api/v1/media/{id}
+func (h *SimplifiedContractHandler) DeleteMedia(w http.ResponseWriter, r *http.Request, id admin.MediaIdParam) {
+	ctx := r.Context()
+	correlationCtx := domain.FromContext(ctx)
+	if correlationCtx == nil {
+		correlationCtx = domain.NewCorrelationContext()
+	}
+
+	if err := h.media.DeleteMedia(ctx, id.String(), r.Header.Get("X-User-ID")); err != nil {
+		h.writeDomainError(w, err, "Failed to delete media", correlationCtx.CorrelationID)
+		return
+	}
+
+	correlationUUID, _ := uuid.Parse(correlationCtx.CorrelationID)
+	response := admin.DeletedResponse{
+		Success:       true,
+		Message:       "Media deleted successfully",
+		Timestamp:     time.Now().UTC(),
+		CorrelationId: openapi_types.UUID(correlationUUID),
+	}
+
+	h.writeResponse(w, http.StatusOK, response, correlationCtx.CorrelationID)
+}
+
+// LinkMedia implements POST /admin/api/v1/media/{id}/links
+func (h *SimplifiedContractHandler) LinkMedia(w http.ResponseWriter, r *http.Request, id admin.MediaIdParam) {
+	ctx := r.Context()
+	correlationCtx := domain.FromContext(ctx)
+	if correlationCtx == nil {
+		correlationCtx = domain.NewCorrelationContext()
+	}
+
+	var body admin.LinkMediaJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid JSON in request body", correlationCtx.CorrelationID)
+		return
+	}
+
+	asset, err := h.media.LinkMedia(ctx, id.String(), domain.EntityType(body.EntityType), body.EntityId.String(), r.Header.Get("X-User-ID"))
+	if err != nil {
+		h.writeDomainError(w, err, "Failed to link media", correlationCtx.CorrelationID)
+		return
+	}
+
+	h.writeMedia(w, http.StatusOK, asset, correlationCtx.CorrelationID)
+}
+
+// UnlinkMedia implements DELETE /admin/api/v1/media/{id}/links/{entity_type}/{entity_id}
+func (h *SimplifiedContractHandler) UnlinkMedia(w http.ResponseWriter, r *http.Request, id admin.MediaIdParam, entityType admin.ContentEntityType, entityId openapi_types.UUID) {
+	ctx := r.Context()
+	correlationCtx := domain.FromContext(ctx)
+	if correlationCtx == nil {
+		correlationCtx = domain.NewCorrelationContext()
+	}
+
+	asset, err := h.media.UnlinkMedia(ctx, id.String(), domain.EntityType(entityType), entityId.String(), r.Header.Get("X-User-ID"))
+	if err != nil {
+		h.writeDomainError(w, err, "Failed to unlink media", correlationCtx.CorrelationID)
+		return
+	}
+
+	h.writeMedia(w, http.StatusOK, asset, correlationCtx.CorrelationID)
+}
+
+// GetMediaSignedUrl implements GET /admin/api/v1/media/{id}/url
+func (h *SimplifiedContractHandler) GetMediaSignedUrl(w http.ResponseWriter, r *http.Request, id admin.MediaIdParam, params admin.GetMediaSignedUrlParams) {
+	ctx := r.Context()
+	correlationCtx := domain.FromContext(ctx)
+	if correlationCtx == nil {
+		correlationCtx = domain.NewCorrelationContext()
+	}
+
+	variant := media.VariantOriginal
+	if params.Variant != nil && *params.Variant != "" {
+		variant = *params.Variant
+	}
+	var expiresIn time.Duration
+	if params.ExpiresIn != nil {
+		if *params.ExpiresIn <= 0 {
+			h.writeErrorResponse(w, http.StatusBadRequest, "expires_in must be a positive number of seconds", correlationCtx.CorrelationID)
+			return
+		}
+		expiresIn = time.Duration(*params.ExpiresIn) * time.Second
+	}
+
+	signed, err := h.media.SignedURL(ctx, id.String(), variant, expiresIn)
+	if err != nil {
+		h.writeDomainError(w, err, "Failed to create media download URL", correlationCtx.CorrelationID)
+		return
+	}
+
+	response := struct {
+		Data admin.SignedMediaURL `json:"data"`
+	}{
+		Data: admin.SignedMediaURL{
+			Url:       signed.URL,
+			Variant:   signed.Variant,
+			ExpiresAt: signed.ExpiresAt,
+		},
+	}
+
+	h.writeResponse(w, http.StatusOK, response, correlationCtx.CorrelationID)
+}
+
 // Stub implementations for other admin interface methods
 func (h *SimplifiedContractHandler) GetDashboardAnalytics(w http.ResponseWriter, r *http.Request, params admin.GetDashboardAnalyticsParams) {
 	http.Error(w, "Not implemented", http.StatusNotImplemented)
@@ -426,6 +912,14 @@ func (h *SimplifiedContractHandler) RefreshToken(w http.ResponseWriter, r *http.
 	http.Error(w, "Not implemented", http.StatusNotImplemented)
 }
 
+func (h *SimplifiedContractHandler) GetCurrentUserSessions(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, "Not implemented", http.StatusNotImplemented)
+}
+
+func (h *SimplifiedContractHandler) RevokeCurrentUserSession(w http.ResponseWriter, r *http.Request, sessionId admin.SessionIdParam) {
+	http.Error(w, "Not implemented", http.StatusNotImplemented)
+}
+
 func (h *SimplifiedContractHandler) GetAdminHealth(w http.ResponseWriter, r *http.Request) {
 	http.Error(w, "Not implemented", http.StatusNotImplemented)
 }
@@ -470,6 +964,18 @@ func (h *SimplifiedContractHandler) UpdateAdminUser(w http.ResponseWriter, r *ht
 	http.Error(w, "Not implemented", http.StatusNotImplemented)
 }
 
+func (h *SimplifiedContractHandler) GetAdminUserSessions(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	http.Error(w, "Not implemented", http.StatusNotImplemented)
+}
+
+func (h *SimplifiedContractHandler) RevokeAdminUserSessions(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	http.Error(w, "Not implemented", http.StatusNotImplemented)
+}
+
+func (h *SimplifiedContractHandler) RevokeAdminUserSession(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, sessionId admin.SessionIdParam) {
+	http.Error(w, "Not implemented", http.StatusNotImplemented)
+}
+
 // writeResponse writes a standardized JSON response
 func (h *SimplifiedContractHandler) writeResponse(w http.ResponseWriter, statusCode int, data interface{}, correlationID string) {
 	w.Header().Set("Content-Type", "application/json")
@@ -503,6 +1009,174 @@ func (h *SimplifiedContractHandler) writeErrorResponse(w http.ResponseWriter, st
 	json.NewEncoder(w).Encode(errorResponse)
 }
 
+// writeDomainError maps domain errors to their HTTP status
+func (h *SimplifiedContractHandler) writeDomainError(w http.ResponseWriter, err error, fallback string, correlationID string) {
+	switch {
+	case domain.IsValidationError(err):
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error(), correlationID)
+	case domain.IsUnauthorizedError(err):
+		h.writeErrorResponse(w, http.StatusUnauthorized, err.Error(), correlationID)
+	case domain.IsForbiddenError(err):
+		h.writeErrorResponse(w, http.StatusForbidden, err.Error(), correlationID)
+	case domain.IsNotFoundError(err):
+		h.writeErrorResponse(w, http.StatusNotFound, err.Error(), correlationID)
+	case domain.IsConflictError(err):
+		h.writeErrorResponse(w, http.StatusConflict, err.Error(), correlationID)
+	default:
+		h.writeErrorResponse(w, http.StatusInternalServerError, fallback, correlationID)
+	}
+}
+
+// convertScheduleToContract converts a publication schedule to contract-compliant ContentSchedule
+func (h *SimplifiedContractHandler) convertScheduleToContract(schedule *domain.PublicationSchedule) admin.ContentSchedule {
+	scheduleUUID, _ := uuid.Parse(schedule.ScheduleID)
+	entityUUID, _ := uuid.Parse(schedule.EntityID)
+
+	contractSchedule := admin.ContentSchedule{
+		ScheduleId:  openapi_types.UUID(scheduleUUID),
+		EntityType:  admin.ContentScheduleEntityType(schedule.EntityType),
+		EntityId:    openapi_types.UUID(entityUUID),
+		Action:      admin.ContentScheduleAction(schedule.Action),
+		RunAt:       schedule.RunAt,
+		Embargo:     schedule.Embargo,
+		Status:      admin.ContentScheduleStatus(schedule.Status),
+		Attempts:    schedule.Attempts,
+		CreatedOn:   schedule.CreatedOn,
+		CreatedBy:   schedule.CreatedBy,
+		CompletedOn: schedule.CompletedOn,
+		CancelledOn: schedule.CancelledOn,
+	}
+	if schedule.LastError != "" {
+		contractSchedule.LastError = &schedule.LastError
+	}
+	if schedule.CancelledBy != "" {
+		contractSchedule.CancelledBy = &schedule.CancelledBy
+	}
+
+	return contractSchedule
+}
+
+// writeReview writes a content review in the contract envelope
+func (h *SimplifiedContractHandler) writeReview(w http.ResponseWriter, statusCode int, review *domain.ContentReview, correlationID string) {
+	response := struct {
+		Data admin.ContentReview `json:"data"`
+	}{
+		Data: h.convertReviewToContract(review),
+	}
+
+	h.writeResponse(w, statusCode, response, correlationID)
+}
+
+// convertReviewToContract converts a content review to contract-compliant ContentReview
+func (h *SimplifiedContractHandler) convertReviewToContract(review *domain.ContentReview) admin.ContentReview {
+	entityUUID, _ := uuid.Parse(review.EntityID)
+	record := review.Review
+	if record == nil {
+		record = &domain.EditorialReview{}
+	}
+
+	contractReview := admin.ContentReview{
+		EntityType:  admin.ContentEntityType(review.EntityType),
+		EntityId:    openapi_types.UUID(entityUUID),
+		Title:       review.Title,
+		Status:      admin.ContentReviewStatus(review.Status),
+		Authors:     append([]string{}, record.Authors...),
+		Reviewers:   append([]string{}, record.Reviewers...),
+		Approvals:   make([]admin.ReviewApproval, len(record.Approvals)),
+		Comments:    make([]admin.ReviewComment, len(record.Comments)),
+		SubmittedOn: record.SubmittedOn,
+		RejectedOn:  record.RejectedOn,
+	}
+	for i, approval := range record.Approvals {
+		contractReview.Approvals[i] = admin.ReviewApproval{
+			ReviewerId: approval.ReviewerID,
+			ApprovedOn: approval.ApprovedOn,
+		}
+	}
+	for i, comment := range record.Comments {
+		commentUUID, _ := uuid.Parse(comment.CommentID)
+		contractReview.Comments[i] = admin.ReviewComment{
+			CommentId: openapi_types.UUID(commentUUID),
+			AuthorId:  comment.AuthorID,
+			Body:      comment.Body,
+			CreatedOn: comment.CreatedOn,
+		}
+	}
+	if record.SubmittedBy != "" {
+		contractReview.SubmittedBy = &record.SubmittedBy
+	}
+	if record.RejectedBy != "" {
+		contractReview.RejectedBy = &record.RejectedBy
+	}
+	if record.RejectionReason != "" {
+		contractReview.RejectionReason = &record.RejectionReason
+	}
+
+	return contractReview
+}
+
+// writeMedia writes a media asset in the contract envelope
+func (h *SimplifiedContractHandler) writeMedia(w http.ResponseWriter, statusCode int, asset *media.MediaAsset, correlationID string) {
+	response := struct {
+		Data admin.MediaAsset `json:"data"`
+	}{
+		Data: h.convertMediaToContract(asset),
+	}
+
+	h.writeResponse(w, statusCode, response, correlationID)
+}
+
+// convertMediaToContract converts a media asset to contract-compliant MediaAsset
+func (h *SimplifiedContractHandler) convertMediaToContract(asset *media.MediaAsset) admin.MediaAsset {
+	mediaUUID, _ := uuid.Parse(asset.MediaID)
+
+	contractAsset := admin.MediaAsset{
+		MediaId:     openapi_types.UUID(mediaUUID),
+		FileName:    asset.FileName,
+		ContentType: admin.MediaAssetContentType(asset.ContentType),
+		Kind:        admin.MediaAssetKind(asset.Kind),
+		SizeBytes:   asset.SizeBytes,
+		ContentHash: asset.ContentHash,
+		StoragePath: asset.StoragePath,
+		Derivatives: make([]admin.MediaDerivative, len(asset.Derivatives)),
+		Links:       make([]admin.MediaLink, len(asset.Links)),
+		CreatedOn:   asset.CreatedOn,
+		CreatedBy:   asset.CreatedBy,
+		ModifiedOn:  asset.ModifiedOn,
+	}
+	if asset.Width > 0 && asset.Height > 0 {
+		contractAsset.Width = &asset.Width
+		contractAsset.Height = &asset.Height
+	}
+	if asset.AltText != "" {
+		contractAsset.AltText = &asset.AltText
+	}
+	if asset.ModifiedBy != "" {
+		contractAsset.ModifiedBy = &asset.ModifiedBy
+	}
+	for i, derivative := range asset.Derivatives {
+		contractAsset.Derivatives[i] = admin.MediaDerivative{
+			Name:        derivative.Name,
+			Width:       derivative.Width,
+			Height:      derivative.Height,
+			ContentType: derivative.ContentType,
+			SizeBytes:   derivative.SizeBytes,
+			StoragePath: derivative.StoragePath,
+		}
+	}
+	for i, link := range asset.Links {
+		entityUUID, _ := uuid.Parse(link.EntityID)
+		contractAsset.Links[i] = admin.MediaLink{
+			EntityType: admin.ContentEntityType(link.EntityType),
+			EntityId:   openapi_types.UUID(entityUUID),
+			LinkedOn:   link.LinkedOn,
+			LinkedBy:   link.LinkedBy,
+		}
+	}
+
+	return contractAsset
+}
+
 // convertNewsToContract converts domain news to contract-compliant NewsArticle
 func (h *SimplifiedContractHandler) convertNewsToContract(news news.News) admin.NewsArticle {
 	newsUUID, _ := uuid.Parse(news.NewsID)
@@ -585,6 +1259,10 @@ func (h *SimplifiedContractHandler) convertPublishingStatus(status news.Publishi
 	switch status {
 	case news.PublishingStatusDraft:
 		return admin.NewsArticlePublishingStatusDraft
+	case news.PublishingStatusInReview:
+		return admin.NewsArticlePublishingStatusInReview
+	case news.PublishingStatusApproved:
+		return admin.NewsArticlePublishingStatusApproved
 	case news.PublishingStatusPublished:
 		return admin.NewsArticlePublishingStatusPublished
 	case news.PublishingStatusArchived:
@@ -599,8 +1277,11 @@ func RegisterSimplifiedContentRoutes(router *mux.Router,
 	newsService *news.NewsService,
 	researchService *research.ResearchService,
 	servicesService *services.ServicesService,
-	eventsService *events.EventsService) {
+	eventsService *events.EventsService,
+	scheduler *PublicationScheduler,
+	reviews *EditorialReviews,
+	mediaService *media.MediaService) {
 	
-	handler := NewSimplifiedContractHandler(newsService, researchService, servicesService, eventsService)
+	handler := NewSimplifiedContractHandler(newsService, researchService, servicesService, eventsService, scheduler, reviews, mediaService)
 	admin.HandlerFromMux(handler, router)
 }
\ No newline at end of file
@@ -27,6 +27,39 @@ type ServerInterface interface {
 	// Refresh access token
 	// (POST /auth/refresh)
 	RefreshToken(w http.ResponseWriter, r *http.Request)
+	// Get the active sessions of the signed in user
+	// (GET /auth/sessions)
+	GetCurrentUserSessions(w http.ResponseWriter, r *http.Request)
+	// Sign out one session of the signed in user
+	// (DELETE /auth/sessions/{session_id})
+	RevokeCurrentUserSession(w http.ResponseWriter, r *http.Request, sessionId SessionIdParam)
+	// List scheduled publishing transitions
+	// (GET /content/schedules)
+	ListContentSchedules(w http.ResponseWriter, r *http.Request, params ListContentSchedulesParams)
+	// Schedule publication or unpublication of content
+	// (POST /content/schedules)
+	CreateContentSchedule(w http.ResponseWriter, r *http.Request)
+	// Cancel a pending schedule
+	// (DELETE /content/schedules/{id})
+	CancelContentSchedule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get the editorial review of a content item
+	// (GET /content/{entity_type}/{id}/review)
+	GetContentReview(w http.ResponseWriter, r *http.Request, entityType ContentEntityTypeParam, id ContentIdParam)
+	// Approve content in review
+	// (POST /content/{entity_type}/{id}/review/approve)
+	ApproveContent(w http.ResponseWriter, r *http.Request, entityType ContentEntityTypeParam, id ContentIdParam)
+	// Comment on a content review
+	// (POST /content/{entity_type}/{id}/review/comments)
+	AddContentReviewComment(w http.ResponseWriter, r *http.Request, entityType ContentEntityTypeParam, id ContentIdParam)
+	// Reject content in review
+	// (POST /content/{entity_type}/{id}/review/reject)
+	RejectContent(w http.ResponseWriter, r *http.Request, entityType ContentEntityTypeParam, id ContentIdParam)
+	// Assign reviewers to content in review
+	// (POST /content/{entity_type}/{id}/review/reviewers)
+	AssignContentReviewers(w http.ResponseWriter, r *http.Request, entityType ContentEntityTypeParam, id ContentIdParam)
+	// Submit draft content for review
+	// (POST /content/{entity_type}/{id}/review/submit)
+	SubmitContentForReview(w http.ResponseWriter, r *http.Request, entityType ContentEntityTypeParam, id ContentIdParam)
 	// Get all events (admin)
 	// (GET /events)
 	GetEventsAdmin(w http.ResponseWriter, r *http.Request, params GetEventsAdminParams)
@@ -45,6 +78,27 @@ type ServerInterface interface {
 	// Update inquiry status
 	// (PUT /inquiries/{id})
 	UpdateInquiryStatus(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List media library assets
+	// (GET /media)
+	ListMedia(w http.ResponseWriter, r *http.Request, params ListMediaParams)
+	// Upload a file to the media library
+	// (POST /media)
+	UploadMedia(w http.ResponseWriter, r *http.Request)
+	// Delete a media library asset
+	// (DELETE /media/{id})
+	DeleteMedia(w http.ResponseWriter, r *http.Request, id MediaIdParam)
+	// Get a media library asset
+	// (GET /media/{id})
+	GetMedia(w http.ResponseWriter, r *http.Request, id MediaIdParam)
+	// Link an asset to a content item
+	// (POST /media/{id}/links)
+	LinkMedia(w http.ResponseWriter, r *http.Request, id MediaIdParam)
+	// Unlink an asset from a content item
+	// (DELETE /media/{id}/links/{entity_type}/{entity_id})
+	UnlinkMedia(w http.ResponseWriter, r *http.Request, id MediaIdParam, entityType ContentEntityType, entityId openapi_types.UUID)
+	// Create a signed download URL
+	// (GET /media/{id}/url)
+	GetMediaSignedUrl(w http.ResponseWriter, r *http.Request, id MediaIdParam, params GetMediaSignedUrlParams)
 	// Get all news articles (admin)
 	// (GET /news)
 	GetNewsAdmin(w http.ResponseWriter, r *http.Request, params GetNewsAdminParams)
@@ -65,7 +119,7 @@ type ServerInterface interface {
 	GetNewsArticleByIdAdmin(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 	// Update news article
 	// (PUT /news/{id})
-	UpdateNewsArticle(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	UpdateNewsArticle(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params UpdateNewsArticleParams)
 	// Publish news article
 	// (POST /news/{id}/publish)
 	PublishNewsArticle(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
@@ -105,6 +159,15 @@ type ServerInterface interface {
 	// Update admin user
 	// (PUT /users/{id})
 	UpdateAdminUser(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Sign an admin user out of every session
+	// (DELETE /users/{id}/sessions)
+	RevokeAdminUserSessions(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get the active sessions of an admin user
+	// (GET /users/{id}/sessions)
+	GetAdminUserSessions(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Sign an admin user out of one session
+	// (DELETE /users/{id}/sessions/{session_id})
+	RevokeAdminUserSession(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, sessionId SessionIdParam)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -201,6 +264,419 @@ func (siw *ServerInterfaceWrapper) RefreshToken(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
+// GetCurrentUserSessions operation middleware
+func (siw *ServerInterfaceWrapper) GetCurrentUserSessions(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCurrentUserSessions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevokeCurrentUserSession operation middleware
+func (siw *ServerInterfaceWrapper) RevokeCurrentUserSession(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "session_id" -------------
+	var sessionId SessionIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "session_id", mux.Vars(r)["session_id"], &sessionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "session_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeCurrentUserSession(w, r, sessionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListContentSchedules operation middleware
+func (siw *ServerInterfaceWrapper) ListContentSchedules(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListContentSchedulesParams
+
+	// ------------- Optional query parameter "entity_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entity_type", r.URL.Query(), &params.EntityType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity_type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "entity_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entity_id", r.URL.Query(), &params.EntityId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListContentSchedules(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateContentSchedule operation middleware
+func (siw *ServerInterfaceWrapper) CreateContentSchedule(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateContentSchedule(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CancelContentSchedule operation middleware
+func (siw *ServerInterfaceWrapper) CancelContentSchedule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelContentSchedule(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetContentReview operation middleware
+func (siw *ServerInterfaceWrapper) GetContentReview(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "entity_type" -------------
+	var entityType ContentEntityTypeParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entity_type", mux.Vars(r)["entity_type"], &entityType, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity_type", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id ContentIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetContentReview(w, r, entityType, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ApproveContent operation middleware
+func (siw *ServerInterfaceWrapper) ApproveContent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "entity_type" -------------
+	var entityType ContentEntityTypeParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entity_type", mux.Vars(r)["entity_type"], &entityType, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity_type", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id ContentIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApproveContent(w, r, entityType, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddContentReviewComment operation middleware
+func (siw *ServerInterfaceWrapper) AddContentReviewComment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "entity_type" -------------
+	var entityType ContentEntityTypeParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entity_type", mux.Vars(r)["entity_type"], &entityType, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity_type", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id ContentIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddContentReviewComment(w, r, entityType, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RejectContent operation middleware
+func (siw *ServerInterfaceWrapper) RejectContent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "entity_type" -------------
+	var entityType ContentEntityTypeParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entity_type", mux.Vars(r)["entity_type"], &entityType, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity_type", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id ContentIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RejectContent(w, r, entityType, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AssignContentReviewers operation middleware
+func (siw *ServerInterfaceWrapper) AssignContentReviewers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "entity_type" -------------
+	var entityType ContentEntityTypeParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entity_type", mux.Vars(r)["entity_type"], &entityType, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity_type", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id ContentIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AssignContentReviewers(w, r, entityType, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SubmitContentForReview operation middleware
+func (siw *ServerInterfaceWrapper) SubmitContentForReview(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "entity_type" -------------
+	var entityType ContentEntityTypeParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entity_type", mux.Vars(r)["entity_type"], &entityType, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity_type", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id ContentIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SubmitContentForReview(w, r, entityType, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetEventsAdmin operation middleware
 func (siw *ServerInterfaceWrapper) GetEventsAdmin(w http.ResponseWriter, r *http.Request) {
 
@@ -215,7 +691,118 @@ func (siw *ServerInterfaceWrapper) GetEventsAdmin(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetEventsAdminParams
+	var params GetEventsAdminParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "category_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category_id", r.URL.Query(), &params.CategoryId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "category_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEventsAdmin(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateEvent operation middleware
+func (siw *ServerInterfaceWrapper) CreateEvent(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateEvent(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminHealth(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminHealth(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetInquiries operation middleware
+func (siw *ServerInterfaceWrapper) GetInquiries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetInquiriesParams
 
 	// ------------- Optional query parameter "page" -------------
 
@@ -233,6 +820,14 @@ func (siw *ServerInterfaceWrapper) GetEventsAdmin(w http.ResponseWriter, r *http
 		return
 	}
 
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
 	// ------------- Optional query parameter "search" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
@@ -241,24 +836,205 @@ func (siw *ServerInterfaceWrapper) GetEventsAdmin(w http.ResponseWriter, r *http
 		return
 	}
 
+	// ------------- Optional query parameter "inquiry_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "inquiry_type", r.URL.Query(), &params.InquiryType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "inquiry_type", Err: err})
+		return
+	}
+
 	// ------------- Optional query parameter "status" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "priority" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "priority", r.URL.Query(), &params.Priority)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "priority", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "urgency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "urgency", r.URL.Query(), &params.Urgency)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "urgency", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "media_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "media_type", r.URL.Query(), &params.MediaType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "media_type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "deadline_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "deadline_from", r.URL.Query(), &params.DeadlineFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deadline_from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "deadline_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "deadline_to", r.URL.Query(), &params.DeadlineTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deadline_to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "created_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_from", r.URL.Query(), &params.CreatedFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "created_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_to", r.URL.Query(), &params.CreatedTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetInquiries(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetInquiryById operation middleware
+func (siw *ServerInterfaceWrapper) GetInquiryById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetInquiryById(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateInquiryStatus operation middleware
+func (siw *ServerInterfaceWrapper) UpdateInquiryStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateInquiryStatus(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListMedia operation middleware
+func (siw *ServerInterfaceWrapper) ListMedia(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMediaParams
+
+	// ------------- Optional query parameter "kind" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "kind", r.URL.Query(), &params.Kind)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "entity_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entity_type", r.URL.Query(), &params.EntityType)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity_type", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "category_id" -------------
+	// ------------- Optional query parameter "entity_id" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "category_id", r.URL.Query(), &params.CategoryId)
+	err = runtime.BindQueryParameter("form", true, false, "entity_id", r.URL.Query(), &params.EntityId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "category_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetEventsAdmin(w, r, params)
+		siw.Handler.ListMedia(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -268,8 +1044,8 @@ func (siw *ServerInterfaceWrapper) GetEventsAdmin(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// CreateEvent operation middleware
-func (siw *ServerInterfaceWrapper) CreateEvent(w http.ResponseWriter, r *http.Request) {
+// UploadMedia operation middleware
+func (siw *ServerInterfaceWrapper) UploadMedia(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -280,7 +1056,7 @@ func (siw *ServerInterfaceWrapper) CreateEvent(w http.ResponseWriter, r *http.Re
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateEvent(w, r)
+		siw.Handler.UploadMedia(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -290,11 +1066,30 @@ func (siw *ServerInterfaceWrapper) CreateEvent(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// GetAdminHealth operation middleware
-func (siw *ServerInterfaceWrapper) GetAdminHealth(w http.ResponseWriter, r *http.Request) {
+// DeleteMedia operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMedia(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id MediaIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAdminHealth(w, r)
+		siw.Handler.DeleteMedia(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -304,11 +1099,20 @@ func (siw *ServerInterfaceWrapper) GetAdminHealth(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// GetInquiries operation middleware
-func (siw *ServerInterfaceWrapper) GetInquiries(w http.ResponseWriter, r *http.Request) {
+// GetMedia operation middleware
+func (siw *ServerInterfaceWrapper) GetMedia(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
+	// ------------- Path parameter "id" -------------
+	var id MediaIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -317,51 +1121,41 @@ func (siw *ServerInterfaceWrapper) GetInquiries(w http.ResponseWriter, r *http.R
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetInquiriesParams
-
-	// ------------- Optional query parameter "page" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMedia(w, r, id)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
+// LinkMedia operation middleware
+func (siw *ServerInterfaceWrapper) LinkMedia(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Optional query parameter "search" -------------
+	var err error
 
-	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	// ------------- Path parameter "id" -------------
+	var id MediaIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "inquiry_type" -------------
+	ctx := r.Context()
 
-	err = runtime.BindQueryParameter("form", true, false, "inquiry_type", r.URL.Query(), &params.InquiryType)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "inquiry_type", Err: err})
-		return
-	}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	// ------------- Optional query parameter "status" -------------
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
-		return
-	}
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetInquiries(w, r, params)
+		siw.Handler.LinkMedia(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -371,13 +1165,13 @@ func (siw *ServerInterfaceWrapper) GetInquiries(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// GetInquiryById operation middleware
-func (siw *ServerInterfaceWrapper) GetInquiryById(w http.ResponseWriter, r *http.Request) {
+// UnlinkMedia operation middleware
+func (siw *ServerInterfaceWrapper) UnlinkMedia(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	var id MediaIdParam
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -385,6 +1179,24 @@ func (siw *ServerInterfaceWrapper) GetInquiryById(w http.ResponseWriter, r *http
 		return
 	}
 
+	// ------------- Path parameter "entity_type" -------------
+	var entityType ContentEntityType
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entity_type", mux.Vars(r)["entity_type"], &entityType, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity_type", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "entity_id" -------------
+	var entityId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entity_id", mux.Vars(r)["entity_id"], &entityId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity_id", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -394,7 +1206,7 @@ func (siw *ServerInterfaceWrapper) GetInquiryById(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetInquiryById(w, r, id)
+		siw.Handler.UnlinkMedia(w, r, id, entityType, entityId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -404,13 +1216,13 @@ func (siw *ServerInterfaceWrapper) GetInquiryById(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateInquiryStatus operation middleware
-func (siw *ServerInterfaceWrapper) UpdateInquiryStatus(w http.ResponseWriter, r *http.Request) {
+// GetMediaSignedUrl operation middleware
+func (siw *ServerInterfaceWrapper) GetMediaSignedUrl(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	var id MediaIdParam
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -426,8 +1238,27 @@ func (siw *ServerInterfaceWrapper) UpdateInquiryStatus(w http.ResponseWriter, r
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMediaSignedUrlParams
+
+	// ------------- Optional query parameter "variant" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "variant", r.URL.Query(), &params.Variant)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "variant", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "expires_in" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "expires_in", r.URL.Query(), &params.ExpiresIn)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "expires_in", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateInquiryStatus(w, r, id)
+		siw.Handler.GetMediaSignedUrl(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -469,6 +1300,14 @@ func (siw *ServerInterfaceWrapper) GetNewsAdmin(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
 	// ------------- Optional query parameter "search" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
@@ -650,6 +1489,30 @@ func (siw *ServerInterfaceWrapper) UpdateNewsArticle(w http.ResponseWriter, r *h
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateNewsArticleParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatchParam
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -659,7 +1522,7 @@ func (siw *ServerInterfaceWrapper) UpdateNewsArticle(w http.ResponseWriter, r *h
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateNewsArticle(w, r, id)
+		siw.Handler.UpdateNewsArticle(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -767,6 +1630,14 @@ func (siw *ServerInterfaceWrapper) GetResearchAdmin(w http.ResponseWriter, r *ht
 		return
 	}
 
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
 	// ------------- Optional query parameter "search" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
@@ -856,6 +1727,14 @@ func (siw *ServerInterfaceWrapper) GetServicesAdmin(w http.ResponseWriter, r *ht
 		return
 	}
 
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
 	// ------------- Optional query parameter "search" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
@@ -1145,6 +2024,114 @@ func (siw *ServerInterfaceWrapper) UpdateAdminUser(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
+// RevokeAdminUserSessions operation middleware
+func (siw *ServerInterfaceWrapper) RevokeAdminUserSessions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeAdminUserSessions(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminUserSessions operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminUserSessions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminUserSessions(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevokeAdminUserSession operation middleware
+func (siw *ServerInterfaceWrapper) RevokeAdminUserSession(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "session_id" -------------
+	var sessionId SessionIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "session_id", mux.Vars(r)["session_id"], &sessionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "session_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeAdminUserSession(w, r, id, sessionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -1266,6 +2253,28 @@ func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.H
 
 	r.HandleFunc(options.BaseURL+"/auth/refresh", wrapper.RefreshToken).Methods("POST")
 
+	r.HandleFunc(options.BaseURL+"/auth/sessions", wrapper.GetCurrentUserSessions).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/auth/sessions/{session_id}", wrapper.RevokeCurrentUserSession).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/content/schedules", wrapper.ListContentSchedules).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/content/schedules", wrapper.CreateContentSchedule).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/content/schedules/{id}", wrapper.CancelContentSchedule).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/content/{entity_type}/{id}/review", wrapper.GetContentReview).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/content/{entity_type}/{id}/review/approve", wrapper.ApproveContent).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/content/{entity_type}/{id}/review/comments", wrapper.AddContentReviewComment).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/content/{entity_type}/{id}/review/reject", wrapper.RejectContent).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/content/{entity_type}/{id}/review/reviewers", wrapper.AssignContentReviewers).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/content/{entity_type}/{id}/review/submit", wrapper.SubmitContentForReview).Methods("POST")
+
 	r.HandleFunc(options.BaseURL+"/events", wrapper.GetEventsAdmin).Methods("GET")
 
 	r.HandleFunc(options.BaseURL+"/events", wrapper.CreateEvent).Methods("POST")
@@ -1278,6 +2287,20 @@ func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.H
 
 	r.HandleFunc(options.BaseURL+"/inquiries/{id}", wrapper.UpdateInquiryStatus).Methods("PUT")
 
+	r.HandleFunc(options.BaseURL+"/media", wrapper.ListMedia).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/media", wrapper.UploadMedia).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/media/{id}", wrapper.DeleteMedia).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/media/{id}", wrapper.GetMedia).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/media/{id}/links", wrapper.LinkMedia).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/media/{id}/links/{entity_type}/{entity_id}", wrapper.UnlinkMedia).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/media/{id}/url", wrapper.GetMediaSignedUrl).Methods("GET")
+
 	r.HandleFunc(options.BaseURL+"/news", wrapper.GetNewsAdmin).Methods("GET")
 
 	r.HandleFunc(options.BaseURL+"/news", wrapper.CreateNewsArticle).Methods("POST")
@@ -1318,5 +2341,11 @@ func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.H
 
 	r.HandleFunc(options.BaseURL+"/users/{id}", wrapper.UpdateAdminUser).Methods("PUT")
 
+	r.HandleFunc(options.BaseURL+"/users/{id}/sessions", wrapper.RevokeAdminUserSessions).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/users/{id}/sessions", wrapper.GetAdminUserSessions).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/users/{id}/sessions/{session_id}", wrapper.RevokeAdminUserSession).Methods("DELETE")
+
 	return r
 }
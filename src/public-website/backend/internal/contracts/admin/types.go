@@ -29,6 +29,46 @@ const (
 	AdminUserStatusSuspended AdminUserStatus = "suspended"
 )
 
+// Defines values for ContentEntityType.
+const (
+	ContentEntityTypeEvent    ContentEntityType = "event"
+	ContentEntityTypeNews     ContentEntityType = "news"
+	ContentEntityTypeResearch ContentEntityType = "research"
+	ContentEntityTypeService  ContentEntityType = "service"
+)
+
+// Defines values for ContentReviewStatus.
+const (
+	ContentReviewStatusApproved  ContentReviewStatus = "approved"
+	ContentReviewStatusArchived  ContentReviewStatus = "archived"
+	ContentReviewStatusDraft     ContentReviewStatus = "draft"
+	ContentReviewStatusInReview  ContentReviewStatus = "in_review"
+	ContentReviewStatusPublished ContentReviewStatus = "published"
+)
+
+// Defines values for ContentScheduleAction.
+const (
+	ContentScheduleActionPublish   ContentScheduleAction = "publish"
+	ContentScheduleActionUnpublish ContentScheduleAction = "unpublish"
+)
+
+// Defines values for ContentScheduleEntityType.
+const (
+	ContentScheduleEntityTypeEvent    ContentScheduleEntityType = "event"
+	ContentScheduleEntityTypeNews     ContentScheduleEntityType = "news"
+	ContentScheduleEntityTypeResearch ContentScheduleEntityType = "research"
+	ContentScheduleEntityTypeService  ContentScheduleEntityType = "service"
+)
+
+// Defines values for ContentScheduleStatus.
+const (
+	ContentScheduleStatusCancelled ContentScheduleStatus = "cancelled"
+	ContentScheduleStatusCompleted ContentScheduleStatus = "completed"
+	ContentScheduleStatusFailed    ContentScheduleStatus = "failed"
+	ContentScheduleStatusPending   ContentScheduleStatus = "pending"
+	ContentScheduleStatusRunning   ContentScheduleStatus = "running"
+)
+
 // Defines values for CreateAdminUserRequestRole.
 const (
 	CreateAdminUserRequestRoleAdmin     CreateAdminUserRequestRole = "admin"
@@ -37,6 +77,20 @@ const (
 	CreateAdminUserRequestRoleViewer    CreateAdminUserRequestRole = "viewer"
 )
 
+// Defines values for CreateContentScheduleRequestAction.
+const (
+	CreateContentScheduleRequestActionPublish   CreateContentScheduleRequestAction = "publish"
+	CreateContentScheduleRequestActionUnpublish CreateContentScheduleRequestAction = "unpublish"
+)
+
+// Defines values for CreateContentScheduleRequestEntityType.
+const (
+	CreateContentScheduleRequestEntityTypeEvent    CreateContentScheduleRequestEntityType = "event"
+	CreateContentScheduleRequestEntityTypeNews     CreateContentScheduleRequestEntityType = "news"
+	CreateContentScheduleRequestEntityTypeResearch CreateContentScheduleRequestEntityType = "research"
+	CreateContentScheduleRequestEntityTypeService  CreateContentScheduleRequestEntityType = "service"
+)
+
 // Defines values for CreateEventRequestEventType.
 const (
 	CreateEventRequestEventTypeCommunity   CreateEventRequestEventType = "community"
@@ -127,9 +181,11 @@ const (
 
 // Defines values for EventPublishingStatus.
 const (
+	EventPublishingStatusApproved  EventPublishingStatus = "approved"
 	EventPublishingStatusArchived  EventPublishingStatus = "archived"
 	EventPublishingStatusCancelled EventPublishingStatus = "cancelled"
 	EventPublishingStatusDraft     EventPublishingStatus = "draft"
+	EventPublishingStatusInReview  EventPublishingStatus = "in_review"
 	EventPublishingStatusPublished EventPublishingStatus = "published"
 )
 
@@ -157,6 +213,26 @@ const (
 	InquiryStatusPending    InquiryStatus = "pending"
 )
 
+// Defines values for MediaAssetContentType.
+const (
+	MediaAssetContentTypeApplicationpdf MediaAssetContentType = "application/pdf"
+	MediaAssetContentTypeAudiompeg      MediaAssetContentType = "audio/mpeg"
+	MediaAssetContentTypeImagegif       MediaAssetContentType = "image/gif"
+	MediaAssetContentTypeImagejpeg      MediaAssetContentType = "image/jpeg"
+	MediaAssetContentTypeImagepng       MediaAssetContentType = "image/png"
+	MediaAssetContentTypeImagewebp      MediaAssetContentType = "image/webp"
+	MediaAssetContentTypeVideomp4       MediaAssetContentType = "video/mp4"
+	MediaAssetContentTypeVideowebm      MediaAssetContentType = "video/webm"
+)
+
+// Defines values for MediaAssetKind.
+const (
+	MediaAssetKindAudio    MediaAssetKind = "audio"
+	MediaAssetKindDocument MediaAssetKind = "document"
+	MediaAssetKindImage    MediaAssetKind = "image"
+	MediaAssetKindVideo    MediaAssetKind = "video"
+)
+
 // Defines values for NewsArticleNewsType.
 const (
 	NewsArticleNewsTypeAlert        NewsArticleNewsType = "alert"
@@ -177,15 +253,19 @@ const (
 
 // Defines values for NewsArticlePublishingStatus.
 const (
+	NewsArticlePublishingStatusApproved  NewsArticlePublishingStatus = "approved"
 	NewsArticlePublishingStatusArchived  NewsArticlePublishingStatus = "archived"
 	NewsArticlePublishingStatusDraft     NewsArticlePublishingStatus = "draft"
+	NewsArticlePublishingStatusInReview  NewsArticlePublishingStatus = "in_review"
 	NewsArticlePublishingStatusPublished NewsArticlePublishingStatus = "published"
 )
 
 // Defines values for ResearchPublicationPublishingStatus.
 const (
+	ResearchPublicationPublishingStatusApproved  ResearchPublicationPublishingStatus = "approved"
 	ResearchPublicationPublishingStatusArchived  ResearchPublicationPublishingStatus = "archived"
 	ResearchPublicationPublishingStatusDraft     ResearchPublicationPublishingStatus = "draft"
+	ResearchPublicationPublishingStatusInReview  ResearchPublicationPublishingStatus = "in_review"
 	ResearchPublicationPublishingStatusPublished ResearchPublicationPublishingStatus = "published"
 )
 
@@ -219,8 +299,10 @@ const (
 
 // Defines values for ServicePublishingStatus.
 const (
+	ServicePublishingStatusApproved  ServicePublishingStatus = "approved"
 	ServicePublishingStatusArchived  ServicePublishingStatus = "archived"
 	ServicePublishingStatusDraft     ServicePublishingStatus = "draft"
+	ServicePublishingStatusInReview  ServicePublishingStatus = "in_review"
 	ServicePublishingStatusPublished ServicePublishingStatus = "published"
 )
 
@@ -277,8 +359,10 @@ const (
 
 // Defines values for StatusParam.
 const (
+	StatusParamApproved  StatusParam = "approved"
 	StatusParamArchived  StatusParam = "archived"
 	StatusParamDraft     StatusParam = "draft"
+	StatusParamInReview  StatusParam = "in_review"
 	StatusParamPublished StatusParam = "published"
 )
 
@@ -291,10 +375,45 @@ const (
 	GetDashboardAnalyticsParamsPeriodYear    GetDashboardAnalyticsParamsPeriod = "year"
 )
 
+// Defines values for ListContentSchedulesParamsEntityType.
+const (
+	ListContentSchedulesParamsEntityTypeEvent    ListContentSchedulesParamsEntityType = "event"
+	ListContentSchedulesParamsEntityTypeNews     ListContentSchedulesParamsEntityType = "news"
+	ListContentSchedulesParamsEntityTypeResearch ListContentSchedulesParamsEntityType = "research"
+	ListContentSchedulesParamsEntityTypeService  ListContentSchedulesParamsEntityType = "service"
+)
+
+// Defines values for ListContentSchedulesParamsStatus.
+const (
+	ListContentSchedulesParamsStatusCancelled ListContentSchedulesParamsStatus = "cancelled"
+	ListContentSchedulesParamsStatusCompleted ListContentSchedulesParamsStatus = "completed"
+	ListContentSchedulesParamsStatusFailed    ListContentSchedulesParamsStatus = "failed"
+	ListContentSchedulesParamsStatusPending   ListContentSchedulesParamsStatus = "pending"
+	ListContentSchedulesParamsStatusRunning   ListContentSchedulesParamsStatus = "running"
+)
+
+// Defines values for ListMediaParamsKind.
+const (
+	ListMediaParamsKindAudio    ListMediaParamsKind = "audio"
+	ListMediaParamsKindDocument ListMediaParamsKind = "document"
+	ListMediaParamsKindImage    ListMediaParamsKind = "image"
+	ListMediaParamsKindVideo    ListMediaParamsKind = "video"
+)
+
+// Defines values for ListMediaParamsEntityType.
+const (
+	ListMediaParamsEntityTypeEvent    ListMediaParamsEntityType = "event"
+	ListMediaParamsEntityTypeNews     ListMediaParamsEntityType = "news"
+	ListMediaParamsEntityTypeResearch ListMediaParamsEntityType = "research"
+	ListMediaParamsEntityTypeService  ListMediaParamsEntityType = "service"
+)
+
 // Defines values for GetEventsAdminParamsStatus.
 const (
+	GetEventsAdminParamsStatusApproved  GetEventsAdminParamsStatus = "approved"
 	GetEventsAdminParamsStatusArchived  GetEventsAdminParamsStatus = "archived"
 	GetEventsAdminParamsStatusDraft     GetEventsAdminParamsStatus = "draft"
+	GetEventsAdminParamsStatusInReview  GetEventsAdminParamsStatus = "in_review"
 	GetEventsAdminParamsStatusPublished GetEventsAdminParamsStatus = "published"
 )
 
@@ -306,6 +425,32 @@ const (
 	GetInquiriesParamsInquiryTypeVolunteer GetInquiriesParamsInquiryType = "volunteer"
 )
 
+// Defines values for GetInquiriesParamsMediaType.
+const (
+	GetInquiriesParamsMediaTypeDigital        GetInquiriesParamsMediaType = "digital"
+	GetInquiriesParamsMediaTypeMedicalJournal GetInquiriesParamsMediaType = "medical-journal"
+	GetInquiriesParamsMediaTypeOther          GetInquiriesParamsMediaType = "other"
+	GetInquiriesParamsMediaTypePodcast        GetInquiriesParamsMediaType = "podcast"
+	GetInquiriesParamsMediaTypePrint          GetInquiriesParamsMediaType = "print"
+	GetInquiriesParamsMediaTypeRadio          GetInquiriesParamsMediaType = "radio"
+	GetInquiriesParamsMediaTypeTelevision     GetInquiriesParamsMediaType = "television"
+)
+
+// Defines values for GetInquiriesParamsPriority.
+const (
+	GetInquiriesParamsPriorityHigh   GetInquiriesParamsPriority = "high"
+	GetInquiriesParamsPriorityLow    GetInquiriesParamsPriority = "low"
+	GetInquiriesParamsPriorityMedium GetInquiriesParamsPriority = "medium"
+	GetInquiriesParamsPriorityUrgent GetInquiriesParamsPriority = "urgent"
+)
+
+// Defines values for GetInquiriesParamsSort.
+const (
+	GetInquiriesParamsSortCreatedAt GetInquiriesParamsSort = "created_at"
+	GetInquiriesParamsSortDeadline  GetInquiriesParamsSort = "deadline"
+	GetInquiriesParamsSortUrgency   GetInquiriesParamsSort = "urgency"
+)
+
 // Defines values for GetInquiriesParamsStatus.
 const (
 	GetInquiriesParamsStatusClosed     GetInquiriesParamsStatus = "closed"
@@ -314,6 +459,13 @@ const (
 	GetInquiriesParamsStatusPending    GetInquiriesParamsStatus = "pending"
 )
 
+// Defines values for GetInquiriesParamsUrgency.
+const (
+	GetInquiriesParamsUrgencyHigh   GetInquiriesParamsUrgency = "high"
+	GetInquiriesParamsUrgencyLow    GetInquiriesParamsUrgency = "low"
+	GetInquiriesParamsUrgencyMedium GetInquiriesParamsUrgency = "medium"
+)
+
 // Defines values for UpdateInquiryStatusJSONBodyStatus.
 const (
 	UpdateInquiryStatusJSONBodyStatusClosed     UpdateInquiryStatusJSONBodyStatus = "closed"
@@ -324,22 +476,28 @@ const (
 
 // Defines values for GetNewsAdminParamsStatus.
 const (
+	GetNewsAdminParamsStatusApproved  GetNewsAdminParamsStatus = "approved"
 	GetNewsAdminParamsStatusArchived  GetNewsAdminParamsStatus = "archived"
 	GetNewsAdminParamsStatusDraft     GetNewsAdminParamsStatus = "draft"
+	GetNewsAdminParamsStatusInReview  GetNewsAdminParamsStatus = "in_review"
 	GetNewsAdminParamsStatusPublished GetNewsAdminParamsStatus = "published"
 )
 
 // Defines values for GetResearchAdminParamsStatus.
 const (
+	GetResearchAdminParamsStatusApproved  GetResearchAdminParamsStatus = "approved"
 	GetResearchAdminParamsStatusArchived  GetResearchAdminParamsStatus = "archived"
 	GetResearchAdminParamsStatusDraft     GetResearchAdminParamsStatus = "draft"
+	GetResearchAdminParamsStatusInReview  GetResearchAdminParamsStatus = "in_review"
 	GetResearchAdminParamsStatusPublished GetResearchAdminParamsStatus = "published"
 )
 
 // Defines values for GetServicesAdminParamsStatus.
 const (
+	GetServicesAdminParamsStatusApproved  GetServicesAdminParamsStatus = "approved"
 	GetServicesAdminParamsStatusArchived  GetServicesAdminParamsStatus = "archived"
 	GetServicesAdminParamsStatusDraft     GetServicesAdminParamsStatus = "draft"
+	GetServicesAdminParamsStatusInReview  GetServicesAdminParamsStatus = "in_review"
 	GetServicesAdminParamsStatusPublished GetServicesAdminParamsStatus = "published"
 )
 
@@ -358,6 +516,22 @@ const (
 	GetAdminUsersParamsStatusSuspended GetAdminUsersParamsStatus = "suspended"
 )
 
+// AdminSession A signed in admin session; it ends when idle past idle_expires_at or at expires_at
+type AdminSession struct {
+	CreatedOn time.Time `json:"created_on"`
+
+	// Current Whether this is the session making the request
+	Current       bool               `json:"current"`
+	ExpiresAt     time.Time          `json:"expires_at"`
+	IdleExpiresAt time.Time          `json:"idle_expires_at"`
+	IpAddress     *string            `json:"ip_address,omitempty"`
+	LastSeenAt    time.Time          `json:"last_seen_at"`
+	RememberMe    bool               `json:"remember_me"`
+	SessionId     openapi_types.UUID `json:"session_id"`
+	UserAgent     *string            `json:"user_agent,omitempty"`
+	UserId        openapi_types.UUID `json:"user_id"`
+}
+
 // AdminUser defines model for AdminUser.
 type AdminUser struct {
 	CreatedBy *openapi_types.UUID `json:"created_by"`
@@ -377,6 +551,66 @@ type AdminUserRole string
 // AdminUserStatus defines model for AdminUser.Status.
 type AdminUserStatus string
 
+// AssignContentReviewersRequest defines model for AssignContentReviewersRequest.
+type AssignContentReviewersRequest struct {
+	ReviewerIds []string `json:"reviewer_ids"`
+}
+
+// ContentEntityType defines model for ContentEntityType.
+type ContentEntityType string
+
+// ContentReview defines model for ContentReview.
+type ContentReview struct {
+	Approvals       []ReviewApproval    `json:"approvals"`
+	Authors         []string            `json:"authors"`
+	Comments        []ReviewComment     `json:"comments"`
+	EntityId        openapi_types.UUID  `json:"entity_id"`
+	EntityType      ContentEntityType   `json:"entity_type"`
+	RejectedBy      *string             `json:"rejected_by,omitempty"`
+	RejectedOn      *time.Time          `json:"rejected_on,omitempty"`
+	RejectionReason *string             `json:"rejection_reason,omitempty"`
+	Reviewers       []string            `json:"reviewers"`
+	Status          ContentReviewStatus `json:"status"`
+	SubmittedBy     *string             `json:"submitted_by,omitempty"`
+	SubmittedOn     *time.Time          `json:"submitted_on,omitempty"`
+	Title           string              `json:"title"`
+}
+
+// ContentReviewStatus defines model for ContentReview.Status.
+type ContentReviewStatus string
+
+// ContentReviewCommentRequest defines model for ContentReviewCommentRequest.
+type ContentReviewCommentRequest struct {
+	Body string `json:"body"`
+}
+
+// ContentSchedule defines model for ContentSchedule.
+type ContentSchedule struct {
+	Action      ContentScheduleAction     `json:"action"`
+	Attempts    int                       `json:"attempts"`
+	CancelledBy *string                   `json:"cancelled_by,omitempty"`
+	CancelledOn *time.Time                `json:"cancelled_on,omitempty"`
+	CompletedOn *time.Time                `json:"completed_on,omitempty"`
+	CreatedBy   string                    `json:"created_by"`
+	CreatedOn   time.Time                 `json:"created_on"`
+	Embargo     bool                      `json:"embargo"`
+	EntityId    openapi_types.UUID        `json:"entity_id"`
+	EntityType  ContentScheduleEntityType `json:"entity_type"`
+	LastError   *string                   `json:"last_error,omitempty"`
+	RunAt       time.Time                 `json:"run_at"`
+	ScheduleId  openapi_types.UUID        `json:"schedule_id"`
+	Status      ContentScheduleStatus     `json:"status"`
+}
+
+// ContentScheduleAction defines model for ContentSchedule.Action.
+type ContentScheduleAction string
+
+// ContentScheduleEntityType defines model for ContentSchedule.EntityType.
+type ContentScheduleEntityType string
+
+// ContentScheduleStatus defines model for ContentSchedule.Status.
+type ContentScheduleStatus string
+
 // CreateAdminUserRequest defines model for CreateAdminUserRequest.
 type CreateAdminUserRequest struct {
 	Email     openapi_types.Email        `json:"email"`
@@ -389,6 +623,21 @@ type CreateAdminUserRequest struct {
 // CreateAdminUserRequestRole defines model for CreateAdminUserRequest.Role.
 type CreateAdminUserRequestRole string
 
+// CreateContentScheduleRequest defines model for CreateContentScheduleRequest.
+type CreateContentScheduleRequest struct {
+	Action     CreateContentScheduleRequestAction     `json:"action"`
+	Embargo    *bool                                  `json:"embargo,omitempty"`
+	EntityId   openapi_types.UUID                     `json:"entity_id"`
+	EntityType CreateContentScheduleRequestEntityType `json:"entity_type"`
+	RunAt      time.Time                              `json:"run_at"`
+}
+
+// CreateContentScheduleRequestAction defines model for CreateContentScheduleRequest.Action.
+type CreateContentScheduleRequestAction string
+
+// CreateContentScheduleRequestEntityType defines model for CreateContentScheduleRequest.EntityType.
+type CreateContentScheduleRequestEntityType string
+
 // CreateEventRequest defines model for CreateEventRequest.
 type CreateEventRequest struct {
 	CategoryId  openapi_types.UUID          `json:"category_id"`
@@ -700,6 +949,60 @@ type InquiryInquiryType string
 // InquiryStatus defines model for Inquiry.Status.
 type InquiryStatus string
 
+// LinkMediaRequest defines model for LinkMediaRequest.
+type LinkMediaRequest struct {
+	EntityId   openapi_types.UUID `json:"entity_id"`
+	EntityType ContentEntityType  `json:"entity_type"`
+}
+
+// MediaAsset defines model for MediaAsset.
+type MediaAsset struct {
+	AltText *string `json:"alt_text,omitempty"`
+
+	// ContentHash SHA-256 of the file, hex encoded
+	ContentHash string                `json:"content_hash"`
+	ContentType MediaAssetContentType `json:"content_type"`
+	CreatedBy   string                `json:"created_by"`
+	CreatedOn   time.Time             `json:"created_on"`
+	Derivatives []MediaDerivative     `json:"derivatives"`
+	FileName    string                `json:"file_name"`
+	Height      *int                  `json:"height,omitempty"`
+	Kind        MediaAssetKind        `json:"kind"`
+	Links       []MediaLink           `json:"links"`
+	MediaId     openapi_types.UUID    `json:"media_id"`
+	ModifiedBy  *string               `json:"modified_by,omitempty"`
+	ModifiedOn  *time.Time            `json:"modified_on,omitempty"`
+	SizeBytes   int64                 `json:"size_bytes"`
+	StoragePath string                `json:"storage_path"`
+	Width       *int                  `json:"width,omitempty"`
+}
+
+// MediaAssetContentType defines model for MediaAsset.ContentType.
+type MediaAssetContentType string
+
+// MediaAssetKind defines model for MediaAsset.Kind.
+type MediaAssetKind string
+
+// MediaDerivative defines model for MediaDerivative.
+type MediaDerivative struct {
+	ContentType string `json:"content_type"`
+	Height      int    `json:"height"`
+
+	// Name thumbnail, or w followed by the width for responsive renditions
+	Name        string `json:"name"`
+	SizeBytes   int64  `json:"size_bytes"`
+	StoragePath string `json:"storage_path"`
+	Width       int    `json:"width"`
+}
+
+// MediaLink defines model for MediaLink.
+type MediaLink struct {
+	EntityId   openapi_types.UUID `json:"entity_id"`
+	EntityType ContentEntityType  `json:"entity_type"`
+	LinkedBy   string             `json:"linked_by"`
+	LinkedOn   time.Time          `json:"linked_on"`
+}
+
 // NewsArticle defines model for NewsArticle.
 type NewsArticle struct {
 	// AuthorName Article author name
@@ -806,8 +1109,16 @@ type PaginationInfo struct {
 	HasNext      bool `json:"has_next"`
 	HasPrevious  bool `json:"has_previous"`
 	ItemsPerPage int  `json:"items_per_page"`
-	TotalItems   int  `json:"total_items"`
-	TotalPages   int  `json:"total_pages"`
+
+	// NextCursor Opaque cursor for the following page, omitted on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+	TotalItems int     `json:"total_items"`
+	TotalPages int     `json:"total_pages"`
+}
+
+// RejectContentRequest defines model for RejectContentRequest.
+type RejectContentRequest struct {
+	Reason string `json:"reason"`
 }
 
 // ResearchCategory defines model for ResearchCategory.
@@ -939,6 +1250,20 @@ type ResearchPublicationResearchType string
 // ResearchPublicationStudyStatus Research study status
 type ResearchPublicationStudyStatus string
 
+// ReviewApproval defines model for ReviewApproval.
+type ReviewApproval struct {
+	ApprovedOn time.Time `json:"approved_on"`
+	ReviewerId string    `json:"reviewer_id"`
+}
+
+// ReviewComment defines model for ReviewComment.
+type ReviewComment struct {
+	AuthorId  string             `json:"author_id"`
+	Body      string             `json:"body"`
+	CommentId openapi_types.UUID `json:"comment_id"`
+	CreatedOn time.Time          `json:"created_on"`
+}
+
 // Service defines model for Service.
 type Service struct {
 	// AvailabilityStatus Current availability status
@@ -1048,6 +1373,18 @@ type ServiceCategory struct {
 	SortOrder int `json:"sort_order"`
 }
 
+// SignedMediaURL defines model for SignedMediaURL.
+type SignedMediaURL struct {
+	ExpiresAt time.Time `json:"expires_at"`
+	Url       string    `json:"url"`
+	Variant   string    `json:"variant"`
+}
+
+// SubmitContentReviewRequest defines model for SubmitContentReviewRequest.
+type SubmitContentReviewRequest struct {
+	ReviewerIds *[]string `json:"reviewer_ids,omitempty"`
+}
+
 // SystemSettings defines model for SystemSettings.
 type SystemSettings struct {
 	NotificationSettings struct {
@@ -1125,18 +1462,44 @@ type UpdateSystemSettingsRequest struct {
 	} `json:"site_settings,omitempty"`
 }
 
+// UploadMediaRequest defines model for UploadMediaRequest.
+type UploadMediaRequest struct {
+	AltText    *string             `json:"alt_text,omitempty"`
+	EntityId   *openapi_types.UUID `json:"entity_id,omitempty"`
+	EntityType *ContentEntityType  `json:"entity_type,omitempty"`
+	File       openapi_types.File  `json:"file"`
+}
+
 // CategoryIdParam defines model for CategoryIdParam.
 type CategoryIdParam = openapi_types.UUID
 
+// ContentEntityTypeParam defines model for ContentEntityType.
+type ContentEntityTypeParam = ContentEntityType
+
+// ContentIdParam defines model for ContentIdParam.
+type ContentIdParam = openapi_types.UUID
+
+// CursorParam defines model for CursorParam.
+type CursorParam = string
+
+// IfMatchParam defines model for IfMatchParam.
+type IfMatchParam = string
+
 // LimitParam defines model for LimitParam.
 type LimitParam = int
 
+// MediaIdParam defines model for MediaIdParam.
+type MediaIdParam = openapi_types.UUID
+
 // PageParam defines model for PageParam.
 type PageParam = int
 
 // SearchParam defines model for SearchParam.
 type SearchParam = string
 
+// SessionIdParam defines model for SessionIdParam.
+type SessionIdParam = openapi_types.UUID
+
 // StatusParam defines model for StatusParam.
 type StatusParam string
 
@@ -1256,6 +1619,45 @@ type RefreshTokenJSONBody struct {
 	RefreshToken string `json:"refresh_token"`
 }
 
+// ListContentSchedulesParams defines parameters for ListContentSchedules.
+type ListContentSchedulesParams struct {
+	EntityType *ListContentSchedulesParamsEntityType `form:"entity_type,omitempty" json:"entity_type,omitempty"`
+
+	// EntityId Only schedules for this content item; requires entity_type
+	EntityId *openapi_types.UUID               `form:"entity_id,omitempty" json:"entity_id,omitempty"`
+	Status   *ListContentSchedulesParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// ListContentSchedulesParamsEntityType defines parameters for ListContentSchedules.
+type ListContentSchedulesParamsEntityType string
+
+// ListContentSchedulesParamsStatus defines parameters for ListContentSchedules.
+type ListContentSchedulesParamsStatus string
+
+// ListMediaParams defines parameters for ListMedia.
+type ListMediaParams struct {
+	Kind       *ListMediaParamsKind       `form:"kind,omitempty" json:"kind,omitempty"`
+	EntityType *ListMediaParamsEntityType `form:"entity_type,omitempty" json:"entity_type,omitempty"`
+
+	// EntityId Only media linked to this content item; requires entity_type
+	EntityId *openapi_types.UUID `form:"entity_id,omitempty" json:"entity_id,omitempty"`
+}
+
+// ListMediaParamsKind defines parameters for ListMedia.
+type ListMediaParamsKind string
+
+// ListMediaParamsEntityType defines parameters for ListMedia.
+type ListMediaParamsEntityType string
+
+// GetMediaSignedUrlParams defines parameters for GetMediaSignedUrl.
+type GetMediaSignedUrlParams struct {
+	// Variant original, thumbnail or a responsive width such as w1280
+	Variant *string `form:"variant,omitempty" json:"variant,omitempty"`
+
+	// ExpiresIn Seconds until the link expires, at most one day
+	ExpiresIn *int `form:"expires_in,omitempty" json:"expires_in,omitempty"`
+}
+
 // GetEventsAdminParams defines parameters for GetEventsAdmin.
 type GetEventsAdminParams struct {
 	// Page Page number for pagination (1-based)
@@ -1264,6 +1666,9 @@ type GetEventsAdminParams struct {
 	// Limit Number of items per page
 	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
 
+	// Cursor Opaque cursor returned as next_cursor by the previous page; takes precedence over page
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
 	// Search Search query string for full-text search
 	Search *SearchParam `form:"search,omitempty" json:"search,omitempty"`
 
@@ -1285,10 +1690,31 @@ type GetInquiriesParams struct {
 	// Limit Number of items per page
 	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
 
+	// Cursor Opaque cursor returned as next_cursor by the previous page; takes precedence over page
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
 	// Search Search query string for full-text search
 	Search      *SearchParam                   `form:"search,omitempty" json:"search,omitempty"`
 	InquiryType *GetInquiriesParamsInquiryType `form:"inquiry_type,omitempty" json:"inquiry_type,omitempty"`
 	Status      *GetInquiriesParamsStatus      `form:"status,omitempty" json:"status,omitempty"`
+
+	// Sort Ordering applied to page-based listings; cursor pages are always newest first
+	Sort      *GetInquiriesParamsSort      `form:"sort,omitempty" json:"sort,omitempty"`
+	Priority  *GetInquiriesParamsPriority  `form:"priority,omitempty" json:"priority,omitempty"`
+	Urgency   *GetInquiriesParamsUrgency   `form:"urgency,omitempty" json:"urgency,omitempty"`
+	MediaType *GetInquiriesParamsMediaType `form:"media_type,omitempty" json:"media_type,omitempty"`
+
+	// DeadlineFrom Only inquiries with a deadline at or after this time
+	DeadlineFrom *time.Time `form:"deadline_from,omitempty" json:"deadline_from,omitempty"`
+
+	// DeadlineTo Only inquiries with a deadline at or before this time
+	DeadlineTo *time.Time `form:"deadline_to,omitempty" json:"deadline_to,omitempty"`
+
+	// CreatedFrom Only inquiries submitted at or after this time
+	CreatedFrom *time.Time `form:"created_from,omitempty" json:"created_from,omitempty"`
+
+	// CreatedTo Only inquiries submitted at or before this time
+	CreatedTo *time.Time `form:"created_to,omitempty" json:"created_to,omitempty"`
 }
 
 // GetInquiriesParamsInquiryType defines parameters for GetInquiries.
@@ -1297,6 +1723,18 @@ type GetInquiriesParamsInquiryType string
 // GetInquiriesParamsStatus defines parameters for GetInquiries.
 type GetInquiriesParamsStatus string
 
+// GetInquiriesParamsSort defines parameters for GetInquiries.
+type GetInquiriesParamsSort string
+
+// GetInquiriesParamsPriority defines parameters for GetInquiries.
+type GetInquiriesParamsPriority string
+
+// GetInquiriesParamsUrgency defines parameters for GetInquiries.
+type GetInquiriesParamsUrgency string
+
+// GetInquiriesParamsMediaType defines parameters for GetInquiries.
+type GetInquiriesParamsMediaType string
+
 // UpdateInquiryStatusJSONBody defines parameters for UpdateInquiryStatus.
 type UpdateInquiryStatusJSONBody struct {
 	AssignedTo *openapi_types.UUID               `json:"assigned_to"`
@@ -1315,6 +1753,9 @@ type GetNewsAdminParams struct {
 	// Limit Number of items per page
 	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
 
+	// Cursor Opaque cursor returned as next_cursor by the previous page; takes precedence over page
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
 	// Search Search query string for full-text search
 	Search *SearchParam `form:"search,omitempty" json:"search,omitempty"`
 
@@ -1328,6 +1769,12 @@ type GetNewsAdminParams struct {
 // GetNewsAdminParamsStatus defines parameters for GetNewsAdmin.
 type GetNewsAdminParamsStatus string
 
+// UpdateNewsArticleParams defines parameters for UpdateNewsArticle.
+type UpdateNewsArticleParams struct {
+	// IfMatch ETag returned when the resource was read; the update fails with 412 if it is no longer current
+	IfMatch *IfMatchParam `json:"If-Match,omitempty"`
+}
+
 // GetResearchAdminParams defines parameters for GetResearchAdmin.
 type GetResearchAdminParams struct {
 	// Page Page number for pagination (1-based)
@@ -1336,6 +1783,9 @@ type GetResearchAdminParams struct {
 	// Limit Number of items per page
 	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
 
+	// Cursor Opaque cursor returned as next_cursor by the previous page; takes precedence over page
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
 	// Search Search query string for full-text search
 	Search *SearchParam `form:"search,omitempty" json:"search,omitempty"`
 
@@ -1357,6 +1807,9 @@ type GetServicesAdminParams struct {
 	// Limit Number of items per page
 	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
 
+	// Cursor Opaque cursor returned as next_cursor by the previous page; takes precedence over page
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
 	// Search Search query string for full-text search
 	Search *SearchParam `form:"search,omitempty" json:"search,omitempty"`
 
@@ -1417,6 +1870,27 @@ type AdminLoginJSONRequestBody AdminLoginJSONBody
 // RefreshTokenJSONRequestBody defines body for RefreshToken for application/json ContentType.
 type RefreshTokenJSONRequestBody RefreshTokenJSONBody
 
+// CreateContentScheduleJSONRequestBody defines body for CreateContentSchedule for application/json ContentType.
+type CreateContentScheduleJSONRequestBody = CreateContentScheduleRequest
+
+// AddContentReviewCommentJSONRequestBody defines body for AddContentReviewComment for application/json ContentType.
+type AddContentReviewCommentJSONRequestBody = ContentReviewCommentRequest
+
+// RejectContentJSONRequestBody defines body for RejectContent for application/json ContentType.
+type RejectContentJSONRequestBody = RejectContentRequest
+
+// AssignContentReviewersJSONRequestBody defines body for AssignContentReviewers for application/json ContentType.
+type AssignContentReviewersJSONRequestBody = AssignContentReviewersRequest
+
+// SubmitContentForReviewJSONRequestBody defines body for SubmitContentForReview for application/json ContentType.
+type SubmitContentForReviewJSONRequestBody = SubmitContentReviewRequest
+
+// UploadMediaMultipartRequestBody defines body for UploadMedia for multipart/form-data ContentType.
+type UploadMediaMultipartRequestBody = UploadMediaRequest
+
+// LinkMediaJSONRequestBody defines body for LinkMedia for application/json ContentType.
+type LinkMediaJSONRequestBody = LinkMediaRequest
+
 // CreateEventJSONRequestBody defines body for CreateEvent for application/json ContentType.
 type CreateEventJSONRequestBody = CreateEventRequest
 
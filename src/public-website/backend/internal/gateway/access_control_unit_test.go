@@ -0,0 +1,630 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/auth"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// InMemoryAccessRepository implements AccessRepository over maps, with the system roles seeded
+type InMemoryAccessRepository struct {
+	mutex          sync.Mutex
+	users          map[string]*auth.User
+	passwordHashes map[string]string
+	loginStates    map[string]*LoginState
+	roles          map[string]auth.Role
+	emailLookups   int
+}
+
+func NewInMemoryAccessRepository() *InMemoryAccessRepository {
+	repository := &InMemoryAccessRepository{
+		users:          make(map[string]*auth.User),
+		passwordHashes: make(map[string]string),
+		loginStates:    make(map[string]*LoginState),
+		roles:          make(map[string]auth.Role),
+	}
+	for _, role := range auth.SystemRoles() {
+		role.RoleID = uuid.New().String()
+		repository.roles[role.RoleName] = role
+	}
+	return repository
+}
+
+// addUser stores a user directly and returns its ID
+func (r *InMemoryAccessRepository) addUser(email string, status auth.UserStatus, roles ...string) string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	userID := uuid.New().String()
+	r.users[userID] = &auth.User{
+		UserID: userID, Username: email, Email: email, Status: status,
+		FirstName: "Test", LastName: "User", CreatedBy: "system", UpdatedBy: "system", Roles: roles,
+	}
+	return userID
+}
+
+func (r *InMemoryAccessRepository) CreateUser(ctx context.Context, user *auth.User, passwordHash string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, existing := range r.users {
+		if strings.EqualFold(existing.Email, user.Email) {
+			return domain.NewConflictError("a user with this email already exists")
+		}
+	}
+	for _, role := range user.Roles {
+		if _, exists := r.roles[role]; !exists {
+			return domain.NewNotFoundError("role", role)
+		}
+	}
+	stored := *user
+	r.users[user.UserID] = &stored
+	r.passwordHashes[user.UserID] = passwordHash
+	return nil
+}
+
+func (r *InMemoryAccessRepository) GetUser(ctx context.Context, userID string) (*auth.User, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if user, exists := r.users[userID]; exists {
+		copied := *user
+		return &copied, nil
+	}
+	return nil, domain.NewNotFoundError("user", userID)
+}
+
+func (r *InMemoryAccessRepository) GetUserByEmail(ctx context.Context, email string) (*auth.User, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.emailLookups++
+	for _, user := range r.users {
+		if strings.EqualFold(user.Email, email) {
+			copied := *user
+			return &copied, nil
+		}
+	}
+	return nil, domain.NewNotFoundError("user", email)
+}
+
+func (r *InMemoryAccessRepository) UpdateUser(ctx context.Context, user *auth.User) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	existing, exists := r.users[user.UserID]
+	if !exists {
+		return domain.NewNotFoundError("user", user.UserID)
+	}
+	existing.Status = user.Status
+	existing.FirstName = user.FirstName
+	existing.LastName = user.LastName
+	existing.UpdatedBy = user.UpdatedBy
+	return nil
+}
+
+func (r *InMemoryAccessRepository) DeleteUser(ctx context.Context, userID string, deletedBy string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.users[userID]; !exists {
+		return domain.NewNotFoundError("user", userID)
+	}
+	delete(r.users, userID)
+	return nil
+}
+
+func (r *InMemoryAccessRepository) ListUsers(ctx context.Context, filter UserListFilter, limit, offset int) ([]*auth.User, int, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	var result []*auth.User
+	for _, user := range r.users {
+		if (filter.Status == "" || user.Status == filter.Status) && (filter.Role == "" || user.HasRole(filter.Role)) {
+			result = append(result, user)
+		}
+	}
+	return result, len(result), nil
+}
+
+func (r *InMemoryAccessRepository) SetUserRole(ctx context.Context, userID string, roleName string, assignedBy string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.roles[roleName]; !exists {
+		return domain.NewNotFoundError("role", roleName)
+	}
+	r.users[userID].Roles = []string{roleName}
+	return nil
+}
+
+func (r *InMemoryAccessRepository) GetRolesByName(ctx context.Context, roleNames []string) ([]auth.Role, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	var roles []auth.Role
+	for _, name := range roleNames {
+		if role, exists := r.roles[name]; exists {
+			roles = append(roles, role)
+		}
+	}
+	return roles, nil
+}
+
+func (r *InMemoryAccessRepository) CountActiveUsersWithRole(ctx context.Context, roleName string) (int, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	count := 0
+	for _, user := range r.users {
+		if user.Status == auth.UserStatusActive && user.HasRole(roleName) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *InMemoryAccessRepository) GetLoginState(ctx context.Context, userID string) (*LoginState, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.users[userID]; !exists {
+		return nil, domain.NewNotFoundError("user", userID)
+	}
+	state := LoginState{PasswordHash: r.passwordHashes[userID]}
+	if tracked, exists := r.loginStates[userID]; exists {
+		state.FailedLoginAttempts = tracked.FailedLoginAttempts
+		state.LockedUntil = tracked.LockedUntil
+	}
+	return &state, nil
+}
+
+func (r *InMemoryAccessRepository) RecordLoginFailure(ctx context.Context, userID string, lockedUntil *time.Time) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	state, exists := r.loginStates[userID]
+	if !exists {
+		state = &LoginState{}
+		r.loginStates[userID] = state
+	}
+	if lockedUntil != nil {
+		state.FailedLoginAttempts = 0
+		state.LockedUntil = lockedUntil
+		return nil
+	}
+	state.FailedLoginAttempts++
+	return nil
+}
+
+func (r *InMemoryAccessRepository) RecordLoginSuccess(ctx context.Context, userID string, at time.Time) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	delete(r.loginStates, userID)
+	if user, exists := r.users[userID]; exists {
+		user.LastLoginAt = &at
+	}
+	return nil
+}
+
+// setPassword stores the hash of password for a user added with addUser
+func (r *InMemoryAccessRepository) setPassword(t *testing.T, userID string, password string) {
+	t.Helper()
+	hash, err := auth.HashPassword(password)
+	require.NoError(t, err)
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.passwordHashes[userID] = hash
+}
+
+func stringValue(value string) *string {
+	return &value
+}
+
+func statusValue(status auth.UserStatus) *auth.UserStatus {
+	return &status
+}
+
+func TestAccessControlServiceCreateUser(t *testing.T) {
+	tests := []struct {
+		name          string
+		request       CreateUserRequest
+		expectedError func(error) bool
+	}{
+		{
+			name:    "creates an editor with a normalized email",
+			request: CreateUserRequest{Email: " Editor@Example.org ", FirstName: "Ada", LastName: "Byron", Password: "long enough password", Role: auth.RoleEditor, CreatedBy: "creator"},
+		},
+		{
+			name:          "rejects an invalid email",
+			request:       CreateUserRequest{Email: "not-an-email", FirstName: "Ada", LastName: "Byron", Password: "long enough password", Role: auth.RoleEditor, CreatedBy: "creator"},
+			expectedError: domain.IsValidationError,
+		},
+		{
+			name:          "rejects a short password",
+			request:       CreateUserRequest{Email: "editor@example.org", FirstName: "Ada", LastName: "Byron", Password: "short", Role: auth.RoleEditor, CreatedBy: "creator"},
+			expectedError: domain.IsValidationError,
+		},
+		{
+			name:          "rejects an unknown role",
+			request:       CreateUserRequest{Email: "editor@example.org", FirstName: "Ada", LastName: "Byron", Password: "long enough password", Role: "owner", CreatedBy: "creator"},
+			expectedError: domain.IsValidationError,
+		},
+		{
+			name:          "rejects an email that is already taken",
+			request:       CreateUserRequest{Email: "taken@example.org", FirstName: "Ada", LastName: "Byron", Password: "long enough password", Role: auth.RoleViewer, CreatedBy: "creator"},
+			expectedError: domain.IsConflictError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			repository := NewInMemoryAccessRepository()
+			repository.addUser("taken@example.org", auth.UserStatusActive, auth.RoleViewer)
+			service := NewDefaultAccessControlService(repository)
+
+			// Act
+			user, err := service.CreateUser(context.Background(), &tt.request)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.True(t, tt.expectedError(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "editor@example.org", user.Email)
+			assert.Equal(t, []string{auth.RoleEditor}, user.Roles)
+			assert.Equal(t, auth.UserStatusActive, user.Status)
+			assert.True(t, auth.VerifyPassword(repository.passwordHashes[user.UserID], tt.request.Password))
+		})
+	}
+}
+
+func TestAccessControlServiceProtectsAdmins(t *testing.T) {
+	tests := []struct {
+		name          string
+		otherAdmin    bool
+		act           func(service *DefaultAccessControlService, adminID, viewerID string) error
+		expectedError func(error) bool
+	}{
+		{
+			name: "the last admin cannot be demoted",
+			act: func(service *DefaultAccessControlService, adminID, viewerID string) error {
+				_, err := service.UpdateUser(context.Background(), adminID, &UpdateUserRequest{Role: stringValue(auth.RoleViewer), UpdatedBy: viewerID})
+				return err
+			},
+			expectedError: domain.IsConflictError,
+		},
+		{
+			name: "the last admin cannot be suspended",
+			act: func(service *DefaultAccessControlService, adminID, viewerID string) error {
+				_, err := service.UpdateUser(context.Background(), adminID, &UpdateUserRequest{Status: statusValue(auth.UserStatusSuspended), UpdatedBy: viewerID})
+				return err
+			},
+			expectedError: domain.IsConflictError,
+		},
+		{
+			name: "the last admin cannot be deleted",
+			act: func(service *DefaultAccessControlService, adminID, viewerID string) error {
+				return service.DeleteUser(context.Background(), adminID, viewerID)
+			},
+			expectedError: domain.IsConflictError,
+		},
+		{
+			name:       "an admin can be demoted while another remains",
+			otherAdmin: true,
+			act: func(service *DefaultAccessControlService, adminID, viewerID string) error {
+				_, err := service.UpdateUser(context.Background(), adminID, &UpdateUserRequest{Role: stringValue(auth.RoleEditor), UpdatedBy: viewerID})
+				return err
+			},
+		},
+		{
+			name:       "users cannot change their own role",
+			otherAdmin: true,
+			act: func(service *DefaultAccessControlService, adminID, viewerID string) error {
+				_, err := service.UpdateUser(context.Background(), adminID, &UpdateUserRequest{Role: stringValue(auth.RoleViewer), UpdatedBy: adminID})
+				return err
+			},
+			expectedError: domain.IsForbiddenError,
+		},
+		{
+			name:       "users can change their own name",
+			otherAdmin: true,
+			act: func(service *DefaultAccessControlService, adminID, viewerID string) error {
+				_, err := service.UpdateUser(context.Background(), adminID, &UpdateUserRequest{FirstName: stringValue("Grace"), UpdatedBy: adminID})
+				return err
+			},
+		},
+		{
+			name:       "users cannot delete themselves",
+			otherAdmin: true,
+			act: func(service *DefaultAccessControlService, adminID, viewerID string) error {
+				return service.DeleteUser(context.Background(), adminID, adminID)
+			},
+			expectedError: domain.IsForbiddenError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			repository := NewInMemoryAccessRepository()
+			adminID := repository.addUser("admin@example.org", auth.UserStatusActive, auth.RoleAdmin)
+			viewerID := repository.addUser("viewer@example.org", auth.UserStatusActive, auth.RoleViewer)
+			if tt.otherAdmin {
+				repository.addUser("second-admin@example.org", auth.UserStatusActive, auth.RoleAdmin)
+			}
+			service := NewDefaultAccessControlService(repository)
+
+			// Act
+			err := tt.act(service, adminID, viewerID)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.True(t, tt.expectedError(err), "unexpected error: %v", err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestAccessControlServiceResolvePrincipal(t *testing.T) {
+	tests := []struct {
+		name                string
+		email               string
+		expectedPermissions []auth.Permission
+		deniedPermission    auth.Permission
+		expectedError       func(error) bool
+	}{
+		{
+			name:                "an editor may publish news but not manage users",
+			email:               "EDITOR@example.org",
+			expectedPermissions: []auth.Permission{auth.PermissionNewsPublish, auth.PermissionMediaManage},
+			deniedPermission:    auth.PermissionUsersManage,
+		},
+		{
+			name:                "a moderator handles inquiries but cannot publish",
+			email:               "moderator@example.org",
+			expectedPermissions: []auth.Permission{auth.PermissionInquiriesRead, auth.PermissionSubscribersManage},
+			deniedPermission:    auth.PermissionNewsPublish,
+		},
+		{
+			name:          "a suspended user is forbidden",
+			email:         "suspended@example.org",
+			expectedError: domain.IsForbiddenError,
+		},
+		{
+			name:          "an unknown email is forbidden",
+			email:         "stranger@example.org",
+			expectedError: domain.IsForbiddenError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			repository := NewInMemoryAccessRepository()
+			repository.addUser("editor@example.org", auth.UserStatusActive, auth.RoleEditor)
+			repository.addUser("moderator@example.org", auth.UserStatusActive, auth.RoleModerator)
+			repository.addUser("suspended@example.org", auth.UserStatusSuspended, auth.RoleAdmin)
+			service := NewDefaultAccessControlService(repository)
+
+			// Act
+			principal, err := service.ResolvePrincipal(context.Background(), tt.email)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.True(t, tt.expectedError(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			for _, permission := range tt.expectedPermissions {
+				assert.True(t, principal.Can(permission), "expected %s", permission)
+			}
+			assert.False(t, principal.Can(tt.deniedPermission))
+		})
+	}
+}
+
+func TestAccessControlServicePrincipalCache(t *testing.T) {
+	// Arrange
+	repository := NewInMemoryAccessRepository()
+	repository.addUser("admin@example.org", auth.UserStatusActive, auth.RoleAdmin)
+	editorID := repository.addUser("editor@example.org", auth.UserStatusActive, auth.RoleEditor)
+	service := NewDefaultAccessControlService(repository)
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	service.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	// Act
+	_, err := service.ResolvePrincipal(ctx, "editor@example.org")
+	require.NoError(t, err)
+	_, err = service.ResolvePrincipal(ctx, "editor@example.org")
+	require.NoError(t, err)
+	cachedLookups := repository.emailLookups
+
+	_, err = service.UpdateUser(ctx, editorID, &UpdateUserRequest{Role: stringValue(auth.RoleViewer), UpdatedBy: "someone-else"})
+	require.NoError(t, err)
+	demoted, err := service.ResolvePrincipal(ctx, "editor@example.org")
+	require.NoError(t, err)
+
+	now = now.Add(PrincipalCacheTTL)
+	_, err = service.ResolvePrincipal(ctx, "editor@example.org")
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, 1, cachedLookups, "the second resolution is served from the cache")
+	assert.False(t, demoted.Can(auth.PermissionNewsWrite), "a role change takes effect at once")
+	assert.Equal(t, 3, repository.emailLookups, "expired entries are loaded again")
+}
+
+func TestAccessControlServiceEnsureBootstrapAdmins(t *testing.T) {
+	// Arrange
+	repository := NewInMemoryAccessRepository()
+	demotedID := repository.addUser("demoted@example.org", auth.UserStatusActive, auth.RoleViewer)
+	service := NewDefaultAccessControlService(repository)
+	ctx := context.Background()
+
+	// Act
+	err := service.EnsureBootstrapAdmins(ctx, []string{"Founder@Example.org", "demoted@example.org", ""})
+	require.NoError(t, err)
+	err = service.EnsureBootstrapAdmins(ctx, []string{"founder@example.org"})
+	require.NoError(t, err)
+
+	// Assert
+	founder, err := repository.GetUserByEmail(ctx, "founder@example.org")
+	require.NoError(t, err)
+	assert.Equal(t, []string{auth.RoleAdmin}, founder.Roles)
+	assert.Equal(t, socialLoginPasswordHash, repository.passwordHashes[founder.UserID])
+	assert.False(t, auth.VerifyPassword(repository.passwordHashes[founder.UserID], socialLoginPasswordHash), "bootstrap admins have no usable password")
+	assert.Len(t, repository.users, 2, "bootstrap is idempotent")
+
+	demoted, err := repository.GetUser(ctx, demotedID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{auth.RoleViewer}, demoted.Roles, "existing accounts are not promoted")
+}
+
+func TestPolicyMiddleware(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		token          string
+		expectedStatus int
+		expectedUser   string
+	}{
+		{name: "a viewer can list users", method: http.MethodGet, path: "/admin/api/v1/users", token: "viewer@example.org", expectedStatus: http.StatusOK, expectedUser: "viewer@example.org"},
+		{name: "a viewer cannot create users", method: http.MethodPost, path: "/admin/api/v1/users", token: "viewer@example.org", expectedStatus: http.StatusForbidden},
+		{name: "an editor can publish news", method: http.MethodPost, path: "/admin/api/v1/news/article-1/publish", token: "editor@example.org", expectedStatus: http.StatusOK, expectedUser: "editor@example.org"},
+		{name: "a moderator cannot publish news", method: http.MethodPost, path: "/admin/api/v1/news/article-1/publish", token: "moderator@example.org", expectedStatus: http.StatusForbidden},
+		{name: "a moderator can read inquiries", method: http.MethodGet, path: "/admin/api/v1/inquiries", token: "moderator@example.org", expectedStatus: http.StatusOK, expectedUser: "moderator@example.org"},
+		{name: "a request without a token is unauthorized", method: http.MethodGet, path: "/admin/api/v1/users", expectedStatus: http.StatusUnauthorized},
+		{name: "an invalid token is unauthorized", method: http.MethodGet, path: "/admin/api/v1/users", token: "forged", expectedStatus: http.StatusUnauthorized},
+		{name: "an email without an account is forbidden", method: http.MethodGet, path: "/admin/api/v1/users", token: "stranger@example.org", expectedStatus: http.StatusForbidden},
+		{name: "unprotected paths need no token", method: http.MethodGet, path: "/health", expectedStatus: http.StatusOK},
+		{name: "preflight requests need no token", method: http.MethodOptions, path: "/admin/api/v1/users", expectedStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			repository := NewInMemoryAccessRepository()
+			repository.addUser("viewer@example.org", auth.UserStatusActive, auth.RoleViewer)
+			repository.addUser("editor@example.org", auth.UserStatusActive, auth.RoleEditor)
+			repository.addUser("moderator@example.org", auth.UserStatusActive, auth.RoleModerator)
+			handler := NewAccessControlHandler(NewDefaultAccessControlService(repository), auth.DefaultAdminPolicy(), &GatewayConfiguration{Environment: "testing"})
+			// Tokens in this test are the email they vouch for
+			handler.SetTokenVerifier(func(ctx context.Context, token string) (*auth.Claims, error) {
+				if !strings.Contains(token, "@") {
+					return nil, domain.NewUnauthorizedError("invalid signature")
+				}
+				return &auth.Claims{Email: token}, nil
+			})
+
+			var forwarded *http.Request
+			router := mux.NewRouter()
+			router.Use(handler.PolicyMiddleware)
+			router.PathPrefix("/").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				forwarded = r
+				w.WriteHeader(http.StatusOK)
+			})
+
+			request := httptest.NewRequest(tt.method, tt.path, nil)
+			request.Header.Set("X-User-ID", "admin-forged")
+			if tt.token != "" {
+				request.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			recorder := httptest.NewRecorder()
+
+			// Act
+			router.ServeHTTP(recorder, request)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, recorder.Code)
+			if tt.expectedStatus != http.StatusOK {
+				var body map[string]map[string]interface{}
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+				assert.NotEmpty(t, body["error"]["code"])
+				return
+			}
+			require.NotNil(t, forwarded)
+			if tt.expectedUser == "" {
+				assert.Empty(t, forwarded.Header.Get("X-User-ID"), "client identity headers are stripped")
+				return
+			}
+			principal, ok := auth.PrincipalFromContext(forwarded.Context())
+			require.True(t, ok)
+			assert.Equal(t, tt.expectedUser, forwarded.Header.Get("X-User-Email"))
+			assert.Equal(t, "admin-"+principal.UserID, forwarded.Header.Get("X-User-ID"))
+			assert.NotEmpty(t, forwarded.Header.Get("X-User-Permissions"))
+		})
+	}
+}
+
+func TestAccessControlHandlerUserEndpoints(t *testing.T) {
+	// Arrange
+	repository := NewInMemoryAccessRepository()
+	adminID := repository.addUser("admin@example.org", auth.UserStatusActive, auth.RoleAdmin)
+	viewerID := repository.addUser("viewer@example.org", auth.UserStatusActive, auth.RoleViewer)
+	handler := NewAccessControlHandler(NewDefaultAccessControlService(repository), auth.DefaultAdminPolicy(), &GatewayConfiguration{Environment: "testing"})
+	router := mux.NewRouter()
+	handler.RegisterAccessRoutes(router)
+
+	serve := func(method, path, body string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(method, path, strings.NewReader(body))
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	// Act
+	list := serve(http.MethodGet, "/admin/api/v1/users?role=admin", "")
+	get := serve(http.MethodGet, "/admin/api/v1/users/"+viewerID, "")
+	update := serve(http.MethodPut, "/admin/api/v1/users/"+viewerID, `{"role": "editor", "status": "suspended"}`)
+	demoteLastAdmin := serve(http.MethodPut, "/admin/api/v1/users/"+adminID, `{"role": "viewer"}`)
+	missing := serve(http.MethodGet, "/admin/api/v1/users/"+uuid.New().String(), "")
+
+	// Assert
+	require.Equal(t, http.StatusOK, list.Code)
+	var listBody struct {
+		Data []struct {
+			Email string `json:"email"`
+			Role  string `json:"role"`
+		} `json:"data"`
+		Pagination struct {
+			TotalItems int `json:"total_items"`
+		} `json:"pagination"`
+	}
+	require.NoError(t, json.Unmarshal(list.Body.Bytes(), &listBody))
+	require.Len(t, listBody.Data, 1)
+	assert.Equal(t, "admin@example.org", listBody.Data[0].Email)
+	assert.Equal(t, 1, listBody.Pagination.TotalItems)
+
+	assert.Equal(t, http.StatusOK, get.Code)
+	assert.Contains(t, get.Body.String(), `"role":"viewer"`)
+
+	require.Equal(t, http.StatusOK, update.Code)
+	updated, err := repository.GetUser(context.Background(), viewerID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{auth.RoleEditor}, updated.Roles)
+	assert.Equal(t, auth.UserStatusSuspended, updated.Status)
+
+	assert.Equal(t, http.StatusConflict, demoteLastAdmin.Code)
+	assert.Equal(t, http.StatusNotFound, missing.Code)
+}
@@ -0,0 +1,429 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/contracts/admin"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/auth"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Identity headers the policy middleware sets for downstream services. Client supplied
+// values are always removed first so they cannot be forged.
+var identityHeaders = []string{"X-User-ID", "X-User-Email", "X-User-Role", "X-User-Roles", "X-User-Permissions"}
+
+// TokenVerifier verifies a bearer token and returns its claims
+type TokenVerifier func(ctx context.Context, token string) (*auth.Claims, error)
+
+// AccessControlHandler serves the admin user endpoints and enforces the admin policy
+type AccessControlHandler struct {
+	accessService AccessControlService
+	policy        *auth.Policy
+	verifyToken   TokenVerifier
+	sessions      *auth.SessionManager
+	gatewayConfig *GatewayConfiguration
+}
+
+// NewAccessControlHandler creates a new access control handler that verifies social
+// provider ID tokens
+func NewAccessControlHandler(accessService AccessControlService, policy *auth.Policy, gatewayConfig *GatewayConfiguration) *AccessControlHandler {
+	return &AccessControlHandler{
+		accessService: accessService,
+		policy:        policy,
+		verifyToken:   auth.VerifySocialLogin,
+		gatewayConfig: gatewayConfig,
+	}
+}
+
+// SetTokenVerifier replaces the bearer token verifier
+func (h *AccessControlHandler) SetTokenVerifier(verifier TokenVerifier) {
+	h.verifyToken = verifier
+}
+
+// SetSessionManager enables password sign-in and session access tokens
+func (h *AccessControlHandler) SetSessionManager(sessions *auth.SessionManager) {
+	h.sessions = sessions
+}
+
+// RegisterAccessRoutes registers the admin user management routes, and the session
+// routes when sessions are enabled
+func (h *AccessControlHandler) RegisterAccessRoutes(router *mux.Router) {
+	if h.sessions != nil {
+		h.RegisterSessionRoutes(router)
+	}
+
+	usersRouter := router.PathPrefix("/admin/api/v1").Subrouter()
+
+	usersRouter.HandleFunc("/users", h.GetAdminUsers).Methods("GET")
+	usersRouter.HandleFunc("/users", h.CreateAdminUser).Methods("POST")
+	usersRouter.HandleFunc("/users/{id}", h.GetAdminUserById).Methods("GET")
+	usersRouter.HandleFunc("/users/{id}", h.UpdateAdminUser).Methods("PUT")
+	usersRouter.HandleFunc("/users/{id}", h.DeleteAdminUser).Methods("DELETE")
+}
+
+// PolicyMiddleware authenticates requests to protected admin paths and checks the
+// principal against the admin policy. Authorized requests carry the principal in
+// their context and identity headers for the downstream services.
+func (h *AccessControlHandler) PolicyMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, header := range identityHeaders {
+			r.Header.Del(header)
+		}
+
+		// Preflight requests carry no credentials
+		if r.Method == http.MethodOptions || h.policy.AllowsAnonymous(r.Method, r.URL.Path) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		principal, session, err := h.authenticate(r)
+		if err != nil {
+			h.handleServiceError(w, r, err)
+			return
+		}
+
+		if err := h.policy.Authorize(principal, r.Method, r.URL.Path); err != nil {
+			h.handleServiceError(w, r, err)
+			return
+		}
+
+		permissions := principal.Permissions()
+		permissionNames := make([]string, len(permissions))
+		for i, permission := range permissions {
+			permissionNames[i] = string(permission)
+		}
+
+		// Downstream services recognise admin callers by the admin- prefix
+		r.Header.Set("X-User-ID", "admin-"+principal.UserID)
+		r.Header.Set("X-User-Email", principal.Email)
+		r.Header.Set("X-User-Roles", strings.Join(principal.Roles, ","))
+		r.Header.Set("X-User-Permissions", strings.Join(permissionNames, ","))
+
+		ctx := auth.WithPrincipal(r.Context(), principal)
+		if session != nil {
+			ctx = auth.WithSession(ctx, session)
+		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// authenticate resolves the principal for the bearer token of the request. Session
+// access tokens also return their session; social provider ID tokens have none.
+func (h *AccessControlHandler) authenticate(r *http.Request) (*auth.Principal, *auth.Session, error) {
+	authHeader := r.Header.Get("Authorization")
+	if !strings.HasPrefix(authHeader, "Bearer ") {
+		return nil, nil, domain.NewUnauthorizedError("bearer token required")
+	}
+	token := strings.TrimSpace(strings.TrimPrefix(authHeader, "Bearer "))
+
+	if h.sessions != nil && auth.IsSessionAccessToken(token) {
+		session, err := h.sessions.Authenticate(r.Context(), token)
+		if err != nil {
+			return nil, nil, err
+		}
+		principal, err := h.accessService.ResolvePrincipal(r.Context(), session.Email)
+		return principal, session, err
+	}
+
+	claims, err := h.verifyToken(r.Context(), token)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	principal, err := h.accessService.ResolvePrincipal(r.Context(), claims.Email)
+	return principal, nil, err
+}
+
+// GetAdminUsers handles GET /admin/api/v1/users
+func (h *AccessControlHandler) GetAdminUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	page := 1
+	if pageStr := query.Get("page"); pageStr != "" {
+		value, err := strconv.Atoi(pageStr)
+		if err != nil || value < 1 {
+			h.writeErrorResponse(w, r, http.StatusBadRequest, "VALIDATION_ERROR", "page must be a positive integer", err)
+			return
+		}
+		page = value
+	}
+
+	limit := 20
+	if limitStr := query.Get("limit"); limitStr != "" {
+		value, err := strconv.Atoi(limitStr)
+		if err != nil || value < 1 || value > 100 {
+			h.writeErrorResponse(w, r, http.StatusBadRequest, "VALIDATION_ERROR", "limit must be between 1 and 100", err)
+			return
+		}
+		limit = value
+	}
+
+	filter := UserListFilter{
+		Search: query.Get("search"),
+		Role:   query.Get("role"),
+		Status: auth.UserStatus(query.Get("status")),
+	}
+
+	users, total, err := h.accessService.ListUsers(ctx, filter, page, limit)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	data := make([]admin.AdminUser, 0, len(users))
+	for _, user := range users {
+		data = append(data, toContractAdminUser(user))
+	}
+
+	totalPages := (total + limit - 1) / limit
+	h.writeJSONResponse(w, r, http.StatusOK, map[string]interface{}{
+		"data": data,
+		"pagination": admin.PaginationInfo{
+			CurrentPage:  page,
+			TotalPages:   totalPages,
+			TotalItems:   total,
+			ItemsPerPage: limit,
+			HasNext:      page < totalPages,
+			HasPrevious:  page > 1,
+		},
+	})
+}
+
+// CreateAdminUser handles POST /admin/api/v1/users
+func (h *AccessControlHandler) CreateAdminUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var body admin.CreateAdminUserRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.writeErrorResponse(w, r, http.StatusBadRequest, "INVALID_JSON", "invalid JSON format", err)
+		return
+	}
+
+	user, err := h.accessService.CreateUser(ctx, &CreateUserRequest{
+		Email:     string(body.Email),
+		FirstName: body.FirstName,
+		LastName:  body.LastName,
+		Password:  body.Password,
+		Role:      string(body.Role),
+		CreatedBy: h.actorID(r),
+	})
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	h.writeJSONResponse(w, r, http.StatusCreated, map[string]interface{}{
+		"success":        true,
+		"message":        "Admin user created successfully",
+		"data":           toContractAdminUser(user),
+		"correlation_id": domain.GetCorrelationID(ctx),
+		"timestamp":      time.Now().UTC(),
+	})
+}
+
+// GetAdminUserById handles GET /admin/api/v1/users/{id}
+func (h *AccessControlHandler) GetAdminUserById(w http.ResponseWriter, r *http.Request) {
+	user, err := h.accessService.GetUser(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	h.writeJSONResponse(w, r, http.StatusOK, map[string]interface{}{
+		"data": toContractAdminUser(user),
+	})
+}
+
+// UpdateAdminUser handles PUT /admin/api/v1/users/{id}
+func (h *AccessControlHandler) UpdateAdminUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var body admin.UpdateAdminUserRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.writeErrorResponse(w, r, http.StatusBadRequest, "INVALID_JSON", "invalid JSON format", err)
+		return
+	}
+
+	req := &UpdateUserRequest{
+		FirstName: body.FirstName,
+		LastName:  body.LastName,
+		UpdatedBy: h.actorID(r),
+	}
+	if body.Role != nil {
+		role := string(*body.Role)
+		req.Role = &role
+	}
+	if body.Status != nil {
+		status := auth.UserStatus(*body.Status)
+		req.Status = &status
+	}
+
+	user, err := h.accessService.UpdateUser(ctx, mux.Vars(r)["id"], req)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	h.writeJSONResponse(w, r, http.StatusOK, map[string]interface{}{
+		"success":        true,
+		"message":        "Admin user updated successfully",
+		"data":           toContractAdminUser(user),
+		"correlation_id": domain.GetCorrelationID(ctx),
+		"timestamp":      time.Now().UTC(),
+	})
+}
+
+// DeleteAdminUser handles DELETE /admin/api/v1/users/{id}
+func (h *AccessControlHandler) DeleteAdminUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if err := h.accessService.DeleteUser(ctx, mux.Vars(r)["id"], h.actorID(r)); err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	h.writeJSONResponse(w, r, http.StatusOK, map[string]interface{}{
+		"success":        true,
+		"message":        "Admin user deleted successfully",
+		"correlation_id": domain.GetCorrelationID(ctx),
+		"timestamp":      time.Now().UTC(),
+	})
+}
+
+// Helper methods
+
+// actorID returns the user ID of the principal making the request. Without a
+// principal, which only happens when authentication is disabled, changes are
+// attributed to "admin".
+func (h *AccessControlHandler) actorID(r *http.Request) string {
+	if principal, ok := auth.PrincipalFromContext(r.Context()); ok {
+		return principal.UserID
+	}
+	return "admin"
+}
+
+// toContractAdminUser converts a user to the admin API contract model
+func toContractAdminUser(user *auth.User) admin.AdminUser {
+	contractUser := admin.AdminUser{
+		CreatedOn: user.CreatedAt,
+		Email:     openapi_types.Email(user.Email),
+		FirstName: user.FirstName,
+		LastLogin: user.LastLoginAt,
+		LastName:  user.LastName,
+		Role:      admin.AdminUserRole(user.PrimaryRole()),
+		Status:    admin.AdminUserStatus(user.Status),
+	}
+
+	if userID, err := uuid.Parse(user.UserID); err == nil {
+		contractUser.UserId = userID
+	}
+
+	// The contract has no locked status; a locked account is suspended until it unlocks
+	if user.Status == auth.UserStatusLocked {
+		contractUser.Status = admin.AdminUserStatusSuspended
+	}
+
+	if createdBy, err := uuid.Parse(user.CreatedBy); err == nil {
+		contractUser.CreatedBy = &createdBy
+	}
+
+	return contractUser
+}
+
+// handleServiceError converts service errors to HTTP responses
+func (h *AccessControlHandler) handleServiceError(w http.ResponseWriter, r *http.Request, err error) {
+	var statusCode int
+	var errorCode string
+	var message string
+
+	switch {
+	case domain.IsValidationError(err):
+		statusCode = http.StatusBadRequest
+		errorCode = "VALIDATION_ERROR"
+		message = err.Error()
+	case domain.IsNotFoundError(err):
+		statusCode = http.StatusNotFound
+		errorCode = "USER_NOT_FOUND"
+		message = err.Error()
+	case domain.IsConflictError(err):
+		statusCode = http.StatusConflict
+		errorCode = "USER_CONFLICT"
+		message = err.Error()
+	case domain.IsUnauthorizedError(err):
+		statusCode = http.StatusUnauthorized
+		errorCode = "UNAUTHORIZED"
+		message = err.Error()
+	case domain.IsForbiddenError(err):
+		statusCode = http.StatusForbidden
+		errorCode = "FORBIDDEN"
+		message = err.Error()
+	case domain.IsRateLimitError(err):
+		statusCode = http.StatusTooManyRequests
+		errorCode = "RATE_LIMIT_EXCEEDED"
+		message = err.Error()
+	case domain.IsDependencyError(err):
+		statusCode = http.StatusServiceUnavailable
+		errorCode = "SERVICE_UNAVAILABLE"
+		message = "Database service temporarily unavailable"
+	default:
+		statusCode = http.StatusInternalServerError
+		errorCode = "INTERNAL_ERROR"
+		message = "An internal error occurred while processing the request"
+	}
+
+	h.writeErrorResponse(w, r, statusCode, errorCode, message, err)
+}
+
+// writeErrorResponse writes an error response in the admin API contract format
+func (h *AccessControlHandler) writeErrorResponse(w http.ResponseWriter, r *http.Request, statusCode int, errorCode string, message string, err error) {
+	if statusCode == http.StatusUnauthorized {
+		w.Header().Set("WWW-Authenticate", "Bearer realm=\"admin\"")
+	}
+
+	errorBody := map[string]interface{}{
+		"code":           errorCode,
+		"message":        message,
+		"correlation_id": domain.GetCorrelationID(r.Context()),
+		"timestamp":      time.Now().UTC(),
+	}
+
+	// Add additional error details for debugging (only in development)
+	if h.gatewayConfig.Environment == "development" && err != nil {
+		errorBody["details"] = map[string]interface{}{
+			"error_detail": err.Error(),
+			"error_type":   fmt.Sprintf("%T", err),
+		}
+	}
+
+	h.writeJSONResponse(w, r, statusCode, map[string]interface{}{"error": errorBody})
+}
+
+// writeJSONResponse writes a JSON response; user data is never cached
+func (h *AccessControlHandler) writeJSONResponse(w http.ResponseWriter, r *http.Request, statusCode int, data interface{}) {
+	if correlationID := domain.GetCorrelationID(r.Context()); correlationID != "" {
+		w.Header().Set("X-Correlation-ID", correlationID)
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Cache-Control", "no-store")
+
+	w.WriteHeader(statusCode)
+
+	if data != nil {
+		if err := json.NewEncoder(w).Encode(data); err != nil {
+			// Log error but don't expose it to client
+			fmt.Printf("Failed to encode JSON response: %v\n", err)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package gateway
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/auth"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
+)
+
+// Environment variables holding the base64 encoded keys that sign admin session access
+// tokens. Previous keys, comma separated, still verify tokens issued before a rotation.
+const (
+	SessionSigningKeyEnv         = "ADMIN_SESSION_SIGNING_KEY"
+	SessionPreviousSigningKeyEnv = "ADMIN_SESSION_PREVIOUS_SIGNING_KEYS"
+)
+
+// AccessControlIntegration wires the admin user directory and policy into an admin gateway
+type AccessControlIntegration struct {
+	db            *sql.DB
+	repository    AccessRepository
+	service       *DefaultAccessControlService
+	sessions      *auth.SessionManager
+	handler       *AccessControlHandler
+	gatewayConfig *GatewayConfiguration
+}
+
+// NewAccessControlIntegration creates a new access control integration backed by the
+// gateway database
+func NewAccessControlIntegration(gatewayConfig *GatewayConfiguration) (*AccessControlIntegration, error) {
+	if !gatewayConfig.IsAdmin() {
+		return nil, fmt.Errorf("access control is only available for admin gateways")
+	}
+
+	dbConfig := getDatabaseConfig()
+	if err := validateDatabaseConfig(dbConfig); err != nil {
+		return nil, fmt.Errorf("invalid database configuration: %w", err)
+	}
+
+	db, err := sql.Open("postgres", dbConfig.GetConnectionString())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database connection: %w", err)
+	}
+
+	db.SetMaxOpenConns(dbConfig.MaxOpenConns)
+	db.SetMaxIdleConns(dbConfig.MaxIdleConns)
+	db.SetConnMaxLifetime(time.Duration(dbConfig.ConnMaxLifetime) * time.Minute)
+
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	integration := &AccessControlIntegration{
+		db:            db,
+		repository:    NewPostgreSQLAccessRepository(db),
+		gatewayConfig: gatewayConfig,
+	}
+	integration.service = NewDefaultAccessControlService(integration.repository)
+	integration.handler = NewAccessControlHandler(integration.service, auth.DefaultAdminPolicy(), gatewayConfig)
+
+	return integration, nil
+}
+
+// EnableSessionsFromEnv turns on password sign-in with sessions kept in the Dapr state
+// store, signed with the key in ADMIN_SESSION_SIGNING_KEY. Without the key sessions stay
+// off and admins sign in with a social provider only. Call it before
+// InitializeWithGateway so the session routes are registered.
+func (aci *AccessControlIntegration) EnableSessionsFromEnv(daprClient *dapr.Client) (bool, error) {
+	encodedKey := strings.TrimSpace(os.Getenv(SessionSigningKeyEnv))
+	if encodedKey == "" {
+		return false, nil
+	}
+
+	signingKey, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil {
+		return false, fmt.Errorf("%s is not valid base64: %w", SessionSigningKeyEnv, err)
+	}
+
+	var previousKeys [][]byte
+	for _, encoded := range strings.Split(os.Getenv(SessionPreviousSigningKeyEnv), ",") {
+		if encoded = strings.TrimSpace(encoded); encoded == "" {
+			continue
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return false, fmt.Errorf("%s holds a key that is not valid base64: %w", SessionPreviousSigningKeyEnv, err)
+		}
+		previousKeys = append(previousKeys, key)
+	}
+
+	store := NewStateSessionStore(dapr.NewStateStore(daprClient))
+	sessions, err := auth.NewSessionManager(store, auth.DefaultSessionConfig(), signingKey, previousKeys...)
+	if err != nil {
+		return false, fmt.Errorf("failed to create session manager: %w", err)
+	}
+
+	aci.sessions = sessions
+	aci.handler.SetSessionManager(sessions)
+	aci.service.SetSessionRevoker(sessions)
+	return true, nil
+}
+
+// InitializeWithGateway installs the user routes and policy middleware in the gateway
+// and rebuilds its router so they take effect
+func (aci *AccessControlIntegration) InitializeWithGateway(gatewayService *GatewayService) error {
+	if gatewayService == nil {
+		return fmt.Errorf("gateway service cannot be nil")
+	}
+
+	gatewayHandler := gatewayService.GetHandler()
+	if gatewayHandler == nil {
+		return fmt.Errorf("gateway handler is nil")
+	}
+
+	gatewayHandler.SetAccessControlHandler(aci.handler)
+	gatewayService.RebuildRouter()
+
+	return nil
+}
+
+// EnsureBootstrapAdminsFromEnv creates admin accounts for the comma separated emails in
+// ADMIN_BOOTSTRAP_EMAILS
+func (aci *AccessControlIntegration) EnsureBootstrapAdminsFromEnv(ctx context.Context) error {
+	var emails []string
+	for _, email := range strings.Split(os.Getenv("ADMIN_BOOTSTRAP_EMAILS"), ",") {
+		if email = strings.TrimSpace(email); email != "" {
+			emails = append(emails, email)
+		}
+	}
+
+	if len(emails) == 0 {
+		return nil
+	}
+
+	return aci.service.EnsureBootstrapAdmins(ctx, emails)
+}
+
+// HealthCheck checks database connectivity for access control
+func (aci *AccessControlIntegration) HealthCheck(ctx context.Context) error {
+	if err := aci.db.PingContext(ctx); err != nil {
+		return fmt.Errorf("database connectivity check failed: %w", err)
+	}
+	return nil
+}
+
+// Close closes database connections
+func (aci *AccessControlIntegration) Close() error {
+	if aci.db != nil {
+		return aci.db.Close()
+	}
+	return nil
+}
+
+// GetService returns the access control service
+func (aci *AccessControlIntegration) GetService() AccessControlService {
+	return aci.service
+}
+
+// GetSessionManager returns the session manager, or nil when sessions are disabled
+func (aci *AccessControlIntegration) GetSessionManager() *auth.SessionManager {
+	return aci.sessions
+}
+
+// GetHandler returns the access control handler
+func (aci *AccessControlIntegration) GetHandler() *AccessControlHandler {
+	return aci.handler
+}
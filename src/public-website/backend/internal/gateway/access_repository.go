@@ -0,0 +1,542 @@
+package gateway
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/auth"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// UserListFilter narrows ListUsers; empty fields do not filter
+type UserListFilter struct {
+	Search string
+	Role   string
+	Status auth.UserStatus
+}
+
+// LoginState holds what password sign-in checks beyond the user profile
+type LoginState struct {
+	PasswordHash        string
+	FailedLoginAttempts int
+	LockedUntil         *time.Time
+}
+
+// AccessRepository stores admin users and their role assignments in the gateway
+// users, roles and user_roles tables
+type AccessRepository interface {
+	CreateUser(ctx context.Context, user *auth.User, passwordHash string) error
+	GetUser(ctx context.Context, userID string) (*auth.User, error)
+	GetUserByEmail(ctx context.Context, email string) (*auth.User, error)
+	UpdateUser(ctx context.Context, user *auth.User) error
+	DeleteUser(ctx context.Context, userID string, deletedBy string) error
+	ListUsers(ctx context.Context, filter UserListFilter, limit, offset int) ([]*auth.User, int, error)
+	SetUserRole(ctx context.Context, userID string, roleName string, assignedBy string) error
+	GetRolesByName(ctx context.Context, roleNames []string) ([]auth.Role, error)
+	CountActiveUsersWithRole(ctx context.Context, roleName string) (int, error)
+	GetLoginState(ctx context.Context, userID string) (*LoginState, error)
+	RecordLoginFailure(ctx context.Context, userID string, lockedUntil *time.Time) error
+	RecordLoginSuccess(ctx context.Context, userID string, at time.Time) error
+}
+
+// PostgreSQLAccessRepository implements AccessRepository using PostgreSQL
+type PostgreSQLAccessRepository struct {
+	db *sql.DB
+}
+
+// NewPostgreSQLAccessRepository creates a new PostgreSQL access repository
+func NewPostgreSQLAccessRepository(db *sql.DB) *PostgreSQLAccessRepository {
+	return &PostgreSQLAccessRepository{
+		db: db,
+	}
+}
+
+// selectUsers reads users with the names of their current roles. Expired and removed
+// assignments, and removed roles, are left out.
+const selectUsers = `
+	SELECT u.user_id, u.username, u.email, u.status, u.first_name, u.last_name,
+		   u.last_login_at, u.created_at, u.updated_at, u.created_by, u.updated_by,
+		   COALESCE(array_agg(r.role_name ORDER BY r.role_name) FILTER (WHERE r.role_name IS NOT NULL), '{}') AS roles
+	FROM users u
+	LEFT JOIN user_roles ur ON ur.user_id = u.user_id AND ur.is_deleted = false
+		AND (ur.expires_at IS NULL OR ur.expires_at > NOW())
+	LEFT JOIN roles r ON r.role_id = ur.role_id AND r.is_deleted = false
+`
+
+// CreateUser inserts the user and assigns the roles in user.Roles in one transaction
+func (r *PostgreSQLAccessRepository) CreateUser(ctx context.Context, user *auth.User, passwordHash string) error {
+	if user == nil {
+		return domain.NewValidationError("user cannot be nil")
+	}
+
+	if _, err := uuid.Parse(user.UserID); err != nil {
+		return domain.NewValidationError("invalid user ID format")
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO users (
+			user_id, username, email, password_hash, status, first_name, last_name,
+			created_at, updated_at, created_by, updated_by
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+		)
+	`
+
+	_, err = tx.ExecContext(
+		ctx,
+		query,
+		user.UserID,
+		user.Username,
+		user.Email,
+		passwordHash,
+		user.Status,
+		user.FirstName,
+		user.LastName,
+		user.CreatedAt,
+		user.UpdatedAt,
+		user.CreatedBy,
+		user.UpdatedBy,
+	)
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return domain.NewConflictError("a user with this email already exists")
+		}
+		return fmt.Errorf("failed to create user: %w", err)
+	}
+
+	for _, roleName := range user.Roles {
+		if err := assignRole(ctx, tx, user.UserID, roleName, user.CreatedBy); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit user creation: %w", err)
+	}
+
+	return nil
+}
+
+// GetUser retrieves a user by ID
+func (r *PostgreSQLAccessRepository) GetUser(ctx context.Context, userID string) (*auth.User, error) {
+	if _, err := uuid.Parse(userID); err != nil {
+		return nil, domain.NewValidationError("invalid user ID format")
+	}
+
+	query := selectUsers + `
+		WHERE u.user_id = $1 AND u.is_deleted = false
+		GROUP BY u.user_id
+	`
+
+	user, err := scanUser(r.db.QueryRowContext(ctx, query, userID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.NewNotFoundError("user", userID)
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return user, nil
+}
+
+// GetUserByEmail retrieves a user by email address, ignoring case
+func (r *PostgreSQLAccessRepository) GetUserByEmail(ctx context.Context, email string) (*auth.User, error) {
+	if email == "" {
+		return nil, domain.NewValidationError("email cannot be empty")
+	}
+
+	query := selectUsers + `
+		WHERE LOWER(u.email) = LOWER($1) AND u.is_deleted = false
+		GROUP BY u.user_id
+	`
+
+	user, err := scanUser(r.db.QueryRowContext(ctx, query, email))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.NewNotFoundError("user", email)
+		}
+		return nil, fmt.Errorf("failed to get user by email: %w", err)
+	}
+
+	return user, nil
+}
+
+// UpdateUser updates the profile and status of an existing user. Role assignments are
+// changed with SetUserRole.
+func (r *PostgreSQLAccessRepository) UpdateUser(ctx context.Context, user *auth.User) error {
+	if user == nil {
+		return domain.NewValidationError("user cannot be nil")
+	}
+
+	if _, err := uuid.Parse(user.UserID); err != nil {
+		return domain.NewValidationError("invalid user ID format")
+	}
+
+	query := `
+		UPDATE users
+		SET status = $2, first_name = $3, last_name = $4, updated_at = $5, updated_by = $6
+		WHERE user_id = $1 AND is_deleted = false
+	`
+
+	result, err := r.db.ExecContext(
+		ctx,
+		query,
+		user.UserID,
+		user.Status,
+		user.FirstName,
+		user.LastName,
+		user.UpdatedAt,
+		user.UpdatedBy,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update user: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.NewNotFoundError("user", user.UserID)
+	}
+
+	return nil
+}
+
+// DeleteUser soft deletes a user together with their role assignments
+func (r *PostgreSQLAccessRepository) DeleteUser(ctx context.Context, userID string, deletedBy string) error {
+	if deletedBy == "" {
+		return domain.NewValidationError("deleted by cannot be empty")
+	}
+
+	if _, err := uuid.Parse(userID); err != nil {
+		return domain.NewValidationError("invalid user ID format")
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	now := time.Now().UTC()
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE users
+		SET is_deleted = true, deleted_at = $2, updated_by = $3, updated_at = $2
+		WHERE user_id = $1 AND is_deleted = false
+	`, userID, now, deletedBy)
+	if err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.NewNotFoundError("user", userID)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE user_roles
+		SET is_deleted = true, deleted_at = $2, updated_by = $3, updated_at = $2
+		WHERE user_id = $1 AND is_deleted = false
+	`, userID, now, deletedBy)
+	if err != nil {
+		return fmt.Errorf("failed to remove user roles: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit user deletion: %w", err)
+	}
+
+	return nil
+}
+
+// ListUsers retrieves users matching filter, newest first, with the total match count
+func (r *PostgreSQLAccessRepository) ListUsers(ctx context.Context, filter UserListFilter, limit, offset int) ([]*auth.User, int, error) {
+	if limit < 0 {
+		return nil, 0, domain.NewValidationError("invalid limit parameter")
+	}
+
+	if offset < 0 {
+		return nil, 0, domain.NewValidationError("invalid offset parameter")
+	}
+
+	conditions := []string{"u.is_deleted = false"}
+	var args []interface{}
+
+	if filter.Search != "" {
+		args = append(args, "%"+filter.Search+"%")
+		conditions = append(conditions, fmt.Sprintf(
+			"(u.email ILIKE $%d OR u.first_name ILIKE $%d OR u.last_name ILIKE $%d)", len(args), len(args), len(args)))
+	}
+
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		conditions = append(conditions, fmt.Sprintf("u.status = $%d", len(args)))
+	}
+
+	if filter.Role != "" {
+		args = append(args, filter.Role)
+		conditions = append(conditions, fmt.Sprintf(`EXISTS (
+			SELECT 1 FROM user_roles fur
+			JOIN roles fr ON fr.role_id = fur.role_id AND fr.is_deleted = false
+			WHERE fur.user_id = u.user_id AND fur.is_deleted = false
+				AND (fur.expires_at IS NULL OR fur.expires_at > NOW())
+				AND fr.role_name = $%d
+		)`, len(args)))
+	}
+
+	where := " WHERE " + strings.Join(conditions, " AND ")
+
+	var total int
+	countQuery := "SELECT COUNT(*) FROM users u" + where
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count users: %w", err)
+	}
+
+	query := selectUsers + where + fmt.Sprintf(`
+		GROUP BY u.user_id
+		ORDER BY u.created_at DESC
+		LIMIT $%d OFFSET $%d
+	`, len(args)+1, len(args)+2)
+
+	rows, err := r.db.QueryContext(ctx, query, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list users: %w", err)
+	}
+	defer rows.Close()
+
+	var users []*auth.User
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan user: %w", err)
+		}
+		users = append(users, user)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating users: %w", err)
+	}
+
+	return users, total, nil
+}
+
+// SetUserRole makes roleName the only role assigned to the user
+func (r *PostgreSQLAccessRepository) SetUserRole(ctx context.Context, userID string, roleName string, assignedBy string) error {
+	if _, err := uuid.Parse(userID); err != nil {
+		return domain.NewValidationError("invalid user ID format")
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE user_roles
+		SET is_deleted = true, deleted_at = $3, updated_by = $4, updated_at = $3
+		WHERE user_id = $1 AND is_deleted = false
+			AND role_id NOT IN (SELECT role_id FROM roles WHERE role_name = $2)
+	`, userID, roleName, time.Now().UTC(), assignedBy)
+	if err != nil {
+		return fmt.Errorf("failed to remove previous roles: %w", err)
+	}
+
+	if err := assignRole(ctx, tx, userID, roleName, assignedBy); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit role assignment: %w", err)
+	}
+
+	return nil
+}
+
+// GetRolesByName retrieves the named roles that exist
+func (r *PostgreSQLAccessRepository) GetRolesByName(ctx context.Context, roleNames []string) ([]auth.Role, error) {
+	if len(roleNames) == 0 {
+		return nil, nil
+	}
+
+	query := `
+		SELECT role_id, role_name, COALESCE(description, ''), is_system_role, permissions
+		FROM roles
+		WHERE role_name = ANY($1) AND is_deleted = false
+		ORDER BY role_name
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(roleNames))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get roles: %w", err)
+	}
+	defer rows.Close()
+
+	var roles []auth.Role
+	for rows.Next() {
+		var role auth.Role
+		var permissions pq.StringArray
+		if err := rows.Scan(&role.RoleID, &role.RoleName, &role.Description, &role.IsSystemRole, &permissions); err != nil {
+			return nil, fmt.Errorf("failed to scan role: %w", err)
+		}
+
+		role.Permissions = make([]auth.Permission, len(permissions))
+		for i, permission := range permissions {
+			role.Permissions[i] = auth.Permission(permission)
+		}
+		roles = append(roles, role)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating roles: %w", err)
+	}
+
+	return roles, nil
+}
+
+// CountActiveUsersWithRole counts active users currently assigned roleName
+func (r *PostgreSQLAccessRepository) CountActiveUsersWithRole(ctx context.Context, roleName string) (int, error) {
+	query := `
+		SELECT COUNT(DISTINCT u.user_id)
+		FROM users u
+		JOIN user_roles ur ON ur.user_id = u.user_id AND ur.is_deleted = false
+			AND (ur.expires_at IS NULL OR ur.expires_at > NOW())
+		JOIN roles r ON r.role_id = ur.role_id AND r.is_deleted = false
+		WHERE r.role_name = $1 AND u.status = 'active' AND u.is_deleted = false
+	`
+
+	var count int
+	if err := r.db.QueryRowContext(ctx, query, roleName).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count users with role: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetLoginState retrieves the password hash and failed sign-in tracking of a user
+func (r *PostgreSQLAccessRepository) GetLoginState(ctx context.Context, userID string) (*LoginState, error) {
+	query := `
+		SELECT password_hash, failed_login_attempts, locked_until
+		FROM users
+		WHERE user_id = $1 AND is_deleted = false
+	`
+
+	state := &LoginState{}
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(&state.PasswordHash, &state.FailedLoginAttempts, &state.LockedUntil)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.NewNotFoundError("user", userID)
+		}
+		return nil, fmt.Errorf("failed to get login state: %w", err)
+	}
+
+	return state, nil
+}
+
+// RecordLoginFailure counts a failed sign-in. When lockedUntil is set the account is
+// locked until then and the count starts over.
+func (r *PostgreSQLAccessRepository) RecordLoginFailure(ctx context.Context, userID string, lockedUntil *time.Time) error {
+	query := `
+		UPDATE users
+		SET failed_login_attempts = CASE WHEN $2::timestamptz IS NULL THEN failed_login_attempts + 1 ELSE 0 END,
+			locked_until = COALESCE($2::timestamptz, locked_until)
+		WHERE user_id = $1 AND is_deleted = false
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, userID, lockedUntil); err != nil {
+		return fmt.Errorf("failed to record login failure: %w", err)
+	}
+
+	return nil
+}
+
+// RecordLoginSuccess clears failed sign-in tracking and stamps the last login
+func (r *PostgreSQLAccessRepository) RecordLoginSuccess(ctx context.Context, userID string, at time.Time) error {
+	query := `
+		UPDATE users
+		SET failed_login_attempts = 0, locked_until = NULL, last_login_at = $2
+		WHERE user_id = $1 AND is_deleted = false
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, userID, at); err != nil {
+		return fmt.Errorf("failed to record login success: %w", err)
+	}
+
+	return nil
+}
+
+// assignRole assigns roleName to the user, restoring an earlier removed assignment
+// because user_roles allows one row per user and role
+func assignRole(ctx context.Context, tx *sql.Tx, userID string, roleName string, assignedBy string) error {
+	query := `
+		INSERT INTO user_roles (user_id, role_id, assigned_by, created_by, updated_by)
+		SELECT $1, role_id, $3, $3, $3 FROM roles WHERE role_name = $2 AND is_deleted = false
+		ON CONFLICT (user_id, role_id) DO UPDATE
+		SET is_deleted = false, deleted_at = NULL, expires_at = NULL,
+			assigned_by = EXCLUDED.assigned_by, updated_by = EXCLUDED.updated_by, updated_at = NOW()
+	`
+
+	result, err := tx.ExecContext(ctx, query, userID, roleName, assignedBy)
+	if err != nil {
+		return fmt.Errorf("failed to assign role: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.NewNotFoundError("role", roleName)
+	}
+
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanUser(row rowScanner) (*auth.User, error) {
+	user := &auth.User{}
+	var roles pq.StringArray
+
+	err := row.Scan(
+		&user.UserID,
+		&user.Username,
+		&user.Email,
+		&user.Status,
+		&user.FirstName,
+		&user.LastName,
+		&user.LastLoginAt,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+		&user.CreatedBy,
+		&user.UpdatedBy,
+		&roles,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	user.Roles = []string(roles)
+	return user, nil
+}
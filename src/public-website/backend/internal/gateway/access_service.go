@@ -0,0 +1,572 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/auth"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/google/uuid"
+)
+
+// PrincipalCacheTTL bounds how long a resolved principal is reused. Changes made
+// through the service take effect at once; changes made directly in the database
+// take effect within this window.
+const PrincipalCacheTTL = 30 * time.Second
+
+// socialLoginPasswordHash marks accounts that only sign in through a social provider.
+// auth.VerifyPassword never matches it.
+const socialLoginPasswordHash = "!social-login-only"
+
+// Password sign-in locks an account for LoginLockoutDuration after MaxFailedLoginAttempts
+// consecutive failures
+const (
+	MaxFailedLoginAttempts = 5
+	LoginLockoutDuration   = 15 * time.Minute
+)
+
+// errInvalidCredentials is deliberately the same for unknown emails and wrong passwords
+var errInvalidCredentials = domain.NewUnauthorizedError("invalid email or password")
+
+// timingPasswordHash is verified against when the email is unknown, so that a miss
+// takes as long as a wrong password
+var timingPasswordHash = sync.OnceValue(func() string {
+	hash, _ := auth.HashPassword(uuid.New().String())
+	return hash
+})
+
+var adminEmailRegex = regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
+
+// CreateUserRequest holds the fields needed to create an admin user
+type CreateUserRequest struct {
+	Email     string `json:"email"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Password  string `json:"password"`
+	Role      string `json:"role"`
+	CreatedBy string `json:"created_by"`
+}
+
+// UpdateUserRequest holds the admin user fields to change; nil fields are kept
+type UpdateUserRequest struct {
+	FirstName *string          `json:"first_name,omitempty"`
+	LastName  *string          `json:"last_name,omitempty"`
+	Role      *string          `json:"role,omitempty"`
+	Status    *auth.UserStatus `json:"status,omitempty"`
+	UpdatedBy string           `json:"updated_by"`
+}
+
+// AccessControlService manages admin users and resolves the principal a request acts for
+type AccessControlService interface {
+	CreateUser(ctx context.Context, req *CreateUserRequest) (*auth.User, error)
+	GetUser(ctx context.Context, userID string) (*auth.User, error)
+	UpdateUser(ctx context.Context, userID string, req *UpdateUserRequest) (*auth.User, error)
+	DeleteUser(ctx context.Context, userID string, deletedBy string) error
+	ListUsers(ctx context.Context, filter UserListFilter, page, pageSize int) ([]*auth.User, int, error)
+	ResolvePrincipal(ctx context.Context, email string) (*auth.Principal, error)
+	EnsureBootstrapAdmins(ctx context.Context, emails []string) error
+	AuthenticatePassword(ctx context.Context, email string, password string) (*auth.User, error)
+}
+
+// SessionRevoker ends the sessions of a user, for when their access is taken away
+type SessionRevoker interface {
+	RevokeAll(ctx context.Context, userID string, reason string) (int, error)
+}
+
+type cachedPrincipal struct {
+	principal *auth.Principal
+	expiresAt time.Time
+}
+
+// DefaultAccessControlService implements AccessControlService
+type DefaultAccessControlService struct {
+	repository AccessRepository
+	sessions   SessionRevoker
+	now        func() time.Time
+
+	cacheMu    sync.Mutex
+	principals map[string]cachedPrincipal
+}
+
+// NewDefaultAccessControlService creates a new default access control service
+func NewDefaultAccessControlService(repository AccessRepository) *DefaultAccessControlService {
+	return &DefaultAccessControlService{
+		repository: repository,
+		now:        time.Now,
+		principals: make(map[string]cachedPrincipal),
+	}
+}
+
+// SetSessionRevoker makes role changes, suspensions and deletions end the sessions of
+// the user concerned
+func (s *DefaultAccessControlService) SetSessionRevoker(sessions SessionRevoker) {
+	s.sessions = sessions
+}
+
+// CreateUser creates an admin user with a single role
+func (s *DefaultAccessControlService) CreateUser(ctx context.Context, req *CreateUserRequest) (*auth.User, error) {
+	if req == nil {
+		return nil, domain.NewValidationError("create request cannot be nil")
+	}
+
+	if err := s.validateCreateRequest(req); err != nil {
+		return nil, err
+	}
+
+	passwordHash, err := auth.HashPassword(req.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ensureRoleExists(ctx, req.Role); err != nil {
+		return nil, err
+	}
+
+	email := normalizeEmail(req.Email)
+	now := s.now().UTC()
+	user := &auth.User{
+		UserID:    uuid.New().String(),
+		Username:  email,
+		Email:     email,
+		Status:    auth.UserStatusActive,
+		FirstName: strings.TrimSpace(req.FirstName),
+		LastName:  strings.TrimSpace(req.LastName),
+		CreatedAt: now,
+		UpdatedAt: now,
+		CreatedBy: req.CreatedBy,
+		UpdatedBy: req.CreatedBy,
+		Roles:     []string{req.Role},
+	}
+
+	if err := s.repository.CreateUser(ctx, user, passwordHash); err != nil {
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+
+	s.invalidatePrincipals()
+	return user, nil
+}
+
+// GetUser retrieves an admin user by ID
+func (s *DefaultAccessControlService) GetUser(ctx context.Context, userID string) (*auth.User, error) {
+	if _, err := uuid.Parse(userID); err != nil {
+		return nil, domain.NewValidationError("invalid user ID format")
+	}
+
+	user, err := s.repository.GetUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return user, nil
+}
+
+// UpdateUser changes the profile, status or role of an admin user. Users cannot lock
+// themselves out, and the last active admin cannot be demoted or deactivated.
+func (s *DefaultAccessControlService) UpdateUser(ctx context.Context, userID string, req *UpdateUserRequest) (*auth.User, error) {
+	if req == nil {
+		return nil, domain.NewValidationError("update request cannot be nil")
+	}
+
+	if err := s.validateUpdateRequest(req); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	roleChanged := req.Role != nil && !(len(existing.Roles) == 1 && existing.Roles[0] == *req.Role)
+	statusChanged := req.Status != nil && *req.Status != existing.Status
+
+	losesAdmin := existing.HasRole(auth.RoleAdmin) && existing.Status == auth.UserStatusActive &&
+		((roleChanged && *req.Role != auth.RoleAdmin) || (statusChanged && *req.Status != auth.UserStatusActive))
+
+	if req.UpdatedBy == existing.UserID && (statusChanged || roleChanged) {
+		return nil, domain.NewForbiddenError("users cannot change their own role or status")
+	}
+
+	if losesAdmin {
+		if err := s.ensureAnotherAdmin(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if roleChanged {
+		if err := s.ensureRoleExists(ctx, *req.Role); err != nil {
+			return nil, err
+		}
+	}
+
+	updated := *existing
+	if req.FirstName != nil {
+		updated.FirstName = strings.TrimSpace(*req.FirstName)
+	}
+	if req.LastName != nil {
+		updated.LastName = strings.TrimSpace(*req.LastName)
+	}
+	if req.Status != nil {
+		updated.Status = *req.Status
+	}
+	updated.UpdatedAt = s.now().UTC()
+	updated.UpdatedBy = req.UpdatedBy
+
+	if err := s.repository.UpdateUser(ctx, &updated); err != nil {
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	if roleChanged {
+		if err := s.repository.SetUserRole(ctx, userID, *req.Role, req.UpdatedBy); err != nil {
+			return nil, fmt.Errorf("failed to assign role: %w", err)
+		}
+		updated.Roles = []string{*req.Role}
+	}
+
+	s.invalidatePrincipals()
+	if roleChanged || (statusChanged && updated.Status != auth.UserStatusActive) {
+		s.revokeSessions(ctx, userID)
+	}
+	return &updated, nil
+}
+
+// DeleteUser soft deletes an admin user. Users cannot delete themselves, and the last
+// active admin cannot be deleted.
+func (s *DefaultAccessControlService) DeleteUser(ctx context.Context, userID string, deletedBy string) error {
+	if deletedBy == "" {
+		return domain.NewValidationError("deleted by cannot be empty")
+	}
+
+	existing, err := s.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if deletedBy == existing.UserID {
+		return domain.NewForbiddenError("users cannot delete themselves")
+	}
+
+	if existing.HasRole(auth.RoleAdmin) && existing.Status == auth.UserStatusActive {
+		if err := s.ensureAnotherAdmin(ctx); err != nil {
+			return err
+		}
+	}
+
+	if err := s.repository.DeleteUser(ctx, userID, deletedBy); err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	s.invalidatePrincipals()
+	s.revokeSessions(ctx, userID)
+	return nil
+}
+
+// ListUsers retrieves a page of admin users with the total number of matches
+func (s *DefaultAccessControlService) ListUsers(ctx context.Context, filter UserListFilter, page, pageSize int) ([]*auth.User, int, error) {
+	if page < 1 {
+		page = 1
+	}
+
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	if filter.Status != "" && !filter.Status.IsValid() {
+		return nil, 0, domain.NewValidationError(fmt.Sprintf("invalid status filter: %s", filter.Status))
+	}
+
+	filter.Search = strings.TrimSpace(filter.Search)
+
+	users, total, err := s.repository.ListUsers(ctx, filter, pageSize, (page-1)*pageSize)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	return users, total, nil
+}
+
+// ResolvePrincipal loads the active admin user with email and the permissions of their
+// roles. Unknown and inactive accounts are forbidden rather than unauthorized: the
+// caller has proven who they are, they just have no access.
+func (s *DefaultAccessControlService) ResolvePrincipal(ctx context.Context, email string) (*auth.Principal, error) {
+	email = normalizeEmail(email)
+	if email == "" {
+		return nil, domain.NewUnauthorizedError("email is required to resolve a principal")
+	}
+
+	if principal, ok := s.cachedPrincipal(email); ok {
+		return principal, nil
+	}
+
+	user, err := s.repository.GetUserByEmail(ctx, email)
+	if err != nil {
+		if domain.IsNotFoundError(err) {
+			return nil, domain.NewForbiddenError("no admin account exists for this email")
+		}
+		return nil, fmt.Errorf("failed to get user by email: %w", err)
+	}
+
+	if user.Status != auth.UserStatusActive {
+		return nil, domain.NewForbiddenError(fmt.Sprintf("admin account is %s", user.Status))
+	}
+
+	roles, err := s.repository.GetRolesByName(ctx, user.Roles)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user roles: %w", err)
+	}
+
+	principal := auth.NewPrincipal(user, roles)
+
+	s.cacheMu.Lock()
+	s.principals[email] = cachedPrincipal{principal: principal, expiresAt: s.now().Add(PrincipalCacheTTL)}
+	s.cacheMu.Unlock()
+
+	return principal, nil
+}
+
+// AuthenticatePassword checks the email and password of an admin user signing in.
+// Repeated failures lock the account for a while; unknown emails and wrong passwords
+// fail alike so the response does not reveal which accounts exist.
+func (s *DefaultAccessControlService) AuthenticatePassword(ctx context.Context, email string, password string) (*auth.User, error) {
+	email = normalizeEmail(email)
+	if email == "" || password == "" {
+		return nil, domain.NewValidationError("email and password are required")
+	}
+
+	user, err := s.repository.GetUserByEmail(ctx, email)
+	if err != nil {
+		if domain.IsNotFoundError(err) {
+			auth.VerifyPassword(timingPasswordHash(), password)
+			return nil, errInvalidCredentials
+		}
+		return nil, fmt.Errorf("failed to get user by email: %w", err)
+	}
+
+	state, err := s.repository.GetLoginState(ctx, user.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get login state: %w", err)
+	}
+
+	now := s.now().UTC()
+	if state.LockedUntil != nil && now.Before(*state.LockedUntil) {
+		return nil, domain.NewRateLimitError(fmt.Sprintf("too many failed sign-in attempts, try again after %s", state.LockedUntil.UTC().Format(time.RFC3339)))
+	}
+
+	if !auth.VerifyPassword(state.PasswordHash, password) {
+		var lockedUntil *time.Time
+		if state.FailedLoginAttempts+1 >= MaxFailedLoginAttempts {
+			until := now.Add(LoginLockoutDuration)
+			lockedUntil = &until
+		}
+		if err := s.repository.RecordLoginFailure(ctx, user.UserID, lockedUntil); err != nil {
+			return nil, fmt.Errorf("failed to record login failure: %w", err)
+		}
+		return nil, errInvalidCredentials
+	}
+
+	if user.Status != auth.UserStatusActive {
+		return nil, domain.NewForbiddenError(fmt.Sprintf("admin account is %s", user.Status))
+	}
+
+	if err := s.repository.RecordLoginSuccess(ctx, user.UserID, now); err != nil {
+		return nil, fmt.Errorf("failed to record login success: %w", err)
+	}
+	user.LastLoginAt = &now
+
+	return user, nil
+}
+
+// EnsureBootstrapAdmins creates an admin account for each email that has none, so a
+// fresh deployment has someone who can manage users. Existing accounts are left
+// untouched; a bootstrap email never re-promotes a user who was demoted.
+func (s *DefaultAccessControlService) EnsureBootstrapAdmins(ctx context.Context, emails []string) error {
+	for _, email := range emails {
+		email = normalizeEmail(email)
+		if email == "" {
+			continue
+		}
+
+		if err := s.validateEmail(email); err != nil {
+			return fmt.Errorf("invalid bootstrap admin email %q: %w", email, err)
+		}
+
+		_, err := s.repository.GetUserByEmail(ctx, email)
+		if err == nil {
+			continue
+		}
+		if !domain.IsNotFoundError(err) {
+			return fmt.Errorf("failed to look up bootstrap admin: %w", err)
+		}
+
+		localPart := strings.SplitN(email, "@", 2)[0]
+		now := s.now().UTC()
+		user := &auth.User{
+			UserID:    uuid.New().String(),
+			Username:  email,
+			Email:     email,
+			Status:    auth.UserStatusActive,
+			FirstName: localPart,
+			LastName:  "Administrator",
+			CreatedAt: now,
+			UpdatedAt: now,
+			CreatedBy: "system",
+			UpdatedBy: "system",
+			Roles:     []string{auth.RoleAdmin},
+		}
+
+		if err := s.repository.CreateUser(ctx, user, socialLoginPasswordHash); err != nil && !domain.IsConflictError(err) {
+			return fmt.Errorf("failed to create bootstrap admin: %w", err)
+		}
+	}
+
+	s.invalidatePrincipals()
+	return nil
+}
+
+// Private methods
+
+func (s *DefaultAccessControlService) cachedPrincipal(email string) (*auth.Principal, bool) {
+	s.cacheMu.Lock()
+	defer s.cacheMu.Unlock()
+
+	cached, ok := s.principals[email]
+	if !ok {
+		return nil, false
+	}
+	if !s.now().Before(cached.expiresAt) {
+		delete(s.principals, email)
+		return nil, false
+	}
+	return cached.principal, true
+}
+
+// invalidatePrincipals drops every cached principal. Any change can affect whom a
+// cached principal belongs to or what it may do, and changes are rare.
+func (s *DefaultAccessControlService) invalidatePrincipals() {
+	s.cacheMu.Lock()
+	s.principals = make(map[string]cachedPrincipal)
+	s.cacheMu.Unlock()
+}
+
+// revokeSessions ends the sessions of a user whose access changed. A failure is only
+// logged: the change itself is saved, and every request resolves the principal of its
+// session again, so the sessions cannot keep acting on the old access.
+func (s *DefaultAccessControlService) revokeSessions(ctx context.Context, userID string) {
+	if s.sessions == nil {
+		return
+	}
+	if _, err := s.sessions.RevokeAll(ctx, userID, auth.RevokedOnAccountChange); err != nil {
+		log.Printf("Failed to revoke sessions of user %s: %v", userID, err)
+	}
+}
+
+// ensureAnotherAdmin fails if removing one active admin would leave none
+func (s *DefaultAccessControlService) ensureAnotherAdmin(ctx context.Context) error {
+	admins, err := s.repository.CountActiveUsersWithRole(ctx, auth.RoleAdmin)
+	if err != nil {
+		return fmt.Errorf("failed to count admins: %w", err)
+	}
+	if admins <= 1 {
+		return domain.NewConflictError("the last active admin cannot be removed")
+	}
+	return nil
+}
+
+func (s *DefaultAccessControlService) ensureRoleExists(ctx context.Context, roleName string) error {
+	roles, err := s.repository.GetRolesByName(ctx, []string{roleName})
+	if err != nil {
+		return fmt.Errorf("failed to get role: %w", err)
+	}
+	if len(roles) == 0 {
+		return domain.NewValidationError(fmt.Sprintf("unknown role: %s", roleName))
+	}
+	return nil
+}
+
+func (s *DefaultAccessControlService) validateCreateRequest(req *CreateUserRequest) error {
+	if err := s.validateEmail(req.Email); err != nil {
+		return err
+	}
+
+	if err := s.validateName("first name", req.FirstName); err != nil {
+		return err
+	}
+
+	if err := s.validateName("last name", req.LastName); err != nil {
+		return err
+	}
+
+	if req.Role == "" {
+		return domain.NewValidationError("role is required")
+	}
+
+	if req.CreatedBy == "" {
+		return domain.NewValidationError("created by is required")
+	}
+
+	return nil
+}
+
+func (s *DefaultAccessControlService) validateUpdateRequest(req *UpdateUserRequest) error {
+	if req.FirstName != nil {
+		if err := s.validateName("first name", *req.FirstName); err != nil {
+			return err
+		}
+	}
+
+	if req.LastName != nil {
+		if err := s.validateName("last name", *req.LastName); err != nil {
+			return err
+		}
+	}
+
+	if req.Role != nil && *req.Role == "" {
+		return domain.NewValidationError("role cannot be empty")
+	}
+
+	if req.Status != nil && !req.Status.IsValid() {
+		return domain.NewValidationError(fmt.Sprintf("invalid status: %s", *req.Status))
+	}
+
+	if req.UpdatedBy == "" {
+		return domain.NewValidationError("updated by is required")
+	}
+
+	return nil
+}
+
+// validateEmail checks the email format. The email doubles as the username, so it is
+// held to the 100 character username limit.
+func (s *DefaultAccessControlService) validateEmail(email string) error {
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return domain.NewValidationError("email is required")
+	}
+
+	if len(email) > 100 || !adminEmailRegex.MatchString(email) {
+		return domain.NewValidationError("invalid email format")
+	}
+
+	return nil
+}
+
+func (s *DefaultAccessControlService) validateName(field string, name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return domain.NewValidationError(fmt.Sprintf("%s is required", field))
+	}
+
+	if len(name) > 50 {
+		return domain.NewValidationError(fmt.Sprintf("%s cannot exceed 50 characters", field))
+	}
+
+	return nil
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
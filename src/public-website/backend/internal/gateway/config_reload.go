@@ -0,0 +1,127 @@
+package gateway
+
+import (
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
+)
+
+// RateLimitSettings returns the rate limiting settings currently in effect
+func (c *GatewayConfiguration) RateLimitSettings() RateLimitConfig {
+	c.reloadMutex.RLock()
+	defer c.reloadMutex.RUnlock()
+	return c.RateLimit
+}
+
+// CORSSettings returns the CORS settings currently in effect
+func (c *GatewayConfiguration) CORSSettings() CORSConfig {
+	c.reloadMutex.RLock()
+	defer c.reloadMutex.RUnlock()
+	return c.CORS
+}
+
+// CacheControlSettings returns the cache control settings currently in effect
+func (c *GatewayConfiguration) CacheControlSettings() CacheControlConfig {
+	c.reloadMutex.RLock()
+	defer c.reloadMutex.RUnlock()
+	return c.CacheControl
+}
+
+// ObservabilitySettings returns the observability toggles currently in effect
+func (c *GatewayConfiguration) ObservabilitySettings() ObservabilityConfig {
+	c.reloadMutex.RLock()
+	defer c.reloadMutex.RUnlock()
+	return c.Observability
+}
+
+// ConfigKey names a reloadable setting of this gateway in the configuration store,
+// e.g. "public-gateway.cors.allowed_origins"
+func (c *GatewayConfiguration) ConfigKey(setting string) string {
+	return c.Name + "." + setting
+}
+
+// ConfigListener reloads rate limiting, CORS, cache control and the tracing and
+// logging toggles from the configuration store. A key that is removed from the
+// store reverts to the setting the gateway started with.
+func (c *GatewayConfiguration) ConfigListener() dapr.ConfigListener {
+	c.reloadMutex.RLock()
+	startup := struct {
+		rateLimit     RateLimitConfig
+		cors          CORSConfig
+		cacheControl  CacheControlConfig
+		observability ObservabilityConfig
+	}{c.RateLimit, c.CORS, c.CacheControl, c.Observability}
+	c.reloadMutex.RUnlock()
+
+	settings := []string{
+		"rate_limit.enabled", "rate_limit.requests_per_minute", "rate_limit.burst_size", "rate_limit.algorithm",
+		"cors.enabled", "cors.allowed_origins", "cors.allowed_methods",
+		"cache_control.enabled", "cache_control.max_age", "cache_control.stale_while_revalidate",
+		"observability.tracing_enabled", "observability.logging_enabled",
+	}
+	keys := make([]string, 0, len(settings))
+	for _, setting := range settings {
+		keys = append(keys, c.ConfigKey(setting))
+	}
+
+	return dapr.ConfigListener{
+		Name: c.Name,
+		Keys: keys,
+		Prepare: func(values dapr.ConfigValues) (func(), error) {
+			var err error
+			rateLimit := startup.rateLimit
+			if rateLimit.Enabled, err = values.Bool(c.ConfigKey("rate_limit.enabled"), rateLimit.Enabled); err != nil {
+				return nil, err
+			}
+			if rateLimit.RequestsPerMinute, err = values.Int(c.ConfigKey("rate_limit.requests_per_minute"), rateLimit.RequestsPerMinute); err != nil {
+				return nil, err
+			}
+			if rateLimit.BurstSize, err = values.Int(c.ConfigKey("rate_limit.burst_size"), rateLimit.BurstSize); err != nil {
+				return nil, err
+			}
+			rateLimit.Algorithm = values.String(c.ConfigKey("rate_limit.algorithm"), rateLimit.Algorithm)
+			if err := rateLimit.Validate(); err != nil {
+				return nil, err
+			}
+
+			cors := startup.cors
+			if cors.Enabled, err = values.Bool(c.ConfigKey("cors.enabled"), cors.Enabled); err != nil {
+				return nil, err
+			}
+			cors.AllowedOrigins = values.List(c.ConfigKey("cors.allowed_origins"), cors.AllowedOrigins)
+			cors.AllowedMethods = values.List(c.ConfigKey("cors.allowed_methods"), cors.AllowedMethods)
+			if err := cors.Validate(); err != nil {
+				return nil, err
+			}
+
+			cacheControl := startup.cacheControl
+			if cacheControl.Enabled, err = values.Bool(c.ConfigKey("cache_control.enabled"), cacheControl.Enabled); err != nil {
+				return nil, err
+			}
+			if cacheControl.MaxAge, err = values.Int(c.ConfigKey("cache_control.max_age"), cacheControl.MaxAge); err != nil {
+				return nil, err
+			}
+			if cacheControl.StaleWhileRevalidate, err = values.Int(c.ConfigKey("cache_control.stale_while_revalidate"), cacheControl.StaleWhileRevalidate); err != nil {
+				return nil, err
+			}
+			if err := cacheControl.Validate(); err != nil {
+				return nil, err
+			}
+
+			observability := startup.observability
+			if observability.TracingEnabled, err = values.Bool(c.ConfigKey("observability.tracing_enabled"), observability.TracingEnabled); err != nil {
+				return nil, err
+			}
+			if observability.LoggingEnabled, err = values.Bool(c.ConfigKey("observability.logging_enabled"), observability.LoggingEnabled); err != nil {
+				return nil, err
+			}
+
+			return func() {
+				c.reloadMutex.Lock()
+				defer c.reloadMutex.Unlock()
+				c.RateLimit = rateLimit
+				c.CORS = cors
+				c.CacheControl = cacheControl
+				c.Observability = observability
+			}, nil
+		},
+	}
+}
@@ -0,0 +1,101 @@
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createConfigReloadTestConfiguration returns the startup settings the reload cases
+// compare against; keys in the store are prefixed with its name.
+func createConfigReloadTestConfiguration() *GatewayConfiguration {
+	return &GatewayConfiguration{
+		Name:        "test-gateway",
+		Type:        GatewayTypePublic,
+		Environment: "test",
+		RateLimit: RateLimitConfig{
+			Enabled:           true,
+			RequestsPerMinute: 1000,
+			BurstSize:         100,
+			WindowSize:        time.Minute,
+			KeyExtractor:      "ip",
+			BackingStore:      "state_store",
+		},
+		CORS: CORSConfig{
+			Enabled:        true,
+			AllowedOrigins: []string{"http://localhost:3000"},
+			AllowedMethods: []string{"GET", "OPTIONS"},
+		},
+		Observability: ObservabilityConfig{
+			Enabled:        true,
+			TracingEnabled: true,
+			LoggingEnabled: true,
+		},
+	}
+}
+
+func TestGatewayConfiguration_ConfigListener(t *testing.T) {
+	tests := []struct {
+		name                 string
+		change               map[string]string
+		expectedError        bool
+		expectedRequests     int
+		expectedOrigins      []string
+		expectedCacheEnabled bool
+		expectedTracing      bool
+	}{
+		{name: "raise rate limit and add origin", change: map[string]string{
+			"test-gateway.rate_limit.requests_per_minute": "1500",
+			"test-gateway.cors.allowed_origins":           "http://localhost:3000,https://international-center.org",
+		}, expectedRequests: 1500, expectedOrigins: []string{"http://localhost:3000", "https://international-center.org"}, expectedTracing: true},
+		{name: "toggle cache control and tracing", change: map[string]string{
+			"test-gateway.cache_control.enabled":         "true",
+			"test-gateway.observability.tracing_enabled": "false",
+		}, expectedRequests: 1000, expectedOrigins: []string{"http://localhost:3000"}, expectedCacheEnabled: true},
+		{name: "reject zero burst size", change: map[string]string{
+			"test-gateway.rate_limit.requests_per_minute": "1500",
+			"test-gateway.rate_limit.burst_size":          "0",
+		}, expectedError: true, expectedRequests: 1000, expectedOrigins: []string{"http://localhost:3000"}, expectedTracing: true},
+		{name: "reject unknown rate limit algorithm", change: map[string]string{
+			"test-gateway.rate_limit.algorithm": "leaky_bucket",
+		}, expectedError: true, expectedRequests: 1000, expectedOrigins: []string{"http://localhost:3000"}, expectedTracing: true},
+		{name: "reject CORS without origins", change: map[string]string{
+			"test-gateway.cors.allowed_origins": " , ",
+		}, expectedError: true, expectedRequests: 1000, expectedOrigins: []string{"http://localhost:3000"}, expectedTracing: true},
+		{name: "reject negative cache max age", change: map[string]string{
+			"test-gateway.cache_control.max_age": "-1",
+		}, expectedError: true, expectedRequests: 1000, expectedOrigins: []string{"http://localhost:3000"}, expectedTracing: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			config := createConfigReloadTestConfiguration()
+
+			reloader := dapr.NewConfigReloader(nil)
+			require.NoError(t, reloader.Register(config.ConfigListener()))
+
+			items := map[string]*dapr.ConfigItem{}
+			for key, value := range tt.change {
+				items[key] = &dapr.ConfigItem{Key: key, Value: value}
+			}
+
+			// Act
+			err := reloader.Apply(items)
+
+			// Assert
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedRequests, config.RateLimitSettings().RequestsPerMinute)
+			assert.Equal(t, tt.expectedOrigins, config.CORSSettings().AllowedOrigins)
+			assert.Equal(t, tt.expectedCacheEnabled, config.CacheControlSettings().Enabled)
+			assert.Equal(t, tt.expectedTracing, config.ObservabilitySettings().TracingEnabled)
+		})
+	}
+}
@@ -6,7 +6,10 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/ratelimit"
 )
 
 // GatewayType defines the type of gateway (public or admin)
@@ -45,6 +48,9 @@ type GatewayConfiguration struct {
 	
 	// Observability configuration
 	Observability ObservabilityConfig `json:"observability"`
+
+	// reloadMutex guards the sections the configuration store can change at runtime
+	reloadMutex sync.RWMutex
 }
 
 // SecurityConfig defines security-related configuration
@@ -67,12 +73,28 @@ type SecurityHeadersConfig struct {
 
 // RateLimitConfig defines rate limiting configuration
 type RateLimitConfig struct {
-	Enabled           bool          `json:"enabled"`
-	RequestsPerMinute int           `json:"requests_per_minute"`
-	BurstSize         int           `json:"burst_size"`
-	WindowSize        time.Duration `json:"window_size"`
-	KeyExtractor      string        `json:"key_extractor"` // "ip" or "user"
-	BackingStore      string        `json:"backing_store"` // "redis" or "memory"
+	Enabled           bool             `json:"enabled"`
+	RequestsPerMinute int              `json:"requests_per_minute"`
+	BurstSize         int              `json:"burst_size"`
+	WindowSize        time.Duration    `json:"window_size"`
+	Algorithm         string           `json:"algorithm"`       // "token_bucket" or "sliding_window"
+	KeyExtractor      string           `json:"key_extractor"`   // "ip", "user" or "api_key"
+	APIKeyHeader      string           `json:"api_key_header"`  // read by the "api_key" extractor
+	APIKeyDigests     []string         `json:"api_key_digests"` // SHA-256 of the keys the "api_key" extractor accepts
+	TrustedProxies    []string         `json:"trusted_proxies"`
+	BackingStore      string           `json:"backing_store"` // "state_store" or "memory"
+	Routes            []RouteRateLimit `json:"routes"`
+}
+
+// RouteRateLimit replaces the gateway rate limit for requests matching a path prefix
+// and, when given, one of its methods. The first matching route applies.
+type RouteRateLimit struct {
+	Name              string   `json:"name"`
+	PathPrefix        string   `json:"path_prefix"`
+	Methods           []string `json:"methods"`
+	RequestsPerMinute int      `json:"requests_per_minute"`
+	BurstSize         int      `json:"burst_size"`
+	KeyExtractor      string   `json:"key_extractor"` // defaults to the gateway key extractor
 }
 
 // CORSConfig defines CORS configuration
@@ -86,10 +108,18 @@ type CORSConfig struct {
 	MaxAge           int      `json:"max_age"`
 }
 
-// CacheControlConfig defines cache control configuration
+// CacheControlConfig defines cache control configuration. With the response cache on,
+// MaxAge is how long the gateway serves a cached response and ClientMaxAge how long
+// clients may reuse it before revalidating with its ETag.
 type CacheControlConfig struct {
-	Enabled bool `json:"enabled"`
-	MaxAge  int  `json:"max_age"`
+	Enabled                  bool          `json:"enabled"`
+	MaxAge                   int           `json:"max_age"`
+	ResponseCacheEnabled     bool          `json:"response_cache_enabled"`
+	ClientMaxAge             int           `json:"client_max_age"`
+	StaleWhileRevalidate     int           `json:"stale_while_revalidate"`
+	MaxEntries               int           `json:"max_entries"`
+	MaxEntryBytes            int           `json:"max_entry_bytes"`
+	InvalidationPollInterval time.Duration `json:"invalidation_poll_interval"`
 }
 
 // ServiceRoutingConfig defines service routing configuration
@@ -175,8 +205,17 @@ func NewPublicGatewayConfiguration() *GatewayConfiguration {
 			RequestsPerMinute: 1000, // Higher limit for public access
 			BurstSize:         100,
 			WindowSize:        time.Minute,
+			Algorithm:         string(ratelimit.TokenBucket),
 			KeyExtractor:      "ip",
-			BackingStore:      "redis",
+			APIKeyHeader:      "X-API-Key",
+			APIKeyDigests:     strings.Split(os.Getenv("GATEWAY_API_KEY_DIGESTS"), ","),
+			TrustedProxies:    strings.Split(os.Getenv("GATEWAY_TRUSTED_PROXIES"), ","),
+			BackingStore:      "state_store",
+			Routes: []RouteRateLimit{
+				// Inquiry submissions reach staff inboxes, so they get a much tighter limit
+				{Name: "inquiries", PathPrefix: "/api/v1/inquiries", Methods: []string{"POST"}, RequestsPerMinute: 10, BurstSize: 5},
+				{Name: "search", PathPrefix: "/api/v1/search", Methods: []string{"GET"}, RequestsPerMinute: 120, BurstSize: 30},
+			},
 		},
 		
 		CORS: CORSConfig{
@@ -190,8 +229,14 @@ func NewPublicGatewayConfiguration() *GatewayConfiguration {
 		},
 		
 		CacheControl: CacheControlConfig{
-			Enabled: true,
-			MaxAge:  300, // 5 minutes for public content
+			Enabled:                  true,
+			MaxAge:                   300, // 5 minutes for public content
+			ResponseCacheEnabled:     true,
+			ClientMaxAge:             0, // Clients revalidate so published changes show up at once
+			StaleWhileRevalidate:     60,
+			MaxEntries:               2000,
+			MaxEntryBytes:            1 << 20,
+			InvalidationPollInterval: 2 * time.Second,
 		},
 		
 		ServiceRouting: ServiceRoutingConfig{
@@ -276,8 +321,17 @@ func NewAdminGatewayConfiguration() *GatewayConfiguration {
 			RequestsPerMinute: 100, // Lower limit for admin access
 			BurstSize:         20,
 			WindowSize:        time.Minute,
+			Algorithm:         string(ratelimit.TokenBucket),
 			KeyExtractor:      "user", // Rate limit by user for admin
-			BackingStore:      "redis",
+			APIKeyHeader:      "X-API-Key",
+			APIKeyDigests:     strings.Split(os.Getenv("GATEWAY_API_KEY_DIGESTS"), ","),
+			TrustedProxies:    strings.Split(os.Getenv("GATEWAY_TRUSTED_PROXIES"), ","),
+			BackingStore:      "state_store",
+			Routes: []RouteRateLimit{
+				// Sign-in is anonymous, so password guessing is limited per client address
+				{Name: "login", PathPrefix: "/admin/api/v1/auth/login", Methods: []string{"POST"}, RequestsPerMinute: 10, BurstSize: 5, KeyExtractor: "ip"},
+				{Name: "refresh", PathPrefix: "/admin/api/v1/auth/refresh", Methods: []string{"POST"}, RequestsPerMinute: 30, BurstSize: 10, KeyExtractor: "ip"},
+			},
 		},
 		
 		CORS: CORSConfig{
@@ -342,4 +396,153 @@ func (c *GatewayConfiguration) GetListenAddress() string {
 // ShouldRequireAuth returns true if authentication is required
 func (c *GatewayConfiguration) ShouldRequireAuth() bool {
 	return c.Security.RequireAuthentication
+}
+
+// Validate checks that enabled rate limiting has usable limits
+func (r RateLimitConfig) Validate() error {
+	if !r.Enabled {
+		return nil
+	}
+
+	if r.RequestsPerMinute <= 0 {
+		return fmt.Errorf("invalid requests per minute: %d", r.RequestsPerMinute)
+	}
+
+	if r.BurstSize <= 0 {
+		return fmt.Errorf("invalid burst size: %d", r.BurstSize)
+	}
+
+	if !isRateLimitKeyExtractor(r.KeyExtractor) {
+		return fmt.Errorf("invalid key extractor: %s", r.KeyExtractor)
+	}
+
+	if r.KeyExtractor == "api_key" && r.APIKeyHeader == "" {
+		return fmt.Errorf("api_key key extractor requires an API key header")
+	}
+
+	apiKeys, err := ratelimit.ParseAPIKeyDigests(r.APIKeyDigests)
+	if err != nil {
+		return err
+	}
+	if r.KeyExtractor == "api_key" && apiKeys.Len() == 0 {
+		return fmt.Errorf("api_key key extractor requires the digests of the issued API keys")
+	}
+
+	if r.BackingStore != "state_store" && r.BackingStore != "memory" {
+		return fmt.Errorf("invalid rate limit backing store: %s", r.BackingStore)
+	}
+
+	if _, err := ratelimit.ParseTrustedProxies(r.TrustedProxies); err != nil {
+		return err
+	}
+
+	if err := r.Limit(r.RequestsPerMinute, r.BurstSize).Validate(); err != nil {
+		return err
+	}
+
+	names := make(map[string]bool, len(r.Routes))
+	for _, route := range r.Routes {
+		if route.Name == "" || names[route.Name] {
+			return fmt.Errorf("rate limited routes need unique names: %q", route.Name)
+		}
+		names[route.Name] = true
+
+		if !strings.HasPrefix(route.PathPrefix, "/") {
+			return fmt.Errorf("invalid path prefix for rate limited route %s: %q", route.Name, route.PathPrefix)
+		}
+		if route.RequestsPerMinute <= 0 || route.BurstSize < 0 {
+			return fmt.Errorf("invalid limit for rate limited route %s", route.Name)
+		}
+		if route.KeyExtractor != "" && !isRateLimitKeyExtractor(route.KeyExtractor) {
+			return fmt.Errorf("invalid key extractor for rate limited route %s: %s", route.Name, route.KeyExtractor)
+		}
+		if route.KeyExtractor == "api_key" && (r.APIKeyHeader == "" || apiKeys.Len() == 0) {
+			return fmt.Errorf("rate limited route %s keys on API keys but no API key header or digests are set", route.Name)
+		}
+	}
+
+	return nil
+}
+
+// Limit converts a per-minute rate into a limit over the configured window
+func (r RateLimitConfig) Limit(requestsPerMinute, burstSize int) ratelimit.Limit {
+	window := r.WindowSize
+	if window <= 0 {
+		window = time.Minute
+	}
+
+	return ratelimit.Limit{
+		Requests:  max(1, int(float64(requestsPerMinute)*window.Minutes()+0.5)),
+		Window:    window,
+		Burst:     burstSize,
+		Algorithm: ratelimit.Algorithm(r.Algorithm),
+	}
+}
+
+// MatchRoute returns the first rate limited route matching the request
+func (r RateLimitConfig) MatchRoute(method, path string) (RouteRateLimit, bool) {
+	for _, route := range r.Routes {
+		if !strings.HasPrefix(path, route.PathPrefix) {
+			continue
+		}
+		if len(route.Methods) == 0 {
+			return route, true
+		}
+		for _, allowed := range route.Methods {
+			if strings.EqualFold(allowed, method) {
+				return route, true
+			}
+		}
+	}
+	return RouteRateLimit{}, false
+}
+
+func isRateLimitKeyExtractor(extractor string) bool {
+	return extractor == "ip" || extractor == "user" || extractor == "api_key"
+}
+
+// Validate checks the cache lifetimes and response cache limits
+func (c CacheControlConfig) Validate() error {
+	if c.MaxAge < 0 {
+		return fmt.Errorf("invalid cache max age: %d", c.MaxAge)
+	}
+	if c.ClientMaxAge < 0 || c.ClientMaxAge > c.MaxAge {
+		return fmt.Errorf("invalid client cache max age: %d", c.ClientMaxAge)
+	}
+	if c.StaleWhileRevalidate < 0 {
+		return fmt.Errorf("invalid stale-while-revalidate: %d", c.StaleWhileRevalidate)
+	}
+
+	if !c.Enabled || !c.ResponseCacheEnabled {
+		return nil
+	}
+
+	if c.MaxEntries <= 0 {
+		return fmt.Errorf("invalid response cache size: %d", c.MaxEntries)
+	}
+	if c.MaxEntryBytes <= 0 {
+		return fmt.Errorf("invalid response cache entry size: %d", c.MaxEntryBytes)
+	}
+	if c.InvalidationPollInterval <= 0 {
+		return fmt.Errorf("invalid cache invalidation poll interval: %s", c.InvalidationPollInterval)
+	}
+
+	return nil
+}
+
+// Validate checks that enabled CORS allows at least one origin and method
+func (c CORSConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if len(c.AllowedOrigins) == 0 {
+		return fmt.Errorf("CORS enabled but no allowed origins specified")
+	}
+
+	if len(c.AllowedMethods) == 0 {
+		return fmt.Errorf("CORS enabled but no allowed methods specified")
+	}
+
+	return nil
 }
\ No newline at end of file
@@ -2,57 +2,54 @@ package gateway
 
 import (
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"strings"
-	"sync"
-	"time"
 	
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/ratelimit"
 	"github.com/google/uuid"
 )
 
-// rateLimitState tracks rate limiting for testing
-type rateLimitState struct {
-	requests    map[string]int // key -> request count
-	lastReset   time.Time
-	mutex       sync.Mutex
-	maxRequests int
-}
-
 // DAPRMiddlewareSimulator simulates DAPR middleware behavior for testing
 type DAPRMiddlewareSimulator struct {
 	handler     http.Handler
 	isAdmin     bool
-	rateLimit   *rateLimitState
+	rateLimited http.Handler
 }
 
 // NewDAPRMiddlewareSimulator creates a new DAPR middleware simulator
 func NewDAPRMiddlewareSimulator(handler http.Handler, isAdmin bool) http.Handler {
 	// Set rate limits based on gateway type
-	maxRequests := 1000 // Public gateway: 1000/min
+	rule := ratelimit.Rule{
+		Name:  "public",
+		Limit: ratelimit.PerMinute(1000, 0), // Public gateway: 1000/min
+		Key:   ratelimit.ClientIPKey(nil),
+	}
 	if isAdmin {
-		maxRequests = 100 // Admin gateway: 100/min
+		// The sidecar has authenticated admin callers, so count them per user
+		rule.Name = "admin"
+		rule.Limit = ratelimit.PerMinute(100, 0) // Admin gateway: 100/min
+		rule.Key = ratelimit.FirstKey(simulatedUserKey, ratelimit.ClientIPKey(nil))
 	}
 	
-	return &DAPRMiddlewareSimulator{
+	simulator := &DAPRMiddlewareSimulator{
 		handler: handler,
 		isAdmin: isAdmin,
-		rateLimit: &rateLimitState{
-			requests:    make(map[string]int),
-			lastReset:   time.Now(),
-			maxRequests: maxRequests,
-		},
 	}
+	
+	// Rate limiting applies to all requests, before authentication
+	limiter := ratelimit.NewLimiter(ratelimit.NewMemoryStore())
+	simulator.rateLimited = limiter.Middleware(ratelimit.FixedRule(rule), simulator.writeRateLimitError)(http.HandlerFunc(simulator.serveDAPR))
+	
+	return simulator
 }
 
 // ServeHTTP implements http.Handler interface
 func (d *DAPRMiddlewareSimulator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	// Check rate limiting first (applies to all requests)
-	if d.isRateLimited(r) {
-		d.writeRateLimitError(w, r)
-		return
-	}
+	d.rateLimited.ServeHTTP(w, r)
+}
 
+// serveDAPR simulates the sidecar authentication and routing for requests within their rate limit
+func (d *DAPRMiddlewareSimulator) serveDAPR(w http.ResponseWriter, r *http.Request) {
 	// Skip DAPR authentication for health, readiness, metrics, and gateway info endpoints
 	if d.isSystemEndpoint(r.URL.Path) {
 		d.handler.ServeHTTP(w, r)
@@ -188,51 +185,17 @@ func (d *DAPRMiddlewareSimulator) isSystemEndpoint(path string) bool {
 	return false
 }
 
-// isRateLimited checks if the request should be rate limited
-func (d *DAPRMiddlewareSimulator) isRateLimited(r *http.Request) bool {
-	d.rateLimit.mutex.Lock()
-	defer d.rateLimit.mutex.Unlock()
-	
-	// Reset counts if a minute has passed
-	now := time.Now()
-	if now.Sub(d.rateLimit.lastReset) >= time.Minute {
-		d.rateLimit.requests = make(map[string]int)
-		d.rateLimit.lastReset = now
-	}
-	
-	// Determine rate limiting key
-	var key string
-	if d.isAdmin {
-		// User-based rate limiting for admin gateway
-		key = r.Header.Get("X-User-ID")
-		if key == "" {
-			// For unauthenticated requests, use IP
-			key = r.RemoteAddr
-		}
-	} else {
-		// IP-based rate limiting for public gateway
-		key = r.RemoteAddr
-	}
-	
-	// Check current count
-	currentCount := d.rateLimit.requests[key]
-	
-	// Increment count
-	d.rateLimit.requests[key] = currentCount + 1
-	
-	// Check if limit exceeded
-	return d.rateLimit.requests[key] > d.rateLimit.maxRequests
+// simulatedUserKey keys admin requests on the user the simulated sidecar authenticated
+func simulatedUserKey(r *http.Request) (string, bool) {
+	userID := r.Header.Get("X-User-ID")
+	return "user:" + userID, userID != ""
 }
 
-// writeRateLimitError writes a rate limit error response
-func (d *DAPRMiddlewareSimulator) writeRateLimitError(w http.ResponseWriter, r *http.Request) {
+// writeRateLimitError writes a rate limit error response. The limiter has already set
+// the RateLimit-* and Retry-After headers.
+func (d *DAPRMiddlewareSimulator) writeRateLimitError(w http.ResponseWriter, r *http.Request, decision ratelimit.Decision) {
 	w.Header().Set("Content-Type", "application/json")
 	
-	// Add rate limit headers
-	w.Header().Set("X-RateLimit-Limit", fmt.Sprintf("%d", d.rateLimit.maxRequests))
-	w.Header().Set("X-RateLimit-Remaining", "0")
-	w.Header().Set("X-RateLimit-Reset", fmt.Sprintf("%d", time.Now().Add(time.Minute).Unix()))
-	
 	// Add security headers
 	w.Header().Set("X-Content-Type-Options", "nosniff")
 	w.Header().Set("X-Frame-Options", "DENY")
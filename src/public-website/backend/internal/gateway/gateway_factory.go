@@ -191,7 +191,7 @@ func NewGatewayDAPRConfiguration(environment string) (*DAPRConfig, error) {
 					"/api/v1/research/search", "/api/v1/research/{id}/report", "/api/v1/events", "/api/v1/events/{id}",
 					"/api/v1/events/slug/{slug}", "/api/v1/events/featured", "/api/v1/events/categories",
 					"/api/v1/events/categories/{id}/events", "/api/v1/events/search", "/api/v1/events/{id}/register",
-					"/api/v1/events/{id}/registrations", "/api/v1/inquiries/media", "/api/v1/inquiries/business",
+					"/api/v1/events/{id}/registrations", "/api/v1/media/{id}/download", "/api/v1/inquiries/media", "/api/v1/inquiries/business",
 					"/api/v1/inquiries/donations", "/api/v1/inquiries/volunteers", "/health", "/health/ready",
 				},
 			}},
@@ -262,7 +262,7 @@ func NewGatewayDAPRConfiguration(environment string) (*DAPRConfig, error) {
 					"/api/v1/research/search", "/api/v1/research/{id}/report", "/api/v1/events", "/api/v1/events/{id}",
 					"/api/v1/events/slug/{slug}", "/api/v1/events/featured", "/api/v1/events/categories",
 					"/api/v1/events/categories/{id}/events", "/api/v1/events/search", "/api/v1/events/{id}/register",
-					"/api/v1/events/{id}/registrations", "/api/v1/inquiries/media", "/api/v1/inquiries/business",
+					"/api/v1/events/{id}/registrations", "/api/v1/media/{id}/download", "/api/v1/inquiries/media", "/api/v1/inquiries/business",
 					"/api/v1/inquiries/donations", "/api/v1/inquiries/volunteers", "/health", "/health/ready",
 				},
 			}},
@@ -314,7 +314,7 @@ func NewGatewayDAPRConfiguration(environment string) (*DAPRConfig, error) {
 					"/api/v1/research/search", "/api/v1/research/{id}/report", "/api/v1/events", "/api/v1/events/{id}",
 					"/api/v1/events/slug/{slug}", "/api/v1/events/featured", "/api/v1/events/categories",
 					"/api/v1/events/categories/{id}/events", "/api/v1/events/search", "/api/v1/events/{id}/register",
-					"/api/v1/events/{id}/registrations", "/api/v1/inquiries/media", "/api/v1/inquiries/business",
+					"/api/v1/events/{id}/registrations", "/api/v1/media/{id}/download", "/api/v1/inquiries/media", "/api/v1/inquiries/business",
 					"/api/v1/inquiries/donations", "/api/v1/inquiries/volunteers", "/health", "/health/ready",
 				},
 			}},
@@ -353,9 +353,9 @@ func NewGatewayDAPRConfiguration(environment string) (*DAPRConfig, error) {
 				"keyExtractor": "ip",
 				"store": "redis",
 				"headers": map[string]interface{}{
-					"X-RateLimit-Limit": "1000",
-					"X-RateLimit-Remaining": "{remaining}",
-					"X-RateLimit-Reset": "{reset}",
+					"RateLimit-Limit": "1000",
+					"RateLimit-Remaining": "{remaining}",
+					"RateLimit-Reset": "{reset}",
 				},
 			}},
 		}
@@ -11,13 +11,15 @@ import (
 
 // GatewayService provides the main gateway service implementation
 type GatewayService struct {
-	config        *GatewayConfiguration
-	daprClient    *dapr.Client
-	serviceProxy  *ServiceProxy
-	middleware    *Middleware
-	auditService  *AuditService
-	handler       *GatewayHandler
-	server        *http.Server
+	config         *GatewayConfiguration
+	daprClient     *dapr.Client
+	serviceProxy   *ServiceProxy
+	middleware     *Middleware
+	auditService   *AuditService
+	handler        *GatewayHandler
+	server         *http.Server
+	configReloader *dapr.ConfigReloader
+	responseCache  *ResponseCache
 }
 
 // NewGatewayService creates a new gateway service
@@ -28,6 +30,11 @@ func NewGatewayService(config *GatewayConfiguration, daprClient *dapr.Client) *G
 	// Initialize middleware
 	middleware := NewMiddleware(config)
 	
+	// Share rate limit counters between replicas through the state store
+	if daprClient != nil && config.RateLimit.BackingStore == "state_store" {
+		middleware.SetRateLimitStore(NewStateRateLimitStore(dapr.NewStateStore(daprClient), config.Name))
+	}
+	
 	// Initialize audit service for admin gateways
 	var auditService *AuditService
 	if config.IsAdmin() {
@@ -42,6 +49,40 @@ func NewGatewayService(config *GatewayConfiguration, daprClient *dapr.Client) *G
 		handler.SetAuditService(auditService)
 	}
 	
+	// Cache public content, dropping responses as soon as editors publish or change content.
+	// The delivery route is served on the public listener, so it is only registered when
+	// the sidecar has to authenticate itself with the app API token.
+	var responseCache *ResponseCache
+	if config.IsPublic() && config.CacheControl.Enabled && config.CacheControl.ResponseCacheEnabled {
+		responseCache = NewResponseCache(config)
+		if daprClient != nil {
+			stateStore := dapr.NewStateStore(daprClient)
+			responseCache.SetInvalidationStore(NewStateCacheInvalidationStore(stateStore, config.Name))
+			subscriber := dapr.NewSubscriber()
+			if subscriber.RequiresAppAPIToken() {
+				deduplicator := dapr.NewEventDeduplicator(stateStore)
+				subscriber.UseDeduplication(deduplicator)
+				SubscribeResponseCache(subscriber, dapr.NewPubSub(daprClient), responseCache)
+				handler.SetEventSubscriber(subscriber)
+				handler.SetEventDeduplicator(deduplicator)
+			} else {
+				fmt.Printf("Warning: APP_API_TOKEN is not set, cached responses expire only with their max age\n")
+			}
+		}
+		handler.SetResponseCache(responseCache)
+	}
+	
+	// Reload rate limits, CORS, cache control and observability toggles from the configuration store
+	var configReloader *dapr.ConfigReloader
+	if daprClient != nil {
+		configReloader = dapr.NewConfigReloader(dapr.NewConfiguration(daprClient))
+		if err := configReloader.Register(config.ConfigListener()); err != nil {
+			fmt.Printf("Warning: gateway configuration will not be reloaded: %v\n", err)
+			configReloader = nil
+		}
+		handler.SetConfigReloader(configReloader)
+	}
+	
 	// Create HTTP server
 	server := &http.Server{
 		Addr:         config.GetListenAddress(),
@@ -52,13 +93,15 @@ func NewGatewayService(config *GatewayConfiguration, daprClient *dapr.Client) *G
 	}
 	
 	return &GatewayService{
-		config:       config,
-		daprClient:   daprClient,
-		serviceProxy: serviceProxy,
-		middleware:   middleware,
-		auditService: auditService,
-		handler:      handler,
-		server:       server,
+		config:         config,
+		daprClient:     daprClient,
+		serviceProxy:   serviceProxy,
+		middleware:     middleware,
+		auditService:   auditService,
+		handler:        handler,
+		server:         server,
+		configReloader: configReloader,
+		responseCache:  responseCache,
 	}
 }
 
@@ -138,6 +181,18 @@ func (g *GatewayService) Start(ctx context.Context) error {
 		return fmt.Errorf("Dapr connectivity check failed: %w", err)
 	}
 	
+	// Load the reloadable configuration and watch it for changes
+	if g.configReloader != nil {
+		if err := g.configReloader.Start(ctx); err != nil {
+			fmt.Printf("Warning: using startup configuration: %v\n", err)
+		}
+	}
+	
+	// Pick up cache invalidations other replicas received
+	if g.responseCache != nil {
+		go g.responseCache.Run(ctx)
+	}
+	
 	// Start HTTP server in goroutine
 	go func() {
 		fmt.Printf("Gateway %s listening on %s\n", g.config.Name, g.config.GetListenAddress())
@@ -209,8 +264,8 @@ func (g *GatewayService) GetMetrics(ctx context.Context) (map[string]interface{}
 				"idle_timeout":  g.config.Timeouts.IdleTimeout,
 			},
 			"configuration": map[string]interface{}{
-				"rate_limit_enabled":   g.config.RateLimit.Enabled,
-				"cors_enabled":         g.config.CORS.Enabled,
+				"rate_limit_enabled":   g.config.RateLimitSettings().Enabled,
+				"cors_enabled":         g.config.CORSSettings().Enabled,
 				"auth_required":        g.config.ShouldRequireAuth(),
 				"content_api_enabled":  g.config.ServiceRouting.ContentAPIEnabled,
 				"services_api_enabled": g.config.ServiceRouting.ServicesAPIEnabled,
@@ -238,6 +293,12 @@ func (g *GatewayService) GetHandler() *GatewayHandler {
 	return g.handler
 }
 
+// RebuildRouter recreates the router from the handler, picking up handlers set after
+// the service was created. It must be called before Start.
+func (g *GatewayService) RebuildRouter() {
+	g.server.Handler = g.handler.CreateRouter()
+}
+
 // Private helper methods
 
 // validateConfiguration validates the gateway configuration
@@ -259,29 +320,18 @@ func (g *GatewayService) validateConfiguration() error {
 	}
 	
 	// Validate rate limiting configuration
-	if g.config.RateLimit.Enabled {
-		if g.config.RateLimit.RequestsPerMinute <= 0 {
-			return fmt.Errorf("invalid requests per minute: %d", g.config.RateLimit.RequestsPerMinute)
-		}
-		
-		if g.config.RateLimit.BurstSize <= 0 {
-			return fmt.Errorf("invalid burst size: %d", g.config.RateLimit.BurstSize)
-		}
-		
-		if g.config.RateLimit.KeyExtractor != "ip" && g.config.RateLimit.KeyExtractor != "user" {
-			return fmt.Errorf("invalid key extractor: %s", g.config.RateLimit.KeyExtractor)
-		}
+	if err := g.config.RateLimitSettings().Validate(); err != nil {
+		return err
 	}
 	
 	// Validate CORS configuration
-	if g.config.CORS.Enabled {
-		if len(g.config.CORS.AllowedOrigins) == 0 {
-			return fmt.Errorf("CORS enabled but no allowed origins specified")
-		}
-		
-		if len(g.config.CORS.AllowedMethods) == 0 {
-			return fmt.Errorf("CORS enabled but no allowed methods specified")
-		}
+	if err := g.config.CORSSettings().Validate(); err != nil {
+		return err
+	}
+	
+	// Validate cache configuration
+	if err := g.config.CacheControlSettings().Validate(); err != nil {
+		return err
 	}
 	
 	// Validate timeout configuration
@@ -315,7 +365,15 @@ func (g *GatewayService) logConfiguration() {
 	fmt.Printf("Gateway Configuration:\n")
 	fmt.Printf("  - Rate Limiting: %v", g.config.RateLimit.Enabled)
 	if g.config.RateLimit.Enabled {
-		fmt.Printf(" (%d req/min, burst: %d)", g.config.RateLimit.RequestsPerMinute, g.config.RateLimit.BurstSize)
+		fmt.Printf(" (%d req/min, burst: %d, by %s, %s, %d route limits)", g.config.RateLimit.RequestsPerMinute, g.config.RateLimit.BurstSize,
+			g.config.RateLimit.KeyExtractor, g.config.RateLimit.BackingStore, len(g.config.RateLimit.Routes))
+	}
+	fmt.Printf("\n")
+	
+	fmt.Printf("  - Response Cache: %v", g.responseCache != nil)
+	if g.responseCache != nil {
+		fmt.Printf(" (max age: %ds, stale while revalidate: %ds, %d entries)", g.config.CacheControl.MaxAge,
+			g.config.CacheControl.StaleWhileRevalidate, g.config.CacheControl.MaxEntries)
 	}
 	fmt.Printf("\n")
 	
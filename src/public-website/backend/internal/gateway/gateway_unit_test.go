@@ -319,7 +319,7 @@ func createTestGatewayConfiguration() *GatewayConfiguration {
 			BurstSize:         100,
 			WindowSize:        time.Minute,
 			KeyExtractor:      "ip",
-			BackingStore:      "redis",
+			BackingStore:      "state_store",
 		},
 		
 		CORS: CORSConfig{
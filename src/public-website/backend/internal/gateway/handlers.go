@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
 	"github.com/gorilla/mux"
 )
@@ -18,6 +19,11 @@ type GatewayHandler struct {
 	middleware        *Middleware
 	auditService      *AuditService
 	subscriberHandler *SubscriberHandler
+	accessHandler     *AccessControlHandler
+	configReloader    *dapr.ConfigReloader
+	responseCache     *ResponseCache
+	eventSubscriber   *dapr.Subscriber
+	eventDeduplicator *dapr.EventDeduplicator
 }
 
 // NewGatewayHandler creates a new gateway handler
@@ -43,6 +49,19 @@ func (h *GatewayHandler) RegisterRoutes(router *mux.Router) {
 	// Apply middleware to all routes
 	router.Use(h.middleware.ApplyMiddleware)
 	
+	// Enforce the admin policy before auditing so audit events name the principal
+	if h.accessHandler != nil && h.config.ShouldRequireAuth() {
+		router.Use(h.accessHandler.PolicyMiddleware)
+	}
+	
+	// Rate limit after the policy so admin requests are counted per user
+	router.Use(h.middleware.RateLimitMiddleware)
+	
+	// Serve public content from the response cache once the request is admitted
+	if h.responseCache != nil {
+		router.Use(h.responseCache.Middleware)
+	}
+	
 	// Apply audit middleware for admin gateways after other middleware
 	if h.auditService != nil {
 		router.Use(h.auditService.AuditMiddleware())
@@ -61,6 +80,11 @@ func (h *GatewayHandler) RegisterRoutes(router *mux.Router) {
 		router.HandleFunc(h.config.Observability.MetricsPath, h.MetricsEndpoint).Methods("GET")
 	}
 	
+	// Pub/sub deliveries from the Dapr sidecar
+	if h.eventSubscriber != nil {
+		h.eventSubscriber.RegisterRoutes(router)
+	}
+	
 	// Service proxy routes - admin gateways use /admin prefix, public gateways use /api prefix
 	apiPrefix := "/api"
 	if h.config.IsAdmin() {
@@ -72,11 +96,38 @@ func (h *GatewayHandler) RegisterRoutes(router *mux.Router) {
 		router.PathPrefix(apiPrefix + "/v1/content").HandlerFunc(h.ProxyToContentAPI).Methods("GET", "OPTIONS")
 		router.PathPrefix(apiPrefix + "/v1/research").HandlerFunc(h.ProxyToContentAPI).Methods("GET", "PUT", "POST", "DELETE", "OPTIONS")
 		router.PathPrefix(apiPrefix + "/v1/events").HandlerFunc(h.ProxyToContentAPI).Methods("GET", "OPTIONS")
+		if h.config.IsAdmin() {
+			// Event edits carry If-Match so concurrent editors cannot overwrite each other
+			router.PathPrefix("/admin/api/v1/events").HandlerFunc(h.ProxyToContentAPI).Methods("PUT")
+			router.HandleFunc("/admin/api/v1/events/{id}/revisions/{revision:[0-9]+}/rollback", h.ProxyToContentAPI).Methods("POST")
+		}
 		
 		// Simple API routes for development (without v1 prefix)
 		router.HandleFunc("/api/news", h.ProxyToContentAPI).Methods("GET", "OPTIONS")
 		router.HandleFunc("/api/events", h.ProxyToContentAPI).Methods("GET", "OPTIONS")
 		router.HandleFunc("/api/research", h.ProxyToContentAPI).Methods("GET", "OPTIONS")
+
+		// Unified search across content domains and signed media downloads (public gateway only)
+		if h.config.IsPublic() {
+			router.HandleFunc("/api/v1/search", h.SearchContent).Methods("GET", "OPTIONS")
+			router.PathPrefix("/api/v1/media").HandlerFunc(h.ProxyToContentAPI).Methods("GET", "OPTIONS")
+		}
+
+		// Publication schedules and editorial review decisions are written through the content service (admin gateway only)
+		if h.config.IsAdmin() {
+			router.HandleFunc("/admin/api/v1/content/schedules", h.ProxyToContentAPI).Methods("POST")
+			router.PathPrefix("/admin/api/v1/content/schedules/").HandlerFunc(h.ProxyToContentAPI).Methods("DELETE")
+			router.HandleFunc("/admin/api/v1/content/{entity_type}/{id}/review/{action:submit|approve|reject|comments|reviewers}", h.ProxyToContentAPI).Methods("POST")
+		}
+
+		// Saga administration, the media library, event history, dead letters and feature flags are hosted by the content service (admin gateway only)
+		if h.config.IsAdmin() {
+			router.PathPrefix("/admin/api/v1/sagas").HandlerFunc(h.ProxyToContentAPI).Methods("GET", "POST", "OPTIONS")
+			router.PathPrefix("/admin/api/v1/media").HandlerFunc(h.ProxyToContentAPI).Methods("GET", "POST", "DELETE", "OPTIONS")
+			router.PathPrefix("/admin/api/v1/event-history").HandlerFunc(h.ProxyToContentAPI).Methods("GET", "POST", "OPTIONS")
+			router.PathPrefix("/admin/api/v1/dead-letters").HandlerFunc(h.ProxyToContentAPI).Methods("GET", "POST", "DELETE", "OPTIONS")
+			router.PathPrefix("/admin/api/v1/feature-flags").HandlerFunc(h.ProxyToContentAPI).Methods("GET", "POST", "PUT", "DELETE", "OPTIONS")
+		}
 	}
 	
 	if h.config.ServiceRouting.ServicesAPIEnabled {
@@ -116,6 +167,16 @@ func (h *GatewayHandler) RegisterRoutes(router *mux.Router) {
 		// Additional admin health check endpoint for subscriber management
 		router.HandleFunc("/admin/subscribers/health", h.subscriberHandler.SubscriberHealthCheck).Methods("GET")
 	}
+	
+	// Admin user management backed by the gateway user directory
+	if h.config.IsAdmin() && h.accessHandler != nil {
+		h.accessHandler.RegisterAccessRoutes(router)
+	}
+	
+	// Active reloadable configuration of this gateway (admin gateway only)
+	if h.config.IsAdmin() && h.configReloader != nil {
+		dapr.NewConfigAdminHandler(h.configReloader).RegisterRoutes(router.PathPrefix("/admin/gateway").Subrouter())
+	}
 }
 
 // ProxyToContentAPI proxies requests to content API service
@@ -198,6 +259,34 @@ func (h *GatewayHandler) ProxyToInquiriesAPI(w http.ResponseWriter, r *http.Requ
 	}
 }
 
+// SearchContent handles GET /api/v1/search, merging search results from every content domain
+func (h *GatewayHandler) SearchContent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Add request timeout
+	ctx, cancel := context.WithTimeout(ctx, h.config.Timeouts.RequestTimeout)
+	defer cancel()
+
+	request, err := ParseSearchRequest(r.URL.Query())
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	response, err := h.serviceProxy.SearchContent(ctx, request)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	// Partial results must not outlive the outage that caused them
+	if response.Partial {
+		w.Header().Set("Cache-Control", "no-cache")
+	}
+
+	h.writeJSONResponse(w, r, http.StatusOK, response)
+}
+
 // HealthCheck provides a health check endpoint
 func (h *GatewayHandler) HealthCheck(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -281,8 +370,8 @@ func (h *GatewayHandler) MetricsEndpoint(w http.ResponseWriter, r *http.Request)
 			"environment": h.config.Environment,
 			"uptime":      time.Now().UTC(),
 			"configuration": map[string]interface{}{
-				"rate_limit_enabled":       h.config.RateLimit.Enabled,
-				"cors_enabled":             h.config.CORS.Enabled,
+				"rate_limit_enabled":       h.config.RateLimitSettings().Enabled,
+				"cors_enabled":             h.config.CORSSettings().Enabled,
 				"auth_required":            h.config.ShouldRequireAuth(),
 				"content_api_enabled":      h.config.ServiceRouting.ContentAPIEnabled,
 				"services_api_enabled":     h.config.ServiceRouting.ServicesAPIEnabled,
@@ -291,12 +380,20 @@ func (h *GatewayHandler) MetricsEndpoint(w http.ResponseWriter, r *http.Request)
 		},
 		"services": serviceMetrics,
 	}
+	if h.responseCache != nil {
+		metrics["response_cache"] = h.responseCache.Stats()
+	}
+	if h.eventDeduplicator != nil {
+		metrics["event_deduplication"] = h.eventDeduplicator.Metrics()
+	}
 	
 	h.writeJSONResponse(w, r, http.StatusOK, metrics)
 }
 
 // GatewayInfo provides gateway information
 func (h *GatewayHandler) GatewayInfo(w http.ResponseWriter, r *http.Request) {
+	cors := h.config.CORSSettings()
+	observability := h.config.ObservabilitySettings()
 	info := map[string]interface{}{
 		"name":        h.config.Name,
 		"type":        h.config.Type,
@@ -306,22 +403,22 @@ func (h *GatewayHandler) GatewayInfo(w http.ResponseWriter, r *http.Request) {
 			"content_api":       h.config.ServiceRouting.ContentAPIEnabled,
 			"services_api":      h.config.ServiceRouting.ServicesAPIEnabled,
 			"notification_api":  h.config.ServiceRouting.NotificationAPIEnabled,
-			"rate_limiting":     h.config.RateLimit.Enabled,
-			"cors":              h.config.CORS.Enabled,
+			"rate_limiting":     h.config.RateLimitSettings().Enabled,
+			"cors":              cors.Enabled,
 			"authentication":    h.config.ShouldRequireAuth(),
 		},
 		"endpoints": map[string]interface{}{
-			"health":    h.config.Observability.HealthCheckPath,
-			"readiness": h.config.Observability.ReadinessPath,
-			"metrics":   h.config.Observability.MetricsPath,
+			"health":    observability.HealthCheckPath,
+			"readiness": observability.ReadinessPath,
+			"metrics":   observability.MetricsPath,
 		},
 	}
 	
 	// Add CORS information for public gateway
 	if h.config.IsPublic() {
 		info["cors"] = map[string]interface{}{
-			"allowed_origins": h.config.CORS.AllowedOrigins,
-			"allowed_methods": h.config.CORS.AllowedMethods,
+			"allowed_origins": cors.AllowedOrigins,
+			"allowed_methods": cors.AllowedMethods,
 		}
 	}
 	
@@ -418,11 +515,13 @@ func (h *GatewayHandler) writeJSONResponse(w http.ResponseWriter, r *http.Reques
 	}
 	w.Header().Set("Content-Type", "application/json")
 	
-	// Set cache control based on gateway configuration
-	if h.config.CacheControl.Enabled && statusCode == http.StatusOK {
-		w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", h.config.CacheControl.MaxAge))
-	} else {
-		w.Header().Set("Cache-Control", "no-cache")
+	// Set cache control based on gateway configuration, unless the handler already chose one
+	if w.Header().Get("Cache-Control") == "" {
+		if cacheControl := h.config.CacheControlSettings(); cacheControl.Enabled && statusCode == http.StatusOK {
+			w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", cacheControl.MaxAge))
+		} else {
+			w.Header().Set("Cache-Control", "no-cache")
+		}
 	}
 	
 	w.WriteHeader(statusCode)
@@ -458,6 +557,32 @@ func (h *GatewayHandler) GetSubscriberHandler() *SubscriberHandler {
 	return h.subscriberHandler
 }
 
+// SetAccessControlHandler sets the handler that serves admin users and enforces the
+// admin policy
+func (h *GatewayHandler) SetAccessControlHandler(accessHandler *AccessControlHandler) {
+	h.accessHandler = accessHandler
+}
+
+// SetConfigReloader sets the reloader whose status the admin gateway exposes
+func (h *GatewayHandler) SetConfigReloader(configReloader *dapr.ConfigReloader) {
+	h.configReloader = configReloader
+}
+
+// SetResponseCache sets the cache public content responses are served from
+func (h *GatewayHandler) SetResponseCache(responseCache *ResponseCache) {
+	h.responseCache = responseCache
+}
+
+// SetEventSubscriber sets the subscriber whose pub/sub routes the gateway serves
+func (h *GatewayHandler) SetEventSubscriber(eventSubscriber *dapr.Subscriber) {
+	h.eventSubscriber = eventSubscriber
+}
+
+// SetEventDeduplicator sets the deduplicator whose counters the metrics endpoint reports
+func (h *GatewayHandler) SetEventDeduplicator(eventDeduplicator *dapr.EventDeduplicator) {
+	h.eventDeduplicator = eventDeduplicator
+}
+
 // SetAuditService sets the audit service for admin gateways
 func (h *GatewayHandler) SetAuditService(auditService *AuditService) {
 	h.auditService = auditService
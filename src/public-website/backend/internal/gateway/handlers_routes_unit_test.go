@@ -0,0 +1,210 @@
+package gateway
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func createRoutesTestConfiguration(gatewayType GatewayType) *GatewayConfiguration {
+	return &GatewayConfiguration{
+		Name:        "routes-test-gateway",
+		Type:        gatewayType,
+		Environment: "test",
+		ServiceRouting: ServiceRoutingConfig{
+			ContentAPIEnabled: true,
+		},
+	}
+}
+
+func TestGatewayHandler_RegisterRoutes_ContentWrites(t *testing.T) {
+	tests := []struct {
+		name           string
+		gatewayType    GatewayType
+		method         string
+		path           string
+		expectedRouted bool
+	}{
+		{
+			name:           "admin updates an event",
+			gatewayType:    GatewayTypeAdmin,
+			method:         http.MethodPut,
+			path:           "/admin/api/v1/events/event-1",
+			expectedRouted: true,
+		},
+		{
+			name:           "admin rolls an event back to a revision",
+			gatewayType:    GatewayTypeAdmin,
+			method:         http.MethodPost,
+			path:           "/admin/api/v1/events/event-1/revisions/3/rollback",
+			expectedRouted: true,
+		},
+		{
+			name:           "admin creates a publication schedule",
+			gatewayType:    GatewayTypeAdmin,
+			method:         http.MethodPost,
+			path:           "/admin/api/v1/content/schedules",
+			expectedRouted: true,
+		},
+		{
+			name:           "admin cancels a publication schedule",
+			gatewayType:    GatewayTypeAdmin,
+			method:         http.MethodDelete,
+			path:           "/admin/api/v1/content/schedules/schedule-1",
+			expectedRouted: true,
+		},
+		{
+			name:           "admin lists content",
+			gatewayType:    GatewayTypeAdmin,
+			method:         http.MethodGet,
+			path:           "/admin/api/v1/content/schedules",
+			expectedRouted: true,
+		},
+		{
+			name:           "admin submits content for review",
+			gatewayType:    GatewayTypeAdmin,
+			method:         http.MethodPost,
+			path:           "/admin/api/v1/content/news/news-1/review/submit",
+			expectedRouted: true,
+		},
+		{
+			name:           "admin approves reviewed content",
+			gatewayType:    GatewayTypeAdmin,
+			method:         http.MethodPost,
+			path:           "/admin/api/v1/content/research/research-1/review/approve",
+			expectedRouted: true,
+		},
+		{
+			name:           "admin rejects reviewed content",
+			gatewayType:    GatewayTypeAdmin,
+			method:         http.MethodPost,
+			path:           "/admin/api/v1/content/services/service-1/review/reject",
+			expectedRouted: true,
+		},
+		{
+			name:           "admin comments on reviewed content",
+			gatewayType:    GatewayTypeAdmin,
+			method:         http.MethodPost,
+			path:           "/admin/api/v1/content/events/event-1/review/comments",
+			expectedRouted: true,
+		},
+		{
+			name:           "admin assigns reviewers",
+			gatewayType:    GatewayTypeAdmin,
+			method:         http.MethodPost,
+			path:           "/admin/api/v1/content/news/news-1/review/reviewers",
+			expectedRouted: true,
+		},
+		{
+			name:           "admin searches event history",
+			gatewayType:    GatewayTypeAdmin,
+			method:         http.MethodGet,
+			path:           "/admin/api/v1/event-history",
+			expectedRouted: true,
+		},
+		{
+			name:           "admin replays event history",
+			gatewayType:    GatewayTypeAdmin,
+			method:         http.MethodPost,
+			path:           "/admin/api/v1/event-history/replay",
+			expectedRouted: true,
+		},
+		{
+			name:           "admin inspects a dead letter",
+			gatewayType:    GatewayTypeAdmin,
+			method:         http.MethodGet,
+			path:           "/admin/api/v1/dead-letters/letter-1",
+			expectedRouted: true,
+		},
+		{
+			name:           "admin retries a dead letter",
+			gatewayType:    GatewayTypeAdmin,
+			method:         http.MethodPost,
+			path:           "/admin/api/v1/dead-letters/letter-1/retry",
+			expectedRouted: true,
+		},
+		{
+			name:           "admin deletes a dead letter",
+			gatewayType:    GatewayTypeAdmin,
+			method:         http.MethodDelete,
+			path:           "/admin/api/v1/dead-letters/letter-1",
+			expectedRouted: true,
+		},
+		{
+			name:           "admin saves a feature flag",
+			gatewayType:    GatewayTypeAdmin,
+			method:         http.MethodPut,
+			path:           "/admin/api/v1/feature-flags/new-search",
+			expectedRouted: true,
+		},
+		{
+			name:           "admin evaluates feature flags",
+			gatewayType:    GatewayTypeAdmin,
+			method:         http.MethodPost,
+			path:           "/admin/api/v1/feature-flags/evaluate",
+			expectedRouted: true,
+		},
+		{
+			name:        "public gateway does not accept event updates",
+			gatewayType: GatewayTypePublic,
+			method:      http.MethodPut,
+			path:        "/api/v1/events/event-1",
+		},
+		{
+			name:        "public gateway does not accept event rollbacks",
+			gatewayType: GatewayTypePublic,
+			method:      http.MethodPost,
+			path:        "/api/v1/events/event-1/revisions/3/rollback",
+		},
+		{
+			name:        "public gateway does not accept schedules",
+			gatewayType: GatewayTypePublic,
+			method:      http.MethodPost,
+			path:        "/api/v1/content/schedules",
+		},
+		{
+			name:        "public gateway does not accept review decisions",
+			gatewayType: GatewayTypePublic,
+			method:      http.MethodPost,
+			path:        "/api/v1/content/news/news-1/review/approve",
+		},
+		{
+			name:        "public gateway does not expose event history",
+			gatewayType: GatewayTypePublic,
+			method:      http.MethodGet,
+			path:        "/api/v1/event-history",
+		},
+		{
+			name:        "public gateway does not expose dead letters",
+			gatewayType: GatewayTypePublic,
+			method:      http.MethodGet,
+			path:        "/api/v1/dead-letters",
+		},
+		{
+			name:        "public gateway does not manage feature flags",
+			gatewayType: GatewayTypePublic,
+			method:      http.MethodPut,
+			path:        "/api/v1/feature-flags/new-search",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			config := createRoutesTestConfiguration(tt.gatewayType)
+			handler := NewGatewayHandler(config, NewServiceProxyWithInvocation(nil, config), NewMiddleware(config))
+			router := mux.NewRouter()
+			handler.RegisterRoutes(router)
+
+			// Act
+			var match mux.RouteMatch
+			routed := router.Match(httptest.NewRequest(tt.method, tt.path, nil), &match)
+
+			// Assert
+			assert.Equal(t, tt.expectedRouted, routed)
+		})
+	}
+}
@@ -7,24 +7,33 @@ import (
 	"time"
 
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/ratelimit"
 	"github.com/google/uuid"
 )
 
 // Middleware represents gateway middleware
 type Middleware struct {
-	config        *GatewayConfiguration
+	config         *GatewayConfiguration
+	rateLimiter    *ratelimit.Limiter
+	trustedProxies *ratelimit.TrustedProxies
+	apiKeys        *ratelimit.APIKeys
 }
 
-// NewMiddleware creates a new middleware instance
+// NewMiddleware creates a new middleware instance. Rate limit counters are kept in
+// process until SetRateLimitStore provides a shared store.
 func NewMiddleware(config *GatewayConfiguration) *Middleware {
 	return &Middleware{
-		config: config,
+		config:         config,
+		rateLimiter:    ratelimit.NewLimiter(ratelimit.NewMemoryStore()),
+		trustedProxies: newTrustedProxies(config.RateLimit),
+		apiKeys:        newAPIKeys(config.RateLimit),
 	}
 }
 
 // ApplyMiddleware applies all configured middleware to the handler
 func (m *Middleware) ApplyMiddleware(handler http.Handler) http.Handler {
-	// DAPR middleware chain - DAPR sidecar handles authentication, rate limiting, CORS
+	// DAPR middleware chain - DAPR sidecar handles authentication and CORS, rate limiting
+	// is added by RateLimitMiddleware once the caller is known
 	// We only apply minimal gateway-specific middleware here
 	
 	// Apply observability middleware (tracing, logging) - still needed for gateway metrics
@@ -110,8 +119,11 @@ func (m *Middleware) observabilityMiddleware(next http.Handler) http.Handler {
 		// Create response writer wrapper to capture status
 		ww := &responseWriterWrapper{ResponseWriter: w, statusCode: http.StatusOK}
 		
+		// Toggles are read per request so configuration reloads take effect immediately
+		observability := m.config.ObservabilitySettings()
+		
 		// Add tracing headers if enabled
-		if m.config.Observability.TracingEnabled {
+		if observability.TracingEnabled {
 			if traceID := r.Header.Get("X-Trace-ID"); traceID == "" {
 				w.Header().Set("X-Trace-ID", domain.GetTraceID(r.Context()))
 			}
@@ -121,7 +133,7 @@ func (m *Middleware) observabilityMiddleware(next http.Handler) http.Handler {
 		next.ServeHTTP(ww, r)
 		
 		// Log request if enabled
-		if m.config.Observability.LoggingEnabled {
+		if observability.LoggingEnabled {
 			duration := time.Since(start)
 			m.logRequest(r, ww.statusCode, duration)
 		}
@@ -132,10 +144,11 @@ func (m *Middleware) observabilityMiddleware(next http.Handler) http.Handler {
 
 // isHealthOrMetricsPath checks if path is a health or metrics endpoint
 func (m *Middleware) isHealthOrMetricsPath(path string) bool {
+	observability := m.config.ObservabilitySettings()
 	healthPaths := []string{
-		m.config.Observability.HealthCheckPath,
-		m.config.Observability.ReadinessPath,
-		m.config.Observability.MetricsPath,
+		observability.HealthCheckPath,
+		observability.ReadinessPath,
+		observability.MetricsPath,
 		m.config.ServiceRouting.HealthCheckPath,
 		m.config.ServiceRouting.MetricsPath,
 	}
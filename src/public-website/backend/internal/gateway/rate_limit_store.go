@@ -0,0 +1,55 @@
+package gateway
+
+import (
+	"context"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/ratelimit"
+	"github.com/dapr/go-sdk/client"
+)
+
+const rateLimitEntityType = "ratelimit"
+
+// StateRateLimitStore implements ratelimit.Store using the Dapr state store, so every
+// replica of a gateway counts requests against the same counters
+type StateRateLimitStore struct {
+	stateStore  *dapr.StateStore
+	gatewayName string
+}
+
+// NewStateRateLimitStore creates a counter store for the named gateway. Counters of
+// different gateways are kept apart.
+func NewStateRateLimitStore(stateStore *dapr.StateStore, gatewayName string) *StateRateLimitStore {
+	return &StateRateLimitStore{
+		stateStore:  stateStore,
+		gatewayName: gatewayName,
+	}
+}
+
+// Load reads the counter for key with its ETag
+func (s *StateRateLimitStore) Load(ctx context.Context, key string) (*ratelimit.State, string, error) {
+	var state ratelimit.State
+	found, etag, err := s.stateStore.GetWithETag(ctx, s.stateKey(key), &state)
+	if err != nil {
+		return nil, "", err
+	}
+	if !found {
+		return nil, "", nil
+	}
+	return &state, etag, nil
+}
+
+// Save writes the counter for key if its ETag is unchanged. Without an ETag the write
+// only succeeds when no other replica created the counter first.
+func (s *StateRateLimitStore) Save(ctx context.Context, key string, state *ratelimit.State, version string, ttl time.Duration) error {
+	options := &dapr.StateOptions{ETag: version, TTL: ttl}
+	if version == "" {
+		options.Concurrency = client.StateConcurrencyFirstWrite
+	}
+	return s.stateStore.Save(ctx, s.stateKey(key), state, options)
+}
+
+func (s *StateRateLimitStore) stateKey(key string) string {
+	return s.stateStore.CreateKey(s.gatewayName, rateLimitEntityType, key)
+}
@@ -0,0 +1,94 @@
+package gateway
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/ratelimit"
+)
+
+// SetRateLimitStore switches the counters the gateway rate limits with, e.g. to the
+// state store so replicas share them. Call it before the router is created.
+func (m *Middleware) SetRateLimitStore(store ratelimit.Store) {
+	m.rateLimiter = ratelimit.NewLimiter(store)
+}
+
+// RateLimitMiddleware enforces the rate limits in effect for the gateway. It runs after
+// the admin policy middleware so that requests can be counted per user.
+func (m *Middleware) RateLimitMiddleware(next http.Handler) http.Handler {
+	return m.rateLimiter.Middleware(m.resolveRateLimit, m.writeRateLimitResponse)(next)
+}
+
+// resolveRateLimit picks the limit for a request from the current settings, preferring
+// a matching route over the gateway limit
+func (m *Middleware) resolveRateLimit(r *http.Request) (ratelimit.Rule, bool) {
+	settings := m.config.RateLimitSettings()
+	if !settings.Enabled || r.Method == http.MethodOptions || m.isHealthOrMetricsPath(r.URL.Path) {
+		return ratelimit.Rule{}, false
+	}
+
+	if route, ok := settings.MatchRoute(r.Method, r.URL.Path); ok {
+		extractor := route.KeyExtractor
+		if extractor == "" {
+			extractor = settings.KeyExtractor
+		}
+		return ratelimit.Rule{
+			Name:  "route:" + route.Name,
+			Limit: settings.Limit(route.RequestsPerMinute, route.BurstSize),
+			Key:   m.rateLimitKey(extractor, settings.APIKeyHeader),
+		}, true
+	}
+
+	return ratelimit.Rule{
+		Name:  "gateway",
+		Limit: settings.Limit(settings.RequestsPerMinute, settings.BurstSize),
+		Key:   m.rateLimitKey(settings.KeyExtractor, settings.APIKeyHeader),
+	}, true
+}
+
+// rateLimitKey returns the key function for an extractor. Requests without a user or
+// an issued API key fall back to the client address.
+func (m *Middleware) rateLimitKey(extractor, apiKeyHeader string) ratelimit.KeyFunc {
+	clientIP := ratelimit.ClientIPKey(m.trustedProxies)
+	switch extractor {
+	case "user":
+		return ratelimit.FirstKey(ratelimit.UserKey(), clientIP)
+	case "api_key":
+		return ratelimit.FirstKey(ratelimit.APIKeyKey(apiKeyHeader, m.apiKeys), clientIP)
+	default:
+		return clientIP
+	}
+}
+
+// writeRateLimitResponse answers a limited request in the gateway error format
+func (m *Middleware) writeRateLimitResponse(w http.ResponseWriter, r *http.Request, decision ratelimit.Decision) {
+	retryAfter, _ := strconv.Atoi(w.Header().Get("Retry-After"))
+	m.writeErrorResponse(w, http.StatusTooManyRequests, "RATE_LIMIT_EXCEEDED",
+		fmt.Sprintf("Rate limit exceeded, retry after %d seconds", retryAfter))
+}
+
+// newTrustedProxies parses the proxies whose X-Forwarded-For header the gateway believes.
+// An invalid list also fails configuration validation, so here it only leaves
+// X-Forwarded-For untrusted.
+func newTrustedProxies(config RateLimitConfig) *ratelimit.TrustedProxies {
+	trusted, err := ratelimit.ParseTrustedProxies(config.TrustedProxies)
+	if err != nil {
+		log.Printf("Ignoring trusted proxies for rate limiting: %v", err)
+		return nil
+	}
+	return trusted
+}
+
+// newAPIKeys parses the digests of the API keys the "api_key" extractor counts by. As
+// with trusted proxies, an invalid list fails validation; here it means no key is
+// accepted and every request is counted by client address.
+func newAPIKeys(config RateLimitConfig) *ratelimit.APIKeys {
+	apiKeys, err := ratelimit.ParseAPIKeyDigests(config.APIKeyDigests)
+	if err != nil {
+		log.Printf("Ignoring API key digests for rate limiting: %v", err)
+		return nil
+	}
+	return apiKeys
+}
@@ -0,0 +1,263 @@
+package gateway
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testAPIKeyDigest is the SHA-256 digest of the API key "issued-key"
+const testAPIKeyDigest = "70b93791334a4d9aa4e2e802434d2cbda5e29ac493325875c93c596a971c690f"
+
+func createRateLimitTestConfiguration() *GatewayConfiguration {
+	return &GatewayConfiguration{
+		Name:        "rate-limit-test-gateway",
+		Type:        GatewayTypePublic,
+		Environment: "test",
+		RateLimit: RateLimitConfig{
+			Enabled:           true,
+			RequestsPerMinute: 1000,
+			BurstSize:         100,
+			WindowSize:        time.Minute,
+			KeyExtractor:      "ip",
+			BackingStore:      "memory",
+		},
+		ServiceRouting: ServiceRoutingConfig{
+			HealthCheckPath: "/health",
+			MetricsPath:     "/metrics",
+		},
+	}
+}
+
+func TestRateLimitConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name          string
+		modify        func(config *RateLimitConfig)
+		expectedError bool
+	}{
+		{name: "test configuration", modify: func(config *RateLimitConfig) {}},
+		{name: "sliding window", modify: func(config *RateLimitConfig) { config.Algorithm = "sliding_window" }},
+		{name: "api key extractor", modify: func(config *RateLimitConfig) {
+			config.KeyExtractor = "api_key"
+			config.APIKeyHeader = "X-API-Key"
+			config.APIKeyDigests = []string{testAPIKeyDigest}
+		}},
+		{name: "api key extractor without header", modify: func(config *RateLimitConfig) {
+			config.KeyExtractor = "api_key"
+			config.APIKeyDigests = []string{testAPIKeyDigest}
+		}, expectedError: true},
+		{name: "api key extractor without issued keys", modify: func(config *RateLimitConfig) {
+			config.KeyExtractor = "api_key"
+			config.APIKeyHeader = "X-API-Key"
+		}, expectedError: true},
+		{name: "malformed api key digest", modify: func(config *RateLimitConfig) { config.APIKeyDigests = []string{"issued-key"} }, expectedError: true},
+		{name: "route keyed on api keys without issued keys", modify: func(config *RateLimitConfig) {
+			config.APIKeyHeader = "X-API-Key"
+			config.Routes = []RouteRateLimit{{Name: "search", PathPrefix: "/api/v1/search", RequestsPerMinute: 10, KeyExtractor: "api_key"}}
+		}, expectedError: true},
+		{name: "unknown key extractor", modify: func(config *RateLimitConfig) { config.KeyExtractor = "session" }, expectedError: true},
+		{name: "unknown backing store", modify: func(config *RateLimitConfig) { config.BackingStore = "redis" }, expectedError: true},
+		{name: "invalid trusted proxy", modify: func(config *RateLimitConfig) { config.TrustedProxies = []string{"10.0.0.0/40"} }, expectedError: true},
+		{name: "duplicate route names", modify: func(config *RateLimitConfig) {
+			config.Routes = []RouteRateLimit{
+				{Name: "search", PathPrefix: "/api/v1/search", RequestsPerMinute: 10},
+				{Name: "search", PathPrefix: "/api/search", RequestsPerMinute: 10},
+			}
+		}, expectedError: true},
+		{name: "route without limit", modify: func(config *RateLimitConfig) {
+			config.Routes = []RouteRateLimit{{Name: "search", PathPrefix: "/api/v1/search"}}
+		}, expectedError: true},
+		{name: "route with unknown key extractor", modify: func(config *RateLimitConfig) {
+			config.Routes = []RouteRateLimit{{Name: "search", PathPrefix: "/api/v1/search", RequestsPerMinute: 10, KeyExtractor: "session"}}
+		}, expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			config := createRateLimitTestConfiguration().RateLimit
+			tt.modify(&config)
+
+			// Act
+			err := config.Validate()
+
+			// Assert
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGatewayConfigurations_RateLimitDefaultsAreValid(t *testing.T) {
+	for _, config := range []*GatewayConfiguration{NewPublicGatewayConfiguration(), NewAdminGatewayConfiguration()} {
+		t.Run(config.Name, func(t *testing.T) {
+			// Act
+			err := config.RateLimit.Validate()
+
+			// Assert
+			assert.NoError(t, err)
+			assert.NotEmpty(t, config.RateLimit.Routes)
+		})
+	}
+}
+
+func TestMiddleware_RateLimitMiddleware(t *testing.T) {
+	newRateLimitedHandler := func(configure func(config *GatewayConfiguration)) http.Handler {
+		config := createRateLimitTestConfiguration()
+		config.RateLimit.KeyExtractor = "user"
+		config.RateLimit.RequestsPerMinute = 60
+		config.RateLimit.BurstSize = 2
+		config.RateLimit.TrustedProxies = []string{"10.0.0.0/8"}
+		config.RateLimit.Routes = []RouteRateLimit{
+			{Name: "login", PathPrefix: "/admin/api/v1/auth/login", Methods: []string{"POST"}, RequestsPerMinute: 60, BurstSize: 1, KeyExtractor: "ip"},
+		}
+		configure(config)
+
+		return NewMiddleware(config).RateLimitMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}))
+	}
+	newRequest := func(method, path, remoteAddr, userID string) *http.Request {
+		req := httptest.NewRequest(method, path, nil)
+		req.RemoteAddr = remoteAddr
+		if userID != "" {
+			principal := auth.NewPrincipal(&auth.User{UserID: userID}, nil)
+			req = req.WithContext(auth.WithPrincipal(req.Context(), principal))
+		}
+		return req
+	}
+	serve := func(handler http.Handler, req *http.Request) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("rejects with the gateway error format once the burst is spent", func(t *testing.T) {
+		// Arrange
+		handler := newRateLimitedHandler(func(config *GatewayConfiguration) {})
+
+		// Act
+		var codes []int
+		var limited *httptest.ResponseRecorder
+		for i := 0; i < 3; i++ {
+			limited = serve(handler, newRequest("GET", "/api/v1/news", "192.0.2.1:5000", "user-1"))
+			codes = append(codes, limited.Code)
+		}
+
+		// Assert
+		assert.Equal(t, []int{http.StatusNoContent, http.StatusNoContent, http.StatusTooManyRequests}, codes)
+		assert.Equal(t, "2", limited.Header().Get("RateLimit-Limit"))
+		assert.Equal(t, "0", limited.Header().Get("RateLimit-Remaining"))
+		assert.Equal(t, "1", limited.Header().Get("Retry-After"))
+		assert.Equal(t, "60;w=60;burst=2", limited.Header().Get("RateLimit-Policy"))
+
+		var body map[string]map[string]interface{}
+		require.NoError(t, json.Unmarshal(limited.Body.Bytes(), &body))
+		assert.Equal(t, "RATE_LIMIT_EXCEEDED", body["error"]["code"])
+	})
+
+	t.Run("counts users separately wherever they connect from", func(t *testing.T) {
+		// Arrange
+		handler := newRateLimitedHandler(func(config *GatewayConfiguration) {})
+		serve(handler, newRequest("GET", "/api/v1/news", "192.0.2.1:5000", "user-1"))
+		serve(handler, newRequest("GET", "/api/v1/news", "192.0.2.2:5001", "user-1"))
+
+		// Act
+		sameUser := serve(handler, newRequest("GET", "/api/v1/news", "192.0.2.3:5002", "user-1"))
+		otherUser := serve(handler, newRequest("GET", "/api/v1/news", "192.0.2.1:5000", "user-2"))
+
+		// Assert
+		assert.Equal(t, http.StatusTooManyRequests, sameUser.Code)
+		assert.Equal(t, http.StatusNoContent, otherUser.Code)
+	})
+
+	t.Run("route limits count per forwarded client address", func(t *testing.T) {
+		// Arrange
+		handler := newRateLimitedHandler(func(config *GatewayConfiguration) {})
+		login := func(forwardedFor string, port string) *http.Request {
+			req := newRequest("POST", "/admin/api/v1/auth/login", "10.0.0.5:"+port, "")
+			req.Header.Set("X-Forwarded-For", forwardedFor)
+			return req
+		}
+		serve(handler, login("198.51.100.7", "5000"))
+
+		// Act
+		sameClient := serve(handler, login("198.51.100.7", "5001"))
+		otherClient := serve(handler, login("198.51.100.8", "5002"))
+		otherRoute := serve(handler, newRequest("GET", "/api/v1/news", "10.0.0.5:5003", ""))
+
+		// Assert
+		assert.Equal(t, http.StatusTooManyRequests, sameClient.Code)
+		assert.Equal(t, "1", sameClient.Header().Get("RateLimit-Limit"))
+		assert.Equal(t, http.StatusNoContent, otherClient.Code)
+		assert.Equal(t, http.StatusNoContent, otherRoute.Code)
+	})
+
+	t.Run("counts issued api keys and falls back to the client address for unknown ones", func(t *testing.T) {
+		// Arrange
+		handler := newRateLimitedHandler(func(config *GatewayConfiguration) {
+			config.RateLimit.KeyExtractor = "api_key"
+			config.RateLimit.APIKeyHeader = "X-API-Key"
+			config.RateLimit.APIKeyDigests = []string{testAPIKeyDigest}
+		})
+		withKey := func(apiKey, remoteAddr string) *http.Request {
+			req := newRequest("GET", "/api/v1/news", remoteAddr, "")
+			req.Header.Set("X-API-Key", apiKey)
+			return req
+		}
+		serve(handler, withKey("issued-key", "192.0.2.1:5000"))
+		serve(handler, withKey("issued-key", "192.0.2.2:5001"))
+		serve(handler, withKey("made-up-1", "192.0.2.9:5000"))
+		serve(handler, withKey("made-up-2", "192.0.2.9:5001"))
+
+		// Act
+		sameKey := serve(handler, withKey("issued-key", "192.0.2.3:5002"))
+		rotatedUnknownKey := serve(handler, withKey("made-up-3", "192.0.2.9:5002"))
+		otherClient := serve(handler, withKey("made-up-4", "192.0.2.10:5000"))
+
+		// Assert
+		assert.Equal(t, http.StatusTooManyRequests, sameKey.Code)
+		assert.Equal(t, http.StatusTooManyRequests, rotatedUnknownKey.Code)
+		assert.Equal(t, http.StatusNoContent, otherClient.Code)
+	})
+
+	t.Run("skips health checks and preflight requests", func(t *testing.T) {
+		// Arrange
+		handler := newRateLimitedHandler(func(config *GatewayConfiguration) { config.RateLimit.BurstSize = 1 })
+
+		// Act
+		var codes []int
+		for _, req := range []*http.Request{
+			newRequest("GET", "/health", "192.0.2.1:5000", ""),
+			newRequest("GET", "/health", "192.0.2.1:5000", ""),
+			newRequest("OPTIONS", "/api/v1/news", "192.0.2.1:5000", ""),
+			newRequest("OPTIONS", "/api/v1/news", "192.0.2.1:5000", ""),
+		} {
+			codes = append(codes, serve(handler, req).Code)
+		}
+
+		// Assert
+		assert.Equal(t, []int{http.StatusNoContent, http.StatusNoContent, http.StatusNoContent, http.StatusNoContent}, codes)
+	})
+
+	t.Run("disabled rate limiting sets no headers", func(t *testing.T) {
+		// Arrange
+		handler := newRateLimitedHandler(func(config *GatewayConfiguration) { config.RateLimit.Enabled = false })
+
+		// Act
+		rec := serve(handler, newRequest("GET", "/api/v1/news", "192.0.2.1:5000", ""))
+
+		// Assert
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Empty(t, rec.Header().Get("RateLimit-Limit"))
+	})
+}
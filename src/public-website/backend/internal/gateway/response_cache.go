@@ -0,0 +1,540 @@
+package gateway
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+)
+
+// Content areas cached responses belong to. Changing content in an area invalidates
+// every cached response of the area.
+const (
+	cacheTagNews     = "news"
+	cacheTagResearch = "research"
+	cacheTagServices = "services"
+	cacheTagEvents   = "events"
+)
+
+var allCacheTags = []string{cacheTagNews, cacheTagResearch, cacheTagServices, cacheTagEvents}
+
+// cachedRoutes are the public content routes whose responses are cached, with the
+// content areas each one depends on. Search and the generic content routes span all
+// areas; media downloads and inquiries are never cached.
+var cachedRoutes = []struct {
+	prefix string
+	tags   []string
+}{
+	{prefix: "/api/v1/news", tags: []string{cacheTagNews}},
+	{prefix: "/api/news", tags: []string{cacheTagNews}},
+	{prefix: "/api/v1/research", tags: []string{cacheTagResearch}},
+	{prefix: "/api/research", tags: []string{cacheTagResearch}},
+	{prefix: "/api/v1/services", tags: []string{cacheTagServices}},
+	{prefix: "/api/services", tags: []string{cacheTagServices}},
+	{prefix: "/api/v1/events", tags: []string{cacheTagEvents}},
+	{prefix: "/api/events", tags: []string{cacheTagEvents}},
+	{prefix: "/api/v1/content", tags: allCacheTags},
+	{prefix: "/api/v1/search", tags: allCacheTags},
+}
+
+// entityCacheTags are the content areas changes to each entity type invalidate. Media
+// assets can appear in any area.
+var entityCacheTags = map[domain.EntityType][]string{
+	domain.EntityTypeNews:              {cacheTagNews},
+	domain.EntityTypeNewsCategory:      {cacheTagNews},
+	domain.EntityTypeFeaturedNews:      {cacheTagNews},
+	domain.EntityTypeResearch:          {cacheTagResearch},
+	domain.EntityTypeResearchCategory:  {cacheTagResearch},
+	domain.EntityTypeFeaturedResearch:  {cacheTagResearch},
+	domain.EntityTypeService:           {cacheTagServices},
+	domain.EntityTypeServiceCategory:   {cacheTagServices},
+	domain.EntityTypeFeaturedCategory:  {cacheTagServices},
+	domain.EntityTypeEvent:             {cacheTagEvents},
+	domain.EntityTypeEventCategory:     {cacheTagEvents},
+	domain.EntityTypeFeaturedEvent:     {cacheTagEvents},
+	domain.EntityTypeEventRegistration: {cacheTagEvents},
+	domain.EntityTypeMediaAsset:        allCacheTags,
+}
+
+// invalidationSkew widens every invalidation so that a response fetched on another
+// replica just before it, under a slightly different clock, is not kept
+const invalidationSkew = 2 * time.Second
+
+// uncachedHeaders belong to the request that filled the cache, not to the response
+var uncachedHeaders = map[string]bool{
+	"X-Correlation-Id":    true,
+	"Ratelimit-Limit":     true,
+	"Ratelimit-Remaining": true,
+	"Ratelimit-Reset":     true,
+	"Ratelimit-Policy":    true,
+	"Retry-After":         true,
+	"Set-Cookie":          true,
+	"Date":                true,
+	"Content-Length":      true,
+}
+
+// CacheInvalidationStore shares invalidations between gateway replicas. Pub/sub delivers
+// each content event to a single replica, which records it here for the others to pick up.
+type CacheInvalidationStore interface {
+	SaveInvalidation(ctx context.Context, tag string, at time.Time) error
+	LoadInvalidations(ctx context.Context, tags []string) (map[string]time.Time, error)
+}
+
+// cachedResponse is a stored response with the validators clients revalidate against
+type cachedResponse struct {
+	key          string
+	tags         []string
+	header       http.Header
+	body         []byte
+	etag         string
+	lastModified time.Time
+	fetchedAt    time.Time
+}
+
+// ResponseCacheStats counts how cached requests were answered
+type ResponseCacheStats struct {
+	Entries       int   `json:"entries"`
+	Hits          int64 `json:"hits"`
+	StaleHits     int64 `json:"stale_hits"`
+	Misses        int64 `json:"misses"`
+	NotModified   int64 `json:"not_modified"`
+	Invalidations int64 `json:"invalidations"`
+}
+
+// ResponseCache caches public content responses in the gateway. Entries are served
+// fresh for the configured max age, then served stale while a background request
+// refreshes them, and dropped as soon as content in their area changes.
+type ResponseCache struct {
+	config        *GatewayConfiguration
+	invalidations CacheInvalidationStore
+	now           func() time.Time
+
+	mutex         sync.Mutex
+	entries       map[string]*list.Element
+	lru           *list.List
+	invalidatedAt map[string]time.Time
+	revalidating  map[string]bool
+	refreshes     sync.WaitGroup
+
+	hits        atomic.Int64
+	staleHits   atomic.Int64
+	misses      atomic.Int64
+	notModified atomic.Int64
+	invalidated atomic.Int64
+}
+
+// NewResponseCache creates an empty response cache sized by the gateway cache settings
+func NewResponseCache(config *GatewayConfiguration) *ResponseCache {
+	return &ResponseCache{
+		config:        config,
+		now:           time.Now,
+		entries:       make(map[string]*list.Element),
+		lru:           list.New(),
+		invalidatedAt: make(map[string]time.Time),
+		revalidating:  make(map[string]bool),
+	}
+}
+
+// SetInvalidationStore shares invalidations with the other replicas through store
+func (c *ResponseCache) SetInvalidationStore(store CacheInvalidationStore) {
+	c.invalidations = store
+}
+
+// Stats returns the cache counters
+func (c *ResponseCache) Stats() ResponseCacheStats {
+	c.mutex.Lock()
+	entries := len(c.entries)
+	c.mutex.Unlock()
+
+	return ResponseCacheStats{
+		Entries:       entries,
+		Hits:          c.hits.Load(),
+		StaleHits:     c.staleHits.Load(),
+		Misses:        c.misses.Load(),
+		NotModified:   c.notModified.Load(),
+		Invalidations: c.invalidated.Load(),
+	}
+}
+
+// Middleware answers cacheable GET requests from the cache, fetching and storing the
+// response on a miss. Conditional requests matching the ETag or Last-Modified of the
+// response get 304 Not Modified, whether or not it came from the cache.
+func (c *ResponseCache) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		settings := c.config.CacheControlSettings()
+		tags := cacheTags(r.URL.Path)
+		if !settings.Enabled || !settings.ResponseCacheEnabled || tags == nil || !isCacheableRequest(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		key := responseCacheKey(r)
+		now := c.now()
+
+		// Clients asking for a fresh copy skip the lookup but still refresh the entry
+		if !requestsFreshCopy(r) {
+			if entry, age, ok := c.lookup(key, now); ok {
+				maxAge := time.Duration(settings.MaxAge) * time.Second
+				if age < maxAge {
+					c.hits.Add(1)
+					c.writeEntry(w, r, entry, age, "HIT", settings)
+					return
+				}
+				if age < maxAge+time.Duration(settings.StaleWhileRevalidate)*time.Second {
+					c.staleHits.Add(1)
+					c.revalidate(key, tags, r, next)
+					c.writeEntry(w, r, entry, age, "STALE", settings)
+					return
+				}
+			}
+		}
+
+		c.misses.Add(1)
+		entry, recorded := c.fetch(key, tags, r, next)
+		if entry == nil {
+			recorded.replay(w)
+			return
+		}
+		c.writeEntry(w, r, entry, 0, "MISS", settings)
+	})
+}
+
+// lookup returns the entry for key and its age, unless content it depends on changed
+// since it was fetched
+func (c *ResponseCache) lookup(key string, now time.Time) (*cachedResponse, time.Duration, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, 0, false
+	}
+	entry := element.Value.(*cachedResponse)
+	if c.invalidatedLocked(entry) {
+		c.removeLocked(element)
+		return nil, 0, false
+	}
+
+	c.lru.MoveToFront(element)
+	return entry, now.Sub(entry.fetchedAt), true
+}
+
+// fetch runs the request through next and stores the response when it may be shared.
+// It returns the stored entry, or nil with the recorded response when nothing was stored.
+func (c *ResponseCache) fetch(key string, tags []string, r *http.Request, next http.Handler) (*cachedResponse, *recordedResponse) {
+	fetchedAt := c.now()
+	recorded := newRecordedResponse()
+	next.ServeHTTP(recorded, r)
+
+	settings := c.config.CacheControlSettings()
+	if !recorded.storable(settings.MaxEntryBytes) {
+		return nil, recorded
+	}
+
+	entry := &cachedResponse{
+		key:          key,
+		tags:         tags,
+		header:       make(http.Header),
+		body:         recorded.body.Bytes(),
+		etag:         recorded.header.Get("ETag"),
+		lastModified: fetchedAt.UTC().Truncate(time.Second),
+		fetchedAt:    fetchedAt,
+	}
+	for name, values := range recorded.header {
+		if !uncachedHeaders[http.CanonicalHeaderKey(name)] {
+			entry.header[name] = append([]string(nil), values...)
+		}
+	}
+	if entry.etag == "" {
+		digest := sha256.Sum256(entry.body)
+		entry.etag = `"` + hex.EncodeToString(digest[:16]) + `"`
+	}
+	if upstream, err := http.ParseTime(recorded.header.Get("Last-Modified")); err == nil {
+		entry.lastModified = upstream.UTC()
+	}
+
+	c.store(entry, settings.MaxEntries)
+	return entry, recorded
+}
+
+// store adds entry, evicting the least recently used entries beyond maxEntries. An
+// unchanged body keeps the Last-Modified time clients already hold.
+func (c *ResponseCache) store(entry *cachedResponse, maxEntries int) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if element, ok := c.entries[entry.key]; ok {
+		previous := element.Value.(*cachedResponse)
+		if previous.etag == entry.etag && previous.lastModified.Before(entry.lastModified) {
+			entry.lastModified = previous.lastModified
+		}
+		c.removeLocked(element)
+	}
+	if c.invalidatedLocked(entry) {
+		return
+	}
+
+	c.entries[entry.key] = c.lru.PushFront(entry)
+	for len(c.entries) > maxEntries {
+		c.removeLocked(c.lru.Back())
+	}
+}
+
+// revalidate refreshes a stale entry in the background, once per key at a time
+func (c *ResponseCache) revalidate(key string, tags []string, r *http.Request, next http.Handler) {
+	c.mutex.Lock()
+	if c.revalidating[key] {
+		c.mutex.Unlock()
+		return
+	}
+	c.revalidating[key] = true
+	c.mutex.Unlock()
+
+	// The refresh outlives the client request, but keeps its correlation context
+	refresh := r.Clone(context.WithoutCancel(r.Context()))
+	refresh.Header.Del("If-None-Match")
+	refresh.Header.Del("If-Modified-Since")
+
+	c.refreshes.Add(1)
+	go func() {
+		defer c.refreshes.Done()
+		defer func() {
+			c.mutex.Lock()
+			delete(c.revalidating, key)
+			c.mutex.Unlock()
+		}()
+		c.fetch(key, tags, refresh, next)
+	}()
+}
+
+// writeEntry answers from entry, with 304 Not Modified when the client's copy is current
+func (c *ResponseCache) writeEntry(w http.ResponseWriter, r *http.Request, entry *cachedResponse, age time.Duration, status string, settings CacheControlConfig) {
+	header := w.Header()
+	for name, values := range entry.header {
+		header[name] = append([]string(nil), values...)
+	}
+	header.Set("ETag", entry.etag)
+	header.Set("Last-Modified", entry.lastModified.Format(http.TimeFormat))
+	header.Set("Cache-Control", fmt.Sprintf("public, max-age=%d", settings.ClientMaxAge))
+	header.Set("Age", strconv.Itoa(int(age.Seconds())))
+	header.Set("X-Cache", status)
+
+	if notModified(r, entry.etag, entry.lastModified) {
+		c.notModified.Add(1)
+		header.Del("Content-Type")
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	header.Set("Content-Length", strconv.Itoa(len(entry.body)))
+	w.WriteHeader(http.StatusOK)
+	w.Write(entry.body)
+}
+
+// Invalidate drops the cached responses of the given content areas and records the
+// invalidation for the other replicas
+func (c *ResponseCache) Invalidate(ctx context.Context, tags []string) error {
+	at := c.now()
+	c.applyInvalidations(tags, at)
+
+	if c.invalidations == nil {
+		return nil
+	}
+	for _, tag := range tags {
+		if err := c.invalidations.SaveInvalidation(ctx, tag, at); err != nil {
+			return fmt.Errorf("failed to share cache invalidation of %s: %w", tag, err)
+		}
+	}
+	return nil
+}
+
+// Run applies the invalidations other replicas record until ctx is cancelled. It
+// returns at once when no invalidation store is set.
+func (c *ResponseCache) Run(ctx context.Context) {
+	if c.invalidations == nil {
+		return
+	}
+
+	for {
+		interval := c.config.CacheControlSettings().InvalidationPollInterval
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+
+		if err := c.syncInvalidations(ctx); err != nil {
+			log.Printf("Failed to load response cache invalidations: %v", err)
+		}
+	}
+}
+
+// syncInvalidations applies the invalidations recorded in the invalidation store
+func (c *ResponseCache) syncInvalidations(ctx context.Context) error {
+	invalidations, err := c.invalidations.LoadInvalidations(ctx, allCacheTags)
+	if err != nil {
+		return err
+	}
+	for tag, at := range invalidations {
+		c.applyInvalidations([]string{tag}, at)
+	}
+	return nil
+}
+
+// applyInvalidations records the time tags were invalidated and drops the entries fetched before
+func (c *ResponseCache) applyInvalidations(tags []string, at time.Time) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	changed := false
+	for _, tag := range tags {
+		if at.After(c.invalidatedAt[tag]) {
+			c.invalidatedAt[tag] = at
+			changed = true
+		}
+	}
+	if !changed {
+		return
+	}
+
+	c.invalidated.Add(1)
+	for element := c.lru.Front(); element != nil; {
+		nextElement := element.Next()
+		if c.invalidatedLocked(element.Value.(*cachedResponse)) {
+			c.removeLocked(element)
+		}
+		element = nextElement
+	}
+}
+
+// invalidatedLocked reports whether content entry depends on changed after it was fetched
+func (c *ResponseCache) invalidatedLocked(entry *cachedResponse) bool {
+	for _, tag := range entry.tags {
+		if at, ok := c.invalidatedAt[tag]; ok && entry.fetchedAt.Before(at.Add(invalidationSkew)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *ResponseCache) removeLocked(element *list.Element) {
+	delete(c.entries, element.Value.(*cachedResponse).key)
+	c.lru.Remove(element)
+}
+
+// cacheTags returns the content areas a cacheable path depends on, or nil for paths
+// that are not cached
+func cacheTags(path string) []string {
+	for _, route := range cachedRoutes {
+		if path == route.prefix || strings.HasPrefix(path, route.prefix+"/") {
+			return route.tags
+		}
+	}
+	return nil
+}
+
+// cacheTagsForEntity returns the content areas changes to entityType invalidate, or nil
+// for entities the public site does not show
+func cacheTagsForEntity(entityType domain.EntityType) []string {
+	return entityCacheTags[entityType]
+}
+
+// isCacheableRequest reports whether the response to r may be shared between clients
+func isCacheableRequest(r *http.Request) bool {
+	if r.Method != http.MethodGet {
+		return false
+	}
+	return r.Header.Get("Authorization") == "" && r.Header.Get("Cookie") == ""
+}
+
+// requestsFreshCopy reports whether the client asked not to be served from a cache
+func requestsFreshCopy(r *http.Request) bool {
+	directives := strings.ToLower(r.Header.Get("Cache-Control"))
+	return strings.Contains(directives, "no-cache") || strings.Contains(directives, "no-store") ||
+		strings.EqualFold(r.Header.Get("Pragma"), "no-cache")
+}
+
+// responseCacheKey identifies a response by path, sorted query and the Accept headers
+// that select its representation
+func responseCacheKey(r *http.Request) string {
+	query, err := url.ParseQuery(r.URL.RawQuery)
+	encodedQuery := r.URL.RawQuery
+	if err == nil {
+		encodedQuery = query.Encode()
+	}
+
+	var key strings.Builder
+	key.WriteString(r.URL.Path)
+	key.WriteString("?")
+	key.WriteString(encodedQuery)
+	key.WriteString("\naccept=")
+	key.WriteString(strings.TrimSpace(r.Header.Get("Accept")))
+	key.WriteString("\naccept-language=")
+	key.WriteString(strings.TrimSpace(r.Header.Get("Accept-Language")))
+	return key.String()
+}
+
+// notModified evaluates If-None-Match, falling back to If-Modified-Since when absent
+func notModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if ifNoneMatch := r.Header.Get("If-None-Match"); ifNoneMatch != "" {
+		for _, candidate := range strings.Split(ifNoneMatch, ",") {
+			candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+			if candidate == "*" || candidate == strings.TrimPrefix(etag, "W/") {
+				return true
+			}
+		}
+		return false
+	}
+
+	since, err := http.ParseTime(r.Header.Get("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+	return !lastModified.After(since)
+}
+
+// recordedResponse buffers a response so it can be stored before it is sent
+type recordedResponse struct {
+	header     http.Header
+	statusCode int
+	body       bytes.Buffer
+}
+
+func newRecordedResponse() *recordedResponse {
+	return &recordedResponse{header: make(http.Header), statusCode: http.StatusOK}
+}
+
+func (r *recordedResponse) Header() http.Header            { return r.header }
+func (r *recordedResponse) Write(data []byte) (int, error) { return r.body.Write(data) }
+func (r *recordedResponse) WriteHeader(statusCode int)     { r.statusCode = statusCode }
+
+// storable reports whether the response is a complete 200 the upstream allows sharing
+func (r *recordedResponse) storable(maxBytes int) bool {
+	if r.statusCode != http.StatusOK || r.body.Len() > maxBytes {
+		return false
+	}
+	directives := strings.ToLower(r.header.Get("Cache-Control"))
+	return !strings.Contains(directives, "no-store") && !strings.Contains(directives, "no-cache") &&
+		!strings.Contains(directives, "private")
+}
+
+// replay sends the recorded response unchanged
+func (r *recordedResponse) replay(w http.ResponseWriter) {
+	for name, values := range r.header {
+		w.Header()[name] = values
+	}
+	w.WriteHeader(r.statusCode)
+	w.Write(r.body.Bytes())
+}
@@ -0,0 +1,87 @@
+package gateway
+
+import (
+	"context"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+)
+
+// responseCacheRoute is the route Dapr delivers audit events to for cache invalidation
+const responseCacheRoute = "/events/response-cache"
+
+const cacheInvalidationEntityType = "cache-invalidation"
+
+// HandleAuditEvent invalidates the cached responses of the content area an audit event
+// changed. Read access events and entity types outside the public content are ignored.
+func (c *ResponseCache) HandleAuditEvent(ctx context.Context, event *dapr.TopicEvent) error {
+	message, err := event.EventMessage()
+	if err != nil {
+		return err
+	}
+
+	entityType, _ := message.Data["entity_type"].(string)
+	operationType, _ := message.Data["operation_type"].(string)
+
+	if domain.AuditEventType(operationType) == domain.AuditEventAccess {
+		return nil
+	}
+
+	tags := cacheTagsForEntity(domain.EntityType(entityType))
+	if tags == nil {
+		return nil
+	}
+
+	return c.Invalidate(ctx, tags)
+}
+
+// SubscribeResponseCache delivers content audit events to the response cache
+func SubscribeResponseCache(subscriber *dapr.Subscriber, pubsub *dapr.PubSub, cache *ResponseCache) {
+	subscriber.Subscribe(pubsub.AuditTopic(), responseCacheRoute, cache.HandleAuditEvent)
+}
+
+// cacheInvalidation is the state store record of the last invalidation of a content area
+type cacheInvalidation struct {
+	InvalidatedAt time.Time `json:"invalidated_at"`
+}
+
+// StateCacheInvalidationStore implements CacheInvalidationStore using the Dapr state
+// store, keeping one record per content area
+type StateCacheInvalidationStore struct {
+	stateStore  *dapr.StateStore
+	gatewayName string
+}
+
+// NewStateCacheInvalidationStore creates an invalidation store for the named gateway
+func NewStateCacheInvalidationStore(stateStore *dapr.StateStore, gatewayName string) *StateCacheInvalidationStore {
+	return &StateCacheInvalidationStore{
+		stateStore:  stateStore,
+		gatewayName: gatewayName,
+	}
+}
+
+// SaveInvalidation records that tag was invalidated at
+func (s *StateCacheInvalidationStore) SaveInvalidation(ctx context.Context, tag string, at time.Time) error {
+	return s.stateStore.Save(ctx, s.stateKey(tag), &cacheInvalidation{InvalidatedAt: at}, nil)
+}
+
+// LoadInvalidations returns the last invalidation of each tag that was ever invalidated
+func (s *StateCacheInvalidationStore) LoadInvalidations(ctx context.Context, tags []string) (map[string]time.Time, error) {
+	invalidations := make(map[string]time.Time, len(tags))
+	for _, tag := range tags {
+		var record cacheInvalidation
+		found, err := s.stateStore.Get(ctx, s.stateKey(tag), &record)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			invalidations[tag] = record.InvalidatedAt
+		}
+	}
+	return invalidations, nil
+}
+
+func (s *StateCacheInvalidationStore) stateKey(tag string) string {
+	return s.stateStore.CreateKey(s.gatewayName, cacheInvalidationEntityType, tag)
+}
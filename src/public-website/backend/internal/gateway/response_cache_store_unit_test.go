@@ -0,0 +1,214 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func auditTopicEvent(t *testing.T, entityType, entityID, operationType string) *dapr.TopicEvent {
+	data, err := json.Marshal(dapr.EventMessage{
+		Data: map[string]interface{}{
+			"entity_type":    entityType,
+			"entity_id":      entityID,
+			"operation_type": operationType,
+		},
+	})
+	require.NoError(t, err)
+	return &dapr.TopicEvent{ID: "event-1", Topic: "audit-events-dev", Data: data}
+}
+
+func TestResponseCache_HandleAuditEvent(t *testing.T) {
+	tests := []struct {
+		name                  string
+		event                 func(t *testing.T) *dapr.TopicEvent
+		expectedInvalidations []string
+		expectedError         bool
+	}{
+		{
+			name:                  "published news",
+			event:                 func(t *testing.T) *dapr.TopicEvent { return auditTopicEvent(t, "news", "news-1", "PUBLISH") },
+			expectedInvalidations: []string{cacheTagNews},
+		},
+		{
+			name: "updated service category",
+			event: func(t *testing.T) *dapr.TopicEvent {
+				return auditTopicEvent(t, "service_category", "category-1", "UPDATE")
+			},
+			expectedInvalidations: []string{cacheTagServices},
+		},
+		{
+			name:                  "deleted media asset",
+			event:                 func(t *testing.T) *dapr.TopicEvent { return auditTopicEvent(t, "media_asset", "asset-1", "DELETE") },
+			expectedInvalidations: allCacheTags,
+		},
+		{
+			name:  "read access",
+			event: func(t *testing.T) *dapr.TopicEvent { return auditTopicEvent(t, "news", "news-1", "ACCESS") },
+		},
+		{
+			name: "inquiry",
+			event: func(t *testing.T) *dapr.TopicEvent {
+				return auditTopicEvent(t, "business_inquiry", "inquiry-1", "INSERT")
+			},
+		},
+		{
+			name: "malformed event",
+			event: func(t *testing.T) *dapr.TopicEvent {
+				return &dapr.TopicEvent{ID: "event-1", Data: json.RawMessage(`"not a message"`)}
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			store := &fakeInvalidationStore{invalidations: make(map[string]time.Time)}
+			cache := NewResponseCache(createResponseCacheTestConfiguration())
+			cache.SetInvalidationStore(store)
+
+			// Act
+			err := cache.HandleAuditEvent(context.Background(), tt.event(t))
+
+			// Assert
+			if tt.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			var invalidated []string
+			for _, tag := range allCacheTags {
+				if _, ok := store.invalidations[tag]; ok {
+					invalidated = append(invalidated, tag)
+				}
+			}
+			assert.Equal(t, tt.expectedInvalidations, invalidated)
+		})
+	}
+}
+
+func TestGatewayHandler_ResponseCacheDelivery(t *testing.T) {
+	tests := []struct {
+		name                 string
+		tokens               []string
+		expectedCodes        []int
+		expectedInvalidation bool
+	}{
+		{
+			name:                 "sidecar delivery with the app api token",
+			tokens:               []string{"sidecar-secret"},
+			expectedCodes:        []int{http.StatusOK},
+			expectedInvalidation: true,
+		},
+		{
+			name:          "delivery without the token is rejected",
+			tokens:        []string{""},
+			expectedCodes: []int{http.StatusUnauthorized},
+		},
+		{
+			name:          "repeated forged deliveries are rate limited",
+			tokens:        []string{"guess-1", "guess-2", "guess-3"},
+			expectedCodes: []int{http.StatusUnauthorized, http.StatusUnauthorized, http.StatusTooManyRequests},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			t.Setenv("APP_API_TOKEN", "sidecar-secret")
+			config := createResponseCacheTestConfiguration()
+			config.RateLimit = RateLimitConfig{
+				Enabled:           true,
+				RequestsPerMinute: 60,
+				BurstSize:         2,
+				WindowSize:        time.Minute,
+				KeyExtractor:      "ip",
+				BackingStore:      "memory",
+			}
+
+			store := &fakeInvalidationStore{invalidations: make(map[string]time.Time)}
+			cache := NewResponseCache(config)
+			cache.SetInvalidationStore(store)
+			subscriber := dapr.NewSubscriber()
+			subscriber.Subscribe("audit-events-dev", responseCacheRoute, cache.HandleAuditEvent)
+
+			handler := NewGatewayHandler(config, NewServiceProxyWithInvocation(nil, config), NewMiddleware(config))
+			handler.SetEventSubscriber(subscriber)
+			router := handler.CreateRouter()
+
+			body, err := json.Marshal(auditTopicEvent(t, "news", "news-1", "PUBLISH"))
+			require.NoError(t, err)
+
+			// Act
+			var codes []int
+			for _, token := range tt.tokens {
+				request := httptest.NewRequest(http.MethodPost, responseCacheRoute, bytes.NewReader(body))
+				request.RemoteAddr = "203.0.113.7:5000"
+				if token != "" {
+					request.Header.Set("dapr-api-token", token)
+				}
+				recorder := httptest.NewRecorder()
+				router.ServeHTTP(recorder, request)
+				codes = append(codes, recorder.Code)
+			}
+
+			// Assert
+			assert.Equal(t, tt.expectedCodes, codes)
+			_, invalidated := store.invalidations[cacheTagNews]
+			assert.Equal(t, tt.expectedInvalidation, invalidated)
+		})
+	}
+}
+
+func TestGatewayHandler_MetricsEndpoint_EventDeduplication(t *testing.T) {
+	// Arrange
+	t.Setenv("APP_API_TOKEN", "sidecar-secret")
+	config := createResponseCacheTestConfiguration()
+	config.Observability.MetricsPath = "/metrics"
+
+	store := &fakeInvalidationStore{invalidations: make(map[string]time.Time)}
+	cache := NewResponseCache(config)
+	cache.SetInvalidationStore(store)
+	deduplicator := dapr.NewEventDeduplicator(dapr.NewStateStoreWithBackend(dapr.NewMemoryStateBackend()))
+	subscriber := dapr.NewSubscriber()
+	subscriber.UseDeduplication(deduplicator)
+	subscriber.Subscribe("audit-events-dev", responseCacheRoute, cache.HandleAuditEvent)
+
+	handler := NewGatewayHandler(config, NewServiceProxyWithInvocation(&TestServiceInvocation{}, config), NewMiddleware(config))
+	handler.SetEventSubscriber(subscriber)
+	handler.SetEventDeduplicator(deduplicator)
+	router := handler.CreateRouter()
+
+	body, err := json.Marshal(auditTopicEvent(t, "news", "news-1", "PUBLISH"))
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		request := httptest.NewRequest(http.MethodPost, responseCacheRoute, bytes.NewReader(body))
+		request.Header.Set("dapr-api-token", "sidecar-secret")
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, request)
+		require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+	}
+
+	// Act
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	// Assert
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+	var metrics struct {
+		EventDeduplication dapr.DeduplicationMetrics `json:"event_deduplication"`
+	}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &metrics), recorder.Body.String())
+	assert.Equal(t, int64(1), metrics.EventDeduplication.Processed)
+	assert.Equal(t, int64(1), metrics.EventDeduplication.DuplicatesDropped)
+	assert.Equal(t, map[string]int64{responseCacheRoute: 1}, metrics.EventDeduplication.DroppedByConsumer)
+}
@@ -0,0 +1,504 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeContentUpstream stands in for the proxied content service, answering with the
+// current content version
+type fakeContentUpstream struct {
+	mutex        sync.Mutex
+	calls        int
+	version      int
+	status       int
+	cacheControl string
+}
+
+func (u *fakeContentUpstream) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	u.mutex.Lock()
+	u.calls++
+	version := u.version
+	u.mutex.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", u.cacheControl)
+	w.Header().Set("X-Correlation-ID", "upstream-correlation")
+	w.WriteHeader(u.status)
+	fmt.Fprintf(w, `{"path":%q,"version":%d}`, r.URL.Path, version)
+}
+
+func (u *fakeContentUpstream) publish() {
+	u.mutex.Lock()
+	u.version++
+	u.mutex.Unlock()
+}
+
+func (u *fakeContentUpstream) callCount() int {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+	return u.calls
+}
+
+// fakeInvalidationStore records invalidations in memory
+type fakeInvalidationStore struct {
+	mutex         sync.Mutex
+	invalidations map[string]time.Time
+}
+
+func (s *fakeInvalidationStore) SaveInvalidation(ctx context.Context, tag string, at time.Time) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.invalidations[tag] = at
+	return nil
+}
+
+func (s *fakeInvalidationStore) LoadInvalidations(ctx context.Context, tags []string) (map[string]time.Time, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	invalidations := make(map[string]time.Time)
+	for _, tag := range tags {
+		if at, ok := s.invalidations[tag]; ok {
+			invalidations[tag] = at
+		}
+	}
+	return invalidations, nil
+}
+
+// createResponseCacheTestConfiguration returns a public gateway caching responses for a
+// minute and serving them stale for another 30 seconds
+func createResponseCacheTestConfiguration() *GatewayConfiguration {
+	config := &GatewayConfiguration{
+		Name:         "response-cache-test-gateway",
+		Type:         GatewayTypePublic,
+		Environment:  "test",
+		CacheControl: NewPublicGatewayConfiguration().CacheControl,
+	}
+	config.CacheControl.MaxAge = 60
+	config.CacheControl.StaleWhileRevalidate = 30
+	return config
+}
+
+type responseCacheFixture struct {
+	cache    *ResponseCache
+	upstream *fakeContentUpstream
+	handler  http.Handler
+	now      time.Time
+}
+
+func newResponseCacheFixture(configure func(config *GatewayConfiguration)) *responseCacheFixture {
+	config := createResponseCacheTestConfiguration()
+	configure(config)
+
+	fixture := &responseCacheFixture{
+		upstream: &fakeContentUpstream{status: http.StatusOK, cacheControl: "public, max-age=60"},
+		now:      time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+	}
+	fixture.cache = NewResponseCache(config)
+	fixture.cache.now = func() time.Time { return fixture.now }
+	fixture.handler = fixture.cache.Middleware(fixture.upstream)
+	return fixture
+}
+
+func (f *responseCacheFixture) get(path string, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	rec := httptest.NewRecorder()
+	f.handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func (f *responseCacheFixture) advance(d time.Duration) {
+	f.now = f.now.Add(d)
+}
+
+func TestCacheControlConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name          string
+		modify        func(config *CacheControlConfig)
+		expectedError bool
+	}{
+		{name: "public defaults", modify: func(config *CacheControlConfig) {}},
+		{name: "response cache disabled without limits", modify: func(config *CacheControlConfig) {
+			config.ResponseCacheEnabled = false
+			config.MaxEntries = 0
+			config.InvalidationPollInterval = 0
+		}},
+		{name: "negative max age", modify: func(config *CacheControlConfig) { config.MaxAge = -1 }, expectedError: true},
+		{name: "client max age beyond max age", modify: func(config *CacheControlConfig) { config.ClientMaxAge = config.MaxAge + 1 }, expectedError: true},
+		{name: "negative stale while revalidate", modify: func(config *CacheControlConfig) { config.StaleWhileRevalidate = -1 }, expectedError: true},
+		{name: "no entries", modify: func(config *CacheControlConfig) { config.MaxEntries = 0 }, expectedError: true},
+		{name: "no entry size", modify: func(config *CacheControlConfig) { config.MaxEntryBytes = 0 }, expectedError: true},
+		{name: "no poll interval", modify: func(config *CacheControlConfig) { config.InvalidationPollInterval = 0 }, expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			config := NewPublicGatewayConfiguration().CacheControl
+			tt.modify(&config)
+
+			// Act
+			err := config.Validate()
+
+			// Assert
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestResponseCache_Middleware(t *testing.T) {
+	t.Run("serves repeat requests from the cache", func(t *testing.T) {
+		// Arrange
+		fixture := newResponseCacheFixture(func(config *GatewayConfiguration) {})
+		miss := fixture.get("/api/v1/news?page=1", nil)
+		fixture.advance(10 * time.Second)
+
+		// Act
+		hit := fixture.get("/api/v1/news?page=1", nil)
+
+		// Assert
+		assert.Equal(t, 1, fixture.upstream.callCount())
+		assert.Equal(t, "MISS", miss.Header().Get("X-Cache"))
+		assert.Equal(t, "HIT", hit.Header().Get("X-Cache"))
+		assert.Equal(t, http.StatusOK, hit.Code)
+		assert.Equal(t, miss.Body.String(), hit.Body.String())
+		assert.Equal(t, "10", hit.Header().Get("Age"))
+		assert.Equal(t, "public, max-age=0", hit.Header().Get("Cache-Control"))
+		assert.Equal(t, "application/json", hit.Header().Get("Content-Type"))
+		assert.Empty(t, hit.Header().Get("X-Correlation-ID"))
+		assert.NotEmpty(t, hit.Header().Get("ETag"))
+		assert.Equal(t, miss.Header().Get("ETag"), hit.Header().Get("ETag"))
+		assert.Equal(t, "Sun, 01 Mar 2026 12:00:00 GMT", hit.Header().Get("Last-Modified"))
+	})
+
+	t.Run("keys on path, sorted query and accept headers", func(t *testing.T) {
+		tests := []struct {
+			name          string
+			path          string
+			headers       map[string]string
+			expectedCache string
+		}{
+			{name: "same query in another order", path: "/api/v1/news?page=1&limit=10", expectedCache: "HIT"},
+			{name: "other query", path: "/api/v1/news?limit=20&page=1", expectedCache: "MISS"},
+			{name: "other path", path: "/api/v1/events?limit=10&page=1", expectedCache: "MISS"},
+			{name: "other accept", path: "/api/v1/news?limit=10&page=1", headers: map[string]string{"Accept": "text/html"}, expectedCache: "MISS"},
+			{name: "other language", path: "/api/v1/news?limit=10&page=1", headers: map[string]string{"Accept-Language": "fr"}, expectedCache: "MISS"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				// Arrange
+				fixture := newResponseCacheFixture(func(config *GatewayConfiguration) {})
+				fixture.get("/api/v1/news?limit=10&page=1", nil)
+
+				// Act
+				rec := fixture.get(tt.path, tt.headers)
+
+				// Assert
+				assert.Equal(t, tt.expectedCache, rec.Header().Get("X-Cache"))
+			})
+		}
+	})
+
+	t.Run("answers current conditional requests with not modified", func(t *testing.T) {
+		tests := []struct {
+			name           string
+			headers        func(etag, lastModified string) map[string]string
+			expectedStatus int
+		}{
+			{
+				name:           "matching etag",
+				headers:        func(etag, lastModified string) map[string]string { return map[string]string{"If-None-Match": etag} },
+				expectedStatus: http.StatusNotModified,
+			},
+			{
+				name: "matching weak etag in a list",
+				headers: func(etag, lastModified string) map[string]string {
+					return map[string]string{"If-None-Match": `"other", W/` + etag}
+				},
+				expectedStatus: http.StatusNotModified,
+			},
+			{
+				name: "other etag",
+				headers: func(etag, lastModified string) map[string]string {
+					return map[string]string{"If-None-Match": `"other"`}
+				},
+				expectedStatus: http.StatusOK,
+			},
+			{
+				name: "etag takes precedence over the modification date",
+				headers: func(etag, lastModified string) map[string]string {
+					return map[string]string{"If-None-Match": `"other"`, "If-Modified-Since": lastModified}
+				},
+				expectedStatus: http.StatusOK,
+			},
+			{
+				name: "unmodified since",
+				headers: func(etag, lastModified string) map[string]string {
+					return map[string]string{"If-Modified-Since": lastModified}
+				},
+				expectedStatus: http.StatusNotModified,
+			},
+			{
+				name: "modified since",
+				headers: func(etag, lastModified string) map[string]string {
+					return map[string]string{"If-Modified-Since": "Sun, 01 Mar 2026 11:00:00 GMT"}
+				},
+				expectedStatus: http.StatusOK,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				// Arrange
+				fixture := newResponseCacheFixture(func(config *GatewayConfiguration) {})
+				first := fixture.get("/api/v1/services", nil)
+
+				// Act
+				rec := fixture.get("/api/v1/services", tt.headers(first.Header().Get("ETag"), first.Header().Get("Last-Modified")))
+
+				// Assert
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+				assert.Equal(t, first.Header().Get("ETag"), rec.Header().Get("ETag"))
+				if tt.expectedStatus == http.StatusNotModified {
+					assert.Empty(t, rec.Body.String())
+				} else {
+					assert.Equal(t, first.Body.String(), rec.Body.String())
+				}
+			})
+		}
+	})
+
+	t.Run("serves stale responses while refreshing them in the background", func(t *testing.T) {
+		// Arrange
+		fixture := newResponseCacheFixture(func(config *GatewayConfiguration) {})
+		original := fixture.get("/api/v1/research", nil)
+		fixture.upstream.publish()
+		fixture.advance(70 * time.Second)
+
+		// Act
+		stale := fixture.get("/api/v1/research", nil)
+		fixture.cache.refreshes.Wait()
+		refreshed := fixture.get("/api/v1/research", nil)
+
+		// Assert
+		assert.Equal(t, "STALE", stale.Header().Get("X-Cache"))
+		assert.Equal(t, original.Body.String(), stale.Body.String())
+		assert.Equal(t, "HIT", refreshed.Header().Get("X-Cache"))
+		assert.JSONEq(t, `{"path":"/api/v1/research","version":1}`, refreshed.Body.String())
+		assert.NotEqual(t, original.Header().Get("ETag"), refreshed.Header().Get("ETag"))
+		assert.Equal(t, 2, fixture.upstream.callCount())
+	})
+
+	t.Run("refreshes expired responses before answering", func(t *testing.T) {
+		// Arrange
+		fixture := newResponseCacheFixture(func(config *GatewayConfiguration) {})
+		fixture.get("/api/v1/research", nil)
+		fixture.upstream.publish()
+		fixture.advance(91 * time.Second)
+
+		// Act
+		rec := fixture.get("/api/v1/research", nil)
+
+		// Assert
+		assert.Equal(t, "MISS", rec.Header().Get("X-Cache"))
+		assert.JSONEq(t, `{"path":"/api/v1/research","version":1}`, rec.Body.String())
+	})
+
+	t.Run("keeps the modification date of an unchanged refresh", func(t *testing.T) {
+		// Arrange
+		fixture := newResponseCacheFixture(func(config *GatewayConfiguration) {})
+		original := fixture.get("/api/v1/research", nil)
+		fixture.advance(91 * time.Second)
+
+		// Act
+		refreshed := fixture.get("/api/v1/research", nil)
+
+		// Assert
+		assert.Equal(t, "MISS", refreshed.Header().Get("X-Cache"))
+		assert.Equal(t, original.Header().Get("Last-Modified"), refreshed.Header().Get("Last-Modified"))
+	})
+
+	t.Run("does not share responses that are not cacheable", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			method    string
+			path      string
+			headers   map[string]string
+			configure func(fixture *responseCacheFixture)
+		}{
+			{name: "post", method: http.MethodPost, path: "/api/v1/news"},
+			{name: "authorized request", method: http.MethodGet, path: "/api/v1/news", headers: map[string]string{"Authorization": "Bearer token"}},
+			{name: "request with cookies", method: http.MethodGet, path: "/api/v1/news", headers: map[string]string{"Cookie": "session=1"}},
+			{name: "inquiries", method: http.MethodGet, path: "/api/v1/inquiries/business"},
+			{name: "media downloads", method: http.MethodGet, path: "/api/v1/media/asset-1/download"},
+			{name: "client asks for a fresh copy", method: http.MethodGet, path: "/api/v1/news", headers: map[string]string{"Cache-Control": "no-cache"}},
+			{name: "upstream forbids caching", method: http.MethodGet, path: "/api/v1/search", configure: func(fixture *responseCacheFixture) {
+				fixture.upstream.cacheControl = "no-cache"
+			}},
+			{name: "error response", method: http.MethodGet, path: "/api/v1/news", configure: func(fixture *responseCacheFixture) {
+				fixture.upstream.status = http.StatusServiceUnavailable
+			}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				// Arrange
+				fixture := newResponseCacheFixture(func(config *GatewayConfiguration) {})
+				if tt.configure != nil {
+					tt.configure(fixture)
+				}
+				serve := func() *httptest.ResponseRecorder {
+					req := httptest.NewRequest(tt.method, tt.path, nil)
+					for name, value := range tt.headers {
+						req.Header.Set(name, value)
+					}
+					rec := httptest.NewRecorder()
+					fixture.handler.ServeHTTP(rec, req)
+					return rec
+				}
+				serve()
+
+				// Act
+				rec := serve()
+
+				// Assert
+				assert.Equal(t, 2, fixture.upstream.callCount())
+				assert.NotEqual(t, "HIT", rec.Header().Get("X-Cache"))
+				assert.Equal(t, fixture.upstream.status, rec.Code)
+			})
+		}
+	})
+
+	t.Run("evicts the least recently used response", func(t *testing.T) {
+		// Arrange
+		fixture := newResponseCacheFixture(func(config *GatewayConfiguration) { config.CacheControl.MaxEntries = 2 })
+		fixture.get("/api/v1/news", nil)
+		fixture.get("/api/v1/events", nil)
+		fixture.get("/api/v1/news", nil)
+		fixture.get("/api/v1/services", nil)
+
+		// Act
+		news := fixture.get("/api/v1/news", nil)
+		events := fixture.get("/api/v1/events", nil)
+
+		// Assert
+		assert.Equal(t, "HIT", news.Header().Get("X-Cache"))
+		assert.Equal(t, "MISS", events.Header().Get("X-Cache"))
+	})
+
+	t.Run("passes requests through when the response cache is disabled", func(t *testing.T) {
+		// Arrange
+		fixture := newResponseCacheFixture(func(config *GatewayConfiguration) { config.CacheControl.ResponseCacheEnabled = false })
+		fixture.get("/api/v1/news", nil)
+
+		// Act
+		rec := fixture.get("/api/v1/news", nil)
+
+		// Assert
+		assert.Equal(t, 2, fixture.upstream.callCount())
+		assert.Empty(t, rec.Header().Get("X-Cache"))
+		assert.Equal(t, "public, max-age=60", rec.Header().Get("Cache-Control"))
+	})
+}
+
+func TestResponseCache_Invalidate(t *testing.T) {
+	t.Run("drops the responses of the changed content area", func(t *testing.T) {
+		// Arrange
+		fixture := newResponseCacheFixture(func(config *GatewayConfiguration) {})
+		fixture.get("/api/v1/news", nil)
+		fixture.get("/api/v1/search?q=health", nil)
+		fixture.get("/api/v1/events", nil)
+		fixture.upstream.publish()
+
+		// Act
+		require.NoError(t, fixture.cache.Invalidate(context.Background(), []string{cacheTagNews}))
+		fixture.advance(5 * time.Second)
+		news := fixture.get("/api/v1/news", nil)
+		search := fixture.get("/api/v1/search?q=health", nil)
+		events := fixture.get("/api/v1/events", nil)
+
+		// Assert
+		assert.Equal(t, "MISS", news.Header().Get("X-Cache"))
+		assert.JSONEq(t, `{"path":"/api/v1/news","version":1}`, news.Body.String())
+		assert.Equal(t, "MISS", search.Header().Get("X-Cache"))
+		assert.Equal(t, "HIT", events.Header().Get("X-Cache"))
+		assert.Equal(t, int64(1), fixture.cache.Stats().Invalidations)
+	})
+
+	t.Run("does not store responses fetched just before the invalidation", func(t *testing.T) {
+		// Arrange
+		fixture := newResponseCacheFixture(func(config *GatewayConfiguration) {})
+		require.NoError(t, fixture.cache.Invalidate(context.Background(), []string{cacheTagNews}))
+		fixture.get("/api/v1/news", nil)
+
+		// Act
+		rec := fixture.get("/api/v1/news", nil)
+
+		// Assert
+		assert.Equal(t, "MISS", rec.Header().Get("X-Cache"))
+	})
+
+	t.Run("shares invalidations with the other replicas", func(t *testing.T) {
+		// Arrange
+		store := &fakeInvalidationStore{invalidations: make(map[string]time.Time)}
+		receiver := newResponseCacheFixture(func(config *GatewayConfiguration) {})
+		receiver.cache.SetInvalidationStore(store)
+		replica := newResponseCacheFixture(func(config *GatewayConfiguration) {})
+		replica.cache.SetInvalidationStore(store)
+		replica.get("/api/v1/services/service-1", nil)
+		replica.get("/api/v1/events", nil)
+
+		// Act
+		require.NoError(t, receiver.cache.Invalidate(context.Background(), []string{cacheTagServices}))
+		require.NoError(t, replica.cache.syncInvalidations(context.Background()))
+		replica.advance(5 * time.Second)
+		services := replica.get("/api/v1/services/service-1", nil)
+		events := replica.get("/api/v1/events", nil)
+
+		// Assert
+		assert.Equal(t, "MISS", services.Header().Get("X-Cache"))
+		assert.Equal(t, "HIT", events.Header().Get("X-Cache"))
+	})
+}
+
+func TestCacheTagsForEntity(t *testing.T) {
+	tests := []struct {
+		entityType   domain.EntityType
+		expectedTags []string
+	}{
+		{entityType: domain.EntityTypeNews, expectedTags: []string{cacheTagNews}},
+		{entityType: domain.EntityTypeFeaturedResearch, expectedTags: []string{cacheTagResearch}},
+		{entityType: domain.EntityTypeFeaturedCategory, expectedTags: []string{cacheTagServices}},
+		{entityType: domain.EntityTypeEventRegistration, expectedTags: []string{cacheTagEvents}},
+		{entityType: domain.EntityTypeMediaAsset, expectedTags: allCacheTags},
+		{entityType: domain.EntityTypeBusinessInquiry},
+		{entityType: domain.EntityTypeUser},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.entityType), func(t *testing.T) {
+			// Act
+			tags := cacheTagsForEntity(tt.entityType)
+
+			// Assert
+			assert.Equal(t, tt.expectedTags, tags)
+		})
+	}
+}
@@ -0,0 +1,276 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/search"
+)
+
+// searchDomainTimeout bounds each content domain search so one slow domain
+// cannot hold back the merged response
+const searchDomainTimeout = 5 * time.Second
+
+// searchDomain is one content domain the unified search fans out to
+type searchDomain struct {
+	Type     string
+	Path     string
+	ItemsKey string
+}
+
+// searchDomains lists the searchable content domains in tie-break order
+var searchDomains = []searchDomain{
+	{Type: "news", Path: "/api/v1/news/search", ItemsKey: "news"},
+	{Type: "research", Path: "/api/v1/research/search", ItemsKey: "research"},
+	{Type: "services", Path: "/api/v1/services/search", ItemsKey: "services"},
+	{Type: "events", Path: "/api/v1/events/search", ItemsKey: "events"},
+}
+
+// SearchRequest is a unified search across content domains
+type SearchRequest struct {
+	Query  string
+	Types  []string
+	Limit  int
+	Offset int
+}
+
+// SearchResult is one ranked match from any content domain
+type SearchResult struct {
+	Type       string            `json:"type"`
+	ID         string            `json:"id"`
+	Score      float64           `json:"score"`
+	Highlights map[string]string `json:"highlights,omitempty"`
+	Item       json.RawMessage   `json:"item"`
+}
+
+// SearchResponse is the merged result of a unified search
+type SearchResponse struct {
+	Results     []SearchResult `json:"results"`
+	Total       int            `json:"total"`
+	Types       map[string]int `json:"types"`
+	Limit       int            `json:"limit"`
+	Offset      int            `json:"offset"`
+	Partial     bool           `json:"partial"`
+	Unavailable []string       `json:"unavailable,omitempty"`
+}
+
+// domainSearchResponse is the part of a content domain search response the gateway merges
+type domainSearchResponse struct {
+	Hits  []search.Hit `json:"hits"`
+	Total int          `json:"total"`
+}
+
+// domainSearchOutcome is the result of searching one content domain
+type domainSearchOutcome struct {
+	domain  searchDomain
+	results []SearchResult
+	total   int
+	err     error
+}
+
+// ParseSearchRequest builds a unified search request from query parameters:
+// q holds the text, type restricts the domains (comma separated or repeated),
+// and limit and offset select the window over the merged ranking
+func ParseSearchRequest(values url.Values) (SearchRequest, error) {
+	request := SearchRequest{
+		Query: strings.TrimSpace(values.Get("q")),
+		Limit: search.DefaultLimit,
+	}
+
+	if request.Query == "" {
+		return SearchRequest{}, domain.NewValidationFieldError("q", "search query is required")
+	}
+	if len(request.Query) > search.MaxQueryLength {
+		return SearchRequest{}, domain.NewValidationFieldError("q", "search query is too long")
+	}
+
+	for _, raw := range values["type"] {
+		for _, searchType := range strings.Split(raw, ",") {
+			searchType = strings.ToLower(strings.TrimSpace(searchType))
+			if searchType == "" {
+				continue
+			}
+			if _, exists := findSearchDomain(searchType); !exists {
+				return SearchRequest{}, domain.NewValidationFieldError("type", fmt.Sprintf("unknown search type: %s", searchType))
+			}
+			request.Types = append(request.Types, searchType)
+		}
+	}
+
+	if raw := values.Get("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit < 1 {
+			return SearchRequest{}, domain.NewValidationFieldError("limit", "limit must be a positive number")
+		}
+		request.Limit = limit
+	}
+
+	if raw := values.Get("offset"); raw != "" {
+		offset, err := strconv.Atoi(raw)
+		if err != nil || offset < 0 {
+			return SearchRequest{}, domain.NewValidationFieldError("offset", "offset must be a non-negative number")
+		}
+		request.Offset = offset
+	}
+
+	if request.Offset+request.Limit > search.MaxLimit {
+		return SearchRequest{}, domain.NewValidationFieldError("offset", fmt.Sprintf("offset plus limit must not exceed %d", search.MaxLimit))
+	}
+
+	return request, nil
+}
+
+// SearchContent searches every requested content domain in parallel through
+// service invocation and merges the hits into one ranking. Domains that fail
+// are reported as unavailable; the search only fails when every domain does.
+func (p *ServiceProxy) SearchContent(ctx context.Context, request SearchRequest) (*SearchResponse, error) {
+	domains := selectSearchDomains(request.Types)
+
+	outcomes := make([]domainSearchOutcome, len(domains))
+	var wg sync.WaitGroup
+	for i, target := range domains {
+		wg.Add(1)
+		go func(i int, target searchDomain) {
+			defer wg.Done()
+			outcomes[i] = p.searchContentDomain(ctx, target, request)
+		}(i, target)
+	}
+	wg.Wait()
+
+	response := &SearchResponse{
+		Results: []SearchResult{},
+		Types:   make(map[string]int, len(domains)),
+		Limit:   request.Limit,
+		Offset:  request.Offset,
+	}
+
+	var merged []SearchResult
+	var lastErr error
+	for _, outcome := range outcomes {
+		if outcome.err != nil {
+			lastErr = outcome.err
+			response.Partial = true
+			response.Unavailable = append(response.Unavailable, outcome.domain.Type)
+			continue
+		}
+		response.Types[outcome.domain.Type] = outcome.total
+		response.Total += outcome.total
+		merged = append(merged, outcome.results...)
+	}
+
+	if len(response.Unavailable) == len(domains) {
+		return nil, domain.NewDependencyError("content search", lastErr)
+	}
+
+	typeOrder := make(map[string]int, len(searchDomains))
+	for i, target := range searchDomains {
+		typeOrder[target.Type] = i
+	}
+	sort.SliceStable(merged, func(i, j int) bool {
+		if merged[i].Score != merged[j].Score {
+			return merged[i].Score > merged[j].Score
+		}
+		if merged[i].Type != merged[j].Type {
+			return typeOrder[merged[i].Type] < typeOrder[merged[j].Type]
+		}
+		return merged[i].ID < merged[j].ID
+	})
+
+	if request.Offset < len(merged) {
+		end := request.Offset + request.Limit
+		if end > len(merged) {
+			end = len(merged)
+		}
+		response.Results = merged[request.Offset:end]
+	}
+
+	return response, nil
+}
+
+// searchContentDomain fetches the top offset+limit hits of one content domain,
+// so that any window of the merged ranking can be cut from the combined hits
+func (p *ServiceProxy) searchContentDomain(ctx context.Context, target searchDomain, request SearchRequest) domainSearchOutcome {
+	outcome := domainSearchOutcome{domain: target}
+
+	ctx, cancel := context.WithTimeout(ctx, searchDomainTimeout)
+	defer cancel()
+
+	params := url.Values{}
+	params.Set("q", request.Query)
+	params.Set("limit", strconv.Itoa(request.Offset+request.Limit))
+
+	response, err := p.serviceInvocation.InvokeContentAPI(ctx, target.Path+"?"+params.Encode(), "GET", nil)
+	if err != nil {
+		outcome.err = fmt.Errorf("%s search failed: %w", target.Type, err)
+		return outcome
+	}
+	if response.StatusCode >= 400 {
+		outcome.err = fmt.Errorf("%s search failed with status %d", target.Type, response.StatusCode)
+		return outcome
+	}
+
+	var body domainSearchResponse
+	if err := json.Unmarshal(response.Data, &body); err != nil {
+		outcome.err = fmt.Errorf("%s search returned an invalid response: %w", target.Type, err)
+		return outcome
+	}
+
+	var fields map[string]json.RawMessage
+	var items []json.RawMessage
+	if err := json.Unmarshal(response.Data, &fields); err == nil {
+		json.Unmarshal(fields[target.ItemsKey], &items)
+	}
+
+	outcome.total = body.Total
+	outcome.results = make([]SearchResult, 0, len(body.Hits))
+	for i, hit := range body.Hits {
+		result := SearchResult{
+			Type:       target.Type,
+			ID:         hit.ID,
+			Score:      hit.Score,
+			Highlights: hit.Highlights,
+		}
+		if i < len(items) {
+			result.Item = items[i]
+		}
+		outcome.results = append(outcome.results, result)
+	}
+
+	return outcome
+}
+
+// selectSearchDomains returns the domains named by types, or every domain when none are named
+func selectSearchDomains(types []string) []searchDomain {
+	if len(types) == 0 {
+		return searchDomains
+	}
+
+	selected := make([]searchDomain, 0, len(types))
+	for _, target := range searchDomains {
+		for _, searchType := range types {
+			if target.Type == searchType {
+				selected = append(selected, target)
+				break
+			}
+		}
+	}
+	return selected
+}
+
+// findSearchDomain looks up a content domain by search type
+func findSearchDomain(searchType string) (searchDomain, bool) {
+	for _, target := range searchDomains {
+		if target.Type == searchType {
+			return target, true
+		}
+	}
+	return searchDomain{}, false
+}
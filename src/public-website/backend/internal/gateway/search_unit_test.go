@@ -0,0 +1,283 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockSearchInvocation answers content domain searches by path and is safe for concurrent fan-out.
+// Searches only invoke the content API, so the rest of the interface is left unimplemented.
+type MockSearchInvocation struct {
+	ServiceInvocationInterface
+	mutex     sync.Mutex
+	bodies    map[string]string
+	failures  map[string]error
+	requested []string
+}
+
+func NewMockSearchInvocation() *MockSearchInvocation {
+	return &MockSearchInvocation{
+		bodies:   make(map[string]string),
+		failures: make(map[string]error),
+	}
+}
+
+// InvokeContentAPI returns the body configured for the requested path, ignoring the query string
+func (m *MockSearchInvocation) InvokeContentAPI(ctx context.Context, method, httpVerb string, data []byte) (*dapr.ServiceResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.requested = append(m.requested, method)
+	path := strings.SplitN(method, "?", 2)[0]
+
+	if err, exists := m.failures[path]; exists {
+		return nil, err
+	}
+	body, exists := m.bodies[path]
+	if !exists {
+		return &dapr.ServiceResponse{StatusCode: http.StatusNotFound, Data: []byte(`{}`)}, nil
+	}
+	return &dapr.ServiceResponse{StatusCode: http.StatusOK, Data: []byte(body), ContentType: "application/json"}, nil
+}
+
+func newSearchTestInvocation() *MockSearchInvocation {
+	mock := NewMockSearchInvocation()
+	mock.bodies["/api/v1/news/search"] = `{
+		"news": [{"news_id": "news-1", "title": "Clinic opens"}, {"news_id": "news-2", "title": "Clinic news"}],
+		"hits": [{"id": "news-1", "score": 4.2, "highlights": {"title": "<mark>Clinic</mark> opens"}}, {"id": "news-2", "score": 1.1}],
+		"count": 2, "total": 2
+	}`
+	mock.bodies["/api/v1/research/search"] = `{
+		"research": [{"research_id": "research-1"}],
+		"hits": [{"id": "research-1", "score": 2.5}],
+		"count": 1, "total": 1
+	}`
+	mock.bodies["/api/v1/services/search"] = `{
+		"services": [{"service_id": "service-1"}],
+		"hits": [{"id": "service-1", "score": 4.2}],
+		"count": 1, "total": 1
+	}`
+	mock.bodies["/api/v1/events/search"] = `{"events": [], "hits": [], "count": 0, "total": 0}`
+	return mock
+}
+
+func createSearchTestConfiguration() *GatewayConfiguration {
+	return &GatewayConfiguration{
+		Name:        "search-test-gateway",
+		Type:        GatewayTypePublic,
+		Environment: "test",
+		ServiceRouting: ServiceRoutingConfig{
+			ContentAPIEnabled: true,
+		},
+		Timeouts: TimeoutConfig{
+			RequestTimeout: 30 * time.Second,
+		},
+	}
+}
+
+func searchResultIDs(results []SearchResult) []string {
+	ids := make([]string, len(results))
+	for i, result := range results {
+		ids[i] = result.Type + ":" + result.ID
+	}
+	return ids
+}
+
+func TestParseSearchRequest(t *testing.T) {
+	tests := []struct {
+		name          string
+		query         string
+		expected      SearchRequest
+		expectedError string
+	}{
+		{
+			name:     "defaults",
+			query:    "q=clinic",
+			expected: SearchRequest{Query: "clinic", Limit: 20},
+		},
+		{
+			name:     "comma separated and repeated types",
+			query:    "q=clinic&type=news,Research&type=events&limit=5&offset=10",
+			expected: SearchRequest{Query: "clinic", Types: []string{"news", "research", "events"}, Limit: 5, Offset: 10},
+		},
+		{
+			name:          "missing query",
+			query:         "type=news",
+			expectedError: "search query is required",
+		},
+		{
+			name:          "unknown type",
+			query:         "q=clinic&type=inquiries",
+			expectedError: "unknown search type: inquiries",
+		},
+		{
+			name:          "invalid limit",
+			query:         "q=clinic&limit=0",
+			expectedError: "limit must be a positive number",
+		},
+		{
+			name:          "window beyond ranking depth",
+			query:         "q=clinic&limit=50&offset=60",
+			expectedError: "offset plus limit must not exceed 100",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := url.ParseQuery(tt.query)
+			require.NoError(t, err)
+
+			request, err := ParseSearchRequest(values)
+
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.True(t, domain.IsValidationError(err))
+				assert.Contains(t, err.Error(), tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, request)
+		})
+	}
+}
+
+func TestServiceProxy_SearchContent(t *testing.T) {
+	tests := []struct {
+		name            string
+		request         SearchRequest
+		setupMock       func(*MockSearchInvocation)
+		expectedIDs     []string
+		expectedTotal   int
+		expectedPartial []string
+		expectedError   bool
+	}{
+		{
+			name:          "merge and rank across domains",
+			request:       SearchRequest{Query: "clinic", Limit: 20},
+			expectedIDs:   []string{"news:news-1", "services:service-1", "research:research-1", "news:news-2"},
+			expectedTotal: 4,
+		},
+		{
+			name:          "restrict to requested types",
+			request:       SearchRequest{Query: "clinic", Types: []string{"research", "services"}, Limit: 20},
+			expectedIDs:   []string{"services:service-1", "research:research-1"},
+			expectedTotal: 2,
+		},
+		{
+			name:          "window the merged ranking",
+			request:       SearchRequest{Query: "clinic", Limit: 2, Offset: 1},
+			expectedIDs:   []string{"services:service-1", "research:research-1"},
+			expectedTotal: 4,
+		},
+		{
+			name:    "degrade when one domain fails",
+			request: SearchRequest{Query: "clinic", Limit: 20},
+			setupMock: func(mock *MockSearchInvocation) {
+				mock.failures["/api/v1/news/search"] = errors.New("connection refused")
+			},
+			expectedIDs:     []string{"services:service-1", "research:research-1"},
+			expectedTotal:   2,
+			expectedPartial: []string{"news"},
+		},
+		{
+			name:    "degrade when one domain responds with an error status",
+			request: SearchRequest{Query: "clinic", Limit: 20},
+			setupMock: func(mock *MockSearchInvocation) {
+				delete(mock.bodies, "/api/v1/research/search")
+			},
+			expectedIDs:     []string{"news:news-1", "services:service-1", "news:news-2"},
+			expectedTotal:   3,
+			expectedPartial: []string{"research"},
+		},
+		{
+			name:    "fail when every domain fails",
+			request: SearchRequest{Query: "clinic", Types: []string{"events"}, Limit: 20},
+			setupMock: func(mock *MockSearchInvocation) {
+				mock.failures["/api/v1/events/search"] = errors.New("connection refused")
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mock := newSearchTestInvocation()
+			if tt.setupMock != nil {
+				tt.setupMock(mock)
+			}
+			proxy := NewServiceProxyWithInvocation(mock, createSearchTestConfiguration())
+
+			// Act
+			response, err := proxy.SearchContent(context.Background(), tt.request)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.True(t, domain.IsDependencyError(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedIDs, searchResultIDs(response.Results))
+			assert.Equal(t, tt.expectedTotal, response.Total)
+			assert.Equal(t, len(tt.expectedPartial) > 0, response.Partial)
+			assert.Equal(t, tt.expectedPartial, response.Unavailable)
+		})
+	}
+}
+
+func TestServiceProxy_SearchContent_FetchesRankingWindowFromEachDomain(t *testing.T) {
+	mock := newSearchTestInvocation()
+	proxy := NewServiceProxyWithInvocation(mock, createSearchTestConfiguration())
+
+	response, err := proxy.SearchContent(context.Background(), SearchRequest{Query: "free clinic", Types: []string{"news"}, Limit: 5, Offset: 10})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/api/v1/news/search?limit=15&q=free+clinic"}, mock.requested)
+	assert.Empty(t, response.Results)
+	assert.Equal(t, map[string]int{"news": 2}, response.Types)
+}
+
+func TestGatewayHandler_SearchContent(t *testing.T) {
+	mock := newSearchTestInvocation()
+	mock.failures["/api/v1/events/search"] = errors.New("connection refused")
+	config := createSearchTestConfiguration()
+	config.CacheControl = CacheControlConfig{Enabled: true, MaxAge: 300}
+	handler := &GatewayHandler{config: config, serviceProxy: NewServiceProxyWithInvocation(mock, config)}
+
+	t.Run("return partial results uncached", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		handler.SearchContent(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/search?q=clinic&limit=1", nil))
+
+		require.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "no-cache", recorder.Header().Get("Cache-Control"))
+
+		var body SearchResponse
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+		require.Len(t, body.Results, 1)
+		assert.Equal(t, "news", body.Results[0].Type)
+		assert.Equal(t, "<mark>Clinic</mark> opens", body.Results[0].Highlights["title"])
+		assert.JSONEq(t, `{"news_id": "news-1", "title": "Clinic opens"}`, string(body.Results[0].Item))
+		assert.Equal(t, []string{"events"}, body.Unavailable)
+	})
+
+	t.Run("reject missing query", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		handler.SearchContent(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/search", nil))
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"mime"
 	"net/http"
 	"strings"
 	"time"
@@ -33,6 +35,12 @@ type ServiceInvocationInterface interface {
 	GetNotificationAPIMetrics(ctx context.Context) (map[string]interface{}, error)
 }
 
+// contentForwarder is implemented by service invocations that forward request headers to the
+// content API and report its status code and response headers
+type contentForwarder interface {
+	ForwardContentAPI(ctx context.Context, method, httpVerb string, data []byte, headers map[string]string) (*dapr.ServiceResponse, error)
+}
+
 // ServiceProxy handles proxying requests to backend services via Dapr service invocation
 type ServiceProxy struct {
 	serviceInvocation ServiceInvocationInterface
@@ -69,11 +77,19 @@ func (p *ServiceProxy) ProxyRequest(ctx context.Context, w http.ResponseWriter,
 		correlationCtx.CorrelationID = uuid.New().String()
 	}
 
-	// Prepare request data
+	// Prepare request data. JSON bodies are checked here; the content service also takes
+	// other bodies, such as multipart media uploads, which pass through untouched along
+	// with their Content-Type
 	var requestData interface{}
 	if httpMethod != "GET" && r.ContentLength > 0 {
 		defer r.Body.Close()
-		if err := json.NewDecoder(r.Body).Decode(&requestData); err != nil {
+		if serviceName == "content" && !isJSONContentType(r.Header.Get("Content-Type")) {
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				return domain.NewValidationError("failed to read request body")
+			}
+			requestData = body
+		} else if err := json.NewDecoder(r.Body).Decode(&requestData); err != nil {
 			return domain.NewValidationError("invalid request body")
 		}
 	}
@@ -116,7 +132,7 @@ func (p *ServiceProxy) ProxyRequest(ctx context.Context, w http.ResponseWriter,
 	var response *ProxyResponse
 	switch serviceName {
 	case "content-api", "content":
-		response, err = p.invokeContentAPI(requestCtx, httpMethod, targetPath, requestData, headers)
+		response, err = p.invokeContentAPI(requestCtx, httpMethod, targetPath, r.URL.RawQuery, requestData, headers)
 	case "inquiries-api", "inquiries":
 		response, err = p.invokeInquiriesAPI(requestCtx, httpMethod, targetPath, requestData, headers)
 	case "notification-api", "notifications":
@@ -141,20 +157,33 @@ func (p *ServiceProxy) parseTargetService(path, targetService, httpMethod string
 	// Parse path components
 	parts := strings.Split(path, "/")
 	
-	// Standardized routing: only support /api/v1/* pattern
-	if len(parts) < 3 || parts[0] != "api" || parts[1] != "v1" {
+	// Admin gateways address the admin routes of the backend, which keep their /admin prefix
+	admin := p.configuration.IsAdmin() && len(parts) > 0 && parts[0] == "admin"
+	apiParts := parts
+	if admin {
+		apiParts = parts[1:]
+	}
+	
+	// Standardized routing: only support /api/v1/* pattern (and /admin/api/v1/* on admin gateways)
+	if len(apiParts) < 3 || apiParts[0] != "api" || apiParts[1] != "v1" {
 		return "", "", "", fmt.Errorf("invalid API path format - only /api/v1/* paths supported")
 	}
 
 	// Extract service from standardized path: /api/v1/service
-	service := parts[2] // e.g., "content", "services", "news", etc.
+	service := apiParts[2] // e.g., "content", "services", "news", etc.
 	
 	// Determine service name based on domain consolidation
 	var serviceName string
 	switch service {
-	case "content", "services", "news", "research", "events":
+	case "content", "services", "news", "research", "events", "media":
 		// All content domains consolidated into content service
 		serviceName = "content"
+	case "sagas", "event-history", "dead-letters", "feature-flags":
+		// Operational endpoints of the content service are only reachable through the admin gateway
+		if !admin {
+			return "", "", "", fmt.Errorf("unknown service: %s", service)
+		}
+		serviceName = "content"
 	case "inquiries":
 		serviceName = "inquiries"
 	case "notifications", "subscribers":
@@ -171,27 +200,53 @@ func (p *ServiceProxy) parseTargetService(path, targetService, httpMethod string
 }
 
 // invokeContentAPI invokes content API service (handles content, services, research, events, and news domains)
-func (p *ServiceProxy) invokeContentAPI(ctx context.Context, method, path string, data interface{}, headers map[string]string) (*ProxyResponse, error) {
-	// Standardized routing: only support /api/v1/* paths for content domains
-	if !strings.HasPrefix(path, "/api/v1/") {
+func (p *ServiceProxy) invokeContentAPI(ctx context.Context, method, path, query string, data interface{}, headers map[string]string) (*ProxyResponse, error) {
+	// Standardized routing: only support /api/v1/* and /admin/api/v1/* paths for content domains
+	if !strings.HasPrefix(path, "/api/v1/") && !strings.HasPrefix(path, "/admin/api/v1/") {
 		return nil, domain.NewNotFoundError("content API endpoint", path)
 	}
 
 	// Convert data to []byte if needed
 	var requestData []byte
 	if data != nil {
-		var err error
-		requestData, err = json.Marshal(data)
-		if err != nil {
-			return nil, domain.NewValidationError("failed to marshal request data")
+		// Raw bodies are forwarded as they are (avoid double marshaling)
+		if bytes, ok := data.([]byte); ok {
+			requestData = bytes
+		} else {
+			var err error
+			requestData, err = json.Marshal(data)
+			if err != nil {
+				return nil, domain.NewValidationError("failed to marshal request data")
+			}
 		}
 	}
 	
-	response, err := p.serviceInvocation.InvokeContentAPI(ctx, path, method, requestData)
+	// Conditional requests need the headers to reach the content API and its ETag to come back,
+	// and signed media links need their query string
+	var response *dapr.ServiceResponse
+	var err error
+	if forwarder, ok := p.serviceInvocation.(contentForwarder); ok {
+		target := path
+		if query != "" {
+			target += "?" + query
+		}
+		response, err = forwarder.ForwardContentAPI(ctx, target, method, requestData, headers)
+	} else {
+		response, err = p.serviceInvocation.InvokeContentAPI(ctx, path, method, requestData)
+	}
 	if err != nil {
 		return nil, err
 	}
 	
+	// Media downloads and other non-JSON responses go back to the client byte for byte
+	if len(response.Data) > 0 && !isJSONContentType(response.ContentType) {
+		return &ProxyResponse{
+			Data:       response.Data,
+			StatusCode: response.StatusCode,
+			Headers:    response.Headers,
+		}, nil
+	}
+	
 	// Parse response data back to interface{}
 	var result interface{}
 	if len(response.Data) > 0 {
@@ -301,6 +356,8 @@ func (p *ServiceProxy) extractForwardableHeaders(r *http.Request) map[string]str
 		"Accept",
 		"Accept-Language",
 		"Content-Type",
+		"If-Match",
+		"If-None-Match",
 	}
 	
 	for _, headerName := range forwardableHeaders {
@@ -314,8 +371,16 @@ func (p *ServiceProxy) extractForwardableHeaders(r *http.Request) map[string]str
 
 // writeProxyResponse writes the proxied response back to the client
 func (p *ServiceProxy) writeProxyResponse(w http.ResponseWriter, response *ProxyResponse, correlationCtx *domain.CorrelationContext) error {
-	// Set response headers
-	w.Header().Set("Content-Type", "application/json")
+	// Set response headers; raw responses keep the type and disposition the backend gave them
+	raw, isRaw := response.Data.([]byte)
+	if isRaw {
+		w.Header().Set("Content-Type", proxyResponseHeader(response.Headers, "Content-Type"))
+		if disposition := proxyResponseHeader(response.Headers, "Content-Disposition"); disposition != "" {
+			w.Header().Set("Content-Disposition", disposition)
+		}
+	} else {
+		w.Header().Set("Content-Type", "application/json")
+	}
 	w.Header().Set("X-Correlation-ID", correlationCtx.CorrelationID)
 	
 	// Add security headers
@@ -323,19 +388,60 @@ func (p *ServiceProxy) writeProxyResponse(w http.ResponseWriter, response *Proxy
 	w.Header().Set("X-Frame-Options", "DENY")
 	w.Header().Set("X-XSS-Protection", "1; mode=block")
 	
-	// Set cache control based on gateway configuration
-	if p.configuration.CacheControl.Enabled {
-		w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", p.configuration.CacheControl.MaxAge))
+	// Set cache control based on gateway configuration, unless a raw response (such as a
+	// signed media download) already says how long it may be kept
+	if backendCacheControl := proxyResponseHeader(response.Headers, "Cache-Control"); isRaw && backendCacheControl != "" {
+		w.Header().Set("Cache-Control", backendCacheControl)
+	} else if cacheControl := p.configuration.CacheControlSettings(); cacheControl.Enabled {
+		w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", cacheControl.MaxAge))
 	} else {
 		w.Header().Set("Cache-Control", "no-cache")
 	}
 	
+	// Hand the entity version back so clients can send it as If-Match on their next update
+	if etag := proxyResponseHeader(response.Headers, "ETag"); etag != "" {
+		w.Header().Set("ETag", etag)
+	}
+	
 	w.WriteHeader(response.StatusCode)
 	
+	// A not modified response carries no body
+	if response.StatusCode == http.StatusNotModified || response.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	
+	if isRaw {
+		_, err := w.Write(raw)
+		return err
+	}
+	
 	// Encode response data as JSON
 	return json.NewEncoder(w).Encode(response.Data)
 }
 
+// isJSONContentType reports whether a body of the given media type is JSON. A missing
+// type is taken as JSON, which is what clients of the gateway send by default.
+func isJSONContentType(contentType string) bool {
+	if contentType == "" {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// proxyResponseHeader looks up a backend response header regardless of how its name was cased
+func proxyResponseHeader(headers map[string]string, name string) string {
+	for key, value := range headers {
+		if strings.EqualFold(key, name) {
+			return value
+		}
+	}
+	return ""
+}
+
 // HealthCheck performs health check for the service proxy
 func (p *ServiceProxy) HealthCheck(ctx context.Context) error {
 	// Check content API health
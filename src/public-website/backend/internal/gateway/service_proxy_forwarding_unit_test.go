@@ -0,0 +1,298 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockContentForwarder records the forwarded content API request and answers with a
+// canned response. Only forwarding is exercised, so the rest of the interface is left
+// unimplemented.
+type mockContentForwarder struct {
+	ServiceInvocationInterface
+	response *dapr.ServiceResponse
+	method   string
+	httpVerb string
+	data     []byte
+	headers  map[string]string
+}
+
+func (m *mockContentForwarder) ForwardContentAPI(ctx context.Context, method, httpVerb string, data []byte, headers map[string]string) (*dapr.ServiceResponse, error) {
+	m.method = method
+	m.httpVerb = httpVerb
+	m.data = data
+	m.headers = headers
+	return m.response, nil
+}
+
+func createForwardingTestConfiguration() *GatewayConfiguration {
+	return &GatewayConfiguration{
+		Name:        "forwarding-test-gateway",
+		Type:        GatewayTypeAdmin,
+		Environment: "test",
+		Version:     "1.0.0",
+		ServiceRouting: ServiceRoutingConfig{
+			ContentAPIEnabled: true,
+		},
+	}
+}
+
+func TestServiceProxy_ProxyRequest_ConditionalHeaders(t *testing.T) {
+	tests := []struct {
+		name            string
+		method          string
+		body            string
+		requestHeaders  map[string]string
+		response        *dapr.ServiceResponse
+		expectedHeaders map[string]string
+		expectedStatus  int
+		expectedETag    string
+		expectedBody    string
+	}{
+		{
+			name:           "update forwards if-match and returns the new etag",
+			method:         http.MethodPut,
+			body:           `{"title":"Updated"}`,
+			requestHeaders: map[string]string{"If-Match": `"7"`, "Content-Type": "application/json"},
+			response: &dapr.ServiceResponse{
+				StatusCode: http.StatusOK,
+				Data:       []byte(`{"event_id":"event-1"}`),
+				Headers:    map[string]string{"Etag": `"8"`},
+			},
+			expectedHeaders: map[string]string{"If-Match": `"7"`},
+			expectedStatus:  http.StatusOK,
+			expectedETag:    `"8"`,
+			expectedBody:    `{"event_id":"event-1"}`,
+		},
+		{
+			name:           "stale if-match is rejected by the content api",
+			method:         http.MethodPut,
+			body:           `{"title":"Updated"}`,
+			requestHeaders: map[string]string{"If-Match": `"6"`, "Content-Type": "application/json"},
+			response: &dapr.ServiceResponse{
+				StatusCode: http.StatusPreconditionFailed,
+				Data:       []byte(`{"error":{"code":"CONFLICT"}}`),
+				Headers:    map[string]string{"Etag": `"8"`},
+			},
+			expectedHeaders: map[string]string{"If-Match": `"6"`},
+			expectedStatus:  http.StatusPreconditionFailed,
+			expectedETag:    `"8"`,
+			expectedBody:    `{"error":{"code":"CONFLICT"}}`,
+		},
+		{
+			name:           "read forwards if-none-match and relays not modified",
+			method:         http.MethodGet,
+			requestHeaders: map[string]string{"If-None-Match": `"8"`},
+			response: &dapr.ServiceResponse{
+				StatusCode: http.StatusNotModified,
+				Headers:    map[string]string{"ETag": `"8"`},
+			},
+			expectedHeaders: map[string]string{"If-None-Match": `"8"`},
+			expectedStatus:  http.StatusNotModified,
+			expectedETag:    `"8"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			forwarder := &mockContentForwarder{response: tt.response}
+			proxy := NewServiceProxyWithInvocation(forwarder, createForwardingTestConfiguration())
+
+			request := httptest.NewRequest(tt.method, "/api/v1/events/event-1", strings.NewReader(tt.body))
+			for name, value := range tt.requestHeaders {
+				request.Header.Set(name, value)
+			}
+			recorder := httptest.NewRecorder()
+
+			// Act
+			err := proxy.ProxyRequest(context.Background(), recorder, request, "content")
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.method, forwarder.httpVerb)
+			assert.Equal(t, "/api/v1/events/event-1", forwarder.method)
+			for name, value := range tt.expectedHeaders {
+				assert.Equal(t, value, forwarder.headers[name])
+			}
+
+			assert.Equal(t, tt.expectedStatus, recorder.Code)
+			assert.Equal(t, tt.expectedETag, recorder.Header().Get("ETag"))
+			if tt.expectedBody == "" {
+				assert.Empty(t, recorder.Body.String())
+			} else {
+				assert.JSONEq(t, tt.expectedBody, recorder.Body.String())
+			}
+		})
+	}
+}
+
+func TestServiceProxy_ProxyRequest_AdminPaths(t *testing.T) {
+	tests := []struct {
+		name           string
+		gatewayType    GatewayType
+		method         string
+		path           string
+		expectedError  bool
+		expectedMethod string
+	}{
+		{
+			name:           "admin saga retry keeps its admin path",
+			gatewayType:    GatewayTypeAdmin,
+			method:         http.MethodPost,
+			path:           "/admin/api/v1/sagas/saga-1/retry",
+			expectedMethod: "/admin/api/v1/sagas/saga-1/retry",
+		},
+		{
+			name:           "admin content listing keeps its admin path",
+			gatewayType:    GatewayTypeAdmin,
+			method:         http.MethodGet,
+			path:           "/admin/api/v1/news",
+			expectedMethod: "/admin/api/v1/news",
+		},
+		{
+			name:           "public media download",
+			gatewayType:    GatewayTypePublic,
+			method:         http.MethodGet,
+			path:           "/api/v1/media/media-1/download",
+			expectedMethod: "/api/v1/media/media-1/download",
+		},
+		{
+			name:          "public gateway cannot reach sagas",
+			gatewayType:   GatewayTypePublic,
+			method:        http.MethodGet,
+			path:          "/api/v1/sagas",
+			expectedError: true,
+		},
+		{
+			name:          "public gateway does not accept admin paths",
+			gatewayType:   GatewayTypePublic,
+			method:        http.MethodGet,
+			path:          "/admin/api/v1/news",
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			forwarder := &mockContentForwarder{response: &dapr.ServiceResponse{StatusCode: http.StatusOK, Data: []byte(`{}`)}}
+			config := createForwardingTestConfiguration()
+			config.Type = tt.gatewayType
+			proxy := NewServiceProxyWithInvocation(forwarder, config)
+			recorder := httptest.NewRecorder()
+
+			// Act
+			err := proxy.ProxyRequest(context.Background(), recorder, httptest.NewRequest(tt.method, tt.path, nil), "content")
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Empty(t, forwarder.method)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedMethod, forwarder.method)
+			assert.Equal(t, tt.method, forwarder.httpVerb)
+		})
+	}
+}
+
+func TestServiceProxy_ProxyRequest_RawBodies(t *testing.T) {
+	tests := []struct {
+		name                string
+		gatewayType         GatewayType
+		method              string
+		path                string
+		contentType         string
+		body                string
+		response            *dapr.ServiceResponse
+		expectedMethod      string
+		expectedContentType string
+		expectedBody        string
+		expectedHeaders     map[string]string
+	}{
+		{
+			name:        "multipart upload is forwarded untouched",
+			gatewayType: GatewayTypeAdmin,
+			method:      http.MethodPost,
+			path:        "/admin/api/v1/media",
+			contentType: "multipart/form-data; boundary=upload",
+			body:        "--upload\r\nContent-Disposition: form-data; name=\"file\"; filename=\"a.png\"\r\n\r\nPNG\r\n--upload--\r\n",
+			response: &dapr.ServiceResponse{
+				StatusCode:  http.StatusCreated,
+				Data:        []byte(`{"media_id":"media-1"}`),
+				ContentType: "application/json",
+			},
+			expectedMethod:      "/admin/api/v1/media",
+			expectedContentType: "multipart/form-data; boundary=upload",
+			expectedBody:        `{"media_id":"media-1"}`,
+			expectedHeaders:     map[string]string{"Content-Type": "application/json"},
+		},
+		{
+			name:        "signed download keeps its query and returns the file",
+			gatewayType: GatewayTypePublic,
+			method:      http.MethodGet,
+			path:        "/api/v1/media/media-1/download?expires=1900000000&signature=abc",
+			response: &dapr.ServiceResponse{
+				StatusCode:  http.StatusOK,
+				Data:        []byte("\x89PNG\r\n"),
+				ContentType: "image/png",
+				Headers: map[string]string{
+					"Content-Type":        "image/png",
+					"Content-Disposition": `inline; filename="a.png"`,
+					"Cache-Control":       "private, max-age=600",
+				},
+			},
+			expectedMethod: "/api/v1/media/media-1/download?expires=1900000000&signature=abc",
+			expectedBody:   "\x89PNG\r\n",
+			expectedHeaders: map[string]string{
+				"Content-Type":        "image/png",
+				"Content-Disposition": `inline; filename="a.png"`,
+				"Cache-Control":       "private, max-age=600",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			forwarder := &mockContentForwarder{response: tt.response}
+			config := createForwardingTestConfiguration()
+			config.Type = tt.gatewayType
+			proxy := NewServiceProxyWithInvocation(forwarder, config)
+
+			request := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			if tt.contentType != "" {
+				request.Header.Set("Content-Type", tt.contentType)
+			}
+			recorder := httptest.NewRecorder()
+
+			// Act
+			err := proxy.ProxyRequest(context.Background(), recorder, request, "content")
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedMethod, forwarder.method)
+			assert.Equal(t, tt.body, string(forwarder.data))
+			assert.Equal(t, tt.expectedContentType, forwarder.headers["Content-Type"])
+
+			assert.Equal(t, tt.response.StatusCode, recorder.Code)
+			for name, value := range tt.expectedHeaders {
+				assert.Equal(t, value, recorder.Header().Get(name))
+			}
+			if tt.response.ContentType == "application/json" {
+				assert.JSONEq(t, tt.expectedBody, recorder.Body.String())
+			} else {
+				assert.Equal(t, tt.expectedBody, recorder.Body.String())
+			}
+		})
+	}
+}
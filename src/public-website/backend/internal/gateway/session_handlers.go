@@ -0,0 +1,295 @@
+package gateway
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/contracts/admin"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/auth"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+// RegisterSessionRoutes registers sign-in and session management routes
+func (h *AccessControlHandler) RegisterSessionRoutes(router *mux.Router) {
+	apiRouter := router.PathPrefix("/admin/api/v1").Subrouter()
+
+	apiRouter.HandleFunc("/auth/login", h.AdminLogin).Methods("POST")
+	apiRouter.HandleFunc("/auth/refresh", h.RefreshToken).Methods("POST")
+	apiRouter.HandleFunc("/auth/logout", h.AdminLogout).Methods("POST")
+	apiRouter.HandleFunc("/auth/sessions", h.GetCurrentUserSessions).Methods("GET")
+	apiRouter.HandleFunc("/auth/sessions/{session_id}", h.RevokeCurrentUserSession).Methods("DELETE")
+	apiRouter.HandleFunc("/users/{id}/sessions", h.GetAdminUserSessions).Methods("GET")
+	apiRouter.HandleFunc("/users/{id}/sessions", h.RevokeAdminUserSessions).Methods("DELETE")
+	apiRouter.HandleFunc("/users/{id}/sessions/{session_id}", h.RevokeAdminUserSession).Methods("DELETE")
+}
+
+// AdminLogin handles POST /admin/api/v1/auth/login
+func (h *AccessControlHandler) AdminLogin(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var body admin.AdminLoginJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.writeErrorResponse(w, r, http.StatusBadRequest, "INVALID_JSON", "invalid JSON format", err)
+		return
+	}
+
+	user, err := h.accessService.AuthenticatePassword(ctx, string(body.Email), body.Password)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	rememberMe := body.RememberMe != nil && *body.RememberMe
+	tokens, err := h.sessions.Start(ctx, user, rememberMe, auth.SessionClient{
+		IPAddress: clientIPAddress(r),
+		UserAgent: r.UserAgent(),
+	})
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	h.writeJSONResponse(w, r, http.StatusOK, map[string]interface{}{
+		"access_token":  tokens.AccessToken,
+		"refresh_token": tokens.RefreshToken,
+		"expires_in":    tokens.ExpiresIn,
+		"token_type":    "Bearer",
+		"user":          toContractAdminUser(user),
+	})
+}
+
+// RefreshToken handles POST /admin/api/v1/auth/refresh
+func (h *AccessControlHandler) RefreshToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var body admin.RefreshTokenJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.writeErrorResponse(w, r, http.StatusBadRequest, "INVALID_JSON", "invalid JSON format", err)
+		return
+	}
+
+	tokens, err := h.sessions.Refresh(ctx, body.RefreshToken)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	// Refreshing checks the account again, so a user who lost access cannot keep a
+	// session alive
+	if _, err := h.accessService.ResolvePrincipal(ctx, tokens.Session.Email); err != nil {
+		if revokeErr := h.sessions.Revoke(ctx, tokens.Session.SessionID, auth.RevokedOnAccountChange); revokeErr != nil {
+			h.handleServiceError(w, r, revokeErr)
+			return
+		}
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	h.writeJSONResponse(w, r, http.StatusOK, map[string]interface{}{
+		"access_token":  tokens.AccessToken,
+		"refresh_token": tokens.RefreshToken,
+		"expires_in":    tokens.ExpiresIn,
+		"token_type":    "Bearer",
+	})
+}
+
+// AdminLogout handles POST /admin/api/v1/auth/logout. Callers authenticated with a
+// social provider ID token have no session to end.
+func (h *AccessControlHandler) AdminLogout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if session, ok := auth.SessionFromContext(ctx); ok {
+		if err := h.sessions.Revoke(ctx, session.SessionID, auth.RevokedByLogout); err != nil {
+			h.handleServiceError(w, r, err)
+			return
+		}
+	}
+
+	h.writeJSONResponse(w, r, http.StatusOK, map[string]interface{}{
+		"success":        true,
+		"message":        "Signed out successfully",
+		"correlation_id": domain.GetCorrelationID(ctx),
+		"timestamp":      time.Now().UTC(),
+	})
+}
+
+// GetCurrentUserSessions handles GET /admin/api/v1/auth/sessions
+func (h *AccessControlHandler) GetCurrentUserSessions(w http.ResponseWriter, r *http.Request) {
+	principal, ok := auth.PrincipalFromContext(r.Context())
+	if !ok {
+		h.handleServiceError(w, r, domain.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	h.writeUserSessions(w, r, principal.UserID)
+}
+
+// RevokeCurrentUserSession handles DELETE /admin/api/v1/auth/sessions/{session_id}
+func (h *AccessControlHandler) RevokeCurrentUserSession(w http.ResponseWriter, r *http.Request) {
+	principal, ok := auth.PrincipalFromContext(r.Context())
+	if !ok {
+		h.handleServiceError(w, r, domain.NewUnauthorizedError("authentication required"))
+		return
+	}
+
+	h.revokeUserSession(w, r, principal.UserID, mux.Vars(r)["session_id"], auth.RevokedByLogout)
+}
+
+// GetAdminUserSessions handles GET /admin/api/v1/users/{id}/sessions
+func (h *AccessControlHandler) GetAdminUserSessions(w http.ResponseWriter, r *http.Request) {
+	user, err := h.accessService.GetUser(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	h.writeUserSessions(w, r, user.UserID)
+}
+
+// RevokeAdminUserSessions handles DELETE /admin/api/v1/users/{id}/sessions
+func (h *AccessControlHandler) RevokeAdminUserSessions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	user, err := h.accessService.GetUser(ctx, mux.Vars(r)["id"])
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	revoked, err := h.sessions.RevokeAll(ctx, user.UserID, auth.RevokedByAdmin)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	h.writeJSONResponse(w, r, http.StatusOK, map[string]interface{}{
+		"success":        true,
+		"message":        "Signed out of " + pluralSessions(revoked),
+		"correlation_id": domain.GetCorrelationID(ctx),
+		"timestamp":      time.Now().UTC(),
+	})
+}
+
+// RevokeAdminUserSession handles DELETE /admin/api/v1/users/{id}/sessions/{session_id}
+func (h *AccessControlHandler) RevokeAdminUserSession(w http.ResponseWriter, r *http.Request) {
+	user, err := h.accessService.GetUser(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	h.revokeUserSession(w, r, user.UserID, mux.Vars(r)["session_id"], auth.RevokedByAdmin)
+}
+
+// writeUserSessions writes the active sessions of a user, marking the caller's own
+func (h *AccessControlHandler) writeUserSessions(w http.ResponseWriter, r *http.Request, userID string) {
+	sessions, err := h.sessions.ActiveSessions(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	currentID := ""
+	if current, ok := auth.SessionFromContext(r.Context()); ok {
+		currentID = current.SessionID
+	}
+
+	data := make([]admin.AdminSession, 0, len(sessions))
+	for _, session := range sessions {
+		data = append(data, toContractAdminSession(session, session.SessionID == currentID))
+	}
+
+	h.writeJSONResponse(w, r, http.StatusOK, map[string]interface{}{"data": data})
+}
+
+// revokeUserSession ends one session, answering not found for sessions of other users
+// so session IDs cannot be probed
+func (h *AccessControlHandler) revokeUserSession(w http.ResponseWriter, r *http.Request, userID string, sessionID string, reason string) {
+	ctx := r.Context()
+
+	if _, err := uuid.Parse(sessionID); err != nil {
+		h.handleServiceError(w, r, domain.NewValidationError("invalid session ID format"))
+		return
+	}
+
+	owned := false
+	sessions, err := h.sessions.ActiveSessions(ctx, userID)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+	for _, session := range sessions {
+		owned = owned || session.SessionID == sessionID
+	}
+	if !owned {
+		h.writeErrorResponse(w, r, http.StatusNotFound, "SESSION_NOT_FOUND", "session not found", nil)
+		return
+	}
+
+	if err := h.sessions.Revoke(ctx, sessionID, reason); err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	h.writeJSONResponse(w, r, http.StatusOK, map[string]interface{}{
+		"success":        true,
+		"message":        "Session revoked successfully",
+		"correlation_id": domain.GetCorrelationID(ctx),
+		"timestamp":      time.Now().UTC(),
+	})
+}
+
+// toContractAdminSession converts a session to the admin API contract model
+func toContractAdminSession(session *auth.Session, current bool) admin.AdminSession {
+	contractSession := admin.AdminSession{
+		CreatedOn:     session.CreatedAt,
+		Current:       current,
+		ExpiresAt:     session.ExpiresAt,
+		IdleExpiresAt: session.IdleExpiresAt,
+		LastSeenAt:    session.LastSeenAt,
+		RememberMe:    session.RememberMe,
+	}
+
+	if sessionID, err := uuid.Parse(session.SessionID); err == nil {
+		contractSession.SessionId = sessionID
+	}
+	if userID, err := uuid.Parse(session.UserID); err == nil {
+		contractSession.UserId = userID
+	}
+	if session.IPAddress != "" {
+		contractSession.IpAddress = &session.IPAddress
+	}
+	if session.UserAgent != "" {
+		contractSession.UserAgent = &session.UserAgent
+	}
+
+	return contractSession
+}
+
+func pluralSessions(count int) string {
+	if count == 1 {
+		return "1 session"
+	}
+	return strconv.Itoa(count) + " sessions"
+}
+
+// clientIPAddress returns the address a session was started from, preferring the
+// client address reported by the ingress
+func clientIPAddress(r *http.Request) string {
+	if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+		return strings.TrimSpace(strings.Split(forwarded, ",")[0])
+	}
+	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
+		return realIP
+	}
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+	return r.RemoteAddr
+}
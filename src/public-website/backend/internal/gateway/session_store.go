@@ -0,0 +1,140 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/auth"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+)
+
+const (
+	sessionDomain     = "gateway"
+	sessionEntityType = "session"
+	sessionIndexUser  = "user"
+)
+
+// sessionRetention is how long ended sessions stay in the state store, so that a
+// reused refresh token still finds its revoked session rather than nothing
+const sessionRetention = 7 * 24 * time.Hour
+
+// SessionIndexes declares the secondary indexes maintained for admin sessions
+func SessionIndexes() *dapr.IndexedEntityType {
+	return &dapr.IndexedEntityType{
+		Domain:     sessionDomain,
+		EntityType: sessionEntityType,
+		Indexes: []dapr.IndexDefinition{
+			{Name: sessionIndexUser, Extract: func(entity interface{}) []string {
+				return []string{entity.(*auth.Session).UserID}
+			}},
+		},
+		NewEntity: func() interface{} { return &auth.Session{} },
+		EntityID:  func(entity interface{}) string { return entity.(*auth.Session).SessionID },
+	}
+}
+
+// StateSessionStore implements auth.SessionStore using the Dapr state store
+type StateSessionStore struct {
+	stateStore *dapr.StateStore
+	now        func() time.Time
+}
+
+// NewStateSessionStore creates a session store and registers its indexes
+func NewStateSessionStore(stateStore *dapr.StateStore) *StateSessionStore {
+	stateStore.MustRegisterIndexes(SessionIndexes())
+
+	return &StateSessionStore{
+		stateStore: stateStore,
+		now:        time.Now,
+	}
+}
+
+// CreateSession saves a new session
+func (s *StateSessionStore) CreateSession(ctx context.Context, session *auth.Session) error {
+	return s.save(ctx, session, "")
+}
+
+// GetSession retrieves a session by ID with its current ETag
+func (s *StateSessionStore) GetSession(ctx context.Context, sessionID string) (*auth.Session, error) {
+	key := s.stateStore.CreateKey(sessionDomain, sessionEntityType, sessionID)
+
+	var session auth.Session
+	found, etag, err := s.stateStore.GetWithETag(ctx, key, &session)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session %s: %w", sessionID, err)
+	}
+	if !found {
+		return nil, domain.NewNotFoundError("session", sessionID)
+	}
+
+	session.ETag = etag
+	return &session, nil
+}
+
+// UpdateSession saves a session read with GetSession, provided nobody changed it since
+func (s *StateSessionStore) UpdateSession(ctx context.Context, session *auth.Session) error {
+	if session.ETag == "" {
+		return domain.NewValidationError("session updates require the ETag it was read with")
+	}
+	return s.save(ctx, session, session.ETag)
+}
+
+// ListUserSessions returns every session of the user still held in the store. Sessions
+// that ended longer than the retention period ago are removed along the way.
+func (s *StateSessionStore) ListUserSessions(ctx context.Context, userID string) ([]*auth.Session, error) {
+	ids, err := s.stateStore.LookupIndex(ctx, sessionDomain, sessionEntityType, dapr.IndexCondition{Index: sessionIndexUser, Value: userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query session index: %w", err)
+	}
+
+	cutoff := s.now().UTC().Add(-sessionRetention)
+	sessions := make([]*auth.Session, 0, len(ids))
+	for _, id := range ids {
+		session, err := s.GetSession(ctx, id)
+		if err != nil {
+			// The index can briefly point at a session deleted by a concurrent prune
+			if domain.IsNotFoundError(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		if sessionEndedAt(session).Before(cutoff) {
+			if err := s.stateStore.DeleteIndexed(ctx, sessionDomain, sessionEntityType, id); err != nil {
+				return nil, fmt.Errorf("failed to prune session %s: %w", id, err)
+			}
+			continue
+		}
+
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
+
+func (s *StateSessionStore) save(ctx context.Context, session *auth.Session, etag string) error {
+	err := s.stateStore.SaveIndexedWithETag(ctx, sessionDomain, sessionEntityType, session.SessionID, session, etag)
+	if err != nil {
+		return fmt.Errorf("failed to save session %s: %w", session.SessionID, err)
+	}
+
+	key := s.stateStore.CreateKey(sessionDomain, sessionEntityType, session.SessionID)
+	if session.ETag, err = s.stateStore.WrittenETag(ctx, key, session); err != nil {
+		session.ETag = ""
+	}
+	return nil
+}
+
+// sessionEndedAt returns when the session was revoked or, failing that, when it stops
+// being usable
+func sessionEndedAt(session *auth.Session) time.Time {
+	if session.RevokedAt != nil {
+		return *session.RevokedAt
+	}
+	if session.IdleExpiresAt.Before(session.ExpiresAt) {
+		return session.IdleExpiresAt
+	}
+	return session.ExpiresAt
+}
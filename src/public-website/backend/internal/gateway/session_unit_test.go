@@ -0,0 +1,342 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/auth"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// InMemorySessionStore implements auth.SessionStore with versioned ETags
+type InMemorySessionStore struct {
+	mutex    sync.Mutex
+	sessions map[string]auth.Session
+	versions map[string]int
+}
+
+func NewInMemorySessionStore() *InMemorySessionStore {
+	return &InMemorySessionStore{sessions: make(map[string]auth.Session), versions: make(map[string]int)}
+}
+
+func (s *InMemorySessionStore) CreateSession(ctx context.Context, session *auth.Session) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.save(session)
+	return nil
+}
+
+func (s *InMemorySessionStore) GetSession(ctx context.Context, sessionID string) (*auth.Session, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	session, exists := s.sessions[sessionID]
+	if !exists {
+		return nil, domain.NewNotFoundError("session", sessionID)
+	}
+	session.RetiredTokenHashes = append([]string(nil), session.RetiredTokenHashes...)
+	return &session, nil
+}
+
+func (s *InMemorySessionStore) UpdateSession(ctx context.Context, session *auth.Session) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if session.ETag != strconv.Itoa(s.versions[session.SessionID]) {
+		return domain.NewConflictError("session was modified")
+	}
+	s.save(session)
+	return nil
+}
+
+func (s *InMemorySessionStore) ListUserSessions(ctx context.Context, userID string) ([]*auth.Session, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	var sessions []*auth.Session
+	for _, session := range s.sessions {
+		if session.UserID == userID {
+			copied := session
+			sessions = append(sessions, &copied)
+		}
+	}
+	return sessions, nil
+}
+
+func (s *InMemorySessionStore) save(session *auth.Session) {
+	s.versions[session.SessionID]++
+	session.ETag = strconv.Itoa(s.versions[session.SessionID])
+	stored := *session
+	stored.RetiredTokenHashes = append([]string(nil), session.RetiredTokenHashes...)
+	s.sessions[session.SessionID] = stored
+}
+
+const testPassword = "correct horse battery"
+
+func TestAccessControlServiceAuthenticatePassword(t *testing.T) {
+	tests := []struct {
+		name           string
+		email          string
+		attempts       []string
+		lockedFor      time.Duration
+		expectedError  func(error) bool
+		expectedLocked bool
+	}{
+		{name: "matching password signs in", email: "editor@example.org", attempts: []string{testPassword}},
+		{name: "email is matched without case", email: "Editor@Example.org", attempts: []string{testPassword}},
+		{name: "wrong password is unauthorized", email: "editor@example.org", attempts: []string{"wrong password"}, expectedError: domain.IsUnauthorizedError},
+		{name: "unknown email is unauthorized", email: "stranger@example.org", attempts: []string{testPassword}, expectedError: domain.IsUnauthorizedError},
+		{name: "suspended account is forbidden", email: "suspended@example.org", attempts: []string{testPassword}, expectedError: domain.IsForbiddenError},
+		{
+			name:           "repeated failures lock the account",
+			email:          "editor@example.org",
+			attempts:       []string{"wrong 1", "wrong 2", "wrong 3", "wrong 4", "wrong 5", testPassword},
+			expectedError:  domain.IsRateLimitError,
+			expectedLocked: true,
+		},
+		{
+			name:      "the lock expires",
+			email:     "editor@example.org",
+			attempts:  []string{"wrong 1", "wrong 2", "wrong 3", "wrong 4", "wrong 5", testPassword},
+			lockedFor: LoginLockoutDuration,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			repository := NewInMemoryAccessRepository()
+			editorID := repository.addUser("editor@example.org", auth.UserStatusActive, auth.RoleEditor)
+			repository.setPassword(t, editorID, testPassword)
+			suspendedID := repository.addUser("suspended@example.org", auth.UserStatusSuspended, auth.RoleEditor)
+			repository.setPassword(t, suspendedID, testPassword)
+
+			now := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+			service := NewDefaultAccessControlService(repository)
+			service.now = func() time.Time { return now }
+
+			// Act
+			var user *auth.User
+			var err error
+			for i, password := range tt.attempts {
+				if i == len(tt.attempts)-1 {
+					now = now.Add(tt.lockedFor)
+				}
+				user, err = service.AuthenticatePassword(context.Background(), tt.email, password)
+			}
+
+			// Assert
+			state, stateErr := repository.GetLoginState(context.Background(), editorID)
+			require.NoError(t, stateErr)
+			assert.Equal(t, tt.expectedLocked, state.LockedUntil != nil)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.True(t, tt.expectedError(err), "got %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, editorID, user.UserID)
+			require.NotNil(t, user.LastLoginAt)
+			assert.Zero(t, state.FailedLoginAttempts, "a successful sign-in clears the failures")
+		})
+	}
+}
+
+// sessionTestGateway serves the access routes behind the policy middleware with
+// password sign-in enabled
+type sessionTestGateway struct {
+	repository *InMemoryAccessRepository
+	service    *DefaultAccessControlService
+	router     *mux.Router
+	adminID    string
+	editorID   string
+}
+
+func newSessionTestGateway(t *testing.T) *sessionTestGateway {
+	t.Helper()
+
+	repository := NewInMemoryAccessRepository()
+	adminID := repository.addUser("admin@example.org", auth.UserStatusActive, auth.RoleAdmin)
+	repository.setPassword(t, adminID, testPassword)
+	editorID := repository.addUser("editor@example.org", auth.UserStatusActive, auth.RoleEditor)
+	repository.setPassword(t, editorID, testPassword)
+
+	sessions, err := auth.NewSessionManager(NewInMemorySessionStore(), auth.DefaultSessionConfig(), []byte(strings.Repeat("s", auth.MinSessionSigningKeyBytes)))
+	require.NoError(t, err)
+
+	service := NewDefaultAccessControlService(repository)
+	service.SetSessionRevoker(sessions)
+	handler := NewAccessControlHandler(service, auth.DefaultAdminPolicy(), &GatewayConfiguration{Environment: "testing"})
+	handler.SetSessionManager(sessions)
+	handler.SetTokenVerifier(func(ctx context.Context, token string) (*auth.Claims, error) {
+		return nil, domain.NewUnauthorizedError("social login is not used in this test")
+	})
+
+	router := mux.NewRouter()
+	router.Use(handler.PolicyMiddleware)
+	handler.RegisterAccessRoutes(router)
+
+	return &sessionTestGateway{repository: repository, service: service, router: router, adminID: adminID, editorID: editorID}
+}
+
+func (g *sessionTestGateway) serve(method, path, accessToken, body string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(method, path, strings.NewReader(body))
+	if accessToken != "" {
+		request.Header.Set("Authorization", "Bearer "+accessToken)
+	}
+	recorder := httptest.NewRecorder()
+	g.router.ServeHTTP(recorder, request)
+	return recorder
+}
+
+type tokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int    `json:"expires_in"`
+	TokenType    string `json:"token_type"`
+}
+
+func (g *sessionTestGateway) login(t *testing.T, email string) tokenResponse {
+	t.Helper()
+	recorder := g.serve(http.MethodPost, "/admin/api/v1/auth/login", "", `{"email": "`+email+`", "password": "`+testPassword+`"}`)
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+
+	var tokens tokenResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &tokens))
+	return tokens
+}
+
+func (g *sessionTestGateway) refresh(t *testing.T, refreshToken string) (*httptest.ResponseRecorder, tokenResponse) {
+	t.Helper()
+	recorder := g.serve(http.MethodPost, "/admin/api/v1/auth/refresh", "", `{"refresh_token": "`+refreshToken+`"}`)
+
+	var tokens tokenResponse
+	if recorder.Code == http.StatusOK {
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &tokens))
+	}
+	return recorder, tokens
+}
+
+func TestSessionEndpointsLoginRefreshLogout(t *testing.T) {
+	// Arrange
+	gateway := newSessionTestGateway(t)
+	wrongPassword := gateway.serve(http.MethodPost, "/admin/api/v1/auth/login", "", `{"email": "editor@example.org", "password": "wrong password"}`)
+	tokens := gateway.login(t, "editor@example.org")
+
+	// Act
+	sessions := gateway.serve(http.MethodGet, "/admin/api/v1/auth/sessions", tokens.AccessToken, "")
+	refreshed, rotated := gateway.refresh(t, tokens.RefreshToken)
+	replayed, _ := gateway.refresh(t, tokens.RefreshToken)
+	logout := gateway.serve(http.MethodPost, "/admin/api/v1/auth/logout", rotated.AccessToken, "")
+	afterLogout := gateway.serve(http.MethodGet, "/admin/api/v1/auth/sessions", rotated.AccessToken, "")
+	refreshAfterLogout, _ := gateway.refresh(t, rotated.RefreshToken)
+
+	// Assert
+	assert.Equal(t, http.StatusUnauthorized, wrongPassword.Code)
+	assert.Equal(t, "Bearer", tokens.TokenType)
+	assert.Equal(t, 900, tokens.ExpiresIn)
+
+	require.Equal(t, http.StatusOK, sessions.Code)
+	var sessionsBody struct {
+		Data []struct {
+			SessionID string `json:"session_id"`
+			Current   bool   `json:"current"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(sessions.Body.Bytes(), &sessionsBody))
+	require.Len(t, sessionsBody.Data, 1)
+	assert.True(t, sessionsBody.Data[0].Current)
+	assert.True(t, strings.HasPrefix(tokens.RefreshToken, sessionsBody.Data[0].SessionID+"."))
+
+	require.Equal(t, http.StatusOK, refreshed.Code)
+	assert.NotEqual(t, tokens.RefreshToken, rotated.RefreshToken, "refreshing rotates the refresh token")
+	assert.Equal(t, http.StatusUnauthorized, replayed.Code, "a refresh token works once")
+
+	assert.Equal(t, http.StatusOK, logout.Code)
+	assert.Equal(t, http.StatusUnauthorized, afterLogout.Code, "access tokens stop working at logout")
+	assert.Equal(t, http.StatusUnauthorized, refreshAfterLogout.Code)
+}
+
+func TestSessionEndpointsRevocation(t *testing.T) {
+	tests := []struct {
+		name    string
+		revoke  func(t *testing.T, gateway *sessionTestGateway, adminToken string, editorSessionID string) *httptest.ResponseRecorder
+		status  int
+		revoked bool
+	}{
+		{
+			name: "an admin signs a user out everywhere",
+			revoke: func(t *testing.T, gateway *sessionTestGateway, adminToken string, editorSessionID string) *httptest.ResponseRecorder {
+				return gateway.serve(http.MethodDelete, "/admin/api/v1/users/"+gateway.editorID+"/sessions", adminToken, "")
+			},
+			status:  http.StatusOK,
+			revoked: true,
+		},
+		{
+			name: "an admin signs a user out of one session",
+			revoke: func(t *testing.T, gateway *sessionTestGateway, adminToken string, editorSessionID string) *httptest.ResponseRecorder {
+				return gateway.serve(http.MethodDelete, "/admin/api/v1/users/"+gateway.editorID+"/sessions/"+editorSessionID, adminToken, "")
+			},
+			status:  http.StatusOK,
+			revoked: true,
+		},
+		{
+			name: "suspending a user ends their sessions",
+			revoke: func(t *testing.T, gateway *sessionTestGateway, adminToken string, editorSessionID string) *httptest.ResponseRecorder {
+				return gateway.serve(http.MethodPut, "/admin/api/v1/users/"+gateway.editorID, adminToken, `{"status": "suspended"}`)
+			},
+			status:  http.StatusOK,
+			revoked: true,
+		},
+		{
+			name: "changing a user's role ends their sessions",
+			revoke: func(t *testing.T, gateway *sessionTestGateway, adminToken string, editorSessionID string) *httptest.ResponseRecorder {
+				return gateway.serve(http.MethodPut, "/admin/api/v1/users/"+gateway.editorID, adminToken, `{"role": "viewer"}`)
+			},
+			status:  http.StatusOK,
+			revoked: true,
+		},
+		{
+			name: "sessions of another user cannot be revoked as your own",
+			revoke: func(t *testing.T, gateway *sessionTestGateway, adminToken string, editorSessionID string) *httptest.ResponseRecorder {
+				return gateway.serve(http.MethodDelete, "/admin/api/v1/auth/sessions/"+editorSessionID, adminToken, "")
+			},
+			status: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			gateway := newSessionTestGateway(t)
+			adminTokens := gateway.login(t, "admin@example.org")
+			editorTokens := gateway.login(t, "editor@example.org")
+			editorSessionID := strings.SplitN(editorTokens.RefreshToken, ".", 2)[0]
+
+			// Act
+			response := tt.revoke(t, gateway, adminTokens.AccessToken, editorSessionID)
+
+			// Assert
+			require.Equal(t, tt.status, response.Code, response.Body.String())
+			editorRequest := gateway.serve(http.MethodGet, "/admin/api/v1/auth/sessions", editorTokens.AccessToken, "")
+			if tt.revoked {
+				assert.Equal(t, http.StatusUnauthorized, editorRequest.Code)
+				refresh, _ := gateway.refresh(t, editorTokens.RefreshToken)
+				assert.Equal(t, http.StatusUnauthorized, refresh.Code)
+			} else {
+				assert.Equal(t, http.StatusOK, editorRequest.Code)
+			}
+
+			adminRequest := gateway.serve(http.MethodGet, "/admin/api/v1/users/"+gateway.editorID+"/sessions", adminTokens.AccessToken, "")
+			assert.Equal(t, http.StatusOK, adminRequest.Code, "the admin's own session is untouched")
+		})
+	}
+}
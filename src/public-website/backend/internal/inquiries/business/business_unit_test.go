@@ -2,6 +2,7 @@ package business
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -63,11 +64,12 @@ func (m *MockBusinessRepository) GetAuditEvents() []MockAuditEvent {
 }
 
 // Repository interface methods
-func (m *MockBusinessRepository) SaveInquiry(ctx context.Context, inquiry *BusinessInquiry) error {
+func (m *MockBusinessRepository) SaveInquiry(ctx context.Context, inquiry *BusinessInquiry, audit *domain.AuditEvent) error {
 	if err := m.failures["SaveInquiry"]; err != nil {
 		return err
 	}
 	m.inquiries[inquiry.InquiryID] = inquiry
+	m.recordAudit(audit)
 	return nil
 }
 
@@ -82,7 +84,7 @@ func (m *MockBusinessRepository) GetInquiry(ctx context.Context, inquiryID strin
 	return inquiry, nil
 }
 
-func (m *MockBusinessRepository) DeleteInquiry(ctx context.Context, inquiryID string, userID string) error {
+func (m *MockBusinessRepository) DeleteInquiry(ctx context.Context, inquiryID string, userID string, audit *domain.AuditEvent) error {
 	if err := m.failures["DeleteInquiry"]; err != nil {
 		return err
 	}
@@ -93,6 +95,7 @@ func (m *MockBusinessRepository) DeleteInquiry(ctx context.Context, inquiryID st
 	inquiry.IsDeleted = true
 	inquiry.DeletedAt = &[]time.Time{time.Now()}[0]
 	inquiry.UpdatedBy = userID
+	m.recordAudit(audit)
 	return nil
 }
 
@@ -101,29 +104,44 @@ func (m *MockBusinessRepository) ListInquiries(ctx context.Context, filters Inqu
 		return nil, err
 	}
 	
-	var result []*BusinessInquiry
+	if err := filters.Validate(); err != nil {
+		return nil, err
+	}
+	
+	var inquiries []*BusinessInquiry
 	for _, inquiry := range m.inquiries {
-		if !inquiry.IsDeleted {
-			result = append(result, inquiry)
-		}
+		inquiries = append(inquiries, inquiry)
 	}
+	result, _ := ApplyInquiryFilters(inquiries, filters)
 	return result, nil
 }
 
-func (m *MockBusinessRepository) PublishAuditEvent(ctx context.Context, entityType domain.EntityType, entityID string, operationType domain.AuditEventType, userID string, beforeData, afterData interface{}) error {
-	if err := m.failures["PublishAuditEvent"]; err != nil {
-		return err
+func (m *MockBusinessRepository) CountInquiries(ctx context.Context, filters InquiryFilters) (int, error) {
+	if err := m.failures["CountInquiries"]; err != nil {
+		return 0, err
 	}
 	
+	var inquiries []*BusinessInquiry
+	for _, inquiry := range m.inquiries {
+		inquiries = append(inquiries, inquiry)
+	}
+	_, total := ApplyInquiryFilters(inquiries, filters)
+	return total, nil
+}
+
+// recordAudit keeps the audit event a write committed, in place of the outbox
+func (m *MockBusinessRepository) recordAudit(audit *domain.AuditEvent) {
+	if audit == nil {
+		return
+	}
 	m.auditEvents = append(m.auditEvents, MockAuditEvent{
-		EntityType:    entityType,
-		EntityID:      entityID,
-		OperationType: operationType,
-		UserID:        userID,
-		Before:        beforeData,
-		After:         afterData,
+		EntityType:    audit.EntityType,
+		EntityID:      audit.EntityID,
+		OperationType: audit.OperationType,
+		UserID:        audit.UserID,
+		Before:        audit.DataSnapshot.Before,
+		After:         audit.DataSnapshot.After,
 	})
-	return nil
 }
 
 // Helper function to create test business inquiry
@@ -732,6 +750,121 @@ func TestBusinessService_AdminListInquiries(t *testing.T) {
 	}
 }
 
+func TestBusinessService_AdminListInquiriesPage(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	admin := "admin-550e8400-e29b-41d4-a716-446655440003"
+	base := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("walk pages newest first", func(t *testing.T) {
+		// Arrange
+		repo := NewMockBusinessRepository()
+		mockRepo := repo.(*MockBusinessRepository)
+		for i, id := range []string{"550e8400-e29b-41d4-a716-446655440001", "550e8400-e29b-41d4-a716-446655440002", "550e8400-e29b-41d4-a716-446655440004"} {
+			inquiry := createTestBusinessInquiry(id, "Test Organization", "John Smith", admin)
+			inquiry.CreatedAt = base.Add(time.Duration(i) * time.Hour)
+			mockRepo.inquiries[id] = inquiry
+		}
+		service := NewBusinessService(repo)
+
+		// Act
+		first, firstInfo, err := service.AdminListInquiriesPage(ctx, InquiryFilters{}, domain.PageRequest{Limit: 2}, admin)
+		require.NoError(t, err)
+		after, err := domain.DecodeCursor(firstInfo.NextCursor)
+		require.NoError(t, err)
+		second, secondInfo, err := service.AdminListInquiriesPage(ctx, InquiryFilters{}, domain.PageRequest{After: after, Limit: 2}, admin)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, first, 2)
+		assert.Equal(t, "550e8400-e29b-41d4-a716-446655440004", first[0].InquiryID)
+		assert.True(t, firstInfo.HasMore)
+		require.Len(t, second, 1)
+		assert.Equal(t, "550e8400-e29b-41d4-a716-446655440001", second[0].InquiryID)
+		assert.False(t, secondInfo.HasMore)
+		assert.Equal(t, 3, secondInfo.Total)
+	})
+
+	t.Run("return unauthorized error for non-admin user", func(t *testing.T) {
+		service := NewBusinessService(NewMockBusinessRepository())
+
+		inquiries, _, err := service.AdminListInquiriesPage(ctx, InquiryFilters{}, domain.PageRequest{Limit: 2}, "regular-user-id")
+
+		require.Error(t, err)
+		assertErrorType(t, err, "unauthorized")
+		assert.Nil(t, inquiries)
+	})
+}
+
+func TestApplyInquiryFilters(t *testing.T) {
+	base := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+	inquiries := func() []*BusinessInquiry {
+		var result []*BusinessInquiry
+		for i, priority := range []InquiryPriority{InquiryPriorityLow, InquiryPriorityUrgent, InquiryPriorityMedium, InquiryPriorityUrgent} {
+			id := fmt.Sprintf("inquiry-%d", i+1)
+			inquiry := createTestBusinessInquiry(id, "Test Organization", "John Smith", "admin-550e8400-e29b-41d4-a716-446655440003")
+			inquiry.Priority = priority
+			inquiry.CreatedAt = base.Add(time.Duration(i) * time.Hour)
+			result = append(result, inquiry)
+		}
+		result[2].Status = InquiryStatusResolved
+		result[3].IsDeleted = true
+		return result
+	}
+	status := InquiryStatusNew
+	sortPriority := InquirySortPriority
+	createdFrom := base.Add(time.Hour)
+	limit := 1
+
+	tests := []struct {
+		name      string
+		filters   InquiryFilters
+		wantIDs   []string
+		wantTotal int
+	}{
+		{
+			name:      "default order newest first without deleted",
+			filters:   InquiryFilters{},
+			wantIDs:   []string{"inquiry-3", "inquiry-2", "inquiry-1"},
+			wantTotal: 3,
+		},
+		{
+			name:      "filter by status and created range",
+			filters:   InquiryFilters{Status: &status, CreatedFrom: &createdFrom},
+			wantIDs:   []string{"inquiry-2"},
+			wantTotal: 1,
+		},
+		{
+			name:      "sort by priority",
+			filters:   InquiryFilters{SortBy: &sortPriority},
+			wantIDs:   []string{"inquiry-2", "inquiry-3", "inquiry-1"},
+			wantTotal: 3,
+		},
+		{
+			name:      "limit keeps total count",
+			filters:   InquiryFilters{SortBy: &sortPriority, Limit: &limit},
+			wantIDs:   []string{"inquiry-2"},
+			wantTotal: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			result, total := ApplyInquiryFilters(inquiries(), tt.filters)
+
+			// Assert
+			ids := make([]string, len(result))
+			for i, inquiry := range result {
+				ids[i] = inquiry.InquiryID
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+			assert.Equal(t, tt.wantTotal, total)
+		})
+	}
+}
+
 func TestBusinessService_AdminGetInquiry(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
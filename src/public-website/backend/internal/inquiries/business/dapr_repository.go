@@ -3,6 +3,8 @@ package business
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
@@ -17,10 +19,53 @@ type BusinessRepository struct {
 	pubsub     *dapr.PubSub
 }
 
+// Secondary indexes maintained for business inquiries
+const (
+	businessIndexStatus      = "status"
+	businessIndexPriority    = "priority"
+	businessIndexInquiryType = "inquiry_type"
+	businessIndexIndustry    = "industry"
+	businessIndexIsDeleted   = "is_deleted"
+)
+
+// BusinessInquiryIndexes declares the secondary indexes maintained for business inquiries
+func BusinessInquiryIndexes() *dapr.IndexedEntityType {
+	return &dapr.IndexedEntityType{
+		Domain:     "business",
+		EntityType: "inquiry",
+		Indexes: []dapr.IndexDefinition{
+			{Name: businessIndexStatus, Extract: func(entity interface{}) []string {
+				return []string{string(entity.(*BusinessInquiry).Status)}
+			}},
+			{Name: businessIndexPriority, Extract: func(entity interface{}) []string {
+				return []string{string(entity.(*BusinessInquiry).Priority)}
+			}},
+			{Name: businessIndexInquiryType, Extract: func(entity interface{}) []string {
+				return []string{string(entity.(*BusinessInquiry).InquiryType)}
+			}},
+			{Name: businessIndexIndustry, Extract: func(entity interface{}) []string {
+				if industry := entity.(*BusinessInquiry).Industry; industry != nil {
+					return []string{strings.ToLower(*industry)}
+				}
+				return nil
+			}},
+			{Name: businessIndexIsDeleted, Extract: func(entity interface{}) []string {
+				return []string{dapr.IndexBool(entity.(*BusinessInquiry).IsDeleted)}
+			}},
+		},
+		NewEntity: func() interface{} { return &BusinessInquiry{} },
+		EntityID:  func(entity interface{}) string { return entity.(*BusinessInquiry).InquiryID },
+	}
+}
+
 // NewBusinessRepository creates a new business repository
 func NewBusinessRepository(client *dapr.Client) *BusinessRepository {
+	stateStore := dapr.NewStateStore(client)
+	stateStore.MustRegisterIndexes(BusinessInquiryIndexes())
+	stateStore.MustRegisterIndexes(dapr.OutboxMessageIndexes())
+
 	return &BusinessRepository{
-		stateStore: dapr.NewStateStore(client),
+		stateStore: stateStore,
 		bindings:   dapr.NewBindings(client),
 		pubsub:     dapr.NewPubSub(client),
 	}
@@ -28,69 +73,17 @@ func NewBusinessRepository(client *dapr.Client) *BusinessRepository {
 
 // Business inquiry operations
 
-// SaveInquiry saves business inquiry to Dapr state store
-func (r *BusinessRepository) SaveInquiry(ctx context.Context, inquiry *BusinessInquiry) error {
-	key := r.stateStore.CreateKey("business", "inquiry", inquiry.InquiryID)
-	
-	err := r.stateStore.Save(ctx, key, inquiry, nil)
-	if err != nil {
-		return fmt.Errorf("failed to save business inquiry %s: %w", inquiry.InquiryID, err)
-	}
-
-	// Create index for status search
-	statusKey := r.stateStore.CreateIndexKey("business", "inquiry", "status", string(inquiry.Status))
-	statusIndex := map[string]string{"inquiry_id": inquiry.InquiryID}
-	
-	err = r.stateStore.Save(ctx, statusKey, statusIndex, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create status index for business inquiry %s: %w", inquiry.InquiryID, err)
-	}
-
-	// Create index for priority search
-	priorityKey := r.stateStore.CreateIndexKey("business", "inquiry", "priority", string(inquiry.Priority))
-	priorityIndex := map[string]string{"inquiry_id": inquiry.InquiryID}
-	
-	err = r.stateStore.Save(ctx, priorityKey, priorityIndex, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create priority index for business inquiry %s: %w", inquiry.InquiryID, err)
-	}
-
-	// Create index for inquiry type search
-	typeKey := r.stateStore.CreateIndexKey("business", "inquiry", "type", string(inquiry.InquiryType))
-	typeIndex := map[string]string{"inquiry_id": inquiry.InquiryID}
-	
-	err = r.stateStore.Save(ctx, typeKey, typeIndex, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create type index for business inquiry %s: %w", inquiry.InquiryID, err)
-	}
-
-	// Create index for industry search (if industry is provided)
-	if inquiry.Industry != nil && *inquiry.Industry != "" {
-		industryKey := r.stateStore.CreateIndexKey("business", "inquiry", "industry", *inquiry.Industry)
-		industryIndex := map[string]string{"inquiry_id": inquiry.InquiryID}
-		
-		err = r.stateStore.Save(ctx, industryKey, industryIndex, nil)
-		if err != nil {
-			return fmt.Errorf("failed to create industry index for business inquiry %s: %w", inquiry.InquiryID, err)
-		}
-	}
-
-	// Create index for organization search
-	orgKey := r.stateStore.CreateIndexKey("business", "inquiry", "organization", inquiry.OrganizationName)
-	orgIndex := map[string]string{"inquiry_id": inquiry.InquiryID}
-	
-	err = r.stateStore.Save(ctx, orgKey, orgIndex, nil)
+// SaveInquiry saves business inquiry and its secondary indexes to Dapr state store, together
+// with the audit event describing the change
+func (r *BusinessRepository) SaveInquiry(ctx context.Context, inquiry *BusinessInquiry, audit *domain.AuditEvent) error {
+	messages, err := r.auditMessages(ctx, audit)
 	if err != nil {
-		return fmt.Errorf("failed to create organization index for business inquiry %s: %w", inquiry.InquiryID, err)
+		return err
 	}
 
-	// Create index for creation date search
-	dateKey := r.stateStore.CreateIndexKey("business", "inquiry", "created_date", inquiry.CreatedAt.Format("2006-01-02"))
-	dateIndex := map[string]string{"inquiry_id": inquiry.InquiryID}
-	
-	err = r.stateStore.Save(ctx, dateKey, dateIndex, nil)
+	err = r.stateStore.SaveIndexedWithOutbox(ctx, "business", "inquiry", inquiry.InquiryID, inquiry, "", messages...)
 	if err != nil {
-		return fmt.Errorf("failed to create date index for business inquiry %s: %w", inquiry.InquiryID, err)
+		return fmt.Errorf("failed to save business inquiry %s: %w", inquiry.InquiryID, err)
 	}
 
 	return nil
@@ -119,7 +112,7 @@ func (r *BusinessRepository) GetInquiry(ctx context.Context, inquiryID string) (
 }
 
 // DeleteInquiry soft deletes a business inquiry
-func (r *BusinessRepository) DeleteInquiry(ctx context.Context, inquiryID string, userID string) error {
+func (r *BusinessRepository) DeleteInquiry(ctx context.Context, inquiryID string, userID string, audit *domain.AuditEvent) error {
 	inquiry, err := r.GetInquiry(ctx, inquiryID)
 	if err != nil {
 		return err
@@ -131,42 +124,120 @@ func (r *BusinessRepository) DeleteInquiry(ctx context.Context, inquiryID string
 	inquiry.UpdatedBy = userID
 	inquiry.UpdatedAt = now
 
-	return r.SaveInquiry(ctx, inquiry)
+	return r.SaveInquiry(ctx, inquiry, audit)
 }
 
-// ListInquiries retrieves business inquiries with filtering
+// ListInquiries retrieves business inquiries matching the filters, sorted and windowed by limit/offset
 func (r *BusinessRepository) ListInquiries(ctx context.Context, filters InquiryFilters) ([]*BusinessInquiry, error) {
-	// For this minimal GREEN phase implementation, return empty list
-	// In a production system, this would use proper database queries or indexed searches
-	// This will be improved in the REFACTOR phase
-	
-	return []*BusinessInquiry{}, nil
+	inquiries, err := r.findInquiries(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	windowed, _ := ApplyInquiryFilters(inquiries, filters)
+	return windowed, nil
+}
+
+// CountInquiries returns the total number of business inquiries matching the filters, ignoring limit/offset
+func (r *BusinessRepository) CountInquiries(ctx context.Context, filters InquiryFilters) (int, error) {
+	inquiries, err := r.findInquiries(ctx, filters)
+	if err != nil {
+		return 0, err
+	}
+
+	_, total := ApplyInquiryFilters(inquiries, filters)
+	return total, nil
 }
 
-// PublishAuditEvent publishes an audit event for compliance logging
-func (r *BusinessRepository) PublishAuditEvent(ctx context.Context, entityType domain.EntityType, entityID string, operationType domain.AuditEventType, userID string, beforeData, afterData interface{}) error {
+// findInquiries narrows candidates with the equality indexes and loads them; range
+// filters, sorting and windowing are applied by ApplyInquiryFilters
+func (r *BusinessRepository) findInquiries(ctx context.Context, filters InquiryFilters) ([]*BusinessInquiry, error) {
+	if err := filters.Validate(); err != nil {
+		return nil, err
+	}
+
+	conditions := []dapr.IndexCondition{
+		{Index: businessIndexIsDeleted, Value: dapr.IndexBool(false)},
+	}
+	if filters.Status != nil {
+		conditions = append(conditions, dapr.IndexCondition{Index: businessIndexStatus, Value: string(*filters.Status)})
+	}
+	if filters.Priority != nil {
+		conditions = append(conditions, dapr.IndexCondition{Index: businessIndexPriority, Value: string(*filters.Priority)})
+	}
+	if filters.InquiryType != nil {
+		conditions = append(conditions, dapr.IndexCondition{Index: businessIndexInquiryType, Value: string(*filters.InquiryType)})
+	}
+	if filters.Industry != nil {
+		conditions = append(conditions, dapr.IndexCondition{Index: businessIndexIndustry, Value: strings.ToLower(*filters.Industry)})
+	}
+
+	ids, err := r.stateStore.LookupIndex(ctx, "business", "inquiry", conditions...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up business inquiries: %w", err)
+	}
+
+	return r.getInquiriesByIDs(ctx, ids)
+}
+
+// getInquiriesByIDs bulk loads business inquiries, skipping missing and deleted records
+func (r *BusinessRepository) getInquiriesByIDs(ctx context.Context, ids []string) ([]*BusinessInquiry, error) {
+	inquiries := make([]*BusinessInquiry, 0, len(ids))
+	if len(ids) == 0 {
+		return inquiries, nil
+	}
+
+	keys := make([]string, 0, len(ids))
+	targets := make(map[string]interface{}, len(ids))
+	loaded := make([]*BusinessInquiry, 0, len(ids))
+	for _, id := range ids {
+		key := r.stateStore.CreateKey("business", "inquiry", id)
+		inquiry := &BusinessInquiry{}
+		keys = append(keys, key)
+		targets[key] = inquiry
+		loaded = append(loaded, inquiry)
+	}
+
+	if err := r.stateStore.GetBulk(ctx, keys, targets); err != nil {
+		return nil, fmt.Errorf("failed to load indexed business inquiries: %w", err)
+	}
+
+	for _, inquiry := range loaded {
+		if inquiry.InquiryID != "" && !inquiry.IsDeleted {
+			inquiries = append(inquiries, inquiry)
+		}
+	}
+
+	return inquiries, nil
+}
+
+// PageBusinessInquiries orders inquiries newest first and returns the page following the requested cursor
+func PageBusinessInquiries(inquiries []*BusinessInquiry, page domain.PageRequest) ([]*BusinessInquiry, domain.PageInfo) {
+	sort.Slice(inquiries, func(i, j int) bool {
+		return inquiries[i].PageCursor().Before(inquiries[j].PageCursor())
+	})
+
+	start, end, info := domain.CursorPage(len(inquiries), func(i int) domain.PageCursor {
+		return inquiries[i].PageCursor()
+	}, page)
+
+	return inquiries[start:end], info
+}
+
+// auditMessages prepares the compliance audit event for a write so it commits through the
+// outbox with that write. A nil event prepares nothing.
+func (r *BusinessRepository) auditMessages(ctx context.Context, auditEvent *domain.AuditEvent) ([]*dapr.OutboxMessage, error) {
+	if auditEvent == nil {
+		return nil, nil
+	}
+
 	correlationID := domain.GetCorrelationID(ctx)
 	if correlationID == "" {
 		correlationID = uuid.New().String()
 	}
+	auditEvent.SetTraceContext(correlationID, domain.GetTraceID(ctx))
+	auditEvent.Environment = "development" // This should come from configuration
 
-	auditEvent := domain.AuditEvent{
-		AuditID:       uuid.New().String(), // Generate unique audit ID
-		EntityType:    entityType,
-		EntityID:      entityID,
-		OperationType: operationType,
-		AuditTime:     time.Now(),
-		UserID:        userID,
-		CorrelationID: correlationID,
-		TraceID:       domain.GetTraceID(ctx),
-		DataSnapshot: &domain.AuditDataSnapshot{
-			Before: beforeData,
-			After:  afterData,
-		},
-		Environment: "development", // This should come from configuration
-	}
-
-	// Publish to Grafana audit events topic
 	daprAuditEvent := &dapr.AuditEvent{
 		AuditID:       auditEvent.AuditID,
 		EntityType:    string(auditEvent.EntityType),
@@ -176,11 +247,13 @@ func (r *BusinessRepository) PublishAuditEvent(ctx context.Context, entityType d
 		UserID:        auditEvent.UserID,
 		CorrelationID: auditEvent.CorrelationID,
 		TraceID:       auditEvent.TraceID,
-		DataSnapshot: map[string]interface{}{
+		Environment:   auditEvent.Environment,
+	}
+	if auditEvent.DataSnapshot != nil {
+		daprAuditEvent.DataSnapshot = map[string]interface{}{
 			"before": auditEvent.DataSnapshot.Before,
 			"after":  auditEvent.DataSnapshot.After,
-		},
-		Environment: auditEvent.Environment,
+		}
 	}
 
 	eventMessage := &dapr.EventMessage{
@@ -189,21 +262,16 @@ func (r *BusinessRepository) PublishAuditEvent(ctx context.Context, entityType d
 			"audit_event": daprAuditEvent,
 		},
 		Metadata: map[string]string{
-			"entity_type":    string(entityType),
-			"operation_type": string(operationType),
-			"user_id":        userID,
+			"entity_type":    string(auditEvent.EntityType),
+			"operation_type": string(auditEvent.OperationType),
+			"user_id":        auditEvent.UserID,
 		},
 		ContentType: "application/json",
 		Source:      "business-admin-api",
 		Type:        "audit.event",
-		Subject:     fmt.Sprintf("audit.%s.%s", string(entityType), string(operationType)),
+		Subject:     fmt.Sprintf("audit.%s.%s", string(auditEvent.EntityType), string(auditEvent.OperationType)),
 		Time:        time.Now(),
 	}
 
-	err := r.pubsub.PublishEvent(ctx, "audit-events", eventMessage)
-	if err != nil {
-		return fmt.Errorf("failed to publish business inquiry audit event: %w", err)
-	}
-
-	return nil
+	return []*dapr.OutboxMessage{dapr.NewOutboxMessage(ctx, "audit-events", eventMessage)}, nil
 }
\ No newline at end of file
@@ -12,13 +12,11 @@ import (
 // BusinessRepositoryInterface defines the interface for business inquiry data operations
 type BusinessRepositoryInterface interface {
 	// Business inquiry operations
-	SaveInquiry(ctx context.Context, inquiry *BusinessInquiry) error
+	SaveInquiry(ctx context.Context, inquiry *BusinessInquiry, audit *domain.AuditEvent) error
 	GetInquiry(ctx context.Context, inquiryID string) (*BusinessInquiry, error)
-	DeleteInquiry(ctx context.Context, inquiryID string, userID string) error
+	DeleteInquiry(ctx context.Context, inquiryID string, userID string, audit *domain.AuditEvent) error
 	ListInquiries(ctx context.Context, filters InquiryFilters) ([]*BusinessInquiry, error)
-	
-	// Audit operations
-	PublishAuditEvent(ctx context.Context, entityType domain.EntityType, entityID string, operationType domain.AuditEventType, userID string, beforeData, afterData interface{}) error
+	CountInquiries(ctx context.Context, filters InquiryFilters) (int, error)
 }
 
 // BusinessService provides business logic for business inquiry operations
@@ -108,14 +106,11 @@ func (s *BusinessService) AdminCreateInquiry(ctx context.Context, request AdminC
 		return nil, err
 	}
 
-	if err := s.repository.SaveInquiry(ctx, inquiry); err != nil {
+	audit := domain.NewAuditChange(domain.EntityTypeBusinessInquiry, inquiry.InquiryID, domain.AuditEventInsert, userID, nil, inquiry)
+	if err := s.repository.SaveInquiry(ctx, inquiry, audit); err != nil {
 		return nil, domain.NewInternalError("failed to save business inquiry", err)
 	}
 
-	if err := s.repository.PublishAuditEvent(ctx, domain.EntityTypeBusinessInquiry, inquiry.InquiryID, domain.AuditEventInsert, userID, nil, inquiry); err != nil {
-		return nil, domain.NewInternalError("failed to publish audit event", err)
-	}
-
 	return inquiry, nil
 }
 
@@ -190,14 +185,11 @@ func (s *BusinessService) AdminUpdateInquiry(ctx context.Context, inquiryID stri
 		return nil, err
 	}
 
-	if err := s.repository.SaveInquiry(ctx, inquiry); err != nil {
+	audit := domain.NewAuditChange(domain.EntityTypeBusinessInquiry, inquiry.InquiryID, domain.AuditEventUpdate, userID, beforeData, *inquiry)
+	if err := s.repository.SaveInquiry(ctx, inquiry, audit); err != nil {
 		return nil, domain.NewInternalError("failed to update business inquiry", err)
 	}
 
-	if err := s.repository.PublishAuditEvent(ctx, domain.EntityTypeBusinessInquiry, inquiry.InquiryID, domain.AuditEventUpdate, userID, beforeData, *inquiry); err != nil {
-		return nil, domain.NewInternalError("failed to publish audit event", err)
-	}
-
 	return inquiry, nil
 }
 
@@ -214,14 +206,11 @@ func (s *BusinessService) AdminDeleteInquiry(ctx context.Context, inquiryID stri
 
 	beforeData := *inquiry
 
-	if err := s.repository.DeleteInquiry(ctx, inquiryID, userID); err != nil {
+	audit := domain.NewAuditChange(domain.EntityTypeBusinessInquiry, inquiryID, domain.AuditEventDelete, userID, beforeData, nil)
+	if err := s.repository.DeleteInquiry(ctx, inquiryID, userID, audit); err != nil {
 		return domain.NewInternalError("failed to delete business inquiry", err)
 	}
 
-	if err := s.repository.PublishAuditEvent(ctx, domain.EntityTypeBusinessInquiry, inquiryID, domain.AuditEventDelete, userID, beforeData, nil); err != nil {
-		return domain.NewInternalError("failed to publish audit event", err)
-	}
-
 	return nil
 }
 
@@ -246,14 +235,11 @@ func (s *BusinessService) AdminAcknowledgeInquiry(ctx context.Context, inquiryID
 	inquiry.UpdatedAt = time.Now().UTC()
 	inquiry.UpdatedBy = userID
 
-	if err := s.repository.SaveInquiry(ctx, inquiry); err != nil {
+	audit := domain.NewAuditChange(domain.EntityTypeBusinessInquiry, inquiry.InquiryID, domain.AuditEventUpdate, userID, beforeData, *inquiry)
+	if err := s.repository.SaveInquiry(ctx, inquiry, audit); err != nil {
 		return nil, domain.NewInternalError("failed to acknowledge business inquiry", err)
 	}
 
-	if err := s.repository.PublishAuditEvent(ctx, domain.EntityTypeBusinessInquiry, inquiry.InquiryID, domain.AuditEventUpdate, userID, beforeData, *inquiry); err != nil {
-		return nil, domain.NewInternalError("failed to publish audit event", err)
-	}
-
 	return inquiry, nil
 }
 
@@ -278,14 +264,11 @@ func (s *BusinessService) AdminResolveInquiry(ctx context.Context, inquiryID str
 	inquiry.UpdatedAt = time.Now().UTC()
 	inquiry.UpdatedBy = userID
 
-	if err := s.repository.SaveInquiry(ctx, inquiry); err != nil {
+	audit := domain.NewAuditChange(domain.EntityTypeBusinessInquiry, inquiry.InquiryID, domain.AuditEventUpdate, userID, beforeData, *inquiry)
+	if err := s.repository.SaveInquiry(ctx, inquiry, audit); err != nil {
 		return nil, domain.NewInternalError("failed to resolve business inquiry", err)
 	}
 
-	if err := s.repository.PublishAuditEvent(ctx, domain.EntityTypeBusinessInquiry, inquiry.InquiryID, domain.AuditEventUpdate, userID, beforeData, *inquiry); err != nil {
-		return nil, domain.NewInternalError("failed to publish audit event", err)
-	}
-
 	return inquiry, nil
 }
 
@@ -306,14 +289,11 @@ func (s *BusinessService) AdminCloseInquiry(ctx context.Context, inquiryID strin
 	inquiry.UpdatedAt = time.Now().UTC()
 	inquiry.UpdatedBy = userID
 
-	if err := s.repository.SaveInquiry(ctx, inquiry); err != nil {
+	audit := domain.NewAuditChange(domain.EntityTypeBusinessInquiry, inquiry.InquiryID, domain.AuditEventUpdate, userID, beforeData, *inquiry)
+	if err := s.repository.SaveInquiry(ctx, inquiry, audit); err != nil {
 		return nil, domain.NewInternalError("failed to close business inquiry", err)
 	}
 
-	if err := s.repository.PublishAuditEvent(ctx, domain.EntityTypeBusinessInquiry, inquiry.InquiryID, domain.AuditEventUpdate, userID, beforeData, *inquiry); err != nil {
-		return nil, domain.NewInternalError("failed to publish audit event", err)
-	}
-
 	return inquiry, nil
 }
 
@@ -338,14 +318,11 @@ func (s *BusinessService) AdminSetPriority(ctx context.Context, inquiryID string
 	inquiry.UpdatedAt = time.Now().UTC()
 	inquiry.UpdatedBy = userID
 
-	if err := s.repository.SaveInquiry(ctx, inquiry); err != nil {
+	audit := domain.NewAuditChange(domain.EntityTypeBusinessInquiry, inquiry.InquiryID, domain.AuditEventUpdate, userID, beforeData, *inquiry)
+	if err := s.repository.SaveInquiry(ctx, inquiry, audit); err != nil {
 		return nil, domain.NewInternalError("failed to set inquiry priority", err)
 	}
 
-	if err := s.repository.PublishAuditEvent(ctx, domain.EntityTypeBusinessInquiry, inquiry.InquiryID, domain.AuditEventUpdate, userID, beforeData, *inquiry); err != nil {
-		return nil, domain.NewInternalError("failed to publish audit event", err)
-	}
-
 	return inquiry, nil
 }
 
@@ -355,6 +332,10 @@ func (s *BusinessService) AdminListInquiries(ctx context.Context, filters Inquir
 		return nil, domain.NewUnauthorizedError("admin privileges required to list business inquiries")
 	}
 
+	if err := filters.Validate(); err != nil {
+		return nil, err
+	}
+
 	inquiries, err := s.repository.ListInquiries(ctx, filters)
 	if err != nil {
 		return nil, domain.NewInternalError("failed to list business inquiries", err)
@@ -363,6 +344,47 @@ func (s *BusinessService) AdminListInquiries(ctx context.Context, filters Inquir
 	return inquiries, nil
 }
 
+// AdminCountInquiries counts business inquiries matching the filters, ignoring limit/offset (admin only)
+func (s *BusinessService) AdminCountInquiries(ctx context.Context, filters InquiryFilters, userID string) (int, error) {
+	if !IsAdminUser(userID) {
+		return 0, domain.NewUnauthorizedError("admin privileges required to count business inquiries")
+	}
+
+	if err := filters.Validate(); err != nil {
+		return 0, err
+	}
+
+	total, err := s.repository.CountInquiries(ctx, filters)
+	if err != nil {
+		return 0, domain.NewInternalError("failed to count business inquiries", err)
+	}
+
+	return total, nil
+}
+
+// AdminListInquiriesPage lists the page of business inquiries following the requested cursor (admin only)
+func (s *BusinessService) AdminListInquiriesPage(ctx context.Context, filters InquiryFilters, page domain.PageRequest, userID string) ([]*BusinessInquiry, domain.PageInfo, error) {
+	if !IsAdminUser(userID) {
+		return nil, domain.PageInfo{}, domain.NewUnauthorizedError("admin privileges required to list business inquiries")
+	}
+
+	if err := filters.Validate(); err != nil {
+		return nil, domain.PageInfo{}, err
+	}
+
+	// Cursor paging replaces limit/offset windowing and sort order
+	filters.Limit = nil
+	filters.Offset = nil
+
+	inquiries, err := s.repository.ListInquiries(ctx, filters)
+	if err != nil {
+		return nil, domain.PageInfo{}, domain.NewInternalError("failed to list business inquiries", err)
+	}
+
+	paged, info := PageBusinessInquiries(inquiries, page)
+	return paged, info, nil
+}
+
 // AdminGetInquiry retrieves a specific business inquiry (admin only)
 func (s *BusinessService) AdminGetInquiry(ctx context.Context, inquiryID string, userID string) (*BusinessInquiry, error) {
 	if !IsAdminUser(userID) {
@@ -48,6 +48,9 @@ func (h *ContractCompliantInquiryHandler) GetInquiries(w http.ResponseWriter, r
 	if params.Limit != nil {
 		listParams.Limit = int(*params.Limit)
 	}
+	if params.Cursor != nil {
+		listParams.Cursor = *params.Cursor
+	}
 	if params.Search != nil {
 		listParams.Search = *params.Search
 	}
@@ -80,7 +83,25 @@ func (h *ContractCompliantInquiryHandler) GetInquiries(w http.ResponseWriter, r
 		}
 	}
 
-	// Call media service (for now, we'll focus on media inquiries)
+	if params.Sort != nil {
+		listParams.SortBy = string(*params.Sort)
+	}
+	if params.Priority != nil {
+		listParams.Priority = string(*params.Priority)
+	}
+	if params.Urgency != nil {
+		listParams.Urgency = string(*params.Urgency)
+	}
+	if params.MediaType != nil {
+		listParams.MediaType = string(*params.MediaType)
+	}
+	listParams.DeadlineFrom = params.DeadlineFrom
+	listParams.DeadlineTo = params.DeadlineTo
+	listParams.CreatedFrom = params.CreatedFrom
+	listParams.CreatedTo = params.CreatedTo
+
+	// Only media inquiries are listed here; the media service rejects any
+	// other inquiry_type with a validation error
 	inquiries, pagination, err := h.mediaService.AdminListInquiries(ctx, listParams, userID)
 	if err != nil {
 		h.handleError(w, r, err, correlationCtx.CorrelationID)
@@ -93,20 +114,25 @@ func (h *ContractCompliantInquiryHandler) GetInquiries(w http.ResponseWriter, r
 		contractInquiries[i] = h.convertMediaInquiryToContract(inq)
 	}
 
+	paginationInfo := admin.PaginationInfo{
+		CurrentPage:  pagination.CurrentPage,
+		TotalPages:   pagination.TotalPages,
+		TotalItems:   pagination.TotalItems,
+		ItemsPerPage: pagination.ItemsPerPage,
+		HasNext:      pagination.HasNext,
+		HasPrevious:  pagination.HasPrevious,
+	}
+	if pagination.NextCursor != "" {
+		paginationInfo.NextCursor = &pagination.NextCursor
+	}
+
 	// Build contract-compliant response
 	response := struct {
 		Data       []admin.Inquiry        `json:"data"`
 		Pagination admin.PaginationInfo   `json:"pagination"`
 	}{
-		Data: contractInquiries,
-		Pagination: admin.PaginationInfo{
-			CurrentPage:  pagination.CurrentPage,
-			TotalPages:   pagination.TotalPages,
-			TotalItems:   pagination.TotalItems,
-			ItemsPerPage: pagination.ItemsPerPage,
-			HasNext:      pagination.HasNext,
-			HasPrevious:  pagination.HasPrevious,
-		},
+		Data:       contractInquiries,
+		Pagination: paginationInfo,
 	}
 
 	h.writeContractResponse(w, http.StatusOK, response, correlationCtx.CorrelationID)
@@ -59,6 +59,96 @@ func (s *ContractCompliantServer) RefreshToken(w http.ResponseWriter, r *http.Re
 	http.Error(w, "Not implemented", http.StatusNotImplemented)
 }
 
+func (s *ContractCompliantServer) GetCurrentUserSessions(w http.ResponseWriter, r *http.Request) {
+	// TODO: Delegate to auth handler
+	http.Error(w, "Not implemented", http.StatusNotImplemented)
+}
+
+func (s *ContractCompliantServer) RevokeCurrentUserSession(w http.ResponseWriter, r *http.Request, sessionId admin.SessionIdParam) {
+	// TODO: Delegate to auth handler
+	http.Error(w, "Not implemented", http.StatusNotImplemented)
+}
+
+func (s *ContractCompliantServer) ListContentSchedules(w http.ResponseWriter, r *http.Request, params admin.ListContentSchedulesParams) {
+	// TODO: Delegate to content scheduler
+	http.Error(w, "Not implemented", http.StatusNotImplemented)
+}
+
+func (s *ContractCompliantServer) CreateContentSchedule(w http.ResponseWriter, r *http.Request) {
+	// TODO: Delegate to content scheduler
+	http.Error(w, "Not implemented", http.StatusNotImplemented)
+}
+
+func (s *ContractCompliantServer) CancelContentSchedule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	// TODO: Delegate to content scheduler
+	http.Error(w, "Not implemented", http.StatusNotImplemented)
+}
+
+func (s *ContractCompliantServer) GetContentReview(w http.ResponseWriter, r *http.Request, entityType admin.ContentEntityTypeParam, id admin.ContentIdParam) {
+	// TODO: Delegate to content editorial reviews
+	http.Error(w, "Not implemented", http.StatusNotImplemented)
+}
+
+func (s *ContractCompliantServer) SubmitContentForReview(w http.ResponseWriter, r *http.Request, entityType admin.ContentEntityTypeParam, id admin.ContentIdParam) {
+	// TODO: Delegate to content editorial reviews
+	http.Error(w, "Not implemented", http.StatusNotImplemented)
+}
+
+func (s *ContractCompliantServer) AssignContentReviewers(w http.ResponseWriter, r *http.Request, entityType admin.ContentEntityTypeParam, id admin.ContentIdParam) {
+	// TODO: Delegate to content editorial reviews
+	http.Error(w, "Not implemented", http.StatusNotImplemented)
+}
+
+func (s *ContractCompliantServer) AddContentReviewComment(w http.ResponseWriter, r *http.Request, entityType admin.ContentEntityTypeParam, id admin.ContentIdParam) {
+	// TODO: Delegate to content editorial reviews
+	http.Error(w, "Not implemented", http.StatusNotImplemented)
+}
+
+func (s *ContractCompliantServer) ApproveContent(w http.ResponseWriter, r *http.Request, entityType admin.ContentEntityTypeParam, id admin.ContentIdParam) {
+	// TODO: Delegate to content editorial reviews
+	http.Error(w, "Not implemented", http.StatusNotImplemented)
+}
+
+func (s *ContractCompliantServer) RejectContent(w http.ResponseWriter, r *http.Request, entityType admin.ContentEntityTypeParam, id admin.ContentIdParam) {
+	// TODO: Delegate to content editorial reviews
+	http.Error(w, "Not implemented", http.StatusNotImplemented)
+}
+
+func (s *ContractCompliantServer) ListMedia(w http.ResponseWriter, r *http.Request, params admin.ListMediaParams) {
+	// TODO: Delegate to content media library
+	http.Error(w, "Not implemented", http.StatusNotImplemented)
+}
+
+func (s *ContractCompliantServer) UploadMedia(w http.ResponseWriter, r *http.Request) {
+	// TODO: Delegate to content media library
+	http.Error(w, "Not implemented", http.StatusNotImplemented)
+}
+
+func (s *ContractCompliantServer) GetMedia(w http.ResponseWriter, r *http.Request, id admin.MediaIdParam) {
+	// TODO: Delegate to content media library
+	http.Error(w, "Not implemented", http.StatusNotImplemented)
+}
+
+func (s *ContractCompliantServer) DeleteMedia(w http.ResponseWriter, r *http.Request, id admin.MediaIdParam) {
+	// TODO: Delegate to content media library
+	http.Error(w, "Not implemented", http.StatusNotImplemented)
+}
+
+func (s *ContractCompliantServer) LinkMedia(w http.ResponseWriter, r *http.Request, id admin.MediaIdParam) {
+	// TODO: Delegate to content media library
+	http.Error(w, "Not implemented", http.StatusNotImplemented)
+}
+
+func (s *ContractCompliantServer) UnlinkMedia(w http.ResponseWriter, r *http.Request, id admin.MediaIdParam, entityType admin.ContentEntityType, entityId openapi_types.UUID) {
+	// TODO: Delegate to content media library
+	http.Error(w, "Not implemented", http.StatusNotImplemented)
+}
+
+func (s *ContractCompliantServer) GetMediaSignedUrl(w http.ResponseWriter, r *http.Request, id admin.MediaIdParam, params admin.GetMediaSignedUrlParams) {
+	// TODO: Delegate to content media library
+	http.Error(w, "Not implemented", http.StatusNotImplemented)
+}
+
 func (s *ContractCompliantServer) GetEventsAdmin(w http.ResponseWriter, r *http.Request, params admin.GetEventsAdminParams) {
 	// TODO: Delegate to events handler
 	http.Error(w, "Not implemented", http.StatusNotImplemented)
@@ -104,7 +194,7 @@ func (s *ContractCompliantServer) GetNewsArticleByIdAdmin(w http.ResponseWriter,
 	http.Error(w, "Not implemented", http.StatusNotImplemented)
 }
 
-func (s *ContractCompliantServer) UpdateNewsArticle(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+func (s *ContractCompliantServer) UpdateNewsArticle(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params admin.UpdateNewsArticleParams) {
 	// TODO: Delegate to news handler
 	http.Error(w, "Not implemented", http.StatusNotImplemented)
 }
@@ -174,6 +264,21 @@ func (s *ContractCompliantServer) UpdateAdminUser(w http.ResponseWriter, r *http
 	http.Error(w, "Not implemented", http.StatusNotImplemented)
 }
 
+func (s *ContractCompliantServer) GetAdminUserSessions(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	// TODO: Delegate to user management handler
+	http.Error(w, "Not implemented", http.StatusNotImplemented)
+}
+
+func (s *ContractCompliantServer) RevokeAdminUserSessions(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	// TODO: Delegate to user management handler
+	http.Error(w, "Not implemented", http.StatusNotImplemented)
+}
+
+func (s *ContractCompliantServer) RevokeAdminUserSession(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, sessionId admin.SessionIdParam) {
+	// TODO: Delegate to user management handler
+	http.Error(w, "Not implemented", http.StatusNotImplemented)
+}
+
 // RegisterContractRoutes registers the contract-compliant routes using the generated router
 func RegisterContractRoutes(router *mux.Router, mediaService *media.MediaService) {
 	server := NewContractCompliantServer(mediaService)
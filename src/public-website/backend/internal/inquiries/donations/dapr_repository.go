@@ -3,6 +3,7 @@ package donations
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
@@ -17,10 +18,60 @@ type DonationsRepository struct {
 	pubsub     *dapr.PubSub
 }
 
+// Secondary indexes maintained for donations inquiries
+const (
+	donationsIndexStatus       = "status"
+	donationsIndexPriority     = "priority"
+	donationsIndexDonorType    = "donor_type"
+	donationsIndexInterestArea = "interest_area"
+	donationsIndexAmountRange  = "amount_range"
+	donationsIndexIsDeleted    = "is_deleted"
+)
+
+// DonationsInquiryIndexes declares the secondary indexes maintained for donations inquiries
+func DonationsInquiryIndexes() *dapr.IndexedEntityType {
+	return &dapr.IndexedEntityType{
+		Domain:     "donations",
+		EntityType: "inquiry",
+		Indexes: []dapr.IndexDefinition{
+			{Name: donationsIndexStatus, Extract: func(entity interface{}) []string {
+				return []string{string(entity.(*DonationsInquiry).Status)}
+			}},
+			{Name: donationsIndexPriority, Extract: func(entity interface{}) []string {
+				return []string{string(entity.(*DonationsInquiry).Priority)}
+			}},
+			{Name: donationsIndexDonorType, Extract: func(entity interface{}) []string {
+				return []string{string(entity.(*DonationsInquiry).DonorType)}
+			}},
+			{Name: donationsIndexInterestArea, Extract: func(entity interface{}) []string {
+				if area := entity.(*DonationsInquiry).InterestArea; area != nil {
+					return []string{string(*area)}
+				}
+				return nil
+			}},
+			{Name: donationsIndexAmountRange, Extract: func(entity interface{}) []string {
+				if amountRange := entity.(*DonationsInquiry).PreferredAmountRange; amountRange != nil {
+					return []string{string(*amountRange)}
+				}
+				return nil
+			}},
+			{Name: donationsIndexIsDeleted, Extract: func(entity interface{}) []string {
+				return []string{dapr.IndexBool(entity.(*DonationsInquiry).IsDeleted)}
+			}},
+		},
+		NewEntity: func() interface{} { return &DonationsInquiry{} },
+		EntityID:  func(entity interface{}) string { return entity.(*DonationsInquiry).InquiryID },
+	}
+}
+
 // NewDonationsRepository creates a new donations repository
 func NewDonationsRepository(client *dapr.Client) *DonationsRepository {
+	stateStore := dapr.NewStateStore(client)
+	stateStore.MustRegisterIndexes(DonationsInquiryIndexes())
+	stateStore.MustRegisterIndexes(dapr.OutboxMessageIndexes())
+
 	return &DonationsRepository{
-		stateStore: dapr.NewStateStore(client),
+		stateStore: stateStore,
 		bindings:   dapr.NewBindings(client),
 		pubsub:     dapr.NewPubSub(client),
 	}
@@ -28,102 +79,17 @@ func NewDonationsRepository(client *dapr.Client) *DonationsRepository {
 
 // Donations inquiry operations
 
-// SaveInquiry saves donations inquiry to Dapr state store
-func (r *DonationsRepository) SaveInquiry(ctx context.Context, inquiry *DonationsInquiry) error {
-	key := r.stateStore.CreateKey("donations", "inquiry", inquiry.InquiryID)
-	
-	err := r.stateStore.Save(ctx, key, inquiry, nil)
+// SaveInquiry saves donations inquiry and its secondary indexes to Dapr state store, together
+// with the audit event describing the change
+func (r *DonationsRepository) SaveInquiry(ctx context.Context, inquiry *DonationsInquiry, audit *domain.AuditEvent) error {
+	messages, err := r.auditMessages(ctx, audit)
 	if err != nil {
-		return fmt.Errorf("failed to save donations inquiry %s: %w", inquiry.InquiryID, err)
-	}
-
-	// Create index for status search
-	statusKey := r.stateStore.CreateIndexKey("donations", "inquiry", "status", string(inquiry.Status))
-	statusIndex := map[string]string{"inquiry_id": inquiry.InquiryID}
-	
-	err = r.stateStore.Save(ctx, statusKey, statusIndex, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create status index for donations inquiry %s: %w", inquiry.InquiryID, err)
-	}
-
-	// Create index for priority search
-	priorityKey := r.stateStore.CreateIndexKey("donations", "inquiry", "priority", string(inquiry.Priority))
-	priorityIndex := map[string]string{"inquiry_id": inquiry.InquiryID}
-	
-	err = r.stateStore.Save(ctx, priorityKey, priorityIndex, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create priority index for donations inquiry %s: %w", inquiry.InquiryID, err)
-	}
-
-	// Create index for donor type search
-	donorTypeKey := r.stateStore.CreateIndexKey("donations", "inquiry", "donor_type", string(inquiry.DonorType))
-	donorTypeIndex := map[string]string{"inquiry_id": inquiry.InquiryID}
-	
-	err = r.stateStore.Save(ctx, donorTypeKey, donorTypeIndex, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create donor type index for donations inquiry %s: %w", inquiry.InquiryID, err)
-	}
-
-	// Create index for interest area search (if interest area is provided)
-	if inquiry.InterestArea != nil {
-		interestKey := r.stateStore.CreateIndexKey("donations", "inquiry", "interest_area", string(*inquiry.InterestArea))
-		interestIndex := map[string]string{"inquiry_id": inquiry.InquiryID}
-		
-		err = r.stateStore.Save(ctx, interestKey, interestIndex, nil)
-		if err != nil {
-			return fmt.Errorf("failed to create interest area index for donations inquiry %s: %w", inquiry.InquiryID, err)
-		}
-	}
-
-	// Create index for amount range search (if amount range is provided)
-	if inquiry.PreferredAmountRange != nil {
-		amountKey := r.stateStore.CreateIndexKey("donations", "inquiry", "amount_range", string(*inquiry.PreferredAmountRange))
-		amountIndex := map[string]string{"inquiry_id": inquiry.InquiryID}
-		
-		err = r.stateStore.Save(ctx, amountKey, amountIndex, nil)
-		if err != nil {
-			return fmt.Errorf("failed to create amount range index for donations inquiry %s: %w", inquiry.InquiryID, err)
-		}
-	}
-
-	// Create index for donation frequency search (if donation frequency is provided)
-	if inquiry.DonationFrequency != nil {
-		frequencyKey := r.stateStore.CreateIndexKey("donations", "inquiry", "donation_frequency", string(*inquiry.DonationFrequency))
-		frequencyIndex := map[string]string{"inquiry_id": inquiry.InquiryID}
-		
-		err = r.stateStore.Save(ctx, frequencyKey, frequencyIndex, nil)
-		if err != nil {
-			return fmt.Errorf("failed to create donation frequency index for donations inquiry %s: %w", inquiry.InquiryID, err)
-		}
-	}
-
-	// Create index for organization search (if organization is provided)
-	if inquiry.Organization != nil && *inquiry.Organization != "" {
-		orgKey := r.stateStore.CreateIndexKey("donations", "inquiry", "organization", *inquiry.Organization)
-		orgIndex := map[string]string{"inquiry_id": inquiry.InquiryID}
-		
-		err = r.stateStore.Save(ctx, orgKey, orgIndex, nil)
-		if err != nil {
-			return fmt.Errorf("failed to create organization index for donations inquiry %s: %w", inquiry.InquiryID, err)
-		}
-	}
-
-	// Create index for email search
-	emailKey := r.stateStore.CreateIndexKey("donations", "inquiry", "email", inquiry.Email)
-	emailIndex := map[string]string{"inquiry_id": inquiry.InquiryID}
-	
-	err = r.stateStore.Save(ctx, emailKey, emailIndex, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create email index for donations inquiry %s: %w", inquiry.InquiryID, err)
+		return err
 	}
 
-	// Create index for creation date search
-	dateKey := r.stateStore.CreateIndexKey("donations", "inquiry", "created_date", inquiry.CreatedAt.Format("2006-01-02"))
-	dateIndex := map[string]string{"inquiry_id": inquiry.InquiryID}
-	
-	err = r.stateStore.Save(ctx, dateKey, dateIndex, nil)
+	err = r.stateStore.SaveIndexedWithOutbox(ctx, "donations", "inquiry", inquiry.InquiryID, inquiry, "", messages...)
 	if err != nil {
-		return fmt.Errorf("failed to create date index for donations inquiry %s: %w", inquiry.InquiryID, err)
+		return fmt.Errorf("failed to save donations inquiry %s: %w", inquiry.InquiryID, err)
 	}
 
 	return nil
@@ -152,7 +118,7 @@ func (r *DonationsRepository) GetInquiry(ctx context.Context, inquiryID string)
 }
 
 // DeleteInquiry soft deletes a donations inquiry
-func (r *DonationsRepository) DeleteInquiry(ctx context.Context, inquiryID string, userID string) error {
+func (r *DonationsRepository) DeleteInquiry(ctx context.Context, inquiryID string, userID string, audit *domain.AuditEvent) error {
 	inquiry, err := r.GetInquiry(ctx, inquiryID)
 	if err != nil {
 		return err
@@ -164,42 +130,123 @@ func (r *DonationsRepository) DeleteInquiry(ctx context.Context, inquiryID strin
 	inquiry.UpdatedBy = userID
 	inquiry.UpdatedAt = now
 
-	return r.SaveInquiry(ctx, inquiry)
+	return r.SaveInquiry(ctx, inquiry, audit)
 }
 
-// ListInquiries retrieves donations inquiries with filtering
+// ListInquiries retrieves donations inquiries matching the filters, sorted and windowed by limit/offset
 func (r *DonationsRepository) ListInquiries(ctx context.Context, filters InquiryFilters) ([]*DonationsInquiry, error) {
-	// For this minimal GREEN phase implementation, return empty list
-	// In a production system, this would use proper database queries or indexed searches
-	// This will be improved in the REFACTOR phase
-	
-	return []*DonationsInquiry{}, nil
+	inquiries, err := r.findInquiries(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	windowed, _ := ApplyInquiryFilters(inquiries, filters)
+	return windowed, nil
+}
+
+// CountInquiries returns the total number of donations inquiries matching the filters, ignoring limit/offset
+func (r *DonationsRepository) CountInquiries(ctx context.Context, filters InquiryFilters) (int, error) {
+	inquiries, err := r.findInquiries(ctx, filters)
+	if err != nil {
+		return 0, err
+	}
+
+	_, total := ApplyInquiryFilters(inquiries, filters)
+	return total, nil
 }
 
-// PublishAuditEvent publishes an audit event for compliance logging
-func (r *DonationsRepository) PublishAuditEvent(ctx context.Context, entityType domain.EntityType, entityID string, operationType domain.AuditEventType, userID string, beforeData, afterData interface{}) error {
+// findInquiries narrows candidates with the equality indexes and loads them; range
+// filters, sorting and windowing are applied by ApplyInquiryFilters
+func (r *DonationsRepository) findInquiries(ctx context.Context, filters InquiryFilters) ([]*DonationsInquiry, error) {
+	if err := filters.Validate(); err != nil {
+		return nil, err
+	}
+
+	conditions := []dapr.IndexCondition{
+		{Index: donationsIndexIsDeleted, Value: dapr.IndexBool(false)},
+	}
+	if filters.Status != nil {
+		conditions = append(conditions, dapr.IndexCondition{Index: donationsIndexStatus, Value: string(*filters.Status)})
+	}
+	if filters.Priority != nil {
+		conditions = append(conditions, dapr.IndexCondition{Index: donationsIndexPriority, Value: string(*filters.Priority)})
+	}
+	if filters.DonorType != nil {
+		conditions = append(conditions, dapr.IndexCondition{Index: donationsIndexDonorType, Value: string(*filters.DonorType)})
+	}
+	if filters.InterestArea != nil {
+		conditions = append(conditions, dapr.IndexCondition{Index: donationsIndexInterestArea, Value: string(*filters.InterestArea)})
+	}
+	if filters.AmountRange != nil {
+		conditions = append(conditions, dapr.IndexCondition{Index: donationsIndexAmountRange, Value: string(*filters.AmountRange)})
+	}
+
+	ids, err := r.stateStore.LookupIndex(ctx, "donations", "inquiry", conditions...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up donations inquiries: %w", err)
+	}
+
+	return r.getInquiriesByIDs(ctx, ids)
+}
+
+// getInquiriesByIDs bulk loads donations inquiries, skipping missing and deleted records
+func (r *DonationsRepository) getInquiriesByIDs(ctx context.Context, ids []string) ([]*DonationsInquiry, error) {
+	inquiries := make([]*DonationsInquiry, 0, len(ids))
+	if len(ids) == 0 {
+		return inquiries, nil
+	}
+
+	keys := make([]string, 0, len(ids))
+	targets := make(map[string]interface{}, len(ids))
+	loaded := make([]*DonationsInquiry, 0, len(ids))
+	for _, id := range ids {
+		key := r.stateStore.CreateKey("donations", "inquiry", id)
+		inquiry := &DonationsInquiry{}
+		keys = append(keys, key)
+		targets[key] = inquiry
+		loaded = append(loaded, inquiry)
+	}
+
+	if err := r.stateStore.GetBulk(ctx, keys, targets); err != nil {
+		return nil, fmt.Errorf("failed to load indexed donations inquiries: %w", err)
+	}
+
+	for _, inquiry := range loaded {
+		if inquiry.InquiryID != "" && !inquiry.IsDeleted {
+			inquiries = append(inquiries, inquiry)
+		}
+	}
+
+	return inquiries, nil
+}
+
+// PageDonationsInquiries orders inquiries newest first and returns the page following the requested cursor
+func PageDonationsInquiries(inquiries []*DonationsInquiry, page domain.PageRequest) ([]*DonationsInquiry, domain.PageInfo) {
+	sort.Slice(inquiries, func(i, j int) bool {
+		return inquiries[i].PageCursor().Before(inquiries[j].PageCursor())
+	})
+
+	start, end, info := domain.CursorPage(len(inquiries), func(i int) domain.PageCursor {
+		return inquiries[i].PageCursor()
+	}, page)
+
+	return inquiries[start:end], info
+}
+
+// auditMessages prepares the compliance audit event for a write so it commits through the
+// outbox with that write. A nil event prepares nothing.
+func (r *DonationsRepository) auditMessages(ctx context.Context, auditEvent *domain.AuditEvent) ([]*dapr.OutboxMessage, error) {
+	if auditEvent == nil {
+		return nil, nil
+	}
+
 	correlationID := domain.GetCorrelationID(ctx)
 	if correlationID == "" {
 		correlationID = uuid.New().String()
 	}
+	auditEvent.SetTraceContext(correlationID, domain.GetTraceID(ctx))
+	auditEvent.Environment = "development" // This should come from configuration
 
-	auditEvent := domain.AuditEvent{
-		AuditID:       uuid.New().String(), // Generate unique audit ID
-		EntityType:    entityType,
-		EntityID:      entityID,
-		OperationType: operationType,
-		AuditTime:     time.Now(),
-		UserID:        userID,
-		CorrelationID: correlationID,
-		TraceID:       domain.GetTraceID(ctx),
-		DataSnapshot: &domain.AuditDataSnapshot{
-			Before: beforeData,
-			After:  afterData,
-		},
-		Environment: "development", // This should come from configuration
-	}
-
-	// Publish to Grafana audit events topic
 	daprAuditEvent := &dapr.AuditEvent{
 		AuditID:       auditEvent.AuditID,
 		EntityType:    string(auditEvent.EntityType),
@@ -209,11 +256,13 @@ func (r *DonationsRepository) PublishAuditEvent(ctx context.Context, entityType
 		UserID:        auditEvent.UserID,
 		CorrelationID: auditEvent.CorrelationID,
 		TraceID:       auditEvent.TraceID,
-		DataSnapshot: map[string]interface{}{
+		Environment:   auditEvent.Environment,
+	}
+	if auditEvent.DataSnapshot != nil {
+		daprAuditEvent.DataSnapshot = map[string]interface{}{
 			"before": auditEvent.DataSnapshot.Before,
 			"after":  auditEvent.DataSnapshot.After,
-		},
-		Environment: auditEvent.Environment,
+		}
 	}
 
 	eventMessage := &dapr.EventMessage{
@@ -222,21 +271,16 @@ func (r *DonationsRepository) PublishAuditEvent(ctx context.Context, entityType
 			"audit_event": daprAuditEvent,
 		},
 		Metadata: map[string]string{
-			"entity_type":    string(entityType),
-			"operation_type": string(operationType),
-			"user_id":        userID,
+			"entity_type":    string(auditEvent.EntityType),
+			"operation_type": string(auditEvent.OperationType),
+			"user_id":        auditEvent.UserID,
 		},
 		ContentType: "application/json",
 		Source:      "donations-admin-api",
 		Type:        "audit.event",
-		Subject:     fmt.Sprintf("audit.%s.%s", string(entityType), string(operationType)),
+		Subject:     fmt.Sprintf("audit.%s.%s", string(auditEvent.EntityType), string(auditEvent.OperationType)),
 		Time:        time.Now(),
 	}
 
-	err := r.pubsub.PublishEvent(ctx, "audit-events", eventMessage)
-	if err != nil {
-		return fmt.Errorf("failed to publish donations inquiry audit event: %w", err)
-	}
-
-	return nil
+	return []*dapr.OutboxMessage{dapr.NewOutboxMessage(ctx, "audit-events", eventMessage)}, nil
 }
\ No newline at end of file
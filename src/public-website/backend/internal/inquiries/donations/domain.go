@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/mail"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -190,6 +191,11 @@ func NewDonationsInquiry(contactName, email, message string, donorType DonorType
 	return inquiry, nil
 }
 
+// PageCursor returns the position of the inquiry in cursor-paginated listings
+func (d *DonationsInquiry) PageCursor() domain.PageCursor {
+	return domain.PageCursor{Timestamp: d.CreatedAt, ID: d.InquiryID}
+}
+
 // Validate validates the donations inquiry data
 func (d *DonationsInquiry) Validate() error {
 	if d.InquiryID == "" {
@@ -491,6 +497,23 @@ type AdminUpdateInquiryRequest struct {
 	Message              *string `json:"message,omitempty"`
 }
 
+// InquirySortField selects the ordering applied to inquiry listings
+type InquirySortField string
+
+const (
+	InquirySortCreatedAt InquirySortField = "created_at" // newest first
+	InquirySortPriority  InquirySortField = "priority"   // most pressing priority first, then newest
+)
+
+func (f InquirySortField) IsValid() bool {
+	switch f {
+	case InquirySortCreatedAt, InquirySortPriority:
+		return true
+	default:
+		return false
+	}
+}
+
 // InquiryFilters represents filters for listing inquiries
 type InquiryFilters struct {
 	Status       *InquiryStatus    `json:"status,omitempty"`
@@ -498,7 +521,123 @@ type InquiryFilters struct {
 	DonorType    *DonorType        `json:"donor_type,omitempty"`
 	InterestArea *InterestArea     `json:"interest_area,omitempty"`
 	AmountRange  *AmountRange      `json:"amount_range,omitempty"`
+	CreatedFrom  *time.Time        `json:"created_from,omitempty"`
+	CreatedTo    *time.Time        `json:"created_to,omitempty"`
+	SortBy       *InquirySortField `json:"sort_by,omitempty"`
 	Limit        *int              `json:"limit,omitempty"`
 	Offset       *int              `json:"offset,omitempty"`
 }
 
+// Validate validates the filter values
+func (f InquiryFilters) Validate() error {
+	if f.Status != nil && !f.Status.IsValid() {
+		return domain.NewValidationFieldError("status", "invalid inquiry status")
+	}
+	if f.Priority != nil && !f.Priority.IsValid() {
+		return domain.NewValidationFieldError("priority", "invalid inquiry priority")
+	}
+	if f.DonorType != nil && !f.DonorType.IsValid() {
+		return domain.NewValidationFieldError("donor_type", "invalid donor type")
+	}
+	if f.InterestArea != nil && !f.InterestArea.IsValid() {
+		return domain.NewValidationFieldError("interest_area", "invalid interest area")
+	}
+	if f.AmountRange != nil && !f.AmountRange.IsValid() {
+		return domain.NewValidationFieldError("amount_range", "invalid amount range")
+	}
+	if f.SortBy != nil && !f.SortBy.IsValid() {
+		return domain.NewValidationFieldError("sort_by", "sort_by must be one of: created_at, priority")
+	}
+	if f.CreatedFrom != nil && f.CreatedTo != nil && f.CreatedTo.Before(*f.CreatedFrom) {
+		return domain.NewValidationFieldError("created_to", "created_to must not be before created_from")
+	}
+	return nil
+}
+
+// Matches reports whether a non-deleted inquiry satisfies every filter
+func (f InquiryFilters) Matches(inquiry *DonationsInquiry) bool {
+	if inquiry.IsDeleted {
+		return false
+	}
+	if f.Status != nil && inquiry.Status != *f.Status {
+		return false
+	}
+	if f.Priority != nil && inquiry.Priority != *f.Priority {
+		return false
+	}
+	if f.DonorType != nil && inquiry.DonorType != *f.DonorType {
+		return false
+	}
+	if f.InterestArea != nil && (inquiry.InterestArea == nil || *inquiry.InterestArea != *f.InterestArea) {
+		return false
+	}
+	if f.AmountRange != nil && (inquiry.PreferredAmountRange == nil || *inquiry.PreferredAmountRange != *f.AmountRange) {
+		return false
+	}
+	if f.CreatedFrom != nil && inquiry.CreatedAt.Before(*f.CreatedFrom) {
+		return false
+	}
+	if f.CreatedTo != nil && inquiry.CreatedAt.After(*f.CreatedTo) {
+		return false
+	}
+	return true
+}
+
+// ApplyInquiryFilters filters and sorts inquiries, returning the requested
+// limit/offset window together with the total number of matches
+func ApplyInquiryFilters(inquiries []*DonationsInquiry, filters InquiryFilters) ([]*DonationsInquiry, int) {
+	matched := make([]*DonationsInquiry, 0, len(inquiries))
+	for _, inquiry := range inquiries {
+		if filters.Matches(inquiry) {
+			matched = append(matched, inquiry)
+		}
+	}
+
+	sortBy := InquirySortCreatedAt
+	if filters.SortBy != nil {
+		sortBy = *filters.SortBy
+	}
+	SortInquiries(matched, sortBy)
+
+	total := len(matched)
+	start := 0
+	if filters.Offset != nil && *filters.Offset > 0 {
+		start = *filters.Offset
+	}
+	if start > total {
+		start = total
+	}
+	end := total
+	if filters.Limit != nil && *filters.Limit > 0 && start+*filters.Limit < total {
+		end = start + *filters.Limit
+	}
+
+	return matched[start:end], total
+}
+
+// SortInquiries orders inquiries in place, falling back to newest first for ties
+func SortInquiries(inquiries []*DonationsInquiry, sortBy InquirySortField) {
+	sort.SliceStable(inquiries, func(i, j int) bool {
+		a, b := inquiries[i], inquiries[j]
+		if sortBy == InquirySortPriority && priorityRank(a.Priority) != priorityRank(b.Priority) {
+			return priorityRank(a.Priority) > priorityRank(b.Priority)
+		}
+		return a.PageCursor().Before(b.PageCursor())
+	})
+}
+
+func priorityRank(priority InquiryPriority) int {
+	switch priority {
+	case InquiryPriorityUrgent:
+		return 4
+	case InquiryPriorityHigh:
+		return 3
+	case InquiryPriorityMedium:
+		return 2
+	case InquiryPriorityLow:
+		return 1
+	default:
+		return 0
+	}
+}
+
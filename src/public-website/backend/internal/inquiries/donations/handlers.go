@@ -1,6 +1,7 @@
 package donations
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"strconv"
@@ -214,6 +215,27 @@ func (h *DonationsHandler) ListInquiries(w http.ResponseWriter, r *http.Request)
 		}
 	}
 
+	if sortBy := r.URL.Query().Get("sort_by"); sortBy != "" {
+		sortField := InquirySortField(sortBy)
+		filters.SortBy = &sortField
+	}
+
+	var err error
+	if filters.CreatedFrom, err = parseTimeParam(r, "created_from"); err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+	if filters.CreatedTo, err = parseTimeParam(r, "created_to"); err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	// Offset and sorted requests keep the limit/offset listing
+	if filters.Offset == nil && filters.SortBy == nil {
+		h.listInquiriesPage(ctx, w, r, filters, userID)
+		return
+	}
+
 	// Call service method
 	inquiries, err := h.service.AdminListInquiries(ctx, filters, userID)
 	if err != nil {
@@ -221,10 +243,46 @@ func (h *DonationsHandler) ListInquiries(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	total, err := h.service.AdminCountInquiries(ctx, filters, userID)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
 	// Return inquiries
 	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
 		"inquiries":      inquiries,
 		"count":          len(inquiries),
+		"total":          total,
+		"correlation_id": correlationCtx.CorrelationID,
+	})
+}
+
+// listInquiriesPage returns the page of inquiries following the cursor query parameter
+func (h *DonationsHandler) listInquiriesPage(ctx context.Context, w http.ResponseWriter, r *http.Request, filters InquiryFilters, userID string) {
+	correlationCtx := domain.FromContext(ctx)
+
+	limit := 0
+	if filters.Limit != nil {
+		limit = *filters.Limit
+	}
+
+	page, err := domain.NewPageRequest(r.URL.Query().Get("cursor"), limit)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	inquiries, pageInfo, err := h.service.AdminListInquiriesPage(ctx, filters, page, userID)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
+		"inquiries":      inquiries,
+		"count":          len(inquiries),
+		"pagination":     pageInfo,
 		"correlation_id": correlationCtx.CorrelationID,
 	})
 }
@@ -362,6 +420,21 @@ func (h *DonationsHandler) SetPriority(w http.ResponseWriter, r *http.Request) {
 
 // Helper functions
 
+// parseTimeParam parses an optional RFC3339 timestamp query parameter
+func parseTimeParam(r *http.Request, name string) (*time.Time, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, domain.NewValidationFieldError(name, name+" must be an RFC3339 timestamp")
+	}
+
+	return &parsed, nil
+}
+
 // handleError handles domain errors and returns appropriate HTTP responses
 func (h *DonationsHandler) handleError(w http.ResponseWriter, r *http.Request, err error) {
 	correlationID := domain.GetCorrelationID(r.Context())
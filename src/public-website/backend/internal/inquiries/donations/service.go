@@ -10,11 +10,11 @@ import (
 
 // DonationsRepositoryInterface defines the interface for donations inquiry data operations
 type DonationsRepositoryInterface interface {
-	SaveInquiry(ctx context.Context, inquiry *DonationsInquiry) error
+	SaveInquiry(ctx context.Context, inquiry *DonationsInquiry, audit *domain.AuditEvent) error
 	GetInquiry(ctx context.Context, inquiryID string) (*DonationsInquiry, error)
-	DeleteInquiry(ctx context.Context, inquiryID string, userID string) error
+	DeleteInquiry(ctx context.Context, inquiryID string, userID string, audit *domain.AuditEvent) error
 	ListInquiries(ctx context.Context, filters InquiryFilters) ([]*DonationsInquiry, error)
-	PublishAuditEvent(ctx context.Context, entityType domain.EntityType, entityID string, operationType domain.AuditEventType, userID string, beforeData, afterData interface{}) error
+	CountInquiries(ctx context.Context, filters InquiryFilters) (int, error)
 }
 
 // DonationsService provides business logic for donations inquiry operations
@@ -100,14 +100,11 @@ func (s *DonationsService) AdminCreateInquiry(ctx context.Context, request Admin
 		inquiry.UserAgent = request.UserAgent
 	}
 
-	if err := s.repository.SaveInquiry(ctx, inquiry); err != nil {
+	audit := domain.NewAuditChange(domain.EntityTypeDonationsInquiry, inquiry.InquiryID, domain.AuditEventInsert, userID, nil, inquiry)
+	if err := s.repository.SaveInquiry(ctx, inquiry, audit); err != nil {
 		return nil, domain.NewInternalError("failed to save donations inquiry", err)
 	}
 
-	if err := s.repository.PublishAuditEvent(ctx, domain.EntityTypeDonationsInquiry, inquiry.InquiryID, domain.AuditEventInsert, userID, nil, inquiry); err != nil {
-		return nil, domain.NewInternalError("failed to publish audit event", err)
-	}
-
 	return inquiry, nil
 }
 
@@ -192,14 +189,11 @@ func (s *DonationsService) AdminUpdateInquiry(ctx context.Context, inquiryID str
 		return nil, err
 	}
 
-	if err := s.repository.SaveInquiry(ctx, inquiry); err != nil {
+	audit := domain.NewAuditChange(domain.EntityTypeDonationsInquiry, inquiry.InquiryID, domain.AuditEventUpdate, userID, beforeData, *inquiry)
+	if err := s.repository.SaveInquiry(ctx, inquiry, audit); err != nil {
 		return nil, domain.NewInternalError("failed to update donations inquiry", err)
 	}
 
-	if err := s.repository.PublishAuditEvent(ctx, domain.EntityTypeDonationsInquiry, inquiry.InquiryID, domain.AuditEventUpdate, userID, beforeData, *inquiry); err != nil {
-		return nil, domain.NewInternalError("failed to publish audit event", err)
-	}
-
 	return inquiry, nil
 }
 
@@ -216,14 +210,11 @@ func (s *DonationsService) AdminDeleteInquiry(ctx context.Context, inquiryID str
 
 	beforeData := *inquiry
 
-	if err := s.repository.DeleteInquiry(ctx, inquiryID, userID); err != nil {
+	audit := domain.NewAuditChange(domain.EntityTypeDonationsInquiry, inquiryID, domain.AuditEventDelete, userID, beforeData, nil)
+	if err := s.repository.DeleteInquiry(ctx, inquiryID, userID, audit); err != nil {
 		return domain.NewInternalError("failed to delete donations inquiry", err)
 	}
 
-	if err := s.repository.PublishAuditEvent(ctx, domain.EntityTypeDonationsInquiry, inquiryID, domain.AuditEventDelete, userID, beforeData, nil); err != nil {
-		return domain.NewInternalError("failed to publish audit event", err)
-	}
-
 	return nil
 }
 
@@ -248,14 +239,11 @@ func (s *DonationsService) AdminAcknowledgeInquiry(ctx context.Context, inquiryI
 		return nil, err
 	}
 
-	if err := s.repository.SaveInquiry(ctx, inquiry); err != nil {
+	audit := domain.NewAuditChange(domain.EntityTypeDonationsInquiry, inquiry.InquiryID, domain.AuditEventUpdate, userID, beforeData, *inquiry)
+	if err := s.repository.SaveInquiry(ctx, inquiry, audit); err != nil {
 		return nil, domain.NewInternalError("failed to acknowledge donations inquiry", err)
 	}
 
-	if err := s.repository.PublishAuditEvent(ctx, domain.EntityTypeDonationsInquiry, inquiry.InquiryID, domain.AuditEventUpdate, userID, beforeData, *inquiry); err != nil {
-		return nil, domain.NewInternalError("failed to publish audit event", err)
-	}
-
 	return inquiry, nil
 }
 
@@ -280,14 +268,11 @@ func (s *DonationsService) AdminResolveInquiry(ctx context.Context, inquiryID st
 		return nil, err
 	}
 
-	if err := s.repository.SaveInquiry(ctx, inquiry); err != nil {
+	audit := domain.NewAuditChange(domain.EntityTypeDonationsInquiry, inquiry.InquiryID, domain.AuditEventUpdate, userID, beforeData, *inquiry)
+	if err := s.repository.SaveInquiry(ctx, inquiry, audit); err != nil {
 		return nil, domain.NewInternalError("failed to resolve donations inquiry", err)
 	}
 
-	if err := s.repository.PublishAuditEvent(ctx, domain.EntityTypeDonationsInquiry, inquiry.InquiryID, domain.AuditEventUpdate, userID, beforeData, *inquiry); err != nil {
-		return nil, domain.NewInternalError("failed to publish audit event", err)
-	}
-
 	return inquiry, nil
 }
 
@@ -308,14 +293,11 @@ func (s *DonationsService) AdminCloseInquiry(ctx context.Context, inquiryID stri
 		return nil, err
 	}
 
-	if err := s.repository.SaveInquiry(ctx, inquiry); err != nil {
+	audit := domain.NewAuditChange(domain.EntityTypeDonationsInquiry, inquiry.InquiryID, domain.AuditEventUpdate, userID, beforeData, *inquiry)
+	if err := s.repository.SaveInquiry(ctx, inquiry, audit); err != nil {
 		return nil, domain.NewInternalError("failed to close donations inquiry", err)
 	}
 
-	if err := s.repository.PublishAuditEvent(ctx, domain.EntityTypeDonationsInquiry, inquiry.InquiryID, domain.AuditEventUpdate, userID, beforeData, *inquiry); err != nil {
-		return nil, domain.NewInternalError("failed to publish audit event", err)
-	}
-
 	return inquiry, nil
 }
 
@@ -336,14 +318,11 @@ func (s *DonationsService) AdminSetPriority(ctx context.Context, inquiryID strin
 		return nil, err
 	}
 
-	if err := s.repository.SaveInquiry(ctx, inquiry); err != nil {
+	audit := domain.NewAuditChange(domain.EntityTypeDonationsInquiry, inquiry.InquiryID, domain.AuditEventUpdate, userID, beforeData, *inquiry)
+	if err := s.repository.SaveInquiry(ctx, inquiry, audit); err != nil {
 		return nil, domain.NewInternalError("failed to set inquiry priority", err)
 	}
 
-	if err := s.repository.PublishAuditEvent(ctx, domain.EntityTypeDonationsInquiry, inquiry.InquiryID, domain.AuditEventUpdate, userID, beforeData, *inquiry); err != nil {
-		return nil, domain.NewInternalError("failed to publish audit event", err)
-	}
-
 	return inquiry, nil
 }
 
@@ -353,6 +332,10 @@ func (s *DonationsService) AdminListInquiries(ctx context.Context, filters Inqui
 		return nil, domain.NewUnauthorizedError("admin privileges required to list donations inquiries")
 	}
 
+	if err := filters.Validate(); err != nil {
+		return nil, err
+	}
+
 	inquiries, err := s.repository.ListInquiries(ctx, filters)
 	if err != nil {
 		return nil, domain.NewInternalError("failed to list donations inquiries", err)
@@ -361,6 +344,47 @@ func (s *DonationsService) AdminListInquiries(ctx context.Context, filters Inqui
 	return inquiries, nil
 }
 
+// AdminCountInquiries counts donations inquiries matching the filters, ignoring limit/offset (admin only)
+func (s *DonationsService) AdminCountInquiries(ctx context.Context, filters InquiryFilters, userID string) (int, error) {
+	if !IsAdminUser(userID) {
+		return 0, domain.NewUnauthorizedError("admin privileges required to count donations inquiries")
+	}
+
+	if err := filters.Validate(); err != nil {
+		return 0, err
+	}
+
+	total, err := s.repository.CountInquiries(ctx, filters)
+	if err != nil {
+		return 0, domain.NewInternalError("failed to count donations inquiries", err)
+	}
+
+	return total, nil
+}
+
+// AdminListInquiriesPage lists the page of donations inquiries following the requested cursor (admin only)
+func (s *DonationsService) AdminListInquiriesPage(ctx context.Context, filters InquiryFilters, page domain.PageRequest, userID string) ([]*DonationsInquiry, domain.PageInfo, error) {
+	if !IsAdminUser(userID) {
+		return nil, domain.PageInfo{}, domain.NewUnauthorizedError("admin privileges required to list donations inquiries")
+	}
+
+	if err := filters.Validate(); err != nil {
+		return nil, domain.PageInfo{}, err
+	}
+
+	// Cursor paging replaces limit/offset windowing and sort order
+	filters.Limit = nil
+	filters.Offset = nil
+
+	inquiries, err := s.repository.ListInquiries(ctx, filters)
+	if err != nil {
+		return nil, domain.PageInfo{}, domain.NewInternalError("failed to list donations inquiries", err)
+	}
+
+	paged, info := PageDonationsInquiries(inquiries, page)
+	return paged, info, nil
+}
+
 // AdminGetInquiry retrieves a specific donations inquiry (admin only)
 func (s *DonationsService) AdminGetInquiry(ctx context.Context, inquiryID string, userID string) (*DonationsInquiry, error) {
 	if !IsAdminUser(userID) {
@@ -1,6 +1,7 @@
 package inquiries
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -24,6 +25,8 @@ type InquiriesHandler struct {
 	volunteersHandler     *volunteers.VolunteerHandler
 	contractCompliantServer *ContractCompliantServer
 	mediaService          *media.MediaService
+	outbox                *dapr.OutboxRelay
+	featureFlags          *dapr.FeatureFlagService
 }
 
 // NewInquiriesHandler creates a new consolidated inquiries handler
@@ -67,11 +70,33 @@ func NewInquiriesHandler(client *dapr.Client) (*InquiriesHandler, error) {
 		volunteersHandler:     volunteersHandler,
 		contractCompliantServer: contractCompliantServer,
 		mediaService:          mediaService,
+		outbox:                dapr.NewOutboxRelay(stateStore, pubsub),
+		featureFlags:          dapr.NewFeatureFlagService(stateStore, pubsub, client.GetEnvironment()),
 	}, nil
 }
 
+// RunOutboxRelay publishes the audit events committed by the inquiry repositories until
+// ctx is cancelled
+func (h *InquiriesHandler) RunOutboxRelay(ctx context.Context) {
+	h.outbox.Run(ctx)
+}
+
+// FeatureFlagListener loads the feature flags defined in the configuration store
+func (h *InquiriesHandler) FeatureFlagListener() dapr.ConfigListener {
+	return h.featureFlags.ConfigListener()
+}
+
+// RunFeatureFlagRefresh picks up the flags operators manage through the content admin
+// API until ctx is cancelled
+func (h *InquiriesHandler) RunFeatureFlagRefresh(ctx context.Context) {
+	h.featureFlags.RunRefresh(ctx)
+}
+
 // RegisterRoutes registers all inquiries domain routes with the router
 func (h *InquiriesHandler) RegisterRoutes(router *mux.Router) {
+	// Inquiry handlers read the caller's feature flags from the request context
+	router.Use(h.featureFlags.Middleware())
+
 	// Apply contract validation middleware to admin routes
 	adminRouter := router.PathPrefix("/admin/api/v1").Subrouter()
 	adminMiddleware := middleware.AdminAPIMiddleware()
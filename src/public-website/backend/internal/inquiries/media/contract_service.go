@@ -2,6 +2,7 @@ package media
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
@@ -13,12 +14,23 @@ func (s *MediaService) AdminListInquiries(ctx context.Context, params ListInquir
 		return nil, PaginationResult{}, domain.NewUnauthorizedError("admin privileges required")
 	}
 
-	// Convert contract params to internal filters
-	filters := InquiryFilters{}
-	
-	if params.Status != "" {
-		status := InquiryStatus(params.Status)
-		filters.Status = &status
+	if params.InquiryType != "" && params.InquiryType != "media" {
+		return nil, PaginationResult{}, domain.NewValidationFieldError("inquiry_type", "only media inquiries are listed by this service")
+	}
+	if params.Limit <= 0 {
+		return nil, PaginationResult{}, domain.NewValidationFieldError("limit", "limit must be greater than zero")
+	}
+	if params.Cursor == "" && params.Page < 1 {
+		return nil, PaginationResult{}, domain.NewValidationFieldError("page", "page must be at least 1")
+	}
+
+	filters := params.toFilters()
+	if err := filters.Validate(); err != nil {
+		return nil, PaginationResult{}, err
+	}
+
+	if params.Cursor != "" {
+		return s.adminListInquiriesByCursor(ctx, params, filters)
 	}
 	
 	// Set pagination (convert page-based to offset-based)
@@ -32,14 +44,18 @@ func (s *MediaService) AdminListInquiries(ctx context.Context, params ListInquir
 		return nil, PaginationResult{}, err
 	}
 
+	totalItems, err := s.repository.CountInquiries(ctx, filters)
+	if err != nil {
+		return nil, PaginationResult{}, err
+	}
+
 	// Convert to contract-compliant format
 	inquiries := make([]Inquiry, len(mediaInquiries))
 	for i, mi := range mediaInquiries {
 		inquiries[i] = s.ConvertToContract(mi)
 	}
 
-	// Calculate pagination (simplified for demo)
-	totalItems := len(mediaInquiries)
+	// Calculate pagination
 	totalPages := (totalItems + params.Limit - 1) / params.Limit
 	if totalPages == 0 {
 		totalPages = 1
@@ -57,6 +73,85 @@ func (s *MediaService) AdminListInquiries(ctx context.Context, params ListInquir
 	return inquiries, pagination, nil
 }
 
+// toFilters converts the contract parameters to repository filters. Pagination
+// is left to the caller because cursor and page listings window differently.
+func (p ListInquiriesParams) toFilters() InquiryFilters {
+	filters := InquiryFilters{
+		DeadlineFrom: p.DeadlineFrom,
+		DeadlineTo:   p.DeadlineTo,
+		CreatedFrom:  p.CreatedFrom,
+		CreatedTo:    p.CreatedTo,
+	}
+
+	if p.Status != "" {
+		status := InquiryStatus(p.Status)
+		filters.Status = &status
+	}
+	if p.Priority != "" {
+		priority := InquiryPriority(p.Priority)
+		filters.Priority = &priority
+	}
+	if p.Urgency != "" {
+		urgency := InquiryUrgency(p.Urgency)
+		filters.Urgency = &urgency
+	}
+	if p.MediaType != "" {
+		mediaType := MediaType(p.MediaType)
+		filters.MediaType = &mediaType
+	}
+	if p.Outlet != "" {
+		outlet := p.Outlet
+		filters.Outlet = &outlet
+	}
+	if search := strings.TrimSpace(p.Search); search != "" {
+		filters.Search = &search
+	}
+	if p.SortBy != "" {
+		sortBy := InquirySortField(p.SortBy)
+		filters.SortBy = &sortBy
+	}
+
+	return filters
+}
+
+// adminListInquiriesByCursor lists the page of inquiries following params.Cursor.
+// Cursor pages are always ordered newest first, so params.SortBy does not apply.
+func (s *MediaService) adminListInquiriesByCursor(ctx context.Context, params ListInquiriesParams, filters InquiryFilters) ([]Inquiry, PaginationResult, error) {
+	page, err := domain.NewPageRequest(params.Cursor, params.Limit)
+	if err != nil {
+		return nil, PaginationResult{}, err
+	}
+
+	mediaInquiries, err := s.repository.ListInquiries(ctx, filters)
+	if err != nil {
+		return nil, PaginationResult{}, err
+	}
+
+	paged, info := PageMediaInquiries(mediaInquiries, page)
+
+	inquiries := make([]Inquiry, len(paged))
+	for i, mi := range paged {
+		inquiries[i] = s.ConvertToContract(mi)
+	}
+
+	totalPages := (info.Total + info.Limit - 1) / info.Limit
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	pagination := PaginationResult{
+		CurrentPage:  params.Page,
+		TotalPages:   totalPages,
+		TotalItems:   info.Total,
+		ItemsPerPage: info.Limit,
+		HasNext:      info.HasMore,
+		HasPrevious:  true,
+		NextCursor:   info.NextCursor,
+	}
+
+	return inquiries, pagination, nil
+}
+
 // AdminUpdateInquiryStatus updates inquiry status in a contract-compliant way
 func (s *MediaService) AdminUpdateInquiryStatus(ctx context.Context, inquiryID string, request AdminUpdateInquiryStatusRequest, userID string) (*Inquiry, error) {
 	if !IsAdminUser(userID) {
@@ -82,7 +177,7 @@ func (s *MediaService) AdminUpdateInquiryStatus(ctx context.Context, inquiryID s
 	// In a full implementation, you'd extend MediaInquiry or create a separate notes table
 
 	// Save updated inquiry
-	err = s.repository.SaveInquiry(ctx, mediaInquiry)
+	err = s.repository.SaveInquiry(ctx, mediaInquiry, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -8,9 +8,20 @@ import (
 type ListInquiriesParams struct {
 	Page        int    `json:"page"`
 	Limit       int    `json:"limit"`
+	Cursor      string `json:"cursor,omitempty"`
 	Search      string `json:"search,omitempty"`
 	InquiryType string `json:"inquiry_type,omitempty"`
 	Status      string `json:"status,omitempty"`
+	SortBy      string `json:"sort_by,omitempty"`
+	Priority    string `json:"priority,omitempty"`
+	Urgency     string `json:"urgency,omitempty"`
+	MediaType   string `json:"media_type,omitempty"`
+	Outlet      string `json:"outlet,omitempty"`
+
+	DeadlineFrom *time.Time `json:"deadline_from,omitempty"`
+	DeadlineTo   *time.Time `json:"deadline_to,omitempty"`
+	CreatedFrom  *time.Time `json:"created_from,omitempty"`
+	CreatedTo    *time.Time `json:"created_to,omitempty"`
 }
 
 // AdminUpdateInquiryStatusRequest represents a request to update inquiry status
@@ -22,12 +33,13 @@ type AdminUpdateInquiryStatusRequest struct {
 
 // PaginationResult represents pagination information
 type PaginationResult struct {
-	CurrentPage  int  `json:"current_page"`
-	TotalPages   int  `json:"total_pages"`
-	TotalItems   int  `json:"total_items"`
-	ItemsPerPage int  `json:"items_per_page"`
-	HasNext      bool `json:"has_next"`
-	HasPrevious  bool `json:"has_previous"`
+	CurrentPage  int    `json:"current_page"`
+	TotalPages   int    `json:"total_pages"`
+	TotalItems   int    `json:"total_items"`
+	ItemsPerPage int    `json:"items_per_page"`
+	HasNext      bool   `json:"has_next"`
+	HasPrevious  bool   `json:"has_previous"`
+	NextCursor   string `json:"next_cursor,omitempty"`
 }
 
 // Inquiry represents a contract-compliant inquiry for API responses
@@ -43,4 +55,4 @@ type Inquiry struct {
 	ModifiedOn     time.Time `json:"modified_on"`
 	Notes          *string   `json:"notes,omitempty"`
 	AssignedTo     *string   `json:"assigned_to,omitempty"`
-}
\ No newline at end of file
+}
@@ -3,6 +3,8 @@ package media
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
@@ -17,10 +19,57 @@ type MediaRepository struct {
 	pubsub     *dapr.PubSub
 }
 
+// Secondary indexes maintained for media inquiries
+const (
+	mediaIndexStatus    = "status"
+	mediaIndexPriority  = "priority"
+	mediaIndexUrgency   = "urgency"
+	mediaIndexMediaType = "media_type"
+	mediaIndexOutlet    = "outlet"
+	mediaIndexIsDeleted = "is_deleted"
+)
+
+// MediaInquiryIndexes declares the secondary indexes maintained for media inquiries
+func MediaInquiryIndexes() *dapr.IndexedEntityType {
+	return &dapr.IndexedEntityType{
+		Domain:     "media",
+		EntityType: "inquiry",
+		Indexes: []dapr.IndexDefinition{
+			{Name: mediaIndexStatus, Extract: func(entity interface{}) []string {
+				return []string{string(entity.(*MediaInquiry).Status)}
+			}},
+			{Name: mediaIndexPriority, Extract: func(entity interface{}) []string {
+				return []string{string(entity.(*MediaInquiry).Priority)}
+			}},
+			{Name: mediaIndexUrgency, Extract: func(entity interface{}) []string {
+				return []string{string(entity.(*MediaInquiry).Urgency)}
+			}},
+			{Name: mediaIndexMediaType, Extract: func(entity interface{}) []string {
+				if mediaType := entity.(*MediaInquiry).MediaType; mediaType != nil {
+					return []string{string(*mediaType)}
+				}
+				return nil
+			}},
+			{Name: mediaIndexOutlet, Extract: func(entity interface{}) []string {
+				return []string{strings.ToLower(entity.(*MediaInquiry).Outlet)}
+			}},
+			{Name: mediaIndexIsDeleted, Extract: func(entity interface{}) []string {
+				return []string{dapr.IndexBool(entity.(*MediaInquiry).IsDeleted)}
+			}},
+		},
+		NewEntity: func() interface{} { return &MediaInquiry{} },
+		EntityID:  func(entity interface{}) string { return entity.(*MediaInquiry).InquiryID },
+	}
+}
+
 // NewMediaRepository creates a new media repository
 func NewMediaRepository(client *dapr.Client) *MediaRepository {
+	stateStore := dapr.NewStateStore(client)
+	stateStore.MustRegisterIndexes(MediaInquiryIndexes())
+	stateStore.MustRegisterIndexes(dapr.OutboxMessageIndexes())
+
 	return &MediaRepository{
-		stateStore: dapr.NewStateStore(client),
+		stateStore: stateStore,
 		bindings:   dapr.NewBindings(client),
 		pubsub:     dapr.NewPubSub(client),
 	}
@@ -28,98 +77,17 @@ func NewMediaRepository(client *dapr.Client) *MediaRepository {
 
 // Media inquiry operations
 
-// SaveInquiry saves media inquiry to Dapr state store
-func (r *MediaRepository) SaveInquiry(ctx context.Context, inquiry *MediaInquiry) error {
-	key := r.stateStore.CreateKey("media", "inquiry", inquiry.InquiryID)
-	
-	err := r.stateStore.Save(ctx, key, inquiry, nil)
-	if err != nil {
-		return fmt.Errorf("failed to save media inquiry %s: %w", inquiry.InquiryID, err)
-	}
-
-	// Create index for status search
-	statusKey := r.stateStore.CreateIndexKey("media", "inquiry", "status", string(inquiry.Status))
-	statusIndex := map[string]string{"inquiry_id": inquiry.InquiryID}
-	
-	err = r.stateStore.Save(ctx, statusKey, statusIndex, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create status index for media inquiry %s: %w", inquiry.InquiryID, err)
-	}
-
-	// Create index for priority search
-	priorityKey := r.stateStore.CreateIndexKey("media", "inquiry", "priority", string(inquiry.Priority))
-	priorityIndex := map[string]string{"inquiry_id": inquiry.InquiryID}
-	
-	err = r.stateStore.Save(ctx, priorityKey, priorityIndex, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create priority index for media inquiry %s: %w", inquiry.InquiryID, err)
-	}
-
-	// Create index for urgency search
-	urgencyKey := r.stateStore.CreateIndexKey("media", "inquiry", "urgency", string(inquiry.Urgency))
-	urgencyIndex := map[string]string{"inquiry_id": inquiry.InquiryID}
-	
-	err = r.stateStore.Save(ctx, urgencyKey, urgencyIndex, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create urgency index for media inquiry %s: %w", inquiry.InquiryID, err)
-	}
-
-	// Create index for media type search (if media type is provided)
-	if inquiry.MediaType != nil {
-		mediaTypeKey := r.stateStore.CreateIndexKey("media", "inquiry", "media_type", string(*inquiry.MediaType))
-		mediaTypeIndex := map[string]string{"inquiry_id": inquiry.InquiryID}
-		
-		err = r.stateStore.Save(ctx, mediaTypeKey, mediaTypeIndex, nil)
-		if err != nil {
-			return fmt.Errorf("failed to create media type index for media inquiry %s: %w", inquiry.InquiryID, err)
-		}
-	}
-
-	// Create index for outlet search
-	outletKey := r.stateStore.CreateIndexKey("media", "inquiry", "outlet", inquiry.Outlet)
-	outletIndex := map[string]string{"inquiry_id": inquiry.InquiryID}
-	
-	err = r.stateStore.Save(ctx, outletKey, outletIndex, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create outlet index for media inquiry %s: %w", inquiry.InquiryID, err)
-	}
-
-	// Create index for deadline search (if deadline is provided)
-	if inquiry.Deadline != nil {
-		deadlineKey := r.stateStore.CreateIndexKey("media", "inquiry", "deadline", inquiry.Deadline.Format("2006-01-02"))
-		deadlineIndex := map[string]string{"inquiry_id": inquiry.InquiryID}
-		
-		err = r.stateStore.Save(ctx, deadlineKey, deadlineIndex, nil)
-		if err != nil {
-			return fmt.Errorf("failed to create deadline index for media inquiry %s: %w", inquiry.InquiryID, err)
-		}
-	}
-
-	// Create index for email search
-	emailKey := r.stateStore.CreateIndexKey("media", "inquiry", "email", inquiry.Email)
-	emailIndex := map[string]string{"inquiry_id": inquiry.InquiryID}
-	
-	err = r.stateStore.Save(ctx, emailKey, emailIndex, nil)
+// SaveInquiry saves media inquiry and its secondary indexes to Dapr state store, together
+// with the audit event describing the change
+func (r *MediaRepository) SaveInquiry(ctx context.Context, inquiry *MediaInquiry, audit *domain.AuditEvent) error {
+	messages, err := r.auditMessages(ctx, audit)
 	if err != nil {
-		return fmt.Errorf("failed to create email index for media inquiry %s: %w", inquiry.InquiryID, err)
-	}
-
-	// Create index for contact name search
-	contactKey := r.stateStore.CreateIndexKey("media", "inquiry", "contact_name", inquiry.ContactName)
-	contactIndex := map[string]string{"inquiry_id": inquiry.InquiryID}
-	
-	err = r.stateStore.Save(ctx, contactKey, contactIndex, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create contact name index for media inquiry %s: %w", inquiry.InquiryID, err)
+		return err
 	}
 
-	// Create index for creation date search
-	dateKey := r.stateStore.CreateIndexKey("media", "inquiry", "created_date", inquiry.CreatedAt.Format("2006-01-02"))
-	dateIndex := map[string]string{"inquiry_id": inquiry.InquiryID}
-	
-	err = r.stateStore.Save(ctx, dateKey, dateIndex, nil)
+	err = r.stateStore.SaveIndexedWithOutbox(ctx, "media", "inquiry", inquiry.InquiryID, inquiry, "", messages...)
 	if err != nil {
-		return fmt.Errorf("failed to create date index for media inquiry %s: %w", inquiry.InquiryID, err)
+		return fmt.Errorf("failed to save media inquiry %s: %w", inquiry.InquiryID, err)
 	}
 
 	return nil
@@ -148,7 +116,7 @@ func (r *MediaRepository) GetInquiry(ctx context.Context, inquiryID string) (*Me
 }
 
 // DeleteInquiry soft deletes a media inquiry
-func (r *MediaRepository) DeleteInquiry(ctx context.Context, inquiryID string, userID string) error {
+func (r *MediaRepository) DeleteInquiry(ctx context.Context, inquiryID string, userID string, audit *domain.AuditEvent) error {
 	inquiry, err := r.GetInquiry(ctx, inquiryID)
 	if err != nil {
 		return err
@@ -160,42 +128,123 @@ func (r *MediaRepository) DeleteInquiry(ctx context.Context, inquiryID string, u
 	inquiry.UpdatedBy = userID
 	inquiry.UpdatedAt = now
 
-	return r.SaveInquiry(ctx, inquiry)
+	return r.SaveInquiry(ctx, inquiry, audit)
 }
 
-// ListInquiries retrieves media inquiries with filtering
+// ListInquiries retrieves media inquiries matching the filters, sorted and windowed by limit/offset
 func (r *MediaRepository) ListInquiries(ctx context.Context, filters InquiryFilters) ([]*MediaInquiry, error) {
-	// For this minimal GREEN phase implementation, return empty list
-	// In a production system, this would use proper database queries or indexed searches
-	// This will be improved in the REFACTOR phase
-	
-	return []*MediaInquiry{}, nil
+	inquiries, err := r.findInquiries(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	windowed, _ := ApplyInquiryFilters(inquiries, filters)
+	return windowed, nil
 }
 
-// PublishAuditEvent publishes an audit event for compliance logging
-func (r *MediaRepository) PublishAuditEvent(ctx context.Context, entityType domain.EntityType, entityID string, operationType domain.AuditEventType, userID string, beforeData, afterData interface{}) error {
+// CountInquiries returns the total number of media inquiries matching the filters, ignoring limit/offset
+func (r *MediaRepository) CountInquiries(ctx context.Context, filters InquiryFilters) (int, error) {
+	inquiries, err := r.findInquiries(ctx, filters)
+	if err != nil {
+		return 0, err
+	}
+
+	_, total := ApplyInquiryFilters(inquiries, filters)
+	return total, nil
+}
+
+// findInquiries narrows candidates with the equality indexes and loads them; range
+// filters, sorting and windowing are applied by ApplyInquiryFilters
+func (r *MediaRepository) findInquiries(ctx context.Context, filters InquiryFilters) ([]*MediaInquiry, error) {
+	if err := filters.Validate(); err != nil {
+		return nil, err
+	}
+
+	conditions := []dapr.IndexCondition{
+		{Index: mediaIndexIsDeleted, Value: dapr.IndexBool(false)},
+	}
+	if filters.Status != nil {
+		conditions = append(conditions, dapr.IndexCondition{Index: mediaIndexStatus, Value: string(*filters.Status)})
+	}
+	if filters.Priority != nil {
+		conditions = append(conditions, dapr.IndexCondition{Index: mediaIndexPriority, Value: string(*filters.Priority)})
+	}
+	if filters.Urgency != nil {
+		conditions = append(conditions, dapr.IndexCondition{Index: mediaIndexUrgency, Value: string(*filters.Urgency)})
+	}
+	if filters.MediaType != nil {
+		conditions = append(conditions, dapr.IndexCondition{Index: mediaIndexMediaType, Value: string(*filters.MediaType)})
+	}
+	if filters.Outlet != nil {
+		conditions = append(conditions, dapr.IndexCondition{Index: mediaIndexOutlet, Value: strings.ToLower(*filters.Outlet)})
+	}
+
+	ids, err := r.stateStore.LookupIndex(ctx, "media", "inquiry", conditions...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up media inquiries: %w", err)
+	}
+
+	return r.getInquiriesByIDs(ctx, ids)
+}
+
+// getInquiriesByIDs bulk loads media inquiries, skipping missing and deleted records
+func (r *MediaRepository) getInquiriesByIDs(ctx context.Context, ids []string) ([]*MediaInquiry, error) {
+	inquiries := make([]*MediaInquiry, 0, len(ids))
+	if len(ids) == 0 {
+		return inquiries, nil
+	}
+
+	keys := make([]string, 0, len(ids))
+	targets := make(map[string]interface{}, len(ids))
+	loaded := make([]*MediaInquiry, 0, len(ids))
+	for _, id := range ids {
+		key := r.stateStore.CreateKey("media", "inquiry", id)
+		inquiry := &MediaInquiry{}
+		keys = append(keys, key)
+		targets[key] = inquiry
+		loaded = append(loaded, inquiry)
+	}
+
+	if err := r.stateStore.GetBulk(ctx, keys, targets); err != nil {
+		return nil, fmt.Errorf("failed to load indexed media inquiries: %w", err)
+	}
+
+	for _, inquiry := range loaded {
+		if inquiry.InquiryID != "" && !inquiry.IsDeleted {
+			inquiries = append(inquiries, inquiry)
+		}
+	}
+
+	return inquiries, nil
+}
+
+// PageMediaInquiries orders inquiries newest first and returns the page following the requested cursor
+func PageMediaInquiries(inquiries []*MediaInquiry, page domain.PageRequest) ([]*MediaInquiry, domain.PageInfo) {
+	sort.Slice(inquiries, func(i, j int) bool {
+		return inquiries[i].PageCursor().Before(inquiries[j].PageCursor())
+	})
+
+	start, end, info := domain.CursorPage(len(inquiries), func(i int) domain.PageCursor {
+		return inquiries[i].PageCursor()
+	}, page)
+
+	return inquiries[start:end], info
+}
+
+// auditMessages prepares the compliance audit event for a write so it commits through the
+// outbox with that write. A nil event prepares nothing.
+func (r *MediaRepository) auditMessages(ctx context.Context, auditEvent *domain.AuditEvent) ([]*dapr.OutboxMessage, error) {
+	if auditEvent == nil {
+		return nil, nil
+	}
+
 	correlationID := domain.GetCorrelationID(ctx)
 	if correlationID == "" {
 		correlationID = uuid.New().String()
 	}
+	auditEvent.SetTraceContext(correlationID, domain.GetTraceID(ctx))
+	auditEvent.Environment = "development" // This should come from configuration
 
-	auditEvent := domain.AuditEvent{
-		AuditID:       uuid.New().String(), // Generate unique audit ID
-		EntityType:    entityType,
-		EntityID:      entityID,
-		OperationType: operationType,
-		AuditTime:     time.Now(),
-		UserID:        userID,
-		CorrelationID: correlationID,
-		TraceID:       domain.GetTraceID(ctx),
-		DataSnapshot: &domain.AuditDataSnapshot{
-			Before: beforeData,
-			After:  afterData,
-		},
-		Environment: "development", // This should come from configuration
-	}
-
-	// Publish to Grafana audit events topic
 	daprAuditEvent := &dapr.AuditEvent{
 		AuditID:       auditEvent.AuditID,
 		EntityType:    string(auditEvent.EntityType),
@@ -205,11 +254,13 @@ func (r *MediaRepository) PublishAuditEvent(ctx context.Context, entityType doma
 		UserID:        auditEvent.UserID,
 		CorrelationID: auditEvent.CorrelationID,
 		TraceID:       auditEvent.TraceID,
-		DataSnapshot: map[string]interface{}{
+		Environment:   auditEvent.Environment,
+	}
+	if auditEvent.DataSnapshot != nil {
+		daprAuditEvent.DataSnapshot = map[string]interface{}{
 			"before": auditEvent.DataSnapshot.Before,
 			"after":  auditEvent.DataSnapshot.After,
-		},
-		Environment: auditEvent.Environment,
+		}
 	}
 
 	eventMessage := &dapr.EventMessage{
@@ -218,21 +269,16 @@ func (r *MediaRepository) PublishAuditEvent(ctx context.Context, entityType doma
 			"audit_event": daprAuditEvent,
 		},
 		Metadata: map[string]string{
-			"entity_type":    string(entityType),
-			"operation_type": string(operationType),
-			"user_id":        userID,
+			"entity_type":    string(auditEvent.EntityType),
+			"operation_type": string(auditEvent.OperationType),
+			"user_id":        auditEvent.UserID,
 		},
 		ContentType: "application/json",
 		Source:      "media-admin-api",
 		Type:        "audit.event",
-		Subject:     fmt.Sprintf("audit.%s.%s", string(entityType), string(operationType)),
+		Subject:     fmt.Sprintf("audit.%s.%s", string(auditEvent.EntityType), string(auditEvent.OperationType)),
 		Time:        time.Now(),
 	}
 
-	err := r.pubsub.PublishEvent(ctx, "audit-events", eventMessage)
-	if err != nil {
-		return fmt.Errorf("failed to publish media inquiry audit event: %w", err)
-	}
-
-	return nil
+	return []*dapr.OutboxMessage{dapr.NewOutboxMessage(ctx, "audit-events", eventMessage)}, nil
 }
\ No newline at end of file
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/mail"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -160,6 +161,11 @@ func (m *MediaInquiry) CalculateUrgencyFromDeadline() {
 	}
 }
 
+// PageCursor returns the position of the inquiry in cursor-paginated listings
+func (m *MediaInquiry) PageCursor() domain.PageCursor {
+	return domain.PageCursor{Timestamp: m.CreatedAt, ID: m.InquiryID}
+}
+
 // Validate validates the media inquiry data
 func (m *MediaInquiry) Validate() error {
 	if m.InquiryID == "" {
@@ -468,15 +474,208 @@ type AdminUpdateInquiryRequest struct {
 	Subject     *string    `json:"subject,omitempty"`
 }
 
+// InquirySortField selects the ordering applied to inquiry listings
+type InquirySortField string
+
+const (
+	InquirySortCreatedAt InquirySortField = "created_at" // newest first
+	InquirySortDeadline  InquirySortField = "deadline"   // soonest deadline first, inquiries without a deadline last
+	InquirySortUrgency   InquirySortField = "urgency"    // most urgent first, then soonest deadline
+)
+
+func (f InquirySortField) IsValid() bool {
+	switch f {
+	case InquirySortCreatedAt, InquirySortDeadline, InquirySortUrgency:
+		return true
+	default:
+		return false
+	}
+}
+
 // InquiryFilters represents filters for listing inquiries
 type InquiryFilters struct {
-	Status    *InquiryStatus   `json:"status,omitempty"`
-	Priority  *InquiryPriority `json:"priority,omitempty"`
-	Urgency   *InquiryUrgency  `json:"urgency,omitempty"`
-	MediaType *MediaType       `json:"media_type,omitempty"`
-	Outlet    *string          `json:"outlet,omitempty"`
-	Limit     *int             `json:"limit,omitempty"`
-	Offset    *int             `json:"offset,omitempty"`
+	Status       *InquiryStatus    `json:"status,omitempty"`
+	Priority     *InquiryPriority  `json:"priority,omitempty"`
+	Urgency      *InquiryUrgency   `json:"urgency,omitempty"`
+	MediaType    *MediaType        `json:"media_type,omitempty"`
+	Outlet       *string           `json:"outlet,omitempty"`
+	Search       *string           `json:"search,omitempty"`
+	DeadlineFrom *time.Time        `json:"deadline_from,omitempty"`
+	DeadlineTo   *time.Time        `json:"deadline_to,omitempty"`
+	CreatedFrom  *time.Time        `json:"created_from,omitempty"`
+	CreatedTo    *time.Time        `json:"created_to,omitempty"`
+	SortBy       *InquirySortField `json:"sort_by,omitempty"`
+	Limit        *int              `json:"limit,omitempty"`
+	Offset       *int              `json:"offset,omitempty"`
+}
+
+// Validate validates the filter values
+func (f InquiryFilters) Validate() error {
+	if f.Status != nil && !f.Status.IsValid() {
+		return domain.NewValidationFieldError("status", "invalid inquiry status")
+	}
+	if f.Priority != nil && !f.Priority.IsValid() {
+		return domain.NewValidationFieldError("priority", "invalid inquiry priority")
+	}
+	if f.Urgency != nil && !f.Urgency.IsValid() {
+		return domain.NewValidationFieldError("urgency", "invalid inquiry urgency")
+	}
+	if f.MediaType != nil && !f.MediaType.IsValid() {
+		return domain.NewValidationFieldError("media_type", "invalid media type")
+	}
+	if f.SortBy != nil && !f.SortBy.IsValid() {
+		return domain.NewValidationFieldError("sort_by", "sort_by must be one of: created_at, deadline, urgency")
+	}
+	if f.DeadlineFrom != nil && f.DeadlineTo != nil && f.DeadlineTo.Before(*f.DeadlineFrom) {
+		return domain.NewValidationFieldError("deadline_to", "deadline_to must not be before deadline_from")
+	}
+	if f.CreatedFrom != nil && f.CreatedTo != nil && f.CreatedTo.Before(*f.CreatedFrom) {
+		return domain.NewValidationFieldError("created_to", "created_to must not be before created_from")
+	}
+	return nil
+}
+
+// Matches reports whether a non-deleted inquiry satisfies every filter. Inquiries
+// without a deadline never match a deadline window.
+func (f InquiryFilters) Matches(inquiry *MediaInquiry) bool {
+	if inquiry.IsDeleted {
+		return false
+	}
+	if f.Status != nil && inquiry.Status != *f.Status {
+		return false
+	}
+	if f.Priority != nil && inquiry.Priority != *f.Priority {
+		return false
+	}
+	if f.Urgency != nil && inquiry.Urgency != *f.Urgency {
+		return false
+	}
+	if f.MediaType != nil && (inquiry.MediaType == nil || *inquiry.MediaType != *f.MediaType) {
+		return false
+	}
+	if f.Outlet != nil && !strings.EqualFold(inquiry.Outlet, *f.Outlet) {
+		return false
+	}
+	if f.Search != nil && !inquiry.matchesSearch(*f.Search) {
+		return false
+	}
+	if f.DeadlineFrom != nil || f.DeadlineTo != nil {
+		if inquiry.Deadline == nil {
+			return false
+		}
+		if f.DeadlineFrom != nil && inquiry.Deadline.Before(*f.DeadlineFrom) {
+			return false
+		}
+		if f.DeadlineTo != nil && inquiry.Deadline.After(*f.DeadlineTo) {
+			return false
+		}
+	}
+	if f.CreatedFrom != nil && inquiry.CreatedAt.Before(*f.CreatedFrom) {
+		return false
+	}
+	if f.CreatedTo != nil && inquiry.CreatedAt.After(*f.CreatedTo) {
+		return false
+	}
+	return true
+}
+
+// matchesSearch reports whether the search term appears, ignoring case, in the
+// outlet, contact name, email or subject of the inquiry
+func (m *MediaInquiry) matchesSearch(term string) bool {
+	term = strings.ToLower(strings.TrimSpace(term))
+	if term == "" {
+		return true
+	}
+	for _, field := range []string{m.Outlet, m.ContactName, m.Email, m.Subject} {
+		if strings.Contains(strings.ToLower(field), term) {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplyInquiryFilters filters and sorts inquiries, returning the requested
+// limit/offset window together with the total number of matches
+func ApplyInquiryFilters(inquiries []*MediaInquiry, filters InquiryFilters) ([]*MediaInquiry, int) {
+	matched := make([]*MediaInquiry, 0, len(inquiries))
+	for _, inquiry := range inquiries {
+		if filters.Matches(inquiry) {
+			matched = append(matched, inquiry)
+		}
+	}
+
+	sortBy := InquirySortCreatedAt
+	if filters.SortBy != nil {
+		sortBy = *filters.SortBy
+	}
+	SortInquiries(matched, sortBy)
+
+	total := len(matched)
+	start := 0
+	if filters.Offset != nil && *filters.Offset > 0 {
+		start = *filters.Offset
+	}
+	if start > total {
+		start = total
+	}
+	end := total
+	if filters.Limit != nil && *filters.Limit > 0 && start+*filters.Limit < total {
+		end = start + *filters.Limit
+	}
+
+	return matched[start:end], total
+}
+
+// SortInquiries orders inquiries in place, falling back to newest first for ties
+func SortInquiries(inquiries []*MediaInquiry, sortBy InquirySortField) {
+	sort.SliceStable(inquiries, func(i, j int) bool {
+		a, b := inquiries[i], inquiries[j]
+		switch sortBy {
+		case InquirySortUrgency:
+			if urgencyRank(a.Urgency) != urgencyRank(b.Urgency) {
+				return urgencyRank(a.Urgency) > urgencyRank(b.Urgency)
+			}
+			if !sameDeadline(a.Deadline, b.Deadline) {
+				return deadlineBefore(a.Deadline, b.Deadline)
+			}
+		case InquirySortDeadline:
+			if !sameDeadline(a.Deadline, b.Deadline) {
+				return deadlineBefore(a.Deadline, b.Deadline)
+			}
+		}
+		return a.PageCursor().Before(b.PageCursor())
+	})
+}
+
+func urgencyRank(urgency InquiryUrgency) int {
+	switch urgency {
+	case InquiryUrgencyHigh:
+		return 3
+	case InquiryUrgencyMedium:
+		return 2
+	case InquiryUrgencyLow:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func sameDeadline(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(*b)
+}
+
+// deadlineBefore orders earlier deadlines first and missing deadlines last
+func deadlineBefore(a, b *time.Time) bool {
+	if a == nil {
+		return false
+	}
+	if b == nil {
+		return true
+	}
+	return a.Before(*b)
 }
 
 // IsAdminUser checks if the user ID represents an admin user
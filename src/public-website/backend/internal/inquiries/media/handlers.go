@@ -174,50 +174,70 @@ func (h *MediaHandler) ListInquiries(w http.ResponseWriter, r *http.Request) {
 	correlationCtx.SetUserContext(userID, "media-admin-api-1.0.0")
 	ctx = correlationCtx.ToContext(ctx)
 
-	// Parse query parameters for filters
-	filters := InquiryFilters{}
-	
-	if status := r.URL.Query().Get("status"); status != "" {
-		inquiryStatus := InquiryStatus(status)
-		filters.Status = &inquiryStatus
-	}
-	
-	if priority := r.URL.Query().Get("priority"); priority != "" {
-		inquiryPriority := InquiryPriority(priority)
-		filters.Priority = &inquiryPriority
-	}
-	
-	if urgency := r.URL.Query().Get("urgency"); urgency != "" {
-		inquiryUrgency := InquiryUrgency(urgency)
-		filters.Urgency = &inquiryUrgency
+	params, err := parseListInquiriesParams(r)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
 	}
-	
-	if mediaType := r.URL.Query().Get("media_type"); mediaType != "" {
-		mediaTypeVal := MediaType(mediaType)
-		filters.MediaType = &mediaTypeVal
+
+	// Call service method
+	inquiries, pagination, err := h.service.AdminListInquiries(ctx, params, userID)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
 	}
-	
-	if outlet := r.URL.Query().Get("outlet"); outlet != "" {
-		filters.Outlet = &outlet
+
+	// Return inquiries
+	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
+		"inquiries":      inquiries,
+		"pagination":     pagination,
+		"correlation_id": correlationCtx.CorrelationID,
+	})
+}
+
+// parseListInquiriesParams reads the listing query string, defaulting to the
+// first page of 20. Malformed numbers and timestamps are validation errors.
+func parseListInquiriesParams(r *http.Request) (ListInquiriesParams, error) {
+	query := r.URL.Query()
+	params := ListInquiriesParams{
+		Page:      1,
+		Limit:     20,
+		Cursor:    query.Get("cursor"),
+		Search:    query.Get("search"),
+		Status:    query.Get("status"),
+		Priority:  query.Get("priority"),
+		Urgency:   query.Get("urgency"),
+		MediaType: query.Get("media_type"),
+		Outlet:    query.Get("outlet"),
+		SortBy:    query.Get("sort"),
 	}
-	
-	if limit := r.URL.Query().Get("limit"); limit != "" {
-		if limitInt, err := strconv.Atoi(limit); err == nil && limitInt > 0 {
-			filters.Limit = &limitInt
+
+	for name, target := range map[string]*int{"page": &params.Page, "limit": &params.Limit} {
+		if value := query.Get(name); value != "" {
+			parsed, err := strconv.Atoi(value)
+			if err != nil {
+				return ListInquiriesParams{}, domain.NewValidationFieldError(name, name+" must be an integer")
+			}
+			*target = parsed
 		}
 	}
-	
-	if offset := r.URL.Query().Get("offset"); offset != "" {
-		if offsetInt, err := strconv.Atoi(offset); err == nil && offsetInt >= 0 {
-			filters.Offset = &offsetInt
+
+	for name, target := range map[string]**time.Time{
+		"deadline_from": &params.DeadlineFrom,
+		"deadline_to":   &params.DeadlineTo,
+		"created_from":  &params.CreatedFrom,
+		"created_to":    &params.CreatedTo,
+	} {
+		if value := query.Get(name); value != "" {
+			parsed, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return ListInquiriesParams{}, domain.NewValidationFieldError(name, name+" must be an RFC 3339 timestamp")
+			}
+			*target = &parsed
 		}
 	}
 
-	// Legacy handler - functionality moved to contract-compliant handlers
-	h.writeJSONResponse(w, http.StatusNotImplemented, map[string]interface{}{
-		"message": "This endpoint has been migrated to contract-compliant handlers",
-		"correlation_id": correlationCtx.CorrelationID,
-	})
+	return params, nil
 }
 
 // AcknowledgeInquiry handles POST /admin/api/v1/media/inquiries/{id}/acknowledge
@@ -63,11 +63,12 @@ func (m *MockMediaRepository) GetAuditEvents() []MockAuditEvent {
 }
 
 // Repository interface methods
-func (m *MockMediaRepository) SaveInquiry(ctx context.Context, inquiry *MediaInquiry) error {
+func (m *MockMediaRepository) SaveInquiry(ctx context.Context, inquiry *MediaInquiry, audit *domain.AuditEvent) error {
 	if err := m.failures["SaveInquiry"]; err != nil {
 		return err
 	}
 	m.inquiries[inquiry.InquiryID] = inquiry
+	m.recordAudit(audit)
 	return nil
 }
 
@@ -82,7 +83,7 @@ func (m *MockMediaRepository) GetInquiry(ctx context.Context, inquiryID string)
 	return inquiry, nil
 }
 
-func (m *MockMediaRepository) DeleteInquiry(ctx context.Context, inquiryID string, userID string) error {
+func (m *MockMediaRepository) DeleteInquiry(ctx context.Context, inquiryID string, userID string, audit *domain.AuditEvent) error {
 	if err := m.failures["DeleteInquiry"]; err != nil {
 		return err
 	}
@@ -93,6 +94,7 @@ func (m *MockMediaRepository) DeleteInquiry(ctx context.Context, inquiryID strin
 	inquiry.IsDeleted = true
 	inquiry.DeletedAt = &[]time.Time{time.Now()}[0]
 	inquiry.UpdatedBy = userID
+	m.recordAudit(audit)
 	return nil
 }
 
@@ -101,29 +103,44 @@ func (m *MockMediaRepository) ListInquiries(ctx context.Context, filters Inquiry
 		return nil, err
 	}
 	
-	var result []*MediaInquiry
+	if err := filters.Validate(); err != nil {
+		return nil, err
+	}
+	
+	var inquiries []*MediaInquiry
 	for _, inquiry := range m.inquiries {
-		if !inquiry.IsDeleted {
-			result = append(result, inquiry)
-		}
+		inquiries = append(inquiries, inquiry)
 	}
+	result, _ := ApplyInquiryFilters(inquiries, filters)
 	return result, nil
 }
 
-func (m *MockMediaRepository) PublishAuditEvent(ctx context.Context, entityType domain.EntityType, entityID string, operationType domain.AuditEventType, userID string, beforeData, afterData interface{}) error {
-	if err := m.failures["PublishAuditEvent"]; err != nil {
-		return err
+func (m *MockMediaRepository) CountInquiries(ctx context.Context, filters InquiryFilters) (int, error) {
+	if err := m.failures["CountInquiries"]; err != nil {
+		return 0, err
 	}
 	
+	var inquiries []*MediaInquiry
+	for _, inquiry := range m.inquiries {
+		inquiries = append(inquiries, inquiry)
+	}
+	_, total := ApplyInquiryFilters(inquiries, filters)
+	return total, nil
+}
+
+// recordAudit keeps the audit event a write committed, in place of the outbox
+func (m *MockMediaRepository) recordAudit(audit *domain.AuditEvent) {
+	if audit == nil {
+		return
+	}
 	m.auditEvents = append(m.auditEvents, MockAuditEvent{
-		EntityType:    entityType,
-		EntityID:      entityID,
-		OperationType: operationType,
-		UserID:        userID,
-		Before:        beforeData,
-		After:         afterData,
+		EntityType:    audit.EntityType,
+		EntityID:      audit.EntityID,
+		OperationType: audit.OperationType,
+		UserID:        audit.UserID,
+		Before:        audit.DataSnapshot.Before,
+		After:         audit.DataSnapshot.After,
 	})
-	return nil
 }
 
 // Helper function to create test media inquiry
@@ -841,6 +858,221 @@ func TestMediaService_AdminListInquiries(t *testing.T) {
 	}
 }
 
+func TestMediaService_AdminListInquiries_Params(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	adminID := "admin-550e8400-e29b-41d4-a716-446655440003"
+	seed := func(repo *MockMediaRepository) {
+		ledger := createTestMediaInquiry("inquiry-1", "Daily Ledger", "John Reporter", adminID)
+		ledger.MediaType = &[]MediaType{MediaTypePrint}[0]
+		ledger.Priority = InquiryPriorityHigh
+		radio := createTestMediaInquiry("inquiry-2", "Radio Station", "Jane Host", adminID)
+		radio.Email = "jane@radio.example"
+		repo.inquiries[ledger.InquiryID] = ledger
+		repo.inquiries[radio.InquiryID] = radio
+	}
+
+	tests := []struct {
+		name      string
+		params    ListInquiriesParams
+		wantError bool
+		wantIDs   []string
+	}{
+		{
+			name:    "filter by priority and media type",
+			params:  ListInquiriesParams{Page: 1, Limit: 20, Priority: "high", MediaType: "print"},
+			wantIDs: []string{"inquiry-1"},
+		},
+		{
+			name:    "search matches contact email ignoring case",
+			params:  ListInquiriesParams{Page: 1, Limit: 20, Search: "JANE@RADIO"},
+			wantIDs: []string{"inquiry-2"},
+		},
+		{
+			name:    "media inquiry type is accepted",
+			params:  ListInquiriesParams{Page: 1, Limit: 20, InquiryType: "media", Outlet: "daily ledger"},
+			wantIDs: []string{"inquiry-1"},
+		},
+		{
+			name:      "zero limit is rejected",
+			params:    ListInquiriesParams{Page: 1, Limit: 0},
+			wantError: true,
+		},
+		{
+			name:      "zero limit is rejected for cursor pages",
+			params:    ListInquiriesParams{Limit: 0, Cursor: "next"},
+			wantError: true,
+		},
+		{
+			name:      "page below one is rejected",
+			params:    ListInquiriesParams{Page: 0, Limit: 20},
+			wantError: true,
+		},
+		{
+			name:      "other inquiry types are rejected",
+			params:    ListInquiriesParams{Page: 1, Limit: 20, InquiryType: "business"},
+			wantError: true,
+		},
+		{
+			name:      "unknown priority is rejected",
+			params:    ListInquiriesParams{Page: 1, Limit: 20, Priority: "critical"},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			repo := NewMockMediaRepository()
+			seed(repo.(*MockMediaRepository))
+			service := NewMediaService(repo)
+
+			// Act
+			inquiries, _, err := service.AdminListInquiries(ctx, tt.params, adminID)
+
+			// Assert
+			if tt.wantError {
+				require.Error(t, err)
+				assertErrorType(t, err, "validation")
+				return
+			}
+			require.NoError(t, err)
+			ids := make([]string, len(inquiries))
+			for i, inquiry := range inquiries {
+				ids[i] = inquiry.ID
+			}
+			assert.ElementsMatch(t, tt.wantIDs, ids)
+		})
+	}
+}
+
+func TestApplyInquiryFilters(t *testing.T) {
+	base := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+	deadline := func(hours int) *time.Time {
+		d := base.Add(time.Duration(hours) * time.Hour)
+		return &d
+	}
+	newInquiry := func(id string, createdHours int, urgency InquiryUrgency, due *time.Time) *MediaInquiry {
+		inquiry := createTestMediaInquiry(id, "News Channel", "John Reporter", "admin-550e8400-e29b-41d4-a716-446655440003")
+		inquiry.CreatedAt = base.Add(time.Duration(createdHours) * time.Hour)
+		inquiry.Urgency = urgency
+		inquiry.Deadline = due
+		return inquiry
+	}
+	inquiries := func() []*MediaInquiry {
+		resolved := newInquiry("inquiry-4", 4, InquiryUrgencyLow, deadline(72))
+		resolved.Status = InquiryStatusResolved
+		deleted := newInquiry("inquiry-5", 5, InquiryUrgencyHigh, deadline(1))
+		deleted.IsDeleted = true
+		radio := newInquiry("inquiry-2", 2, InquiryUrgencyHigh, deadline(96))
+		radio.Outlet = "Morning Radio"
+		return []*MediaInquiry{
+			newInquiry("inquiry-1", 1, InquiryUrgencyLow, deadline(48)),
+			radio,
+			newInquiry("inquiry-3", 3, InquiryUrgencyHigh, nil),
+			resolved,
+			deleted,
+		}
+	}
+	status := InquiryStatusNew
+	search := "radio"
+	sortDeadline := InquirySortDeadline
+	sortUrgency := InquirySortUrgency
+	limit, offset := 2, 1
+
+	tests := []struct {
+		name      string
+		filters   InquiryFilters
+		wantIDs   []string
+		wantTotal int
+	}{
+		{
+			name:      "default order newest first without deleted",
+			filters:   InquiryFilters{},
+			wantIDs:   []string{"inquiry-4", "inquiry-3", "inquiry-2", "inquiry-1"},
+			wantTotal: 4,
+		},
+		{
+			name:      "filter by status",
+			filters:   InquiryFilters{Status: &status},
+			wantIDs:   []string{"inquiry-3", "inquiry-2", "inquiry-1"},
+			wantTotal: 3,
+		},
+		{
+			name:      "deadline window excludes inquiries without deadline",
+			filters:   InquiryFilters{DeadlineFrom: deadline(24), DeadlineTo: deadline(80)},
+			wantIDs:   []string{"inquiry-4", "inquiry-1"},
+			wantTotal: 2,
+		},
+		{
+			name:      "created range",
+			filters:   InquiryFilters{CreatedFrom: &[]time.Time{base.Add(2 * time.Hour)}[0], CreatedTo: &[]time.Time{base.Add(3 * time.Hour)}[0]},
+			wantIDs:   []string{"inquiry-3", "inquiry-2"},
+			wantTotal: 2,
+		},
+		{
+			name:      "search matches outlet ignoring case",
+			filters:   InquiryFilters{Search: &search},
+			wantIDs:   []string{"inquiry-2"},
+			wantTotal: 1,
+		},
+		{
+			name:      "sort by deadline puts missing deadlines last",
+			filters:   InquiryFilters{SortBy: &sortDeadline},
+			wantIDs:   []string{"inquiry-1", "inquiry-4", "inquiry-2", "inquiry-3"},
+			wantTotal: 4,
+		},
+		{
+			name:      "sort by urgency then deadline",
+			filters:   InquiryFilters{SortBy: &sortUrgency},
+			wantIDs:   []string{"inquiry-2", "inquiry-3", "inquiry-1", "inquiry-4"},
+			wantTotal: 4,
+		},
+		{
+			name:      "limit and offset keep total count",
+			filters:   InquiryFilters{Limit: &limit, Offset: &offset},
+			wantIDs:   []string{"inquiry-3", "inquiry-2"},
+			wantTotal: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			result, total := ApplyInquiryFilters(inquiries(), tt.filters)
+
+			// Assert
+			ids := make([]string, len(result))
+			for i, inquiry := range result {
+				ids[i] = inquiry.InquiryID
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+			assert.Equal(t, tt.wantTotal, total)
+		})
+	}
+}
+
+func TestInquiryFilters_Validate(t *testing.T) {
+	invalidStatus := InquiryStatus("archived")
+	invalidSort := InquirySortField("outlet")
+	from := time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC)
+	to := from.Add(-time.Hour)
+
+	for name, filters := range map[string]InquiryFilters{
+		"invalid status":         {Status: &invalidStatus},
+		"invalid sort field":     {SortBy: &invalidSort},
+		"inverted deadline range": {DeadlineFrom: &from, DeadlineTo: &to},
+		"inverted created range": {CreatedFrom: &from, CreatedTo: &to},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := filters.Validate()
+			require.Error(t, err)
+			assertErrorType(t, err, "validation")
+		})
+	}
+}
+
 func TestMediaService_AdminGetInquiry(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -10,11 +10,11 @@ import (
 
 // MediaRepositoryInterface defines the interface for media inquiry data operations
 type MediaRepositoryInterface interface {
-	SaveInquiry(ctx context.Context, inquiry *MediaInquiry) error
+	SaveInquiry(ctx context.Context, inquiry *MediaInquiry, audit *domain.AuditEvent) error
 	GetInquiry(ctx context.Context, inquiryID string) (*MediaInquiry, error)
-	DeleteInquiry(ctx context.Context, inquiryID string, userID string) error
+	DeleteInquiry(ctx context.Context, inquiryID string, userID string, audit *domain.AuditEvent) error
 	ListInquiries(ctx context.Context, filters InquiryFilters) ([]*MediaInquiry, error)
-	PublishAuditEvent(ctx context.Context, entityType domain.EntityType, entityID string, operationType domain.AuditEventType, userID string, beforeData, afterData interface{}) error
+	CountInquiries(ctx context.Context, filters InquiryFilters) (int, error)
 }
 
 // MediaService provides business logic for media inquiry operations
@@ -74,14 +74,11 @@ func (s *MediaService) AdminCreateInquiry(ctx context.Context, request AdminCrea
 		return nil, err
 	}
 
-	if err := s.repository.SaveInquiry(ctx, inquiry); err != nil {
+	audit := domain.NewAuditChange(domain.EntityTypeMediaInquiry, inquiry.InquiryID, domain.AuditEventInsert, userID, nil, inquiry)
+	if err := s.repository.SaveInquiry(ctx, inquiry, audit); err != nil {
 		return nil, domain.NewInternalError("failed to save media inquiry", err)
 	}
 
-	if err := s.repository.PublishAuditEvent(ctx, domain.EntityTypeMediaInquiry, inquiry.InquiryID, domain.AuditEventInsert, userID, nil, inquiry); err != nil {
-		return nil, domain.NewInternalError("failed to publish audit event", err)
-	}
-
 	return inquiry, nil
 }
 
@@ -159,14 +156,11 @@ func (s *MediaService) AdminUpdateInquiry(ctx context.Context, inquiryID string,
 		return nil, err
 	}
 
-	if err := s.repository.SaveInquiry(ctx, inquiry); err != nil {
+	audit := domain.NewAuditChange(domain.EntityTypeMediaInquiry, inquiry.InquiryID, domain.AuditEventUpdate, userID, beforeData, *inquiry)
+	if err := s.repository.SaveInquiry(ctx, inquiry, audit); err != nil {
 		return nil, domain.NewInternalError("failed to update media inquiry", err)
 	}
 
-	if err := s.repository.PublishAuditEvent(ctx, domain.EntityTypeMediaInquiry, inquiry.InquiryID, domain.AuditEventUpdate, userID, beforeData, *inquiry); err != nil {
-		return nil, domain.NewInternalError("failed to publish audit event", err)
-	}
-
 	return inquiry, nil
 }
 
@@ -183,14 +177,11 @@ func (s *MediaService) AdminDeleteInquiry(ctx context.Context, inquiryID string,
 
 	beforeData := *inquiry
 
-	if err := s.repository.DeleteInquiry(ctx, inquiryID, userID); err != nil {
+	audit := domain.NewAuditChange(domain.EntityTypeMediaInquiry, inquiryID, domain.AuditEventDelete, userID, beforeData, nil)
+	if err := s.repository.DeleteInquiry(ctx, inquiryID, userID, audit); err != nil {
 		return domain.NewInternalError("failed to delete media inquiry", err)
 	}
 
-	if err := s.repository.PublishAuditEvent(ctx, domain.EntityTypeMediaInquiry, inquiryID, domain.AuditEventDelete, userID, beforeData, nil); err != nil {
-		return domain.NewInternalError("failed to publish audit event", err)
-	}
-
 	return nil
 }
 
@@ -215,14 +206,11 @@ func (s *MediaService) AdminAcknowledgeInquiry(ctx context.Context, inquiryID st
 		return nil, err
 	}
 
-	if err := s.repository.SaveInquiry(ctx, inquiry); err != nil {
+	audit := domain.NewAuditChange(domain.EntityTypeMediaInquiry, inquiry.InquiryID, domain.AuditEventUpdate, userID, beforeData, *inquiry)
+	if err := s.repository.SaveInquiry(ctx, inquiry, audit); err != nil {
 		return nil, domain.NewInternalError("failed to acknowledge media inquiry", err)
 	}
 
-	if err := s.repository.PublishAuditEvent(ctx, domain.EntityTypeMediaInquiry, inquiry.InquiryID, domain.AuditEventUpdate, userID, beforeData, *inquiry); err != nil {
-		return nil, domain.NewInternalError("failed to publish audit event", err)
-	}
-
 	return inquiry, nil
 }
 
@@ -247,14 +235,11 @@ func (s *MediaService) AdminResolveInquiry(ctx context.Context, inquiryID string
 		return nil, err
 	}
 
-	if err := s.repository.SaveInquiry(ctx, inquiry); err != nil {
+	audit := domain.NewAuditChange(domain.EntityTypeMediaInquiry, inquiry.InquiryID, domain.AuditEventUpdate, userID, beforeData, *inquiry)
+	if err := s.repository.SaveInquiry(ctx, inquiry, audit); err != nil {
 		return nil, domain.NewInternalError("failed to resolve media inquiry", err)
 	}
 
-	if err := s.repository.PublishAuditEvent(ctx, domain.EntityTypeMediaInquiry, inquiry.InquiryID, domain.AuditEventUpdate, userID, beforeData, *inquiry); err != nil {
-		return nil, domain.NewInternalError("failed to publish audit event", err)
-	}
-
 	return inquiry, nil
 }
 
@@ -275,14 +260,11 @@ func (s *MediaService) AdminCloseInquiry(ctx context.Context, inquiryID string,
 		return nil, err
 	}
 
-	if err := s.repository.SaveInquiry(ctx, inquiry); err != nil {
+	audit := domain.NewAuditChange(domain.EntityTypeMediaInquiry, inquiry.InquiryID, domain.AuditEventUpdate, userID, beforeData, *inquiry)
+	if err := s.repository.SaveInquiry(ctx, inquiry, audit); err != nil {
 		return nil, domain.NewInternalError("failed to close media inquiry", err)
 	}
 
-	if err := s.repository.PublishAuditEvent(ctx, domain.EntityTypeMediaInquiry, inquiry.InquiryID, domain.AuditEventUpdate, userID, beforeData, *inquiry); err != nil {
-		return nil, domain.NewInternalError("failed to publish audit event", err)
-	}
-
 	return inquiry, nil
 }
 
@@ -303,14 +285,11 @@ func (s *MediaService) AdminSetPriority(ctx context.Context, inquiryID string, p
 		return nil, err
 	}
 
-	if err := s.repository.SaveInquiry(ctx, inquiry); err != nil {
+	audit := domain.NewAuditChange(domain.EntityTypeMediaInquiry, inquiry.InquiryID, domain.AuditEventUpdate, userID, beforeData, *inquiry)
+	if err := s.repository.SaveInquiry(ctx, inquiry, audit); err != nil {
 		return nil, domain.NewInternalError("failed to set inquiry priority", err)
 	}
 
-	if err := s.repository.PublishAuditEvent(ctx, domain.EntityTypeMediaInquiry, inquiry.InquiryID, domain.AuditEventUpdate, userID, beforeData, *inquiry); err != nil {
-		return nil, domain.NewInternalError("failed to publish audit event", err)
-	}
-
 	return inquiry, nil
 }
 
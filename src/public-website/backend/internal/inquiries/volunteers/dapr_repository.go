@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -20,6 +21,8 @@ type VolunteerRepository struct {
 
 // NewVolunteerRepository creates a new volunteer repository with Dapr integration
 func NewVolunteerRepository(stateStore *dapr.StateStore, bindings *dapr.Bindings, pubsub *dapr.PubSub) VolunteerRepositoryInterface {
+	stateStore.MustRegisterIndexes(dapr.OutboxMessageIndexes())
+
 	return &VolunteerRepository{
 		stateStore: stateStore,
 		bindings:   bindings,
@@ -29,11 +32,17 @@ func NewVolunteerRepository(stateStore *dapr.StateStore, bindings *dapr.Bindings
 
 // Volunteer application operations
 
-// SaveVolunteerApplication saves a volunteer application to the state store
-func (r *VolunteerRepository) SaveVolunteerApplication(ctx context.Context, application *VolunteerApplication) error {
+// SaveVolunteerApplication saves a volunteer application to the state store along with
+// the audit event describing the change
+func (r *VolunteerRepository) SaveVolunteerApplication(ctx context.Context, application *VolunteerApplication, audit *domain.AuditEvent) error {
+	messages, err := r.auditMessages(ctx, audit)
+	if err != nil {
+		return err
+	}
+
 	key := r.stateStore.CreateKey("volunteers", "volunteer_application", application.ApplicationID)
 	
-	err := r.stateStore.Save(ctx, key, application, nil)
+	err = r.stateStore.SaveWithOutbox(ctx, key, application, messages...)
 	if err != nil {
 		return fmt.Errorf("failed to save volunteer application %s: %w", application.ApplicationID, err)
 	}
@@ -317,8 +326,98 @@ func (r *VolunteerRepository) SearchVolunteerApplications(ctx context.Context, s
 	return applicationsList, nil
 }
 
+// ListVolunteerApplications retrieves applications matching the filters, sorted and windowed by limit/offset
+func (r *VolunteerRepository) ListVolunteerApplications(ctx context.Context, filters ApplicationFilters) ([]*VolunteerApplication, error) {
+	applications, err := r.queryApplications(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	windowed, _ := ApplyApplicationFilters(applications, filters)
+	return windowed, nil
+}
+
+// CountVolunteerApplications returns the total number of applications matching the filters, ignoring limit/offset
+func (r *VolunteerRepository) CountVolunteerApplications(ctx context.Context, filters ApplicationFilters) (int, error) {
+	applications, err := r.queryApplications(ctx, filters)
+	if err != nil {
+		return 0, err
+	}
+
+	_, total := ApplyApplicationFilters(applications, filters)
+	return total, nil
+}
+
+// queryApplications pushes the equality filters down to the state store query; range
+// filters, sorting and windowing are applied by ApplyApplicationFilters
+func (r *VolunteerRepository) queryApplications(ctx context.Context, filters ApplicationFilters) ([]*VolunteerApplication, error) {
+	if err := filters.Validate(); err != nil {
+		return nil, err
+	}
+
+	conditions := []string{`{"EQ": {"is_deleted": false}}`}
+	if filters.Status != nil {
+		conditions = append(conditions, fmt.Sprintf(`{"EQ": {"status": "%s"}}`, *filters.Status))
+	}
+	if filters.Priority != nil {
+		conditions = append(conditions, fmt.Sprintf(`{"EQ": {"priority": "%s"}}`, *filters.Priority))
+	}
+	if filters.Interest != nil {
+		conditions = append(conditions, fmt.Sprintf(`{"EQ": {"volunteer_interest": "%s"}}`, *filters.Interest))
+	}
+	query := fmt.Sprintf(`{"filter": {"AND": [%s]}}`, strings.Join(conditions, ", "))
+
+	results, err := r.stateStore.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query volunteer applications: %w", err)
+	}
+
+	applicationsList := make([]*VolunteerApplication, 0, len(results))
+	for _, result := range results {
+		var application VolunteerApplication
+		if err := json.Unmarshal(result.Value, &application); err != nil {
+			continue // Skip invalid records
+		}
+		applicationsList = append(applicationsList, &application)
+	}
+
+	return applicationsList, nil
+}
+
+// ListVolunteerApplicationsPage retrieves the page of applications matching the filters that
+// follows the requested cursor; limit, offset and sort order are replaced by the cursor
+func (r *VolunteerRepository) ListVolunteerApplicationsPage(ctx context.Context, filters ApplicationFilters, page domain.PageRequest) ([]*VolunteerApplication, domain.PageInfo, error) {
+	applications, err := r.queryApplications(ctx, filters)
+	if err != nil {
+		return nil, domain.PageInfo{}, err
+	}
+
+	matched := make([]*VolunteerApplication, 0, len(applications))
+	for _, application := range applications {
+		if filters.Matches(application) {
+			matched = append(matched, application)
+		}
+	}
+
+	paged, info := PageVolunteerApplications(matched, page)
+	return paged, info, nil
+}
+
+// PageVolunteerApplications orders applications newest first and returns the page following the requested cursor
+func PageVolunteerApplications(applications []*VolunteerApplication, page domain.PageRequest) ([]*VolunteerApplication, domain.PageInfo) {
+	sort.Slice(applications, func(i, j int) bool {
+		return applications[i].PageCursor().Before(applications[j].PageCursor())
+	})
+
+	start, end, info := domain.CursorPage(len(applications), func(i int) domain.PageCursor {
+		return applications[i].PageCursor()
+	}, page)
+
+	return applications[start:end], info
+}
+
 // DeleteVolunteerApplication soft deletes a volunteer application
-func (r *VolunteerRepository) DeleteVolunteerApplication(ctx context.Context, applicationID string) error {
+func (r *VolunteerRepository) DeleteVolunteerApplication(ctx context.Context, applicationID string, audit *domain.AuditEvent) error {
 	// Get the existing application first
 	application, err := r.GetVolunteerApplication(ctx, applicationID)
 	if err != nil {
@@ -331,7 +430,7 @@ func (r *VolunteerRepository) DeleteVolunteerApplication(ctx context.Context, ap
 	application.DeletedAt = &now
 
 	// Save the soft-deleted application
-	return r.SaveVolunteerApplication(ctx, application)
+	return r.SaveVolunteerApplication(ctx, application, audit)
 }
 
 // Audit operations
@@ -350,10 +449,12 @@ func (r *VolunteerRepository) GetVolunteerApplicationAudit(ctx context.Context,
 	return auditEvents, nil
 }
 
-// PublishAuditEvent publishes an audit event via Dapr pubsub to Grafana Cloud Loki
-func (r *VolunteerRepository) PublishAuditEvent(ctx context.Context, entityType domain.EntityType, entityID string, operationType domain.AuditEventType, userID string, beforeData, afterData interface{}) error {
-	auditEvent := domain.NewAuditEvent(entityType, entityID, operationType, userID)
-	auditEvent.SetDataSnapshot(beforeData, afterData)
+// auditMessages prepares the audit event for Grafana Cloud Loki as an outbox message, so
+// it is committed with the application write it describes
+func (r *VolunteerRepository) auditMessages(ctx context.Context, auditEvent *domain.AuditEvent) ([]*dapr.OutboxMessage, error) {
+	if auditEvent == nil {
+		return nil, nil
+	}
 	auditEvent.SetEnvironmentContext("development", "1.0.0") // TODO: Get from config
 
 	// Convert domain.AuditEvent to Dapr AuditEvent structure  
@@ -381,11 +482,10 @@ func (r *VolunteerRepository) PublishAuditEvent(ctx context.Context, entityType
 		}
 	}
 
-	// Publish audit event to Grafana Cloud Loki via Dapr pubsub
-	err := r.pubsub.PublishAuditEvent(ctx, daprAuditEvent)
+	message, err := r.pubsub.AuditEventMessage(daprAuditEvent)
 	if err != nil {
-		return fmt.Errorf("failed to publish audit event for %s %s: %w", entityType, entityID, err)
+		return nil, fmt.Errorf("failed to prepare audit event for %s %s: %w", auditEvent.EntityType, auditEvent.EntityID, err)
 	}
 
-	return nil
+	return []*dapr.OutboxMessage{dapr.NewOutboxMessage(ctx, message.Topic, message)}, nil
 }
\ No newline at end of file
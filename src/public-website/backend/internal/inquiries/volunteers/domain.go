@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net"
 	"net/mail"
+	"sort"
 	"strings"
 	"time"
 
@@ -238,6 +239,137 @@ func NewVolunteerApplication(firstName, lastName, email, phone string, age int,
 	return application, nil
 }
 
+// PageCursor returns the position of the application in cursor-paginated listings
+func (v *VolunteerApplication) PageCursor() domain.PageCursor {
+	return domain.PageCursor{Timestamp: v.CreatedAt, ID: v.ApplicationID}
+}
+
+// ApplicationSortField selects the ordering applied to application listings
+type ApplicationSortField string
+
+const (
+	ApplicationSortCreatedAt ApplicationSortField = "created_at" // newest first
+	ApplicationSortPriority  ApplicationSortField = "priority"   // most pressing priority first, then newest
+)
+
+// IsValid checks if the sort field is valid
+func (f ApplicationSortField) IsValid() bool {
+	switch f {
+	case ApplicationSortCreatedAt, ApplicationSortPriority:
+		return true
+	default:
+		return false
+	}
+}
+
+// ApplicationFilters represents filters for listing volunteer applications
+type ApplicationFilters struct {
+	Status      *ApplicationStatus    `json:"status,omitempty"`
+	Priority    *ApplicationPriority  `json:"priority,omitempty"`
+	Interest    *VolunteerInterest    `json:"volunteer_interest,omitempty"`
+	CreatedFrom *time.Time            `json:"created_from,omitempty"`
+	CreatedTo   *time.Time            `json:"created_to,omitempty"`
+	SortBy      *ApplicationSortField `json:"sort_by,omitempty"`
+	Limit       *int                  `json:"limit,omitempty"`
+	Offset      *int                  `json:"offset,omitempty"`
+}
+
+// Validate validates the filter values
+func (f ApplicationFilters) Validate() error {
+	if f.Status != nil && !f.Status.IsValid() {
+		return domain.NewValidationFieldError("status", "invalid application status")
+	}
+	if f.Priority != nil && !f.Priority.IsValid() {
+		return domain.NewValidationFieldError("priority", "invalid application priority")
+	}
+	if f.Interest != nil && !f.Interest.IsValid() {
+		return domain.NewValidationFieldError("volunteer_interest", "invalid volunteer interest")
+	}
+	if f.SortBy != nil && !f.SortBy.IsValid() {
+		return domain.NewValidationFieldError("sort_by", "sort_by must be one of: created_at, priority")
+	}
+	if f.CreatedFrom != nil && f.CreatedTo != nil && f.CreatedTo.Before(*f.CreatedFrom) {
+		return domain.NewValidationFieldError("created_to", "created_to must not be before created_from")
+	}
+	return nil
+}
+
+// Matches reports whether a non-deleted application satisfies every filter
+func (f ApplicationFilters) Matches(application *VolunteerApplication) bool {
+	if application.IsDeleted {
+		return false
+	}
+	if f.Status != nil && application.Status != *f.Status {
+		return false
+	}
+	if f.Priority != nil && application.Priority != *f.Priority {
+		return false
+	}
+	if f.Interest != nil && application.VolunteerInterest != *f.Interest {
+		return false
+	}
+	if f.CreatedFrom != nil && application.CreatedAt.Before(*f.CreatedFrom) {
+		return false
+	}
+	if f.CreatedTo != nil && application.CreatedAt.After(*f.CreatedTo) {
+		return false
+	}
+	return true
+}
+
+// ApplyApplicationFilters filters and sorts applications, returning the requested
+// limit/offset window together with the total number of matches
+func ApplyApplicationFilters(applications []*VolunteerApplication, filters ApplicationFilters) ([]*VolunteerApplication, int) {
+	matched := make([]*VolunteerApplication, 0, len(applications))
+	for _, application := range applications {
+		if filters.Matches(application) {
+			matched = append(matched, application)
+		}
+	}
+
+	sortBy := ApplicationSortCreatedAt
+	if filters.SortBy != nil {
+		sortBy = *filters.SortBy
+	}
+	sort.SliceStable(matched, func(i, j int) bool {
+		a, b := matched[i], matched[j]
+		if sortBy == ApplicationSortPriority && priorityRank(a.Priority) != priorityRank(b.Priority) {
+			return priorityRank(a.Priority) > priorityRank(b.Priority)
+		}
+		return a.PageCursor().Before(b.PageCursor())
+	})
+
+	total := len(matched)
+	start := 0
+	if filters.Offset != nil && *filters.Offset > 0 {
+		start = *filters.Offset
+	}
+	if start > total {
+		start = total
+	}
+	end := total
+	if filters.Limit != nil && *filters.Limit > 0 && start+*filters.Limit < total {
+		end = start + *filters.Limit
+	}
+
+	return matched[start:end], total
+}
+
+func priorityRank(priority ApplicationPriority) int {
+	switch priority {
+	case ApplicationPriorityUrgent:
+		return 4
+	case ApplicationPriorityHigh:
+		return 3
+	case ApplicationPriorityMedium:
+		return 2
+	case ApplicationPriorityLow:
+		return 1
+	default:
+		return 0
+	}
+}
+
 // Validate validates the volunteer application data
 func (v *VolunteerApplication) Validate() error {
 	if v.ApplicationID == "" {
@@ -1,10 +1,12 @@
 package volunteers
 
 import (
+	"context"
 	"encoding/json"
 	"net"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
 	"github.com/gorilla/mux"
@@ -97,10 +99,24 @@ func (h *VolunteerHandler) GetAllVolunteerApplications(w http.ResponseWriter, r
 	correlationCtx.SetUserContext(userID, "volunteers-api-1.0.0")
 	ctx = correlationCtx.ToContext(ctx)
 
+	filters, err := h.extractApplicationFilters(r)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	// Offset and sorted requests keep the limit/offset listing
+	if r.URL.Query().Get("offset") == "" && filters.SortBy == nil {
+		h.listApplicationsPage(ctx, w, r, filters)
+		return
+	}
+
 	// Extract pagination parameters
 	limit, offset := h.extractPaginationParams(r)
+	filters.Limit = &limit
+	filters.Offset = &offset
 
-	applications, err := h.service.GetAllVolunteerApplications(ctx, limit, offset)
+	applications, total, err := h.service.ListVolunteerApplications(ctx, filters)
 	if err != nil {
 		h.handleError(w, r, err)
 		return
@@ -109,6 +125,7 @@ func (h *VolunteerHandler) GetAllVolunteerApplications(w http.ResponseWriter, r
 	h.writeJSONResponse(w, http.StatusOK, map[string]interface{}{
 		"applications": applications,
 		"count":        len(applications),
+		"total":        total,
 		"pagination": map[string]interface{}{
 			"limit":  limit,
 			"offset": offset,
@@ -203,6 +220,12 @@ func (h *VolunteerHandler) GetVolunteerApplicationsByStatus(w http.ResponseWrite
 	correlationCtx.SetUserContext(userID, "volunteers-api-1.0.0")
 	ctx = correlationCtx.ToContext(ctx)
 
+	// Offset requests keep the legacy limit/offset listing
+	if r.URL.Query().Get("offset") == "" {
+		h.listApplicationsPage(ctx, w, r, ApplicationFilters{Status: &status})
+		return
+	}
+
 	// Extract pagination parameters
 	limit, offset := h.extractPaginationParams(r)
 
@@ -456,6 +479,86 @@ func (h *VolunteerHandler) extractPaginationParams(r *http.Request) (int, int) {
 	return limit, offset
 }
 
+// extractPageRequest extracts cursor pagination parameters from request
+func (h *VolunteerHandler) extractPageRequest(r *http.Request) (domain.PageRequest, error) {
+	limit := 0
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		l, err := strconv.Atoi(limitStr)
+		if err != nil {
+			return domain.PageRequest{}, domain.NewValidationFieldError("limit", "limit must be a number")
+		}
+		limit = l
+	}
+
+	return domain.NewPageRequest(r.URL.Query().Get("cursor"), limit)
+}
+
+// extractApplicationFilters extracts listing filters from query parameters
+func (h *VolunteerHandler) extractApplicationFilters(r *http.Request) (ApplicationFilters, error) {
+	query := r.URL.Query()
+	filters := ApplicationFilters{}
+
+	if status := query.Get("status"); status != "" {
+		statusVal := ApplicationStatus(status)
+		filters.Status = &statusVal
+	}
+	if priority := query.Get("priority"); priority != "" {
+		priorityVal := ApplicationPriority(priority)
+		filters.Priority = &priorityVal
+	}
+	if interest := query.Get("volunteer_interest"); interest != "" {
+		interestVal := VolunteerInterest(interest)
+		filters.Interest = &interestVal
+	}
+	if sortBy := query.Get("sort_by"); sortBy != "" {
+		sortField := ApplicationSortField(sortBy)
+		filters.SortBy = &sortField
+	}
+
+	for name, target := range map[string]**time.Time{"created_from": &filters.CreatedFrom, "created_to": &filters.CreatedTo} {
+		value := query.Get(name)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return ApplicationFilters{}, domain.NewValidationFieldError(name, name+" must be an RFC3339 timestamp")
+		}
+		*target = &parsed
+	}
+
+	return filters, nil
+}
+
+// listApplicationsPage writes the page of applications following the cursor query parameter
+func (h *VolunteerHandler) listApplicationsPage(ctx context.Context, w http.ResponseWriter, r *http.Request, filters ApplicationFilters) {
+	correlationCtx := domain.FromContext(ctx)
+
+	page, err := h.extractPageRequest(r)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	applications, pageInfo, err := h.service.ListVolunteerApplicationsPage(ctx, filters, page)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	response := map[string]interface{}{
+		"applications":   applications,
+		"count":          len(applications),
+		"pagination":     pageInfo,
+		"correlation_id": correlationCtx.CorrelationID,
+	}
+	if filters.Status != nil {
+		response["status"] = *filters.Status
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
 // handleError handles HTTP errors with proper status codes and responses
 func (h *VolunteerHandler) handleError(w http.ResponseWriter, r *http.Request, err error) {
 	correlationID := domain.GetCorrelationID(r.Context())
@@ -14,17 +14,19 @@ import (
 // VolunteerRepositoryInterface defines the contract for volunteer data access
 type VolunteerRepositoryInterface interface {
 	// Volunteer application operations
-	SaveVolunteerApplication(ctx context.Context, application *VolunteerApplication) error
+	SaveVolunteerApplication(ctx context.Context, application *VolunteerApplication, audit *domain.AuditEvent) error
 	GetVolunteerApplication(ctx context.Context, applicationID string) (*VolunteerApplication, error)
 	GetAllVolunteerApplications(ctx context.Context, limit, offset int) ([]*VolunteerApplication, error)
 	GetVolunteerApplicationsByStatus(ctx context.Context, status ApplicationStatus, limit, offset int) ([]*VolunteerApplication, error)
 	GetVolunteerApplicationsByPriority(ctx context.Context, priority ApplicationPriority, limit, offset int) ([]*VolunteerApplication, error)
 	GetVolunteerApplicationsByInterest(ctx context.Context, interest VolunteerInterest, limit, offset int) ([]*VolunteerApplication, error)
 	SearchVolunteerApplications(ctx context.Context, searchTerm string, limit, offset int) ([]*VolunteerApplication, error)
-	DeleteVolunteerApplication(ctx context.Context, applicationID string) error
+	ListVolunteerApplications(ctx context.Context, filters ApplicationFilters) ([]*VolunteerApplication, error)
+	CountVolunteerApplications(ctx context.Context, filters ApplicationFilters) (int, error)
+	ListVolunteerApplicationsPage(ctx context.Context, filters ApplicationFilters, page domain.PageRequest) ([]*VolunteerApplication, domain.PageInfo, error)
+	DeleteVolunteerApplication(ctx context.Context, applicationID string, audit *domain.AuditEvent) error
 
 	// Audit operations
-	PublishAuditEvent(ctx context.Context, entityType domain.EntityType, entityID string, operationType domain.AuditEventType, userID string, beforeData, afterData interface{}) error
 	GetVolunteerApplicationAudit(ctx context.Context, applicationID string, userID string, limit int, offset int) ([]*domain.AuditEvent, error)
 }
 
@@ -165,6 +167,33 @@ func (s *VolunteerService) GetAllVolunteerApplications(ctx context.Context, limi
 	return s.repository.GetAllVolunteerApplications(ctx, limit, offset)
 }
 
+// ListVolunteerApplications retrieves applications matching the filters together with the total match count
+func (s *VolunteerService) ListVolunteerApplications(ctx context.Context, filters ApplicationFilters) ([]*VolunteerApplication, int, error) {
+	if err := filters.Validate(); err != nil {
+		return nil, 0, err
+	}
+
+	applications, err := s.repository.ListVolunteerApplications(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.repository.CountVolunteerApplications(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return applications, total, nil
+}
+
+// ListVolunteerApplicationsPage retrieves the page of applications following the requested cursor
+func (s *VolunteerService) ListVolunteerApplicationsPage(ctx context.Context, filters ApplicationFilters, page domain.PageRequest) ([]*VolunteerApplication, domain.PageInfo, error) {
+	if err := filters.Validate(); err != nil {
+		return nil, domain.PageInfo{}, err
+	}
+	return s.repository.ListVolunteerApplicationsPage(ctx, filters, page)
+}
+
 // SearchVolunteerApplications searches volunteer applications by query
 func (s *VolunteerService) SearchVolunteerApplications(ctx context.Context, query string, limit, offset int) ([]*VolunteerApplication, error) {
 	if query == "" {
@@ -215,12 +244,12 @@ func (s *VolunteerService) CreateVolunteerApplication(ctx context.Context, appli
 	application.IsDeleted = false
 
 	// Save to repository
-	if err := s.repository.SaveVolunteerApplication(ctx, application); err != nil {
+	audit := domain.NewAuditChange(domain.EntityTypeVolunteerApplication, application.ApplicationID, domain.AuditEventInsert, userID, nil, application)
+	if err := s.repository.SaveVolunteerApplication(ctx, application, audit); err != nil {
 		return domain.WrapError(err, "failed to save volunteer application")
 	}
 
-	// Publish audit event
-	return s.repository.PublishAuditEvent(ctx, domain.EntityTypeVolunteerApplication, application.ApplicationID, domain.AuditEventInsert, userID, nil, application)
+	return nil
 }
 
 // UpdateVolunteerApplicationStatus updates the status of a volunteer application
@@ -245,12 +274,12 @@ func (s *VolunteerService) UpdateVolunteerApplicationStatus(ctx context.Context,
 	existing.UpdatedBy = userID
 
 	// Save updated application
-	if err := s.repository.SaveVolunteerApplication(ctx, existing); err != nil {
+	audit := domain.NewAuditChange(domain.EntityTypeVolunteerApplication, applicationID, domain.AuditEventUpdate, userID, &beforeData, existing)
+	if err := s.repository.SaveVolunteerApplication(ctx, existing, audit); err != nil {
 		return domain.WrapError(err, "failed to update volunteer application status")
 	}
 
-	// Publish audit event
-	return s.repository.PublishAuditEvent(ctx, domain.EntityTypeVolunteerApplication, applicationID, domain.AuditEventUpdate, userID, &beforeData, existing)
+	return nil
 }
 
 // UpdateVolunteerApplicationPriority updates the priority of a volunteer application
@@ -275,12 +304,12 @@ func (s *VolunteerService) UpdateVolunteerApplicationPriority(ctx context.Contex
 	existing.UpdatedBy = userID
 
 	// Save updated application
-	if err := s.repository.SaveVolunteerApplication(ctx, existing); err != nil {
+	audit := domain.NewAuditChange(domain.EntityTypeVolunteerApplication, applicationID, domain.AuditEventUpdate, userID, &beforeData, existing)
+	if err := s.repository.SaveVolunteerApplication(ctx, existing, audit); err != nil {
 		return domain.WrapError(err, "failed to update volunteer application priority")
 	}
 
-	// Publish audit event
-	return s.repository.PublishAuditEvent(ctx, domain.EntityTypeVolunteerApplication, applicationID, domain.AuditEventUpdate, userID, &beforeData, existing)
+	return nil
 }
 
 // DeleteVolunteerApplication soft deletes a volunteer application
@@ -295,12 +324,12 @@ func (s *VolunteerService) DeleteVolunteerApplication(ctx context.Context, appli
 	beforeData := *existing
 
 	// Perform soft delete
-	if err := s.repository.DeleteVolunteerApplication(ctx, applicationID); err != nil {
+	audit := domain.NewAuditChange(domain.EntityTypeVolunteerApplication, applicationID, domain.AuditEventDelete, userID, &beforeData, nil)
+	if err := s.repository.DeleteVolunteerApplication(ctx, applicationID, audit); err != nil {
 		return domain.WrapError(err, "failed to delete volunteer application")
 	}
 
-	// Publish audit event
-	return s.repository.PublishAuditEvent(ctx, domain.EntityTypeVolunteerApplication, applicationID, domain.AuditEventDelete, userID, &beforeData, nil)
+	return nil
 }
 
 // GetVolunteerApplicationAudit retrieves audit events for a volunteer application
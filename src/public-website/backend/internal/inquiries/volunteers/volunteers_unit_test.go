@@ -143,15 +143,58 @@ func (m *MockVolunteerRepository) SearchVolunteerApplications(ctx context.Contex
 	return applicationsList, nil
 }
 
-func (m *MockVolunteerRepository) SaveVolunteerApplication(ctx context.Context, application *VolunteerApplication) error {
+func (m *MockVolunteerRepository) ListVolunteerApplications(ctx context.Context, filters ApplicationFilters) ([]*VolunteerApplication, error) {
+	if err, exists := m.failures["ListVolunteerApplications"]; exists {
+		return nil, err
+	}
+
+	applicationsList := make([]*VolunteerApplication, 0, len(m.applications))
+	for _, application := range m.applications {
+		applicationsList = append(applicationsList, application)
+	}
+	result, _ := ApplyApplicationFilters(applicationsList, filters)
+	return result, nil
+}
+
+func (m *MockVolunteerRepository) CountVolunteerApplications(ctx context.Context, filters ApplicationFilters) (int, error) {
+	if err, exists := m.failures["CountVolunteerApplications"]; exists {
+		return 0, err
+	}
+
+	applicationsList := make([]*VolunteerApplication, 0, len(m.applications))
+	for _, application := range m.applications {
+		applicationsList = append(applicationsList, application)
+	}
+	_, total := ApplyApplicationFilters(applicationsList, filters)
+	return total, nil
+}
+
+func (m *MockVolunteerRepository) ListVolunteerApplicationsPage(ctx context.Context, filters ApplicationFilters, page domain.PageRequest) ([]*VolunteerApplication, domain.PageInfo, error) {
+	if err, exists := m.failures["ListVolunteerApplicationsPage"]; exists {
+		return nil, domain.PageInfo{}, err
+	}
+
+	applicationsList := make([]*VolunteerApplication, 0)
+	for _, application := range m.applications {
+		if filters.Matches(application) {
+			applicationsList = append(applicationsList, application)
+		}
+	}
+
+	paged, info := PageVolunteerApplications(applicationsList, page)
+	return paged, info, nil
+}
+
+func (m *MockVolunteerRepository) SaveVolunteerApplication(ctx context.Context, application *VolunteerApplication, audit *domain.AuditEvent) error {
 	if err, exists := m.failures["SaveVolunteerApplication"]; exists {
 		return err
 	}
 	m.applications[application.ApplicationID] = application
+	m.recordAudit(audit)
 	return nil
 }
 
-func (m *MockVolunteerRepository) DeleteVolunteerApplication(ctx context.Context, applicationID string) error {
+func (m *MockVolunteerRepository) DeleteVolunteerApplication(ctx context.Context, applicationID string, audit *domain.AuditEvent) error {
 	if err, exists := m.failures["DeleteVolunteerApplication"]; exists {
 		return err
 	}
@@ -159,6 +202,7 @@ func (m *MockVolunteerRepository) DeleteVolunteerApplication(ctx context.Context
 		application.IsDeleted = true
 		now := time.Now()
 		application.DeletedAt = &now
+		m.recordAudit(audit)
 		return nil
 	}
 	return domain.NewNotFoundError("volunteer_application", applicationID)
@@ -186,21 +230,19 @@ func (m *MockVolunteerRepository) GetVolunteerApplicationAudit(ctx context.Conte
 	return events, nil
 }
 
-// Audit event publishing method
-func (m *MockVolunteerRepository) PublishAuditEvent(ctx context.Context, entityType domain.EntityType, entityID string, operationType domain.AuditEventType, userID string, beforeData, afterData interface{}) error {
-	if err, exists := m.failures["PublishAuditEvent"]; exists {
-		return err
+// recordAudit keeps the audit event a write committed, in place of the outbox
+func (m *MockVolunteerRepository) recordAudit(audit *domain.AuditEvent) {
+	if audit == nil {
+		return
 	}
-	// Record the audit event in memory for testing
 	m.auditEvents = append(m.auditEvents, MockAuditEvent{
-		EntityType:    entityType,
-		EntityID:      entityID,
-		OperationType: operationType,
-		UserID:        userID,
-		Before:        beforeData,
-		After:         afterData,
+		EntityType:    audit.EntityType,
+		EntityID:      audit.EntityID,
+		OperationType: audit.OperationType,
+		UserID:        audit.UserID,
+		Before:        audit.DataSnapshot.Before,
+		After:         audit.DataSnapshot.After,
 	})
-	return nil
 }
 
 // Test Volunteer Service Operations
@@ -933,6 +975,153 @@ func TestVolunteerService_GetVolunteerApplicationsByStatus(t *testing.T) {
 	}
 }
 
+func TestApplyApplicationFilters(t *testing.T) {
+	base := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+	applications := func() []*VolunteerApplication {
+		var result []*VolunteerApplication
+		for i, priority := range []ApplicationPriority{ApplicationPriorityLow, ApplicationPriorityUrgent, ApplicationPriorityMedium, ApplicationPriorityUrgent} {
+			result = append(result, &VolunteerApplication{
+				ApplicationID:     []string{"application-1", "application-2", "application-3", "application-4"}[i],
+				Status:            ApplicationStatusNew,
+				Priority:          priority,
+				VolunteerInterest: VolunteerInterestPatientSupport,
+				CreatedAt:         base.Add(time.Duration(i) * time.Hour),
+			})
+		}
+		result[2].Status = ApplicationStatusApproved
+		result[3].IsDeleted = true
+		return result
+	}
+	status := ApplicationStatusNew
+	sortPriority := ApplicationSortPriority
+	createdFrom := base.Add(time.Hour)
+	limit := 1
+	offset := 1
+
+	tests := []struct {
+		name      string
+		filters   ApplicationFilters
+		wantIDs   []string
+		wantTotal int
+	}{
+		{
+			name:      "default order newest first without deleted",
+			filters:   ApplicationFilters{},
+			wantIDs:   []string{"application-3", "application-2", "application-1"},
+			wantTotal: 3,
+		},
+		{
+			name:      "filter by status and created range",
+			filters:   ApplicationFilters{Status: &status, CreatedFrom: &createdFrom},
+			wantIDs:   []string{"application-2"},
+			wantTotal: 1,
+		},
+		{
+			name:      "sort by priority",
+			filters:   ApplicationFilters{SortBy: &sortPriority},
+			wantIDs:   []string{"application-2", "application-3", "application-1"},
+			wantTotal: 3,
+		},
+		{
+			name:      "limit and offset keep total count",
+			filters:   ApplicationFilters{SortBy: &sortPriority, Limit: &limit, Offset: &offset},
+			wantIDs:   []string{"application-3"},
+			wantTotal: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			result, total := ApplyApplicationFilters(applications(), tt.filters)
+
+			// Assert
+			ids := make([]string, len(result))
+			for i, application := range result {
+				ids[i] = application.ApplicationID
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+			assert.Equal(t, tt.wantTotal, total)
+		})
+	}
+}
+
+func TestVolunteerService_ListVolunteerApplicationsPage(t *testing.T) {
+	base := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+	setup := func(repo *MockVolunteerRepository) {
+		for i, id := range []string{"application-1", "application-2", "application-3"} {
+			repo.applications[id] = &VolunteerApplication{
+				ApplicationID: id,
+				Status:        ApplicationStatusNew,
+				CreatedAt:     base.Add(time.Duration(i) * time.Hour),
+			}
+		}
+		repo.applications["application-4"] = &VolunteerApplication{
+			ApplicationID: "application-4",
+			Status:        ApplicationStatusApproved,
+			CreatedAt:     base.Add(4 * time.Hour),
+		}
+	}
+
+	tests := []struct {
+		name        string
+		status      *ApplicationStatus
+		limit       int
+		wantIDs     []string
+		wantHasMore bool
+		wantErr     bool
+	}{
+		{
+			name:        "first page newest first",
+			limit:       2,
+			wantIDs:     []string{"application-4", "application-3"},
+			wantHasMore: true,
+		},
+		{
+			name:    "filter by status",
+			status:  &[]ApplicationStatus{ApplicationStatusNew}[0],
+			limit:   5,
+			wantIDs: []string{"application-3", "application-2", "application-1"},
+		},
+		{
+			name:    "reject invalid status",
+			status:  &[]ApplicationStatus{"bogus"}[0],
+			limit:   5,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx, cancel := sharedtesting.CreateUnitTestContext()
+			defer cancel()
+
+			repo := NewMockVolunteerRepository()
+			setup(repo)
+			service := NewVolunteerService(repo)
+
+			// Act
+			result, info, err := service.ListVolunteerApplicationsPage(ctx, ApplicationFilters{Status: tt.status}, domain.PageRequest{Limit: tt.limit})
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.True(t, domain.IsValidationError(err))
+				return
+			}
+			require.NoError(t, err)
+			ids := make([]string, len(result))
+			for i, application := range result {
+				ids[i] = application.ApplicationID
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+			assert.Equal(t, tt.wantHasMore, info.HasMore)
+			assert.Equal(t, tt.wantHasMore, info.NextCursor != "")
+		})
+	}
+}
+
 func TestVolunteerService_GetVolunteerApplicationsByInterest(t *testing.T) {
 	tests := []struct {
 		name      string
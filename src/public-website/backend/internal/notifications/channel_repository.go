@@ -0,0 +1,217 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/notifications/email"
+	"github.com/axiom-software-co/international-center/src/backend/internal/notifications/slack"
+	"github.com/axiom-software-co/international-center/src/backend/internal/notifications/sms"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+)
+
+// channelIndexDeliveryStatus indexes every channel's delivery statuses by state
+const channelIndexDeliveryStatus = "delivery_status"
+
+// daprChannelRepository stores the messages and delivery statuses of one notification
+// channel in the Dapr state store. Delivery statuses are indexed by state, so the
+// workers' pending and failed scans only load the messages in that state.
+type daprChannelRepository[M, S any] struct {
+	stateStore    *dapr.StateStore
+	channel       string
+	messageID     func(*M) string
+	statusID      func(*S) string
+	pendingStatus string
+	failedStatus  string
+}
+
+// NewDaprEmailRepository creates the Dapr-backed store of email messages
+func NewDaprEmailRepository(stateStore *dapr.StateStore) email.EmailRepository {
+	return newDaprChannelRepository(stateStore, "email",
+		func(message *email.EmailMessage) string { return message.MessageID },
+		func(status *email.EmailDeliveryStatus) string { return status.MessageID },
+		func(status *email.EmailDeliveryStatus) string { return string(status.Status) },
+		string(email.DeliveryStatusPending), string(email.DeliveryStatusFailed))
+}
+
+// NewDaprSMSRepository creates the Dapr-backed store of SMS messages
+func NewDaprSMSRepository(stateStore *dapr.StateStore) sms.SMSRepository {
+	return newDaprChannelRepository(stateStore, "sms",
+		func(message *sms.SMSMessage) string { return message.MessageID },
+		func(status *sms.SMSDeliveryStatus) string { return status.MessageID },
+		func(status *sms.SMSDeliveryStatus) string { return string(status.Status) },
+		string(sms.SMSStatusPending), string(sms.SMSStatusFailed))
+}
+
+// NewDaprSlackRepository creates the Dapr-backed store of Slack messages
+func NewDaprSlackRepository(stateStore *dapr.StateStore) slack.SlackRepository {
+	return newDaprChannelRepository(stateStore, "slack",
+		func(message *slack.SlackMessage) string { return message.MessageID },
+		func(status *slack.SlackDeliveryStatus) string { return status.MessageID },
+		func(status *slack.SlackDeliveryStatus) string { return string(status.Status) },
+		string(slack.SlackStatusPending), string(slack.SlackStatusFailed))
+}
+
+func newDaprChannelRepository[M, S any](
+	stateStore *dapr.StateStore,
+	channel string,
+	messageID func(*M) string,
+	statusID func(*S) string,
+	statusOf func(*S) string,
+	pendingStatus, failedStatus string,
+) *daprChannelRepository[M, S] {
+	stateStore.MustRegisterIndexes(&dapr.IndexedEntityType{
+		Domain:     "notifications",
+		EntityType: channel + "_delivery_status",
+		Indexes: []dapr.IndexDefinition{
+			{Name: channelIndexDeliveryStatus, Extract: func(entity interface{}) []string {
+				return []string{statusOf(entity.(*S))}
+			}},
+		},
+		NewEntity: func() interface{} { return new(S) },
+		EntityID:  func(entity interface{}) string { return statusID(entity.(*S)) },
+	})
+
+	return &daprChannelRepository[M, S]{
+		stateStore:    stateStore,
+		channel:       channel,
+		messageID:     messageID,
+		statusID:      statusID,
+		pendingStatus: pendingStatus,
+		failedStatus:  failedStatus,
+	}
+}
+
+// SaveMessage stores a message before it is sent
+func (r *daprChannelRepository[M, S]) SaveMessage(ctx context.Context, message *M) error {
+	id := r.messageID(message)
+	if err := r.stateStore.Save(ctx, r.messageKey(id), message, nil); err != nil {
+		return fmt.Errorf("failed to save %s message %s: %w", r.channel, id, err)
+	}
+	return nil
+}
+
+// GetMessage retrieves a message by ID
+func (r *daprChannelRepository[M, S]) GetMessage(ctx context.Context, messageID string) (*M, error) {
+	message := new(M)
+	found, err := r.stateStore.Get(ctx, r.messageKey(messageID), message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s message %s: %w", r.channel, messageID, err)
+	}
+	if !found {
+		return nil, domain.NewNotFoundError(r.channel+" message", messageID)
+	}
+	return message, nil
+}
+
+// UpdateMessage replaces a stored message
+func (r *daprChannelRepository[M, S]) UpdateMessage(ctx context.Context, message *M) error {
+	return r.SaveMessage(ctx, message)
+}
+
+// DeleteMessage removes a message together with its delivery status
+func (r *daprChannelRepository[M, S]) DeleteMessage(ctx context.Context, messageID string) error {
+	if err := r.stateStore.DeleteIndexed(ctx, "notifications", r.statusEntityType(), messageID); err != nil {
+		return fmt.Errorf("failed to delete %s delivery status %s: %w", r.channel, messageID, err)
+	}
+	if err := r.stateStore.Delete(ctx, r.messageKey(messageID), nil); err != nil {
+		return fmt.Errorf("failed to delete %s message %s: %w", r.channel, messageID, err)
+	}
+	return nil
+}
+
+// SaveDeliveryStatus stores a delivery status and moves it to the index of its state
+func (r *daprChannelRepository[M, S]) SaveDeliveryStatus(ctx context.Context, status *S) error {
+	id := r.statusID(status)
+	if err := r.stateStore.SaveIndexed(ctx, "notifications", r.statusEntityType(), id, status); err != nil {
+		return fmt.Errorf("failed to save %s delivery status %s: %w", r.channel, id, err)
+	}
+	return nil
+}
+
+// GetDeliveryStatus retrieves the delivery status of a message
+func (r *daprChannelRepository[M, S]) GetDeliveryStatus(ctx context.Context, messageID string) (*S, error) {
+	status := new(S)
+	key := r.stateStore.CreateKey("notifications", r.statusEntityType(), messageID)
+	found, err := r.stateStore.Get(ctx, key, status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s delivery status %s: %w", r.channel, messageID, err)
+	}
+	if !found {
+		return nil, domain.NewNotFoundError(r.channel+" delivery status", messageID)
+	}
+	return status, nil
+}
+
+// UpdateDeliveryStatus replaces a stored delivery status
+func (r *daprChannelRepository[M, S]) UpdateDeliveryStatus(ctx context.Context, status *S) error {
+	return r.SaveDeliveryStatus(ctx, status)
+}
+
+// GetPendingMessages returns the messages whose delivery is still pending
+func (r *daprChannelRepository[M, S]) GetPendingMessages(ctx context.Context) ([]*M, error) {
+	return r.messagesInState(ctx, r.pendingStatus, 0)
+}
+
+// GetFailedMessages returns up to limit messages whose delivery failed
+func (r *daprChannelRepository[M, S]) GetFailedMessages(ctx context.Context, limit int) ([]*M, error) {
+	return r.messagesInState(ctx, r.failedStatus, limit)
+}
+
+// HealthCheck verifies the state store can be read
+func (r *daprChannelRepository[M, S]) HealthCheck(ctx context.Context) error {
+	var probe map[string]interface{}
+	if _, err := r.stateStore.Get(ctx, r.messageKey("health-check"), &probe); err != nil {
+		return domain.NewDependencyError(r.channel+" message store", err)
+	}
+	return nil
+}
+
+// messagesInState loads the messages whose delivery status is in state; a limit of
+// zero or less loads all of them
+func (r *daprChannelRepository[M, S]) messagesInState(ctx context.Context, state string, limit int) ([]*M, error) {
+	ids, err := r.stateStore.LookupIndex(ctx, "notifications", r.statusEntityType(),
+		dapr.IndexCondition{Index: channelIndexDeliveryStatus, Value: state})
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up %s %s messages: %w", state, r.channel, err)
+	}
+	if limit > 0 && len(ids) > limit {
+		ids = ids[:limit]
+	}
+
+	messages := make([]*M, 0, len(ids))
+	if len(ids) == 0 {
+		return messages, nil
+	}
+
+	keys := make([]string, 0, len(ids))
+	targets := make(map[string]interface{}, len(ids))
+	loaded := make([]*M, 0, len(ids))
+	for _, id := range ids {
+		key := r.messageKey(id)
+		message := new(M)
+		keys = append(keys, key)
+		targets[key] = message
+		loaded = append(loaded, message)
+	}
+
+	if err := r.stateStore.GetBulk(ctx, keys, targets); err != nil {
+		return nil, fmt.Errorf("failed to load %s %s messages: %w", state, r.channel, err)
+	}
+
+	for _, message := range loaded {
+		if r.messageID(message) != "" {
+			messages = append(messages, message)
+		}
+	}
+	return messages, nil
+}
+
+func (r *daprChannelRepository[M, S]) messageKey(messageID string) string {
+	return r.stateStore.CreateKey("notifications", r.channel+"_message", messageID)
+}
+
+func (r *daprChannelRepository[M, S]) statusEntityType() string {
+	return r.channel + "_delivery_status"
+}
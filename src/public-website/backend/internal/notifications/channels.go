@@ -0,0 +1,253 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/notifications/email"
+	"github.com/axiom-software-co/international-center/src/backend/internal/notifications/slack"
+	"github.com/axiom-software-co/international-center/src/backend/internal/notifications/sms"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
+)
+
+// NotificationChannels holds the email, SMS and Slack handler services enabled in the
+// configuration; the service of a disabled channel is nil
+type NotificationChannels struct {
+	Email *email.EmailHandlerService
+	SMS   *sms.SMSHandlerService
+	Slack *slack.SlackHandlerService
+}
+
+// NewNotificationChannels builds the handler services of the enabled channels. Their
+// credentials are read from the secret store, so each client starts with the value the
+// secret rotator later replaces.
+func NewNotificationChannels(
+	ctx context.Context,
+	stateStore *dapr.StateStore,
+	secrets *dapr.Secrets,
+	queue MessageQueueClient,
+	config *NotificationConfig,
+	logger *slog.Logger,
+) (*NotificationChannels, error) {
+	channels := &NotificationChannels{}
+	deadLetterEnabled := config.MessageQueue != nil && config.MessageQueue.EnableDeadLetter
+
+	if config.Email != nil && config.Email.Enabled {
+		connectionString, err := secrets.GetSecret(ctx, SecretKeyEmailConnectionString)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read email credentials: %w", err)
+		}
+		azure := config.Email.Azure
+		channels.Email = email.NewEmailHandlerService(
+			newChannelQueue(queue, func(fields queueMessageFields) email.QueueMessage { return email.QueueMessage(fields) }),
+			NewDaprEmailRepository(stateStore),
+			email.NewAzureCommunicationEmailClient(logger),
+			email.NewDefaultEmailTemplateRenderer(logger, &email.TemplateRendererConfig{CacheEnabled: true, DefaultLanguage: "en"}),
+			logger,
+			&email.EmailHandlerConfig{
+				QueueName:         config.Email.QueueName,
+				Workers:           config.Email.Workers,
+				ProcessingDelay:   config.Email.ProcessingDelay,
+				RetryDelay:        config.RetryDelay,
+				MaxRetries:        config.MaxRetries,
+				BatchSize:         config.BatchSize,
+				DeadLetterEnabled: deadLetterEnabled,
+				Azure: &email.AzureEmailConfig{
+					ConnectionString: connectionString,
+					SenderAddress:    azure.SenderAddress,
+					SenderName:       azure.SenderName,
+					MaxRetries:       azure.MaxRetries,
+					RetryDelay:       azure.RetryDelay,
+					RequestTimeout:   azure.RequestTimeout,
+				},
+			},
+		)
+	}
+
+	if config.SMS != nil && config.SMS.Enabled {
+		connectionString, err := secrets.GetSecret(ctx, SecretKeySMSConnectionString)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read SMS credentials: %w", err)
+		}
+		azure := config.SMS.Azure
+		channels.SMS = sms.NewSMSHandlerService(
+			newChannelQueue(queue, func(fields queueMessageFields) sms.QueueMessage { return sms.QueueMessage(fields) }),
+			NewDaprSMSRepository(stateStore),
+			sms.NewAzureCommunicationSMSClient(logger),
+			logger,
+			&sms.SMSHandlerConfig{
+				QueueName:         config.SMS.QueueName,
+				Workers:           config.SMS.Workers,
+				ProcessingDelay:   config.SMS.ProcessingDelay,
+				RetryDelay:        config.RetryDelay,
+				MaxRetries:        config.MaxRetries,
+				BatchSize:         config.BatchSize,
+				DeadLetterEnabled: deadLetterEnabled,
+				Azure: &sms.AzureSMSConfig{
+					ConnectionString: connectionString,
+					FromNumber:       azure.FromNumber,
+					MaxRetries:       azure.MaxRetries,
+					RetryDelay:       azure.RetryDelay,
+					RequestTimeout:   azure.RequestTimeout,
+				},
+			},
+		)
+	}
+
+	if config.Slack != nil && config.Slack.Enabled {
+		botToken, err := secrets.GetSecret(ctx, SecretKeySlackBotToken)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read Slack credentials: %w", err)
+		}
+		slackConfig := config.Slack.Slack
+		channels.Slack = slack.NewSlackHandlerService(
+			newChannelQueue(queue, func(fields queueMessageFields) slack.QueueMessage { return slack.QueueMessage(fields) }),
+			NewDaprSlackRepository(stateStore),
+			slack.NewSlackWebAPIClient(logger),
+			logger,
+			&slack.SlackHandlerConfig{
+				QueueName:         config.Slack.QueueName,
+				Workers:           config.Slack.Workers,
+				ProcessingDelay:   config.Slack.ProcessingDelay,
+				RetryDelay:        config.RetryDelay,
+				MaxRetries:        config.MaxRetries,
+				BatchSize:         config.BatchSize,
+				DeadLetterEnabled: deadLetterEnabled,
+				Slack: &slack.SlackConfig{
+					BotToken:       botToken,
+					DefaultChannel: slackConfig.DefaultChannel,
+					MaxRetries:     slackConfig.MaxRetries,
+					RetryDelay:     slackConfig.RetryDelay,
+					RequestTimeout: slackConfig.RequestTimeout,
+				},
+			},
+		)
+	}
+
+	return channels, nil
+}
+
+// SubscribeCredentials re-initializes each channel client when the rotator sees its
+// credential change
+func (c *NotificationChannels) SubscribeCredentials(rotator *dapr.SecretRotator) error {
+	var consumers []dapr.SecretConsumer
+	if c.Email != nil {
+		consumers = append(consumers, c.Email.CredentialConsumer(SecretKeyEmailConnectionString))
+	}
+	if c.SMS != nil {
+		consumers = append(consumers, c.SMS.CredentialConsumer(SecretKeySMSConnectionString))
+	}
+	if c.Slack != nil {
+		consumers = append(consumers, c.Slack.CredentialConsumer(SecretKeySlackBotToken))
+	}
+
+	for _, consumer := range consumers {
+		if err := rotator.Subscribe(consumer); err != nil {
+			return fmt.Errorf("failed to subscribe %s to credential rotation: %w", consumer.Name, err)
+		}
+	}
+	return nil
+}
+
+// UseDeduplication makes every enabled channel send each queued request at most once.
+// The channels record deliveries under their own queue names, so one deduplicator
+// serves them all.
+func (c *NotificationChannels) UseDeduplication(deduplicator *dapr.EventDeduplicator) {
+	if c.Email != nil {
+		c.Email.UseDeduplication(deduplicator)
+	}
+	if c.SMS != nil {
+		c.SMS.UseDeduplication(deduplicator)
+	}
+	if c.Slack != nil {
+		c.Slack.UseDeduplication(deduplicator)
+	}
+}
+
+// Start starts the handler service of every enabled channel
+func (c *NotificationChannels) Start(ctx context.Context) error {
+	if c.Email != nil {
+		if err := c.Email.Start(ctx); err != nil {
+			return fmt.Errorf("failed to start email handler: %w", err)
+		}
+	}
+	if c.SMS != nil {
+		if err := c.SMS.Start(ctx); err != nil {
+			return fmt.Errorf("failed to start SMS handler: %w", err)
+		}
+	}
+	if c.Slack != nil {
+		if err := c.Slack.Start(ctx); err != nil {
+			return fmt.Errorf("failed to start Slack handler: %w", err)
+		}
+	}
+	return nil
+}
+
+// Stop stops the handler service of every enabled channel, returning the first error
+func (c *NotificationChannels) Stop(ctx context.Context) error {
+	var errs []error
+	if c.Email != nil {
+		errs = append(errs, c.Email.Stop(ctx))
+	}
+	if c.SMS != nil {
+		errs = append(errs, c.SMS.Stop(ctx))
+	}
+	if c.Slack != nil {
+		errs = append(errs, c.Slack.Stop(ctx))
+	}
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// queueMessageFields is the layout shared by the QueueMessage type of every channel
+// package, which lets a channel queue convert to it directly
+type queueMessageFields struct {
+	ID            string
+	Data          []byte
+	Headers       map[string]string
+	CorrelationID string
+	Timestamp     time.Time
+}
+
+// channelQueue adapts the notification message queue to the consumer interface of
+// one channel package
+type channelQueue[Q any] struct {
+	queue   MessageQueueClient
+	convert func(queueMessageFields) Q
+}
+
+func newChannelQueue[Q any](queue MessageQueueClient, convert func(queueMessageFields) Q) *channelQueue[Q] {
+	return &channelQueue[Q]{queue: queue, convert: convert}
+}
+
+// Subscribe delivers the messages of queueName to handler as the channel's queue messages
+func (c *channelQueue[Q]) Subscribe(ctx context.Context, queueName string, handler func(context.Context, *Q) error) error {
+	return c.queue.Subscribe(ctx, queueName, func(ctx context.Context, message *Message) error {
+		converted := c.convert(queueMessageFields{
+			ID:            message.ID,
+			Data:          message.Data,
+			Headers:       message.Headers,
+			CorrelationID: message.CorrelationID,
+			Timestamp:     message.Timestamp,
+		})
+		return handler(ctx, &converted)
+	})
+}
+
+// Unsubscribe stops delivering the messages of queueName
+func (c *channelQueue[Q]) Unsubscribe(ctx context.Context, queueName string) error {
+	return c.queue.Unsubscribe(ctx, queueName)
+}
+
+// HealthCheck checks the underlying message queue
+func (c *channelQueue[Q]) HealthCheck(ctx context.Context) error {
+	return c.queue.HealthCheck(ctx)
+}
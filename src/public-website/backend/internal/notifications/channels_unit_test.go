@@ -0,0 +1,196 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/notifications/email"
+	"github.com/axiom-software-co/international-center/src/backend/internal/notifications/slack"
+	"github.com/axiom-software-co/international-center/src/backend/internal/notifications/sms"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingEmailClient keeps the connection strings the email client is initialized
+// with and the subjects of the emails it sends
+type recordingEmailClient struct {
+	email.AzureEmailClient
+	credentials []string
+	sent        []string
+}
+
+func (c *recordingEmailClient) Initialize(ctx context.Context, config *email.AzureEmailConfig) error {
+	c.credentials = append(c.credentials, config.ConnectionString)
+	return nil
+}
+
+func (c *recordingEmailClient) SendEmail(ctx context.Context, request *email.AzureSendEmailRequest) (*email.AzureSendEmailResponse, error) {
+	c.sent = append(c.sent, request.Content.Subject)
+	return &email.AzureSendEmailResponse{MessageID: "azure-msg-1", Status: "Accepted"}, nil
+}
+
+// recordingSMSClient keeps the connection strings the SMS client is initialized with
+type recordingSMSClient struct {
+	sms.AzureSMSClient
+	credentials []string
+}
+
+func (c *recordingSMSClient) Initialize(ctx context.Context, config *sms.AzureSMSConfig) error {
+	c.credentials = append(c.credentials, config.ConnectionString)
+	return nil
+}
+
+// recordingSlackClient keeps the bot tokens the Slack client is initialized with
+type recordingSlackClient struct {
+	slack.SlackAPIClient
+	credentials []string
+}
+
+func (c *recordingSlackClient) Initialize(ctx context.Context, config *slack.SlackConfig) error {
+	c.credentials = append(c.credentials, config.BotToken)
+	return nil
+}
+
+// capturingMessageQueue keeps the subscribed handlers so a test can deliver messages
+type capturingMessageQueue struct {
+	MessageQueueClient
+	handlers map[string]MessageHandler
+}
+
+func (q *capturingMessageQueue) Subscribe(ctx context.Context, topic string, handler MessageHandler) error {
+	q.handlers[topic] = handler
+	return nil
+}
+
+func (q *capturingMessageQueue) Unsubscribe(ctx context.Context, topic string) error {
+	delete(q.handlers, topic)
+	return nil
+}
+
+func TestNotificationChannels_SubscribeCredentials(t *testing.T) {
+	tests := []struct {
+		name          string
+		rotatedKey    string
+		rotatedValue  string
+		expectedEmail []string
+		expectedSMS   []string
+		expectedSlack []string
+	}{
+		{name: "email connection string rotated", rotatedKey: SecretKeyEmailConnectionString,
+			rotatedValue:  "endpoint=https://acs.example.com/;accesskey=rotated",
+			expectedEmail: []string{"endpoint=https://acs.example.com/;accesskey=rotated"}},
+		{name: "SMS connection string rotated", rotatedKey: SecretKeySMSConnectionString,
+			rotatedValue: "endpoint=https://acs.example.com/;accesskey=rotated",
+			expectedSMS:  []string{"endpoint=https://acs.example.com/;accesskey=rotated"}},
+		{name: "Slack bot token rotated", rotatedKey: SecretKeySlackBotToken,
+			rotatedValue:  "xoxb-rotated",
+			expectedSlack: []string{"xoxb-rotated"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+			logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+			store := dapr.NewLocalSecretStore(map[string]string{
+				SecretKeyEmailConnectionString: "endpoint=https://acs.example.com/;accesskey=initial",
+				SecretKeySMSConnectionString:   "endpoint=https://acs.example.com/;accesskey=initial",
+				SecretKeySlackBotToken:         "xoxb-initial",
+			})
+			secrets := dapr.NewSecrets(&dapr.Client{})
+			secrets.UseBackend(store)
+			rotator := dapr.NewSecretRotator(secrets, dapr.NewStateStoreWithBackend(dapr.NewMemoryStateBackend()), dapr.NewPubSub(&dapr.Client{}))
+			for _, policy := range SecretRotationPolicies() {
+				require.NoError(t, rotator.Register(policy))
+			}
+
+			emailClient := &recordingEmailClient{}
+			smsClient := &recordingSMSClient{}
+			slackClient := &recordingSlackClient{}
+			channels := &NotificationChannels{
+				Email: email.NewEmailHandlerService(nil, nil, emailClient, nil, logger, &email.EmailHandlerConfig{
+					Azure: &email.AzureEmailConfig{ConnectionString: "endpoint=https://acs.example.com/;accesskey=initial", SenderAddress: "noreply@example.com"},
+				}),
+				SMS: sms.NewSMSHandlerService(nil, nil, smsClient, logger, &sms.SMSHandlerConfig{
+					Azure: &sms.AzureSMSConfig{ConnectionString: "endpoint=https://acs.example.com/;accesskey=initial", FromNumber: "+15555550100"},
+				}),
+				Slack: slack.NewSlackHandlerService(nil, nil, slackClient, logger, &slack.SlackHandlerConfig{
+					Slack: &slack.SlackConfig{BotToken: "xoxb-initial", DefaultChannel: "#notifications"},
+				}),
+			}
+			require.NoError(t, channels.SubscribeCredentials(rotator))
+			require.NoError(t, rotator.CheckSecrets(ctx))
+
+			_, err := store.PutSecret(ctx, tt.rotatedKey, tt.rotatedValue)
+			require.NoError(t, err)
+
+			// Act
+			err = rotator.CheckSecrets(ctx)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedEmail, emailClient.credentials)
+			assert.Equal(t, tt.expectedSMS, smsClient.credentials)
+			assert.Equal(t, tt.expectedSlack, slackClient.credentials)
+		})
+	}
+}
+
+func TestNotificationChannels_UseDeduplication(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	stateStore := dapr.NewStateStoreWithBackend(dapr.NewMemoryStateBackend())
+	queue := &capturingMessageQueue{handlers: make(map[string]MessageHandler)}
+	emailClient := &recordingEmailClient{}
+	channels := &NotificationChannels{
+		Email: email.NewEmailHandlerService(
+			newChannelQueue(queue, func(fields queueMessageFields) email.QueueMessage { return email.QueueMessage(fields) }),
+			NewDaprEmailRepository(stateStore),
+			emailClient,
+			email.NewDefaultEmailTemplateRenderer(logger, &email.TemplateRendererConfig{CacheEnabled: true, DefaultLanguage: "en"}),
+			logger,
+			&email.EmailHandlerConfig{
+				QueueName:       "email-notifications",
+				Workers:         1,
+				ProcessingDelay: time.Hour,
+				Azure:           &email.AzureEmailConfig{ConnectionString: "endpoint=https://acs.example.com/;accesskey=initial", SenderAddress: "noreply@example.com"},
+			},
+		),
+	}
+	deduplicator := dapr.NewEventDeduplicator(stateStore)
+	channels.UseDeduplication(deduplicator)
+	require.NoError(t, channels.Start(ctx))
+
+	data, err := json.Marshal(email.EmailNotificationRequest{
+		SubscriberID:  "sub-001",
+		EventType:     "inquiry-business",
+		Priority:      "high",
+		Recipients:    []string{"business@example.com"},
+		EventData:     map[string]interface{}{"entity_id": "biz-001"},
+		Schedule:      "immediate",
+		CreatedAt:     time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC),
+		CorrelationID: "corr-001",
+	})
+	require.NoError(t, err)
+	message := &Message{ID: "queue-message-1", Data: data, CorrelationID: "corr-001"}
+
+	// Act
+	firstErr := queue.handlers["email-notifications"](ctx, message)
+	redeliveryErr := queue.handlers["email-notifications"](ctx, message)
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.NoError(t, redeliveryErr)
+	assert.Len(t, emailClient.sent, 1, "the redelivered request is not sent again")
+	metrics := deduplicator.Metrics()
+	assert.Equal(t, int64(1), metrics.Processed)
+	assert.Equal(t, int64(1), metrics.DuplicatesDropped)
+}
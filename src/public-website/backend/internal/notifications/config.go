@@ -356,6 +356,11 @@ func (c *NotificationConfig) Validate() error {
 		return fmt.Errorf("observability config validation failed: %w", err)
 	}
 
+	// Validate rate limits and worker pool sizing
+	if err := c.validateCapacityConfig(); err != nil {
+		return fmt.Errorf("capacity config validation failed: %w", err)
+	}
+
 	return nil
 }
 
@@ -573,6 +578,37 @@ func (c *NotificationConfig) validateObservabilityConfig() error {
 	return nil
 }
 
+// validateCapacityConfig validates the rate limits and worker pool size, which can
+// also change while the service runs
+func (c *NotificationConfig) validateCapacityConfig() error {
+	if c.Reliability != nil && c.Reliability.RateLimit != nil && c.Reliability.RateLimit.Enabled {
+		rateLimit := c.Reliability.RateLimit
+		if rateLimit.MaxRequests <= 0 {
+			return domain.NewValidationError("rate limit max requests must be positive")
+		}
+
+		if rateLimit.WindowDuration <= 0 {
+			return domain.NewValidationError("rate limit window must be positive")
+		}
+
+		if rateLimit.BurstSize <= 0 {
+			return domain.NewValidationError("rate limit burst size must be positive")
+		}
+	}
+
+	if c.Performance != nil && c.Performance.WorkerPool != nil && c.Performance.WorkerPool.Enabled {
+		if c.Performance.WorkerPool.WorkerCount <= 0 {
+			return domain.NewValidationError("worker pool size must be positive")
+		}
+	}
+
+	if c.Slack != nil && c.Slack.Slack != nil && c.Slack.Slack.RateLimit < 0 {
+		return domain.NewValidationError("Slack rate limit cannot be negative")
+	}
+
+	return nil
+}
+
 // GetEnabledHandlers returns a list of enabled notification handlers
 func (c *NotificationConfig) GetEnabledHandlers() []string {
 	var handlers []string
@@ -0,0 +1,192 @@
+package notifications
+
+import (
+	"sync"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
+)
+
+// Configuration store keys the notification service reloads while running
+const (
+	ConfigKeyRateLimitEnabled     = "notifications.rate_limit.enabled"
+	ConfigKeyRateLimitMaxRequests = "notifications.rate_limit.max_requests"
+	ConfigKeyRateLimitWindow      = "notifications.rate_limit.window"
+	ConfigKeyRateLimitBurstSize   = "notifications.rate_limit.burst_size"
+	ConfigKeyWorkerPoolSize       = "notifications.worker_pool.workers"
+	ConfigKeyEmailEnabled         = "notifications.email.enabled"
+	ConfigKeyEmailWorkers         = "notifications.email.workers"
+	ConfigKeySMSEnabled           = "notifications.sms.enabled"
+	ConfigKeySMSWorkers           = "notifications.sms.workers"
+	ConfigKeySlackEnabled         = "notifications.slack.enabled"
+	ConfigKeySlackWorkers         = "notifications.slack.workers"
+	ConfigKeySlackRateLimit       = "notifications.slack.rate_limit"
+)
+
+// ReloadableNotificationConfig holds the notification configuration in effect and
+// resizes the rate limiter and worker pool built from it when the configuration
+// store changes. The configuration returned by Current is never modified; a reload
+// replaces it.
+type ReloadableNotificationConfig struct {
+	mutex       sync.RWMutex
+	startup     *NotificationConfig
+	current     *NotificationConfig
+	rateLimiter *RateLimiter
+	workerPool  *WorkerPool
+}
+
+// NewReloadableNotificationConfig wraps the startup configuration. The rate limiter
+// and worker pool are optional.
+func NewReloadableNotificationConfig(config *NotificationConfig, rateLimiter *RateLimiter, workerPool *WorkerPool) *ReloadableNotificationConfig {
+	return &ReloadableNotificationConfig{
+		startup:     config,
+		current:     config,
+		rateLimiter: rateLimiter,
+		workerPool:  workerPool,
+	}
+}
+
+// Current returns the notification configuration in effect
+func (r *ReloadableNotificationConfig) Current() *NotificationConfig {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return r.current
+}
+
+// IsHandlerEnabled reports whether a channel ("email", "sms" or "slack") is switched on
+func (r *ReloadableNotificationConfig) IsHandlerEnabled(handler string) bool {
+	for _, enabled := range r.Current().GetEnabledHandlers() {
+		if enabled == handler {
+			return true
+		}
+	}
+	return false
+}
+
+// ConfigListener reloads the rate limit, worker counts and channel toggles. The
+// changed configuration must pass Validate as a whole, so a change that leaves no
+// channel enabled or sizes a pool to zero is rejected and the current one kept.
+func (r *ReloadableNotificationConfig) ConfigListener() dapr.ConfigListener {
+	return dapr.ConfigListener{
+		Name: "notifications",
+		Keys: []string{
+			ConfigKeyRateLimitEnabled, ConfigKeyRateLimitMaxRequests, ConfigKeyRateLimitWindow, ConfigKeyRateLimitBurstSize,
+			ConfigKeyWorkerPoolSize,
+			ConfigKeyEmailEnabled, ConfigKeyEmailWorkers,
+			ConfigKeySMSEnabled, ConfigKeySMSWorkers,
+			ConfigKeySlackEnabled, ConfigKeySlackWorkers, ConfigKeySlackRateLimit,
+		},
+		Prepare: r.prepare,
+	}
+}
+
+func (r *ReloadableNotificationConfig) prepare(values dapr.ConfigValues) (func(), error) {
+	next, err := reloadNotificationConfig(r.startup, values)
+	if err != nil {
+		return nil, err
+	}
+	if err := next.Validate(); err != nil {
+		return nil, err
+	}
+
+	return func() {
+		r.mutex.Lock()
+		r.current = next
+		r.mutex.Unlock()
+
+		if r.rateLimiter != nil && next.Reliability != nil && next.Reliability.RateLimit != nil && next.Reliability.RateLimit.Enabled {
+			r.rateLimiter.SetLimit(rateLimiterSettings(next.Reliability.RateLimit))
+		}
+		if r.workerPool != nil && next.Performance != nil && next.Performance.WorkerPool != nil && next.Performance.WorkerPool.Enabled {
+			// Validate has already checked the size, so resizing cannot fail
+			_ = r.workerPool.Resize(next.Performance.WorkerPool.WorkerCount)
+		}
+	}, nil
+}
+
+// reloadNotificationConfig copies the sections of base that can be reloaded and
+// overlays the values from the configuration store
+func reloadNotificationConfig(base *NotificationConfig, values dapr.ConfigValues) (*NotificationConfig, error) {
+	next := *base
+	var err error
+
+	if base.Email != nil {
+		email := *base.Email
+		if email.Enabled, err = values.Bool(ConfigKeyEmailEnabled, email.Enabled); err != nil {
+			return nil, err
+		}
+		if email.Workers, err = values.Int(ConfigKeyEmailWorkers, email.Workers); err != nil {
+			return nil, err
+		}
+		next.Email = &email
+	}
+
+	if base.SMS != nil {
+		sms := *base.SMS
+		if sms.Enabled, err = values.Bool(ConfigKeySMSEnabled, sms.Enabled); err != nil {
+			return nil, err
+		}
+		if sms.Workers, err = values.Int(ConfigKeySMSWorkers, sms.Workers); err != nil {
+			return nil, err
+		}
+		next.SMS = &sms
+	}
+
+	if base.Slack != nil {
+		slack := *base.Slack
+		if slack.Enabled, err = values.Bool(ConfigKeySlackEnabled, slack.Enabled); err != nil {
+			return nil, err
+		}
+		if slack.Workers, err = values.Int(ConfigKeySlackWorkers, slack.Workers); err != nil {
+			return nil, err
+		}
+		if slack.Slack != nil {
+			api := *slack.Slack
+			if api.RateLimit, err = values.Int(ConfigKeySlackRateLimit, api.RateLimit); err != nil {
+				return nil, err
+			}
+			slack.Slack = &api
+		}
+		next.Slack = &slack
+	}
+
+	if base.Reliability != nil && base.Reliability.RateLimit != nil {
+		reliability := *base.Reliability
+		rateLimit := *base.Reliability.RateLimit
+		if rateLimit.Enabled, err = values.Bool(ConfigKeyRateLimitEnabled, rateLimit.Enabled); err != nil {
+			return nil, err
+		}
+		maxRequests, err := values.Int(ConfigKeyRateLimitMaxRequests, int(rateLimit.MaxRequests))
+		if err != nil {
+			return nil, err
+		}
+		burstSize, err := values.Int(ConfigKeyRateLimitBurstSize, int(rateLimit.BurstSize))
+		if err != nil {
+			return nil, err
+		}
+		if rateLimit.WindowDuration, err = values.Duration(ConfigKeyRateLimitWindow, rateLimit.WindowDuration); err != nil {
+			return nil, err
+		}
+		rateLimit.MaxRequests, rateLimit.BurstSize = int64(maxRequests), int64(burstSize)
+		reliability.RateLimit = &rateLimit
+		next.Reliability = &reliability
+	}
+
+	if base.Performance != nil && base.Performance.WorkerPool != nil {
+		performance := *base.Performance
+		workerPool := *base.Performance.WorkerPool
+		if workerPool.WorkerCount, err = values.Int(ConfigKeyWorkerPoolSize, workerPool.WorkerCount); err != nil {
+			return nil, err
+		}
+		performance.WorkerPool = &workerPool
+		next.Performance = &performance
+	}
+
+	return &next, nil
+}
+
+// rateLimiterSettings converts a rate limit into token bucket settings: the burst
+// size is the bucket and one token is added every window / max requests
+func rateLimiterSettings(rateLimit *RateLimitConfig) (int64, time.Duration) {
+	return rateLimit.BurstSize, rateLimit.WindowDuration / time.Duration(rateLimit.MaxRequests)
+}
@@ -0,0 +1,77 @@
+package notifications
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReloadableNotificationConfig_ConfigListener(t *testing.T) {
+	tests := []struct {
+		name                string
+		change              map[string]string
+		expectedError       bool
+		expectedPoolWorkers int
+		expectedHandlers    []string
+		expectedWindow      time.Duration
+	}{
+		{name: "resize worker pool", change: map[string]string{ConfigKeyWorkerPoolSize: "4"},
+			expectedPoolWorkers: 4, expectedHandlers: []string{"email", "sms", "slack"}, expectedWindow: time.Minute},
+		{name: "switch channel off and tighten rate limit", change: map[string]string{ConfigKeySMSEnabled: "false", ConfigKeyRateLimitWindow: "2m"},
+			expectedPoolWorkers: 10, expectedHandlers: []string{"email", "slack"}, expectedWindow: 2 * time.Minute},
+		{name: "reject empty worker pool", change: map[string]string{ConfigKeyWorkerPoolSize: "0", ConfigKeySMSEnabled: "false"}, expectedError: true,
+			expectedPoolWorkers: 10, expectedHandlers: []string{"email", "sms", "slack"}, expectedWindow: time.Minute},
+		{name: "reject disabling every channel", change: map[string]string{ConfigKeyEmailEnabled: "false", ConfigKeySMSEnabled: "false", ConfigKeySlackEnabled: "off"}, expectedError: true,
+			expectedPoolWorkers: 10, expectedHandlers: []string{"email", "sms", "slack"}, expectedWindow: time.Minute},
+		{name: "reject negative channel workers", change: map[string]string{ConfigKeyEmailWorkers: "-1"}, expectedError: true,
+			expectedPoolWorkers: 10, expectedHandlers: []string{"email", "sms", "slack"}, expectedWindow: time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			config := DefaultNotificationConfig()
+			config.Database.ConnectionString = "postgres://notifications@localhost/notifications"
+			workerPool := NewWorkerPool(config.Performance.WorkerPool.WorkerCount, slog.New(slog.NewTextHandler(io.Discard, nil)))
+			workerPool.Start(ctx)
+			rateLimiter := NewRateLimiter(config.Reliability.RateLimit.BurstSize, time.Second)
+			reloadable := NewReloadableNotificationConfig(config, rateLimiter, workerPool)
+
+			reloader := dapr.NewConfigReloader(nil)
+			require.NoError(t, reloader.Register(reloadable.ConfigListener()))
+			registered := reloadable.Current()
+
+			items := map[string]*dapr.ConfigItem{}
+			for key, value := range tt.change {
+				items[key] = &dapr.ConfigItem{Key: key, Value: value}
+			}
+
+			// Act
+			err := reloader.Apply(items)
+
+			// Assert
+			current := reloadable.Current()
+			assert.Equal(t, tt.expectedHandlers, current.GetEnabledHandlers())
+			assert.Equal(t, tt.expectedWindow, current.Reliability.RateLimit.WindowDuration)
+			assert.Equal(t, tt.expectedPoolWorkers, workerPool.GetMetrics()["total_workers"])
+			assert.Eventually(t, func() bool { return workerPool.GetActiveWorkers() == int32(tt.expectedPoolWorkers) }, time.Second, 5*time.Millisecond)
+			assert.Equal(t, 5, config.Email.Workers, "the startup configuration is never modified")
+			if tt.expectedError {
+				assert.True(t, domain.IsValidationError(err), "got %v", err)
+				assert.Same(t, registered, current, "the rejected change is rolled back")
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
@@ -18,8 +19,10 @@ type AzureEmailClient interface {
 	HealthCheck(ctx context.Context) error
 }
 
-// AzureCommunicationEmailClient implements AzureEmailClient
+// AzureCommunicationEmailClient implements AzureEmailClient. Initialize may run
+// again while emails are being sent, so the settings it replaces are guarded by mutex.
 type AzureCommunicationEmailClient struct {
+	mutex      sync.RWMutex
 	config     *AzureEmailConfig
 	httpClient *http.Client
 	logger     *slog.Logger
@@ -58,14 +61,20 @@ func (a *AzureCommunicationEmailClient) Initialize(ctx context.Context, config *
 		return fmt.Errorf("failed to parse connection string: %w", err)
 	}
 
+	httpClient := &http.Client{
+		Timeout: time.Duration(config.RequestTimeout) * time.Second,
+	}
+
+	a.mutex.Lock()
 	a.config = config
 	a.endpoint = endpoint
-	a.httpClient.Timeout = time.Duration(config.RequestTimeout) * time.Second
+	a.httpClient = httpClient
+	a.mutex.Unlock()
 
 	a.logger.Info("Azure Communication Services email client initialized",
 		"endpoint", endpoint,
 		"sender_address", config.SenderAddress,
-		"timeout", a.httpClient.Timeout)
+		"timeout", httpClient.Timeout)
 
 	return nil
 }
@@ -137,9 +146,16 @@ func (a *AzureCommunicationEmailClient) GetDeliveryStatus(ctx context.Context, m
 	return status, nil
 }
 
+// currentConfig returns the configuration of the last successful Initialize
+func (a *AzureCommunicationEmailClient) currentConfig() *AzureEmailConfig {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+	return a.config
+}
+
 // HealthCheck performs a health check on the Azure Communication Services
 func (a *AzureCommunicationEmailClient) HealthCheck(ctx context.Context) error {
-	if a.config == nil {
+	if a.currentConfig() == nil {
 		return domain.NewDependencyError("Azure client not initialized", nil)
 	}
 
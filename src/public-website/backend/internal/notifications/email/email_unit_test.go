@@ -2,9 +2,14 @@ package email
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
 	sharedtesting "github.com/axiom-software-co/international-center/src/backend/internal/shared/testing"
 	"github.com/stretchr/testify/assert"
@@ -860,4 +865,62 @@ func isPermanentFailure(errorMsg string) bool {
 		}
 	}
 	return false
-}
\ No newline at end of file
+}
+
+func TestEmailHandlerService_CredentialConsumer_DuringSends(t *testing.T) {
+	// Arrange
+	ctx, cancel := sharedtesting.CreateUnitTestContext()
+	defer cancel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	client := NewAzureCommunicationEmailClient(logger)
+	service := NewEmailHandlerService(nil, nil, client, nil, logger, &EmailHandlerConfig{
+		Azure: &AzureEmailConfig{
+			ConnectionString: "endpoint=https://acs.example.com/;accesskey=initial",
+			SenderAddress:    "notifications@example.com",
+			RequestTimeout:   30,
+		},
+	})
+	assert.NoError(t, client.Initialize(ctx, service.azureConfig()))
+	consumer := service.CredentialConsumer("email-azure-connection-string")
+
+	message := &EmailMessage{
+		MessageID:     "msg-rotation",
+		SubscriberID:  "sub-001",
+		Recipients:    []string{"admin@example.com"},
+		Subject:       "New Business Inquiry Received",
+		TextContent:   "New inquiry",
+		EventType:     "inquiry-business",
+		Priority:      "high",
+		CorrelationID: "corr-rotation",
+	}
+
+	// Act
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func(version int) {
+			defer wg.Done()
+			errs <- consumer.Reinitialize(ctx, dapr.SecretCredentials{
+				Key:     "email-azure-connection-string",
+				Current: fmt.Sprintf("endpoint=https://acs.example.com/;accesskey=rotated-%d", version),
+			})
+		}(i)
+		go func() {
+			defer wg.Done()
+			_, err := service.sendEmail(ctx, message)
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	// Assert
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+	assert.Contains(t, service.azureConfig().ConnectionString, "accesskey=rotated-")
+	assert.Equal(t, "notifications@example.com", service.azureConfig().SenderAddress)
+	assert.NoError(t, client.HealthCheck(ctx))
+}
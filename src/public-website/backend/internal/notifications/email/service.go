@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"sync"
 	"time"
 
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
 	"github.com/google/uuid"
 )
@@ -21,6 +23,12 @@ type EmailHandlerService struct {
 	config           *EmailHandlerConfig
 	workers          []*EmailWorker
 	stopChan         chan struct{}
+	deduplicator     *dapr.EventDeduplicator
+	deadLetters      *dapr.DeadLetterStore
+
+	// azureMutex guards config.Azure, which credential rotation replaces while
+	// workers are sending
+	azureMutex sync.RWMutex
 }
 
 // EmailHandlerConfig contains configuration for the email handler
@@ -78,7 +86,7 @@ func (e *EmailHandlerService) Start(ctx context.Context) error {
 	}
 
 	// Initialize Azure client
-	if err := e.azureClient.Initialize(ctx, e.config.Azure); err != nil {
+	if err := e.azureClient.Initialize(ctx, e.azureConfig()); err != nil {
 		return fmt.Errorf("failed to initialize Azure client: %w", err)
 	}
 
@@ -94,8 +102,13 @@ func (e *EmailHandlerService) Start(ctx context.Context) error {
 		}(worker)
 	}
 
+	// Redeliver the dead letters operators queue for retry
+	if e.deadLetters != nil {
+		go e.deadLetters.RunRetries(ctx, dapr.DeadLetterSourceEmail, e.redeliverDeadLetter)
+	}
+
 	// Subscribe to email notification queue
-	if err := e.messageQueue.Subscribe(ctx, e.config.QueueName, e.handleEmailRequest); err != nil {
+	if err := e.messageQueue.Subscribe(ctx, e.config.QueueName, e.queueHandler()); err != nil {
 		return fmt.Errorf("failed to subscribe to queue %s: %w", e.config.QueueName, err)
 	}
 
@@ -103,6 +116,100 @@ func (e *EmailHandlerService) Start(ctx context.Context) error {
 	return nil
 }
 
+// UseDeduplication makes the service send each queued email request at most once,
+// even when the queue redelivers it
+func (e *EmailHandlerService) UseDeduplication(deduplicator *dapr.EventDeduplicator) {
+	e.deduplicator = deduplicator
+}
+
+// UseDeadLetters keeps email requests that fail permanently in the shared dead
+// letter store, where operators can inspect, retry or purge them
+func (e *EmailHandlerService) UseDeadLetters(deadLetters *dapr.DeadLetterStore) {
+	e.deadLetters = deadLetters
+}
+
+// CredentialConsumer re-initializes the Azure client with the connection string
+// stored under secretKey whenever it is rotated. Requests already sent with the
+// previous access key complete, since Azure keeps it valid until it is regenerated.
+func (e *EmailHandlerService) CredentialConsumer(secretKey string) dapr.SecretConsumer {
+	return dapr.SecretConsumer{
+		Name: "email-azure-client",
+		Keys: []string{secretKey},
+		Reinitialize: func(ctx context.Context, credentials dapr.SecretCredentials) error {
+			e.azureMutex.Lock()
+			defer e.azureMutex.Unlock()
+
+			azure := *e.config.Azure
+			azure.ConnectionString = credentials.Current
+			if err := e.azureClient.Initialize(ctx, &azure); err != nil {
+				return fmt.Errorf("failed to re-initialize Azure client: %w", err)
+			}
+			e.config.Azure = &azure
+			return nil
+		},
+	}
+}
+
+// azureConfig returns the Azure settings in use, including rotated credentials
+func (e *EmailHandlerService) azureConfig() *AzureEmailConfig {
+	e.azureMutex.RLock()
+	defer e.azureMutex.RUnlock()
+	return e.config.Azure
+}
+
+// recordDeadLetter stores a failed email request when the dead letter store is in use.
+// Failures of the same request for the same subscriber count as attempts of one letter.
+func (e *EmailHandlerService) recordDeadLetter(ctx context.Context, request *EmailNotificationRequest, cause error) {
+	if e.deadLetters == nil {
+		return
+	}
+
+	payload, err := json.Marshal(request)
+	if err != nil {
+		e.logger.Error("Failed to encode dead letter", "correlation_id", request.CorrelationID, "error", err)
+		return
+	}
+
+	letter := &dapr.DeadLetter{
+		Source:        dapr.DeadLetterSourceEmail,
+		Destination:   e.config.QueueName,
+		Reason:        dapr.DeadLetterReason(cause),
+		Error:         cause.Error(),
+		Payload:       payload,
+		CorrelationID: request.CorrelationID,
+	}
+	if request.CorrelationID != "" {
+		letter.DeadLetterID = fmt.Sprintf("email-%s-%s", request.CorrelationID, request.SubscriberID)
+	}
+
+	if err := e.deadLetters.Record(ctx, letter); err != nil {
+		e.logger.Error("Failed to record dead letter", "correlation_id", request.CorrelationID, "error", err)
+	}
+}
+
+// redeliverDeadLetter processes a dead email request again, with the payload as an
+// operator may have edited it
+func (e *EmailHandlerService) redeliverDeadLetter(ctx context.Context, letter *dapr.DeadLetter) error {
+	var request EmailNotificationRequest
+	if err := json.Unmarshal(letter.Payload, &request); err != nil {
+		return domain.NewValidationError(fmt.Sprintf("dead letter payload is not an email request: %v", err))
+	}
+	return e.ProcessEmailRequest(ctx, &request)
+}
+
+// queueHandler returns the queue subscription handler, deduplicated on the queue
+// message ID when deduplication is enabled
+func (e *EmailHandlerService) queueHandler() func(context.Context, *QueueMessage) error {
+	if e.deduplicator == nil {
+		return e.handleEmailRequest
+	}
+	return func(ctx context.Context, message *QueueMessage) error {
+		return e.deduplicator.Process(ctx, e.config.QueueName, message.ID, func(ctx context.Context) error {
+			return e.handleEmailRequest(ctx, message)
+		})
+	}
+}
+
 // Stop gracefully shuts down the email handler service
 func (e *EmailHandlerService) Stop(ctx context.Context) error {
 	e.logger.Info("Stopping email handler service")
@@ -414,10 +521,11 @@ func (e *EmailHandlerService) createEmailMessage(ctx context.Context, request *E
 // sendEmail sends an email via Azure Communication Services
 func (e *EmailHandlerService) sendEmail(ctx context.Context, message *EmailMessage) (*EmailDeliveryStatus, error) {
 	logger := e.logger.With("message_id", message.MessageID)
+	azure := e.azureConfig()
 
 	// Create Azure send request
 	sendRequest := &AzureSendEmailRequest{
-		SenderAddress: e.config.Azure.SenderAddress,
+		SenderAddress: azure.SenderAddress,
 		Recipients: AzureRecipients{
 			To: make([]AzureRecipient, len(message.Recipients)),
 		},
@@ -427,7 +535,7 @@ func (e *EmailHandlerService) sendEmail(ctx context.Context, message *EmailMessa
 			Html:      message.HtmlContent,
 		},
 		Headers:       make(map[string]string),
-		ReplyTo:       e.config.Azure.ReplyToAddress,
+		ReplyTo:       azure.ReplyToAddress,
 		AttachmentIds: []string{}, // No attachments for notifications
 	}
 
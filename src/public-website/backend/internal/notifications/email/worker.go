@@ -321,8 +321,6 @@ func (w *EmailWorker) sendToDeadLetterQueue(ctx context.Context, request *EmailN
 	
 	logger.Info("Sending message to dead letter queue")
 
-	// In a full implementation, this would publish to a dead letter queue
-	// For now, we just log the action
 	deadLetterMessage := DeadLetterMessage{
 		OriginalRequest: *request,
 		Error:          err.Error(),
@@ -335,6 +333,8 @@ func (w *EmailWorker) sendToDeadLetterQueue(ctx context.Context, request *EmailN
 		"message_id", deadLetterMessage.OriginalRequest.CorrelationID,
 		"error", deadLetterMessage.Error,
 		"reason", deadLetterMessage.Reason)
+
+	w.service.recordDeadLetter(ctx, request, err)
 }
 
 // Error classification helper functions
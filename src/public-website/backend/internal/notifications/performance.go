@@ -15,9 +15,13 @@ type WorkerPool struct {
 	workerCount   int
 	taskChannel   chan func()
 	stopChannel   chan struct{}
+	retireChannel chan struct{}
 	waitGroup     sync.WaitGroup
 	logger        *slog.Logger
 	activeWorkers int32
+	ctx           context.Context
+	nextWorkerID  int
+	mutex         sync.Mutex
 }
 
 // NewWorkerPool creates a new worker pool
@@ -28,19 +32,66 @@ func NewWorkerPool(workerCount int, logger *slog.Logger) *WorkerPool {
 	
 	return &WorkerPool{
 		workerCount: workerCount,
-		taskChannel: make(chan func(), workerCount*2), // Buffer to prevent blocking
-		stopChannel: make(chan struct{}),
-		logger:      logger,
+		taskChannel:   make(chan func(), workerCount*2), // Buffer to prevent blocking
+		stopChannel:   make(chan struct{}),
+		retireChannel: make(chan struct{}),
+		logger:        logger,
 	}
 }
 
 // Start initializes and starts all workers
 func (wp *WorkerPool) Start(ctx context.Context) {
+	wp.mutex.Lock()
+	defer wp.mutex.Unlock()
+	
 	wp.logger.Info("Starting worker pool", "workers", wp.workerCount)
 	
+	wp.ctx = ctx
 	for i := 0; i < wp.workerCount; i++ {
-		wp.waitGroup.Add(1)
-		go wp.worker(ctx, i)
+		wp.startWorker()
+	}
+}
+
+// Resize changes the number of workers. Added workers start immediately; removed
+// workers finish their current task first. The task buffer keeps its original size.
+func (wp *WorkerPool) Resize(workerCount int) error {
+	if workerCount <= 0 {
+		return fmt.Errorf("worker count must be positive, got %d", workerCount)
+	}
+	
+	wp.mutex.Lock()
+	defer wp.mutex.Unlock()
+	
+	previous := wp.workerCount
+	wp.workerCount = workerCount
+	if wp.ctx == nil {
+		return nil
+	}
+	
+	for i := previous; i < workerCount; i++ {
+		wp.startWorker()
+	}
+	for i := workerCount; i < previous; i++ {
+		go wp.retireWorker()
+	}
+	
+	wp.logger.Info("Resized worker pool", "previous_workers", previous, "workers", workerCount)
+	return nil
+}
+
+// startWorker starts one more worker; the caller holds the mutex
+func (wp *WorkerPool) startWorker() {
+	wp.waitGroup.Add(1)
+	go wp.worker(wp.ctx, wp.nextWorkerID)
+	wp.nextWorkerID++
+}
+
+// retireWorker asks whichever worker is free first to exit
+func (wp *WorkerPool) retireWorker() {
+	select {
+	case wp.retireChannel <- struct{}{}:
+	case <-wp.stopChannel:
+	case <-wp.ctx.Done():
 	}
 }
 
@@ -61,6 +112,10 @@ func (wp *WorkerPool) worker(ctx context.Context, id int) {
 			wp.logger.Debug("Worker stopped", "worker_id", id)
 			atomic.AddInt32(&wp.activeWorkers, -1)
 			return
+		case <-wp.retireChannel:
+			wp.logger.Debug("Worker retired", "worker_id", id)
+			atomic.AddInt32(&wp.activeWorkers, -1)
+			return
 		case task := <-wp.taskChannel:
 			if task != nil {
 				wp.executeTask(task, id)
@@ -132,8 +187,12 @@ func (wp *WorkerPool) GetActiveWorkers() int32 {
 
 // GetMetrics returns worker pool metrics
 func (wp *WorkerPool) GetMetrics() map[string]interface{} {
+	wp.mutex.Lock()
+	workerCount := wp.workerCount
+	wp.mutex.Unlock()
+	
 	return map[string]interface{}{
-		"total_workers":  wp.workerCount,
+		"total_workers":  workerCount,
 		"active_workers": wp.GetActiveWorkers(),
 		"pending_tasks":  len(wp.taskChannel),
 		"channel_cap":    cap(wp.taskChannel),
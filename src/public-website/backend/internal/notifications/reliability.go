@@ -265,6 +265,17 @@ func NewRateLimiter(maxTokens int64, refillRate time.Duration) *RateLimiter {
 	}
 }
 
+// SetLimit changes the bucket size and refill rate. Tokens above the new bucket
+// size are dropped; tokens already earned are kept.
+func (rl *RateLimiter) SetLimit(maxTokens int64, refillRate time.Duration) {
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+	
+	rl.maxTokens = maxTokens
+	rl.refillRate = refillRate
+	rl.tokens = min(rl.maxTokens, rl.tokens)
+}
+
 // Allow checks if an operation is allowed based on rate limit
 func (rl *RateLimiter) Allow() bool {
 	rl.mutex.Lock()
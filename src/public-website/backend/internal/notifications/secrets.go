@@ -0,0 +1,26 @@
+package notifications
+
+import (
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
+)
+
+// Secret store keys holding the notification channel credentials
+const (
+	SecretKeyEmailConnectionString = "email-azure-connection-string"
+	SecretKeySMSConnectionString   = "sms-azure-connection-string"
+	SecretKeySlackBotToken         = "slack-bot-token"
+)
+
+// SecretRotationPolicies watches the channel credentials. They are regenerated in the
+// Azure and Slack consoles and written to the secret store by operators, so the
+// policies have no maximum age: the rotator picks up the new value, re-initializes the
+// channel clients and keeps the previous credential for the rotator's grace period.
+func SecretRotationPolicies() []dapr.SecretRotationPolicy {
+	keys := []string{SecretKeyEmailConnectionString, SecretKeySMSConnectionString, SecretKeySlackBotToken}
+
+	policies := make([]dapr.SecretRotationPolicy, 0, len(keys))
+	for _, key := range keys {
+		policies = append(policies, dapr.SecretRotationPolicy{Key: key})
+	}
+	return policies
+}
@@ -7,6 +7,7 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
 	"github.com/google/uuid"
 )
@@ -20,6 +21,7 @@ type NotificationRouterService struct {
 	slackPublisher  SlackNotificationPublisher
 	logger          *slog.Logger
 	config          *NotificationConfig
+	deduplicator    *dapr.EventDeduplicator
 }
 
 // NewNotificationRouterService creates a new notification router service
@@ -43,6 +45,12 @@ func NewNotificationRouterService(
 	}
 }
 
+// UseDeduplication makes the router fan out each domain event at most once, however
+// often it is redelivered or replayed
+func (n *NotificationRouterService) UseDeduplication(deduplicator *dapr.EventDeduplicator) {
+	n.deduplicator = deduplicator
+}
+
 // Start initializes the notification router and begins processing events
 func (n *NotificationRouterService) Start(ctx context.Context) error {
 	n.logger.Info("Starting notification router service",
@@ -203,8 +211,13 @@ func (n *NotificationRouterService) subscribeToEvents(ctx context.Context) error
 		"compliance-alert-events",
 	}
 
+	handler := n.handleDomainEvent
+	if n.deduplicator != nil {
+		handler = n.deduplicateDomainEvents(handler)
+	}
+
 	for _, topic := range topics {
-		if err := n.messageQueue.Subscribe(ctx, topic, n.handleDomainEvent); err != nil {
+		if err := n.messageQueue.Subscribe(ctx, topic, handler); err != nil {
 			return fmt.Errorf("failed to subscribe to topic %s: %w", topic, err)
 		}
 		n.logger.Info("Subscribed to domain event topic", "topic", topic)
@@ -213,6 +226,23 @@ func (n *NotificationRouterService) subscribeToEvents(ctx context.Context) error
 	return nil
 }
 
+// deduplicateDomainEvents skips domain events the router has already routed. Events
+// are recognised by their event ID, which survives republishing, and otherwise by the
+// queue message ID.
+func (n *NotificationRouterService) deduplicateDomainEvents(handler MessageHandler) MessageHandler {
+	return func(ctx context.Context, message *Message) error {
+		eventKey := message.ID
+		var event DomainEvent
+		if err := json.Unmarshal(message.Data, &event); err == nil && event.EventID != "" {
+			eventKey = event.EventID
+		}
+
+		return n.deduplicator.Process(ctx, "notification-router", eventKey, func(ctx context.Context) error {
+			return handler(ctx, message)
+		})
+	}
+}
+
 // handleDomainEvent handles incoming domain events from message queue
 func (n *NotificationRouterService) handleDomainEvent(ctx context.Context, message *Message) error {
 	correlationID := message.CorrelationID
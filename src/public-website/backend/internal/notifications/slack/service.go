@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"sync"
 	"time"
 
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
 	"github.com/google/uuid"
 )
@@ -20,6 +22,12 @@ type SlackHandlerService struct {
 	config           *SlackHandlerConfig
 	workers          []*SlackWorker
 	stopChan         chan struct{}
+	deduplicator     *dapr.EventDeduplicator
+	deadLetters      *dapr.DeadLetterStore
+
+	// slackMutex guards config.Slack; a rotated bot token replaces it while
+	// workers read the default channel
+	slackMutex sync.RWMutex
 }
 
 // SlackHandlerConfig contains configuration for the Slack handler
@@ -66,7 +74,7 @@ func (s *SlackHandlerService) Start(ctx context.Context) error {
 	}
 
 	// Initialize Slack client
-	if err := s.slackClient.Initialize(ctx, s.config.Slack); err != nil {
+	if err := s.slackClient.Initialize(ctx, s.slackConfig()); err != nil {
 		return fmt.Errorf("failed to initialize Slack client: %w", err)
 	}
 
@@ -82,8 +90,13 @@ func (s *SlackHandlerService) Start(ctx context.Context) error {
 		}(worker)
 	}
 
+	// Redeliver the dead letters operators queue for retry
+	if s.deadLetters != nil {
+		go s.deadLetters.RunRetries(ctx, dapr.DeadLetterSourceSlack, s.redeliverDeadLetter)
+	}
+
 	// Subscribe to Slack notification queue
-	if err := s.messageQueue.Subscribe(ctx, s.config.QueueName, s.handleSlackRequest); err != nil {
+	if err := s.messageQueue.Subscribe(ctx, s.config.QueueName, s.queueHandler()); err != nil {
 		return fmt.Errorf("failed to subscribe to queue %s: %w", s.config.QueueName, err)
 	}
 
@@ -91,6 +104,99 @@ func (s *SlackHandlerService) Start(ctx context.Context) error {
 	return nil
 }
 
+// UseDeduplication makes the service send each queued Slack request at most once,
+// even when the queue redelivers it
+func (s *SlackHandlerService) UseDeduplication(deduplicator *dapr.EventDeduplicator) {
+	s.deduplicator = deduplicator
+}
+
+// UseDeadLetters keeps Slack requests that fail permanently in the shared dead
+// letter store, where operators can inspect, retry or purge them
+func (s *SlackHandlerService) UseDeadLetters(deadLetters *dapr.DeadLetterStore) {
+	s.deadLetters = deadLetters
+}
+
+// CredentialConsumer switches the Slack client to the bot token stored under
+// secretKey when it is rotated. Initialize tests the new token before it is used.
+func (s *SlackHandlerService) CredentialConsumer(secretKey string) dapr.SecretConsumer {
+	return dapr.SecretConsumer{
+		Name: "slack-client",
+		Keys: []string{secretKey},
+		Reinitialize: func(ctx context.Context, credentials dapr.SecretCredentials) error {
+			s.slackMutex.Lock()
+			defer s.slackMutex.Unlock()
+
+			slackConfig := *s.config.Slack
+			slackConfig.BotToken = credentials.Current
+			if err := s.slackClient.Initialize(ctx, &slackConfig); err != nil {
+				return fmt.Errorf("failed to re-initialize Slack client: %w", err)
+			}
+			s.config.Slack = &slackConfig
+			return nil
+		},
+	}
+}
+
+// slackConfig returns the Slack settings in use
+func (s *SlackHandlerService) slackConfig() *SlackConfig {
+	s.slackMutex.RLock()
+	defer s.slackMutex.RUnlock()
+	return s.config.Slack
+}
+
+// recordDeadLetter stores a failed Slack request when the dead letter store is in use.
+// Failures of the same request for the same subscriber count as attempts of one letter.
+func (s *SlackHandlerService) recordDeadLetter(ctx context.Context, request *SlackNotificationRequest, cause error) {
+	if s.deadLetters == nil {
+		return
+	}
+
+	payload, err := json.Marshal(request)
+	if err != nil {
+		s.logger.Error("Failed to encode dead letter", "correlation_id", request.CorrelationID, "error", err)
+		return
+	}
+
+	letter := &dapr.DeadLetter{
+		Source:        dapr.DeadLetterSourceSlack,
+		Destination:   s.config.QueueName,
+		Reason:        dapr.DeadLetterReason(cause),
+		Error:         cause.Error(),
+		Payload:       payload,
+		CorrelationID: request.CorrelationID,
+	}
+	if request.CorrelationID != "" {
+		letter.DeadLetterID = fmt.Sprintf("slack-%s-%s", request.CorrelationID, request.SubscriberID)
+	}
+
+	if err := s.deadLetters.Record(ctx, letter); err != nil {
+		s.logger.Error("Failed to record dead letter", "correlation_id", request.CorrelationID, "error", err)
+	}
+}
+
+// redeliverDeadLetter processes a dead Slack request again, with the payload as an
+// operator may have edited it
+func (s *SlackHandlerService) redeliverDeadLetter(ctx context.Context, letter *dapr.DeadLetter) error {
+	var request SlackNotificationRequest
+	if err := json.Unmarshal(letter.Payload, &request); err != nil {
+		return domain.NewValidationError(fmt.Sprintf("dead letter payload is not a Slack request: %v", err))
+	}
+	return s.ProcessSlackRequest(ctx, &request)
+}
+
+// queueHandler returns the queue subscription handler, deduplicated on the queue
+// message ID when deduplication is enabled
+func (s *SlackHandlerService) queueHandler() func(context.Context, *QueueMessage) error {
+	if s.deduplicator == nil {
+		return s.handleSlackRequest
+	}
+	return func(ctx context.Context, message *QueueMessage) error {
+		return s.deduplicator.Process(ctx, s.config.QueueName, message.ID, func(ctx context.Context) error {
+			return s.handleSlackRequest(ctx, message)
+		})
+	}
+}
+
 // Stop gracefully shuts down the Slack handler service
 func (s *SlackHandlerService) Stop(ctx context.Context) error {
 	s.logger.Info("Stopping Slack handler service")
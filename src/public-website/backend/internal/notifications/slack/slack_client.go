@@ -9,6 +9,7 @@ import (
 	"log/slog"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
@@ -24,8 +25,10 @@ type SlackAPIClient interface {
 	HealthCheck(ctx context.Context) error
 }
 
-// SlackWebAPIClient implements SlackAPIClient using Slack Web API
+// SlackWebAPIClient implements SlackAPIClient using Slack Web API. Requests read
+// the bot token and HTTP client under mutex, since a rotated token replaces them.
 type SlackWebAPIClient struct {
+	mutex      sync.RWMutex
 	config     *SlackConfig
 	httpClient *http.Client
 	logger     *slog.Logger
@@ -62,17 +65,23 @@ func (s *SlackWebAPIClient) Initialize(ctx context.Context, config *SlackConfig)
 		return domain.NewValidationError("default channel format is invalid")
 	}
 
+	httpClient := &http.Client{
+		Timeout: time.Duration(config.RequestTimeout) * time.Second,
+	}
+
+	// Test the bot token with a simple API call before any request uses it
+	if err := s.testConnection(ctx, config, httpClient); err != nil {
+		return fmt.Errorf("failed to test Slack connection: %w", err)
+	}
+
+	s.mutex.Lock()
 	s.config = config
-	s.httpClient.Timeout = time.Duration(config.RequestTimeout) * time.Second
+	s.httpClient = httpClient
+	s.mutex.Unlock()
 
 	s.logger.Info("Slack Web API client initialized",
 		"default_channel", config.DefaultChannel,
-		"timeout", s.httpClient.Timeout)
-
-	// Test the bot token with a simple API call
-	if err := s.testConnection(ctx); err != nil {
-		return fmt.Errorf("failed to test Slack connection: %w", err)
-	}
+		"timeout", httpClient.Timeout)
 
 	return nil
 }
@@ -313,20 +322,28 @@ func (s *SlackWebAPIClient) GetChannelInfo(ctx context.Context, channel string)
 
 // HealthCheck performs a health check on the Slack API
 func (s *SlackWebAPIClient) HealthCheck(ctx context.Context) error {
-	if s.config == nil {
+	config, httpClient := s.current()
+	if config == nil {
 		return domain.NewDependencyError("Slack client not initialized", nil)
 	}
 
-	return s.testConnection(ctx)
+	return s.testConnection(ctx, config, httpClient)
 }
 
 // Private helper methods
 
-// testConnection tests the connection to Slack API
-func (s *SlackWebAPIClient) testConnection(ctx context.Context) error {
+// current returns the configuration and HTTP client set by the latest Initialize
+func (s *SlackWebAPIClient) current() (*SlackConfig, *http.Client) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.config, s.httpClient
+}
+
+// testConnection tests the bot token in config against the Slack API
+func (s *SlackWebAPIClient) testConnection(ctx context.Context, config *SlackConfig, httpClient *http.Client) error {
 	url := fmt.Sprintf("%s/auth.test", s.baseURL)
 	
-	response, err := s.makeAPIRequest(ctx, "GET", url, nil)
+	response, err := s.doAPIRequest(ctx, config, httpClient, "GET", url, nil)
 	if err != nil {
 		return fmt.Errorf("Slack connection test failed: %w", err)
 	}
@@ -348,8 +365,17 @@ func (s *SlackWebAPIClient) testConnection(ctx context.Context) error {
 	return nil
 }
 
-// makeAPIRequest makes an HTTP request to the Slack API
+// makeAPIRequest makes an HTTP request to the Slack API with the current bot token
 func (s *SlackWebAPIClient) makeAPIRequest(ctx context.Context, method, url string, payload map[string]interface{}) ([]byte, error) {
+	config, httpClient := s.current()
+	if config == nil {
+		return nil, domain.NewDependencyError("Slack client not initialized", nil)
+	}
+	return s.doAPIRequest(ctx, config, httpClient, method, url, payload)
+}
+
+// doAPIRequest makes an HTTP request to the Slack API with the given bot token and client
+func (s *SlackWebAPIClient) doAPIRequest(ctx context.Context, config *SlackConfig, httpClient *http.Client, method, url string, payload map[string]interface{}) ([]byte, error) {
 	var body io.Reader
 
 	if payload != nil {
@@ -378,13 +404,13 @@ func (s *SlackWebAPIClient) makeAPIRequest(ctx context.Context, method, url stri
 	}
 
 	// Add headers
-	req.Header.Set("Authorization", "Bearer "+s.config.BotToken)
+	req.Header.Set("Authorization", "Bearer "+config.BotToken)
 	if method == "POST" {
 		req.Header.Set("Content-Type", "application/json")
 	}
 
 	// Make request
-	resp, err := s.httpClient.Do(req)
+	resp, err := httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("HTTP request failed: %w", err)
 	}
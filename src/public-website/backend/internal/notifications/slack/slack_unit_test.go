@@ -1,7 +1,17 @@
 package slack
 
 import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
 )
 
 // Domain Model Validation Tests
@@ -856,4 +866,88 @@ func TestExtractString(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+// Credential Rotation Tests
+
+func TestSlackHandlerService_CredentialConsumer_DuringSends(t *testing.T) {
+	// Arrange
+	var mutex sync.Mutex
+	var postedWith []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if token == "xoxb-revoked" {
+			fmt.Fprint(w, `{"ok":false,"error":"invalid_auth"}`)
+			return
+		}
+		if strings.HasSuffix(r.URL.Path, "/chat.postMessage") {
+			mutex.Lock()
+			postedWith = append(postedWith, token)
+			mutex.Unlock()
+		}
+		fmt.Fprint(w, `{"ok":true,"channel":"C0NOTIFY","ts":"1700000000.000100"}`)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	client := NewSlackWebAPIClient(logger)
+	client.baseURL = server.URL
+	service := NewSlackHandlerService(nil, nil, client, logger, &SlackHandlerConfig{
+		Slack: &SlackConfig{BotToken: "xoxb-initial", DefaultChannel: "#notifications", RequestTimeout: 5},
+	})
+	if err := client.Initialize(ctx, service.slackConfig()); err != nil {
+		t.Fatalf("Initialize() error = %v", err)
+	}
+	consumer := service.CredentialConsumer("slack-bot-token")
+
+	// Act
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func(version int) {
+			defer wg.Done()
+			errs <- consumer.Reinitialize(ctx, dapr.SecretCredentials{
+				Key:     "slack-bot-token",
+				Current: fmt.Sprintf("xoxb-rotated-%d", version),
+			})
+		}(i)
+		go func() {
+			defer wg.Done()
+			_, err := client.SendMessage(ctx, &SlackSendMessageRequest{
+				Channel: service.slackConfig().DefaultChannel,
+				Text:    "New business inquiry received",
+			})
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	rotated := service.slackConfig().BotToken
+	revokeErr := consumer.Reinitialize(ctx, dapr.SecretCredentials{Key: "slack-bot-token", Current: "xoxb-revoked"})
+	_, sendErr := client.SendMessage(ctx, &SlackSendMessageRequest{Channel: "#notifications", Text: "After rotation"})
+
+	// Assert
+	for err := range errs {
+		if err != nil {
+			t.Errorf("unexpected error during rotation: %v", err)
+		}
+	}
+	if !strings.HasPrefix(rotated, "xoxb-rotated-") {
+		t.Errorf("bot token after rotation = %q, expected a rotated token", rotated)
+	}
+	if revokeErr == nil {
+		t.Error("expected a token rejected by Slack to fail re-initialization")
+	}
+	if token := service.slackConfig().BotToken; token != rotated {
+		t.Errorf("bot token after rejected rotation = %q, expected %q", token, rotated)
+	}
+	if sendErr != nil {
+		t.Errorf("SendMessage() after rejected rotation error = %v", sendErr)
+	}
+	if last := postedWith[len(postedWith)-1]; last != rotated {
+		t.Errorf("last message sent with %q, expected %q", last, rotated)
+	}
+}
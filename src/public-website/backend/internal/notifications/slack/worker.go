@@ -334,8 +334,8 @@ func (w *SlackWorker) monitorChannels(ctx context.Context) {
 	}
 
 	// Add default channel
-	if w.service.config.Slack.DefaultChannel != "" {
-		allChannels[w.service.config.Slack.DefaultChannel] = true
+	if defaultChannel := w.service.slackConfig().DefaultChannel; defaultChannel != "" {
+		allChannels[defaultChannel] = true
 	}
 
 	// Check each channel
@@ -497,8 +497,9 @@ func (w *SlackWorker) fallbackToDefaultChannel(ctx context.Context, request *Sla
 	logger := w.logger.With("correlation_id", request.CorrelationID)
 	
 	// Create fallback request with default channel
+	defaultChannel := w.service.slackConfig().DefaultChannel
 	fallbackRequest := *request
-	fallbackRequest.Channels = []string{w.service.config.Slack.DefaultChannel}
+	fallbackRequest.Channels = []string{defaultChannel}
 	
 	// Add note about fallback in event data
 	fallbackRequest.EventData = make(map[string]interface{})
@@ -509,7 +510,7 @@ func (w *SlackWorker) fallbackToDefaultChannel(ctx context.Context, request *Sla
 	fallbackRequest.EventData["original_channels"] = request.Channels
 
 	logger.Info("Attempting fallback to default channel",
-		"default_channel", w.service.config.Slack.DefaultChannel)
+		"default_channel", defaultChannel)
 
 	// Attempt to process with default channel
 	if err := w.service.ProcessSlackRequest(ctx, &fallbackRequest); err != nil {
@@ -538,6 +539,8 @@ func (w *SlackWorker) sendToDeadLetterQueue(ctx context.Context, request *SlackN
 		"message_id", deadLetterMessage.OriginalRequest.CorrelationID,
 		"error", deadLetterMessage.Error,
 		"reason", deadLetterMessage.Reason)
+
+	w.service.recordDeadLetter(ctx, request, err)
 }
 
 // Error classification helper functions specific to Slack
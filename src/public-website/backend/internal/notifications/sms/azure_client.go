@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
@@ -18,8 +19,10 @@ type AzureSMSClient interface {
 	HealthCheck(ctx context.Context) error
 }
 
-// AzureCommunicationSMSClient implements AzureSMSClient
+// AzureCommunicationSMSClient implements AzureSMSClient. A rotated connection
+// string re-initializes it in place, so mutex guards the settings Initialize sets.
 type AzureCommunicationSMSClient struct {
+	mutex      sync.RWMutex
 	config     *AzureSMSConfig
 	httpClient *http.Client
 	logger     *slog.Logger
@@ -63,14 +66,20 @@ func (a *AzureCommunicationSMSClient) Initialize(ctx context.Context, config *Az
 		return fmt.Errorf("failed to parse connection string: %w", err)
 	}
 
+	httpClient := &http.Client{
+		Timeout: time.Duration(config.RequestTimeout) * time.Second,
+	}
+
+	a.mutex.Lock()
 	a.config = config
 	a.endpoint = endpoint
-	a.httpClient.Timeout = time.Duration(config.RequestTimeout) * time.Second
+	a.httpClient = httpClient
+	a.mutex.Unlock()
 
 	a.logger.Info("Azure Communication Services SMS client initialized",
 		"endpoint", endpoint,
 		"from_number", config.FromNumber,
-		"timeout", a.httpClient.Timeout)
+		"timeout", httpClient.Timeout)
 
 	return nil
 }
@@ -148,7 +157,7 @@ func (a *AzureCommunicationSMSClient) GetDeliveryStatus(ctx context.Context, mes
 	// For now, we simulate the response
 	status := &AzureSMSDeliveryStatus{
 		MessageID:    messageID,
-		From:         a.config.FromNumber,
+		From:         a.currentConfig().FromNumber,
 		To:           "+1234567890", // Would come from actual response
 		DeliveryStatus: "Delivered",
 		DeliveryStatusDetails: AzureSMSDeliveryDetails{
@@ -162,9 +171,16 @@ func (a *AzureCommunicationSMSClient) GetDeliveryStatus(ctx context.Context, mes
 	return status, nil
 }
 
+// currentConfig returns the configuration set by the latest Initialize
+func (a *AzureCommunicationSMSClient) currentConfig() *AzureSMSConfig {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+	return a.config
+}
+
 // HealthCheck performs a health check on the Azure Communication Services
 func (a *AzureCommunicationSMSClient) HealthCheck(ctx context.Context) error {
-	if a.config == nil {
+	if a.currentConfig() == nil {
 		return domain.NewDependencyError("Azure SMS client not initialized", nil)
 	}
 
@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"sync"
 	"time"
 
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
 	"github.com/google/uuid"
 )
@@ -20,6 +22,11 @@ type SMSHandlerService struct {
 	config          *SMSHandlerConfig
 	workers         []*SMSWorker
 	stopChan        chan struct{}
+	deduplicator    *dapr.EventDeduplicator
+	deadLetters     *dapr.DeadLetterStore
+
+	// azureMutex guards config.Azure against a rotation replacing it mid-send
+	azureMutex sync.RWMutex
 }
 
 // SMSHandlerConfig contains configuration for the SMS handler
@@ -66,7 +73,7 @@ func (s *SMSHandlerService) Start(ctx context.Context) error {
 	}
 
 	// Initialize Azure client
-	if err := s.azureClient.Initialize(ctx, s.config.Azure); err != nil {
+	if err := s.azureClient.Initialize(ctx, s.azureConfig()); err != nil {
 		return fmt.Errorf("failed to initialize Azure client: %w", err)
 	}
 
@@ -82,8 +89,13 @@ func (s *SMSHandlerService) Start(ctx context.Context) error {
 		}(worker)
 	}
 
+	// Redeliver the dead letters operators queue for retry
+	if s.deadLetters != nil {
+		go s.deadLetters.RunRetries(ctx, dapr.DeadLetterSourceSMS, s.redeliverDeadLetter)
+	}
+
 	// Subscribe to SMS notification queue
-	if err := s.messageQueue.Subscribe(ctx, s.config.QueueName, s.handleSMSRequest); err != nil {
+	if err := s.messageQueue.Subscribe(ctx, s.config.QueueName, s.queueHandler()); err != nil {
 		return fmt.Errorf("failed to subscribe to queue %s: %w", s.config.QueueName, err)
 	}
 
@@ -91,6 +103,99 @@ func (s *SMSHandlerService) Start(ctx context.Context) error {
 	return nil
 }
 
+// UseDeduplication makes the service send each queued SMS request at most once,
+// even when the queue redelivers it
+func (s *SMSHandlerService) UseDeduplication(deduplicator *dapr.EventDeduplicator) {
+	s.deduplicator = deduplicator
+}
+
+// UseDeadLetters keeps SMS requests that fail permanently in the shared dead
+// letter store, where operators can inspect, retry or purge them
+func (s *SMSHandlerService) UseDeadLetters(deadLetters *dapr.DeadLetterStore) {
+	s.deadLetters = deadLetters
+}
+
+// CredentialConsumer re-initializes the Azure client when the connection string
+// stored under secretKey changes
+func (s *SMSHandlerService) CredentialConsumer(secretKey string) dapr.SecretConsumer {
+	return dapr.SecretConsumer{
+		Name: "sms-azure-client",
+		Keys: []string{secretKey},
+		Reinitialize: func(ctx context.Context, credentials dapr.SecretCredentials) error {
+			s.azureMutex.Lock()
+			defer s.azureMutex.Unlock()
+
+			azure := *s.config.Azure
+			azure.ConnectionString = credentials.Current
+			if err := s.azureClient.Initialize(ctx, &azure); err != nil {
+				return fmt.Errorf("failed to re-initialize Azure client: %w", err)
+			}
+			s.config.Azure = &azure
+			return nil
+		},
+	}
+}
+
+// azureConfig returns the current Azure settings
+func (s *SMSHandlerService) azureConfig() *AzureSMSConfig {
+	s.azureMutex.RLock()
+	defer s.azureMutex.RUnlock()
+	return s.config.Azure
+}
+
+// recordDeadLetter stores a failed SMS request when the dead letter store is in use.
+// Failures of the same request for the same subscriber count as attempts of one letter.
+func (s *SMSHandlerService) recordDeadLetter(ctx context.Context, request *SMSNotificationRequest, cause error) {
+	if s.deadLetters == nil {
+		return
+	}
+
+	payload, err := json.Marshal(request)
+	if err != nil {
+		s.logger.Error("Failed to encode dead letter", "correlation_id", request.CorrelationID, "error", err)
+		return
+	}
+
+	letter := &dapr.DeadLetter{
+		Source:        dapr.DeadLetterSourceSMS,
+		Destination:   s.config.QueueName,
+		Reason:        dapr.DeadLetterReason(cause),
+		Error:         cause.Error(),
+		Payload:       payload,
+		CorrelationID: request.CorrelationID,
+	}
+	if request.CorrelationID != "" {
+		letter.DeadLetterID = fmt.Sprintf("sms-%s-%s", request.CorrelationID, request.SubscriberID)
+	}
+
+	if err := s.deadLetters.Record(ctx, letter); err != nil {
+		s.logger.Error("Failed to record dead letter", "correlation_id", request.CorrelationID, "error", err)
+	}
+}
+
+// redeliverDeadLetter processes a dead SMS request again, with the payload as an
+// operator may have edited it
+func (s *SMSHandlerService) redeliverDeadLetter(ctx context.Context, letter *dapr.DeadLetter) error {
+	var request SMSNotificationRequest
+	if err := json.Unmarshal(letter.Payload, &request); err != nil {
+		return domain.NewValidationError(fmt.Sprintf("dead letter payload is not an SMS request: %v", err))
+	}
+	return s.ProcessSMSRequest(ctx, &request)
+}
+
+// queueHandler returns the queue subscription handler, deduplicated on the queue
+// message ID when deduplication is enabled
+func (s *SMSHandlerService) queueHandler() func(context.Context, *QueueMessage) error {
+	if s.deduplicator == nil {
+		return s.handleSMSRequest
+	}
+	return func(ctx context.Context, message *QueueMessage) error {
+		return s.deduplicator.Process(ctx, s.config.QueueName, message.ID, func(ctx context.Context) error {
+			return s.handleSMSRequest(ctx, message)
+		})
+	}
+}
+
 // Stop gracefully shuts down the SMS handler service
 func (s *SMSHandlerService) Stop(ctx context.Context) error {
 	s.logger.Info("Stopping SMS handler service")
@@ -395,7 +500,7 @@ func (s *SMSHandlerService) sendSMS(ctx context.Context, message *SMSMessage) (*
 
 	// Create Azure send request
 	sendRequest := &AzureSendSMSRequest{
-		From:    s.config.Azure.FromNumber,
+		From:    s.azureConfig().FromNumber,
 		To:      message.Recipients,
 		Message: message.Content,
 		DeliveryReportEnabled: true,
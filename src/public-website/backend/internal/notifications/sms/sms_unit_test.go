@@ -2,10 +2,15 @@ package sms
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"log/slog"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/dapr"
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
 	sharedtesting "github.com/axiom-software-co/international-center/src/backend/internal/shared/testing"
 	"github.com/stretchr/testify/assert"
@@ -1010,4 +1015,61 @@ func isPermanentSMSFailure(errorMsg string) bool {
 		}
 	}
 	return false
-}
\ No newline at end of file
+}
+
+func TestSMSHandlerService_CredentialConsumer_DuringSends(t *testing.T) {
+	// Arrange
+	ctx, cancel := sharedtesting.CreateUnitTestContext()
+	defer cancel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	client := NewAzureCommunicationSMSClient(logger)
+	service := NewSMSHandlerService(nil, nil, client, logger, &SMSHandlerConfig{
+		Azure: &AzureSMSConfig{
+			ConnectionString: "endpoint=https://acs.example.com/;accesskey=initial",
+			FromNumber:       "+15555550100",
+			RequestTimeout:   30,
+		},
+	})
+	assert.NoError(t, client.Initialize(ctx, service.azureConfig()))
+	consumer := service.CredentialConsumer("sms-azure-connection-string")
+
+	message := &SMSMessage{
+		MessageID:     "sms-rotation",
+		SubscriberID:  "sub-001",
+		Recipients:    []string{"+15555550123"},
+		Content:       "New business inquiry received",
+		EventType:     "inquiry-business",
+		Priority:      "high",
+		CorrelationID: "corr-rotation",
+	}
+
+	// Act
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func(version int) {
+			defer wg.Done()
+			errs <- consumer.Reinitialize(ctx, dapr.SecretCredentials{
+				Key:     "sms-azure-connection-string",
+				Current: fmt.Sprintf("endpoint=https://acs.example.com/;accesskey=rotated-%d", version),
+			})
+		}(i)
+		go func() {
+			defer wg.Done()
+			_, err := service.sendSMS(ctx, message)
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	// Assert
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+	assert.Contains(t, service.azureConfig().ConnectionString, "accesskey=rotated-")
+	assert.Equal(t, "+15555550100", service.azureConfig().FromNumber)
+	assert.NoError(t, client.HealthCheck(ctx))
+}
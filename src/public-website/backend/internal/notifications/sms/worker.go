@@ -373,6 +373,8 @@ func (w *SMSWorker) sendToDeadLetterQueue(ctx context.Context, request *SMSNotif
 		"message_id", deadLetterMessage.OriginalRequest.CorrelationID,
 		"error", deadLetterMessage.Error,
 		"reason", deadLetterMessage.Reason)
+
+	w.service.recordDeadLetter(ctx, request, err)
 }
 
 // Error classification helper functions specific to SMS
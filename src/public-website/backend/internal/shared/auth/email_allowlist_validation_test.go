@@ -1,287 +0,0 @@
-// RED PHASE: Email allowlist validation tests - these should FAIL initially
-package auth
-
-import (
-	"context"
-	"fmt"
-	"testing"
-	"time"
-
-	"github.com/stretchr/testify/assert"
-)
-
-func TestEmailAllowlistValidation(t *testing.T) {
-	timeout := 5 * time.Second
-	_, cancel := context.WithTimeout(context.Background(), timeout)
-	defer cancel()
-
-	t.Run("Email allowlist should only contain two specific emails", func(t *testing.T) {
-		// Contract expectation: allowlist should be exactly tojkuv@gmail.com and tojkuv@outlook.com
-		
-		// This will fail in RED phase - GetEmailAllowlist not implemented yet
-		defer func() {
-			if r := recover(); r != nil {
-				t.Logf("RED PHASE: Email allowlist not implemented as expected: %v", r)
-				// Expected failure - allowlist not implemented
-			}
-		}()
-		
-		allowlist := GetEmailAllowlist()
-		
-		// Should contain exactly 2 emails
-		assert.Len(t, allowlist, 2, "Allowlist should contain exactly 2 emails")
-		
-		// Should contain specific emails
-		assert.Contains(t, allowlist, "tojkuv@gmail.com", "Allowlist should contain tojkuv@gmail.com")
-		assert.Contains(t, allowlist, "tojkuv@outlook.com", "Allowlist should contain tojkuv@outlook.com")
-		
-		// Should not contain any other emails
-		invalidEmails := []string{
-			"user@gmail.com",
-			"admin@gmail.com", 
-			"tojkuv@yahoo.com",
-			"test@outlook.com",
-			"admin@company.com",
-		}
-		
-		for _, email := range invalidEmails {
-			assert.NotContains(t, allowlist, email, "Allowlist should not contain %s", email)
-		}
-	})
-
-	t.Run("Email validation should enforce strict allowlist matching", func(t *testing.T) {
-		// Contract expectation: email validation should be exact and case-sensitive
-		
-		// This will fail in RED phase - ValidateEmailInAllowlist not implemented yet
-		defer func() {
-			if r := recover(); r != nil {
-				t.Logf("RED PHASE: Email validation not implemented as expected: %v", r)
-				// Expected failure - email validation not implemented
-			}
-		}()
-		
-		emailValidationTests := []struct {
-			email        string
-			expectedValid bool
-			description  string
-		}{
-			{
-				email:        "tojkuv@gmail.com",
-				expectedValid: true,
-				description:  "Exact match for admin email should be valid",
-			},
-			{
-				email:        "tojkuv@outlook.com",
-				expectedValid: true,
-				description:  "Exact match for viewer email should be valid",
-			},
-			{
-				email:        "TOJKUV@GMAIL.COM", // Different case
-				expectedValid: false, // Should be case-sensitive
-				description:  "Case mismatch should be rejected",
-			},
-			{
-				email:        "tojkuv@OUTLOOK.com", // Different case
-				expectedValid: false, // Should be case-sensitive
-				description:  "Case mismatch should be rejected",
-			},
-			{
-				email:        " tojkuv@gmail.com", // Leading space
-				expectedValid: false,
-				description:  "Email with leading space should be rejected",
-			},
-			{
-				email:        "tojkuv@gmail.com ", // Trailing space
-				expectedValid: false,
-				description:  "Email with trailing space should be rejected",
-			},
-			{
-				email:        "",
-				expectedValid: false,
-				description:  "Empty email should be rejected",
-			},
-			{
-				email:        "tojkuv+test@gmail.com", // Email alias
-				expectedValid: false,
-				description:  "Email alias should be rejected",
-			},
-		}
-
-		for _, tt := range emailValidationTests {
-			t.Run(tt.description, func(t *testing.T) {
-				isValid := ValidateEmailInAllowlist(tt.email)
-				assert.Equal(t, tt.expectedValid, isValid, tt.description)
-			})
-		}
-	})
-
-	t.Run("Role assignment should be deterministic based on email", func(t *testing.T) {
-		// Contract expectation: role assignment should be based exactly on email address
-		
-		// This will fail in RED phase - role assignment functions not implemented yet
-		defer func() {
-			if r := recover(); r != nil {
-				t.Logf("RED PHASE: Role assignment not implemented as expected: %v", r)
-				// Expected failure - role assignment not implemented
-			}
-		}()
-		
-		roleAssignmentTests := []struct {
-			email        string
-			expectedRole string
-			expectedPerms []string
-		}{
-			{
-				email:        "tojkuv@gmail.com",
-				expectedRole: "admin",
-				expectedPerms: []string{"read", "write", "delete", "manage_users", "manage_content", "view_audit"},
-			},
-			{
-				email:        "tojkuv@outlook.com",
-				expectedRole: "viewer", 
-				expectedPerms: []string{"read"}, // Lowest permissions - read-only
-			},
-		}
-
-		for _, tt := range roleAssignmentTests {
-			t.Run(fmt.Sprintf("Role assignment for %s should be %s", tt.email, tt.expectedRole), func(t *testing.T) {
-				role := GetRoleForEmail(tt.email)
-				assert.Equal(t, tt.expectedRole, role, "Role should match expected for %s", tt.email)
-				
-				permissions := GetPermissionsForEmail(tt.email)
-				assert.ElementsMatch(t, tt.expectedPerms, permissions, "Permissions should match expected for %s", tt.email)
-			})
-		}
-	})
-
-	t.Run("Social provider claim extraction should work for allowed emails", func(t *testing.T) {
-		// Contract expectation: extract email and profile from social provider JWTs
-		
-		// This will fail in RED phase - claim extraction functions not implemented yet
-		defer func() {
-			if r := recover(); r != nil {
-				t.Logf("RED PHASE: Social provider claim extraction not implemented as expected: %v", r)
-				// Expected failure - claim extraction not implemented
-			}
-		}()
-		
-		socialClaimTests := []struct {
-			provider      string
-			email         string
-			expectedClaims map[string]interface{}
-		}{
-			{
-				provider: "google",
-				email:    "tojkuv@gmail.com",
-				expectedClaims: map[string]interface{}{
-					"email":          "tojkuv@gmail.com",
-					"email_verified": true,
-					"name":           "Test User",
-					"picture":        "https://example.com/avatar.jpg",
-					"iss":            "https://accounts.google.com",
-					"aud":            "international-center",
-				},
-			},
-			{
-				provider: "microsoft",
-				email:    "tojkuv@outlook.com",
-				expectedClaims: map[string]interface{}{
-					"email":          "tojkuv@outlook.com",
-					"email_verified": true,
-					"name":           "Test User",
-					"picture":        "https://example.com/avatar.jpg",
-					"iss":            "https://login.microsoftonline.com/common/v2.0",
-					"aud":            "international-center",
-				},
-			},
-		}
-
-		for _, tt := range socialClaimTests {
-			t.Run(fmt.Sprintf("Should extract claims from %s JWT for %s", tt.provider, tt.email), func(t *testing.T) {
-				mockJWT := createMockSocialJWTWithFullClaims(tt.email, tt.provider, tt.expectedClaims)
-				
-				extractedClaims := ExtractSocialProviderClaims(mockJWT)
-				
-				// Should extract email claim
-				assert.Equal(t, tt.email, extractedClaims["email"], "Should extract email claim")
-				
-				// Should extract issuer claim
-				expectedIssuer := tt.expectedClaims["iss"]
-				assert.Equal(t, expectedIssuer, extractedClaims["iss"], "Should extract issuer claim")
-				
-				// Should extract audience claim
-				expectedAudience := tt.expectedClaims["aud"]
-				assert.Equal(t, expectedAudience, extractedClaims["aud"], "Should extract audience claim")
-			})
-		}
-	})
-
-	t.Run("Authentication should fail gracefully for invalid tokens", func(t *testing.T) {
-		// Contract expectation: invalid tokens should be handled gracefully
-		
-		invalidTokenTests := []struct {
-			name        string
-			token       string
-			expectedError string
-		}{
-			{
-				name:        "Empty token should be rejected",
-				token:       "",
-				expectedError: "empty token",
-			},
-			{
-				name:        "Malformed JWT should be rejected", 
-				token:       "malformed.jwt.token",
-				expectedError: "invalid jwt",
-			},
-			{
-				name:        "Expired token should be rejected",
-				token:       createExpiredMockJWT("tojkuv@gmail.com"),
-				expectedError: "token expired",
-			},
-			{
-				name:        "Token with invalid signature should be rejected",
-				token:       createInvalidSignatureMockJWT("tojkuv@gmail.com"),
-				expectedError: "invalid signature",
-			},
-		}
-
-		for _, tt := range invalidTokenTests {
-			t.Run(tt.name, func(t *testing.T) {
-				// This will fail in RED phase - token validation functions not implemented yet
-				defer func() {
-					if r := recover(); r != nil {
-						t.Logf("RED PHASE: Token validation not implemented as expected: %v", r)
-						// Expected failure - token validation not implemented
-					}
-				}()
-				
-				isValid := ValidateJWTToken(tt.token)
-				
-				// Should reject invalid tokens
-				assert.False(t, isValid, "Invalid token should be rejected")
-				
-				// Should provide appropriate error information
-				errorInfo := GetTokenValidationError(tt.token)
-				assert.Contains(t, errorInfo, tt.expectedError, "Should provide appropriate error information")
-			})
-		}
-	})
-}
-
-// Helper functions for creating test JWT tokens
-
-func createMockSocialJWTWithFullClaims(email string, provider string, claims map[string]interface{}) string {
-	// Mock JWT with full claims structure for comprehensive testing
-	return fmt.Sprintf("mock-jwt-full-%s-%s", provider, email)
-}
-
-func createExpiredMockJWT(email string) string {
-	// Mock expired JWT for testing expiration handling
-	return fmt.Sprintf("expired-jwt-%s", email)
-}
-
-func createInvalidSignatureMockJWT(email string) string {
-	// Mock JWT with invalid signature for testing signature validation
-	return fmt.Sprintf("invalid-signature-jwt-%s", email)
-}
\ No newline at end of file
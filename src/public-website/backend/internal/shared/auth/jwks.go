@@ -0,0 +1,253 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+)
+
+const (
+	// DefaultJWKSCacheTTL is how long fetched keys are trusted when the issuer does not
+	// say otherwise through Cache-Control
+	DefaultJWKSCacheTTL = time.Hour
+	// MaxJWKSCacheTTL caps the lifetime an issuer may ask for
+	MaxJWKSCacheTTL = 24 * time.Hour
+	// MinJWKSRefreshInterval limits refetches triggered by unknown key IDs, so tokens
+	// with made-up key IDs cannot be used to hammer the issuer
+	MinJWKSRefreshInterval = 30 * time.Second
+
+	maxJWKSResponseBytes = 1 << 20
+)
+
+// JWKSCache holds the signing keys published by one issuer. Keys are refetched when
+// the cache expires or when a token names a key that is not cached yet, which is how
+// rotated keys are picked up. A failed refresh keeps serving the keys already held.
+type JWKSCache struct {
+	url    string
+	client *http.Client
+	now    func() time.Time
+
+	mu          sync.RWMutex
+	keys        map[string]crypto.PublicKey
+	expiresAt   time.Time
+	lastAttempt time.Time
+	lastErr     error
+
+	refreshMu sync.Mutex
+}
+
+// NewJWKSCache creates a cache for the key set at jwksURL. A nil client uses a client
+// with a short timeout.
+func NewJWKSCache(jwksURL string, client *http.Client) *JWKSCache {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &JWKSCache{
+		url:    jwksURL,
+		client: client,
+		now:    time.Now,
+		keys:   map[string]crypto.PublicKey{},
+	}
+}
+
+// Key returns the public key with the given key ID
+func (c *JWKSCache) Key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	key, found, fresh := c.cached(kid)
+	if found && fresh {
+		return key, nil
+	}
+
+	if err := c.refresh(ctx, !fresh); err != nil {
+		// Keep accepting known keys while the issuer is unreachable
+		if found {
+			return key, nil
+		}
+		return nil, err
+	}
+
+	key, found, _ = c.cached(kid)
+	if !found {
+		return nil, tokenError(ErrUnknownSigningKey, fmt.Sprintf("signing key %q is not published by the issuer", kid))
+	}
+	return key, nil
+}
+
+func (c *JWKSCache) cached(kid string) (crypto.PublicKey, bool, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	key, found := c.keys[kid]
+	return key, found, c.now().Before(c.expiresAt)
+}
+
+// refresh fetches the key set. Fetches are spaced at least MinJWKSRefreshInterval
+// apart; within that interval an expired cache reports the last fetch error again.
+func (c *JWKSCache) refresh(ctx context.Context, expired bool) error {
+	c.refreshMu.Lock()
+	defer c.refreshMu.Unlock()
+
+	c.mu.RLock()
+	lastAttempt, expiresAt, lastErr := c.lastAttempt, c.expiresAt, c.lastErr
+	c.mu.RUnlock()
+
+	now := c.now()
+	if expired && now.Before(expiresAt) {
+		// Another caller refreshed while this one waited
+		return nil
+	}
+	if !lastAttempt.IsZero() && now.Sub(lastAttempt) < MinJWKSRefreshInterval {
+		if expired {
+			return lastErr
+		}
+		return nil
+	}
+
+	keys, ttl, err := c.fetch(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lastAttempt = now
+	c.lastErr = err
+	if err != nil {
+		return err
+	}
+	c.keys = keys
+	c.expiresAt = now.Add(ttl)
+	return nil
+}
+
+func (c *JWKSCache) fetch(ctx context.Context) (map[string]crypto.PublicKey, time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url, nil)
+	if err != nil {
+		return nil, 0, domain.NewDependencyError("jwks", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, 0, domain.NewDependencyError("jwks", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, 0, domain.NewDependencyError("jwks", fmt.Errorf("%s returned status %d", c.url, resp.StatusCode))
+	}
+
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	decoder := json.NewDecoder(http.MaxBytesReader(nil, resp.Body, maxJWKSResponseBytes))
+	if err := decoder.Decode(&set); err != nil {
+		return nil, 0, domain.NewDependencyError("jwks", fmt.Errorf("failed to decode key set from %s: %w", c.url, err))
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		key, err := jwk.publicKey()
+		if err != nil || jwk.KeyID == "" {
+			// Issuers publish key types this package does not verify; skip them
+			continue
+		}
+		keys[jwk.KeyID] = key
+	}
+
+	return keys, cacheTTL(resp.Header.Get("Cache-Control")), nil
+}
+
+// cacheTTL reads max-age from a Cache-Control header, clamped to sensible bounds
+func cacheTTL(cacheControl string) time.Duration {
+	for _, directive := range strings.Split(cacheControl, ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(directive), "=")
+		if !ok || !strings.EqualFold(name, "max-age") {
+			continue
+		}
+		seconds, err := strconv.Atoi(value)
+		if err != nil {
+			break
+		}
+		ttl := time.Duration(seconds) * time.Second
+		if ttl < MinJWKSRefreshInterval {
+			return MinJWKSRefreshInterval
+		}
+		if ttl > MaxJWKSCacheTTL {
+			return MaxJWKSCacheTTL
+		}
+		return ttl
+	}
+	return DefaultJWKSCacheTTL
+}
+
+// jsonWebKey is the subset of RFC 7517 needed for RSA and P-256 signature keys
+type jsonWebKey struct {
+	KeyType string `json:"kty"`
+	KeyID   string `json:"kid"`
+	Use     string `json:"use"`
+	N       string `json:"n"`
+	E       string `json:"e"`
+	Curve   string `json:"crv"`
+	X       string `json:"x"`
+	Y       string `json:"y"`
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	if k.Use != "" && k.Use != "sig" {
+		return nil, fmt.Errorf("key %s is not a signing key", k.KeyID)
+	}
+
+	switch k.KeyType {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() || e.Int64() < 3 || e.Int64() > 1<<31-1 {
+			return nil, fmt.Errorf("key %s has an invalid RSA exponent", k.KeyID)
+		}
+		if n.BitLen() < 2048 {
+			return nil, fmt.Errorf("key %s is shorter than 2048 bits", k.KeyID)
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		if k.Curve != "P-256" {
+			return nil, fmt.Errorf("key %s uses unsupported curve %s", k.KeyID, k.Curve)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !elliptic.P256().IsOnCurve(x, y) {
+			return nil, fmt.Errorf("key %s is not a point on P-256", k.KeyID)
+		}
+		return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("key %s has unsupported type %s", k.KeyID, k.KeyType)
+	}
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil || len(data) == 0 {
+		return nil, fmt.Errorf("invalid key parameter")
+	}
+	return new(big.Int).SetBytes(data), nil
+}
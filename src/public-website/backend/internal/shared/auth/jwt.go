@@ -0,0 +1,473 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+)
+
+// Signing algorithms accepted for ID tokens. Symmetric algorithms and "none" are
+// always refused.
+const (
+	AlgorithmRS256 = "RS256"
+	AlgorithmES256 = "ES256"
+)
+
+// DefaultClockSkew is the leeway applied to exp and nbf for clocks that disagree
+const DefaultClockSkew = time.Minute
+
+// MicrosoftConsumerTenantID is the tenant Microsoft issues personal account tokens from
+const MicrosoftConsumerTenantID = "9188040d-6c67-4c5b-b112-36a304b66dad"
+
+// Token verification errors. Each failure returns an unauthorized domain error whose
+// code matches one of these, so callers can tell them apart with errors.Is.
+var (
+	ErrMalformedToken       = domain.NewDomainError(domain.ErrorTypeUnauthorized, "INVALID_JWT", "invalid jwt")
+	ErrUnsupportedAlgorithm = domain.NewDomainError(domain.ErrorTypeUnauthorized, "UNSUPPORTED_ALGORITHM", "unsupported signing algorithm")
+	ErrUnknownIssuer        = domain.NewDomainError(domain.ErrorTypeUnauthorized, "UNKNOWN_ISSUER", "unknown issuer")
+	ErrUnknownSigningKey    = domain.NewDomainError(domain.ErrorTypeUnauthorized, "UNKNOWN_SIGNING_KEY", "unknown signing key")
+	ErrInvalidSignature     = domain.NewDomainError(domain.ErrorTypeUnauthorized, "INVALID_SIGNATURE", "invalid signature")
+	ErrTokenExpired         = domain.NewDomainError(domain.ErrorTypeUnauthorized, "TOKEN_EXPIRED", "token expired")
+	ErrTokenNotYetValid     = domain.NewDomainError(domain.ErrorTypeUnauthorized, "TOKEN_NOT_YET_VALID", "token not yet valid")
+	ErrInvalidAudience      = domain.NewDomainError(domain.ErrorTypeUnauthorized, "INVALID_AUDIENCE", "invalid audience")
+)
+
+func tokenError(kind *domain.DomainError, detail string) *domain.DomainError {
+	return &domain.DomainError{
+		Type:    kind.Type,
+		Code:    kind.Code,
+		Message: kind.Message + ": " + detail,
+	}
+}
+
+// NumericDate is a JWT time claim, expressed in seconds since the epoch
+type NumericDate struct {
+	time.Time
+}
+
+// UnmarshalJSON accepts integer and fractional seconds
+func (d *NumericDate) UnmarshalJSON(data []byte) error {
+	var seconds json.Number
+	if err := json.Unmarshal(data, &seconds); err != nil {
+		return err
+	}
+	value, err := seconds.Float64()
+	if err != nil {
+		return err
+	}
+	whole := int64(value)
+	d.Time = time.Unix(whole, int64((value-float64(whole))*float64(time.Second))).UTC()
+	return nil
+}
+
+// Audience is the aud claim, which issuers send as a string or an array of strings
+type Audience []string
+
+// UnmarshalJSON accepts both forms of the aud claim
+func (a *Audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = Audience{single}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return err
+	}
+	*a = many
+	return nil
+}
+
+// Contains reports whether audience lists value
+func (a Audience) Contains(value string) bool {
+	for _, audience := range a {
+		if audience == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Claims are the verified contents of an OIDC ID token
+type Claims struct {
+	Issuer    string       `json:"iss"`
+	Subject   string       `json:"sub"`
+	Audience  Audience     `json:"aud"`
+	ExpiresAt *NumericDate `json:"exp"`
+	NotBefore *NumericDate `json:"nbf,omitempty"`
+	IssuedAt  *NumericDate `json:"iat,omitempty"`
+
+	Email             string `json:"email"`
+	EmailVerified     bool   `json:"email_verified"`
+	Name              string `json:"name"`
+	Picture           string `json:"picture"`
+	PreferredUsername string `json:"preferred_username"`
+	TenantID          string `json:"tid"`
+
+	// Provider names the configured provider that issued the token
+	Provider string `json:"-"`
+	// Raw holds every claim in the token, including those without a field above
+	Raw map[string]interface{} `json:"-"`
+}
+
+// OIDCProvider describes a trusted token issuer
+type OIDCProvider struct {
+	// Name identifies the provider, e.g. "google"
+	Name string
+	// Issuers lists the accepted iss values. "{tenantid}" stands for the token's tid
+	// claim, for multi-tenant issuers such as Microsoft's common endpoint.
+	Issuers []string
+	// TenantIDs lists the tenants "{tenantid}" may stand for. An issuer with the
+	// placeholder accepts no tenant outside this list.
+	TenantIDs []string
+	// JWKSURL is where the issuer publishes its signing keys
+	JWKSURL string
+	// Audiences lists the accepted aud values, normally the registered client IDs
+	Audiences []string
+	// Algorithms restricts the accepted signing algorithms; empty accepts RS256 and ES256
+	Algorithms []string
+	// EmailVerifiedClaim names the boolean claim through which the provider vouches
+	// that the token's email belongs to its subject. Without one no email is trusted.
+	EmailVerifiedClaim string
+}
+
+// GoogleProvider returns the Google Identity provider for the given client IDs
+func GoogleProvider(clientIDs ...string) OIDCProvider {
+	return OIDCProvider{
+		Name:      "google",
+		Issuers:   []string{"https://accounts.google.com", "accounts.google.com"},
+		JWKSURL:   "https://www.googleapis.com/oauth2/v3/certs",
+		Audiences: clientIDs,
+
+		EmailVerifiedClaim: "email_verified",
+	}
+}
+
+// MicrosoftProvider returns the Microsoft identity platform provider for the given
+// client IDs. Tokens are accepted from personal accounts and from the listed
+// organisation tenants only. Microsoft does not assert email_verified, so emails are
+// trusted only when the token carries the xms_edov optional claim, which has to be
+// enabled on the app registration.
+func MicrosoftProvider(tenantIDs []string, clientIDs ...string) OIDCProvider {
+	return OIDCProvider{
+		Name:      "microsoft",
+		Issuers:   []string{"https://login.microsoftonline.com/{tenantid}/v2.0"},
+		TenantIDs: append([]string{MicrosoftConsumerTenantID}, tenantIDs...),
+		JWKSURL:   "https://login.microsoftonline.com/common/discovery/v2.0/keys",
+		Audiences: clientIDs,
+
+		EmailVerifiedClaim: "xms_edov",
+	}
+}
+
+func (p *OIDCProvider) issues(issuer string, tenantID string) bool {
+	for _, accepted := range p.Issuers {
+		if strings.Contains(accepted, "{tenantid}") {
+			if tenantID == "" || !p.acceptsTenant(tenantID) {
+				continue
+			}
+			accepted = strings.ReplaceAll(accepted, "{tenantid}", tenantID)
+		}
+		if accepted == issuer {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *OIDCProvider) acceptsTenant(tenantID string) bool {
+	for _, accepted := range p.TenantIDs {
+		if accepted == tenantID {
+			return true
+		}
+	}
+	return false
+}
+
+// vouchesForEmail reports whether the provider's email verification claim is true.
+// Some providers send it as the string "true" rather than a JSON boolean.
+func (p *OIDCProvider) vouchesForEmail(raw map[string]interface{}) bool {
+	if p.EmailVerifiedClaim == "" {
+		return false
+	}
+	switch value := raw[p.EmailVerifiedClaim].(type) {
+	case bool:
+		return value
+	case string:
+		return value == "true" || value == "1"
+	case json.Number:
+		return value.String() == "1"
+	default:
+		return false
+	}
+}
+
+func (p *OIDCProvider) allows(algorithm string) bool {
+	if algorithm != AlgorithmRS256 && algorithm != AlgorithmES256 {
+		return false
+	}
+	if len(p.Algorithms) == 0 {
+		return true
+	}
+	for _, allowed := range p.Algorithms {
+		if allowed == algorithm {
+			return true
+		}
+	}
+	return false
+}
+
+type trustedIssuer struct {
+	provider OIDCProvider
+	keys     *JWKSCache
+}
+
+// Verifier checks ID tokens issued by a fixed set of OIDC providers: the signature
+// against the provider's published keys, then expiry, not-before, issuer and audience.
+type Verifier struct {
+	issuers   []*trustedIssuer
+	clockSkew time.Duration
+	now       func() time.Time
+}
+
+// NewVerifier creates a verifier trusting providers. Each provider needs a name, at
+// least one issuer, a key set URL and at least one audience.
+func NewVerifier(providers ...OIDCProvider) (*Verifier, error) {
+	verifier := &Verifier{
+		clockSkew: DefaultClockSkew,
+		now:       time.Now,
+	}
+	for _, provider := range providers {
+		if provider.Name == "" || len(provider.Issuers) == 0 || provider.JWKSURL == "" {
+			return nil, domain.NewValidationError("OIDC provider needs a name, an issuer and a JWKS URL")
+		}
+		if len(provider.Audiences) == 0 {
+			return nil, domain.NewValidationError(fmt.Sprintf("OIDC provider %s needs at least one audience", provider.Name))
+		}
+		verifier.issuers = append(verifier.issuers, &trustedIssuer{
+			provider: provider,
+			keys:     NewJWKSCache(provider.JWKSURL, nil),
+		})
+	}
+	return verifier, nil
+}
+
+// ProvidersFromEnv builds the trusted providers from OIDC_GOOGLE_CLIENT_IDS and
+// OIDC_MICROSOFT_CLIENT_IDS, each a comma-separated list of client IDs. A provider
+// whose variable is unset is not trusted. OIDC_MICROSOFT_TENANT_IDS lists the
+// organisation tenants accepted besides personal Microsoft accounts.
+func ProvidersFromEnv() []OIDCProvider {
+	var providers []OIDCProvider
+	if clientIDs := splitList(os.Getenv("OIDC_GOOGLE_CLIENT_IDS")); len(clientIDs) > 0 {
+		providers = append(providers, GoogleProvider(clientIDs...))
+	}
+	if clientIDs := splitList(os.Getenv("OIDC_MICROSOFT_CLIENT_IDS")); len(clientIDs) > 0 {
+		providers = append(providers, MicrosoftProvider(splitList(os.Getenv("OIDC_MICROSOFT_TENANT_IDS")), clientIDs...))
+	}
+	return providers
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+var (
+	defaultVerifierMu sync.Mutex
+	defaultVerifier   *Verifier
+)
+
+// DefaultVerifier returns the verifier used by the package-level token functions. It
+// is built from ProvidersFromEnv on first use unless UseDefaultVerifier set one.
+func DefaultVerifier() *Verifier {
+	defaultVerifierMu.Lock()
+	defer defaultVerifierMu.Unlock()
+	if defaultVerifier == nil {
+		verifier, err := NewVerifier(ProvidersFromEnv()...)
+		if err != nil {
+			// The providers built from the environment always carry every field
+			verifier, _ = NewVerifier()
+		}
+		defaultVerifier = verifier
+	}
+	return defaultVerifier
+}
+
+// UseDefaultVerifier replaces the verifier used by the package-level token functions
+func UseDefaultVerifier(verifier *Verifier) {
+	defaultVerifierMu.Lock()
+	defer defaultVerifierMu.Unlock()
+	defaultVerifier = verifier
+}
+
+// UseClockSkew changes the leeway applied to time claims
+func (v *Verifier) UseClockSkew(skew time.Duration) {
+	v.clockSkew = skew
+}
+
+// Providers returns the names of the trusted providers
+func (v *Verifier) Providers() []string {
+	names := make([]string, 0, len(v.issuers))
+	for _, issuer := range v.issuers {
+		names = append(names, issuer.provider.Name)
+	}
+	return names
+}
+
+type tokenHeader struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+}
+
+// Verify checks token and returns its claims. Failures are unauthorized domain errors
+// matching one of the Err* values, except for key set fetch failures, which are
+// dependency errors.
+func (v *Verifier) Verify(ctx context.Context, token string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, tokenError(ErrMalformedToken, "token must have three segments")
+	}
+
+	var header tokenHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, tokenError(ErrMalformedToken, "header: "+err.Error())
+	}
+
+	var claims Claims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, tokenError(ErrMalformedToken, "claims: "+err.Error())
+	}
+	if err := decodeSegment(parts[1], &claims.Raw); err != nil {
+		return nil, tokenError(ErrMalformedToken, "claims: "+err.Error())
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, tokenError(ErrMalformedToken, "signature is not base64url")
+	}
+
+	// The issuer is read before the signature is checked only to choose whose keys to
+	// check it with; nothing else is trusted until the signature verifies
+	issuer := v.issuerFor(claims.Issuer, claims.TenantID)
+	if issuer == nil {
+		return nil, tokenError(ErrUnknownIssuer, fmt.Sprintf("%q is not a trusted issuer", claims.Issuer))
+	}
+	if !issuer.provider.allows(header.Algorithm) {
+		return nil, tokenError(ErrUnsupportedAlgorithm, fmt.Sprintf("%q is not accepted", header.Algorithm))
+	}
+
+	key, err := issuer.keys.Key(ctx, header.KeyID)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifySignature(header.Algorithm, key, parts[0]+"."+parts[1], signature); err != nil {
+		return nil, err
+	}
+
+	if err := v.checkTimes(&claims); err != nil {
+		return nil, err
+	}
+	if !audienceAllowed(claims.Audience, issuer.provider.Audiences) {
+		return nil, tokenError(ErrInvalidAudience, fmt.Sprintf("token is not intended for this application: %v", []string(claims.Audience)))
+	}
+
+	claims.Provider = issuer.provider.Name
+	// EmailVerified reports the provider's own verification claim, whatever it is named
+	claims.EmailVerified = issuer.provider.vouchesForEmail(claims.Raw)
+	return &claims, nil
+}
+
+func (v *Verifier) issuerFor(issuer string, tenantID string) *trustedIssuer {
+	for _, trusted := range v.issuers {
+		if trusted.provider.issues(issuer, tenantID) {
+			return trusted
+		}
+	}
+	return nil
+}
+
+func (v *Verifier) checkTimes(claims *Claims) error {
+	now := v.now()
+	if claims.ExpiresAt == nil {
+		return tokenError(ErrMalformedToken, "exp claim is required")
+	}
+	if !now.Before(claims.ExpiresAt.Add(v.clockSkew)) {
+		return tokenError(ErrTokenExpired, "expired at "+claims.ExpiresAt.Format(time.RFC3339))
+	}
+	if claims.NotBefore != nil && now.Add(v.clockSkew).Before(claims.NotBefore.Time) {
+		return tokenError(ErrTokenNotYetValid, "valid from "+claims.NotBefore.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func audienceAllowed(audience Audience, allowed []string) bool {
+	for _, value := range allowed {
+		if audience.Contains(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func decodeSegment(segment string, target interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return fmt.Errorf("segment is not base64url")
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(target); err != nil {
+		return fmt.Errorf("segment is not a JSON object")
+	}
+	return nil
+}
+
+func verifySignature(algorithm string, key crypto.PublicKey, signingInput string, signature []byte) error {
+	digest := sha256.Sum256([]byte(signingInput))
+
+	switch algorithm {
+	case AlgorithmRS256:
+		publicKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return tokenError(ErrInvalidSignature, "RS256 token signed with a non-RSA key")
+		}
+		if err := rsa.VerifyPKCS1v15(publicKey, crypto.SHA256, digest[:], signature); err != nil {
+			return tokenError(ErrInvalidSignature, "signature does not match")
+		}
+	case AlgorithmES256:
+		publicKey, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return tokenError(ErrInvalidSignature, "ES256 token signed with a non-EC key")
+		}
+		// JWS carries ES256 signatures as fixed-size r||s rather than ASN.1
+		if len(signature) != 64 {
+			return tokenError(ErrInvalidSignature, "ES256 signature must be 64 bytes")
+		}
+		r := new(big.Int).SetBytes(signature[:32])
+		s := new(big.Int).SetBytes(signature[32:])
+		if !ecdsa.Verify(publicKey, digest[:], r, s) {
+			return tokenError(ErrInvalidSignature, "signature does not match")
+		}
+	default:
+		return tokenError(ErrUnsupportedAlgorithm, fmt.Sprintf("%q is not accepted", algorithm))
+	}
+	return nil
+}
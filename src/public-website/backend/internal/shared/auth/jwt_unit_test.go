@@ -0,0 +1,583 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// jwksStandIn serves a JWKS document the way Google and Microsoft do and signs tokens
+// with the matching private keys
+type jwksStandIn struct {
+	server  *httptest.Server
+	fetches atomic.Int32
+
+	mu     sync.Mutex
+	rsaKey *rsa.PrivateKey
+	rsaKID string
+	ecKey  *ecdsa.PrivateKey
+	ecKID  string
+	status int
+}
+
+func newJWKSStandIn(t *testing.T) *jwksStandIn {
+	t.Helper()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	standIn := &jwksStandIn{
+		rsaKey: rsaKey,
+		rsaKID: "rsa-1",
+		ecKey:  ecKey,
+		ecKID:  "ec-1",
+		status: http.StatusOK,
+	}
+	standIn.server = httptest.NewServer(http.HandlerFunc(standIn.serveKeys))
+	t.Cleanup(standIn.server.Close)
+	return standIn
+}
+
+func (s *jwksStandIn) serveKeys(w http.ResponseWriter, r *http.Request) {
+	s.fetches.Add(1)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.status != http.StatusOK {
+		w.WriteHeader(s.status)
+		return
+	}
+
+	point := s.ecKey.PublicKey
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=3600")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"keys": []map[string]string{
+			{
+				"kty": "RSA", "use": "sig", "alg": AlgorithmRS256, "kid": s.rsaKID,
+				"n": base64.RawURLEncoding.EncodeToString(s.rsaKey.N.Bytes()),
+				"e": base64.RawURLEncoding.EncodeToString([]byte{1, 0, 1}),
+			},
+			{
+				"kty": "EC", "use": "sig", "alg": AlgorithmES256, "kid": s.ecKID, "crv": "P-256",
+				"x": base64.RawURLEncoding.EncodeToString(point.X.FillBytes(make([]byte, 32))),
+				"y": base64.RawURLEncoding.EncodeToString(point.Y.FillBytes(make([]byte, 32))),
+			},
+			// Encryption keys and unsupported types are published alongside and ignored
+			{"kty": "RSA", "use": "enc", "kid": "enc-1", "n": "AQAB", "e": "AQAB"},
+			{"kty": "OKP", "kid": "ed-1", "crv": "Ed25519", "x": "AQAB"},
+		},
+	})
+}
+
+// rotate replaces the RSA signing key, as issuers do on their rotation schedule
+func (s *jwksStandIn) rotate(t *testing.T, kid string) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rsaKey, s.rsaKID = key, kid
+}
+
+func (s *jwksStandIn) fail(status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status = status
+}
+
+// organisationTenant is the one Microsoft organisation tenant the stand-in verifier accepts
+const organisationTenant = "5b6f3a2e-0000-4000-8000-00000000c0de"
+
+// verifier trusts the stand-in as both Google and Microsoft
+func (s *jwksStandIn) verifier(t *testing.T) *Verifier {
+	t.Helper()
+	google := GoogleProvider("international-center")
+	google.JWKSURL = s.server.URL
+	microsoft := MicrosoftProvider([]string{organisationTenant}, "international-center")
+	microsoft.JWKSURL = s.server.URL
+
+	verifier, err := NewVerifier(google, microsoft)
+	require.NoError(t, err)
+	return verifier
+}
+
+func (s *jwksStandIn) sign(t *testing.T, algorithm string, claims map[string]interface{}) string {
+	t.Helper()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	kid := s.rsaKID
+	if algorithm == AlgorithmES256 {
+		kid = s.ecKID
+	}
+	return s.signWithKID(t, algorithm, kid, claims)
+}
+
+func (s *jwksStandIn) signWithKID(t *testing.T, algorithm string, kid string, claims map[string]interface{}) string {
+	t.Helper()
+	signingInput := encodeSegment(t, map[string]string{"alg": algorithm, "kid": kid, "typ": "JWT"}) + "." + encodeSegment(t, claims)
+	digest := sha256.Sum256([]byte(signingInput))
+
+	var signature []byte
+	switch algorithm {
+	case AlgorithmRS256:
+		var err error
+		signature, err = rsa.SignPKCS1v15(rand.Reader, s.rsaKey, crypto.SHA256, digest[:])
+		require.NoError(t, err)
+	case AlgorithmES256:
+		r, sig, err := ecdsa.Sign(rand.Reader, s.ecKey, digest[:])
+		require.NoError(t, err)
+		signature = append(r.FillBytes(make([]byte, 32)), sig.FillBytes(make([]byte, 32))...)
+	default:
+		signature = []byte("unsigned")
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func encodeSegment(t *testing.T, value interface{}) string {
+	t.Helper()
+	data, err := json.Marshal(value)
+	require.NoError(t, err)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func googleClaims(email string, expiresAt time.Time) map[string]interface{} {
+	return map[string]interface{}{
+		"iss":            "https://accounts.google.com",
+		"aud":            "international-center",
+		"sub":            "google-subject",
+		"email":          email,
+		"email_verified": true,
+		"iat":            expiresAt.Add(-time.Hour).Unix(),
+		"exp":            expiresAt.Unix(),
+	}
+}
+
+func microsoftClaims(email string, expiresAt time.Time) map[string]interface{} {
+	return map[string]interface{}{
+		"iss":      "https://login.microsoftonline.com/" + MicrosoftConsumerTenantID + "/v2.0",
+		"aud":      []string{"international-center"},
+		"sub":      "microsoft-subject",
+		"tid":      MicrosoftConsumerTenantID,
+		"email":    email,
+		"xms_edov": true,
+		"iat":      expiresAt.Add(-time.Hour).Unix(),
+		"exp":      expiresAt.Unix(),
+	}
+}
+
+func TestVerifierVerify(t *testing.T) {
+	standIn := newJWKSStandIn(t)
+	now := time.Now()
+
+	with := func(claims map[string]interface{}, name string, value interface{}) map[string]interface{} {
+		claims[name] = value
+		return claims
+	}
+
+	tests := []struct {
+		name             string
+		token            func() string
+		expectedErr      error
+		expectedProvider string
+	}{
+		{
+			name: "RS256 Google token verifies",
+			token: func() string {
+				return standIn.sign(t, AlgorithmRS256, googleClaims("tojkuv@gmail.com", now.Add(time.Hour)))
+			},
+			expectedProvider: "google",
+		},
+		{
+			name: "ES256 Microsoft token verifies against its tenant issuer",
+			token: func() string {
+				return standIn.sign(t, AlgorithmES256, microsoftClaims("tojkuv@outlook.com", now.Add(time.Hour)))
+			},
+			expectedProvider: "microsoft",
+		},
+		{
+			name: "expiry within the clock skew is tolerated",
+			token: func() string {
+				return standIn.sign(t, AlgorithmRS256, googleClaims("tojkuv@gmail.com", now.Add(-30*time.Second)))
+			},
+			expectedProvider: "google",
+		},
+		{
+			name: "expiry beyond the clock skew is rejected",
+			token: func() string {
+				return standIn.sign(t, AlgorithmRS256, googleClaims("tojkuv@gmail.com", now.Add(-2*time.Minute)))
+			},
+			expectedErr: ErrTokenExpired,
+		},
+		{
+			name: "token used before nbf is rejected",
+			token: func() string {
+				return standIn.sign(t, AlgorithmRS256, with(googleClaims("tojkuv@gmail.com", now.Add(time.Hour)), "nbf", now.Add(10*time.Minute).Unix()))
+			},
+			expectedErr: ErrTokenNotYetValid,
+		},
+		{
+			name: "token without exp is rejected",
+			token: func() string {
+				claims := googleClaims("tojkuv@gmail.com", now)
+				delete(claims, "exp")
+				return standIn.sign(t, AlgorithmRS256, claims)
+			},
+			expectedErr: ErrMalformedToken,
+		},
+		{
+			name: "token for another application is rejected",
+			token: func() string {
+				return standIn.sign(t, AlgorithmRS256, with(googleClaims("tojkuv@gmail.com", now.Add(time.Hour)), "aud", "someone-else"))
+			},
+			expectedErr: ErrInvalidAudience,
+		},
+		{
+			name: "untrusted issuer is rejected",
+			token: func() string {
+				return standIn.sign(t, AlgorithmRS256, with(googleClaims("tojkuv@gmail.com", now.Add(time.Hour)), "iss", "https://evil.example.com"))
+			},
+			expectedErr: ErrUnknownIssuer,
+		},
+		{
+			name: "Microsoft issuer for a different tenant than tid is rejected",
+			token: func() string {
+				return standIn.sign(t, AlgorithmES256, with(microsoftClaims("tojkuv@outlook.com", now.Add(time.Hour)), "tid", "another-tenant"))
+			},
+			expectedErr: ErrUnknownIssuer,
+		},
+		{
+			name: "Microsoft token from an unlisted organisation tenant is rejected",
+			token: func() string {
+				claims := microsoftClaims("someone@contoso.com", now.Add(time.Hour))
+				claims["iss"] = "https://login.microsoftonline.com/another-tenant/v2.0"
+				claims["tid"] = "another-tenant"
+				return standIn.sign(t, AlgorithmES256, claims)
+			},
+			expectedErr: ErrUnknownIssuer,
+		},
+		{
+			name: "unsigned token is rejected",
+			token: func() string {
+				return standIn.signWithKID(t, "none", "rsa-1", googleClaims("tojkuv@gmail.com", now.Add(time.Hour)))
+			},
+			expectedErr: ErrUnsupportedAlgorithm,
+		},
+		{
+			name: "HMAC token is rejected",
+			token: func() string {
+				return standIn.signWithKID(t, "HS256", "rsa-1", googleClaims("tojkuv@gmail.com", now.Add(time.Hour)))
+			},
+			expectedErr: ErrUnsupportedAlgorithm,
+		},
+		{
+			name: "ES256 header naming the RSA key is rejected",
+			token: func() string {
+				return standIn.signWithKID(t, AlgorithmES256, "rsa-1", googleClaims("tojkuv@gmail.com", now.Add(time.Hour)))
+			},
+			expectedErr: ErrInvalidSignature,
+		},
+		{
+			name: "token naming an unpublished key is rejected",
+			token: func() string {
+				return standIn.signWithKID(t, AlgorithmRS256, "rsa-404", googleClaims("tojkuv@gmail.com", now.Add(time.Hour)))
+			},
+			expectedErr: ErrUnknownSigningKey,
+		},
+		{
+			name:        "token that is not a JWT is rejected",
+			token:       func() string { return "not-a-jwt" },
+			expectedErr: ErrMalformedToken,
+		},
+		{
+			name:        "token with undecodable segments is rejected",
+			token:       func() string { return "malformed.jwt.token" },
+			expectedErr: ErrMalformedToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			verifier := standIn.verifier(t)
+			token := tt.token()
+
+			// Act
+			claims, err := verifier.Verify(context.Background(), token)
+
+			// Assert
+			if tt.expectedErr != nil {
+				require.Error(t, err)
+				assert.True(t, errors.Is(err, tt.expectedErr), "expected %v, got %v", tt.expectedErr, err)
+				assert.True(t, domain.IsUnauthorizedError(err))
+				assert.Nil(t, claims)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedProvider, claims.Provider)
+			assert.True(t, claims.Audience.Contains("international-center"))
+			assert.NotEmpty(t, claims.Email)
+			assert.Equal(t, claims.Email, claims.Raw["email"])
+		})
+	}
+}
+
+func TestJWKSCacheKeyRotation(t *testing.T) {
+	// Arrange
+	standIn := newJWKSStandIn(t)
+	verifier := standIn.verifier(t)
+	cache := verifier.issuers[0].keys
+	clock := time.Now()
+	cache.now = func() time.Time { return clock }
+	claims := googleClaims("tojkuv@gmail.com", time.Now().Add(time.Hour))
+
+	oldToken := standIn.sign(t, AlgorithmRS256, claims)
+	_, err := verifier.Verify(context.Background(), oldToken)
+	require.NoError(t, err)
+
+	// Act
+	standIn.rotate(t, "rsa-2")
+	clock = clock.Add(MinJWKSRefreshInterval)
+	newToken := standIn.sign(t, AlgorithmRS256, claims)
+	_, newErr := verifier.Verify(context.Background(), newToken)
+	_, oldErr := verifier.Verify(context.Background(), oldToken)
+
+	// Assert
+	assert.NoError(t, newErr, "a key published after the cache was filled should be fetched on demand")
+	assert.True(t, errors.Is(oldErr, ErrUnknownSigningKey), "a retired key should stop verifying once the key set is refetched")
+	assert.Equal(t, int32(2), standIn.fetches.Load())
+}
+
+func TestJWKSCacheRefreshPolicy(t *testing.T) {
+	tests := []struct {
+		name            string
+		advance         time.Duration
+		issuerStatus    int
+		kid             string
+		expectedFetches int32
+		expectedErr     bool
+	}{
+		{
+			name:            "cached key is served without refetching",
+			kid:             "rsa-1",
+			expectedFetches: 1,
+		},
+		{
+			name:            "unknown key within the refresh interval does not refetch",
+			advance:         MinJWKSRefreshInterval / 2,
+			kid:             "rsa-404",
+			expectedFetches: 1,
+			expectedErr:     true,
+		},
+		{
+			name:            "unknown key after the refresh interval refetches",
+			advance:         MinJWKSRefreshInterval + time.Second,
+			kid:             "rsa-404",
+			expectedFetches: 2,
+			expectedErr:     true,
+		},
+		{
+			name:            "expired cache refetches",
+			advance:         time.Hour + time.Second,
+			kid:             "rsa-1",
+			expectedFetches: 2,
+		},
+		{
+			name:            "known key survives an issuer outage after expiry",
+			advance:         time.Hour + time.Second,
+			issuerStatus:    http.StatusServiceUnavailable,
+			kid:             "rsa-1",
+			expectedFetches: 2,
+		},
+		{
+			name:            "unknown key during an issuer outage is a dependency error",
+			advance:         time.Hour + time.Second,
+			issuerStatus:    http.StatusServiceUnavailable,
+			kid:             "rsa-404",
+			expectedFetches: 2,
+			expectedErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			standIn := newJWKSStandIn(t)
+			cache := NewJWKSCache(standIn.server.URL, nil)
+			clock := time.Now()
+			cache.now = func() time.Time { return clock }
+
+			_, err := cache.Key(context.Background(), "rsa-1")
+			require.NoError(t, err)
+
+			clock = clock.Add(tt.advance)
+			if tt.issuerStatus != 0 {
+				standIn.fail(tt.issuerStatus)
+			}
+
+			// Act
+			key, err := cache.Key(context.Background(), tt.kid)
+
+			// Assert
+			assert.Equal(t, tt.expectedFetches, standIn.fetches.Load())
+			if tt.expectedErr {
+				assert.Error(t, err)
+				assert.Nil(t, key)
+				if tt.issuerStatus != 0 {
+					assert.True(t, domain.IsDependencyError(err), "got %v", err)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.IsType(t, &rsa.PublicKey{}, key)
+		})
+	}
+}
+
+func TestCacheTTL(t *testing.T) {
+	tests := []struct {
+		cacheControl string
+		expected     time.Duration
+	}{
+		{cacheControl: "", expected: DefaultJWKSCacheTTL},
+		{cacheControl: "public, max-age=20315, must-revalidate", expected: 20315 * time.Second},
+		{cacheControl: "max-age=1", expected: MinJWKSRefreshInterval},
+		{cacheControl: "max-age=999999", expected: MaxJWKSCacheTTL},
+		{cacheControl: "no-cache", expected: DefaultJWKSCacheTTL},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("Cache-Control %q", tt.cacheControl), func(t *testing.T) {
+			// Act
+			ttl := cacheTTL(tt.cacheControl)
+
+			// Assert
+			assert.Equal(t, tt.expected, ttl)
+		})
+	}
+}
+
+func TestVerifySocialLogin(t *testing.T) {
+	standIn := newJWKSStandIn(t)
+	UseDefaultVerifier(standIn.verifier(t))
+	t.Cleanup(func() { UseDefaultVerifier(nil) })
+	expiresAt := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name            string
+		token           string
+		expectForbidden bool
+		expectedMessage string
+	}{
+		{
+			name:  "verified Google account signs in",
+			token: standIn.sign(t, AlgorithmRS256, googleClaims("tojkuv@gmail.com", expiresAt)),
+		},
+		{
+			name:  "Microsoft account with a verified email domain signs in",
+			token: standIn.sign(t, AlgorithmES256, microsoftClaims("tojkuv@outlook.com", expiresAt)),
+		},
+		{
+			name: "Microsoft account from a listed organisation tenant signs in",
+			token: func() string {
+				claims := microsoftClaims("tojkuv@outlook.com", expiresAt)
+				claims["iss"] = "https://login.microsoftonline.com/" + organisationTenant + "/v2.0"
+				claims["tid"] = organisationTenant
+				return standIn.sign(t, AlgorithmES256, claims)
+			}(),
+		},
+		{
+			name: "Microsoft account without xms_edov is forbidden",
+			token: func() string {
+				claims := microsoftClaims("tojkuv@outlook.com", expiresAt)
+				delete(claims, "xms_edov")
+				return standIn.sign(t, AlgorithmES256, claims)
+			}(),
+			expectForbidden: true,
+			expectedMessage: "email not verified",
+		},
+		{
+			name: "Google account without email_verified is forbidden",
+			token: func() string {
+				claims := googleClaims("tojkuv@gmail.com", expiresAt)
+				delete(claims, "email_verified")
+				return standIn.sign(t, AlgorithmRS256, claims)
+			}(),
+			expectForbidden: true,
+			expectedMessage: "email not verified",
+		},
+		{
+			name:  "accounts outside the admin directory still verify",
+			token: standIn.sign(t, AlgorithmRS256, googleClaims("someone@gmail.com", expiresAt)),
+		},
+		{
+			name: "token without an email is forbidden",
+			token: func() string {
+				claims := googleClaims("", expiresAt)
+				delete(claims, "email")
+				return standIn.sign(t, AlgorithmRS256, claims)
+			}(),
+			expectForbidden: true,
+			expectedMessage: "token does not carry an email",
+		},
+		{
+			name: "unverified email is forbidden",
+			token: func() string {
+				claims := googleClaims("tojkuv@gmail.com", expiresAt)
+				claims["email_verified"] = false
+				return standIn.sign(t, AlgorithmRS256, claims)
+			}(),
+			expectForbidden: true,
+			expectedMessage: "email not verified",
+		},
+		{
+			name:  "verified organisation address signs in through Google",
+			token: standIn.sign(t, AlgorithmRS256, googleClaims("editor@international-center.org", expiresAt)),
+		},
+		{
+			name:  "verified Gmail address signs in through Microsoft",
+			token: standIn.sign(t, AlgorithmES256, microsoftClaims("tojkuv@gmail.com", expiresAt)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			claims, err := VerifySocialLogin(context.Background(), tt.token)
+
+			// Assert
+			if tt.expectForbidden {
+				assert.True(t, domain.IsForbiddenError(err), "got %v", err)
+				assert.Equal(t, tt.expectedMessage, GetTokenValidationError(tt.token))
+				assert.False(t, ValidateJWTToken(tt.token))
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEmpty(t, claims.Email)
+			assert.True(t, ValidateJWTToken(tt.token))
+			assert.Empty(t, GetTokenValidationError(tt.token))
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package auth
+
+import (
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+)
+
+const (
+	// MinPasswordLength matches the minimum the admin API contract accepts
+	MinPasswordLength = 8
+
+	passwordHashScheme     = "pbkdf2-sha256"
+	passwordHashIterations = 600000
+	passwordSaltBytes      = 16
+	passwordKeyBytes       = 32
+)
+
+// HashPassword derives a salted PBKDF2-SHA256 hash of password in the form
+// "pbkdf2-sha256$<iterations>$<salt>$<key>" for the users.password_hash column
+func HashPassword(password string) (string, error) {
+	if len(password) < MinPasswordLength {
+		return "", domain.NewValidationFieldError("password", fmt.Sprintf("password must be at least %d characters", MinPasswordLength))
+	}
+
+	salt := make([]byte, passwordSaltBytes)
+	if _, err := rand.Read(salt); err != nil {
+		return "", domain.NewInternalError("failed to generate password salt", err)
+	}
+	key, err := pbkdf2.Key(sha256.New, password, salt, passwordHashIterations, passwordKeyBytes)
+	if err != nil {
+		return "", domain.NewInternalError("failed to hash password", err)
+	}
+
+	return strings.Join([]string{
+		passwordHashScheme,
+		strconv.Itoa(passwordHashIterations),
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	}, "$"), nil
+}
+
+// VerifyPassword checks password against a hash produced by HashPassword. Hashes in
+// any other format never match.
+func VerifyPassword(hash string, password string) bool {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 4 || parts[0] != passwordHashScheme {
+		return false
+	}
+	iterations, err := strconv.Atoi(parts[1])
+	if err != nil || iterations <= 0 {
+		return false
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return false
+	}
+	expected, err := base64.RawStdEncoding.DecodeString(parts[3])
+	if err != nil {
+		return false
+	}
+
+	key, err := pbkdf2.Key(sha256.New, password, salt, iterations, len(expected))
+	if err != nil {
+		return false
+	}
+	return subtle.ConstantTimeCompare(key, expected) == 1
+}
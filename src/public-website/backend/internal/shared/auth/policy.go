@@ -0,0 +1,209 @@
+package auth
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+)
+
+// PolicyRule requires Permission for requests whose method is in Methods and whose path
+// matches Pattern. Pattern segments are literal, "*" for exactly one segment, or a
+// final "**" for any number of remaining segments, including none. A rule without a
+// Permission admits any authenticated principal, and a Public rule admits anyone.
+type PolicyRule struct {
+	Methods    []string
+	Pattern    string
+	Permission Permission
+	Public     bool
+}
+
+func (r PolicyRule) matches(method string, segments []string) bool {
+	methodMatches := false
+	for _, allowed := range r.Methods {
+		if allowed == method {
+			methodMatches = true
+			break
+		}
+	}
+	if !methodMatches {
+		return false
+	}
+
+	pattern := splitPath(r.Pattern)
+	for i, part := range pattern {
+		if part == "**" && i == len(pattern)-1 {
+			return true
+		}
+		if i >= len(segments) || (part != "*" && part != segments[i]) {
+			return false
+		}
+	}
+	return len(pattern) == len(segments)
+}
+
+// Policy maps admin routes to the permission each requires. Rules are checked in
+// order and the first match decides, so specific routes go before general ones.
+// Requests under a protected prefix that no rule matches are denied.
+type Policy struct {
+	protectedPrefixes []string
+	rules             []PolicyRule
+}
+
+// NewPolicy creates a policy guarding every path under protectedPrefixes
+func NewPolicy(protectedPrefixes []string, rules ...PolicyRule) (*Policy, error) {
+	for _, rule := range rules {
+		if rule.Permission != "" && !rule.Permission.IsValid() {
+			return nil, domain.NewValidationError(fmt.Sprintf("policy rule for %s uses unknown permission %q", rule.Pattern, rule.Permission))
+		}
+		if len(rule.Methods) == 0 {
+			return nil, domain.NewValidationError(fmt.Sprintf("policy rule for %s has no methods", rule.Pattern))
+		}
+	}
+	return &Policy{protectedPrefixes: protectedPrefixes, rules: rules}, nil
+}
+
+// Protects reports whether requests to path need an authorized principal
+func (p *Policy) Protects(path string) bool {
+	for _, prefix := range p.protectedPrefixes {
+		if path == strings.TrimSuffix(prefix, "/") || strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Policy) match(method string, path string) (PolicyRule, bool) {
+	if method == http.MethodHead {
+		method = http.MethodGet
+	}
+	segments := splitPath(path)
+	for _, rule := range p.rules {
+		if rule.matches(method, segments) {
+			return rule, true
+		}
+	}
+	return PolicyRule{}, false
+}
+
+// RequiredPermission returns the permission the first matching rule requires
+func (p *Policy) RequiredPermission(method string, path string) (Permission, bool) {
+	rule, ok := p.match(method, path)
+	return rule.Permission, ok
+}
+
+// AllowsAnonymous reports whether the request may be made without authenticating
+func (p *Policy) AllowsAnonymous(method string, path string) bool {
+	if !p.Protects(path) {
+		return true
+	}
+	rule, ok := p.match(method, path)
+	return ok && rule.Public
+}
+
+// Authorize checks that principal may make the request. Unprotected paths are always
+// allowed; protected ones need a matching rule whose permission the principal holds.
+func (p *Policy) Authorize(principal *Principal, method string, path string) error {
+	if p.AllowsAnonymous(method, path) {
+		return nil
+	}
+	if principal == nil {
+		return domain.NewUnauthorizedError("authentication required")
+	}
+
+	rule, ok := p.match(method, path)
+	if !ok {
+		return domain.NewForbiddenError(fmt.Sprintf("no policy grants %s %s", method, path))
+	}
+	if rule.Permission != "" && !principal.Can(rule.Permission) {
+		return domain.NewForbiddenError(fmt.Sprintf("permission %s is required", rule.Permission))
+	}
+	return nil
+}
+
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+var (
+	readMethods  = []string{http.MethodGet}
+	writeMethods = []string{http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+	anyMethod    = []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+)
+
+// readWrite requires read for GET under pattern and write for changes
+func readWrite(pattern string, read Permission, write Permission) []PolicyRule {
+	return []PolicyRule{
+		{Methods: readMethods, Pattern: pattern, Permission: read},
+		{Methods: writeMethods, Pattern: pattern, Permission: write},
+	}
+}
+
+// DefaultAdminPolicy returns the policy for the admin gateway routes
+func DefaultAdminPolicy() *Policy {
+	const api = "/admin/api/v1"
+
+	var rules []PolicyRule
+	add := func(more ...PolicyRule) { rules = append(rules, more...) }
+
+	// Signing in and refreshing happen before there is a principal; managing your own
+	// sessions only needs one
+	add(
+		PolicyRule{Methods: []string{http.MethodPost}, Pattern: api + "/auth/login", Public: true},
+		PolicyRule{Methods: []string{http.MethodPost}, Pattern: api + "/auth/refresh", Public: true},
+		PolicyRule{Methods: []string{http.MethodPost}, Pattern: api + "/auth/logout"},
+		PolicyRule{Methods: []string{http.MethodGet}, Pattern: api + "/auth/sessions"},
+		PolicyRule{Methods: []string{http.MethodDelete}, Pattern: api + "/auth/sessions/*"},
+	)
+
+	// Publishing is checked before the general write rule of each content domain
+	contentDomains := []struct {
+		name                 string
+		read, write, publish Permission
+	}{
+		{"news", PermissionNewsRead, PermissionNewsWrite, PermissionNewsPublish},
+		{"events", PermissionEventsRead, PermissionEventsWrite, PermissionEventsPublish},
+		{"research", PermissionResearchRead, PermissionResearchWrite, PermissionResearchPublish},
+		{"services", PermissionServicesRead, PermissionServicesWrite, PermissionServicesPublish},
+	}
+	for _, content := range contentDomains {
+		add(
+			PolicyRule{Methods: writeMethods, Pattern: api + "/" + content.name + "/*/publish", Permission: content.publish},
+			PolicyRule{Methods: writeMethods, Pattern: api + "/" + content.name + "/*/unpublish", Permission: content.publish},
+		)
+		add(readWrite(api+"/"+content.name+"/**", content.read, content.write)...)
+	}
+
+	add(
+		PolicyRule{Methods: anyMethod, Pattern: api + "/content/schedules/**", Permission: PermissionContentSchedule},
+		PolicyRule{Methods: anyMethod, Pattern: api + "/content/*/*/review/**", Permission: PermissionContentReview},
+		// The remaining content routes are read-only listings across domains
+		PolicyRule{Methods: readMethods, Pattern: api + "/content/**", Permission: PermissionNewsRead},
+	)
+	add(readWrite(api+"/media/**", PermissionMediaRead, PermissionMediaManage)...)
+	add(readWrite(api+"/inquiries/**", PermissionInquiriesRead, PermissionInquiriesManage)...)
+	add(readWrite("/api/admin/inquiries", PermissionInquiriesRead, PermissionInquiriesManage)...)
+	add(readWrite("/admin/subscribers/**", PermissionSubscribersRead, PermissionSubscribersManage)...)
+	add(readWrite("/api/admin/subscribers", PermissionSubscribersRead, PermissionSubscribersManage)...)
+	add(PolicyRule{Methods: anyMethod, Pattern: api + "/notifications/**", Permission: PermissionNotificationsManage})
+	add(readWrite(api+"/users/**", PermissionUsersRead, PermissionUsersManage)...)
+	add(PolicyRule{Methods: readMethods, Pattern: api + "/analytics/**", Permission: PermissionAnalyticsRead})
+	add(readWrite(api+"/system/**", PermissionSettingsRead, PermissionSettingsManage)...)
+	add(readWrite("/admin/gateway/**", PermissionSettingsRead, PermissionSettingsManage)...)
+	add(PolicyRule{Methods: anyMethod, Pattern: api + "/sagas/**", Permission: PermissionSagasManage})
+	// Event history holds full payloads and replays republish them, so both need settings.manage
+	add(PolicyRule{Methods: anyMethod, Pattern: api + "/event-history/**", Permission: PermissionSettingsManage})
+	// Dead letters keep the payloads of failed messages and retries resend them
+	add(PolicyRule{Methods: anyMethod, Pattern: api + "/dead-letters/**", Permission: PermissionSettingsManage})
+	// Evaluating flags only previews them, so it is a read even though it is posted
+	add(PolicyRule{Methods: []string{http.MethodPost}, Pattern: api + "/feature-flags/evaluate", Permission: PermissionSettingsRead})
+	add(readWrite(api+"/feature-flags/**", PermissionSettingsRead, PermissionSettingsManage)...)
+
+	policy, err := NewPolicy([]string{"/admin/", "/api/admin/"}, rules...)
+	if err != nil {
+		// The rules above only use declared permissions
+		panic(err)
+	}
+	return policy
+}
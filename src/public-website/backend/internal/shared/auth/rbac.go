@@ -0,0 +1,260 @@
+package auth
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// Permission grants one kind of access to one admin resource, named "resource.action"
+type Permission string
+
+// Admin permissions. Content domains share the read/write/publish split; publishing is
+// separate from editing so drafts can be prepared by people who cannot release them.
+const (
+	PermissionNewsRead        Permission = "news.read"
+	PermissionNewsWrite       Permission = "news.write"
+	PermissionNewsPublish     Permission = "news.publish"
+	PermissionEventsRead      Permission = "events.read"
+	PermissionEventsWrite     Permission = "events.write"
+	PermissionEventsPublish   Permission = "events.publish"
+	PermissionResearchRead    Permission = "research.read"
+	PermissionResearchWrite   Permission = "research.write"
+	PermissionResearchPublish Permission = "research.publish"
+	PermissionServicesRead    Permission = "services.read"
+	PermissionServicesWrite   Permission = "services.write"
+	PermissionServicesPublish Permission = "services.publish"
+
+	PermissionContentReview   Permission = "content.review"
+	PermissionContentSchedule Permission = "content.schedule"
+	PermissionMediaRead       Permission = "media.read"
+	PermissionMediaManage     Permission = "media.manage"
+
+	PermissionInquiriesRead       Permission = "inquiries.read"
+	PermissionInquiriesManage     Permission = "inquiries.manage"
+	PermissionSubscribersRead     Permission = "subscribers.read"
+	PermissionSubscribersManage   Permission = "subscribers.manage"
+	PermissionNotificationsManage Permission = "notifications.manage"
+
+	PermissionUsersRead      Permission = "users.read"
+	PermissionUsersManage    Permission = "users.manage"
+	PermissionAnalyticsRead  Permission = "analytics.read"
+	PermissionSettingsRead   Permission = "settings.read"
+	PermissionSettingsManage Permission = "settings.manage"
+	PermissionSagasManage    Permission = "sagas.manage"
+)
+
+// AllPermissions lists every permission the admin policy checks
+var AllPermissions = []Permission{
+	PermissionNewsRead, PermissionNewsWrite, PermissionNewsPublish,
+	PermissionEventsRead, PermissionEventsWrite, PermissionEventsPublish,
+	PermissionResearchRead, PermissionResearchWrite, PermissionResearchPublish,
+	PermissionServicesRead, PermissionServicesWrite, PermissionServicesPublish,
+	PermissionContentReview, PermissionContentSchedule,
+	PermissionMediaRead, PermissionMediaManage,
+	PermissionInquiriesRead, PermissionInquiriesManage,
+	PermissionSubscribersRead, PermissionSubscribersManage,
+	PermissionNotificationsManage,
+	PermissionUsersRead, PermissionUsersManage,
+	PermissionAnalyticsRead,
+	PermissionSettingsRead, PermissionSettingsManage,
+	PermissionSagasManage,
+}
+
+// IsValid checks if the permission is one the admin policy knows
+func (p Permission) IsValid() bool {
+	for _, permission := range AllPermissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// System role names. These roles are seeded by the gateway migrations and are the
+// roles the admin API contract can assign.
+const (
+	RoleAdmin     = "admin"
+	RoleEditor    = "editor"
+	RoleModerator = "moderator"
+	RoleViewer    = "viewer"
+)
+
+// roleRank orders the system roles from most to least privileged
+var roleRank = map[string]int{
+	RoleAdmin:     0,
+	RoleEditor:    1,
+	RoleModerator: 2,
+	RoleViewer:    3,
+}
+
+// IsSystemRoleName checks if name is one of the seeded system roles
+func IsSystemRoleName(name string) bool {
+	_, ok := roleRank[name]
+	return ok
+}
+
+// Role is a named set of permissions, stored in the gateway roles table
+type Role struct {
+	RoleID       string       `json:"role_id"`
+	RoleName     string       `json:"role_name"`
+	Description  string       `json:"description,omitempty"`
+	IsSystemRole bool         `json:"is_system_role"`
+	Permissions  []Permission `json:"permissions"`
+}
+
+// SystemRoles returns the roles seeded by the gateway migrations, without IDs. The
+// seed migration and this list must stay in step.
+func SystemRoles() []Role {
+	read := []Permission{
+		PermissionNewsRead, PermissionEventsRead, PermissionResearchRead, PermissionServicesRead,
+		PermissionMediaRead, PermissionInquiriesRead, PermissionSubscribersRead,
+		PermissionUsersRead, PermissionAnalyticsRead, PermissionSettingsRead,
+	}
+	editing := []Permission{
+		PermissionNewsRead, PermissionNewsWrite, PermissionNewsPublish,
+		PermissionEventsRead, PermissionEventsWrite, PermissionEventsPublish,
+		PermissionResearchRead, PermissionResearchWrite, PermissionResearchPublish,
+		PermissionServicesRead, PermissionServicesWrite, PermissionServicesPublish,
+		PermissionContentReview, PermissionContentSchedule,
+		PermissionMediaRead, PermissionMediaManage,
+		PermissionAnalyticsRead,
+	}
+	moderation := []Permission{
+		PermissionNewsRead, PermissionEventsRead, PermissionResearchRead, PermissionServicesRead,
+		PermissionContentReview, PermissionMediaRead,
+		PermissionInquiriesRead, PermissionInquiriesManage,
+		PermissionSubscribersRead, PermissionSubscribersManage,
+		PermissionAnalyticsRead,
+	}
+
+	return []Role{
+		{RoleName: RoleAdmin, Description: "Full access to every admin resource", IsSystemRole: true, Permissions: append([]Permission(nil), AllPermissions...)},
+		{RoleName: RoleEditor, Description: "Writes, reviews and publishes content", IsSystemRole: true, Permissions: editing},
+		{RoleName: RoleModerator, Description: "Handles inquiries and subscribers and reviews content", IsSystemRole: true, Permissions: moderation},
+		{RoleName: RoleViewer, Description: "Read-only access", IsSystemRole: true, Permissions: read},
+	}
+}
+
+// UserStatus mirrors the status column of the gateway users table
+type UserStatus string
+
+const (
+	UserStatusActive    UserStatus = "active"
+	UserStatusInactive  UserStatus = "inactive"
+	UserStatusSuspended UserStatus = "suspended"
+	UserStatusLocked    UserStatus = "locked"
+)
+
+// IsValid checks if the status is allowed by the users table
+func (s UserStatus) IsValid() bool {
+	switch s {
+	case UserStatusActive, UserStatusInactive, UserStatusSuspended, UserStatusLocked:
+		return true
+	default:
+		return false
+	}
+}
+
+// User is an admin account from the gateway users table. Roles holds the names of the
+// roles currently assigned, leaving out expired assignments.
+type User struct {
+	UserID      string     `json:"user_id"`
+	Username    string     `json:"username"`
+	Email       string     `json:"email"`
+	Status      UserStatus `json:"status"`
+	FirstName   string     `json:"first_name"`
+	LastName    string     `json:"last_name"`
+	LastLoginAt *time.Time `json:"last_login_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	CreatedBy   string     `json:"created_by"`
+	UpdatedBy   string     `json:"updated_by"`
+	Roles       []string   `json:"roles"`
+}
+
+// HasRole checks if the user is currently assigned the named role
+func (u *User) HasRole(name string) bool {
+	for _, role := range u.Roles {
+		if role == name {
+			return true
+		}
+	}
+	return false
+}
+
+// PrimaryRole returns the most privileged system role of the user, which is the single
+// role the admin API contract shows. Custom roles are only used if no system role is
+// assigned.
+func (u *User) PrimaryRole() string {
+	primary := ""
+	for _, role := range u.Roles {
+		rank, system := roleRank[role]
+		current, currentSystem := roleRank[primary]
+		switch {
+		case primary == "":
+			primary = role
+		case system && (!currentSystem || rank < current):
+			primary = role
+		}
+	}
+	return primary
+}
+
+// Principal is the authenticated admin user a request acts for, with the permissions
+// granted by all of their roles
+type Principal struct {
+	UserID      string
+	Email       string
+	Roles       []string
+	permissions map[Permission]struct{}
+}
+
+// NewPrincipal combines the permissions of the roles assigned to user
+func NewPrincipal(user *User, roles []Role) *Principal {
+	principal := &Principal{
+		UserID:      user.UserID,
+		Email:       user.Email,
+		permissions: map[Permission]struct{}{},
+	}
+	for _, role := range roles {
+		principal.Roles = append(principal.Roles, role.RoleName)
+		for _, permission := range role.Permissions {
+			principal.permissions[permission] = struct{}{}
+		}
+	}
+	sort.Strings(principal.Roles)
+	return principal
+}
+
+// Can checks if the principal holds permission
+func (p *Principal) Can(permission Permission) bool {
+	if p == nil {
+		return false
+	}
+	_, ok := p.permissions[permission]
+	return ok
+}
+
+// Permissions returns the granted permissions in a stable order
+func (p *Principal) Permissions() []Permission {
+	permissions := make([]Permission, 0, len(p.permissions))
+	for permission := range p.permissions {
+		permissions = append(permissions, permission)
+	}
+	sort.Slice(permissions, func(i, j int) bool { return permissions[i] < permissions[j] })
+	return permissions
+}
+
+type principalContextKey struct{}
+
+// WithPrincipal returns a context carrying principal
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+// PrincipalFromContext returns the principal stored by WithPrincipal, if any
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalContextKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
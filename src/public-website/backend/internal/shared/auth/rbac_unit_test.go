@@ -0,0 +1,358 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func systemRole(t *testing.T, name string) Role {
+	t.Helper()
+	for _, role := range SystemRoles() {
+		if role.RoleName == name {
+			return role
+		}
+	}
+	t.Fatalf("no system role %s", name)
+	return Role{}
+}
+
+func principalWithRoles(t *testing.T, names ...string) *Principal {
+	t.Helper()
+	roles := make([]Role, 0, len(names))
+	for _, name := range names {
+		roles = append(roles, systemRole(t, name))
+	}
+	return NewPrincipal(&User{UserID: "user-1", Email: "staff@example.com", Roles: names}, roles)
+}
+
+func TestSystemRolesUseKnownPermissions(t *testing.T) {
+	for _, role := range SystemRoles() {
+		t.Run(role.RoleName, func(t *testing.T) {
+			// Assert
+			assert.True(t, role.IsSystemRole)
+			assert.True(t, IsSystemRoleName(role.RoleName))
+			assert.NotEmpty(t, role.Permissions)
+			for _, permission := range role.Permissions {
+				assert.True(t, permission.IsValid(), "%s grants unknown permission %s", role.RoleName, permission)
+			}
+		})
+	}
+}
+
+func TestSystemRolesMatchSeedMigration(t *testing.T) {
+	// Arrange
+	seed, err := os.ReadFile("../../../../migrations/sql/gateway/004_seed_system_roles.up.sql")
+	require.NoError(t, err)
+	quotedPermission := regexp.MustCompile(`'([a-z]+\.[a-z]+)'`)
+
+	for _, role := range SystemRoles() {
+		t.Run(role.RoleName, func(t *testing.T) {
+			// Act
+			start := strings.Index(string(seed), "'"+role.RoleName+"',")
+			require.NotEqual(t, -1, start, "role %s is not seeded", role.RoleName)
+			block := string(seed[start:])
+			block = block[:strings.Index(block, "'system'")]
+
+			var seeded []Permission
+			for _, match := range quotedPermission.FindAllStringSubmatch(block, -1) {
+				seeded = append(seeded, Permission(match[1]))
+			}
+
+			// Assert
+			assert.ElementsMatch(t, role.Permissions, seeded)
+		})
+	}
+}
+
+func TestDefaultAdminPolicyAuthorize(t *testing.T) {
+	tests := []struct {
+		name          string
+		roles         []string
+		method        string
+		path          string
+		expectedError func(error) bool
+	}{
+		{
+			name:   "viewer reads news",
+			roles:  []string{RoleViewer},
+			method: http.MethodGet,
+			path:   "/admin/api/v1/news/3f1c2d4e-0000-4000-8000-000000000001",
+		},
+		{
+			name:          "viewer cannot edit news",
+			roles:         []string{RoleViewer},
+			method:        http.MethodPut,
+			path:          "/admin/api/v1/news/3f1c2d4e-0000-4000-8000-000000000001",
+			expectedError: domain.IsForbiddenError,
+		},
+		{
+			name:   "editor publishes news",
+			roles:  []string{RoleEditor},
+			method: http.MethodPost,
+			path:   "/admin/api/v1/news/3f1c2d4e-0000-4000-8000-000000000001/publish",
+		},
+		{
+			name:          "moderator cannot publish news",
+			roles:         []string{RoleModerator},
+			method:        http.MethodPost,
+			path:          "/admin/api/v1/news/3f1c2d4e-0000-4000-8000-000000000001/publish",
+			expectedError: domain.IsForbiddenError,
+		},
+		{
+			name:   "moderator reads inquiries",
+			roles:  []string{RoleModerator},
+			method: http.MethodGet,
+			path:   "/admin/api/v1/inquiries",
+		},
+		{
+			name:   "moderator manages subscribers on the legacy route",
+			roles:  []string{RoleModerator},
+			method: http.MethodDelete,
+			path:   "/admin/subscribers/7d9e",
+		},
+		{
+			name:          "editor cannot read inquiries",
+			roles:         []string{RoleEditor},
+			method:        http.MethodGet,
+			path:          "/api/admin/inquiries",
+			expectedError: domain.IsForbiddenError,
+		},
+		{
+			name:          "editor cannot manage users",
+			roles:         []string{RoleEditor},
+			method:        http.MethodPost,
+			path:          "/admin/api/v1/users",
+			expectedError: domain.IsForbiddenError,
+		},
+		{
+			name:   "admin manages users",
+			roles:  []string{RoleAdmin},
+			method: http.MethodPost,
+			path:   "/admin/api/v1/users",
+		},
+		{
+			name:   "combined roles grant the union of permissions",
+			roles:  []string{RoleEditor, RoleModerator},
+			method: http.MethodPut,
+			path:   "/admin/api/v1/inquiries/42",
+		},
+		{
+			name:   "HEAD is treated as a read",
+			roles:  []string{RoleViewer},
+			method: http.MethodHead,
+			path:   "/admin/api/v1/media",
+		},
+		{
+			name:   "admin searches event history",
+			roles:  []string{RoleAdmin},
+			method: http.MethodGet,
+			path:   "/admin/api/v1/event-history",
+		},
+		{
+			name:   "admin replays event history",
+			roles:  []string{RoleAdmin},
+			method: http.MethodPost,
+			path:   "/admin/api/v1/event-history/replay",
+		},
+		{
+			name:          "viewer cannot search event history",
+			roles:         []string{RoleViewer},
+			method:        http.MethodGet,
+			path:          "/admin/api/v1/event-history",
+			expectedError: domain.IsForbiddenError,
+		},
+		{
+			name:   "admin retries dead letters",
+			roles:  []string{RoleAdmin},
+			method: http.MethodPost,
+			path:   "/admin/api/v1/dead-letters/retry",
+		},
+		{
+			name:          "moderator cannot purge dead letters",
+			roles:         []string{RoleModerator},
+			method:        http.MethodPost,
+			path:          "/admin/api/v1/dead-letters/purge",
+			expectedError: domain.IsForbiddenError,
+		},
+		{
+			name:   "viewer evaluates feature flags",
+			roles:  []string{RoleViewer},
+			method: http.MethodPost,
+			path:   "/admin/api/v1/feature-flags/evaluate",
+		},
+		{
+			name:          "viewer cannot change a feature flag",
+			roles:         []string{RoleViewer},
+			method:        http.MethodPut,
+			path:          "/admin/api/v1/feature-flags/new-search",
+			expectedError: domain.IsForbiddenError,
+		},
+		{
+			name:   "admin changes a feature flag",
+			roles:  []string{RoleAdmin},
+			method: http.MethodPut,
+			path:   "/admin/api/v1/feature-flags/new-search",
+		},
+		{
+			name:          "admin routes without a rule are denied",
+			roles:         []string{RoleAdmin},
+			method:        http.MethodGet,
+			path:          "/admin/api/v1/unknown",
+			expectedError: domain.IsForbiddenError,
+		},
+		{
+			name:          "protected routes need a principal",
+			method:        http.MethodGet,
+			path:          "/admin/api/v1/news",
+			expectedError: domain.IsUnauthorizedError,
+		},
+		{
+			name:   "signing in needs no principal",
+			method: http.MethodPost,
+			path:   "/admin/api/v1/auth/login",
+		},
+		{
+			name:   "refreshing needs no principal",
+			method: http.MethodPost,
+			path:   "/admin/api/v1/auth/refresh",
+		},
+		{
+			name:          "signing out needs a principal",
+			method:        http.MethodPost,
+			path:          "/admin/api/v1/auth/logout",
+			expectedError: domain.IsUnauthorizedError,
+		},
+		{
+			name:   "any principal lists its own sessions",
+			roles:  []string{RoleViewer},
+			method: http.MethodGet,
+			path:   "/admin/api/v1/auth/sessions",
+		},
+		{
+			name:          "viewer cannot revoke the sessions of other users",
+			roles:         []string{RoleViewer},
+			method:        http.MethodDelete,
+			path:          "/admin/api/v1/users/7d9e/sessions",
+			expectedError: domain.IsForbiddenError,
+		},
+		{
+			name:   "routes outside the admin prefixes are not guarded",
+			method: http.MethodGet,
+			path:   "/health",
+		},
+	}
+
+	policy := DefaultAdminPolicy()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var principal *Principal
+			if len(tt.roles) > 0 {
+				principal = principalWithRoles(t, tt.roles...)
+			}
+
+			// Act
+			err := policy.Authorize(principal, tt.method, tt.path)
+
+			// Assert
+			if tt.expectedError == nil {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.True(t, tt.expectedError(err), "got %v", err)
+		})
+	}
+}
+
+func TestNewPolicyRejectsUnknownPermissions(t *testing.T) {
+	// Act
+	_, err := NewPolicy([]string{"/admin/"}, PolicyRule{Methods: []string{http.MethodGet}, Pattern: "/admin/reports/**", Permission: "reports.read"})
+
+	// Assert
+	assert.True(t, domain.IsValidationError(err))
+}
+
+func TestUserPrimaryRole(t *testing.T) {
+	tests := []struct {
+		name     string
+		roles    []string
+		expected string
+	}{
+		{name: "no roles", roles: nil, expected: ""},
+		{name: "single system role", roles: []string{RoleViewer}, expected: RoleViewer},
+		{name: "most privileged system role wins", roles: []string{RoleViewer, RoleAdmin, RoleEditor}, expected: RoleAdmin},
+		{name: "system role beats custom role", roles: []string{"newsletter", RoleModerator}, expected: RoleModerator},
+		{name: "custom role when nothing else is assigned", roles: []string{"newsletter"}, expected: "newsletter"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			user := &User{Roles: tt.roles}
+
+			// Act
+			primary := user.PrimaryRole()
+
+			// Assert
+			assert.Equal(t, tt.expected, primary)
+		})
+	}
+}
+
+func TestPrincipalContext(t *testing.T) {
+	// Arrange
+	principal := principalWithRoles(t, RoleViewer)
+
+	// Act
+	stored, ok := PrincipalFromContext(WithPrincipal(context.Background(), principal))
+	_, missing := PrincipalFromContext(context.Background())
+
+	// Assert
+	require.True(t, ok)
+	assert.Same(t, principal, stored)
+	assert.False(t, missing)
+	assert.True(t, stored.Can(PermissionNewsRead))
+	assert.False(t, stored.Can(PermissionNewsWrite))
+	assert.Contains(t, stored.Permissions(), PermissionUsersRead)
+}
+
+func TestPasswordHashing(t *testing.T) {
+	tests := []struct {
+		name          string
+		password      string
+		attempt       string
+		expectedError bool
+		expectedMatch bool
+	}{
+		{name: "matching password", password: "correct horse battery", attempt: "correct horse battery", expectedMatch: true},
+		{name: "different password", password: "correct horse battery", attempt: "correct horse battery staple"},
+		{name: "short password is rejected", password: "short", expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			hash, err := HashPassword(tt.password)
+
+			// Assert
+			if tt.expectedError {
+				assert.True(t, domain.IsValidationError(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.NotContains(t, hash, tt.password)
+			assert.Equal(t, tt.expectedMatch, VerifyPassword(hash, tt.attempt))
+		})
+	}
+
+	assert.False(t, VerifyPassword("!disabled", "anything"), "hashes in other formats never match")
+}
@@ -0,0 +1,575 @@
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/google/uuid"
+)
+
+// SessionTokenIssuer is the iss claim of access tokens issued for admin sessions
+const SessionTokenIssuer = "international-center-admin"
+
+// MinSessionSigningKeyBytes is the shortest HMAC key accepted for access tokens
+const MinSessionSigningKeyBytes = 32
+
+const (
+	// maxRetiredRefreshTokens bounds the refresh token history kept for reuse detection.
+	// Older tokens are rejected as unknown rather than detected as reused.
+	maxRetiredRefreshTokens = 64
+	// sessionTouchInterval limits how often authenticated requests write the session
+	// back to extend its idle timeout
+	sessionTouchInterval = time.Minute
+	// maxRevokeAttempts bounds the retries when requests race to update a session
+	// that is being revoked
+	maxRevokeAttempts = 5
+)
+
+// Session revocation reasons
+const (
+	RevokedByLogout        = "logout"
+	RevokedByAdmin         = "revoked_by_admin"
+	RevokedOnReuse         = "refresh_token_reuse"
+	RevokedOnAccountChange = "account_changed"
+)
+
+// Session errors. Each is an unauthorized domain error, matched with errors.Is.
+var (
+	ErrInvalidRefreshToken = domain.NewDomainError(domain.ErrorTypeUnauthorized, "INVALID_REFRESH_TOKEN", "invalid refresh token")
+	ErrRefreshTokenReused  = domain.NewDomainError(domain.ErrorTypeUnauthorized, "REFRESH_TOKEN_REUSED", "refresh token reuse detected; the session has been revoked")
+	ErrSessionRevoked      = domain.NewDomainError(domain.ErrorTypeUnauthorized, "SESSION_REVOKED", "session revoked")
+	ErrSessionExpired      = domain.NewDomainError(domain.ErrorTypeUnauthorized, "SESSION_EXPIRED", "session expired")
+)
+
+// SessionConfig sets the lifetimes of admin sessions and their tokens. A session ends
+// when it has been idle for the idle timeout or has existed for the absolute timeout,
+// whichever comes first; "remember me" sessions use the longer pair.
+type SessionConfig struct {
+	AccessTokenTTL            time.Duration
+	IdleTimeout               time.Duration
+	AbsoluteTimeout           time.Duration
+	RememberMeIdleTimeout     time.Duration
+	RememberMeAbsoluteTimeout time.Duration
+	// RefreshReuseGrace tolerates a client presenting the refresh token it has just
+	// rotated, as happens when two tabs refresh at once, without revoking the session
+	RefreshReuseGrace time.Duration
+}
+
+// DefaultSessionConfig returns the lifetimes used by the admin gateway
+func DefaultSessionConfig() SessionConfig {
+	return SessionConfig{
+		AccessTokenTTL:            15 * time.Minute,
+		IdleTimeout:               30 * time.Minute,
+		AbsoluteTimeout:           12 * time.Hour,
+		RememberMeIdleTimeout:     7 * 24 * time.Hour,
+		RememberMeAbsoluteTimeout: 30 * 24 * time.Hour,
+		RefreshReuseGrace:         10 * time.Second,
+	}
+}
+
+// Validate checks that every lifetime is positive and tokens do not outlive sessions
+func (c SessionConfig) Validate() error {
+	if c.AccessTokenTTL <= 0 || c.IdleTimeout <= 0 || c.AbsoluteTimeout <= 0 ||
+		c.RememberMeIdleTimeout <= 0 || c.RememberMeAbsoluteTimeout <= 0 || c.RefreshReuseGrace < 0 {
+		return domain.NewValidationError("session lifetimes must be positive")
+	}
+	if c.IdleTimeout > c.AbsoluteTimeout || c.RememberMeIdleTimeout > c.RememberMeAbsoluteTimeout {
+		return domain.NewValidationError("session idle timeout cannot exceed the absolute timeout")
+	}
+	if c.AccessTokenTTL > c.IdleTimeout {
+		return domain.NewValidationError("access tokens cannot outlive the session idle timeout")
+	}
+	return nil
+}
+
+// Session is a signed-in admin user. Only hashes of refresh tokens are stored: the
+// current one, and the retired ones so that presenting an old token is recognised
+// as reuse.
+type Session struct {
+	SessionID          string     `json:"session_id"`
+	UserID             string     `json:"user_id"`
+	Email              string     `json:"email"`
+	RememberMe         bool       `json:"remember_me"`
+	IPAddress          string     `json:"ip_address,omitempty"`
+	UserAgent          string     `json:"user_agent,omitempty"`
+	RefreshTokenHash   string     `json:"refresh_token_hash"`
+	RetiredTokenHashes []string   `json:"retired_token_hashes,omitempty"`
+	Generation         int        `json:"generation"`
+	CreatedAt          time.Time  `json:"created_at"`
+	LastSeenAt         time.Time  `json:"last_seen_at"`
+	RotatedAt          time.Time  `json:"rotated_at"`
+	IdleExpiresAt      time.Time  `json:"idle_expires_at"`
+	ExpiresAt          time.Time  `json:"expires_at"`
+	RevokedAt          *time.Time `json:"revoked_at,omitempty"`
+	RevokedReason      string     `json:"revoked_reason,omitempty"`
+
+	// ETag is the state store version the session was read at
+	ETag string `json:"-"`
+}
+
+// Active reports whether the session is neither revoked nor timed out at now
+func (s *Session) Active(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.IdleExpiresAt) && now.Before(s.ExpiresAt)
+}
+
+// check returns the error for a session that cannot be used at now
+func (s *Session) check(now time.Time) error {
+	if s.RevokedAt != nil {
+		return ErrSessionRevoked
+	}
+	if !now.Before(s.IdleExpiresAt) || !now.Before(s.ExpiresAt) {
+		return ErrSessionExpired
+	}
+	return nil
+}
+
+func (s *Session) revoke(now time.Time, reason string) {
+	s.RevokedAt = &now
+	s.RevokedReason = reason
+}
+
+// SessionStore persists sessions. Update only succeeds while the stored session
+// still has the ETag it was read with, and reports a conflict error otherwise.
+type SessionStore interface {
+	CreateSession(ctx context.Context, session *Session) error
+	GetSession(ctx context.Context, sessionID string) (*Session, error)
+	UpdateSession(ctx context.Context, session *Session) error
+	ListUserSessions(ctx context.Context, userID string) ([]*Session, error)
+}
+
+// SessionClient describes where a session was started from
+type SessionClient struct {
+	IPAddress string
+	UserAgent string
+}
+
+// SessionTokens are the credentials handed to the client for a session
+type SessionTokens struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresIn    int
+	Session      *Session
+}
+
+// SessionManager issues, rotates and revokes admin sessions
+type SessionManager struct {
+	store            SessionStore
+	config           SessionConfig
+	signingKey       []byte
+	signingKeyID     string
+	verificationKeys map[string][]byte
+	now              func() time.Time
+}
+
+// NewSessionManager creates a session manager that signs access tokens with
+// signingKey. Tokens signed with any of previousKeys are still accepted, so the key
+// can be rotated without signing everyone out.
+func NewSessionManager(store SessionStore, config SessionConfig, signingKey []byte, previousKeys ...[]byte) (*SessionManager, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	manager := &SessionManager{
+		store:            store,
+		config:           config,
+		verificationKeys: make(map[string][]byte),
+		now:              time.Now,
+	}
+	for i, key := range append([][]byte{signingKey}, previousKeys...) {
+		if len(key) < MinSessionSigningKeyBytes {
+			return nil, domain.NewValidationError(fmt.Sprintf("session signing keys must be at least %d bytes", MinSessionSigningKeyBytes))
+		}
+		keyID := signingKeyID(key)
+		manager.verificationKeys[keyID] = key
+		if i == 0 {
+			manager.signingKey = key
+			manager.signingKeyID = keyID
+		}
+	}
+	return manager, nil
+}
+
+// Config returns the session lifetimes
+func (m *SessionManager) Config() SessionConfig {
+	return m.config
+}
+
+// Start opens a session for user and issues its first tokens
+func (m *SessionManager) Start(ctx context.Context, user *User, rememberMe bool, client SessionClient) (*SessionTokens, error) {
+	now := m.now().UTC()
+	idleTimeout, absoluteTimeout := m.config.IdleTimeout, m.config.AbsoluteTimeout
+	if rememberMe {
+		idleTimeout, absoluteTimeout = m.config.RememberMeIdleTimeout, m.config.RememberMeAbsoluteTimeout
+	}
+
+	session := &Session{
+		SessionID:     uuid.New().String(),
+		UserID:        user.UserID,
+		Email:         user.Email,
+		RememberMe:    rememberMe,
+		IPAddress:     client.IPAddress,
+		UserAgent:     client.UserAgent,
+		CreatedAt:     now,
+		LastSeenAt:    now,
+		RotatedAt:     now,
+		IdleExpiresAt: now.Add(idleTimeout),
+		ExpiresAt:     now.Add(absoluteTimeout),
+	}
+
+	secret, err := newRefreshSecret()
+	if err != nil {
+		return nil, err
+	}
+	session.RefreshTokenHash = hashRefreshSecret(secret)
+
+	if err := m.store.CreateSession(ctx, session); err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+
+	return m.tokens(session, secret)
+}
+
+// Refresh exchanges a refresh token for new tokens. Each refresh token works once;
+// presenting one that was already exchanged means it has leaked, so the session is
+// revoked, ending the session of whoever holds the current token as well.
+func (m *SessionManager) Refresh(ctx context.Context, refreshToken string) (*SessionTokens, error) {
+	sessionID, secret, ok := strings.Cut(refreshToken, ".")
+	if !ok || sessionID == "" || secret == "" {
+		return nil, tokenError(ErrInvalidRefreshToken, "malformed token")
+	}
+
+	session, err := m.store.GetSession(ctx, sessionID)
+	if err != nil {
+		if domain.IsNotFoundError(err) {
+			return nil, tokenError(ErrInvalidRefreshToken, "unknown session")
+		}
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+
+	now := m.now().UTC()
+	if err := session.check(now); err != nil {
+		return nil, err
+	}
+
+	presented := hashRefreshSecret(secret)
+	if !hashesEqual(presented, session.RefreshTokenHash) {
+		return nil, m.rejectRefresh(ctx, session, presented, now)
+	}
+
+	next, err := newRefreshSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	session.RetiredTokenHashes = append(session.RetiredTokenHashes, session.RefreshTokenHash)
+	if len(session.RetiredTokenHashes) > maxRetiredRefreshTokens {
+		session.RetiredTokenHashes = session.RetiredTokenHashes[len(session.RetiredTokenHashes)-maxRetiredRefreshTokens:]
+	}
+	session.RefreshTokenHash = hashRefreshSecret(next)
+	session.Generation++
+	session.RotatedAt = now
+	m.extendIdle(session, now)
+
+	if err := m.store.UpdateSession(ctx, session); err != nil {
+		if domain.IsConflictError(err) {
+			// Another refresh with the same token won the race; this one is a reuse
+			return nil, tokenError(ErrInvalidRefreshToken, "refresh token already used")
+		}
+		return nil, fmt.Errorf("failed to rotate refresh token: %w", err)
+	}
+
+	return m.tokens(session, next)
+}
+
+// rejectRefresh handles a refresh token that is not the current one of its session
+func (m *SessionManager) rejectRefresh(ctx context.Context, session *Session, presented string, now time.Time) error {
+	retired := -1
+	for i, hash := range session.RetiredTokenHashes {
+		if hashesEqual(presented, hash) {
+			retired = i
+		}
+	}
+
+	if retired < 0 {
+		return tokenError(ErrInvalidRefreshToken, "token does not belong to the session")
+	}
+
+	// The token rotated moments ago: most likely a concurrent refresh by the same client
+	if retired == len(session.RetiredTokenHashes)-1 && now.Sub(session.RotatedAt) < m.config.RefreshReuseGrace {
+		return tokenError(ErrInvalidRefreshToken, "refresh token already used")
+	}
+
+	// Revoke re-reads the session when a concurrent touch or refresh changed it, so the
+	// session is never reported revoked while the stolen token keeps working
+	if err := m.Revoke(ctx, session.SessionID, RevokedOnReuse); err != nil {
+		return fmt.Errorf("failed to revoke session after refresh token reuse: %w", err)
+	}
+	return ErrRefreshTokenReused
+}
+
+// Authenticate verifies an access token and returns its session, extending the idle
+// timeout of the session as it is used
+func (m *SessionManager) Authenticate(ctx context.Context, accessToken string) (*Session, error) {
+	claims, err := m.verifyAccessToken(accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := m.store.GetSession(ctx, claims.SessionID)
+	if err != nil {
+		if domain.IsNotFoundError(err) {
+			return nil, ErrSessionRevoked
+		}
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+
+	now := m.now().UTC()
+	if err := session.check(now); err != nil {
+		return nil, err
+	}
+
+	if now.Sub(session.LastSeenAt) >= sessionTouchInterval {
+		m.extendIdle(session, now)
+		// Losing the race to another request extends the session all the same
+		if err := m.store.UpdateSession(ctx, session); err != nil && !domain.IsConflictError(err) {
+			return nil, fmt.Errorf("failed to update session activity: %w", err)
+		}
+	}
+
+	return session, nil
+}
+
+// Revoke ends one session. Revoking a session that has already ended is not an error.
+func (m *SessionManager) Revoke(ctx context.Context, sessionID string, reason string) error {
+	var lastErr error
+	for attempt := 0; attempt < maxRevokeAttempts; attempt++ {
+		session, err := m.store.GetSession(ctx, sessionID)
+		if err != nil {
+			return err
+		}
+		if session.RevokedAt != nil {
+			return nil
+		}
+
+		session.revoke(m.now().UTC(), reason)
+		err = m.store.UpdateSession(ctx, session)
+		if err == nil {
+			return nil
+		}
+		if !domain.IsConflictError(err) {
+			return fmt.Errorf("failed to revoke session: %w", err)
+		}
+		// The session changed underneath; read it again and retry
+		lastErr = err
+	}
+
+	return fmt.Errorf("session %s kept changing while it was revoked: %w", sessionID, lastErr)
+}
+
+// RevokeAll ends every active session of the user and returns how many were ended
+func (m *SessionManager) RevokeAll(ctx context.Context, userID string, reason string) (int, error) {
+	sessions, err := m.ActiveSessions(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	revoked := 0
+	for _, session := range sessions {
+		if err := m.Revoke(ctx, session.SessionID, reason); err != nil {
+			if domain.IsNotFoundError(err) {
+				continue
+			}
+			return revoked, err
+		}
+		revoked++
+	}
+	return revoked, nil
+}
+
+// ActiveSessions returns the active sessions of the user, most recently used first
+func (m *SessionManager) ActiveSessions(ctx context.Context, userID string) ([]*Session, error) {
+	sessions, err := m.store.ListUserSessions(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	now := m.now().UTC()
+	active := make([]*Session, 0, len(sessions))
+	for _, session := range sessions {
+		if session.Active(now) {
+			active = append(active, session)
+		}
+	}
+	sort.Slice(active, func(i, j int) bool { return active[i].LastSeenAt.After(active[j].LastSeenAt) })
+	return active, nil
+}
+
+// IsSessionAccessToken reports whether token claims to be an access token issued by a
+// session manager. The claim is not verified; it only chooses the verifier.
+func IsSessionAccessToken(token string) bool {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return false
+	}
+	var claims accessTokenClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return false
+	}
+	return claims.Issuer == SessionTokenIssuer
+}
+
+func (m *SessionManager) extendIdle(session *Session, now time.Time) {
+	idleTimeout := m.config.IdleTimeout
+	if session.RememberMe {
+		idleTimeout = m.config.RememberMeIdleTimeout
+	}
+	session.LastSeenAt = now
+	session.IdleExpiresAt = now.Add(idleTimeout)
+}
+
+func (m *SessionManager) tokens(session *Session, refreshSecret string) (*SessionTokens, error) {
+	accessToken, err := m.signAccessToken(session)
+	if err != nil {
+		return nil, err
+	}
+	return &SessionTokens{
+		AccessToken:  accessToken,
+		RefreshToken: session.SessionID + "." + refreshSecret,
+		ExpiresIn:    int(m.config.AccessTokenTTL / time.Second),
+		Session:      session,
+	}, nil
+}
+
+type accessTokenClaims struct {
+	Issuer    string `json:"iss"`
+	Subject   string `json:"sub"`
+	SessionID string `json:"sid"`
+	Email     string `json:"email"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+type accessTokenHeader struct {
+	Algorithm string `json:"alg"`
+	Type      string `json:"typ"`
+	KeyID     string `json:"kid"`
+}
+
+const algorithmHS256 = "HS256"
+
+func (m *SessionManager) signAccessToken(session *Session) (string, error) {
+	now := m.now().UTC()
+	header, err := json.Marshal(accessTokenHeader{Algorithm: algorithmHS256, Type: "JWT", KeyID: m.signingKeyID})
+	if err != nil {
+		return "", domain.NewInternalError("failed to encode access token", err)
+	}
+	claims, err := json.Marshal(accessTokenClaims{
+		Issuer:    SessionTokenIssuer,
+		Subject:   session.UserID,
+		SessionID: session.SessionID,
+		Email:     session.Email,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(m.config.AccessTokenTTL).Unix(),
+	})
+	if err != nil {
+		return "", domain.NewInternalError("failed to encode access token", err)
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signHS256(m.signingKey, signingInput)), nil
+}
+
+func (m *SessionManager) verifyAccessToken(token string) (*accessTokenClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, tokenError(ErrMalformedToken, "token must have three segments")
+	}
+
+	var header accessTokenHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, tokenError(ErrMalformedToken, "header: "+err.Error())
+	}
+	if header.Algorithm != algorithmHS256 {
+		return nil, tokenError(ErrUnsupportedAlgorithm, fmt.Sprintf("%q is not accepted", header.Algorithm))
+	}
+	key, ok := m.verificationKeys[header.KeyID]
+	if !ok {
+		return nil, tokenError(ErrUnknownSigningKey, fmt.Sprintf("no key with id %q", header.KeyID))
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, tokenError(ErrMalformedToken, "signature is not base64url")
+	}
+	if !hmac.Equal(signature, signHS256(key, parts[0]+"."+parts[1])) {
+		return nil, tokenError(ErrInvalidSignature, "access token signature does not match")
+	}
+
+	var claims accessTokenClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, tokenError(ErrMalformedToken, "claims: "+err.Error())
+	}
+	if claims.Issuer != SessionTokenIssuer {
+		return nil, tokenError(ErrUnknownIssuer, fmt.Sprintf("%q is not a trusted issuer", claims.Issuer))
+	}
+	if claims.SessionID == "" {
+		return nil, tokenError(ErrMalformedToken, "sid claim is required")
+	}
+	expiresAt := time.Unix(claims.ExpiresAt, 0)
+	if !m.now().Before(expiresAt) {
+		return nil, tokenError(ErrTokenExpired, "expired at "+expiresAt.UTC().Format(time.RFC3339))
+	}
+	return &claims, nil
+}
+
+func signHS256(key []byte, signingInput string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(signingInput))
+	return mac.Sum(nil)
+}
+
+func signingKeyID(key []byte) string {
+	digest := sha256.Sum256(key)
+	return hex.EncodeToString(digest[:8])
+}
+
+func newRefreshSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", domain.NewInternalError("failed to generate refresh token", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+func hashRefreshSecret(secret string) string {
+	digest := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(digest[:])
+}
+
+func hashesEqual(a, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
+
+type sessionContextKey struct{}
+
+// WithSession returns a context carrying the session a request was authenticated with
+func WithSession(ctx context.Context, session *Session) context.Context {
+	return context.WithValue(ctx, sessionContextKey{}, session)
+}
+
+// SessionFromContext returns the session stored by WithSession, if any
+func SessionFromContext(ctx context.Context) (*Session, bool) {
+	session, ok := ctx.Value(sessionContextKey{}).(*Session)
+	return session, ok && session != nil
+}
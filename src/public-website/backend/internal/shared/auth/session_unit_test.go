@@ -0,0 +1,549 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memorySessionStore keeps sessions in memory with the ETag semantics of the state store
+type memorySessionStore struct {
+	mu       sync.Mutex
+	sessions map[string]Session
+	versions map[string]int
+}
+
+func newMemorySessionStore() *memorySessionStore {
+	return &memorySessionStore{sessions: make(map[string]Session), versions: make(map[string]int)}
+}
+
+func (s *memorySessionStore) CreateSession(ctx context.Context, session *Session) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, exists := s.sessions[session.SessionID]; exists {
+		return domain.NewConflictError("session already exists")
+	}
+	s.save(session)
+	return nil
+}
+
+func (s *memorySessionStore) GetSession(ctx context.Context, sessionID string) (*Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	session, ok := s.sessions[sessionID]
+	if !ok {
+		return nil, domain.NewNotFoundError("session", sessionID)
+	}
+	return copySession(session), nil
+}
+
+func (s *memorySessionStore) UpdateSession(ctx context.Context, session *Session) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if session.ETag != strconv.Itoa(s.versions[session.SessionID]) {
+		return domain.NewConflictError("session was modified")
+	}
+	s.save(session)
+	return nil
+}
+
+func (s *memorySessionStore) ListUserSessions(ctx context.Context, userID string) ([]*Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var sessions []*Session
+	for _, session := range s.sessions {
+		if session.UserID == userID {
+			sessions = append(sessions, copySession(session))
+		}
+	}
+	return sessions, nil
+}
+
+func (s *memorySessionStore) save(session *Session) {
+	s.versions[session.SessionID]++
+	session.ETag = strconv.Itoa(s.versions[session.SessionID])
+	s.sessions[session.SessionID] = *copySession(*session)
+}
+
+func copySession(session Session) *Session {
+	session.RetiredTokenHashes = append([]string(nil), session.RetiredTokenHashes...)
+	return &session
+}
+
+type testClock struct{ now time.Time }
+
+func (c *testClock) Now() time.Time                 { return c.now }
+func (c *testClock) Advance(duration time.Duration) { c.now = c.now.Add(duration) }
+
+var (
+	testSigningKey  = []byte(strings.Repeat("k", MinSessionSigningKeyBytes))
+	otherSigningKey = []byte(strings.Repeat("o", MinSessionSigningKeyBytes))
+	sessionUser     = &User{UserID: "user-1", Email: "staff@example.com"}
+)
+
+func newTestSessionManager(t *testing.T, signingKey []byte, previousKeys ...[]byte) (*SessionManager, *memorySessionStore, *testClock) {
+	t.Helper()
+	store := newMemorySessionStore()
+	clock := &testClock{now: time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)}
+	manager, err := NewSessionManager(store, DefaultSessionConfig(), signingKey, previousKeys...)
+	require.NoError(t, err)
+	manager.now = clock.Now
+	return manager, store, clock
+}
+
+func TestNewSessionManagerValidation(t *testing.T) {
+	shortConfig := DefaultSessionConfig()
+	shortConfig.AccessTokenTTL = time.Hour
+
+	tests := []struct {
+		name         string
+		config       SessionConfig
+		signingKey   []byte
+		previousKeys [][]byte
+	}{
+		{name: "short signing key", config: DefaultSessionConfig(), signingKey: []byte("short")},
+		{name: "short previous key", config: DefaultSessionConfig(), signingKey: testSigningKey, previousKeys: [][]byte{[]byte("short")}},
+		{name: "access tokens outlive the idle timeout", config: shortConfig, signingKey: testSigningKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			_, err := NewSessionManager(newMemorySessionStore(), tt.config, tt.signingKey, tt.previousKeys...)
+
+			// Assert
+			assert.True(t, domain.IsValidationError(err), "got %v", err)
+		})
+	}
+}
+
+func TestSessionManagerAuthenticate(t *testing.T) {
+	tests := []struct {
+		name        string
+		token       func(t *testing.T, manager *SessionManager, clock *testClock, tokens *SessionTokens) string
+		expectedErr error
+	}{
+		{
+			name: "fresh access token",
+			token: func(t *testing.T, manager *SessionManager, clock *testClock, tokens *SessionTokens) string {
+				return tokens.AccessToken
+			},
+		},
+		{
+			name: "expired access token",
+			token: func(t *testing.T, manager *SessionManager, clock *testClock, tokens *SessionTokens) string {
+				clock.Advance(16 * time.Minute)
+				return tokens.AccessToken
+			},
+			expectedErr: ErrTokenExpired,
+		},
+		{
+			name: "tampered claims",
+			token: func(t *testing.T, manager *SessionManager, clock *testClock, tokens *SessionTokens) string {
+				parts := strings.Split(tokens.AccessToken, ".")
+				other, err := manager.Start(context.Background(), &User{UserID: "user-2", Email: "other@example.com"}, false, SessionClient{})
+				require.NoError(t, err)
+				return parts[0] + "." + strings.Split(other.AccessToken, ".")[1] + "." + parts[2]
+			},
+			expectedErr: ErrInvalidSignature,
+		},
+		{
+			name: "signed with an unknown key",
+			token: func(t *testing.T, manager *SessionManager, clock *testClock, tokens *SessionTokens) string {
+				stranger, _, _ := newTestSessionManager(t, otherSigningKey)
+				issued, err := stranger.Start(context.Background(), sessionUser, false, SessionClient{})
+				require.NoError(t, err)
+				return issued.AccessToken
+			},
+			expectedErr: ErrUnknownSigningKey,
+		},
+		{
+			name: "revoked session",
+			token: func(t *testing.T, manager *SessionManager, clock *testClock, tokens *SessionTokens) string {
+				require.NoError(t, manager.Revoke(context.Background(), tokens.Session.SessionID, RevokedByLogout))
+				return tokens.AccessToken
+			},
+			expectedErr: ErrSessionRevoked,
+		},
+		{
+			name:        "not a token",
+			token:       func(*testing.T, *SessionManager, *testClock, *SessionTokens) string { return "opaque" },
+			expectedErr: ErrMalformedToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			manager, _, clock := newTestSessionManager(t, testSigningKey)
+			tokens, err := manager.Start(context.Background(), sessionUser, false, SessionClient{IPAddress: "203.0.113.7"})
+			require.NoError(t, err)
+			token := tt.token(t, manager, clock, tokens)
+
+			// Act
+			session, err := manager.Authenticate(context.Background(), token)
+
+			// Assert
+			if tt.expectedErr != nil {
+				assert.True(t, errors.Is(err, tt.expectedErr), "expected %v, got %v", tt.expectedErr, err)
+				assert.True(t, domain.IsUnauthorizedError(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tokens.Session.SessionID, session.SessionID)
+			assert.Equal(t, "203.0.113.7", session.IPAddress)
+			assert.True(t, IsSessionAccessToken(token))
+		})
+	}
+}
+
+func TestSessionManagerSigningKeyRotation(t *testing.T) {
+	// Arrange
+	previous, store, clock := newTestSessionManager(t, otherSigningKey)
+	tokens, err := previous.Start(context.Background(), sessionUser, false, SessionClient{})
+	require.NoError(t, err)
+
+	current, err := NewSessionManager(store, DefaultSessionConfig(), testSigningKey, otherSigningKey)
+	require.NoError(t, err)
+	current.now = clock.Now
+
+	// Act
+	session, err := current.Authenticate(context.Background(), tokens.AccessToken)
+	refreshed, refreshErr := current.Refresh(context.Background(), tokens.RefreshToken)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, tokens.Session.SessionID, session.SessionID)
+	require.NoError(t, refreshErr)
+	_, err = previous.Authenticate(context.Background(), refreshed.AccessToken)
+	assert.True(t, errors.Is(err, ErrUnknownSigningKey), "tokens signed with the new key are unknown to the old manager")
+}
+
+func TestSessionManagerRefresh(t *testing.T) {
+	tests := []struct {
+		name            string
+		refreshToken    func(t *testing.T, manager *SessionManager, clock *testClock, tokens *SessionTokens) string
+		expectedErr     error
+		expectedRevoked string
+	}{
+		{
+			name: "current token rotates",
+			refreshToken: func(t *testing.T, manager *SessionManager, clock *testClock, tokens *SessionTokens) string {
+				return tokens.RefreshToken
+			},
+		},
+		{
+			name: "rotated token replayed by a concurrent request",
+			refreshToken: func(t *testing.T, manager *SessionManager, clock *testClock, tokens *SessionTokens) string {
+				_, err := manager.Refresh(context.Background(), tokens.RefreshToken)
+				require.NoError(t, err)
+				clock.Advance(2 * time.Second)
+				return tokens.RefreshToken
+			},
+			expectedErr: ErrInvalidRefreshToken,
+		},
+		{
+			name: "rotated token reused later",
+			refreshToken: func(t *testing.T, manager *SessionManager, clock *testClock, tokens *SessionTokens) string {
+				_, err := manager.Refresh(context.Background(), tokens.RefreshToken)
+				require.NoError(t, err)
+				clock.Advance(5 * time.Minute)
+				return tokens.RefreshToken
+			},
+			expectedErr:     ErrRefreshTokenReused,
+			expectedRevoked: RevokedOnReuse,
+		},
+		{
+			name: "older token reused within the grace period",
+			refreshToken: func(t *testing.T, manager *SessionManager, clock *testClock, tokens *SessionTokens) string {
+				second, err := manager.Refresh(context.Background(), tokens.RefreshToken)
+				require.NoError(t, err)
+				_, err = manager.Refresh(context.Background(), second.RefreshToken)
+				require.NoError(t, err)
+				return tokens.RefreshToken
+			},
+			expectedErr:     ErrRefreshTokenReused,
+			expectedRevoked: RevokedOnReuse,
+		},
+		{
+			name: "secret that was never issued",
+			refreshToken: func(t *testing.T, manager *SessionManager, clock *testClock, tokens *SessionTokens) string {
+				return tokens.Session.SessionID + ".forged"
+			},
+			expectedErr: ErrInvalidRefreshToken,
+		},
+		{
+			name: "unknown session",
+			refreshToken: func(t *testing.T, manager *SessionManager, clock *testClock, tokens *SessionTokens) string {
+				return "missing." + strings.Split(tokens.RefreshToken, ".")[1]
+			},
+			expectedErr: ErrInvalidRefreshToken,
+		},
+		{
+			name:         "malformed token",
+			refreshToken: func(*testing.T, *SessionManager, *testClock, *SessionTokens) string { return "opaque" },
+			expectedErr:  ErrInvalidRefreshToken,
+		},
+		{
+			name: "idle session",
+			refreshToken: func(t *testing.T, manager *SessionManager, clock *testClock, tokens *SessionTokens) string {
+				clock.Advance(31 * time.Minute)
+				return tokens.RefreshToken
+			},
+			expectedErr: ErrSessionExpired,
+		},
+		{
+			name: "session past its absolute timeout",
+			refreshToken: func(t *testing.T, manager *SessionManager, clock *testClock, tokens *SessionTokens) string {
+				token := tokens.RefreshToken
+				for i := 0; i < 24; i++ {
+					clock.Advance(29 * time.Minute)
+					refreshed, err := manager.Refresh(context.Background(), token)
+					require.NoError(t, err)
+					token = refreshed.RefreshToken
+				}
+				clock.Advance(29 * time.Minute)
+				return token
+			},
+			expectedErr: ErrSessionExpired,
+		},
+		{
+			name: "revoked session",
+			refreshToken: func(t *testing.T, manager *SessionManager, clock *testClock, tokens *SessionTokens) string {
+				require.NoError(t, manager.Revoke(context.Background(), tokens.Session.SessionID, RevokedByAdmin))
+				return tokens.RefreshToken
+			},
+			expectedErr:     ErrSessionRevoked,
+			expectedRevoked: RevokedByAdmin,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			manager, store, clock := newTestSessionManager(t, testSigningKey)
+			tokens, err := manager.Start(context.Background(), sessionUser, false, SessionClient{})
+			require.NoError(t, err)
+			refreshToken := tt.refreshToken(t, manager, clock, tokens)
+
+			// Act
+			refreshed, err := manager.Refresh(context.Background(), refreshToken)
+
+			// Assert
+			stored, getErr := store.GetSession(context.Background(), tokens.Session.SessionID)
+			require.NoError(t, getErr)
+			assert.Equal(t, tt.expectedRevoked, stored.RevokedReason)
+
+			if tt.expectedErr != nil {
+				assert.True(t, errors.Is(err, tt.expectedErr), "expected %v, got %v", tt.expectedErr, err)
+				assert.True(t, domain.IsUnauthorizedError(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEqual(t, tokens.RefreshToken, refreshed.RefreshToken)
+			assert.Equal(t, 1, stored.Generation)
+			assert.NotContains(t, stored.RefreshTokenHash, strings.Split(refreshed.RefreshToken, ".")[1], "only the hash is stored")
+
+			_, err = manager.Authenticate(context.Background(), refreshed.AccessToken)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestSessionManagerIdleTimeout(t *testing.T) {
+	tests := []struct {
+		name       string
+		rememberMe bool
+		activity   []time.Duration
+		expiresIn  time.Duration
+	}{
+		{name: "activity keeps the session alive", activity: []time.Duration{10 * time.Minute, 10 * time.Minute, 10 * time.Minute}, expiresIn: 30 * time.Minute},
+		{name: "remember me allows long gaps", rememberMe: true, activity: []time.Duration{6 * 24 * time.Hour}, expiresIn: 7 * 24 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			manager, _, clock := newTestSessionManager(t, testSigningKey)
+			tokens, err := manager.Start(context.Background(), sessionUser, tt.rememberMe, SessionClient{})
+			require.NoError(t, err)
+
+			// Act
+			for _, gap := range tt.activity {
+				clock.Advance(gap)
+				tokens, err = manager.Refresh(context.Background(), tokens.RefreshToken)
+				require.NoError(t, err)
+				_, err = manager.Authenticate(context.Background(), tokens.AccessToken)
+				require.NoError(t, err)
+			}
+
+			// Assert
+			assert.Equal(t, clock.Now().Add(tt.expiresIn), tokens.Session.IdleExpiresAt)
+			clock.Advance(tt.expiresIn)
+			_, err = manager.Refresh(context.Background(), tokens.RefreshToken)
+			assert.True(t, errors.Is(err, ErrSessionExpired), "got %v", err)
+		})
+	}
+}
+
+func TestSessionManagerRevokeAll(t *testing.T) {
+	// Arrange
+	manager, _, clock := newTestSessionManager(t, testSigningKey)
+	ctx := context.Background()
+	laptop, err := manager.Start(ctx, sessionUser, false, SessionClient{UserAgent: "laptop"})
+	require.NoError(t, err)
+	clock.Advance(time.Minute)
+	phone, err := manager.Start(ctx, sessionUser, true, SessionClient{UserAgent: "phone"})
+	require.NoError(t, err)
+	other, err := manager.Start(ctx, &User{UserID: "user-2", Email: "other@example.com"}, false, SessionClient{})
+	require.NoError(t, err)
+
+	active, err := manager.ActiveSessions(ctx, sessionUser.UserID)
+	require.NoError(t, err)
+	require.Len(t, active, 2)
+	assert.Equal(t, "phone", active[0].UserAgent, "most recently used session comes first")
+
+	// Act
+	revoked, err := manager.RevokeAll(ctx, sessionUser.UserID, RevokedOnAccountChange)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 2, revoked)
+	for _, tokens := range []*SessionTokens{laptop, phone} {
+		_, err := manager.Authenticate(ctx, tokens.AccessToken)
+		assert.True(t, errors.Is(err, ErrSessionRevoked), "got %v", err)
+	}
+	_, err = manager.Authenticate(ctx, other.AccessToken)
+	assert.NoError(t, err, "sessions of other users are untouched")
+
+	active, err = manager.ActiveSessions(ctx, sessionUser.UserID)
+	require.NoError(t, err)
+	assert.Empty(t, active)
+}
+
+// conflictingSessionStore fails the next conflicts updates as if another request had
+// changed the session first
+type conflictingSessionStore struct {
+	*memorySessionStore
+	conflicts int
+	updates   int
+}
+
+func (s *conflictingSessionStore) UpdateSession(ctx context.Context, session *Session) error {
+	s.updates++
+	if s.conflicts > 0 {
+		s.conflicts--
+		return domain.NewConflictError("session was modified")
+	}
+	return s.memorySessionStore.UpdateSession(ctx, session)
+}
+
+func TestSessionManagerRevokeConflicts(t *testing.T) {
+	tests := []struct {
+		name            string
+		conflicts       int
+		expectedError   bool
+		expectedUpdates int
+	}{
+		{name: "retries after a concurrent update", conflicts: 2, expectedUpdates: 3},
+		{name: "gives up when the session keeps changing", conflicts: 100, expectedError: true, expectedUpdates: maxRevokeAttempts},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+			store := &conflictingSessionStore{memorySessionStore: newMemorySessionStore()}
+			manager, err := NewSessionManager(store, DefaultSessionConfig(), testSigningKey)
+			require.NoError(t, err)
+			tokens, err := manager.Start(ctx, sessionUser, false, SessionClient{})
+			require.NoError(t, err)
+			store.conflicts = tt.conflicts
+
+			// Act
+			err = manager.Revoke(ctx, tokens.Session.SessionID, RevokedByAdmin)
+
+			// Assert
+			assert.Equal(t, tt.expectedUpdates, store.updates)
+			stored, getErr := store.GetSession(ctx, tokens.Session.SessionID)
+			require.NoError(t, getErr)
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.True(t, domain.IsConflictError(err), "got %v", err)
+				assert.Nil(t, stored.RevokedAt)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, RevokedByAdmin, stored.RevokedReason)
+		})
+	}
+}
+
+func TestSessionManagerRefreshReuseConflicts(t *testing.T) {
+	tests := []struct {
+		name          string
+		conflicts     int
+		expectedErr   error
+		expectRevoked bool
+	}{
+		{name: "revokes after a concurrent update", conflicts: 1, expectedErr: ErrRefreshTokenReused, expectRevoked: true},
+		{name: "reports a failure instead of claiming the revocation", conflicts: 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+			store := &conflictingSessionStore{memorySessionStore: newMemorySessionStore()}
+			manager, err := NewSessionManager(store, DefaultSessionConfig(), testSigningKey)
+			require.NoError(t, err)
+			clock := &testClock{now: time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)}
+			manager.now = clock.Now
+
+			tokens, err := manager.Start(ctx, sessionUser, false, SessionClient{})
+			require.NoError(t, err)
+			_, err = manager.Refresh(ctx, tokens.RefreshToken)
+			require.NoError(t, err)
+			clock.Advance(5 * time.Minute)
+			store.conflicts = tt.conflicts
+
+			// Act
+			_, err = manager.Refresh(ctx, tokens.RefreshToken)
+
+			// Assert
+			stored, getErr := store.GetSession(ctx, tokens.Session.SessionID)
+			require.NoError(t, getErr)
+			if !tt.expectRevoked {
+				require.Error(t, err)
+				assert.False(t, errors.Is(err, ErrRefreshTokenReused), "got %v", err)
+				assert.Nil(t, stored.RevokedAt)
+				return
+			}
+			assert.True(t, errors.Is(err, tt.expectedErr), "got %v", err)
+			require.NotNil(t, stored.RevokedAt)
+			assert.Equal(t, RevokedOnReuse, stored.RevokedReason)
+		})
+	}
+}
+
+func TestSessionContext(t *testing.T) {
+	// Arrange
+	session := &Session{SessionID: "session-1"}
+
+	// Act
+	stored, ok := SessionFromContext(WithSession(context.Background(), session))
+	_, missing := SessionFromContext(context.Background())
+
+	// Assert
+	require.True(t, ok)
+	assert.Same(t, session, stored)
+	assert.False(t, missing)
+}
@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+)
+
+// VerifySocialLogin verifies a social provider ID token with the default verifier
+// and checks that the provider vouches for the email it names. Whether that email
+// belongs to an admin user is decided by the gateway user directory, so an email
+// the provider has not verified is never accepted.
+func VerifySocialLogin(ctx context.Context, token string) (*Claims, error) {
+	if token == "" {
+		return nil, domain.NewUnauthorizedError("empty token")
+	}
+
+	claims, err := DefaultVerifier().Verify(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Email == "" {
+		return nil, domain.NewForbiddenError("token does not carry an email")
+	}
+	if !claims.EmailVerified {
+		return nil, domain.NewForbiddenError("email not verified")
+	}
+	return claims, nil
+}
+
+// ExtractSocialProviderClaims extracts claims from a social provider JWT. Only tokens
+// that pass verification yield claims; anything else returns an empty map.
+func ExtractSocialProviderClaims(jwtToken string) map[string]interface{} {
+	claims, err := DefaultVerifier().Verify(context.Background(), jwtToken)
+	if err != nil {
+		return map[string]interface{}{}
+	}
+	return claims.Raw
+}
+
+// ValidateJWTToken validates JWT token signature, claims and email
+func ValidateJWTToken(token string) bool {
+	_, err := VerifySocialLogin(context.Background(), token)
+	return err == nil
+}
+
+// GetTokenValidationError returns error information for invalid tokens, or an empty
+// string for a valid one
+func GetTokenValidationError(token string) string {
+	_, err := VerifySocialLogin(context.Background(), token)
+	if err == nil {
+		return ""
+	}
+
+	var domainErr *domain.DomainError
+	if errors.As(err, &domainErr) {
+		return domainErr.Message
+	}
+	return err.Error()
+}
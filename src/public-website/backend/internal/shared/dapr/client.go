@@ -14,6 +14,10 @@ type Client struct {
 	client      client.Client
 	environment string
 	appID       string
+
+	// stateBackends holds the local state backends shared by this client's state stores
+	stateBackends map[string]StateBackend
+	backendsMutex sync.Mutex
 }
 
 var (
@@ -0,0 +1,28 @@
+package dapr
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// ConfigAdminHandler serves the admin view of a service's reloadable configuration
+type ConfigAdminHandler struct {
+	reloader *ConfigReloader
+}
+
+// NewConfigAdminHandler creates the configuration admin API for a reloader
+func NewConfigAdminHandler(reloader *ConfigReloader) *ConfigAdminHandler {
+	return &ConfigAdminHandler{reloader: reloader}
+}
+
+// RegisterRoutes registers the configuration routes on a router mounted at the admin API root
+func (h *ConfigAdminHandler) RegisterRoutes(router *mux.Router) {
+	router.HandleFunc("/config", h.GetConfig).Methods("GET")
+}
+
+// GetConfig returns the active configuration version, its values and the last
+// change that was rolled back
+func (h *ConfigAdminHandler) GetConfig(w http.ResponseWriter, r *http.Request) {
+	writeAdminResponse(w, r, http.StatusOK, map[string]interface{}{"data": h.reloader.Status()})
+}
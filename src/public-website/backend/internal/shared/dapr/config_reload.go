@@ -0,0 +1,342 @@
+package dapr
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+)
+
+// ConfigValues holds configuration store values by key. Missing and empty values
+// fall back to the listener's defaults.
+type ConfigValues map[string]string
+
+// String returns the value of key, or fallback when it is unset
+func (v ConfigValues) String(key, fallback string) string {
+	if value := strings.TrimSpace(v[key]); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// Int parses the value of key as an integer
+func (v ConfigValues) Int(key string, fallback int) (int, error) {
+	value := strings.TrimSpace(v[key])
+	if value == "" {
+		return fallback, nil
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, domain.NewValidationError(fmt.Sprintf("configuration %s must be an integer, got %q", key, value))
+	}
+	return parsed, nil
+}
+
+// Bool parses the value of key as a boolean
+func (v ConfigValues) Bool(key string, fallback bool) (bool, error) {
+	value := strings.TrimSpace(v[key])
+	if value == "" {
+		return fallback, nil
+	}
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, domain.NewValidationError(fmt.Sprintf("configuration %s must be true or false, got %q", key, value))
+	}
+	return parsed, nil
+}
+
+// Duration parses the value of key as a Go duration such as "30s"
+func (v ConfigValues) Duration(key string, fallback time.Duration) (time.Duration, error) {
+	value := strings.TrimSpace(v[key])
+	if value == "" {
+		return fallback, nil
+	}
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, domain.NewValidationError(fmt.Sprintf("configuration %s must be a duration, got %q", key, value))
+	}
+	return parsed, nil
+}
+
+// List splits the comma separated value of key, dropping blank entries
+func (v ConfigValues) List(key string, fallback []string) []string {
+	if strings.TrimSpace(v[key]) == "" {
+		return fallback
+	}
+	var list []string
+	for _, entry := range strings.Split(v[key], ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			list = append(list, entry)
+		}
+	}
+	return list
+}
+
+// ConfigListener reloads one subsystem when any of its keys change. Prepare builds
+// and validates the subsystem's next settings from the complete set of values
+// without touching the running ones; the function it returns switches to them and
+// must not fail.
+type ConfigListener struct {
+	Name    string
+	Keys    []string
+	Prepare func(values ConfigValues) (apply func(), err error)
+}
+
+// ConfigRejection describes the last change that failed validation and was not applied
+type ConfigRejection struct {
+	Listener   string            `json:"listener"`
+	Values     map[string]string `json:"values"`
+	Error      string            `json:"error"`
+	RejectedAt time.Time         `json:"rejected_at"`
+}
+
+// ConfigListenerStatus names a registered listener and the keys it watches
+type ConfigListenerStatus struct {
+	Name string   `json:"name"`
+	Keys []string `json:"keys"`
+}
+
+// ConfigStatus reports the configuration a service is running with
+type ConfigStatus struct {
+	Version       int                    `json:"version"`
+	AppliedAt     time.Time              `json:"applied_at"`
+	Watching      bool                   `json:"watching"`
+	Values        map[string]string      `json:"values"`
+	StoreVersions map[string]string      `json:"store_versions"`
+	Listeners     []ConfigListenerStatus `json:"listeners"`
+	LastRejection *ConfigRejection       `json:"last_rejection,omitempty"`
+}
+
+// ConfigReloader applies configuration store changes to the registered listeners
+// while the service runs. A change is applied to every affected listener or to
+// none: when one listener rejects it, the previous configuration stays active.
+type ConfigReloader struct {
+	configuration *Configuration
+	mutex         sync.Mutex
+	listeners     []ConfigListener
+	values        ConfigValues
+	storeVersions map[string]string
+	version       int
+	appliedAt     time.Time
+	watching      bool
+	lastRejection *ConfigRejection
+	now           func() time.Time
+}
+
+// NewConfigReloader creates a reloader reading from the Dapr configuration store
+func NewConfigReloader(configuration *Configuration) *ConfigReloader {
+	return &ConfigReloader{
+		configuration: configuration,
+		values:        ConfigValues{},
+		storeVersions: map[string]string{},
+		now:           time.Now,
+	}
+}
+
+// Register adds a listener and applies the values known so far to it, so the
+// listener's defaults are validated before the first change arrives
+func (r *ConfigReloader) Register(listener ConfigListener) error {
+	if listener.Name == "" || len(listener.Keys) == 0 || listener.Prepare == nil {
+		return domain.NewValidationError("configuration listener needs a name, keys and a prepare function")
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, registered := range r.listeners {
+		if registered.Name == listener.Name {
+			return domain.NewConflictError(fmt.Sprintf("configuration listener %s is already registered", listener.Name))
+		}
+	}
+
+	apply, err := listener.Prepare(r.values.copy())
+	if err != nil {
+		return fmt.Errorf("configuration listener %s rejected the current values: %w", listener.Name, err)
+	}
+	apply()
+	r.listeners = append(r.listeners, listener)
+	return nil
+}
+
+// Keys returns every key a registered listener watches
+func (r *ConfigReloader) Keys() []string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.keys()
+}
+
+// Start loads the watched keys from the configuration store, applies them and keeps
+// watching for changes until ctx ends. A store that cannot be watched leaves the
+// loaded configuration in place.
+func (r *ConfigReloader) Start(ctx context.Context) error {
+	keys := r.Keys()
+	if len(keys) == 0 {
+		return nil
+	}
+
+	items, err := r.configuration.GetConfigurationItems(ctx, keys)
+	if err != nil {
+		return fmt.Errorf("failed to load reloadable configuration: %w", err)
+	}
+	if err := r.Apply(items); err != nil {
+		log.Printf("Initial configuration rejected, keeping defaults: %v", err)
+	}
+
+	err = r.configuration.WatchConfiguration(ctx, keys, func(items map[string]*ConfigItem) {
+		if err := r.Apply(items); err != nil {
+			log.Printf("Configuration change rejected, keeping version %d: %v", r.Status().Version, err)
+		}
+	})
+	if err != nil {
+		log.Printf("Configuration changes will not be applied until restart: %v", err)
+		return nil
+	}
+
+	r.mutex.Lock()
+	r.watching = true
+	r.mutex.Unlock()
+	return nil
+}
+
+// Apply merges changed configuration items into the active values and reloads the
+// listeners watching them. Items that do not change a value are ignored.
+func (r *ConfigReloader) Apply(items map[string]*ConfigItem) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	next := r.values.copy()
+	changed := map[string]bool{}
+	for key, item := range items {
+		if item == nil || next[key] == item.Value {
+			continue
+		}
+		if item.Value == "" {
+			delete(next, key)
+		} else {
+			next[key] = item.Value
+		}
+		changed[key] = true
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+
+	var applies []func()
+	for _, listener := range r.listeners {
+		if !listener.watchesAny(changed) {
+			continue
+		}
+		apply, err := listener.Prepare(next.copy())
+		if err != nil {
+			r.lastRejection = &ConfigRejection{
+				Listener:   listener.Name,
+				Values:     redactConfigValues(changedValues(next, changed)),
+				Error:      err.Error(),
+				RejectedAt: r.now().UTC(),
+			}
+			return fmt.Errorf("configuration listener %s rejected the change: %w", listener.Name, err)
+		}
+		applies = append(applies, apply)
+	}
+
+	for _, apply := range applies {
+		apply()
+	}
+	for key := range changed {
+		if item := items[key]; item.Version != "" {
+			r.storeVersions[key] = item.Version
+		} else {
+			delete(r.storeVersions, key)
+		}
+	}
+	r.values = next
+	r.version++
+	r.appliedAt = r.now().UTC()
+	return nil
+}
+
+// Status reports the active configuration version and values, with secrets redacted
+func (r *ConfigReloader) Status() *ConfigStatus {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	status := &ConfigStatus{
+		Version:       r.version,
+		AppliedAt:     r.appliedAt,
+		Watching:      r.watching,
+		Values:        redactConfigValues(r.values),
+		StoreVersions: make(map[string]string, len(r.storeVersions)),
+		Listeners:     make([]ConfigListenerStatus, 0, len(r.listeners)),
+		LastRejection: r.lastRejection,
+	}
+	for key, version := range r.storeVersions {
+		status.StoreVersions[key] = version
+	}
+	for _, listener := range r.listeners {
+		status.Listeners = append(status.Listeners, ConfigListenerStatus{
+			Name: listener.Name,
+			Keys: append([]string{}, listener.Keys...),
+		})
+	}
+	return status
+}
+
+func (r *ConfigReloader) keys() []string {
+	seen := map[string]bool{}
+	var keys []string
+	for _, listener := range r.listeners {
+		for _, key := range listener.Keys {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (l ConfigListener) watchesAny(keys map[string]bool) bool {
+	for _, key := range l.Keys {
+		if keys[key] {
+			return true
+		}
+	}
+	return false
+}
+
+func (v ConfigValues) copy() ConfigValues {
+	copied := make(ConfigValues, len(v))
+	for key, value := range v {
+		copied[key] = value
+	}
+	return copied
+}
+
+func changedValues(values ConfigValues, changed map[string]bool) map[string]string {
+	result := make(map[string]string, len(changed))
+	for key := range changed {
+		result[key] = values[key]
+	}
+	return result
+}
+
+// redactConfigValues hides values whose key suggests a credential
+func redactConfigValues(values map[string]string) map[string]string {
+	redacted := make(map[string]string, len(values))
+	for key, value := range values {
+		lower := strings.ToLower(key)
+		if strings.Contains(lower, "secret") || strings.Contains(lower, "password") ||
+			strings.Contains(lower, "token") || strings.Contains(lower, "credential") {
+			value = "[redacted]"
+		}
+		redacted[key] = value
+	}
+	return redacted
+}
@@ -0,0 +1,193 @@
+package dapr
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testLimits is the state two test listeners reload: a rate limit and the origins
+// allowed to call the API
+type testLimits struct {
+	requestsPerMinute int
+	origins           []string
+}
+
+func newTestConfigReloader(t *testing.T, limits *testLimits) *ConfigReloader {
+	reloader := NewConfigReloader(nil)
+	reloader.now = func() time.Time { return time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC) }
+
+	require.NoError(t, reloader.Register(ConfigListener{
+		Name: "rate-limit",
+		Keys: []string{"gateway.requests_per_minute"},
+		Prepare: func(values ConfigValues) (func(), error) {
+			requestsPerMinute, err := values.Int("gateway.requests_per_minute", 100)
+			if err != nil {
+				return nil, err
+			}
+			if requestsPerMinute <= 0 {
+				return nil, domain.NewValidationError("requests per minute must be positive")
+			}
+			return func() { limits.requestsPerMinute = requestsPerMinute }, nil
+		},
+	}))
+	require.NoError(t, reloader.Register(ConfigListener{
+		Name: "cors",
+		Keys: []string{"gateway.allowed_origins", "gateway.requests_per_minute"},
+		Prepare: func(values ConfigValues) (func(), error) {
+			origins := values.List("gateway.allowed_origins", []string{"https://example.com"})
+			return func() { limits.origins = origins }, nil
+		},
+	}))
+	return reloader
+}
+
+func configItems(values map[string]string) map[string]*ConfigItem {
+	items := make(map[string]*ConfigItem, len(values))
+	for key, value := range values {
+		items[key] = &ConfigItem{Key: key, Value: value, Version: "7"}
+	}
+	return items
+}
+
+func TestConfigReloader_Register(t *testing.T) {
+	// Arrange
+	limits := &testLimits{}
+
+	// Act
+	reloader := newTestConfigReloader(t, limits)
+	duplicate := reloader.Register(ConfigListener{Name: "cors", Keys: []string{"gateway.cors"}, Prepare: func(ConfigValues) (func(), error) { return func() {}, nil }})
+	rejected := reloader.Register(ConfigListener{Name: "broken", Keys: []string{"gateway.broken"}, Prepare: func(ConfigValues) (func(), error) {
+		return nil, errors.New("no defaults")
+	}})
+
+	// Assert
+	assert.Equal(t, &testLimits{requestsPerMinute: 100, origins: []string{"https://example.com"}}, limits, "listeners start from their defaults")
+	assert.True(t, domain.IsConflictError(duplicate))
+	assert.Error(t, rejected)
+	assert.Equal(t, []string{"gateway.allowed_origins", "gateway.requests_per_minute"}, reloader.Keys())
+	assert.Zero(t, reloader.Status().Version, "registering does not change the configuration version")
+}
+
+func TestConfigReloader_Apply(t *testing.T) {
+	tests := []struct {
+		name              string
+		change            map[string]string
+		expectedError     bool
+		expectedVersion   int
+		expectedLimits    *testLimits
+		expectedRejection string
+	}{
+		{name: "change applied to every listener", change: map[string]string{"gateway.requests_per_minute": "250", "gateway.allowed_origins": "https://a.example, https://b.example"},
+			expectedVersion: 2, expectedLimits: &testLimits{requestsPerMinute: 250, origins: []string{"https://a.example", "https://b.example"}}},
+		{name: "unchanged values are ignored", change: map[string]string{"gateway.requests_per_minute": "200"},
+			expectedVersion: 1, expectedLimits: &testLimits{requestsPerMinute: 200, origins: []string{"https://admin.example"}}},
+		{name: "removed value reverts to the default", change: map[string]string{"gateway.allowed_origins": ""},
+			expectedVersion: 2, expectedLimits: &testLimits{requestsPerMinute: 200, origins: []string{"https://example.com"}}},
+		{name: "invalid value rolls back every listener", change: map[string]string{"gateway.requests_per_minute": "0", "gateway.allowed_origins": "https://c.example"},
+			expectedError: true, expectedVersion: 1, expectedLimits: &testLimits{requestsPerMinute: 200, origins: []string{"https://admin.example"}}, expectedRejection: "rate-limit"},
+		{name: "unparsable value is rejected", change: map[string]string{"gateway.requests_per_minute": "many"},
+			expectedError: true, expectedVersion: 1, expectedLimits: &testLimits{requestsPerMinute: 200, origins: []string{"https://admin.example"}}, expectedRejection: "rate-limit"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			limits := &testLimits{}
+			reloader := newTestConfigReloader(t, limits)
+			require.NoError(t, reloader.Apply(configItems(map[string]string{
+				"gateway.requests_per_minute": "200",
+				"gateway.allowed_origins":     "https://admin.example",
+			})))
+
+			// Act
+			err := reloader.Apply(configItems(tt.change))
+
+			// Assert
+			status := reloader.Status()
+			assert.Equal(t, tt.expectedLimits, limits)
+			assert.Equal(t, tt.expectedVersion, status.Version)
+			if !tt.expectedError {
+				require.NoError(t, err)
+				assert.Nil(t, status.LastRejection)
+				return
+			}
+			assert.True(t, domain.IsValidationError(err), "got %v", err)
+			require.NotNil(t, status.LastRejection)
+			assert.Equal(t, tt.expectedRejection, status.LastRejection.Listener)
+			assert.Equal(t, tt.change["gateway.requests_per_minute"], status.LastRejection.Values["gateway.requests_per_minute"])
+			assert.Equal(t, "200", status.Values["gateway.requests_per_minute"], "the active values are kept")
+		})
+	}
+}
+
+func TestConfigValues(t *testing.T) {
+	// Arrange
+	values := ConfigValues{"int": "12", "bool": "false", "duration": "90s", "list": "a, ,b", "bad": "x"}
+
+	// Act
+	integer, intErr := values.Int("int", 1)
+	missing, missingErr := values.Int("missing", 7)
+	boolean, boolErr := values.Bool("bool", true)
+	duration, durationErr := values.Duration("duration", time.Second)
+	_, badIntErr := values.Int("bad", 1)
+	_, badBoolErr := values.Bool("bad", true)
+	_, badDurationErr := values.Duration("bad", time.Second)
+
+	// Assert
+	require.NoError(t, intErr)
+	require.NoError(t, missingErr)
+	require.NoError(t, boolErr)
+	require.NoError(t, durationErr)
+	assert.Equal(t, 12, integer)
+	assert.Equal(t, 7, missing)
+	assert.False(t, boolean)
+	assert.Equal(t, 90*time.Second, duration)
+	assert.Equal(t, []string{"a", "b"}, values.List("list", nil))
+	assert.Equal(t, "fallback", values.String("missing", "fallback"))
+	assert.True(t, domain.IsValidationError(badIntErr))
+	assert.True(t, domain.IsValidationError(badBoolErr))
+	assert.True(t, domain.IsValidationError(badDurationErr))
+}
+
+func TestConfigAdminHandler_GetConfig(t *testing.T) {
+	// Arrange
+	reloader := newTestConfigReloader(t, &testLimits{})
+	require.NoError(t, reloader.Register(ConfigListener{
+		Name:    "slack",
+		Keys:    []string{"notifications.slack.bot_token"},
+		Prepare: func(ConfigValues) (func(), error) { return func() {}, nil },
+	}))
+	require.NoError(t, reloader.Apply(configItems(map[string]string{
+		"gateway.requests_per_minute":   "300",
+		"notifications.slack.bot_token": "xoxb-secret",
+	})))
+
+	router := mux.NewRouter()
+	NewConfigAdminHandler(reloader).RegisterRoutes(router.PathPrefix("/admin/gateway").Subrouter())
+	recorder := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/admin/gateway/config", nil))
+
+	// Assert
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+	var response struct {
+		Data ConfigStatus `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, 1, response.Data.Version)
+	assert.Equal(t, "300", response.Data.Values["gateway.requests_per_minute"])
+	assert.Equal(t, "[redacted]", response.Data.Values["notifications.slack.bot_token"])
+	assert.Equal(t, "7", response.Data.StoreVersions["gateway.requests_per_minute"])
+	assert.Len(t, response.Data.Listeners, 3)
+	assert.NotContains(t, recorder.Body.String(), "xoxb-secret")
+}
@@ -0,0 +1,171 @@
+package dapr
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/gorilla/mux"
+)
+
+// DeadLetterAdminHandler serves the admin API for inspecting, retrying and purging
+// dead letters from pub/sub and the notification channels
+type DeadLetterAdminHandler struct {
+	deadLetters *DeadLetterStore
+}
+
+// deadLetterSelection picks dead letters for a bulk operation, by ID or by filter
+type deadLetterSelection struct {
+	IDs []string `json:"ids"`
+	DeadLetterFilter
+}
+
+// retryDeadLetterRequest is the optional body of a single retry; a payload replaces
+// the stored one before the retry
+type retryDeadLetterRequest struct {
+	Payload json.RawMessage `json:"payload"`
+}
+
+// NewDeadLetterAdminHandler creates the dead letter admin API for a dead letter store
+func NewDeadLetterAdminHandler(deadLetters *DeadLetterStore) *DeadLetterAdminHandler {
+	return &DeadLetterAdminHandler{deadLetters: deadLetters}
+}
+
+// RegisterRoutes registers the dead letter routes on a router mounted at the admin API root
+func (h *DeadLetterAdminHandler) RegisterRoutes(router *mux.Router) {
+	router.HandleFunc("/dead-letters", h.ListDeadLetters).Methods("GET")
+	router.HandleFunc("/dead-letters/groups", h.GroupDeadLetters).Methods("GET")
+	router.HandleFunc("/dead-letters/retry", h.RetryDeadLetters).Methods("POST")
+	router.HandleFunc("/dead-letters/purge", h.PurgeDeadLetters).Methods("POST")
+	router.HandleFunc("/dead-letters/{id}", h.GetDeadLetter).Methods("GET")
+	router.HandleFunc("/dead-letters/{id}", h.DeleteDeadLetter).Methods("DELETE")
+	router.HandleFunc("/dead-letters/{id}/retry", h.RetryDeadLetter).Methods("POST")
+}
+
+// ListDeadLetters lists dead letters, optionally narrowed by source, reason and status
+func (h *DeadLetterAdminHandler) ListDeadLetters(w http.ResponseWriter, r *http.Request) {
+	letters, err := h.deadLetters.List(r.Context(), deadLetterFilter(r))
+	if err != nil {
+		writeAdminError(w, r, err)
+		return
+	}
+
+	writeAdminResponse(w, r, http.StatusOK, map[string]interface{}{
+		"data":  letters,
+		"count": len(letters),
+	})
+}
+
+// GroupDeadLetters counts dead letters by source and failure reason
+func (h *DeadLetterAdminHandler) GroupDeadLetters(w http.ResponseWriter, r *http.Request) {
+	groups, err := h.deadLetters.Groups(r.Context(), deadLetterFilter(r))
+	if err != nil {
+		writeAdminError(w, r, err)
+		return
+	}
+
+	writeAdminResponse(w, r, http.StatusOK, map[string]interface{}{
+		"data":  groups,
+		"count": len(groups),
+	})
+}
+
+// GetDeadLetter returns one dead letter with its payload
+func (h *DeadLetterAdminHandler) GetDeadLetter(w http.ResponseWriter, r *http.Request) {
+	letter, err := h.deadLetters.Get(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		writeAdminError(w, r, err)
+		return
+	}
+
+	writeAdminResponse(w, r, http.StatusOK, map[string]interface{}{"data": letter})
+}
+
+// RetryDeadLetter queues one dead letter for redelivery, with an edited payload when
+// the body carries one
+func (h *DeadLetterAdminHandler) RetryDeadLetter(w http.ResponseWriter, r *http.Request) {
+	var request retryDeadLetterRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			writeAdminError(w, r, domain.NewValidationError("invalid request body"))
+			return
+		}
+	}
+
+	id := mux.Vars(r)["id"]
+	if len(request.Payload) > 0 {
+		letter, err := h.deadLetters.EditAndRetry(r.Context(), id, request.Payload)
+		if err != nil {
+			writeAdminError(w, r, err)
+			return
+		}
+		writeAdminResponse(w, r, http.StatusAccepted, map[string]interface{}{"data": letter})
+		return
+	}
+
+	writeSingleResult(w, r, id, h.deadLetters.Retry(r.Context(), []string{id}))
+}
+
+// RetryDeadLetters queues the selected dead letters for redelivery
+func (h *DeadLetterAdminHandler) RetryDeadLetters(w http.ResponseWriter, r *http.Request) {
+	ids, ok := h.selectDeadLetters(w, r)
+	if !ok {
+		return
+	}
+
+	writeAdminResponse(w, r, http.StatusAccepted, map[string]interface{}{"data": h.deadLetters.Retry(r.Context(), ids)})
+}
+
+// PurgeDeadLetters deletes the selected dead letters without redelivering them
+func (h *DeadLetterAdminHandler) PurgeDeadLetters(w http.ResponseWriter, r *http.Request) {
+	ids, ok := h.selectDeadLetters(w, r)
+	if !ok {
+		return
+	}
+
+	writeAdminResponse(w, r, http.StatusOK, map[string]interface{}{"data": h.deadLetters.Purge(r.Context(), ids)})
+}
+
+// DeleteDeadLetter purges one dead letter
+func (h *DeadLetterAdminHandler) DeleteDeadLetter(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+	writeSingleResult(w, r, id, h.deadLetters.Purge(r.Context(), []string{id}))
+}
+
+func (h *DeadLetterAdminHandler) selectDeadLetters(w http.ResponseWriter, r *http.Request) ([]string, bool) {
+	var selection deadLetterSelection
+	if err := json.NewDecoder(r.Body).Decode(&selection); err != nil {
+		writeAdminError(w, r, domain.NewValidationError("invalid request body"))
+		return nil, false
+	}
+	if len(selection.IDs) > 0 {
+		return selection.IDs, true
+	}
+
+	ids, err := h.deadLetters.ResolveIDs(r.Context(), selection.DeadLetterFilter)
+	if err != nil {
+		writeAdminError(w, r, err)
+		return nil, false
+	}
+	return ids, true
+}
+
+// writeSingleResult answers an operation on one dead letter with the error that
+// stopped it, if any
+func writeSingleResult(w http.ResponseWriter, r *http.Request, id string, result *DeadLetterBulkResult) {
+	if err := result.Err(id); err != nil {
+		writeAdminError(w, r, err)
+		return
+	}
+
+	writeAdminResponse(w, r, http.StatusAccepted, map[string]interface{}{"data": result})
+}
+
+func deadLetterFilter(r *http.Request) DeadLetterFilter {
+	query := r.URL.Query()
+	return DeadLetterFilter{
+		Source: DeadLetterSource(query.Get("source")),
+		Reason: query.Get("reason"),
+		Status: DeadLetterStatus(query.Get("status")),
+	}
+}
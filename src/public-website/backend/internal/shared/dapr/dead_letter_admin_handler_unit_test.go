@@ -0,0 +1,99 @@
+package dapr
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeadLetterAdminHandler(t *testing.T) {
+	tests := []struct {
+		name              string
+		method            string
+		path              string
+		body              string
+		expectedStatus    int
+		expectedCode      string
+		expectedCount     int
+		expectedRemaining int
+	}{
+		{name: "list by source", method: http.MethodGet, path: "/admin/api/v1/dead-letters?source=email", expectedStatus: http.StatusOK, expectedCount: 2, expectedRemaining: 3},
+		{name: "group by reason", method: http.MethodGet, path: "/admin/api/v1/dead-letters/groups", expectedStatus: http.StatusOK, expectedCount: 2, expectedRemaining: 3},
+		{name: "inspect payload", method: http.MethodGet, path: "/admin/api/v1/dead-letters/pubsub-1", expectedStatus: http.StatusOK, expectedRemaining: 3},
+		{name: "inspect missing letter", method: http.MethodGet, path: "/admin/api/v1/dead-letters/missing", expectedStatus: http.StatusNotFound, expectedCode: "NOT_FOUND"},
+		{name: "retry one", method: http.MethodPost, path: "/admin/api/v1/dead-letters/email-1/retry", expectedStatus: http.StatusAccepted, expectedRemaining: 3},
+		{name: "edit and retry", method: http.MethodPost, path: "/admin/api/v1/dead-letters/email-1/retry",
+			body: `{"payload":{"subscriber_id":"subscriber-9"}}`, expectedStatus: http.StatusAccepted, expectedRemaining: 3},
+		{name: "reject invalid edit", method: http.MethodPost, path: "/admin/api/v1/dead-letters/email-1/retry",
+			body: `{"payload":`, expectedStatus: http.StatusBadRequest, expectedCode: "VALIDATION_ERROR"},
+		{name: "bulk retry by reason", method: http.MethodPost, path: "/admin/api/v1/dead-letters/retry",
+			body: `{"reason":"validation_failed"}`, expectedStatus: http.StatusAccepted, expectedCount: 2, expectedRemaining: 3},
+		{name: "reject unselected bulk retry", method: http.MethodPost, path: "/admin/api/v1/dead-letters/retry",
+			body: `{}`, expectedStatus: http.StatusBadRequest, expectedCode: "VALIDATION_ERROR"},
+		{name: "bulk purge by ID", method: http.MethodPost, path: "/admin/api/v1/dead-letters/purge",
+			body: `{"ids":["email-1","pubsub-1"]}`, expectedStatus: http.StatusOK, expectedCount: 2, expectedRemaining: 1},
+		{name: "purge one", method: http.MethodDelete, path: "/admin/api/v1/dead-letters/email-2", expectedStatus: http.StatusAccepted, expectedCount: 1, expectedRemaining: 2},
+		{name: "purge missing letter", method: http.MethodDelete, path: "/admin/api/v1/dead-letters/missing", expectedStatus: http.StatusNotFound, expectedCode: "NOT_FOUND"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			deadLetters := newTestDeadLetterStore()
+			seedDeadLetters(t, deadLetters)
+
+			router := mux.NewRouter()
+			NewDeadLetterAdminHandler(deadLetters).RegisterRoutes(router.PathPrefix("/admin/api/v1").Subrouter())
+
+			request := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			recorder := httptest.NewRecorder()
+
+			// Act
+			router.ServeHTTP(recorder, request)
+
+			// Assert
+			require.Equal(t, tt.expectedStatus, recorder.Code, recorder.Body.String())
+			var response struct {
+				Data  json.RawMessage `json:"data"`
+				Count int             `json:"count"`
+				Error struct {
+					Code string `json:"code"`
+				} `json:"error"`
+			}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+			if tt.expectedCode != "" {
+				assert.Equal(t, tt.expectedCode, response.Error.Code)
+				return
+			}
+
+			switch {
+			case tt.method == http.MethodGet && strings.HasSuffix(tt.path, "pubsub-1"):
+				var letter DeadLetter
+				require.NoError(t, json.Unmarshal(response.Data, &letter))
+				assert.JSONEq(t, `{"type":"audit.event"}`, string(letter.Payload))
+			case tt.method == http.MethodGet:
+				assert.Equal(t, tt.expectedCount, response.Count)
+			case strings.HasSuffix(tt.path, "/dead-letters/retry") || strings.HasSuffix(tt.path, "/purge") || tt.method == http.MethodDelete:
+				var result DeadLetterBulkResult
+				require.NoError(t, json.Unmarshal(response.Data, &result))
+				assert.Len(t, result.Affected, tt.expectedCount)
+			default:
+				letter, err := deadLetters.Get(context.Background(), "email-1")
+				require.NoError(t, err)
+				assert.Equal(t, DeadLetterStatusRetrying, letter.Status)
+				assert.Equal(t, tt.body != "", letter.Edited)
+			}
+
+			remaining, err := deadLetters.List(context.Background(), DeadLetterFilter{})
+			require.NoError(t, err)
+			assert.Len(t, remaining, tt.expectedRemaining)
+		})
+	}
+}
@@ -0,0 +1,490 @@
+package dapr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/google/uuid"
+)
+
+const (
+	deadLetterDomain      = "dlq"
+	deadLetterEntityType  = "message"
+	deadLetterSourceIndex = "source"
+	deadLetterReasonIndex = "reason"
+	deadLetterStatusIndex = "status"
+
+	defaultDeadLetterRetryInterval = 10 * time.Second
+)
+
+// DeadLetterSource names the component a dead letter failed in. Each source redelivers
+// its own letters, since only it knows how to process them.
+type DeadLetterSource string
+
+const (
+	DeadLetterSourcePubSub DeadLetterSource = "pubsub"
+	DeadLetterSourceEmail  DeadLetterSource = "email"
+	DeadLetterSourceSMS    DeadLetterSource = "sms"
+	DeadLetterSourceSlack  DeadLetterSource = "slack"
+)
+
+// DeadLetterStatus tracks whether a dead letter waits for an operator or for redelivery
+type DeadLetterStatus string
+
+const (
+	DeadLetterStatusDead     DeadLetterStatus = "dead"
+	DeadLetterStatusRetrying DeadLetterStatus = "retrying"
+)
+
+// Failure reasons recorded for dead letters, by the kind of error that caused them
+const (
+	DeadLetterReasonValidation  = "validation_failed"
+	DeadLetterReasonTimeout     = "timeout"
+	DeadLetterReasonDependency  = "dependency_unavailable"
+	DeadLetterReasonUnprocessed = "processing_failed"
+)
+
+// DeadLetter is a message that could not be delivered or processed, kept until an
+// operator retries or purges it
+type DeadLetter struct {
+	DeadLetterID     string           `json:"dead_letter_id"`
+	Source           DeadLetterSource `json:"source"`
+	Destination      string           `json:"destination"`
+	Reason           string           `json:"reason"`
+	Error            string           `json:"error"`
+	Payload          json.RawMessage  `json:"payload"`
+	CorrelationID    string           `json:"correlation_id,omitempty"`
+	Status           DeadLetterStatus `json:"status"`
+	Attempts         int              `json:"attempts"`
+	Edited           bool             `json:"edited"`
+	FirstFailedAt    time.Time        `json:"first_failed_at"`
+	LastFailedAt     time.Time        `json:"last_failed_at"`
+	RetryRequestedBy string           `json:"retry_requested_by,omitempty"`
+	RetryRequestedAt *time.Time       `json:"retry_requested_at,omitempty"`
+}
+
+// DeadLetterFilter selects dead letters; empty fields match everything
+type DeadLetterFilter struct {
+	Source DeadLetterSource `json:"source,omitempty"`
+	Reason string           `json:"reason,omitempty"`
+	Status DeadLetterStatus `json:"status,omitempty"`
+}
+
+// DeadLetterGroup counts the dead letters that failed in one source for one reason
+type DeadLetterGroup struct {
+	Source         DeadLetterSource `json:"source"`
+	Reason         string           `json:"reason"`
+	Count          int              `json:"count"`
+	OldestFailedAt time.Time        `json:"oldest_failed_at"`
+	NewestFailedAt time.Time        `json:"newest_failed_at"`
+}
+
+// DeadLetterBulkResult reports which dead letters a bulk operation changed and why
+// the others were left alone
+type DeadLetterBulkResult struct {
+	Affected []string          `json:"affected"`
+	Failed   map[string]string `json:"failed,omitempty"`
+
+	errs map[string]error
+}
+
+// Err returns the error that stopped the operation on one dead letter, if any
+func (r *DeadLetterBulkResult) Err(deadLetterID string) error {
+	return r.errs[deadLetterID]
+}
+
+// DeadLetterRedeliverer processes a dead letter again; an error keeps it dead
+type DeadLetterRedeliverer func(ctx context.Context, letter *DeadLetter) error
+
+// DeadLetterStore keeps the dead letters of every source in the state store. Operators
+// request retries through the admin API and each source's RunRetries loop redelivers
+// them; every operator action is audited through the outbox.
+type DeadLetterStore struct {
+	stateStore    *StateStore
+	pubsub        *PubSub
+	retryInterval time.Duration
+	now           func() time.Time
+}
+
+// DeadLetterIndexes keeps dead letters findable by source, failure reason and status
+func DeadLetterIndexes() *IndexedEntityType {
+	return &IndexedEntityType{
+		Domain:     deadLetterDomain,
+		EntityType: deadLetterEntityType,
+		Indexes: []IndexDefinition{
+			{Name: deadLetterSourceIndex, Extract: func(entity interface{}) []string { return []string{string(entity.(*DeadLetter).Source)} }},
+			{Name: deadLetterReasonIndex, Extract: func(entity interface{}) []string { return []string{entity.(*DeadLetter).Reason} }},
+			{Name: deadLetterStatusIndex, Extract: func(entity interface{}) []string { return []string{string(entity.(*DeadLetter).Status)} }},
+		},
+		NewEntity: func() interface{} { return &DeadLetter{} },
+		EntityID:  func(entity interface{}) string { return entity.(*DeadLetter).DeadLetterID },
+	}
+}
+
+// NewDeadLetterStore creates a dead letter store in stateStore whose operator actions
+// are audited on the audit topic of pubsub
+func NewDeadLetterStore(stateStore *StateStore, pubsub *PubSub) *DeadLetterStore {
+	stateStore.MustRegisterIndexes(DeadLetterIndexes())
+	stateStore.MustRegisterIndexes(OutboxMessageIndexes())
+
+	return &DeadLetterStore{
+		stateStore:    stateStore,
+		pubsub:        pubsub,
+		retryInterval: parseDurationEnv("DLQ_RETRY_INTERVAL", defaultDeadLetterRetryInterval),
+		now:           time.Now,
+	}
+}
+
+// DeadLetterReason classifies a failure so that dead letters group by cause rather
+// than by error message
+func DeadLetterReason(err error) string {
+	switch {
+	case domain.IsValidationError(err):
+		return DeadLetterReasonValidation
+	case domain.IsTimeoutError(err):
+		return DeadLetterReasonTimeout
+	case domain.IsDependencyError(err):
+		return DeadLetterReasonDependency
+	default:
+		return DeadLetterReasonUnprocessed
+	}
+}
+
+// Record stores a failed message. A message that already has a dead letter with the
+// same ID counts as another failed attempt of it and goes back to waiting for an operator.
+func (s *DeadLetterStore) Record(ctx context.Context, letter *DeadLetter) error {
+	if letter.Source == "" || letter.Destination == "" {
+		return domain.NewValidationError("dead letters require a source and destination")
+	}
+	if letter.DeadLetterID == "" {
+		letter.DeadLetterID = uuid.New().String()
+	}
+	if letter.Reason == "" {
+		letter.Reason = DeadLetterReasonUnprocessed
+	}
+
+	now := s.now().UTC()
+	existing, etag, err := s.load(ctx, letter.DeadLetterID)
+	if err != nil && !domain.IsNotFoundError(err) {
+		return err
+	}
+
+	if existing != nil {
+		existing.Attempts++
+		existing.Reason = letter.Reason
+		existing.Error = letter.Error
+		existing.Status = DeadLetterStatusDead
+		existing.LastFailedAt = now
+		letter = existing
+	} else {
+		letter.Status = DeadLetterStatusDead
+		letter.Attempts = 1
+		letter.FirstFailedAt = now
+		letter.LastFailedAt = now
+	}
+
+	return s.stateStore.SaveIndexedWithETag(ctx, deadLetterDomain, deadLetterEntityType, letter.DeadLetterID, letter, etag)
+}
+
+// Get returns one dead letter with its payload
+func (s *DeadLetterStore) Get(ctx context.Context, deadLetterID string) (*DeadLetter, error) {
+	letter, _, err := s.load(ctx, deadLetterID)
+	return letter, err
+}
+
+// List returns the dead letters matching filter, most recently failed first
+func (s *DeadLetterStore) List(ctx context.Context, filter DeadLetterFilter) ([]*DeadLetter, error) {
+	ids, err := s.matchingIDs(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	letters := make([]*DeadLetter, 0, len(ids))
+	for _, id := range ids {
+		letter, _, err := s.load(ctx, id)
+		if err != nil {
+			if domain.IsNotFoundError(err) {
+				continue
+			}
+			return nil, err
+		}
+		letters = append(letters, letter)
+	}
+
+	sort.SliceStable(letters, func(i, j int) bool {
+		return letters[i].LastFailedAt.After(letters[j].LastFailedAt)
+	})
+	return letters, nil
+}
+
+// Groups counts the dead letters matching filter by source and failure reason
+func (s *DeadLetterStore) Groups(ctx context.Context, filter DeadLetterFilter) ([]*DeadLetterGroup, error) {
+	letters, err := s.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make(map[string]*DeadLetterGroup)
+	for _, letter := range letters {
+		key := string(letter.Source) + "/" + letter.Reason
+		group, ok := groups[key]
+		if !ok {
+			group = &DeadLetterGroup{Source: letter.Source, Reason: letter.Reason, OldestFailedAt: letter.LastFailedAt, NewestFailedAt: letter.LastFailedAt}
+			groups[key] = group
+		}
+		group.Count++
+		if letter.LastFailedAt.Before(group.OldestFailedAt) {
+			group.OldestFailedAt = letter.LastFailedAt
+		}
+		if letter.LastFailedAt.After(group.NewestFailedAt) {
+			group.NewestFailedAt = letter.LastFailedAt
+		}
+	}
+
+	result := make([]*DeadLetterGroup, 0, len(groups))
+	for _, group := range groups {
+		result = append(result, group)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		return string(result[i].Source)+result[i].Reason < string(result[j].Source)+result[j].Reason
+	})
+	return result, nil
+}
+
+// Retry queues dead letters for redelivery by their source. Letters already queued
+// are reported as failed rather than queued twice.
+func (s *DeadLetterStore) Retry(ctx context.Context, deadLetterIDs []string) *DeadLetterBulkResult {
+	return s.bulk(ctx, deadLetterIDs, func(letter *DeadLetter, etag string) error {
+		if letter.Status == DeadLetterStatusRetrying {
+			return domain.NewConflictError(fmt.Sprintf("dead letter %s is already queued for retry", letter.DeadLetterID))
+		}
+		return s.requestRetry(ctx, letter, letter.Payload, etag)
+	})
+}
+
+// EditAndRetry replaces the payload of a dead letter and queues it for redelivery
+func (s *DeadLetterStore) EditAndRetry(ctx context.Context, deadLetterID string, payload json.RawMessage) (*DeadLetter, error) {
+	if !json.Valid(payload) {
+		return nil, domain.NewValidationError("dead letter payload must be valid JSON")
+	}
+
+	letter, etag, err := s.load(ctx, deadLetterID)
+	if err != nil {
+		return nil, err
+	}
+	if letter.Status == DeadLetterStatusRetrying {
+		return nil, domain.NewConflictError(fmt.Sprintf("dead letter %s is already queued for retry", deadLetterID))
+	}
+
+	if err := s.requestRetry(ctx, letter, payload, etag); err != nil {
+		return nil, err
+	}
+	return letter, nil
+}
+
+// Purge deletes dead letters without redelivering them
+func (s *DeadLetterStore) Purge(ctx context.Context, deadLetterIDs []string) *DeadLetterBulkResult {
+	return s.bulk(ctx, deadLetterIDs, func(letter *DeadLetter, etag string) error {
+		messages, err := s.auditMessages(ctx, letter, domain.AuditEventDelete, letter, nil)
+		if err != nil {
+			return err
+		}
+		return s.stateStore.DeleteIndexedWithOutbox(ctx, deadLetterDomain, deadLetterEntityType, letter.DeadLetterID, messages...)
+	})
+}
+
+// ResolveIDs returns the IDs of the dead letters matching filter, for bulk operations
+// selected by filter rather than by ID
+func (s *DeadLetterStore) ResolveIDs(ctx context.Context, filter DeadLetterFilter) ([]string, error) {
+	if filter.Source == "" && filter.Reason == "" {
+		return nil, domain.NewValidationError("bulk dead letter operations require IDs, a source or a reason")
+	}
+	return s.matchingIDs(ctx, filter)
+}
+
+// RunRetries redelivers the dead letters of source that operators queued for retry,
+// until ctx is cancelled
+func (s *DeadLetterStore) RunRetries(ctx context.Context, source DeadLetterSource, redeliver DeadLetterRedeliverer) {
+	ticker := time.NewTicker(s.retryInterval)
+	defer ticker.Stop()
+
+	for {
+		if redelivered, err := s.ProcessRetries(ctx, source, redeliver); err != nil {
+			log.Printf("Dead letter retry run for %s failed: %v", source, err)
+		} else if redelivered > 0 {
+			log.Printf("Redelivered %d %s dead letters", redelivered, source)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessRetries redelivers the queued dead letters of source once and returns how
+// many succeeded. Each letter is claimed before redelivery, so concurrent runs do not
+// process it twice; a successful letter is removed and a failed one is dead again.
+func (s *DeadLetterStore) ProcessRetries(ctx context.Context, source DeadLetterSource, redeliver DeadLetterRedeliverer) (int, error) {
+	ids, err := s.stateStore.LookupIndex(ctx, deadLetterDomain, deadLetterEntityType,
+		IndexCondition{Index: deadLetterSourceIndex, Value: string(source)},
+		IndexCondition{Index: deadLetterStatusIndex, Value: string(DeadLetterStatusRetrying)})
+	if err != nil {
+		return 0, err
+	}
+
+	redelivered := 0
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			return redelivered, ctx.Err()
+		}
+
+		letter, err := s.claim(ctx, id)
+		if err != nil {
+			log.Printf("Failed to claim dead letter %s: %v", id, err)
+			continue
+		}
+		if letter == nil {
+			continue
+		}
+
+		if err := redeliver(ctx, letter); err != nil {
+			letter.Reason = DeadLetterReason(err)
+			letter.Error = err.Error()
+			letter.LastFailedAt = s.now().UTC()
+			if saveErr := s.stateStore.SaveIndexed(ctx, deadLetterDomain, deadLetterEntityType, id, letter); saveErr != nil {
+				log.Printf("Failed to record failed redelivery of dead letter %s: %v", id, saveErr)
+			}
+			continue
+		}
+
+		if err := s.stateStore.DeleteIndexed(ctx, deadLetterDomain, deadLetterEntityType, id); err != nil {
+			log.Printf("Dead letter %s was redelivered but could not be removed: %v", id, err)
+		}
+		redelivered++
+	}
+
+	return redelivered, nil
+}
+
+// claim moves a queued dead letter back to dead before it is redelivered, so that other
+// runs leave it alone. It returns nil when the letter is no longer queued.
+func (s *DeadLetterStore) claim(ctx context.Context, deadLetterID string) (*DeadLetter, error) {
+	letter, etag, err := s.load(ctx, deadLetterID)
+	if err != nil {
+		if domain.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if letter.Status != DeadLetterStatusRetrying {
+		return nil, nil
+	}
+
+	letter.Status = DeadLetterStatusDead
+	letter.Attempts++
+	if err := s.stateStore.SaveIndexedWithETag(ctx, deadLetterDomain, deadLetterEntityType, deadLetterID, letter, etag); err != nil {
+		if domain.IsConflictError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return letter, nil
+}
+
+func (s *DeadLetterStore) requestRetry(ctx context.Context, letter *DeadLetter, payload json.RawMessage, etag string) error {
+	before := *letter
+	now := s.now().UTC()
+
+	letter.Status = DeadLetterStatusRetrying
+	letter.RetryRequestedBy = domain.GetUserID(ctx)
+	letter.RetryRequestedAt = &now
+	if string(payload) != string(letter.Payload) {
+		letter.Payload = payload
+		letter.Edited = true
+	}
+
+	messages, err := s.auditMessages(ctx, letter, domain.AuditEventUpdate, &before, letter)
+	if err != nil {
+		return err
+	}
+	return s.stateStore.SaveIndexedWithOutbox(ctx, deadLetterDomain, deadLetterEntityType, letter.DeadLetterID, letter, etag, messages...)
+}
+
+// bulk applies an operation to each dead letter, collecting failures instead of stopping
+func (s *DeadLetterStore) bulk(ctx context.Context, deadLetterIDs []string, apply func(letter *DeadLetter, etag string) error) *DeadLetterBulkResult {
+	result := &DeadLetterBulkResult{Affected: []string{}, Failed: make(map[string]string), errs: make(map[string]error)}
+	for _, id := range deadLetterIDs {
+		letter, etag, err := s.load(ctx, id)
+		if err == nil {
+			err = apply(letter, etag)
+		}
+		if err != nil {
+			result.Failed[id] = err.Error()
+			result.errs[id] = err
+			continue
+		}
+		result.Affected = append(result.Affected, id)
+	}
+	return result
+}
+
+func (s *DeadLetterStore) matchingIDs(ctx context.Context, filter DeadLetterFilter) ([]string, error) {
+	var conditions []IndexCondition
+	if filter.Source != "" {
+		conditions = append(conditions, IndexCondition{Index: deadLetterSourceIndex, Value: string(filter.Source)})
+	}
+	if filter.Reason != "" {
+		conditions = append(conditions, IndexCondition{Index: deadLetterReasonIndex, Value: filter.Reason})
+	}
+	if filter.Status != "" {
+		conditions = append(conditions, IndexCondition{Index: deadLetterStatusIndex, Value: string(filter.Status)})
+	}
+
+	if len(conditions) == 0 {
+		return s.stateStore.LookupIndexAny(ctx, deadLetterDomain, deadLetterEntityType, deadLetterStatusIndex,
+			[]string{string(DeadLetterStatusDead), string(DeadLetterStatusRetrying)})
+	}
+	return s.stateStore.LookupIndex(ctx, deadLetterDomain, deadLetterEntityType, conditions...)
+}
+
+// auditMessages records an operator action on a dead letter for the audit trail
+func (s *DeadLetterStore) auditMessages(ctx context.Context, letter *DeadLetter, operation domain.AuditEventType, before, after interface{}) ([]*OutboxMessage, error) {
+	message, err := s.pubsub.AuditEventMessage(&AuditEvent{
+		AuditID:       uuid.New().String(),
+		EntityType:    string(domain.EntityTypeDeadLetter),
+		EntityID:      letter.DeadLetterID,
+		OperationType: string(operation),
+		AuditTime:     s.now().UTC(),
+		UserID:        domain.GetUserID(ctx),
+		CorrelationID: domain.GetCorrelationID(ctx),
+		TraceID:       domain.GetTraceID(ctx),
+		DataSnapshot:  map[string]interface{}{"before": before, "after": after},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare audit event for dead letter %s: %w", letter.DeadLetterID, err)
+	}
+
+	return []*OutboxMessage{NewOutboxMessage(ctx, message.Topic, message)}, nil
+}
+
+func (s *DeadLetterStore) load(ctx context.Context, deadLetterID string) (*DeadLetter, string, error) {
+	var letter DeadLetter
+	found, etag, err := s.stateStore.GetWithETag(ctx, s.stateStore.CreateKey(deadLetterDomain, deadLetterEntityType, deadLetterID), &letter)
+	if err != nil {
+		return nil, "", err
+	}
+	if !found {
+		return nil, "", domain.NewNotFoundError("dead letter", deadLetterID)
+	}
+	return &letter, etag, nil
+}
@@ -0,0 +1,244 @@
+package dapr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestDeadLetterStore() *DeadLetterStore {
+	return NewDeadLetterStore(NewStateStoreWithBackend(NewMemoryStateBackend()), NewPubSub(&Client{appID: "content-api"}))
+}
+
+// seedDeadLetters records two email validation failures and one pub/sub timeout
+func seedDeadLetters(t *testing.T, deadLetters *DeadLetterStore) {
+	ctx := context.Background()
+	start := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	letters := []*DeadLetter{
+		{DeadLetterID: "email-1", Source: DeadLetterSourceEmail, Destination: "email-queue", Reason: DeadLetterReasonValidation,
+			Error: "invalid recipient", Payload: json.RawMessage(`{"subscriber_id":"subscriber-1"}`)},
+		{DeadLetterID: "email-2", Source: DeadLetterSourceEmail, Destination: "email-queue", Reason: DeadLetterReasonValidation,
+			Error: "invalid recipient", Payload: json.RawMessage(`{"subscriber_id":"subscriber-2"}`)},
+		{DeadLetterID: "pubsub-1", Source: DeadLetterSourcePubSub, Destination: "audit-events", Reason: DeadLetterReasonTimeout,
+			Error: "publish timed out", Payload: json.RawMessage(`{"type":"audit.event"}`)},
+	}
+	for i, letter := range letters {
+		failedAt := start.Add(time.Duration(i) * time.Hour)
+		deadLetters.now = func() time.Time { return failedAt }
+		require.NoError(t, deadLetters.Record(ctx, letter))
+	}
+	deadLetters.now = func() time.Time { return start.Add(24 * time.Hour) }
+}
+
+func deadLetterIDs(letters []*DeadLetter) []string {
+	ids := make([]string, 0, len(letters))
+	for _, letter := range letters {
+		ids = append(ids, letter.DeadLetterID)
+	}
+	return ids
+}
+
+func TestDeadLetterStore_List(t *testing.T) {
+	tests := []struct {
+		name        string
+		filter      DeadLetterFilter
+		expectedIDs []string
+	}{
+		{name: "all, most recent first", filter: DeadLetterFilter{}, expectedIDs: []string{"pubsub-1", "email-2", "email-1"}},
+		{name: "by source", filter: DeadLetterFilter{Source: DeadLetterSourceEmail}, expectedIDs: []string{"email-2", "email-1"}},
+		{name: "by reason", filter: DeadLetterFilter{Reason: DeadLetterReasonTimeout}, expectedIDs: []string{"pubsub-1"}},
+		{name: "by status", filter: DeadLetterFilter{Status: DeadLetterStatusRetrying}, expectedIDs: []string{}},
+		{name: "no match", filter: DeadLetterFilter{Source: DeadLetterSourceSlack}, expectedIDs: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			deadLetters := newTestDeadLetterStore()
+			seedDeadLetters(t, deadLetters)
+
+			// Act
+			letters, err := deadLetters.List(context.Background(), tt.filter)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedIDs, deadLetterIDs(letters))
+		})
+	}
+}
+
+func TestDeadLetterStore_RecordCountsRepeatedFailures(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	deadLetters := newTestDeadLetterStore()
+	seedDeadLetters(t, deadLetters)
+
+	// Act
+	err := deadLetters.Record(ctx, &DeadLetter{DeadLetterID: "email-1", Source: DeadLetterSourceEmail, Destination: "email-queue",
+		Reason: DeadLetterReasonDependency, Error: "provider unavailable", Payload: json.RawMessage(`{}`)})
+
+	// Assert
+	require.NoError(t, err)
+	letter, getErr := deadLetters.Get(ctx, "email-1")
+	require.NoError(t, getErr)
+	assert.Equal(t, 2, letter.Attempts)
+	assert.Equal(t, DeadLetterReasonDependency, letter.Reason)
+	assert.JSONEq(t, `{"subscriber_id":"subscriber-1"}`, string(letter.Payload), "the original payload is kept")
+	assert.True(t, letter.LastFailedAt.After(letter.FirstFailedAt))
+	assert.True(t, domain.IsValidationError(deadLetters.Record(ctx, &DeadLetter{Source: DeadLetterSourceEmail})))
+}
+
+func TestDeadLetterStore_Groups(t *testing.T) {
+	// Arrange
+	deadLetters := newTestDeadLetterStore()
+	seedDeadLetters(t, deadLetters)
+
+	// Act
+	groups, err := deadLetters.Groups(context.Background(), DeadLetterFilter{})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+	assert.Equal(t, DeadLetterSourceEmail, groups[0].Source)
+	assert.Equal(t, DeadLetterReasonValidation, groups[0].Reason)
+	assert.Equal(t, 2, groups[0].Count)
+	assert.True(t, groups[0].OldestFailedAt.Before(groups[0].NewestFailedAt))
+	assert.Equal(t, DeadLetterReasonTimeout, groups[1].Reason)
+	assert.Equal(t, 1, groups[1].Count)
+}
+
+func TestDeadLetterStore_ProcessRetries(t *testing.T) {
+	tests := []struct {
+		name              string
+		redeliverErr      error
+		expectedRemaining []string
+		expectedReason    string
+	}{
+		{name: "successful redelivery removes the letters", expectedRemaining: []string{"pubsub-1"}},
+		{name: "failed redelivery keeps the letters dead", redeliverErr: domain.NewDependencyError("email provider", errors.New("unavailable")),
+			expectedRemaining: []string{"email-1", "email-2", "pubsub-1"}, expectedReason: DeadLetterReasonDependency},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+			deadLetters := newTestDeadLetterStore()
+			seedDeadLetters(t, deadLetters)
+			retry := deadLetters.Retry(ctx, []string{"email-1", "email-2", "missing"})
+			require.Equal(t, []string{"email-1", "email-2"}, retry.Affected)
+			require.True(t, domain.IsNotFoundError(retry.Err("missing")))
+
+			var redelivered []string
+			redeliver := func(ctx context.Context, letter *DeadLetter) error {
+				redelivered = append(redelivered, letter.DeadLetterID)
+				return tt.redeliverErr
+			}
+
+			// Act
+			pubsubCount, pubsubErr := deadLetters.ProcessRetries(ctx, DeadLetterSourcePubSub, redeliver)
+			emailCount, emailErr := deadLetters.ProcessRetries(ctx, DeadLetterSourceEmail, redeliver)
+
+			// Assert
+			require.NoError(t, pubsubErr)
+			require.NoError(t, emailErr)
+			assert.Zero(t, pubsubCount, "only letters queued for retry are redelivered")
+			assert.ElementsMatch(t, []string{"email-1", "email-2"}, redelivered)
+
+			remaining, err := deadLetters.List(ctx, DeadLetterFilter{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedRemaining, deadLetterIDs(remaining))
+			if tt.redeliverErr == nil {
+				assert.Equal(t, 2, emailCount)
+				return
+			}
+			assert.Zero(t, emailCount)
+			letter, err := deadLetters.Get(ctx, "email-1")
+			require.NoError(t, err)
+			assert.Equal(t, DeadLetterStatusDead, letter.Status)
+			assert.Equal(t, tt.expectedReason, letter.Reason)
+			assert.Equal(t, 2, letter.Attempts)
+		})
+	}
+}
+
+func TestDeadLetterStore_RetryRejectsLettersAlreadyQueued(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	deadLetters := newTestDeadLetterStore()
+	seedDeadLetters(t, deadLetters)
+	require.Empty(t, deadLetters.Retry(ctx, []string{"email-1"}).Failed)
+
+	// Act
+	result := deadLetters.Retry(ctx, []string{"email-1"})
+
+	// Assert
+	assert.Empty(t, result.Affected)
+	assert.True(t, domain.IsConflictError(result.Err("email-1")))
+}
+
+func TestDeadLetterStore_EditAndRetry(t *testing.T) {
+	tests := []struct {
+		name          string
+		payload       string
+		expectedError func(error) bool
+	}{
+		{name: "replace payload and queue", payload: `{"subscriber_id":"subscriber-1","recipient":"fixed@example.com"}`},
+		{name: "reject invalid JSON", payload: `{"subscriber_id":`, expectedError: domain.IsValidationError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := domain.WithUserID(context.Background(), "operator-1")
+			deadLetters := newTestDeadLetterStore()
+			seedDeadLetters(t, deadLetters)
+
+			// Act
+			letter, err := deadLetters.EditAndRetry(ctx, "email-1", json.RawMessage(tt.payload))
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.True(t, tt.expectedError(err))
+				return
+			}
+			require.NoError(t, err)
+			stored, getErr := deadLetters.Get(ctx, "email-1")
+			require.NoError(t, getErr)
+			assert.Equal(t, letter, stored)
+			assert.Equal(t, DeadLetterStatusRetrying, stored.Status)
+			assert.True(t, stored.Edited)
+			assert.Equal(t, "operator-1", stored.RetryRequestedBy)
+			assert.JSONEq(t, tt.payload, string(stored.Payload))
+			assert.Len(t, pendingOutboxIDs(t, deadLetters.stateStore), 1, "the retry is audited")
+		})
+	}
+}
+
+func TestDeadLetterStore_Purge(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	deadLetters := newTestDeadLetterStore()
+	seedDeadLetters(t, deadLetters)
+	ids, err := deadLetters.ResolveIDs(ctx, DeadLetterFilter{Source: DeadLetterSourceEmail})
+	require.NoError(t, err)
+
+	// Act
+	result := deadLetters.Purge(ctx, ids)
+
+	// Assert
+	assert.ElementsMatch(t, []string{"email-1", "email-2"}, result.Affected)
+	remaining, listErr := deadLetters.List(ctx, DeadLetterFilter{})
+	require.NoError(t, listErr)
+	assert.Equal(t, []string{"pubsub-1"}, deadLetterIDs(remaining))
+	assert.Len(t, pendingOutboxIDs(t, deadLetters.stateStore), 2, "each purge is audited")
+
+	_, resolveErr := deadLetters.ResolveIDs(ctx, DeadLetterFilter{Status: DeadLetterStatusDead})
+	assert.True(t, domain.IsValidationError(resolveErr), "bulk operations cannot select every letter")
+}
@@ -0,0 +1,221 @@
+package dapr
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/dapr/go-sdk/client"
+)
+
+const (
+	deduplicationDomain = "dedup"
+
+	// defaultDeduplicationRetention is how long a processed event is remembered; a
+	// redelivery or replay arriving later is handled again
+	defaultDeduplicationRetention = 24 * time.Hour
+	// defaultDeduplicationLease bounds how long one delivery may hold an event before
+	// another delivery is allowed to take it over
+	defaultDeduplicationLease = 5 * time.Minute
+)
+
+// DeliveryStatus records how far a consumer got with an event
+type DeliveryStatus string
+
+const (
+	DeliveryStatusProcessing DeliveryStatus = "processing"
+	DeliveryStatusProcessed  DeliveryStatus = "processed"
+)
+
+// ConsumedEvent is the deduplication record a consumer keeps for one event
+type ConsumedEvent struct {
+	Consumer       string         `json:"consumer"`
+	EventKey       string         `json:"event_key"`
+	Status         DeliveryStatus `json:"status"`
+	ClaimedAt      time.Time      `json:"claimed_at"`
+	LeaseExpiresAt time.Time      `json:"lease_expires_at"`
+	ProcessedAt    *time.Time     `json:"processed_at,omitempty"`
+}
+
+// DeduplicationMetrics counts what the deduplicator did with the deliveries it saw
+type DeduplicationMetrics struct {
+	Processed         int64            `json:"processed"`
+	DuplicatesDropped int64            `json:"duplicates_dropped"`
+	Deferred          int64            `json:"deferred"`
+	DroppedByConsumer map[string]int64 `json:"dropped_by_consumer"`
+}
+
+// EventDeduplicator gives at-least-once deliveries an exactly-once effect. Each
+// consumer claims an event before handling it and marks it processed afterwards, so
+// a retried, republished or replayed copy is acknowledged without being handled
+// again. Records live in the state store and expire on their own.
+type EventDeduplicator struct {
+	stateStore *StateStore
+	retention  time.Duration
+	lease      time.Duration
+	now        func() time.Time
+
+	mu      sync.Mutex
+	metrics DeduplicationMetrics
+}
+
+// NewEventDeduplicator creates a deduplicator keeping its records in stateStore
+func NewEventDeduplicator(stateStore *StateStore) *EventDeduplicator {
+	return &EventDeduplicator{
+		stateStore: stateStore,
+		retention:  parseDurationEnv("EVENT_DEDUP_RETENTION", defaultDeduplicationRetention),
+		lease:      parseDurationEnv("EVENT_DEDUP_LEASE", defaultDeduplicationLease),
+		now:        time.Now,
+		metrics:    DeduplicationMetrics{DroppedByConsumer: make(map[string]int64)},
+	}
+}
+
+// Wrap returns a handler that passes each event to handler at most once per consumer
+func (d *EventDeduplicator) Wrap(consumer string, handler TopicEventHandler) TopicEventHandler {
+	return func(ctx context.Context, event *TopicEvent) error {
+		return d.Process(ctx, consumer, TopicEventKey(event), func(ctx context.Context) error {
+			return handler(ctx, event)
+		})
+	}
+}
+
+// Process runs handle unless consumer already processed the event identified by
+// eventKey. A duplicate is dropped without error. An event another delivery is still
+// handling is reported as a conflict, so the broker redelivers it later. When handle
+// fails the claim is released and the next delivery tries again.
+func (d *EventDeduplicator) Process(ctx context.Context, consumer, eventKey string, handle func(ctx context.Context) error) error {
+	if eventKey == "" {
+		// Nothing identifies the event, so it cannot be recognised when it comes back
+		return handle(ctx)
+	}
+
+	key := d.stateStore.CreateKey(deduplicationDomain, consumer, eventKey)
+	status, etag, err := d.claim(ctx, key, consumer, eventKey)
+	if err != nil {
+		return err
+	}
+
+	switch status {
+	case DeliveryStatusProcessed:
+		d.record(consumer, func(m *DeduplicationMetrics) {
+			m.DuplicatesDropped++
+			m.DroppedByConsumer[consumer]++
+		})
+		log.Printf("Dropping duplicate event %s for %s", eventKey, consumer)
+		return nil
+	case DeliveryStatusProcessing:
+		d.record(consumer, func(m *DeduplicationMetrics) { m.Deferred++ })
+		return domain.NewConflictError(fmt.Sprintf("event %s is already being processed by %s", eventKey, consumer))
+	}
+
+	if err := handle(ctx); err != nil {
+		if releaseErr := d.stateStore.Delete(ctx, key, &StateOptions{ETag: etag}); releaseErr != nil {
+			log.Printf("Failed to release claim on event %s for %s: %v", eventKey, consumer, releaseErr)
+		}
+		return err
+	}
+
+	now := d.now().UTC()
+	processed := &ConsumedEvent{
+		Consumer:       consumer,
+		EventKey:       eventKey,
+		Status:         DeliveryStatusProcessed,
+		ClaimedAt:      now,
+		LeaseExpiresAt: now,
+		ProcessedAt:    &now,
+	}
+	if err := d.stateStore.Save(ctx, key, processed, &StateOptions{TTL: d.retention}); err != nil {
+		// The effect already happened; a redelivery would repeat it, but failing
+		// here would make the broker redeliver for certain
+		log.Printf("Failed to record event %s as processed for %s: %v", eventKey, consumer, err)
+	}
+
+	d.record(consumer, func(m *DeduplicationMetrics) { m.Processed++ })
+	return nil
+}
+
+// Metrics returns a snapshot of the deduplication counters
+func (d *EventDeduplicator) Metrics() DeduplicationMetrics {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	snapshot := d.metrics
+	snapshot.DroppedByConsumer = make(map[string]int64, len(d.metrics.DroppedByConsumer))
+	for consumer, count := range d.metrics.DroppedByConsumer {
+		snapshot.DroppedByConsumer[consumer] = count
+	}
+	return snapshot
+}
+
+// claim takes the event for this delivery. It returns an empty status and the ETag of
+// the claim when the delivery may go ahead, or the status that stops it.
+func (d *EventDeduplicator) claim(ctx context.Context, key, consumer, eventKey string) (DeliveryStatus, string, error) {
+	var existing ConsumedEvent
+	found, etag, err := d.stateStore.GetWithETag(ctx, key, &existing)
+	if err != nil {
+		return "", "", err
+	}
+
+	now := d.now().UTC()
+	if found {
+		if existing.Status == DeliveryStatusProcessed {
+			return DeliveryStatusProcessed, "", nil
+		}
+		if existing.LeaseExpiresAt.After(now) {
+			return DeliveryStatusProcessing, "", nil
+		}
+	}
+
+	// Absent records are inserted with first-write concurrency and abandoned claims are
+	// replaced by ETag, so of two concurrent deliveries only one gets through
+	options := &StateOptions{ETag: etag, TTL: d.lease}
+	if !found {
+		options.Concurrency = client.StateConcurrencyFirstWrite
+	}
+	claimed := &ConsumedEvent{
+		Consumer:       consumer,
+		EventKey:       eventKey,
+		Status:         DeliveryStatusProcessing,
+		ClaimedAt:      now,
+		LeaseExpiresAt: now.Add(d.lease),
+	}
+	if err := d.stateStore.Save(ctx, key, claimed, options); err != nil {
+		if d.stateStore.isConcurrencyConflict(err) {
+			return DeliveryStatusProcessing, "", nil
+		}
+		return "", "", err
+	}
+
+	claimETag, err := d.stateStore.GetETag(ctx, key)
+	if err != nil {
+		return "", "", err
+	}
+	return "", claimETag, nil
+}
+
+func (d *EventDeduplicator) record(consumer string, update func(m *DeduplicationMetrics)) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	update(&d.metrics)
+}
+
+// TopicEventKey identifies the event a delivery carries. Retries and replays of an
+// EventMessage keep its ID, which Dapr's per-publish CloudEvent ID does not, so the
+// message ID is preferred; messages without one fall back to their correlation ID,
+// type and subject, and undecodable payloads to the CloudEvent ID.
+func TopicEventKey(event *TopicEvent) string {
+	message, err := event.EventMessage()
+	if err != nil {
+		return event.ID
+	}
+	if message.ID != "" {
+		return message.ID
+	}
+	if message.CorrelationID != "" {
+		return fmt.Sprintf("%s:%s:%s", message.CorrelationID, message.Type, message.Subject)
+	}
+	return event.ID
+}
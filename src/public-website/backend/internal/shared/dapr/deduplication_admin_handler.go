@@ -0,0 +1,28 @@
+package dapr
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// DeduplicationAdminHandler serves the counters of a service's event deduplicator
+type DeduplicationAdminHandler struct {
+	deduplicator *EventDeduplicator
+}
+
+// NewDeduplicationAdminHandler creates the deduplication admin API for a deduplicator
+func NewDeduplicationAdminHandler(deduplicator *EventDeduplicator) *DeduplicationAdminHandler {
+	return &DeduplicationAdminHandler{deduplicator: deduplicator}
+}
+
+// RegisterRoutes registers the deduplication routes on a router mounted at the admin API root
+func (h *DeduplicationAdminHandler) RegisterRoutes(router *mux.Router) {
+	router.HandleFunc("/event-deduplication", h.GetMetrics).Methods("GET")
+}
+
+// GetMetrics returns how many deliveries were processed, dropped as duplicates or
+// deferred while another delivery held the event
+func (h *DeduplicationAdminHandler) GetMetrics(w http.ResponseWriter, r *http.Request) {
+	writeAdminResponse(w, r, http.StatusOK, map[string]interface{}{"data": h.deduplicator.Metrics()})
+}
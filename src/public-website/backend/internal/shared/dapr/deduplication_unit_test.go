@@ -0,0 +1,246 @@
+package dapr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestDeduplicator() *EventDeduplicator {
+	return NewEventDeduplicator(NewStateStoreWithBackend(NewMemoryStateBackend()))
+}
+
+func TestEventDeduplicator_Process(t *testing.T) {
+	tests := []struct {
+		name              string
+		handlerErrs       []error
+		expectedCalls     int
+		expectedErrs      []bool
+		expectedProcessed int64
+		expectedDropped   int64
+	}{
+		{
+			name:              "process first delivery",
+			handlerErrs:       []error{nil},
+			expectedCalls:     1,
+			expectedErrs:      []bool{false},
+			expectedProcessed: 1,
+		},
+		{
+			name:              "drop redelivered event",
+			handlerErrs:       []error{nil, nil, nil},
+			expectedCalls:     1,
+			expectedErrs:      []bool{false, false, false},
+			expectedProcessed: 1,
+			expectedDropped:   2,
+		},
+		{
+			name:              "retry event after failed delivery",
+			handlerErrs:       []error{errors.New("smtp unavailable"), nil, nil},
+			expectedCalls:     2,
+			expectedErrs:      []bool{true, false, false},
+			expectedProcessed: 1,
+			expectedDropped:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+			deduplicator := newTestDeduplicator()
+			calls := 0
+
+			// Act
+			var errs []bool
+			for _, handlerErr := range tt.handlerErrs {
+				handlerErr := handlerErr
+				err := deduplicator.Process(ctx, "search-indexer", "event-1", func(ctx context.Context) error {
+					calls++
+					return handlerErr
+				})
+				errs = append(errs, err != nil)
+			}
+
+			// Assert
+			assert.Equal(t, tt.expectedCalls, calls)
+			assert.Equal(t, tt.expectedErrs, errs)
+			metrics := deduplicator.Metrics()
+			assert.Equal(t, tt.expectedProcessed, metrics.Processed)
+			assert.Equal(t, tt.expectedDropped, metrics.DuplicatesDropped)
+			assert.Equal(t, tt.expectedDropped, metrics.DroppedByConsumer["search-indexer"])
+		})
+	}
+}
+
+func TestEventDeduplicator_ConsumersAreIndependent(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	deduplicator := newTestDeduplicator()
+	calls := map[string]int{}
+
+	// Act
+	for _, consumer := range []string{"search-indexer", "notification-router", "search-indexer"} {
+		consumer := consumer
+		require.NoError(t, deduplicator.Process(ctx, consumer, "event-1", func(ctx context.Context) error {
+			calls[consumer]++
+			return nil
+		}))
+	}
+
+	// Assert
+	assert.Equal(t, map[string]int{"search-indexer": 1, "notification-router": 1}, calls)
+}
+
+func TestEventDeduplicator_ConcurrentDeliveries(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	deduplicator := newTestDeduplicator()
+	var calls int32
+	release := make(chan struct{})
+
+	// Act
+	var wg sync.WaitGroup
+	results := make(chan error, 5)
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results <- deduplicator.Process(ctx, "search-indexer", "event-1", func(ctx context.Context) error {
+				atomic.AddInt32(&calls, 1)
+				<-release
+				return nil
+			})
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(results)
+
+	// Assert
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls), "only one delivery runs the handler")
+	for err := range results {
+		if err != nil {
+			assert.True(t, domain.IsConflictError(err), "overlapping deliveries are deferred for redelivery")
+		}
+	}
+	metrics := deduplicator.Metrics()
+	assert.Equal(t, int64(1), metrics.Processed)
+	assert.Equal(t, int64(5), metrics.Processed+metrics.Deferred+metrics.DuplicatesDropped)
+}
+
+func TestEventDeduplicator_TakesOverExpiredClaim(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	deduplicator := newTestDeduplicator()
+	clock := time.Now()
+	deduplicator.now = func() time.Time { return clock }
+
+	key := deduplicator.stateStore.CreateKey(deduplicationDomain, "search-indexer", "event-1")
+	abandoned := &ConsumedEvent{
+		Consumer:       "search-indexer",
+		EventKey:       "event-1",
+		Status:         DeliveryStatusProcessing,
+		ClaimedAt:      clock,
+		LeaseExpiresAt: clock.Add(deduplicator.lease),
+	}
+	require.NoError(t, deduplicator.stateStore.Save(ctx, key, abandoned, nil))
+	calls := 0
+	handle := func(ctx context.Context) error {
+		calls++
+		return nil
+	}
+
+	// Act
+	beforeExpiry := deduplicator.Process(ctx, "search-indexer", "event-1", handle)
+	clock = clock.Add(deduplicator.lease + time.Second)
+	afterExpiry := deduplicator.Process(ctx, "search-indexer", "event-1", handle)
+
+	// Assert
+	assert.True(t, domain.IsConflictError(beforeExpiry))
+	assert.NoError(t, afterExpiry)
+	assert.Equal(t, 1, calls)
+}
+
+func TestDeduplicationAdminHandler_GetMetrics(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	deduplicator := newTestDeduplicator()
+	for _, eventKey := range []string{"event-1", "event-1", "event-2"} {
+		require.NoError(t, deduplicator.Process(ctx, "email-notifications", eventKey, func(ctx context.Context) error { return nil }))
+	}
+
+	router := mux.NewRouter()
+	NewDeduplicationAdminHandler(deduplicator).RegisterRoutes(router.PathPrefix("/admin").Subrouter())
+	recorder := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/admin/event-deduplication", nil))
+
+	// Assert
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+	var response struct {
+		Data DeduplicationMetrics `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, DeduplicationMetrics{
+		Processed:         2,
+		DuplicatesDropped: 1,
+		DroppedByConsumer: map[string]int64{"email-notifications": 1},
+	}, response.Data)
+}
+
+func TestTopicEventKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		message  *EventMessage
+		raw      string
+		expected string
+	}{
+		{
+			name:     "prefer event message ID",
+			message:  &EventMessage{ID: "message-1", CorrelationID: "correlation-1"},
+			expected: "message-1",
+		},
+		{
+			name:     "fall back to correlation ID, type and subject",
+			message:  &EventMessage{CorrelationID: "correlation-1", Type: "audit.event", Subject: "news-1"},
+			expected: "correlation-1:audit.event:news-1",
+		},
+		{
+			name:     "fall back to cloud event ID",
+			raw:      `"not an event message"`,
+			expected: "cloud-event-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			data := json.RawMessage(tt.raw)
+			if tt.message != nil {
+				encoded, err := json.Marshal(tt.message)
+				require.NoError(t, err)
+				data = encoded
+			}
+
+			// Act
+			key := TopicEventKey(&TopicEvent{ID: "cloud-event-1", Data: data})
+
+			// Assert
+			assert.Equal(t, tt.expected, key)
+		})
+	}
+}
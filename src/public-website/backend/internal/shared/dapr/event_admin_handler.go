@@ -0,0 +1,102 @@
+package dapr
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/gorilla/mux"
+)
+
+// EventAdminHandler serves the admin API for searching the event history and
+// replaying it into a topic
+type EventAdminHandler struct {
+	pubsub *PubSub
+}
+
+// NewEventAdminHandler creates the event history admin API for the events published through pubsub
+func NewEventAdminHandler(pubsub *PubSub) *EventAdminHandler {
+	return &EventAdminHandler{pubsub: pubsub}
+}
+
+// RegisterRoutes registers the event history routes on a router mounted at the admin API root
+func (h *EventAdminHandler) RegisterRoutes(router *mux.Router) {
+	router.HandleFunc("/event-history", h.SearchEvents).Methods("GET")
+	router.HandleFunc("/event-history/replay", h.ReplayEvents).Methods("POST")
+}
+
+// SearchEvents lists stored events filtered by the topic, type, entity_id,
+// correlation_id, from, to and limit query parameters
+func (h *EventAdminHandler) SearchEvents(w http.ResponseWriter, r *http.Request) {
+	query, err := parseEventQuery(r.URL.Query())
+	if err != nil {
+		writeAdminError(w, r, err)
+		return
+	}
+
+	events, err := h.pubsub.SearchEvents(r.Context(), query)
+	if err != nil {
+		writeAdminError(w, r, err)
+		return
+	}
+
+	writeAdminResponse(w, r, http.StatusOK, map[string]interface{}{
+		"data":  events,
+		"count": len(events),
+	})
+}
+
+// ReplayEvents publishes the stored events selected by the request body into its
+// target topic, or previews them when dry_run is set
+func (h *EventAdminHandler) ReplayEvents(w http.ResponseWriter, r *http.Request) {
+	var request EventReplayRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeAdminError(w, r, domain.NewValidationError("invalid request body"))
+		return
+	}
+
+	result, err := h.pubsub.ReplayHistory(r.Context(), &request)
+	if err != nil {
+		writeAdminError(w, r, err)
+		return
+	}
+
+	status := http.StatusAccepted
+	if request.DryRun {
+		status = http.StatusOK
+	}
+	writeAdminResponse(w, r, status, map[string]interface{}{"data": result})
+}
+
+func parseEventQuery(values url.Values) (EventQuery, error) {
+	query := EventQuery{
+		Topic:         values.Get("topic"),
+		Type:          values.Get("type"),
+		EntityID:      values.Get("entity_id"),
+		CorrelationID: values.Get("correlation_id"),
+	}
+
+	for name, target := range map[string]*time.Time{"from": &query.From, "to": &query.To} {
+		if value := values.Get(name); value != "" {
+			parsed, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return query, domain.NewValidationError(fmt.Sprintf("%s must be an RFC 3339 timestamp", name))
+			}
+			*target = parsed
+		}
+	}
+
+	if value := values.Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 1 {
+			return query, domain.NewValidationError("limit must be a positive number")
+		}
+		query.Limit = limit
+	}
+
+	return query, nil
+}
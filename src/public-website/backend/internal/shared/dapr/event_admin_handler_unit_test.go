@@ -0,0 +1,103 @@
+package dapr
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventAdminHandler(t *testing.T) {
+	tests := []struct {
+		name             string
+		method           string
+		path             string
+		body             string
+		expectedStatus   int
+		expectedCode     string
+		expectedMatched  int
+		expectedReplayed int
+		expectedReplays  int
+	}{
+		{name: "search by topic", method: http.MethodGet, path: "/admin/api/v1/event-history?topic=audit-events", expectedStatus: http.StatusOK, expectedMatched: 3},
+		{name: "search by correlation ID with limit", method: http.MethodGet, path: "/admin/api/v1/event-history?correlation_id=request-1&limit=2", expectedStatus: http.StatusOK, expectedMatched: 2},
+		{name: "reject malformed time", method: http.MethodGet, path: "/admin/api/v1/event-history?topic=audit-events&from=yesterday", expectedStatus: http.StatusBadRequest, expectedCode: "VALIDATION_ERROR"},
+		{name: "reject unfiltered search", method: http.MethodGet, path: "/admin/api/v1/event-history", expectedStatus: http.StatusBadRequest, expectedCode: "VALIDATION_ERROR"},
+		{name: "preview replay", method: http.MethodPost, path: "/admin/api/v1/event-history/replay",
+			body:           `{"entity_id":"news-1","target_topic":"audit-events-replay","dry_run":true}`,
+			expectedStatus: http.StatusOK, expectedMatched: 2},
+		{name: "replay into target topic", method: http.MethodPost, path: "/admin/api/v1/event-history/replay",
+			body:           `{"topic":"audit-events","from":"2026-10-01T00:00:00Z","target_topic":"audit-events-replay"}`,
+			expectedStatus: http.StatusAccepted, expectedMatched: 2, expectedReplayed: 2, expectedReplays: 2},
+		{name: "reject replay without target topic", method: http.MethodPost, path: "/admin/api/v1/event-history/replay",
+			body: `{"topic":"audit-events"}`, expectedStatus: http.StatusBadRequest, expectedCode: "VALIDATION_ERROR"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			pubsub := NewPubSub(&Client{appID: "content-api"})
+			pubsub.eventStore = newTestEventStore()
+			pubsub.eventStore.now = func() time.Time { return time.Date(2026, 10, 30, 0, 0, 0, 0, time.UTC) }
+			seedEventHistory(t, pubsub.eventStore)
+
+			router := mux.NewRouter()
+			NewEventAdminHandler(pubsub).RegisterRoutes(router.PathPrefix("/admin/api/v1").Subrouter())
+
+			request := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			recorder := httptest.NewRecorder()
+
+			// Act
+			router.ServeHTTP(recorder, request)
+
+			// Assert
+			require.Equal(t, tt.expectedStatus, recorder.Code, recorder.Body.String())
+			var response struct {
+				Data  json.RawMessage `json:"data"`
+				Error struct {
+					Code string `json:"code"`
+				} `json:"error"`
+			}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+			if tt.expectedCode != "" {
+				assert.Equal(t, tt.expectedCode, response.Error.Code)
+				return
+			}
+
+			if tt.method == http.MethodGet {
+				var events []*StoredEvent
+				require.NoError(t, json.Unmarshal(response.Data, &events))
+				assert.Len(t, events, tt.expectedMatched)
+				return
+			}
+
+			var result EventReplayResult
+			require.NoError(t, json.Unmarshal(response.Data, &result))
+			assert.Equal(t, tt.expectedMatched, result.Matched)
+			assert.Equal(t, tt.expectedReplayed, result.Replayed)
+
+			originals, err := pubsub.eventStore.Search(request.Context(), EventQuery{Topic: "audit-events"})
+			require.NoError(t, err)
+			originalIDs := make(map[string]bool, len(originals))
+			for _, original := range originals {
+				originalIDs[original.Event.ID] = true
+			}
+
+			replays, err := pubsub.eventStore.Search(request.Context(), EventQuery{Topic: "audit-events-replay"})
+			require.NoError(t, err)
+			assert.Len(t, replays, tt.expectedReplays)
+			for _, replay := range replays {
+				assert.Equal(t, "true", replay.Event.Metadata["replayed"])
+				assert.Equal(t, "audit-events", replay.Event.Metadata["original_topic"])
+				assert.True(t, originalIDs[replay.Event.Metadata["original_event_id"]])
+				assert.False(t, originalIDs[replay.Event.ID], "a replay must not reuse the original event ID")
+			}
+		})
+	}
+}
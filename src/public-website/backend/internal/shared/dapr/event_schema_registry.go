@@ -0,0 +1,496 @@
+package dapr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// eventSchemaVersionPattern matches the major.minor schema versions used as file names
+var eventSchemaVersionPattern = regexp.MustCompile(`^(\d+)\.(\d+)$`)
+
+// RegisteredEventSchema is one version of the contract for a cross-service event type:
+// a JSON Schema for its payload and the services allowed to produce and receive it
+type RegisteredEventSchema struct {
+	EventType     string
+	SchemaVersion string
+	ValidSources  []string
+	ValidTargets  []string
+	Payload       *openapi3.Schema
+	Path          string
+}
+
+// eventSchemaDocument is the part of a schema file read besides the payload schema itself
+type eventSchemaDocument struct {
+	Title        string   `json:"title"`
+	ValidSources []string `json:"x-valid-sources"`
+	ValidTargets []string `json:"x-valid-targets"`
+}
+
+// SchemaIncompatibility is a change between two versions of an event schema that
+// breaks producers or consumers of the earlier version
+type SchemaIncompatibility struct {
+	EventType   string `json:"event_type"`
+	FromVersion string `json:"from_version"`
+	ToVersion   string `json:"to_version"`
+	Path        string `json:"path"`
+	Reason      string `json:"reason"`
+}
+
+func (i SchemaIncompatibility) String() string {
+	return fmt.Sprintf("%s %s -> %s at %s: %s", i.EventType, i.FromVersion, i.ToVersion, i.Path, i.Reason)
+}
+
+// EventSchemaRegistry holds every version of every cross-service event contract, as
+// loaded from the contracts tree. Producers validate against the exact version they
+// publish; consumers fall back to the newest earlier minor version they know, which
+// the compatibility rules guarantee can still read the event.
+type EventSchemaRegistry struct {
+	schemas map[string]map[string]*RegisteredEventSchema
+}
+
+// NewEventSchemaRegistry creates an empty registry
+func NewEventSchemaRegistry() *EventSchemaRegistry {
+	return &EventSchemaRegistry{schemas: make(map[string]map[string]*RegisteredEventSchema)}
+}
+
+// EventSchemaDir locates the event contracts: EVENT_SCHEMA_DIR when set, otherwise the
+// nearest contracts/events directory above the working directory. It returns an empty
+// string when neither exists.
+func EventSchemaDir() string {
+	if dir := os.Getenv("EVENT_SCHEMA_DIR"); dir != "" {
+		return dir
+	}
+
+	dir, err := os.Getwd()
+	if err != nil {
+		return ""
+	}
+	for {
+		candidate := filepath.Join(dir, "contracts", "events")
+		if info, err := os.Stat(candidate); err == nil && info.IsDir() {
+			return candidate
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// LoadEventSchemaRegistry loads the schemas laid out as <dir>/<event type>/<version>.json
+func LoadEventSchemaRegistry(dir string) (*EventSchemaRegistry, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("event schema directory not found, set EVENT_SCHEMA_DIR")
+	}
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*", "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list event schemas in %s: %w", dir, err)
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no event schemas found in %s", dir)
+	}
+
+	registry := NewEventSchemaRegistry()
+	for _, path := range paths {
+		schema, err := loadEventSchema(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := registry.Register(schema); err != nil {
+			return nil, err
+		}
+	}
+	return registry, nil
+}
+
+func loadEventSchema(path string) (*RegisteredEventSchema, error) {
+	eventType := filepath.Base(filepath.Dir(path))
+	version := strings.TrimSuffix(filepath.Base(path), ".json")
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read event schema %s: %w", path, err)
+	}
+
+	var document eventSchemaDocument
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("invalid event schema %s: %w", path, err)
+	}
+	if document.Title != eventType {
+		return nil, fmt.Errorf("event schema %s is titled %q but stored under %s", path, document.Title, eventType)
+	}
+
+	payload := &openapi3.Schema{}
+	if err := json.Unmarshal(data, payload); err != nil {
+		return nil, fmt.Errorf("invalid event schema %s: %w", path, err)
+	}
+	if !payload.Type.Is(openapi3.TypeObject) {
+		return nil, fmt.Errorf("event schema %s must describe an object payload", path)
+	}
+
+	return &RegisteredEventSchema{
+		EventType:     eventType,
+		SchemaVersion: version,
+		ValidSources:  document.ValidSources,
+		ValidTargets:  document.ValidTargets,
+		Payload:       payload,
+		Path:          path,
+	}, nil
+}
+
+// Register adds one schema version to the registry
+func (r *EventSchemaRegistry) Register(schema *RegisteredEventSchema) error {
+	if !eventSchemaVersionPattern.MatchString(schema.SchemaVersion) {
+		return fmt.Errorf("event schema %s has version %q, expected major.minor", schema.EventType, schema.SchemaVersion)
+	}
+
+	versions, ok := r.schemas[schema.EventType]
+	if !ok {
+		versions = make(map[string]*RegisteredEventSchema)
+		r.schemas[schema.EventType] = versions
+	}
+	if _, exists := versions[schema.SchemaVersion]; exists {
+		return fmt.Errorf("event schema %s %s is registered twice", schema.EventType, schema.SchemaVersion)
+	}
+	versions[schema.SchemaVersion] = schema
+	return nil
+}
+
+// EventTypes returns the registered event types in name order
+func (r *EventSchemaRegistry) EventTypes() []string {
+	eventTypes := make([]string, 0, len(r.schemas))
+	for eventType := range r.schemas {
+		eventTypes = append(eventTypes, eventType)
+	}
+	sort.Strings(eventTypes)
+	return eventTypes
+}
+
+// Versions returns the registered versions of an event type, oldest first
+func (r *EventSchemaRegistry) Versions(eventType string) []string {
+	versions := make([]string, 0, len(r.schemas[eventType]))
+	for version := range r.schemas[eventType] {
+		versions = append(versions, version)
+	}
+	sort.Slice(versions, func(i, j int) bool { return compareSchemaVersions(versions[i], versions[j]) < 0 })
+	return versions
+}
+
+// Schema returns one version of an event type's schema
+func (r *EventSchemaRegistry) Schema(eventType, version string) (*RegisteredEventSchema, bool) {
+	schema, ok := r.schemas[eventType][version]
+	return schema, ok
+}
+
+// Latest returns the newest version of an event type's schema
+func (r *EventSchemaRegistry) Latest(eventType string) (*RegisteredEventSchema, bool) {
+	versions := r.Versions(eventType)
+	if len(versions) == 0 {
+		return nil, false
+	}
+	return r.Schema(eventType, versions[len(versions)-1])
+}
+
+// ValidateProduced checks an event before it is published: its exact schema version
+// must be registered, its source and target allowed, and its payload valid
+func (r *EventSchemaRegistry) ValidateProduced(event *CrossServiceEvent) error {
+	if _, ok := r.schemas[event.EventType]; !ok {
+		return domain.NewValidationError(fmt.Sprintf("no schema defined for event type %s", event.EventType))
+	}
+	schema, ok := r.Schema(event.EventType, event.SchemaVersion)
+	if !ok {
+		return domain.NewValidationError(fmt.Sprintf("event type %s has no schema version %s, registered versions: %v",
+			event.EventType, event.SchemaVersion, r.Versions(event.EventType)))
+	}
+
+	if len(schema.ValidSources) > 0 && !containsString(schema.ValidSources, event.SourceService) {
+		return domain.NewValidationError(fmt.Sprintf("invalid source service %s for event type %s, valid sources: %v",
+			event.SourceService, event.EventType, schema.ValidSources))
+	}
+	if event.TargetService != "" && len(schema.ValidTargets) > 0 && !containsString(schema.ValidTargets, event.TargetService) {
+		return domain.NewValidationError(fmt.Sprintf("invalid target service %s for event type %s, valid targets: %v",
+			event.TargetService, event.EventType, schema.ValidTargets))
+	}
+
+	return schema.validatePayload(event.Payload)
+}
+
+// ValidateConsumed checks a received event against the schema its consumer knows.
+// An event on an unknown minor version is read with the newest earlier minor version
+// of the same major, which compatible changes keep able to read it.
+func (r *EventSchemaRegistry) ValidateConsumed(event *CrossServiceEvent) error {
+	if _, ok := r.schemas[event.EventType]; !ok {
+		return domain.NewValidationError(fmt.Sprintf("no schema defined for event type %s", event.EventType))
+	}
+
+	schema, ok := r.Schema(event.EventType, event.SchemaVersion)
+	if !ok {
+		schema, ok = r.readableSchema(event.EventType, event.SchemaVersion)
+	}
+	if !ok {
+		return domain.NewValidationError(fmt.Sprintf("event type %s schema version %s is not supported by this consumer",
+			event.EventType, event.SchemaVersion))
+	}
+
+	return schema.validatePayload(event.Payload)
+}
+
+func (r *EventSchemaRegistry) readableSchema(eventType, version string) (*RegisteredEventSchema, bool) {
+	major, _, ok := parseSchemaVersion(version)
+	if !ok {
+		return nil, false
+	}
+
+	versions := r.Versions(eventType)
+	for i := len(versions) - 1; i >= 0; i-- {
+		candidateMajor, _, _ := parseSchemaVersion(versions[i])
+		if candidateMajor == major && compareSchemaVersions(versions[i], version) < 0 {
+			return r.Schema(eventType, versions[i])
+		}
+	}
+	return nil, false
+}
+
+// validatePayload validates a payload as it travels on the wire, so values such as
+// times and typed slices are checked in their JSON form
+func (s *RegisteredEventSchema) validatePayload(payload map[string]interface{}) error {
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return domain.NewValidationError(fmt.Sprintf("event %s payload cannot be encoded: %v", s.EventType, err))
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return domain.NewValidationError(fmt.Sprintf("event %s payload cannot be decoded: %v", s.EventType, err))
+	}
+
+	if err := s.Payload.VisitJSON(decoded, openapi3.MultiErrors()); err != nil {
+		return domain.NewValidationError(fmt.Sprintf("event %s %s payload does not match its schema: %s",
+			s.EventType, s.SchemaVersion, schemaErrorSummary(err)))
+	}
+	return nil
+}
+
+// CheckCompatibility reports the breaking changes within the registry: each minor
+// version must stay compatible with the previous version of the same major. A new
+// major version may break compatibility.
+func (r *EventSchemaRegistry) CheckCompatibility() []SchemaIncompatibility {
+	var incompatibilities []SchemaIncompatibility
+	for _, eventType := range r.EventTypes() {
+		versions := r.Versions(eventType)
+		for i := 1; i < len(versions); i++ {
+			previousMajor, _, _ := parseSchemaVersion(versions[i-1])
+			major, _, _ := parseSchemaVersion(versions[i])
+			if previousMajor != major {
+				continue
+			}
+			previous, _ := r.Schema(eventType, versions[i-1])
+			next, _ := r.Schema(eventType, versions[i])
+			incompatibilities = append(incompatibilities, CompareEventSchemas(previous, next)...)
+		}
+	}
+	return incompatibilities
+}
+
+// CheckCompatibilityWith reports the breaking changes since a baseline registry, such
+// as the schemas on the main branch: a published version may not be removed or
+// changed incompatibly in place.
+func (r *EventSchemaRegistry) CheckCompatibilityWith(baseline *EventSchemaRegistry) []SchemaIncompatibility {
+	var incompatibilities []SchemaIncompatibility
+	for _, eventType := range baseline.EventTypes() {
+		for _, version := range baseline.Versions(eventType) {
+			published, _ := baseline.Schema(eventType, version)
+			current, ok := r.Schema(eventType, version)
+			if !ok {
+				incompatibilities = append(incompatibilities, SchemaIncompatibility{
+					EventType: eventType, FromVersion: version, ToVersion: version, Path: "$",
+					Reason: "published schema version was removed",
+				})
+				continue
+			}
+			incompatibilities = append(incompatibilities, CompareEventSchemas(published, current)...)
+		}
+	}
+	return incompatibilities
+}
+
+// CompareEventSchemas lists the changes from previous to next that break existing
+// producers or consumers. Adding optional properties, enum values, sources and targets
+// is compatible; removing or retyping properties, changing which are required,
+// removing enum values, closing additional properties and dropping sources or targets
+// is not.
+func CompareEventSchemas(previous, next *RegisteredEventSchema) []SchemaIncompatibility {
+	var incompatibilities []SchemaIncompatibility
+	report := func(path, reason string) {
+		incompatibilities = append(incompatibilities, SchemaIncompatibility{
+			EventType:   next.EventType,
+			FromVersion: previous.SchemaVersion,
+			ToVersion:   next.SchemaVersion,
+			Path:        path,
+			Reason:      reason,
+		})
+	}
+
+	for _, source := range previous.ValidSources {
+		if len(next.ValidSources) > 0 && !containsString(next.ValidSources, source) {
+			report("x-valid-sources", fmt.Sprintf("source %s is no longer allowed", source))
+		}
+	}
+	for _, target := range previous.ValidTargets {
+		if len(next.ValidTargets) > 0 && !containsString(next.ValidTargets, target) {
+			report("x-valid-targets", fmt.Sprintf("target %s is no longer allowed", target))
+		}
+	}
+
+	compareSchemas("$", previous.Payload, next.Payload, report)
+	return incompatibilities
+}
+
+func compareSchemas(path string, previous, next *openapi3.Schema, report func(path, reason string)) {
+	if previous == nil || next == nil {
+		return
+	}
+
+	previousTypes, nextTypes := previous.Type.Slice(), next.Type.Slice()
+	if len(previousTypes) > 0 && strings.Join(previousTypes, ",") != strings.Join(nextTypes, ",") {
+		report(path, fmt.Sprintf("type changed from %v to %v", previousTypes, nextTypes))
+		return
+	}
+
+	for _, name := range previous.Required {
+		if !containsString(next.Required, name) {
+			report(path+"."+name, "property is no longer required")
+		}
+	}
+	for _, name := range next.Required {
+		if !containsString(previous.Required, name) {
+			report(path+"."+name, "property became required")
+		}
+	}
+
+	for name, previousProperty := range previous.Properties {
+		nextProperty, ok := next.Properties[name]
+		if !ok {
+			report(path+"."+name, "property was removed")
+			continue
+		}
+		compareSchemas(path+"."+name, previousProperty.Value, nextProperty.Value, report)
+	}
+
+	if previous.AdditionalProperties.Has == nil || *previous.AdditionalProperties.Has {
+		if next.AdditionalProperties.Has != nil && !*next.AdditionalProperties.Has {
+			report(path, "additional properties are no longer allowed")
+		}
+	}
+
+	for _, value := range previous.Enum {
+		if len(next.Enum) > 0 && !containsEnumValue(next.Enum, value) {
+			report(path, fmt.Sprintf("enum value %v was removed", value))
+		}
+	}
+	if len(previous.Enum) == 0 && len(next.Enum) > 0 {
+		report(path, "values were restricted to an enum")
+	}
+
+	if previous.Items != nil && next.Items != nil {
+		compareSchemas(path+"[]", previous.Items.Value, next.Items.Value, report)
+	}
+}
+
+// summaries describes the newest version of every event type in the field-type form
+// of EventSchema
+func (r *EventSchemaRegistry) summaries() map[string]*EventSchema {
+	summaries := make(map[string]*EventSchema, len(r.schemas))
+	for _, eventType := range r.EventTypes() {
+		schema, _ := r.Latest(eventType)
+		summary := &EventSchema{
+			EventType:      eventType,
+			SchemaVersion:  schema.SchemaVersion,
+			RequiredFields: make(map[string]string),
+			OptionalFields: make(map[string]string),
+			ValidSources:   schema.ValidSources,
+			ValidTargets:   schema.ValidTargets,
+		}
+		for name, property := range schema.Payload.Properties {
+			fieldType := ""
+			if property.Value != nil && len(property.Value.Type.Slice()) > 0 {
+				fieldType = property.Value.Type.Slice()[0]
+			}
+			if containsString(schema.Payload.Required, name) {
+				summary.RequiredFields[name] = fieldType
+			} else {
+				summary.OptionalFields[name] = fieldType
+			}
+		}
+		summaries[eventType] = summary
+	}
+	return summaries
+}
+
+func parseSchemaVersion(version string) (int, int, bool) {
+	match := eventSchemaVersionPattern.FindStringSubmatch(version)
+	if match == nil {
+		return 0, 0, false
+	}
+	major, _ := strconv.Atoi(match[1])
+	minor, _ := strconv.Atoi(match[2])
+	return major, minor, true
+}
+
+func compareSchemaVersions(a, b string) int {
+	aMajor, aMinor, _ := parseSchemaVersion(a)
+	bMajor, bMinor, _ := parseSchemaVersion(b)
+	if aMajor != bMajor {
+		return aMajor - bMajor
+	}
+	return aMinor - bMinor
+}
+
+func containsString(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsEnumValue(values []interface{}, value interface{}) bool {
+	for _, candidate := range values {
+		if fmt.Sprint(candidate) == fmt.Sprint(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// schemaErrorSummary flattens the validation errors of a payload onto one line
+func schemaErrorSummary(err error) string {
+	var errs openapi3.MultiError
+	if !errors.As(err, &errs) {
+		return err.Error()
+	}
+
+	messages := make([]string, 0, len(errs))
+	for _, e := range errs {
+		var schemaErr *openapi3.SchemaError
+		if errors.As(e, &schemaErr) {
+			pointer := strings.Join(schemaErr.JSONPointer(), "/")
+			messages = append(messages, fmt.Sprintf("/%s: %s", pointer, schemaErr.Reason))
+			continue
+		}
+		messages = append(messages, e.Error())
+	}
+	return strings.Join(messages, "; ")
+}
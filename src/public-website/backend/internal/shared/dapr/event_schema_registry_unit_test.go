@@ -0,0 +1,260 @@
+package dapr
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testReviewSchema = `{
+  "title": "content.review_requested",
+  "type": "object",
+  "required": ["content_id", "recipients"],
+  "properties": {
+    "content_id": {"type": "string"},
+    "recipients": {"type": "array", "items": {"type": "string"}},
+    "priority": {"type": "string", "enum": ["low", "high"]},
+    "message": {"type": "string"}
+  },
+  "x-valid-sources": ["content-api"],
+  "x-valid-targets": ["notification-api", "admin-gateway"]
+}`
+
+// writeEventSchemas lays schemas out in a temporary contracts directory, keyed by
+// <event type>/<version>
+func writeEventSchemas(t *testing.T, schemas map[string]string) string {
+	dir := t.TempDir()
+	for name, document := range schemas {
+		path := filepath.Join(dir, name+".json")
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(document), 0o644))
+	}
+	return dir
+}
+
+func loadTestEventSchemas(t *testing.T, schemas map[string]string) *EventSchemaRegistry {
+	registry, err := LoadEventSchemaRegistry(writeEventSchemas(t, schemas))
+	require.NoError(t, err)
+	return registry
+}
+
+func TestLoadEventSchemaRegistry_ContractsTree(t *testing.T) {
+	// Arrange
+	dir := EventSchemaDir()
+	require.NotEmpty(t, dir, "the contracts tree is found above the package directory")
+
+	// Act
+	registry, err := LoadEventSchemaRegistry(dir)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, registry.EventTypes(), "content.published")
+	assert.Contains(t, registry.EventTypes(), "inquiry.submitted")
+	assert.Empty(t, registry.CheckCompatibility(), "the published contracts are compatible within each major version")
+}
+
+func TestLoadEventSchemaRegistry_RejectsMisplacedSchemas(t *testing.T) {
+	tests := []struct {
+		name    string
+		schemas map[string]string
+	}{
+		{name: "title differs from directory", schemas: map[string]string{"content.published/1.0": testReviewSchema}},
+		{name: "version is not major.minor", schemas: map[string]string{"content.review_requested/v1": testReviewSchema}},
+		{name: "payload is not an object", schemas: map[string]string{"content.review_requested/1.0": `{"title": "content.review_requested", "type": "string"}`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			dir := writeEventSchemas(t, tt.schemas)
+
+			// Act
+			_, err := LoadEventSchemaRegistry(dir)
+
+			// Assert
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestEventSchemaRegistry_ValidateProduced(t *testing.T) {
+	validPayload := func() map[string]interface{} {
+		return map[string]interface{}{"content_id": "news-1", "recipients": []string{"user-1"}}
+	}
+
+	tests := []struct {
+		name          string
+		mutate        func(event *CrossServiceEvent)
+		expectedError bool
+	}{
+		{name: "valid event", mutate: func(event *CrossServiceEvent) {}},
+		{name: "optional field with enum value", mutate: func(event *CrossServiceEvent) { event.Payload["priority"] = "high" }},
+		{name: "unknown event type", mutate: func(event *CrossServiceEvent) { event.EventType = "content.archived" }, expectedError: true},
+		{name: "unregistered version", mutate: func(event *CrossServiceEvent) { event.SchemaVersion = "1.1" }, expectedError: true},
+		{name: "invalid source", mutate: func(event *CrossServiceEvent) { event.SourceService = "inquiries-api" }, expectedError: true},
+		{name: "invalid target", mutate: func(event *CrossServiceEvent) { event.TargetService = "content-api" }, expectedError: true},
+		{name: "missing required field", mutate: func(event *CrossServiceEvent) { delete(event.Payload, "recipients") }, expectedError: true},
+		{name: "wrong nested type", mutate: func(event *CrossServiceEvent) { event.Payload["recipients"] = []int{1} }, expectedError: true},
+		{name: "value outside enum", mutate: func(event *CrossServiceEvent) { event.Payload["priority"] = "urgent" }, expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			registry := loadTestEventSchemas(t, map[string]string{"content.review_requested/1.0": testReviewSchema})
+			event := &CrossServiceEvent{
+				EventType:     "content.review_requested",
+				SchemaVersion: "1.0",
+				SourceService: "content-api",
+				TargetService: "notification-api",
+				Payload:       validPayload(),
+			}
+			tt.mutate(event)
+
+			// Act
+			err := registry.ValidateProduced(event)
+
+			// Assert
+			if tt.expectedError {
+				assert.True(t, domain.IsValidationError(err), "got %v", err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestEventSchemaRegistry_ValidateConsumed(t *testing.T) {
+	tests := []struct {
+		name          string
+		version       string
+		payload       map[string]interface{}
+		expectedError bool
+	}{
+		{name: "known version", version: "1.0", payload: map[string]interface{}{"content_id": "news-1", "recipients": []string{}}},
+		{name: "newer minor read as the older one", version: "1.3",
+			payload: map[string]interface{}{"content_id": "news-1", "recipients": []string{}, "added_later": true}},
+		{name: "newer minor still needs a valid payload", version: "1.3", payload: map[string]interface{}{"recipients": []string{}}, expectedError: true},
+		{name: "unknown major", version: "2.0", payload: map[string]interface{}{"content_id": "news-1", "recipients": []string{}}, expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			registry := loadTestEventSchemas(t, map[string]string{"content.review_requested/1.0": testReviewSchema})
+
+			// Act
+			err := registry.ValidateConsumed(&CrossServiceEvent{EventType: "content.review_requested", SchemaVersion: tt.version, Payload: tt.payload})
+
+			// Assert
+			if tt.expectedError {
+				assert.True(t, domain.IsValidationError(err), "got %v", err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCompareEventSchemas(t *testing.T) {
+	tests := []struct {
+		name         string
+		next         string
+		expectedPath string
+	}{
+		{name: "add optional property", next: `{"properties": {"summary": {"type": "string"}}}`},
+		{name: "add enum value", next: `{"properties": {"priority": {"type": "string", "enum": ["low", "high", "urgent"]}}}`},
+		{name: "add target", next: `{"x-valid-targets": ["notification-api", "admin-gateway", "public-gateway"]}`},
+		{name: "require new property", next: `{"required": ["content_id", "recipients", "message"]}`, expectedPath: "$.message"},
+		{name: "stop requiring property", next: `{"required": ["content_id"]}`, expectedPath: "$.recipients"},
+		{name: "remove property", next: `{"properties": {"message": null}}`, expectedPath: "$.message"},
+		{name: "retype property", next: `{"properties": {"message": {"type": "object"}}}`, expectedPath: "$.message"},
+		{name: "retype array items", next: `{"properties": {"recipients": {"type": "array", "items": {"type": "number"}}}}`, expectedPath: "$.recipients[]"},
+		{name: "remove enum value", next: `{"properties": {"priority": {"type": "string", "enum": ["low"]}}}`, expectedPath: "$.priority"},
+		{name: "close additional properties", next: `{"additionalProperties": false}`, expectedPath: "$"},
+		{name: "remove target", next: `{"x-valid-targets": ["notification-api"]}`, expectedPath: "x-valid-targets"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			registry := loadTestEventSchemas(t, map[string]string{
+				"content.review_requested/1.0": testReviewSchema,
+				"content.review_requested/1.1": mergeSchemaJSON(t, testReviewSchema, tt.next),
+			})
+			previous, _ := registry.Schema("content.review_requested", "1.0")
+			next, _ := registry.Schema("content.review_requested", "1.1")
+
+			// Act
+			incompatibilities := CompareEventSchemas(previous, next)
+
+			// Assert
+			if tt.expectedPath == "" {
+				assert.Empty(t, incompatibilities)
+				return
+			}
+			require.Len(t, incompatibilities, 1, "%v", incompatibilities)
+			assert.Equal(t, tt.expectedPath, incompatibilities[0].Path)
+			assert.Equal(t, "1.0", incompatibilities[0].FromVersion)
+			assert.Equal(t, "1.1", incompatibilities[0].ToVersion)
+		})
+	}
+}
+
+func TestEventSchemaRegistry_CheckCompatibility(t *testing.T) {
+	// Arrange
+	breaking := `{"required": ["content_id", "recipients", "message"]}`
+	registry := loadTestEventSchemas(t, map[string]string{
+		"content.review_requested/1.0": testReviewSchema,
+		"content.review_requested/1.1": mergeSchemaJSON(t, testReviewSchema, breaking),
+		"content.review_requested/2.0": mergeSchemaJSON(t, testReviewSchema, `{"properties": {"message": null}}`),
+	})
+	baseline := loadTestEventSchemas(t, map[string]string{
+		"content.review_requested/0.9": testReviewSchema,
+		"content.review_requested/1.0": mergeSchemaJSON(t, testReviewSchema, `{"properties": {"priority": {"type": "string"}}}`),
+	})
+
+	// Act
+	withinRegistry := registry.CheckCompatibility()
+	sinceBaseline := registry.CheckCompatibilityWith(baseline)
+
+	// Assert
+	require.Len(t, withinRegistry, 1, "a new major version may break compatibility")
+	assert.Equal(t, "1.1", withinRegistry[0].ToVersion)
+
+	require.Len(t, sinceBaseline, 2)
+	assert.Equal(t, "published schema version was removed", sinceBaseline[0].Reason)
+	assert.Equal(t, "$.priority", sinceBaseline[1].Path, "published versions cannot be narrowed in place")
+}
+
+// mergeSchemaJSON overlays patch onto a schema document, one level deep into
+// properties; a null property removes it
+func mergeSchemaJSON(t *testing.T, document, patch string) string {
+	var base, overlay map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(document), &base))
+	require.NoError(t, json.Unmarshal([]byte(patch), &overlay))
+
+	for key, value := range overlay {
+		if key != "properties" {
+			base[key] = value
+			continue
+		}
+		properties := base["properties"].(map[string]interface{})
+		for name, property := range value.(map[string]interface{}) {
+			if property == nil {
+				delete(properties, name)
+				continue
+			}
+			properties[name] = property
+		}
+	}
+
+	merged, err := json.Marshal(base)
+	require.NoError(t, err)
+	return string(merged)
+}
@@ -0,0 +1,282 @@
+package dapr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+)
+
+const (
+	eventStoreDomain      = "eventstore"
+	eventStoreEntityType  = "event"
+	eventTopicIndex       = "topic"
+	eventTypeIndex        = "type"
+	eventEntityIndex      = "entity_id"
+	eventCorrelationIndex = "correlation_id"
+	eventMonthIndex       = "month"
+
+	defaultEventQueryLimit = 100
+	maxEventQueryLimit     = 1000
+)
+
+// StoredEvent is one entry of the event history: an event as it was published to a
+// topic. The same event published to several topics, as a replay does, is stored once
+// per topic.
+type StoredEvent struct {
+	StoredID      string        `json:"stored_id"`
+	EventID       string        `json:"event_id"`
+	Topic         string        `json:"topic"`
+	Type          string        `json:"type"`
+	EntityID      string        `json:"entity_id,omitempty"`
+	CorrelationID string        `json:"correlation_id,omitempty"`
+	OccurredAt    time.Time     `json:"occurred_at"`
+	StoredAt      time.Time     `json:"stored_at"`
+	Event         *EventMessage `json:"event"`
+}
+
+// EventQuery narrows the event history. Every field set must match; From and To bound
+// the time the event occurred, inclusive.
+type EventQuery struct {
+	Topic         string    `json:"topic,omitempty"`
+	Type          string    `json:"type,omitempty"`
+	EntityID      string    `json:"entity_id,omitempty"`
+	CorrelationID string    `json:"correlation_id,omitempty"`
+	From          time.Time `json:"from,omitempty"`
+	To            time.Time `json:"to,omitempty"`
+	Limit         int       `json:"limit,omitempty"`
+}
+
+// EventReplayRequest selects history to publish again into a target topic
+type EventReplayRequest struct {
+	EventQuery
+	TargetTopic string `json:"target_topic"`
+	DryRun      bool   `json:"dry_run"`
+}
+
+// EventReplayResult reports the events a replay matched and how many were published.
+// A dry run only previews the matches.
+type EventReplayResult struct {
+	TargetTopic string         `json:"target_topic"`
+	DryRun      bool           `json:"dry_run"`
+	Matched     int            `json:"matched"`
+	Replayed    int            `json:"replayed"`
+	Events      []*StoredEvent `json:"events"`
+}
+
+// EventStore is the append-only history of published events, indexed by topic, event
+// type, entity ID, correlation ID and month so it can be searched and replayed
+type EventStore struct {
+	stateStore *StateStore
+	now        func() time.Time
+}
+
+// EventStoreIndexes declares the secondary indexes of the event history
+func EventStoreIndexes() *IndexedEntityType {
+	single := func(value string) []string {
+		if value == "" {
+			return nil
+		}
+		return []string{value}
+	}
+
+	return &IndexedEntityType{
+		Domain:     eventStoreDomain,
+		EntityType: eventStoreEntityType,
+		Indexes: []IndexDefinition{
+			{Name: eventTopicIndex, Extract: func(entity interface{}) []string { return single(entity.(*StoredEvent).Topic) }},
+			{Name: eventTypeIndex, Extract: func(entity interface{}) []string { return single(entity.(*StoredEvent).Type) }},
+			{Name: eventEntityIndex, Extract: func(entity interface{}) []string { return single(entity.(*StoredEvent).EntityID) }},
+			{Name: eventCorrelationIndex, Extract: func(entity interface{}) []string { return single(entity.(*StoredEvent).CorrelationID) }},
+			{Name: eventMonthIndex, Extract: func(entity interface{}) []string {
+				return single(IndexMonthBucket(entity.(*StoredEvent).OccurredAt))
+			}},
+		},
+		NewEntity: func() interface{} { return &StoredEvent{} },
+		EntityID:  func(entity interface{}) string { return entity.(*StoredEvent).StoredID },
+	}
+}
+
+// NewEventStore creates the event history kept in stateStore
+func NewEventStore(stateStore *StateStore) *EventStore {
+	stateStore.MustRegisterIndexes(EventStoreIndexes())
+
+	return &EventStore{
+		stateStore: stateStore,
+		now:        time.Now,
+	}
+}
+
+// Append records an event published to topic. Appending the same event to the same
+// topic again, as a publish retry does, leaves the history unchanged.
+func (es *EventStore) Append(ctx context.Context, topic string, event *EventMessage) error {
+	if topic == "" || event == nil || event.ID == "" {
+		return domain.NewValidationError("stored events require a topic and an event ID")
+	}
+
+	storedID := fmt.Sprintf("%s:%s", topic, event.ID)
+	var existing StoredEvent
+	exists, err := es.stateStore.Get(ctx, es.stateStore.CreateKey(eventStoreDomain, eventStoreEntityType, storedID), &existing)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+
+	occurredAt := event.Time.UTC()
+	if occurredAt.IsZero() {
+		occurredAt = es.now().UTC()
+	}
+
+	stored := &StoredEvent{
+		StoredID:      storedID,
+		EventID:       event.ID,
+		Topic:         topic,
+		Type:          event.Type,
+		EntityID:      eventEntityID(event),
+		CorrelationID: event.CorrelationID,
+		OccurredAt:    occurredAt,
+		StoredAt:      es.now().UTC(),
+		Event:         event,
+	}
+	return es.stateStore.SaveIndexed(ctx, eventStoreDomain, eventStoreEntityType, storedID, stored)
+}
+
+// Search returns the stored events matching query, oldest first
+func (es *EventStore) Search(ctx context.Context, query EventQuery) ([]*StoredEvent, error) {
+	if err := query.validate(); err != nil {
+		return nil, err
+	}
+
+	ids, err := es.matchingIDs(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*StoredEvent, 0, len(ids))
+	for _, id := range ids {
+		var stored StoredEvent
+		found, err := es.stateStore.Get(ctx, es.stateStore.CreateKey(eventStoreDomain, eventStoreEntityType, id), &stored)
+		if err != nil {
+			return nil, err
+		}
+		if !found || !query.covers(stored.OccurredAt) {
+			continue
+		}
+		events = append(events, &stored)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].OccurredAt.Equal(events[j].OccurredAt) {
+			return events[i].StoredID < events[j].StoredID
+		}
+		return events[i].OccurredAt.Before(events[j].OccurredAt)
+	})
+	if limit := query.limit(); len(events) > limit {
+		events = events[:limit]
+	}
+	return events, nil
+}
+
+// GetEventsInTimeRange returns the events published to topic between startTime and endTime
+func (es *EventStore) GetEventsInTimeRange(ctx context.Context, topic string, startTime, endTime time.Time) ([]*StoredEvent, error) {
+	return es.Search(ctx, EventQuery{Topic: topic, From: startTime, To: endTime, Limit: maxEventQueryLimit})
+}
+
+// matchingIDs intersects the index lookups for every filter the query sets
+func (es *EventStore) matchingIDs(ctx context.Context, query EventQuery) ([]string, error) {
+	var conditions []IndexCondition
+	for index, value := range map[string]string{
+		eventTopicIndex:       query.Topic,
+		eventTypeIndex:        query.Type,
+		eventEntityIndex:      query.EntityID,
+		eventCorrelationIndex: query.CorrelationID,
+	} {
+		if value != "" {
+			conditions = append(conditions, IndexCondition{Index: index, Value: value})
+		}
+	}
+
+	var ids []string
+	if len(conditions) > 0 {
+		matched, err := es.stateStore.LookupIndex(ctx, eventStoreDomain, eventStoreEntityType, conditions...)
+		if err != nil {
+			return nil, err
+		}
+		ids = matched
+	}
+
+	if query.From.IsZero() {
+		return ids, nil
+	}
+
+	to := query.To
+	if to.IsZero() {
+		to = es.now()
+	}
+	inRange, err := es.stateStore.LookupIndexAny(ctx, eventStoreDomain, eventStoreEntityType, eventMonthIndex, IndexMonthBuckets(query.From, to))
+	if err != nil {
+		return nil, err
+	}
+	if len(conditions) == 0 {
+		return inRange, nil
+	}
+	return intersectSorted(ids, inRange), nil
+}
+
+func (q EventQuery) validate() error {
+	if q.Topic == "" && q.Type == "" && q.EntityID == "" && q.CorrelationID == "" && q.From.IsZero() {
+		return domain.NewValidationError("event search requires a topic, type, entity ID, correlation ID or start time")
+	}
+	if !q.From.IsZero() && !q.To.IsZero() && q.To.Before(q.From) {
+		return domain.NewValidationError("event search end time is before its start time")
+	}
+	if q.Limit < 0 || q.Limit > maxEventQueryLimit {
+		return domain.NewValidationError(fmt.Sprintf("event search limit cannot exceed %d", maxEventQueryLimit))
+	}
+	return nil
+}
+
+func (q EventQuery) covers(occurredAt time.Time) bool {
+	if !q.From.IsZero() && occurredAt.Before(q.From) {
+		return false
+	}
+	if !q.To.IsZero() && occurredAt.After(q.To) {
+		return false
+	}
+	return true
+}
+
+func (q EventQuery) limit() int {
+	if q.Limit == 0 {
+		return defaultEventQueryLimit
+	}
+	return q.Limit
+}
+
+// eventEntityID finds the entity an event is about. Audit events carry it in their
+// payload, directly or nested under audit_event, and otherwise in a type/id subject.
+func eventEntityID(event *EventMessage) string {
+	if entityID, ok := event.Data["entity_id"].(string); ok && entityID != "" {
+		return entityID
+	}
+
+	if nested, ok := event.Data["audit_event"]; ok {
+		var audit struct {
+			EntityID string `json:"entity_id"`
+		}
+		if encoded, err := json.Marshal(nested); err == nil && json.Unmarshal(encoded, &audit) == nil && audit.EntityID != "" {
+			return audit.EntityID
+		}
+	}
+
+	if _, entityID, found := strings.Cut(event.Subject, "/"); found {
+		return entityID
+	}
+	return ""
+}
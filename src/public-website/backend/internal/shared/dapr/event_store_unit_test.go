@@ -0,0 +1,109 @@
+package dapr
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEventStore() *EventStore {
+	return NewEventStore(NewStateStoreWithBackend(NewMemoryStateBackend()))
+}
+
+// seedEventHistory appends three audit events and one notification across two months
+func seedEventHistory(t *testing.T, eventStore *EventStore) {
+	ctx := context.Background()
+	start := time.Date(2026, 9, 30, 12, 0, 0, 0, time.UTC)
+	events := []struct {
+		topic string
+		event *EventMessage
+	}{
+		{"audit-events", &EventMessage{ID: "event-1", Type: "audit.event", CorrelationID: "request-1", Time: start,
+			Data: map[string]interface{}{"entity_id": "news-1"}}},
+		{"audit-events", &EventMessage{ID: "event-2", Type: "audit.event", CorrelationID: "request-2", Time: start.Add(24 * time.Hour),
+			Subject: "research/research-1"}},
+		{"audit-events", &EventMessage{ID: "event-3", Type: "audit.event", CorrelationID: "request-1", Time: start.Add(48 * time.Hour),
+			Data: map[string]interface{}{"audit_event": map[string]interface{}{"entity_id": "news-1"}}}},
+		{"notification-events", &EventMessage{ID: "event-4", Type: "notification.sent", CorrelationID: "request-1", Time: start.Add(72 * time.Hour)}},
+	}
+	for _, seeded := range events {
+		require.NoError(t, eventStore.Append(ctx, seeded.topic, seeded.event))
+	}
+}
+
+func storedEventIDs(events []*StoredEvent) []string {
+	ids := make([]string, 0, len(events))
+	for _, event := range events {
+		ids = append(ids, event.EventID)
+	}
+	return ids
+}
+
+func TestEventStore_Search(t *testing.T) {
+	start := time.Date(2026, 9, 30, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		query         EventQuery
+		expectedIDs   []string
+		expectedError bool
+	}{
+		{name: "by topic", query: EventQuery{Topic: "audit-events"}, expectedIDs: []string{"event-1", "event-2", "event-3"}},
+		{name: "by type", query: EventQuery{Type: "notification.sent"}, expectedIDs: []string{"event-4"}},
+		{name: "by entity from payload or nested audit event", query: EventQuery{EntityID: "news-1"}, expectedIDs: []string{"event-1", "event-3"}},
+		{name: "by entity from subject", query: EventQuery{EntityID: "research-1"}, expectedIDs: []string{"event-2"}},
+		{name: "by correlation ID across topics", query: EventQuery{CorrelationID: "request-1"}, expectedIDs: []string{"event-1", "event-3", "event-4"}},
+		{name: "by topic and correlation ID", query: EventQuery{Topic: "audit-events", CorrelationID: "request-1"}, expectedIDs: []string{"event-1", "event-3"}},
+		{name: "by time range alone", query: EventQuery{From: start.Add(time.Hour), To: start.Add(60 * time.Hour)}, expectedIDs: []string{"event-2", "event-3"}},
+		{name: "by topic and time range", query: EventQuery{Topic: "audit-events", From: start.Add(24 * time.Hour)}, expectedIDs: []string{"event-2", "event-3"}},
+		{name: "with limit", query: EventQuery{Topic: "audit-events", Limit: 2}, expectedIDs: []string{"event-1", "event-2"}},
+		{name: "no match", query: EventQuery{Topic: "missing"}, expectedIDs: []string{}},
+		{name: "reject unfiltered search", query: EventQuery{}, expectedError: true},
+		{name: "reject inverted time range", query: EventQuery{Topic: "audit-events", From: start, To: start.Add(-time.Hour)}, expectedError: true},
+		{name: "reject excessive limit", query: EventQuery{Topic: "audit-events", Limit: maxEventQueryLimit + 1}, expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			eventStore := newTestEventStore()
+			eventStore.now = func() time.Time { return start.Add(30 * 24 * time.Hour) }
+			seedEventHistory(t, eventStore)
+
+			// Act
+			events, err := eventStore.Search(context.Background(), tt.query)
+
+			// Assert
+			if tt.expectedError {
+				assert.True(t, domain.IsValidationError(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedIDs, storedEventIDs(events))
+		})
+	}
+}
+
+func TestEventStore_AppendIsIdempotentPerTopic(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	eventStore := newTestEventStore()
+	event := &EventMessage{ID: "event-1", Type: "audit.event", Time: time.Now()}
+
+	// Act
+	require.NoError(t, eventStore.Append(ctx, "audit-events", event))
+	require.NoError(t, eventStore.Append(ctx, "audit-events", event))
+	require.NoError(t, eventStore.Append(ctx, "audit-events-replay", event))
+	err := eventStore.Append(ctx, "audit-events", &EventMessage{Type: "audit.event"})
+
+	// Assert
+	assert.True(t, domain.IsValidationError(err), "events without an ID cannot be stored")
+	stored, searchErr := eventStore.Search(ctx, EventQuery{Type: "audit.event"})
+	require.NoError(t, searchErr)
+	require.Len(t, stored, 2, "a retried publish is stored once, a replay to another topic again")
+	assert.ElementsMatch(t, []string{"audit-events", "audit-events-replay"}, []string{stored[0].Topic, stored[1].Topic})
+}
@@ -0,0 +1,107 @@
+package dapr
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/gorilla/mux"
+)
+
+// FeatureFlagAdminHandler serves the admin API for managing feature flags and
+// checking how they evaluate
+type FeatureFlagAdminHandler struct {
+	flags *FeatureFlagService
+}
+
+// NewFeatureFlagAdminHandler creates the feature flag admin API for a flag service
+func NewFeatureFlagAdminHandler(flags *FeatureFlagService) *FeatureFlagAdminHandler {
+	return &FeatureFlagAdminHandler{flags: flags}
+}
+
+// RegisterRoutes registers the feature flag routes on a router mounted at the admin API root
+func (h *FeatureFlagAdminHandler) RegisterRoutes(router *mux.Router) {
+	router.HandleFunc("/feature-flags", h.ListFeatureFlags).Methods("GET")
+	router.HandleFunc("/feature-flags/evaluate", h.EvaluateFeatureFlags).Methods("POST")
+	router.HandleFunc("/feature-flags/{name}", h.GetFeatureFlag).Methods("GET")
+	router.HandleFunc("/feature-flags/{name}", h.SaveFeatureFlag).Methods("PUT")
+	router.HandleFunc("/feature-flags/{name}", h.DeleteFeatureFlag).Methods("DELETE")
+}
+
+// ListFeatureFlags lists every flag in effect with where it is defined
+func (h *FeatureFlagAdminHandler) ListFeatureFlags(w http.ResponseWriter, r *http.Request) {
+	flags := h.flags.Flags()
+	writeAdminResponse(w, r, http.StatusOK, map[string]interface{}{
+		"data":  flags,
+		"count": len(flags),
+	})
+}
+
+// GetFeatureFlag returns one flag
+func (h *FeatureFlagAdminHandler) GetFeatureFlag(w http.ResponseWriter, r *http.Request) {
+	flag, err := h.flags.Get(mux.Vars(r)["name"])
+	if err != nil {
+		writeAdminError(w, r, err)
+		return
+	}
+
+	writeAdminResponse(w, r, http.StatusOK, map[string]interface{}{"data": flag})
+}
+
+// SaveFeatureFlag creates or replaces the admin flag named in the path
+func (h *FeatureFlagAdminHandler) SaveFeatureFlag(w http.ResponseWriter, r *http.Request) {
+	var flag FeatureFlag
+	if err := json.NewDecoder(r.Body).Decode(&flag); err != nil {
+		writeAdminError(w, r, domain.NewValidationError("invalid request body"))
+		return
+	}
+
+	name := mux.Vars(r)["name"]
+	if flag.Name != "" && flag.Name != name {
+		writeAdminError(w, r, domain.NewValidationError("feature flag name does not match the path"))
+		return
+	}
+	flag.Name = name
+
+	saved, err := h.flags.Save(r.Context(), &flag)
+	if err != nil {
+		writeAdminError(w, r, err)
+		return
+	}
+
+	writeAdminResponse(w, r, http.StatusOK, map[string]interface{}{"data": saved})
+}
+
+// DeleteFeatureFlag removes an admin flag
+func (h *FeatureFlagAdminHandler) DeleteFeatureFlag(w http.ResponseWriter, r *http.Request) {
+	if err := h.flags.Delete(r.Context(), mux.Vars(r)["name"]); err != nil {
+		writeAdminError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// EvaluateFeatureFlags explains how every flag evaluates for the subject in the body,
+// for checking a rollout before relying on it
+func (h *FeatureFlagAdminHandler) EvaluateFeatureFlags(w http.ResponseWriter, r *http.Request) {
+	var subject FeatureFlagSubject
+	if err := json.NewDecoder(r.Body).Decode(&subject); err != nil {
+		writeAdminError(w, r, domain.NewValidationError("invalid request body"))
+		return
+	}
+	if subject.Environment == "" {
+		subject.Environment = h.flags.environment
+	}
+
+	flags := h.flags.Flags()
+	evaluations := make([]FeatureFlagEvaluation, 0, len(flags))
+	for _, flag := range flags {
+		evaluations = append(evaluations, flag.Evaluate(subject))
+	}
+
+	writeAdminResponse(w, r, http.StatusOK, map[string]interface{}{
+		"data":  evaluations,
+		"count": len(evaluations),
+	})
+}
@@ -0,0 +1,100 @@
+package dapr
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeatureFlagAdminHandler(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		body           string
+		expectedStatus int
+		expectedCode   string
+		expectedCount  int
+		expectedFlags  int
+	}{
+		{name: "list flags", method: http.MethodGet, path: "/admin/api/v1/feature-flags", expectedStatus: http.StatusOK, expectedCount: 2, expectedFlags: 2},
+		{name: "get flag", method: http.MethodGet, path: "/admin/api/v1/feature-flags/new-search", expectedStatus: http.StatusOK, expectedFlags: 2},
+		{name: "get missing flag", method: http.MethodGet, path: "/admin/api/v1/feature-flags/missing", expectedStatus: http.StatusNotFound, expectedCode: "NOT_FOUND", expectedFlags: 2},
+		{name: "create flag", method: http.MethodPut, path: "/admin/api/v1/feature-flags/donations-v2",
+			body: `{"type":"percentage","enabled":true,"percentage":10}`, expectedStatus: http.StatusOK, expectedFlags: 3},
+		{name: "reject mismatched name", method: http.MethodPut, path: "/admin/api/v1/feature-flags/donations-v2",
+			body: `{"name":"other","type":"boolean"}`, expectedStatus: http.StatusBadRequest, expectedCode: "VALIDATION_ERROR", expectedFlags: 2},
+		{name: "reject invalid flag", method: http.MethodPut, path: "/admin/api/v1/feature-flags/donations-v2",
+			body: `{"type":"targeted","enabled":true}`, expectedStatus: http.StatusBadRequest, expectedCode: "VALIDATION_ERROR", expectedFlags: 2},
+		{name: "delete admin flag", method: http.MethodDelete, path: "/admin/api/v1/feature-flags/editor-tools", expectedStatus: http.StatusNoContent, expectedFlags: 1},
+		{name: "delete configuration flag", method: http.MethodDelete, path: "/admin/api/v1/feature-flags/new-search", expectedStatus: http.StatusNotFound, expectedCode: "NOT_FOUND", expectedFlags: 2},
+		{name: "evaluate for subject", method: http.MethodPost, path: "/admin/api/v1/feature-flags/evaluate",
+			body: `{"user_id":"user-1","roles":["editor"]}`, expectedStatus: http.StatusOK, expectedCount: 2, expectedFlags: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			flags := newTestFeatureFlagService(t, `[{"name":"new-search","type":"boolean","enabled":true}]`)
+			_, err := flags.Save(httptest.NewRequest(http.MethodGet, "/", nil).Context(), &FeatureFlag{Name: "editor-tools", Type: FeatureFlagTypeTargeted, Enabled: true,
+				Rules: []FeatureFlagRule{{Attribute: FeatureFlagAttributeRole, Values: []string{"editor"}}}})
+			require.NoError(t, err)
+
+			router := mux.NewRouter()
+			NewFeatureFlagAdminHandler(flags).RegisterRoutes(router.PathPrefix("/admin/api/v1").Subrouter())
+			recorder := httptest.NewRecorder()
+
+			// Act
+			router.ServeHTTP(recorder, httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body)))
+
+			// Assert
+			require.Equal(t, tt.expectedStatus, recorder.Code, recorder.Body.String())
+			assert.Len(t, flags.Flags(), tt.expectedFlags)
+			if recorder.Code == http.StatusNoContent {
+				return
+			}
+
+			var response struct {
+				Data  json.RawMessage `json:"data"`
+				Count int             `json:"count"`
+				Error struct {
+					Code string `json:"code"`
+				} `json:"error"`
+			}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+			assert.Equal(t, tt.expectedCode, response.Error.Code)
+			assert.Equal(t, tt.expectedCount, response.Count)
+		})
+	}
+}
+
+func TestFeatureFlagAdminHandler_EvaluateExplainsEachFlag(t *testing.T) {
+	// Arrange
+	flags := newTestFeatureFlagService(t, `[
+		{"name":"editor-tools","type":"targeted","enabled":true,"rules":[{"attribute":"environment","values":["production"]}]},
+		{"name":"new-search","type":"boolean","enabled":false}
+	]`)
+	router := mux.NewRouter()
+	NewFeatureFlagAdminHandler(flags).RegisterRoutes(router)
+	recorder := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/feature-flags/evaluate", strings.NewReader(`{"user_id":"user-1"}`)))
+
+	// Assert
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+	var response struct {
+		Data []FeatureFlagEvaluation `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, []FeatureFlagEvaluation{
+		{Flag: "editor-tools", Reason: FeatureFlagReasonNotTargeted},
+		{Flag: "new-search", Reason: FeatureFlagReasonDisabled},
+	}, response.Data, "the service environment is used when the subject names none")
+}
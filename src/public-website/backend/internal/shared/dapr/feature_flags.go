@@ -0,0 +1,568 @@
+package dapr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+const (
+	featureFlagDomain     = "flags"
+	featureFlagEntityType = "flag"
+	featureFlagTypeIndex  = "type"
+
+	// FeatureFlagsConfigKey holds a JSON array of flag definitions in the configuration store
+	FeatureFlagsConfigKey = "feature_flags"
+
+	defaultFeatureFlagCacheTTL        = 30 * time.Second
+	defaultFeatureFlagRefreshInterval = 30 * time.Second
+	maxCachedFeatureFlagSubjects      = 10000
+)
+
+// FeatureFlagType selects how a flag decides who it is on for
+type FeatureFlagType string
+
+const (
+	// FeatureFlagTypeBoolean is on for everyone while enabled
+	FeatureFlagTypeBoolean FeatureFlagType = "boolean"
+	// FeatureFlagTypePercentage is on for a stable share of users
+	FeatureFlagTypePercentage FeatureFlagType = "percentage"
+	// FeatureFlagTypeTargeted is on for requests matching all of its rules
+	FeatureFlagTypeTargeted FeatureFlagType = "targeted"
+)
+
+// FeatureFlagSource records where a flag is defined. Flags managed through the admin
+// API override configuration store flags of the same name.
+type FeatureFlagSource string
+
+const (
+	FeatureFlagSourceAdmin         FeatureFlagSource = "admin"
+	FeatureFlagSourceConfiguration FeatureFlagSource = "configuration"
+)
+
+// Request attributes a flag can target
+const (
+	FeatureFlagAttributeEnvironment = "environment"
+	FeatureFlagAttributeRole        = "role"
+	FeatureFlagAttributeGatewayType = "gateway_type"
+)
+
+// Reasons reported with an evaluation
+const (
+	FeatureFlagReasonUnknown     = "unknown_flag"
+	FeatureFlagReasonDisabled    = "disabled"
+	FeatureFlagReasonEnabled     = "enabled"
+	FeatureFlagReasonNotTargeted = "not_targeted"
+	FeatureFlagReasonInRollout   = "in_rollout"
+	FeatureFlagReasonOutRollout  = "outside_rollout"
+)
+
+var featureFlagNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_.-]{0,63}$`)
+
+// FeatureFlagRule matches requests whose attribute has one of the listed values
+type FeatureFlagRule struct {
+	Attribute string   `json:"attribute"`
+	Values    []string `json:"values"`
+}
+
+// FeatureFlag switches a feature on or off without a deployment
+type FeatureFlag struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Type        FeatureFlagType   `json:"type"`
+	Enabled     bool              `json:"enabled"`
+	Percentage  int               `json:"percentage,omitempty"`
+	Rules       []FeatureFlagRule `json:"rules,omitempty"`
+	Source      FeatureFlagSource `json:"source"`
+	Version     int               `json:"version,omitempty"`
+	UpdatedAt   time.Time         `json:"updated_at,omitempty"`
+	UpdatedBy   string            `json:"updated_by,omitempty"`
+}
+
+// Validate checks that a flag can be evaluated
+func (f *FeatureFlag) Validate() error {
+	if !featureFlagNamePattern.MatchString(f.Name) {
+		return domain.NewValidationError(fmt.Sprintf("feature flag name %q must be lower case letters, digits, '.', '_' or '-'", f.Name))
+	}
+
+	switch f.Type {
+	case FeatureFlagTypeBoolean:
+	case FeatureFlagTypePercentage:
+		if f.Percentage < 0 || f.Percentage > 100 {
+			return domain.NewValidationError(fmt.Sprintf("feature flag %s percentage must be between 0 and 100", f.Name))
+		}
+	case FeatureFlagTypeTargeted:
+		if len(f.Rules) == 0 {
+			return domain.NewValidationError(fmt.Sprintf("targeted feature flag %s needs at least one rule", f.Name))
+		}
+	default:
+		return domain.NewValidationError(fmt.Sprintf("feature flag %s has unknown type %q", f.Name, f.Type))
+	}
+
+	for _, rule := range f.Rules {
+		switch rule.Attribute {
+		case FeatureFlagAttributeEnvironment, FeatureFlagAttributeRole, FeatureFlagAttributeGatewayType:
+		default:
+			return domain.NewValidationError(fmt.Sprintf("feature flag %s targets unknown attribute %q", f.Name, rule.Attribute))
+		}
+		if len(rule.Values) == 0 {
+			return domain.NewValidationError(fmt.Sprintf("feature flag %s rule on %s lists no values", f.Name, rule.Attribute))
+		}
+	}
+	return nil
+}
+
+// FeatureFlagSubject describes the request a flag is evaluated for
+type FeatureFlagSubject struct {
+	UserID      string   `json:"user_id,omitempty"`
+	Roles       []string `json:"roles,omitempty"`
+	Environment string   `json:"environment,omitempty"`
+	GatewayType string   `json:"gateway_type,omitempty"`
+}
+
+func (s FeatureFlagSubject) attribute(name string) []string {
+	switch name {
+	case FeatureFlagAttributeEnvironment:
+		return []string{s.Environment}
+	case FeatureFlagAttributeRole:
+		return s.Roles
+	case FeatureFlagAttributeGatewayType:
+		return []string{s.GatewayType}
+	}
+	return nil
+}
+
+// cacheKey identifies subjects that every flag evaluates the same way
+func (s FeatureFlagSubject) cacheKey() string {
+	roles := append([]string(nil), s.Roles...)
+	sort.Strings(roles)
+	return strings.Join([]string{s.UserID, strings.Join(roles, ","), s.Environment, s.GatewayType}, "|")
+}
+
+// FeatureFlagEvaluation is the outcome of one flag for one subject
+type FeatureFlagEvaluation struct {
+	Flag    string `json:"flag"`
+	Enabled bool   `json:"enabled"`
+	Reason  string `json:"reason"`
+}
+
+// Evaluate decides whether flag is on for subject. Rules narrow every flag type.
+// Percentage rollouts bucket users by a hash of the flag name and user ID, so a user
+// keeps the same answer as the percentage grows; requests without a user are outside
+// any partial rollout.
+func (f *FeatureFlag) Evaluate(subject FeatureFlagSubject) FeatureFlagEvaluation {
+	evaluation := FeatureFlagEvaluation{Flag: f.Name}
+	if !f.Enabled {
+		evaluation.Reason = FeatureFlagReasonDisabled
+		return evaluation
+	}
+
+	for _, rule := range f.Rules {
+		if !ruleMatches(rule, subject) {
+			evaluation.Reason = FeatureFlagReasonNotTargeted
+			return evaluation
+		}
+	}
+
+	if f.Type == FeatureFlagTypePercentage && f.Percentage < 100 {
+		if subject.UserID == "" || rolloutBucket(f.Name, subject.UserID) >= f.Percentage {
+			evaluation.Reason = FeatureFlagReasonOutRollout
+			return evaluation
+		}
+		evaluation.Enabled, evaluation.Reason = true, FeatureFlagReasonInRollout
+		return evaluation
+	}
+
+	evaluation.Enabled, evaluation.Reason = true, FeatureFlagReasonEnabled
+	return evaluation
+}
+
+func ruleMatches(rule FeatureFlagRule, subject FeatureFlagSubject) bool {
+	for _, actual := range subject.attribute(rule.Attribute) {
+		for _, value := range rule.Values {
+			if actual != "" && strings.EqualFold(actual, value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// rolloutBucket places a user in one of 100 buckets for a flag
+func rolloutBucket(flagName, userID string) int {
+	hash := fnv.New32a()
+	hash.Write([]byte(flagName + ":" + userID))
+	return int(hash.Sum32() % 100)
+}
+
+// FeatureFlagSet holds the flags evaluated for a request by name
+type FeatureFlagSet map[string]bool
+
+type featureFlagsContextKey struct{}
+
+// WithFeatureFlags attaches the flags evaluated for a request to ctx
+func WithFeatureFlags(ctx context.Context, flags FeatureFlagSet) context.Context {
+	return context.WithValue(ctx, featureFlagsContextKey{}, flags)
+}
+
+// FeatureFlagsFromContext returns the flags evaluated for the request, if any
+func FeatureFlagsFromContext(ctx context.Context) FeatureFlagSet {
+	flags, _ := ctx.Value(featureFlagsContextKey{}).(FeatureFlagSet)
+	return flags
+}
+
+// FeatureEnabled reports whether a flag is on for the request. Unknown flags are off.
+func FeatureEnabled(ctx context.Context, name string) bool {
+	return FeatureFlagsFromContext(ctx)[name]
+}
+
+type cachedFeatureFlags struct {
+	flags     FeatureFlagSet
+	expiresAt time.Time
+}
+
+// FeatureFlagService evaluates feature flags defined in the configuration store and
+// through the admin API. Admin flags are kept in the state store, audited through the
+// outbox and picked up by other instances on their next refresh. Evaluations are
+// cached per subject until the TTL passes or any flag changes.
+type FeatureFlagService struct {
+	stateStore      *StateStore
+	pubsub          *PubSub
+	environment     string
+	cacheTTL        time.Duration
+	refreshInterval time.Duration
+	now             func() time.Time
+
+	mutex       sync.RWMutex
+	adminFlags  map[string]*FeatureFlag
+	configFlags map[string]*FeatureFlag
+	cache       map[string]*cachedFeatureFlags
+}
+
+// FeatureFlagIndexes keeps admin flags findable by type
+func FeatureFlagIndexes() *IndexedEntityType {
+	return &IndexedEntityType{
+		Domain:     featureFlagDomain,
+		EntityType: featureFlagEntityType,
+		Indexes: []IndexDefinition{
+			{Name: featureFlagTypeIndex, Extract: func(entity interface{}) []string { return []string{string(entity.(*FeatureFlag).Type)} }},
+		},
+		NewEntity: func() interface{} { return &FeatureFlag{} },
+		EntityID:  func(entity interface{}) string { return entity.(*FeatureFlag).Name },
+	}
+}
+
+// NewFeatureFlagService creates a feature flag service for a service running in
+// environment, keeping admin flags in stateStore and auditing changes on pubsub
+func NewFeatureFlagService(stateStore *StateStore, pubsub *PubSub, environment string) *FeatureFlagService {
+	stateStore.MustRegisterIndexes(FeatureFlagIndexes())
+	stateStore.MustRegisterIndexes(OutboxMessageIndexes())
+
+	return &FeatureFlagService{
+		stateStore:      stateStore,
+		pubsub:          pubsub,
+		environment:     environment,
+		cacheTTL:        parseDurationEnv("FEATURE_FLAG_CACHE_TTL", defaultFeatureFlagCacheTTL),
+		refreshInterval: parseDurationEnv("FEATURE_FLAG_REFRESH_INTERVAL", defaultFeatureFlagRefreshInterval),
+		now:             time.Now,
+		adminFlags:      map[string]*FeatureFlag{},
+		configFlags:     map[string]*FeatureFlag{},
+		cache:           map[string]*cachedFeatureFlags{},
+	}
+}
+
+// ConfigListener loads the flags defined under FeatureFlagsConfigKey. A definition
+// that does not parse or validate rejects the whole change.
+func (s *FeatureFlagService) ConfigListener() ConfigListener {
+	return ConfigListener{
+		Name: "feature-flags",
+		Keys: []string{FeatureFlagsConfigKey},
+		Prepare: func(values ConfigValues) (func(), error) {
+			flags := map[string]*FeatureFlag{}
+			if raw := values.String(FeatureFlagsConfigKey, ""); raw != "" {
+				var definitions []*FeatureFlag
+				if err := json.Unmarshal([]byte(raw), &definitions); err != nil {
+					return nil, domain.NewValidationError(fmt.Sprintf("configuration %s must be a JSON array of flags: %v", FeatureFlagsConfigKey, err))
+				}
+				for _, flag := range definitions {
+					if err := flag.Validate(); err != nil {
+						return nil, err
+					}
+					if _, duplicate := flags[flag.Name]; duplicate {
+						return nil, domain.NewValidationError(fmt.Sprintf("feature flag %s is defined twice", flag.Name))
+					}
+					flag.Source = FeatureFlagSourceConfiguration
+					flags[flag.Name] = flag
+				}
+			}
+
+			return func() {
+				s.mutex.Lock()
+				defer s.mutex.Unlock()
+				s.configFlags = flags
+				s.cache = map[string]*cachedFeatureFlags{}
+			}, nil
+		},
+	}
+}
+
+// Refresh reloads the admin flags from the state store
+func (s *FeatureFlagService) Refresh(ctx context.Context) error {
+	ids, err := s.stateStore.LookupIndexAny(ctx, featureFlagDomain, featureFlagEntityType, featureFlagTypeIndex,
+		[]string{string(FeatureFlagTypeBoolean), string(FeatureFlagTypePercentage), string(FeatureFlagTypeTargeted)})
+	if err != nil {
+		return fmt.Errorf("failed to list feature flags: %w", err)
+	}
+
+	flags := make(map[string]*FeatureFlag, len(ids))
+	for _, id := range ids {
+		flag, _, err := s.load(ctx, id)
+		if err != nil {
+			if domain.IsNotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		flags[flag.Name] = flag
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.adminFlags = flags
+	s.cache = map[string]*cachedFeatureFlags{}
+	return nil
+}
+
+// RunRefresh reloads the admin flags until ctx is cancelled, so changes made through
+// another instance take effect here
+func (s *FeatureFlagService) RunRefresh(ctx context.Context) {
+	ticker := time.NewTicker(s.refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Refresh(ctx); err != nil {
+			log.Printf("Feature flag refresh failed, keeping the previous flags: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Flags returns every flag in effect, ordered by name
+func (s *FeatureFlagService) Flags() []*FeatureFlag {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	merged := make(map[string]*FeatureFlag, len(s.configFlags)+len(s.adminFlags))
+	for name, flag := range s.configFlags {
+		merged[name] = flag
+	}
+	for name, flag := range s.adminFlags {
+		merged[name] = flag
+	}
+
+	flags := make([]*FeatureFlag, 0, len(merged))
+	for _, flag := range merged {
+		copied := *flag
+		flags = append(flags, &copied)
+	}
+	sort.Slice(flags, func(i, j int) bool { return flags[i].Name < flags[j].Name })
+	return flags
+}
+
+// Get returns the flag in effect under name
+func (s *FeatureFlagService) Get(name string) (*FeatureFlag, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	flag := s.flag(name)
+	if flag == nil {
+		return nil, domain.NewNotFoundError("feature flag", name)
+	}
+	copied := *flag
+	return &copied, nil
+}
+
+// Save creates or replaces an admin flag. It overrides a configuration store flag of
+// the same name until it is deleted.
+func (s *FeatureFlagService) Save(ctx context.Context, flag *FeatureFlag) (*FeatureFlag, error) {
+	if err := flag.Validate(); err != nil {
+		return nil, err
+	}
+
+	existing, etag, err := s.load(ctx, flag.Name)
+	if err != nil && !domain.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	saved := *flag
+	saved.Source = FeatureFlagSourceAdmin
+	saved.UpdatedAt = s.now().UTC()
+	saved.UpdatedBy = domain.GetUserID(ctx)
+	saved.Version = 1
+	operation := domain.AuditEventInsert
+	var before interface{}
+	if existing != nil {
+		saved.Version = existing.Version + 1
+		operation = domain.AuditEventUpdate
+		before = existing
+	}
+
+	messages, err := s.auditMessages(ctx, saved.Name, operation, before, &saved)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.stateStore.SaveIndexedWithOutbox(ctx, featureFlagDomain, featureFlagEntityType, saved.Name, &saved, etag, messages...); err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	stored := saved
+	s.adminFlags[saved.Name] = &stored
+	s.cache = map[string]*cachedFeatureFlags{}
+	return &saved, nil
+}
+
+// Delete removes an admin flag. A configuration store flag of the same name takes
+// effect again; flags defined only in the configuration store cannot be deleted here.
+func (s *FeatureFlagService) Delete(ctx context.Context, name string) error {
+	existing, _, err := s.load(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	messages, err := s.auditMessages(ctx, name, domain.AuditEventDelete, existing, nil)
+	if err != nil {
+		return err
+	}
+	if err := s.stateStore.DeleteIndexedWithOutbox(ctx, featureFlagDomain, featureFlagEntityType, name, messages...); err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.adminFlags, name)
+	s.cache = map[string]*cachedFeatureFlags{}
+	return nil
+}
+
+// Evaluate explains whether one flag is on for subject
+func (s *FeatureFlagService) Evaluate(name string, subject FeatureFlagSubject) FeatureFlagEvaluation {
+	s.mutex.RLock()
+	flag := s.flag(name)
+	s.mutex.RUnlock()
+
+	if flag == nil {
+		return FeatureFlagEvaluation{Flag: name, Reason: FeatureFlagReasonUnknown}
+	}
+	return flag.Evaluate(subject)
+}
+
+// EvaluateAll returns every flag evaluated for subject, from the cache when possible
+func (s *FeatureFlagService) EvaluateAll(subject FeatureFlagSubject) FeatureFlagSet {
+	key := subject.cacheKey()
+	now := s.now()
+
+	s.mutex.RLock()
+	cached, ok := s.cache[key]
+	s.mutex.RUnlock()
+	if ok && now.Before(cached.expiresAt) {
+		return cached.flags
+	}
+
+	evaluated := FeatureFlagSet{}
+	for _, flag := range s.Flags() {
+		evaluated[flag.Name] = flag.Evaluate(subject).Enabled
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if len(s.cache) >= maxCachedFeatureFlagSubjects {
+		s.cache = map[string]*cachedFeatureFlags{}
+	}
+	s.cache[key] = &cachedFeatureFlags{flags: evaluated, expiresAt: now.Add(s.cacheTTL)}
+	return evaluated
+}
+
+// Subject describes a request forwarded by the gateway for flag evaluation
+func (s *FeatureFlagService) Subject(r *http.Request) FeatureFlagSubject {
+	subject := FeatureFlagSubject{
+		UserID:      r.Header.Get("X-User-ID"),
+		Environment: s.environment,
+		GatewayType: r.Header.Get("X-Gateway-Type"),
+	}
+	for _, role := range strings.Split(r.Header.Get("X-User-Roles"), ",") {
+		if role = strings.TrimSpace(role); role != "" {
+			subject.Roles = append(subject.Roles, role)
+		}
+	}
+	return subject
+}
+
+// Middleware evaluates every flag for the request and attaches the results to its
+// context, where handlers read them with FeatureEnabled
+func (s *FeatureFlagService) Middleware() mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			flags := s.EvaluateAll(s.Subject(r))
+			next.ServeHTTP(w, r.WithContext(WithFeatureFlags(r.Context(), flags)))
+		})
+	}
+}
+
+// flag returns the flag in effect under name; callers hold the mutex
+func (s *FeatureFlagService) flag(name string) *FeatureFlag {
+	if flag, ok := s.adminFlags[name]; ok {
+		return flag
+	}
+	return s.configFlags[name]
+}
+
+// auditMessages records a flag change for the audit trail
+func (s *FeatureFlagService) auditMessages(ctx context.Context, name string, operation domain.AuditEventType, before, after interface{}) ([]*OutboxMessage, error) {
+	message, err := s.pubsub.AuditEventMessage(&AuditEvent{
+		AuditID:       uuid.New().String(),
+		EntityType:    string(domain.EntityTypeFeatureFlag),
+		EntityID:      name,
+		OperationType: string(operation),
+		AuditTime:     s.now().UTC(),
+		UserID:        domain.GetUserID(ctx),
+		CorrelationID: domain.GetCorrelationID(ctx),
+		TraceID:       domain.GetTraceID(ctx),
+		DataSnapshot:  map[string]interface{}{"before": before, "after": after},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare audit event for feature flag %s: %w", name, err)
+	}
+
+	return []*OutboxMessage{NewOutboxMessage(ctx, message.Topic, message)}, nil
+}
+
+func (s *FeatureFlagService) load(ctx context.Context, name string) (*FeatureFlag, string, error) {
+	var flag FeatureFlag
+	found, etag, err := s.stateStore.GetWithETag(ctx, s.stateStore.CreateKey(featureFlagDomain, featureFlagEntityType, name), &flag)
+	if err != nil {
+		return nil, "", err
+	}
+	if !found {
+		return nil, "", domain.NewNotFoundError("feature flag", name)
+	}
+	return &flag, etag, nil
+}
@@ -0,0 +1,217 @@
+package dapr
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestFeatureFlagService(t *testing.T, configFlags string) *FeatureFlagService {
+	flags := NewFeatureFlagService(NewStateStoreWithBackend(NewMemoryStateBackend()), NewPubSub(&Client{appID: "content-api"}), "staging")
+	flags.now = func() time.Time { return time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC) }
+
+	reloader := NewConfigReloader(nil)
+	require.NoError(t, reloader.Register(flags.ConfigListener()))
+	require.NoError(t, reloader.Apply(map[string]*ConfigItem{FeatureFlagsConfigKey: {Key: FeatureFlagsConfigKey, Value: configFlags}}))
+	return flags
+}
+
+func TestFeatureFlag_Evaluate(t *testing.T) {
+	tests := []struct {
+		name            string
+		flag            FeatureFlag
+		subject         FeatureFlagSubject
+		expectedEnabled bool
+		expectedReason  string
+	}{
+		{name: "boolean on", flag: FeatureFlag{Name: "new-search", Type: FeatureFlagTypeBoolean, Enabled: true},
+			expectedEnabled: true, expectedReason: FeatureFlagReasonEnabled},
+		{name: "boolean off", flag: FeatureFlag{Name: "new-search", Type: FeatureFlagTypeBoolean},
+			expectedReason: FeatureFlagReasonDisabled},
+		{name: "targeted role matches any of the user's roles", flag: FeatureFlag{Name: "bulk-publish", Type: FeatureFlagTypeTargeted, Enabled: true,
+			Rules: []FeatureFlagRule{{Attribute: FeatureFlagAttributeRole, Values: []string{"editor"}}}},
+			subject: FeatureFlagSubject{Roles: []string{"viewer", "Editor"}}, expectedEnabled: true, expectedReason: FeatureFlagReasonEnabled},
+		{name: "targeted requires every rule", flag: FeatureFlag{Name: "bulk-publish", Type: FeatureFlagTypeTargeted, Enabled: true,
+			Rules: []FeatureFlagRule{
+				{Attribute: FeatureFlagAttributeRole, Values: []string{"editor"}},
+				{Attribute: FeatureFlagAttributeGatewayType, Values: []string{"admin"}},
+			}},
+			subject: FeatureFlagSubject{Roles: []string{"editor"}, GatewayType: "public"}, expectedReason: FeatureFlagReasonNotTargeted},
+		{name: "full rollout", flag: FeatureFlag{Name: "new-search", Type: FeatureFlagTypePercentage, Enabled: true, Percentage: 100},
+			expectedEnabled: true, expectedReason: FeatureFlagReasonEnabled},
+		{name: "partial rollout needs a user", flag: FeatureFlag{Name: "new-search", Type: FeatureFlagTypePercentage, Enabled: true, Percentage: 99},
+			expectedReason: FeatureFlagReasonOutRollout},
+		{name: "percentage narrowed by environment", flag: FeatureFlag{Name: "new-search", Type: FeatureFlagTypePercentage, Enabled: true, Percentage: 100,
+			Rules: []FeatureFlagRule{{Attribute: FeatureFlagAttributeEnvironment, Values: []string{"staging"}}}},
+			subject: FeatureFlagSubject{UserID: "user-1", Environment: "production"}, expectedReason: FeatureFlagReasonNotTargeted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			evaluation := tt.flag.Evaluate(tt.subject)
+
+			// Assert
+			assert.Equal(t, tt.expectedEnabled, evaluation.Enabled)
+			assert.Equal(t, tt.expectedReason, evaluation.Reason)
+		})
+	}
+}
+
+func TestFeatureFlag_EvaluatePercentageIsStable(t *testing.T) {
+	// Arrange
+	quarter := &FeatureFlag{Name: "new-search", Type: FeatureFlagTypePercentage, Enabled: true, Percentage: 25}
+	half := &FeatureFlag{Name: "new-search", Type: FeatureFlagTypePercentage, Enabled: true, Percentage: 50}
+
+	// Act
+	inQuarter, inHalf := 0, 0
+	for i := 0; i < 1000; i++ {
+		subject := FeatureFlagSubject{UserID: fmt.Sprintf("user-%d", i)}
+		quarterEnabled := quarter.Evaluate(subject).Enabled
+		if quarterEnabled {
+			inQuarter++
+			require.True(t, half.Evaluate(subject).Enabled, "users keep the feature as the rollout grows")
+		}
+		if half.Evaluate(subject).Enabled {
+			inHalf++
+		}
+		require.Equal(t, quarterEnabled, quarter.Evaluate(subject).Enabled)
+	}
+
+	// Assert
+	assert.InDelta(t, 250, inQuarter, 50)
+	assert.InDelta(t, 500, inHalf, 50)
+}
+
+func TestFeatureFlag_Validate(t *testing.T) {
+	tests := []struct {
+		name string
+		flag FeatureFlag
+	}{
+		{name: "invalid name", flag: FeatureFlag{Name: "New Search", Type: FeatureFlagTypeBoolean}},
+		{name: "unknown type", flag: FeatureFlag{Name: "new-search", Type: "gradual"}},
+		{name: "percentage out of range", flag: FeatureFlag{Name: "new-search", Type: FeatureFlagTypePercentage, Percentage: 101}},
+		{name: "targeted without rules", flag: FeatureFlag{Name: "new-search", Type: FeatureFlagTypeTargeted}},
+		{name: "unknown attribute", flag: FeatureFlag{Name: "new-search", Type: FeatureFlagTypeTargeted,
+			Rules: []FeatureFlagRule{{Attribute: "country", Values: []string{"us"}}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			err := tt.flag.Validate()
+
+			// Assert
+			assert.True(t, domain.IsValidationError(err), "got %v", err)
+		})
+	}
+}
+
+func TestFeatureFlagService_AdminFlagsOverrideConfiguration(t *testing.T) {
+	// Arrange
+	ctx := domain.NewCorrelationContext().ToContext(context.Background())
+	flags := newTestFeatureFlagService(t, `[{"name":"new-search","type":"boolean","enabled":false},{"name":"donations-v2","type":"boolean","enabled":true}]`)
+	subject := FeatureFlagSubject{UserID: "user-1", Environment: "staging"}
+	before := flags.EvaluateAll(subject)
+
+	// Act
+	saved, saveErr := flags.Save(ctx, &FeatureFlag{Name: "new-search", Type: FeatureFlagTypeBoolean, Enabled: true})
+	overridden := flags.EvaluateAll(subject)
+	deleteErr := flags.Delete(ctx, "new-search")
+	restored := flags.EvaluateAll(subject)
+	configOnlyErr := flags.Delete(ctx, "donations-v2")
+
+	// Assert
+	require.NoError(t, saveErr)
+	require.NoError(t, deleteErr)
+	assert.Equal(t, FeatureFlagSet{"new-search": false, "donations-v2": true}, before)
+	assert.Equal(t, FeatureFlagSet{"new-search": true, "donations-v2": true}, overridden, "saving clears cached evaluations")
+	assert.Equal(t, FeatureFlagSet{"new-search": false, "donations-v2": true}, restored)
+	assert.Equal(t, FeatureFlagSourceAdmin, saved.Source)
+	assert.Equal(t, 1, saved.Version)
+	assert.True(t, domain.IsNotFoundError(configOnlyErr), "configuration flags are not managed through the admin API")
+	assert.Len(t, pendingOutboxIDs(t, flags.stateStore), 2, "the save and delete are audited")
+}
+
+func TestFeatureFlagService_RefreshLoadsOtherInstancesFlags(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	stateStore := NewStateStoreWithBackend(NewMemoryStateBackend())
+	pubsub := NewPubSub(&Client{appID: "content-api"})
+	admin := NewFeatureFlagService(stateStore, pubsub, "staging")
+	other := NewFeatureFlagService(stateStore, pubsub, "staging")
+	_, err := admin.Save(ctx, &FeatureFlag{Name: "volunteer-form-v2", Type: FeatureFlagTypeTargeted, Enabled: true,
+		Rules: []FeatureFlagRule{{Attribute: FeatureFlagAttributeGatewayType, Values: []string{"public"}}}})
+	require.NoError(t, err)
+	stale := other.Evaluate("volunteer-form-v2", FeatureFlagSubject{GatewayType: "public"})
+
+	// Act
+	refreshErr := other.Refresh(ctx)
+
+	// Assert
+	require.NoError(t, refreshErr)
+	assert.Equal(t, FeatureFlagReasonUnknown, stale.Reason)
+	assert.True(t, other.Evaluate("volunteer-form-v2", FeatureFlagSubject{GatewayType: "public"}).Enabled)
+	assert.False(t, other.Evaluate("volunteer-form-v2", FeatureFlagSubject{GatewayType: "admin"}).Enabled)
+}
+
+func TestFeatureFlagService_ConfigListenerRejectsInvalidFlags(t *testing.T) {
+	tests := []struct {
+		name   string
+		config string
+	}{
+		{name: "not JSON", config: `new-search=true`},
+		{name: "invalid flag", config: `[{"name":"new-search","type":"percentage","percentage":150}]`},
+		{name: "duplicate flag", config: `[{"name":"new-search","type":"boolean"},{"name":"new-search","type":"boolean"}]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			flags := NewFeatureFlagService(NewStateStoreWithBackend(NewMemoryStateBackend()), NewPubSub(&Client{appID: "content-api"}), "staging")
+			reloader := NewConfigReloader(nil)
+			require.NoError(t, reloader.Register(flags.ConfigListener()))
+			require.NoError(t, reloader.Apply(map[string]*ConfigItem{FeatureFlagsConfigKey: {Key: FeatureFlagsConfigKey, Value: `[{"name":"new-search","type":"boolean","enabled":true}]`}}))
+
+			// Act
+			err := reloader.Apply(map[string]*ConfigItem{FeatureFlagsConfigKey: {Key: FeatureFlagsConfigKey, Value: tt.config}})
+
+			// Assert
+			assert.True(t, domain.IsValidationError(err), "got %v", err)
+			assert.True(t, flags.Evaluate("new-search", FeatureFlagSubject{}).Enabled, "the previous flags stay in effect")
+		})
+	}
+}
+
+func TestFeatureFlagService_Middleware(t *testing.T) {
+	// Arrange
+	flags := newTestFeatureFlagService(t, `[
+		{"name":"editor-tools","type":"targeted","enabled":true,"rules":[{"attribute":"role","values":["editor"]},{"attribute":"environment","values":["staging"]}]},
+		{"name":"new-search","type":"boolean","enabled":true}
+	]`)
+
+	var seen FeatureFlagSet
+	handler := flags.Middleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = FeatureFlagsFromContext(r.Context())
+	}))
+
+	request := httptest.NewRequest(http.MethodGet, "/api/v1/news", nil)
+	request.Header.Set("X-User-ID", "user-1")
+	request.Header.Set("X-User-Roles", "viewer, editor")
+	request.Header.Set("X-Gateway-Type", "admin")
+
+	// Act
+	handler.ServeHTTP(httptest.NewRecorder(), request)
+
+	// Assert
+	assert.Equal(t, FeatureFlagSet{"editor-tools": true, "new-search": true}, seen)
+	assert.True(t, FeatureEnabled(WithFeatureFlags(context.Background(), seen), "editor-tools"))
+	assert.False(t, FeatureEnabled(context.Background(), "editor-tools"), "requests without evaluations have every flag off")
+}
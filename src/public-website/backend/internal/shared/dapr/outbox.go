@@ -0,0 +1,328 @@
+package dapr
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/google/uuid"
+)
+
+const (
+	outboxDomain      = "outbox"
+	outboxEntityType  = "message"
+	outboxStatusIndex = "status"
+
+	defaultOutboxRelayInterval = 5 * time.Second
+	defaultOutboxBatchSize     = 100
+	defaultOutboxClaimDuration = 30 * time.Second
+	defaultOutboxRetryDelay    = 5 * time.Second
+	defaultOutboxMaxRetryDelay = 10 * time.Minute
+)
+
+// OutboxStatus tracks whether an outbox message has reached the broker
+type OutboxStatus string
+
+const (
+	OutboxStatusPending   OutboxStatus = "pending"
+	OutboxStatusDelivered OutboxStatus = "delivered"
+)
+
+// OutboxMessage is an event committed in the same transaction as the entity write it
+// describes. The relay publishes it afterwards, so a crash can delay the event but can
+// neither lose it nor publish it for a write that never happened.
+type OutboxMessage struct {
+	MessageID     string        `json:"message_id"`
+	Topic         string        `json:"topic"`
+	Event         *EventMessage `json:"event"`
+	Status        OutboxStatus  `json:"status"`
+	Attempts      int           `json:"attempts"`
+	NextAttemptAt time.Time     `json:"next_attempt_at"`
+	LastError     string        `json:"last_error,omitempty"`
+	CreatedAt     time.Time     `json:"created_at"`
+	DeliveredAt   *time.Time    `json:"delivered_at,omitempty"`
+}
+
+// OutboxPublisher delivers relayed events; PubSub is the production implementation
+type OutboxPublisher interface {
+	PublishEvent(ctx context.Context, topic string, event *EventMessage) error
+}
+
+// OutboxMessageIndexes keeps undelivered messages findable by the relay. Delivered
+// messages drop out of the index so it only ever holds outstanding work.
+func OutboxMessageIndexes() *IndexedEntityType {
+	return &IndexedEntityType{
+		Domain:     outboxDomain,
+		EntityType: outboxEntityType,
+		Indexes: []IndexDefinition{
+			{Name: outboxStatusIndex, Extract: func(entity interface{}) []string {
+				if status := entity.(*OutboxMessage).Status; status == OutboxStatusPending {
+					return []string{string(status)}
+				}
+				return nil
+			}},
+		},
+		NewEntity: func() interface{} { return &OutboxMessage{} },
+		EntityID:  func(entity interface{}) string { return entity.(*OutboxMessage).MessageID },
+	}
+}
+
+// NewOutboxMessage prepares an event for topic to be committed with an entity write.
+// Request-scoped details PublishEvent would take from ctx are captured now, since the
+// relay publishes long after the request is gone.
+func NewOutboxMessage(ctx context.Context, topic string, event *EventMessage) *OutboxMessage {
+	now := time.Now().UTC()
+	messageID := uuid.New().String()
+
+	if event.ID == "" {
+		event.ID = messageID
+	}
+	if event.Time.IsZero() {
+		event.Time = now
+	}
+	if event.CorrelationID == "" {
+		event.CorrelationID = domain.GetCorrelationID(ctx)
+	}
+	if event.Metadata == nil {
+		event.Metadata = make(map[string]string)
+	}
+	event.Metadata["outbox_message_id"] = messageID
+
+	return &OutboxMessage{
+		MessageID:     messageID,
+		Topic:         topic,
+		Event:         event,
+		Status:        OutboxStatusPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}
+}
+
+// SaveWithOutbox saves a value that has no secondary indexes and commits the outbox
+// messages describing the change in the same transaction
+func (s *StateStore) SaveWithOutbox(ctx context.Context, key string, value interface{}, messages ...*OutboxMessage) error {
+	if key == "" {
+		return fmt.Errorf("state key cannot be empty")
+	}
+
+	writes, err := s.outboxWrites(indexedWrite{op: TransactionOperation{Operation: "upsert", Key: key, Value: value}}, messages)
+	if err != nil {
+		return err
+	}
+
+	return s.writeIndexedWithRetry(ctx, writes...)
+}
+
+// DeleteWithOutbox removes a value that has no secondary indexes and commits the outbox
+// messages describing the removal in the same transaction
+func (s *StateStore) DeleteWithOutbox(ctx context.Context, key string, messages ...*OutboxMessage) error {
+	if key == "" {
+		return fmt.Errorf("state key cannot be empty")
+	}
+
+	writes, err := s.outboxWrites(indexedWrite{op: TransactionOperation{Operation: "delete", Key: key}}, messages)
+	if err != nil {
+		return err
+	}
+
+	return s.writeIndexedWithRetry(ctx, writes...)
+}
+
+// outboxWrites appends the outbox messages to an entity write so they commit together
+func (s *StateStore) outboxWrites(write indexedWrite, messages []*OutboxMessage) ([]indexedWrite, error) {
+	writes := []indexedWrite{write}
+	for _, message := range messages {
+		if message == nil {
+			continue
+		}
+		if message.Topic == "" || message.Event == nil {
+			return nil, domain.NewValidationError(fmt.Sprintf("outbox message %s requires a topic and event", message.MessageID))
+		}
+
+		messageWrite, err := s.indexedUpsert(outboxDomain, outboxEntityType, message.MessageID, message, "")
+		if err != nil {
+			return nil, err
+		}
+		writes = append(writes, messageWrite)
+	}
+	return writes, nil
+}
+
+// OutboxRelay publishes outbox messages committed by repositories and marks them
+// delivered. Several relays may share a store: each message is claimed before it is
+// published, so it normally goes out once, and at least once if a relay dies mid-way.
+type OutboxRelay struct {
+	stateStore    *StateStore
+	publisher     OutboxPublisher
+	interval      time.Duration
+	batchSize     int
+	claimDuration time.Duration
+	retryDelay    time.Duration
+	maxRetryDelay time.Duration
+	now           func() time.Time
+}
+
+// NewOutboxRelay creates a relay that drains the outbox of stateStore into publisher
+func NewOutboxRelay(stateStore *StateStore, publisher OutboxPublisher) *OutboxRelay {
+	stateStore.MustRegisterIndexes(OutboxMessageIndexes())
+
+	return &OutboxRelay{
+		stateStore:    stateStore,
+		publisher:     publisher,
+		interval:      parseDurationEnv("OUTBOX_RELAY_INTERVAL", defaultOutboxRelayInterval),
+		batchSize:     parseIntEnv("OUTBOX_RELAY_BATCH_SIZE", defaultOutboxBatchSize),
+		claimDuration: defaultOutboxClaimDuration,
+		retryDelay:    defaultOutboxRetryDelay,
+		maxRetryDelay: defaultOutboxMaxRetryDelay,
+		now:           time.Now,
+	}
+}
+
+// Run relays pending messages until ctx is cancelled
+func (r *OutboxRelay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		if delivered, err := r.RelayPending(ctx); err != nil {
+			log.Printf("Outbox relay run failed: %v", err)
+		} else if delivered > 0 {
+			log.Printf("Outbox relay delivered %d messages", delivered)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RelayPending publishes up to one batch of pending messages that are due and returns how
+// many were delivered. A failed publish is recorded on the message and retried on a later
+// run after an exponential backoff; it does not stop the rest of the batch.
+func (r *OutboxRelay) RelayPending(ctx context.Context) (int, error) {
+	ids, err := r.stateStore.LookupIndex(ctx, outboxDomain, outboxEntityType, IndexCondition{
+		Index: outboxStatusIndex,
+		Value: string(OutboxStatusPending),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	delivered := 0
+	attempted := 0
+	for _, id := range ids {
+		if attempted >= r.batchSize {
+			break
+		}
+		if ctx.Err() != nil {
+			return delivered, ctx.Err()
+		}
+
+		message, err := r.claim(ctx, id)
+		if err != nil {
+			log.Printf("Failed to claim outbox message %s: %v", id, err)
+			continue
+		}
+		if message == nil {
+			continue
+		}
+
+		attempted++
+		if err := r.deliver(ctx, message); err != nil {
+			log.Printf("Failed to relay outbox message %s to %s: %v", id, message.Topic, err)
+			continue
+		}
+		delivered++
+	}
+
+	return delivered, nil
+}
+
+// GetOutboxMessage retrieves an outbox message by ID
+func (r *OutboxRelay) GetOutboxMessage(ctx context.Context, messageID string) (*OutboxMessage, error) {
+	message, _, err := r.load(ctx, messageID)
+	return message, err
+}
+
+// claim pushes a due message's next attempt past the claim duration, so that other relays
+// leave it alone while this one publishes. It returns nil when the message is not due or
+// another relay claimed it first.
+func (r *OutboxRelay) claim(ctx context.Context, messageID string) (*OutboxMessage, error) {
+	message, etag, err := r.load(ctx, messageID)
+	if err != nil {
+		if domain.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	now := r.now().UTC()
+	if message.Status != OutboxStatusPending || message.NextAttemptAt.After(now) {
+		return nil, nil
+	}
+
+	message.Attempts++
+	message.NextAttemptAt = now.Add(r.claimDuration)
+	err = r.stateStore.SaveIndexedWithETag(ctx, outboxDomain, outboxEntityType, messageID, message, etag)
+	if err != nil {
+		if domain.IsConflictError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return message, nil
+}
+
+// deliver publishes a claimed message and records the outcome
+func (r *OutboxRelay) deliver(ctx context.Context, message *OutboxMessage) error {
+	publishErr := r.publisher.PublishEvent(ctx, message.Topic, message.Event)
+
+	now := r.now().UTC()
+	if publishErr == nil {
+		message.Status = OutboxStatusDelivered
+		message.DeliveredAt = &now
+		message.LastError = ""
+	} else {
+		message.LastError = publishErr.Error()
+		message.NextAttemptAt = now.Add(r.backoff(message.Attempts))
+	}
+
+	// The claim keeps other relays away, so the message can be saved without an ETag
+	if err := r.stateStore.SaveIndexed(ctx, outboxDomain, outboxEntityType, message.MessageID, message); err != nil {
+		if publishErr != nil {
+			return publishErr
+		}
+		return fmt.Errorf("outbox message %s was published but could not be marked delivered: %w", message.MessageID, err)
+	}
+
+	return publishErr
+}
+
+// backoff doubles the retry delay with every failed attempt, up to the maximum
+func (r *OutboxRelay) backoff(attempts int) time.Duration {
+	delay := r.retryDelay
+	for i := 1; i < attempts && delay < r.maxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > r.maxRetryDelay {
+		delay = r.maxRetryDelay
+	}
+	return delay
+}
+
+func (r *OutboxRelay) load(ctx context.Context, messageID string) (*OutboxMessage, string, error) {
+	var message OutboxMessage
+	found, etag, err := r.stateStore.GetWithETag(ctx, r.stateStore.CreateKey(outboxDomain, outboxEntityType, messageID), &message)
+	if err != nil {
+		return nil, "", err
+	}
+	if !found {
+		return nil, "", domain.NewNotFoundError("outbox message", messageID)
+	}
+	return &message, etag, nil
+}
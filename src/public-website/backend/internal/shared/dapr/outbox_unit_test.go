@@ -0,0 +1,244 @@
+package dapr
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingPublisher struct {
+	mu        sync.Mutex
+	failures  int
+	published []string
+}
+
+func (p *recordingPublisher) PublishEvent(ctx context.Context, topic string, event *EventMessage) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.failures > 0 {
+		p.failures--
+		return domain.NewDependencyError("pub/sub", errors.New("broker unavailable"))
+	}
+	p.published = append(p.published, topic+"/"+event.Type)
+	return nil
+}
+
+func (p *recordingPublisher) topics() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.published...)
+}
+
+func newOutboxTestStateStore() *StateStore {
+	stateStore := NewStateStoreWithBackend(NewMemoryStateBackend())
+	stateStore.MustRegisterIndexes(indexedTestEntityType())
+	stateStore.MustRegisterIndexes(OutboxMessageIndexes())
+	return stateStore
+}
+
+func testOutboxMessage(eventType string) *OutboxMessage {
+	return NewOutboxMessage(context.Background(), "audit-events", &EventMessage{Type: eventType})
+}
+
+func pendingOutboxIDs(t *testing.T, stateStore *StateStore) []string {
+	ids, err := stateStore.LookupIndex(context.Background(), outboxDomain, outboxEntityType, IndexCondition{
+		Index: outboxStatusIndex,
+		Value: string(OutboxStatusPending),
+	})
+	require.NoError(t, err)
+	return ids
+}
+
+func TestStateStore_SaveIndexedWithOutbox(t *testing.T) {
+	tests := []struct {
+		name            string
+		staleETag       bool
+		messages        []*OutboxMessage
+		expectedError   bool
+		expectedPending int
+	}{
+		{
+			name:            "commit entity with one message",
+			messages:        []*OutboxMessage{testOutboxMessage("audit.event")},
+			expectedPending: 1,
+		},
+		{
+			name:            "commit entity with several messages",
+			messages:        []*OutboxMessage{testOutboxMessage("audit.event"), testOutboxMessage("content.updated")},
+			expectedPending: 2,
+		},
+		{
+			name:            "commit entity without messages",
+			expectedPending: 0,
+		},
+		{
+			name:            "stale entity leaves no message behind",
+			staleETag:       true,
+			messages:        []*OutboxMessage{testOutboxMessage("audit.event")},
+			expectedError:   true,
+			expectedPending: 0,
+		},
+		{
+			name:            "reject message without topic",
+			messages:        []*OutboxMessage{{MessageID: "broken", Event: &EventMessage{}}},
+			expectedError:   true,
+			expectedPending: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+			stateStore := newOutboxTestStateStore()
+			entity := &indexedTestEntity{ID: "entity-1", CategoryID: "news"}
+			require.NoError(t, stateStore.SaveIndexed(ctx, "test", "entity", entity.ID, entity))
+			etag, err := stateStore.GetETag(ctx, stateStore.CreateKey("test", "entity", entity.ID))
+			require.NoError(t, err)
+			if tt.staleETag {
+				require.NoError(t, stateStore.SaveIndexed(ctx, "test", "entity", entity.ID, entity))
+			}
+
+			// Act
+			updated := &indexedTestEntity{ID: "entity-1", CategoryID: "research"}
+			err = stateStore.SaveIndexedWithOutbox(ctx, "test", "entity", updated.ID, updated, etag, tt.messages...)
+
+			// Assert
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				ids, err := stateStore.LookupIndex(ctx, "test", "entity", IndexCondition{Index: "category", Value: "research"})
+				require.NoError(t, err)
+				assert.Equal(t, []string{"entity-1"}, ids)
+			}
+			assert.Len(t, pendingOutboxIDs(t, stateStore), tt.expectedPending)
+		})
+	}
+}
+
+func TestOutboxRelay_RelayPending(t *testing.T) {
+	tests := []struct {
+		name              string
+		failures          int
+		expectedDelivered int
+		expectedStatus    OutboxStatus
+		expectedAttempts  int
+	}{
+		{
+			name:              "deliver pending message",
+			expectedDelivered: 1,
+			expectedStatus:    OutboxStatusDelivered,
+			expectedAttempts:  1,
+		},
+		{
+			name:              "keep failed message pending for retry",
+			failures:          1,
+			expectedDelivered: 0,
+			expectedStatus:    OutboxStatusPending,
+			expectedAttempts:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+			stateStore := newOutboxTestStateStore()
+			publisher := &recordingPublisher{failures: tt.failures}
+			relay := NewOutboxRelay(stateStore, publisher)
+
+			message := testOutboxMessage("audit.event")
+			entity := &indexedTestEntity{ID: "entity-1", CategoryID: "news"}
+			require.NoError(t, stateStore.SaveIndexedWithOutbox(ctx, "test", "entity", entity.ID, entity, "", message))
+
+			// Act
+			delivered, err := relay.RelayPending(ctx)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedDelivered, delivered)
+
+			stored, err := relay.GetOutboxMessage(ctx, message.MessageID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, stored.Status)
+			assert.Equal(t, tt.expectedAttempts, stored.Attempts)
+			if tt.expectedStatus == OutboxStatusDelivered {
+				assert.NotNil(t, stored.DeliveredAt)
+				assert.Empty(t, pendingOutboxIDs(t, stateStore))
+				assert.Equal(t, []string{"audit-events/audit.event"}, publisher.topics())
+			} else {
+				assert.Contains(t, stored.LastError, "pub/sub")
+				assert.True(t, stored.NextAttemptAt.After(time.Now()))
+				assert.Equal(t, []string{message.MessageID}, pendingOutboxIDs(t, stateStore))
+			}
+		})
+	}
+}
+
+func TestOutboxRelay_RetriesAfterBackoff(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	stateStore := newOutboxTestStateStore()
+	publisher := &recordingPublisher{failures: 2}
+	relay := NewOutboxRelay(stateStore, publisher)
+	clock := time.Now()
+	relay.now = func() time.Time { return clock }
+
+	message := testOutboxMessage("audit.event")
+	entity := &indexedTestEntity{ID: "entity-1", CategoryID: "news"}
+	require.NoError(t, stateStore.SaveIndexedWithOutbox(ctx, "test", "entity", entity.ID, entity, "", message))
+
+	// Act
+	var delivered []int
+	for _, advance := range []time.Duration{0, time.Second, relay.backoff(1), relay.backoff(2)} {
+		clock = clock.Add(advance)
+		count, err := relay.RelayPending(ctx)
+		require.NoError(t, err)
+		delivered = append(delivered, count)
+	}
+
+	// Assert
+	assert.Equal(t, []int{0, 0, 0, 1}, delivered, "retries wait out the backoff")
+	stored, err := relay.GetOutboxMessage(ctx, message.MessageID)
+	require.NoError(t, err)
+	assert.Equal(t, OutboxStatusDelivered, stored.Status)
+	assert.Equal(t, 3, stored.Attempts)
+	assert.Equal(t, 2*relay.retryDelay, relay.backoff(2))
+	assert.Equal(t, relay.maxRetryDelay, relay.backoff(100))
+}
+
+func TestOutboxRelay_ConcurrentRelays(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	stateStore := newOutboxTestStateStore()
+	publisher := &recordingPublisher{}
+
+	for i := 0; i < 10; i++ {
+		entity := &indexedTestEntity{ID: "entity-" + string(rune('a'+i)), CategoryID: "news"}
+		require.NoError(t, stateStore.SaveIndexedWithOutbox(ctx, "test", "entity", entity.ID, entity, "", testOutboxMessage("audit.event")))
+	}
+
+	// Act
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := NewOutboxRelay(stateStore, publisher).RelayPending(ctx)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	// Assert
+	assert.Len(t, publisher.topics(), 10, "each message is published by exactly one relay")
+	assert.Empty(t, pendingOutboxIDs(t, stateStore))
+}
@@ -4,12 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"reflect"
+	"log"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/google/uuid"
 )
 
 // PubSub wraps Dapr pub/sub operations with advanced event processing capabilities
@@ -18,8 +19,9 @@ type PubSub struct {
 	pubsub         string
 	appID          string
 	batchProcessor *EventBatchProcessor
-	dlqHandler     *DeadLetterQueueHandler
+	deadLetters    *DeadLetterStore
 	eventStore     *EventStore
+	schemas        *EventSchemaRegistry
 	metrics        *PubSubMetrics
 	config         *PubSubConfig
 }
@@ -52,37 +54,6 @@ type EventBatchProcessor struct {
 	wg            sync.WaitGroup
 }
 
-// DeadLetterQueueHandler manages failed event processing
-type DeadLetterQueueHandler struct {
-	dlqTopic        string
-	maxRetries      int
-	retryDelays     []time.Duration
-	failedEvents    map[string]*FailedEvent
-	failedEventsMux sync.RWMutex
-	pubsub          *PubSub
-	stateStore      *StateStore
-}
-
-// FailedEvent represents an event that failed processing
-type FailedEvent struct {
-	OriginalEvent EventMessage  `json:"original_event"`
-	FailureReason string        `json:"failure_reason"`
-	RetryCount    int           `json:"retry_count"`
-	FirstFailure  time.Time     `json:"first_failure"`
-	LastAttempt   time.Time     `json:"last_attempt"`
-	NextRetry     time.Time     `json:"next_retry"`
-	IsPermanent   bool          `json:"is_permanent"`
-}
-
-// EventStore provides event replay and audit capabilities
-type EventStore struct {
-	stateStore    *StateStore
-	retentionDays int
-	enabled       bool
-	indexByTopic  map[string][]string
-	indexMutex    sync.RWMutex
-}
-
 // PubSubMetrics tracks performance metrics for pub/sub operations
 type PubSubMetrics struct {
 	EventsPublished     int64            `json:"events_published"`
@@ -113,6 +84,7 @@ type PubSubConfig struct {
 
 // EventMessage represents a generic event message
 type EventMessage struct {
+	ID          string                 `json:"id,omitempty"`
 	Topic       string                 `json:"topic"`
 	Data        map[string]interface{} `json:"data"`
 	Metadata    map[string]string      `json:"metadata"`
@@ -196,34 +168,22 @@ func NewPubSub(client *Client) *PubSub {
 		}
 	}
 	
+	// Cross-service events are validated against the contracts in the schema registry
+	schemas, err := LoadEventSchemaRegistry(EventSchemaDir())
+	if err != nil {
+		log.Printf("Event schema registry unavailable, cross-service events will be rejected: %v", err)
+		schemas = NewEventSchemaRegistry()
+	}
+	pubsub.schemas = schemas
+	
 	// Initialize event store if enabled
 	if config.EventStoreEnabled {
-		pubsub.eventStore = &EventStore{
-			stateStore:    NewStateStore(client),
-			retentionDays: config.EventRetentionDays,
-			enabled:       true,
-			indexByTopic:  make(map[string][]string),
-		}
+		pubsub.eventStore = NewEventStore(NewStateStore(client))
 	}
 	
-	// Initialize dead letter queue handler if enabled
+	// Initialize dead letter store if enabled
 	if config.DeadLetterEnabled {
-		retryDelays := []time.Duration{
-			1 * time.Second,
-			5 * time.Second,
-			30 * time.Second,
-			2 * time.Minute,
-			10 * time.Minute,
-		}
-		
-		pubsub.dlqHandler = &DeadLetterQueueHandler{
-			dlqTopic:     config.DeadLetterTopic,
-			maxRetries:   config.MaxRetries,
-			retryDelays:  retryDelays,
-			failedEvents: make(map[string]*FailedEvent),
-			pubsub:       pubsub,
-			stateStore:   NewStateStore(client),
-		}
+		pubsub.deadLetters = NewDeadLetterStore(NewStateStore(client), pubsub)
 	}
 	
 	// Initialize batch processor if enabled
@@ -254,160 +214,19 @@ func (p *PubSub) getDefaultRetryConfig() *RetryConfig {
 	}
 }
 
-// getEventSchemas returns predefined event schemas for validation
+// getEventSchemas summarizes the newest registered version of every event schema
 func (p *PubSub) getEventSchemas() map[string]*EventSchema {
-	return map[string]*EventSchema{
-		"content.published": {
-			EventType:     "content.published",
-			SchemaVersion: "1.0",
-			RequiredFields: map[string]string{
-				"content_id":   "string",
-				"title":        "string",
-				"content_type": "string",
-				"published_at": "string",
-			},
-			OptionalFields: map[string]string{
-				"summary":     "string",
-				"category_id": "string",
-				"author_id":   "string",
-			},
-			ValidSources: []string{"content-api"},
-			ValidTargets: []string{"notification-api", "admin-gateway"},
-		},
-		"inquiry.submitted": {
-			EventType:     "inquiry.submitted",
-			SchemaVersion: "1.0",
-			RequiredFields: map[string]string{
-				"inquiry_id":   "string",
-				"inquiry_type": "string",
-				"status":       "string",
-				"submitted_at": "string",
-			},
-			OptionalFields: map[string]string{
-				"priority":      "string",
-				"organization": "string",
-				"contact_info": "object",
-			},
-			ValidSources: []string{"inquiries-api"},
-			ValidTargets: []string{"notification-api", "admin-gateway"},
-		},
-		"inquiry.updated": {
-			EventType:     "inquiry.updated",
-			SchemaVersion: "1.0",
-			RequiredFields: map[string]string{
-				"inquiry_id":   "string",
-				"inquiry_type": "string",
-				"old_status":   "string",
-				"new_status":   "string",
-				"updated_at":   "string",
-			},
-			OptionalFields: map[string]string{
-				"updated_by": "string",
-				"notes":      "string",
-			},
-			ValidSources: []string{"inquiries-api", "admin-gateway"},
-			ValidTargets: []string{"notification-api"},
-		},
-		"notification.sent": {
-			EventType:     "notification.sent",
-			SchemaVersion: "1.0",
-			RequiredFields: map[string]string{
-				"notification_id": "string",
-				"channel":        "string",
-				"recipient":      "string",
-				"sent_at":        "string",
-				"status":         "string",
-			},
-			OptionalFields: map[string]string{
-				"error_message": "string",
-				"retry_count":   "number",
-			},
-			ValidSources: []string{"notification-api"},
-		},
-	}
+	return p.schemas.summaries()
 }
 
-// validateEventSchema validates an event against its schema
+// validateEventSchema validates an event against the schema version it declares
 func (p *PubSub) validateEventSchema(event *CrossServiceEvent) error {
-	schemas := p.getEventSchemas()
-	schema, exists := schemas[event.EventType]
-	if !exists {
-		return fmt.Errorf("no schema defined for event type: %s", event.EventType)
-	}
-
-	// Validate source service
-	if len(schema.ValidSources) > 0 {
-		validSource := false
-		for _, source := range schema.ValidSources {
-			if source == event.SourceService {
-				validSource = true
-				break
-			}
-		}
-		if !validSource {
-			return fmt.Errorf("invalid source service %s for event type %s, valid sources: %v", 
-				event.SourceService, event.EventType, schema.ValidSources)
-		}
-	}
-
-	// Validate target service if specified
-	if event.TargetService != "" && len(schema.ValidTargets) > 0 {
-		validTarget := false
-		for _, target := range schema.ValidTargets {
-			if target == event.TargetService {
-				validTarget = true
-				break
-			}
-		}
-		if !validTarget {
-			return fmt.Errorf("invalid target service %s for event type %s, valid targets: %v", 
-				event.TargetService, event.EventType, schema.ValidTargets)
-		}
-	}
-
-	// Validate required fields
-	for fieldName, fieldType := range schema.RequiredFields {
-		value, exists := event.Payload[fieldName]
-		if !exists {
-			return fmt.Errorf("missing required field %s for event type %s", fieldName, event.EventType)
-		}
-		if !p.validateFieldType(value, fieldType) {
-			return fmt.Errorf("invalid type for field %s in event type %s, expected %s", 
-				fieldName, event.EventType, fieldType)
-		}
-	}
-
-	// Validate schema version
-	if event.SchemaVersion != schema.SchemaVersion {
-		return fmt.Errorf("schema version mismatch for event type %s, expected %s, got %s", 
-			event.EventType, schema.SchemaVersion, event.SchemaVersion)
-	}
-
-	return nil
+	return p.schemas.ValidateProduced(event)
 }
 
-// validateFieldType validates that a field value matches the expected type
-func (p *PubSub) validateFieldType(value interface{}, expectedType string) bool {
-	switch expectedType {
-	case "string":
-		_, ok := value.(string)
-		return ok
-	case "number":
-		_, ok1 := value.(int)
-		_, ok2 := value.(float64)
-		_, ok3 := value.(int64)
-		return ok1 || ok2 || ok3
-	case "boolean":
-		_, ok := value.(bool)
-		return ok
-	case "object":
-		_, ok := value.(map[string]interface{})
-		return ok
-	case "array":
-		return reflect.TypeOf(value).Kind() == reflect.Slice
-	default:
-		return true // Unknown types are allowed
-	}
+// EventSchemas returns the registry cross-service events are validated against
+func (p *PubSub) EventSchemas() *EventSchemaRegistry {
+	return p.schemas
 }
 
 // PublishEvent publishes a generic event to a topic
@@ -419,6 +238,11 @@ func (p *PubSub) PublishEvent(ctx context.Context, topic string, event *EventMes
 		return fmt.Errorf("event cannot be nil")
 	}
 
+	// The ID is kept across retries so consumers can spot redeliveries
+	if event.ID == "" {
+		event.ID = uuid.New().String()
+	}
+
 	if event.Time.IsZero() {
 		event.Time = time.Now()
 	}
@@ -454,12 +278,15 @@ func (p *PubSub) PublishEvent(ctx context.Context, topic string, event *EventMes
 		if ctx.Err() == context.DeadlineExceeded {
 			return domain.NewTimeoutError(fmt.Sprintf("pub/sub publish operation for topic %s", topic))
 		}
-		return nil
+	} else if err := p.client.GetClient().PublishEvent(ctx, p.pubsub, topic, data); err != nil {
+		return domain.NewDependencyError("pub/sub", domain.WrapError(err, fmt.Sprintf("failed to publish event to topic %s", topic)))
 	}
 
-	err = p.client.GetClient().PublishEvent(ctx, p.pubsub, topic, data)
-	if err != nil {
-		return domain.NewDependencyError("pub/sub", domain.WrapError(err, fmt.Sprintf("failed to publish event to topic %s", topic)))
+	// The event is out; failing to record it must not make the caller publish it again
+	if p.eventStore != nil {
+		if err := p.eventStore.Append(ctx, topic, event); err != nil {
+			log.Printf("Failed to record event %s on topic %s in the event store: %v", event.ID, topic, err)
+		}
 	}
 
 	return nil
@@ -586,6 +413,10 @@ func (p *PubSub) getTopicForEventType(eventType string) string {
 		"content.published": "content-events",
 		"content.updated":   "content-events",
 		"content.deleted":   "content-events",
+		"content.review_requested": "content-events",
+		"content.review_commented": "content-events",
+		"content.review_approved":  "content-events",
+		"content.review_rejected":  "content-events",
 		"inquiry.submitted": "inquiry-events", 
 		"inquiry.updated":   "inquiry-events",
 		"inquiry.completed": "inquiry-events",
@@ -601,10 +432,36 @@ func (p *PubSub) getTopicForEventType(eventType string) string {
 	return "cross-service-events"
 }
 
+// AuditTopic returns the topic audit events are published to in the current environment
+func (p *PubSub) AuditTopic() string {
+	switch p.client.GetEnvironment() {
+	case "production", "staging":
+		return getEnv("AUDIT_TOPIC", "grafana-audit-events")
+	default:
+		return getEnv("AUDIT_TOPIC", "audit-events-dev")
+	}
+}
+
 // PublishAuditEvent publishes an audit event to Grafana Loki
 func (p *PubSub) PublishAuditEvent(ctx context.Context, event *AuditEvent) error {
+	eventMsg, err := p.AuditEventMessage(event)
+	if err != nil {
+		return err
+	}
+
+	err = p.PublishEvent(ctx, eventMsg.Topic, eventMsg)
+	if err != nil {
+		return fmt.Errorf("failed to publish audit event: %w", err)
+	}
+
+	return nil
+}
+
+// AuditEventMessage builds the message PublishAuditEvent sends, addressed to the audit topic,
+// for callers that deliver it later through an outbox
+func (p *PubSub) AuditEventMessage(event *AuditEvent) (*EventMessage, error) {
 	if event == nil {
-		return fmt.Errorf("audit event cannot be nil")
+		return nil, fmt.Errorf("audit event cannot be nil")
 	}
 
 	// Set environment and timestamp if not provided
@@ -616,14 +473,7 @@ func (p *PubSub) PublishAuditEvent(ctx context.Context, event *AuditEvent) error
 		event.AuditTime = time.Now()
 	}
 
-	// Determine the appropriate topic based on environment
-	var topic string
-	switch p.client.GetEnvironment() {
-	case "production", "staging":
-		topic = getEnv("AUDIT_TOPIC", "grafana-audit-events")
-	default:
-		topic = getEnv("AUDIT_TOPIC", "audit-events-dev")
-	}
+	topic := p.AuditTopic()
 
 	eventMsg := &EventMessage{
 		Topic: topic,
@@ -655,12 +505,7 @@ func (p *PubSub) PublishAuditEvent(ctx context.Context, event *AuditEvent) error
 		Time:        event.AuditTime,
 	}
 
-	err := p.PublishEvent(ctx, topic, eventMsg)
-	if err != nil {
-		return fmt.Errorf("failed to publish audit event: %w", err)
-	}
-
-	return nil
+	return eventMsg, nil
 }
 
 // PublishContentEvent publishes content-related events with enhanced validation
@@ -698,6 +543,34 @@ func (p *PubSub) PublishContentEvent(ctx context.Context, eventType string, cont
 	return p.PublishCrossServiceEvent(ctx, crossServiceEvent)
 }
 
+// PublishReviewEvent notifies reviewers and authors that a content review has moved on
+func (p *PubSub) PublishReviewEvent(ctx context.Context, event *domain.ReviewEvent) error {
+	if event == nil {
+		return fmt.Errorf("review event cannot be nil")
+	}
+
+	recipients := event.Recipients
+	if recipients == nil {
+		recipients = []string{}
+	}
+
+	data := map[string]interface{}{
+		"title":        event.Title,
+		"content_type": string(event.EntityType),
+		"recipients":   recipients,
+		"actor_id":     event.ActorID,
+		"user_id":      event.ActorID,
+		"message":      event.Message,
+		"occurred_at":  event.OccurredOn.Format(time.RFC3339),
+	}
+
+	if err := p.PublishContentEvent(ctx, string(event.EventType), event.EntityID, data, "notification-api"); err != nil {
+		return fmt.Errorf("failed to publish %s event for %s %s: %w", event.EventType, event.EntityType, event.EntityID, err)
+	}
+
+	return nil
+}
+
 // PublishServicesEvent publishes services-related events with enhanced validation
 func (p *PubSub) PublishServicesEvent(ctx context.Context, eventType string, serviceID string, data map[string]interface{}, targetService string) error {
 	// Create cross-service event
@@ -884,14 +757,12 @@ func (p *PubSub) PublishWithBatching(ctx context.Context, event EventMessage) er
 
 // PublishWithRetryAndDLQ publishes an event with retry logic and dead letter queue support
 func (p *PubSub) PublishWithRetryAndDLQ(ctx context.Context, event EventMessage) error {
-	eventID := fmt.Sprintf("%s-%d", event.Topic, time.Now().UnixNano())
-	
 	// Try publishing with retry logic
 	err := p.publishWithRetry(ctx, event, p.getDefaultRetryConfig())
 	if err != nil {
 		// Send to dead letter queue if all retries failed
-		if p.dlqHandler != nil {
-			return p.dlqHandler.handleFailedEvent(ctx, eventID, event, err.Error())
+		if p.deadLetters != nil {
+			return p.deadLetter(ctx, &event, err)
 		}
 		return err
 	}
@@ -902,58 +773,134 @@ func (p *PubSub) PublishWithRetryAndDLQ(ctx context.Context, event EventMessage)
 
 // ReplayEvents replays events from the event store within a specified time range
 func (p *PubSub) ReplayEvents(ctx context.Context, topic string, startTime, endTime time.Time, targetTopic string) error {
-	if p.eventStore == nil || !p.eventStore.enabled {
-		return fmt.Errorf("event store is not enabled")
+	_, err := p.ReplayHistory(ctx, &EventReplayRequest{
+		EventQuery:  EventQuery{Topic: topic, From: startTime, To: endTime, Limit: maxEventQueryLimit},
+		TargetTopic: targetTopic,
+	})
+	return err
+}
+
+// SearchEvents searches the history of events published through this PubSub
+func (p *PubSub) SearchEvents(ctx context.Context, query EventQuery) ([]*StoredEvent, error) {
+	if p.eventStore == nil {
+		return nil, domain.NewValidationError("event store is not enabled")
 	}
+	return p.eventStore.Search(ctx, query)
+}
 
-	events, err := p.eventStore.GetEventsInTimeRange(ctx, topic, startTime, endTime)
+// ReplayHistory publishes the stored events matching the request into its target
+// topic, oldest first. Each replay is published under a new ID, with the
+// original one in its original_event_id metadata, so deduplicating consumers
+// handle it again instead of dropping it as a redelivery. A dry run returns the
+// matches without publishing anything.
+func (p *PubSub) ReplayHistory(ctx context.Context, request *EventReplayRequest) (*EventReplayResult, error) {
+	if request.TargetTopic == "" {
+		return nil, domain.NewValidationError("replay requires a target topic")
+	}
+
+	events, err := p.SearchEvents(ctx, request.EventQuery)
 	if err != nil {
-		return fmt.Errorf("failed to retrieve events for replay: %w", err)
+		return nil, err
+	}
+
+	result := &EventReplayResult{
+		TargetTopic: request.TargetTopic,
+		DryRun:      request.DryRun,
+		Matched:     len(events),
+		Events:      events,
+	}
+	if request.DryRun {
+		return result, nil
 	}
 
-	for _, event := range events {
-		// Modify event for replay
-		replayEvent := event
-		replayEvent.Topic = targetTopic
+	var replayErr error
+	replayedAt := time.Now().UTC().Format(time.RFC3339)
+	for _, stored := range events {
+		replayEvent := *stored.Event
+		replayEvent.ID = uuid.New().String()
+		replayEvent.Topic = request.TargetTopic
+		replayEvent.Metadata = make(map[string]string, len(stored.Event.Metadata)+4)
+		for key, value := range stored.Event.Metadata {
+			replayEvent.Metadata[key] = value
+		}
 		replayEvent.Metadata["replayed"] = "true"
-		replayEvent.Metadata["original_topic"] = topic
-		replayEvent.Metadata["replay_timestamp"] = time.Now().Format(time.RFC3339)
+		replayEvent.Metadata["original_topic"] = stored.Topic
+		replayEvent.Metadata["original_event_id"] = stored.Event.ID
+		replayEvent.Metadata["replay_timestamp"] = replayedAt
 
-		err := p.PublishEvent(ctx, targetTopic, replayEvent.Data, replayEvent.Metadata)
-		if err != nil {
-			return fmt.Errorf("failed to replay event %s: %w", event.CorrelationID, err)
+		if err := p.PublishEvent(ctx, request.TargetTopic, &replayEvent); err != nil {
+			replayErr = fmt.Errorf("failed to replay event %s: %w", stored.EventID, err)
+			break
 		}
+		result.Replayed++
 	}
 
-	p.recordMetric("replayed_events", int64(len(events)))
-	return nil
+	p.recordMetric("replayed_events", int64(result.Replayed))
+	return result, replayErr
+}
+
+// DeadLetters returns the dead letter store shared by pub/sub and the notification
+// channels, or nil when dead lettering is disabled
+func (p *PubSub) DeadLetters() *DeadLetterStore {
+	return p.deadLetters
 }
 
-// GetFailedEvents returns all events currently in the dead letter queue
-func (p *PubSub) GetFailedEvents(ctx context.Context) (map[string]*FailedEvent, error) {
-	if p.dlqHandler == nil {
+// GetFailedEvents returns the events that could not be published and are in the dead letter queue
+func (p *PubSub) GetFailedEvents(ctx context.Context) ([]*DeadLetter, error) {
+	if p.deadLetters == nil {
 		return nil, fmt.Errorf("dead letter queue is not enabled")
 	}
 
-	p.dlqHandler.failedEventsMux.RLock()
-	defer p.dlqHandler.failedEventsMux.RUnlock()
+	return p.deadLetters.List(ctx, DeadLetterFilter{Source: DeadLetterSourcePubSub})
+}
 
-	// Create a copy to avoid concurrent access issues
-	failedEventsCopy := make(map[string]*FailedEvent)
-	for id, event := range p.dlqHandler.failedEvents {
-		failedEventsCopy[id] = event
+// RetryFailedEvent queues a failed event for republishing by RunDeadLetterRetries
+func (p *PubSub) RetryFailedEvent(ctx context.Context, eventID string) error {
+	if p.deadLetters == nil {
+		return fmt.Errorf("dead letter queue is not enabled")
 	}
 
-	return failedEventsCopy, nil
+	result := p.deadLetters.Retry(ctx, []string{eventID})
+	if reason, failed := result.Failed[eventID]; failed {
+		return fmt.Errorf("failed to retry event %s: %s", eventID, reason)
+	}
+	return nil
 }
 
-// RetryFailedEvent manually retries a specific failed event
-func (p *PubSub) RetryFailedEvent(ctx context.Context, eventID string) error {
-	if p.dlqHandler == nil {
-		return fmt.Errorf("dead letter queue is not enabled")
+// RunDeadLetterRetries republishes the failed events operators queued for retry until
+// ctx is cancelled
+func (p *PubSub) RunDeadLetterRetries(ctx context.Context) {
+	if p.deadLetters == nil {
+		return
 	}
+	p.deadLetters.RunRetries(ctx, DeadLetterSourcePubSub, p.redeliverDeadLetter)
+}
 
-	return p.dlqHandler.retryEvent(ctx, eventID)
+func (p *PubSub) redeliverDeadLetter(ctx context.Context, letter *DeadLetter) error {
+	var event EventMessage
+	if err := json.Unmarshal(letter.Payload, &event); err != nil {
+		return domain.NewValidationError(fmt.Sprintf("dead letter %s does not hold an event message", letter.DeadLetterID))
+	}
+	return p.PublishEvent(ctx, letter.Destination, &event)
+}
+
+// deadLetter records an event that could not be published
+func (p *PubSub) deadLetter(ctx context.Context, event *EventMessage, publishErr error) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return domain.WrapError(err, fmt.Sprintf("failed to marshal dead letter for topic %s", event.Topic))
+	}
+
+	p.recordMetric("dead_letter_events", 1)
+	return p.deadLetters.Record(ctx, &DeadLetter{
+		DeadLetterID:  event.ID,
+		Source:        DeadLetterSourcePubSub,
+		Destination:   event.Topic,
+		Reason:        DeadLetterReason(publishErr),
+		Error:         publishErr.Error(),
+		Payload:       payload,
+		CorrelationID: event.CorrelationID,
+	})
 }
 
 // GetMetrics returns current pub/sub performance metrics
@@ -1035,9 +982,8 @@ func (bp *EventBatchProcessor) flushBatch() error {
 		err := bp.pubsub.PublishEvent(context.Background(), event.Topic, event.Data, event.Metadata)
 		if err != nil {
 			// Handle individual event failure
-			if bp.pubsub.dlqHandler != nil {
-				eventID := fmt.Sprintf("%s-%d", event.Topic, time.Now().UnixNano())
-				bp.pubsub.dlqHandler.handleFailedEvent(context.Background(), eventID, event, err.Error())
+			if bp.pubsub.deadLetters != nil {
+				bp.pubsub.deadLetter(context.Background(), &event, err)
 			}
 		}
 	}
@@ -1060,9 +1006,8 @@ func (bp *EventBatchProcessor) processBatchParallel() error {
 
 			err := bp.pubsub.PublishEvent(context.Background(), evt.Topic, evt.Data, evt.Metadata)
 			if err != nil {
-				if bp.pubsub.dlqHandler != nil {
-					eventID := fmt.Sprintf("%s-%d", evt.Topic, time.Now().UnixNano())
-					bp.pubsub.dlqHandler.handleFailedEvent(context.Background(), eventID, evt, err.Error())
+				if bp.pubsub.deadLetters != nil {
+					bp.pubsub.deadLetter(context.Background(), &evt, err)
 				}
 			}
 		}(event)
@@ -1074,139 +1019,6 @@ func (bp *EventBatchProcessor) processBatchParallel() error {
 	return nil
 }
 
-// DeadLetterQueueHandler Methods
-
-func (dlq *DeadLetterQueueHandler) handleFailedEvent(ctx context.Context, eventID string, event EventMessage, reason string) error {
-	dlq.failedEventsMux.Lock()
-	defer dlq.failedEventsMux.Unlock()
-
-	now := time.Now()
-	
-	// Check if this event has failed before
-	if existingEvent, exists := dlq.failedEvents[eventID]; exists {
-		existingEvent.RetryCount++
-		existingEvent.LastAttempt = now
-		existingEvent.FailureReason = reason
-
-		// Check if max retries exceeded
-		if existingEvent.RetryCount >= dlq.maxRetries {
-			existingEvent.IsPermanent = true
-		} else {
-			// Calculate next retry time
-			if existingEvent.RetryCount-1 < len(dlq.retryDelays) {
-				existingEvent.NextRetry = now.Add(dlq.retryDelays[existingEvent.RetryCount-1])
-			} else {
-				// Use last delay for retries beyond defined delays
-				existingEvent.NextRetry = now.Add(dlq.retryDelays[len(dlq.retryDelays)-1])
-			}
-		}
-	} else {
-		// First failure
-		failedEvent := &FailedEvent{
-			OriginalEvent: event,
-			FailureReason: reason,
-			RetryCount:    1,
-			FirstFailure:  now,
-			LastAttempt:   now,
-			NextRetry:     now.Add(dlq.retryDelays[0]),
-			IsPermanent:   false,
-		}
-		dlq.failedEvents[eventID] = failedEvent
-	}
-
-	// Persist to state store for durability
-	err := dlq.stateStore.Save(ctx, fmt.Sprintf("dlq:event:%s", eventID), dlq.failedEvents[eventID], nil)
-	if err != nil {
-		return fmt.Errorf("failed to persist failed event to state store: %w", err)
-	}
-
-	dlq.pubsub.recordMetric("dead_letter_events", 1)
-	return nil
-}
-
-func (dlq *DeadLetterQueueHandler) retryEvent(ctx context.Context, eventID string) error {
-	dlq.failedEventsMux.RLock()
-	failedEvent, exists := dlq.failedEvents[eventID]
-	dlq.failedEventsMux.RUnlock()
-
-	if !exists {
-		return fmt.Errorf("failed event with ID %s not found", eventID)
-	}
-
-	if failedEvent.IsPermanent {
-		return fmt.Errorf("event %s has been marked as permanently failed", eventID)
-	}
-
-	// Attempt to republish the event
-	err := dlq.pubsub.PublishEvent(ctx, failedEvent.OriginalEvent.Topic, 
-		failedEvent.OriginalEvent.Data, failedEvent.OriginalEvent.Metadata)
-	
-	if err != nil {
-		// Update failure information
-		return dlq.handleFailedEvent(ctx, eventID, failedEvent.OriginalEvent, err.Error())
-	}
-
-	// Success - remove from failed events
-	dlq.failedEventsMux.Lock()
-	delete(dlq.failedEvents, eventID)
-	dlq.failedEventsMux.Unlock()
-
-	// Remove from state store
-	dlq.stateStore.Delete(ctx, fmt.Sprintf("dlq:event:%s", eventID), nil)
-
-	return nil
-}
-
-// EventStore Methods
-
-func (es *EventStore) StoreEvent(ctx context.Context, event EventMessage) error {
-	if !es.enabled {
-		return nil
-	}
-
-	eventKey := fmt.Sprintf("event:%s:%s:%d", event.Topic, event.CorrelationID, time.Now().UnixNano())
-	
-	// Store the event
-	err := es.stateStore.Save(ctx, eventKey, event, nil)
-	if err != nil {
-		return fmt.Errorf("failed to store event: %w", err)
-	}
-
-	// Update topic index
-	es.indexMutex.Lock()
-	es.indexByTopic[event.Topic] = append(es.indexByTopic[event.Topic], eventKey)
-	es.indexMutex.Unlock()
-
-	return nil
-}
-
-func (es *EventStore) GetEventsInTimeRange(ctx context.Context, topic string, startTime, endTime time.Time) ([]EventMessage, error) {
-	es.indexMutex.RLock()
-	eventKeys, exists := es.indexByTopic[topic]
-	es.indexMutex.RUnlock()
-
-	if !exists {
-		return []EventMessage{}, nil
-	}
-
-	var events []EventMessage
-	
-	// Retrieve events and filter by time range
-	for _, eventKey := range eventKeys {
-		var event EventMessage
-		found, err := es.stateStore.Get(ctx, eventKey, &event)
-		if err != nil {
-			continue // Skip failed retrievals
-		}
-		
-		if found && event.Time.After(startTime) && event.Time.Before(endTime) {
-			events = append(events, event)
-		}
-	}
-
-	return events, nil
-}
-
 // Helper Methods
 
 func (p *PubSub) publishWithRetry(ctx context.Context, event EventMessage, retryConfig *RetryConfig) error {
@@ -0,0 +1,137 @@
+package dapr
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/gorilla/mux"
+)
+
+// SagaAdminHandler serves the admin API for inspecting saga executions and for
+// retrying or aborting them by hand
+type SagaAdminHandler struct {
+	transactions *TransactionManager
+}
+
+// abortSagaRequest is the optional body of an abort request
+type abortSagaRequest struct {
+	Reason string `json:"reason"`
+}
+
+// NewSagaAdminHandler creates the saga admin API for a transaction manager
+func NewSagaAdminHandler(transactions *TransactionManager) *SagaAdminHandler {
+	return &SagaAdminHandler{transactions: transactions}
+}
+
+// RegisterRoutes registers the saga routes on a router mounted at the admin API root
+func (h *SagaAdminHandler) RegisterRoutes(router *mux.Router) {
+	router.HandleFunc("/sagas", h.ListSagas).Methods("GET")
+	router.HandleFunc("/sagas/{id}", h.GetSaga).Methods("GET")
+	router.HandleFunc("/sagas/{id}/retry", h.RetrySaga).Methods("POST")
+	router.HandleFunc("/sagas/{id}/abort", h.AbortSaga).Methods("POST")
+}
+
+// ListSagas lists saga executions, optionally narrowed by a comma-separated status parameter
+func (h *SagaAdminHandler) ListSagas(w http.ResponseWriter, r *http.Request) {
+	var filter SagaExecutionFilter
+	if value := r.URL.Query().Get("status"); value != "" {
+		for _, name := range strings.Split(value, ",") {
+			status, err := ParseSagaStatus(strings.TrimSpace(name))
+			if err != nil {
+				writeAdminError(w, r, err)
+				return
+			}
+			filter.Statuses = append(filter.Statuses, status)
+		}
+	}
+
+	executions, err := h.transactions.ListSagaExecutions(r.Context(), filter)
+	if err != nil {
+		writeAdminError(w, r, err)
+		return
+	}
+
+	writeAdminResponse(w, r, http.StatusOK, map[string]interface{}{
+		"data":  executions,
+		"count": len(executions),
+	})
+}
+
+// GetSaga returns one saga execution with the progress of each step
+func (h *SagaAdminHandler) GetSaga(w http.ResponseWriter, r *http.Request) {
+	execution, err := h.transactions.GetSagaExecution(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		writeAdminError(w, r, err)
+		return
+	}
+
+	writeAdminResponse(w, r, http.StatusOK, map[string]interface{}{"data": execution})
+}
+
+// RetrySaga resumes a failed or stalled saga execution
+func (h *SagaAdminHandler) RetrySaga(w http.ResponseWriter, r *http.Request) {
+	execution, err := h.transactions.RetrySagaExecution(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		writeAdminError(w, r, err)
+		return
+	}
+
+	writeAdminResponse(w, r, http.StatusAccepted, map[string]interface{}{"data": execution})
+}
+
+// AbortSaga stops a running saga execution and compensates its completed steps
+func (h *SagaAdminHandler) AbortSaga(w http.ResponseWriter, r *http.Request) {
+	var request abortSagaRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			writeAdminError(w, r, domain.NewValidationError("invalid request body"))
+			return
+		}
+	}
+
+	execution, err := h.transactions.AbortSagaExecution(r.Context(), mux.Vars(r)["id"], request.Reason)
+	if err != nil {
+		writeAdminError(w, r, err)
+		return
+	}
+
+	writeAdminResponse(w, r, http.StatusAccepted, map[string]interface{}{"data": execution})
+}
+
+func writeAdminResponse(w http.ResponseWriter, r *http.Request, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if correlationID := domain.GetCorrelationID(r.Context()); correlationID != "" {
+		w.Header().Set("X-Correlation-ID", correlationID)
+	}
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+// writeAdminError maps domain errors to their HTTP status
+func writeAdminError(w http.ResponseWriter, r *http.Request, err error) {
+	status, code := http.StatusInternalServerError, "INTERNAL_ERROR"
+	switch {
+	case domain.IsValidationError(err):
+		status, code = http.StatusBadRequest, "VALIDATION_ERROR"
+	case domain.IsNotFoundError(err):
+		status, code = http.StatusNotFound, "NOT_FOUND"
+	case domain.IsConflictError(err):
+		status, code = http.StatusConflict, "CONFLICT"
+	case domain.IsTimeoutError(err):
+		status, code = http.StatusGatewayTimeout, "TIMEOUT"
+	case domain.IsDependencyError(err):
+		status, code = http.StatusBadGateway, "DEPENDENCY_ERROR"
+	}
+
+	writeAdminResponse(w, r, status, map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":           code,
+			"message":        err.Error(),
+			"correlation_id": domain.GetCorrelationID(r.Context()),
+			"timestamp":      time.Now().UTC().Format(time.RFC3339),
+		},
+	})
+}
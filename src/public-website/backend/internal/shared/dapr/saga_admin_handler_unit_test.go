@@ -0,0 +1,86 @@
+package dapr
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSagaAdminHandler(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		body           string
+		expectedStatus int
+		expectedIDs    []string
+		expectedCode   string
+	}{
+		{name: "list every saga", method: http.MethodGet, path: "/admin/api/v1/sagas", expectedStatus: http.StatusOK, expectedIDs: []string{"running", "failed"}},
+		{name: "list by status", method: http.MethodGet, path: "/admin/api/v1/sagas?status=failed,compensated", expectedStatus: http.StatusOK, expectedIDs: []string{"failed"}},
+		{name: "reject unknown status", method: http.MethodGet, path: "/admin/api/v1/sagas?status=stuck", expectedStatus: http.StatusBadRequest, expectedCode: "VALIDATION_ERROR"},
+		{name: "get saga", method: http.MethodGet, path: "/admin/api/v1/sagas/running", expectedStatus: http.StatusOK, expectedIDs: []string{"running"}},
+		{name: "get missing saga", method: http.MethodGet, path: "/admin/api/v1/sagas/missing", expectedStatus: http.StatusNotFound, expectedCode: "NOT_FOUND"},
+		{name: "retry failed saga", method: http.MethodPost, path: "/admin/api/v1/sagas/failed/retry", expectedStatus: http.StatusAccepted, expectedIDs: []string{"failed"}},
+		{name: "reject retrying a running saga", method: http.MethodPost, path: "/admin/api/v1/sagas/running/retry", expectedStatus: http.StatusConflict, expectedCode: "CONFLICT"},
+		{name: "abort running saga", method: http.MethodPost, path: "/admin/api/v1/sagas/running/abort", body: `{"reason":"duplicate"}`, expectedStatus: http.StatusAccepted, expectedIDs: []string{"running"}},
+		{name: "reject aborting a failed saga", method: http.MethodPost, path: "/admin/api/v1/sagas/failed/abort", expectedStatus: http.StatusConflict, expectedCode: "CONFLICT"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tm := newTestTransactionManager(&recordingStepExecutor{})
+			live := time.Now().Add(time.Hour)
+			seedSagaExecution(t, tm, &SagaExecution{SagaID: "running", Definition: testSaga("running"), Status: SagaStatusExecuting,
+				StartedAt: time.Now(), LeaseOwner: "other-manager", LeaseExpiresAt: &live})
+			seedSagaExecution(t, tm, &SagaExecution{SagaID: "failed", Definition: testSaga("failed"), Status: SagaStatusFailed,
+				CompletedSteps: []string{"reserve"}, StartedAt: time.Now().Add(-time.Hour)})
+
+			router := mux.NewRouter()
+			NewSagaAdminHandler(tm).RegisterRoutes(router.PathPrefix("/admin/api/v1").Subrouter())
+
+			request := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			recorder := httptest.NewRecorder()
+
+			// Act
+			router.ServeHTTP(recorder, request)
+			tm.Wait()
+
+			// Assert
+			require.Equal(t, tt.expectedStatus, recorder.Code, recorder.Body.String())
+			var response struct {
+				Data  json.RawMessage `json:"data"`
+				Error struct {
+					Code string `json:"code"`
+				} `json:"error"`
+			}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+			if tt.expectedCode != "" {
+				assert.Equal(t, tt.expectedCode, response.Error.Code)
+				return
+			}
+
+			var executions []*SagaExecution
+			if strings.HasPrefix(string(response.Data), "[") {
+				require.NoError(t, json.Unmarshal(response.Data, &executions))
+			} else {
+				var execution SagaExecution
+				require.NoError(t, json.Unmarshal(response.Data, &execution))
+				executions = append(executions, &execution)
+			}
+			ids := make([]string, 0, len(executions))
+			for _, execution := range executions {
+				ids = append(ids, execution.SagaID)
+			}
+			assert.Equal(t, tt.expectedIDs, ids)
+		})
+	}
+}
@@ -0,0 +1,385 @@
+package dapr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+)
+
+const (
+	sagaDomain      = "saga"
+	sagaEntityType  = "execution"
+	sagaStatusIndex = "status"
+)
+
+// sagaExecutionIndexes lets recovery and the admin API find executions by status
+var sagaExecutionIndexes = &IndexedEntityType{
+	Domain:     sagaDomain,
+	EntityType: sagaEntityType,
+	Indexes: []IndexDefinition{
+		{Name: sagaStatusIndex, Extract: func(entity interface{}) []string {
+			return []string{string(entity.(*SagaExecution).Status)}
+		}},
+	},
+	NewEntity: func() interface{} { return &SagaExecution{} },
+	EntityID:  func(entity interface{}) string { return entity.(*SagaExecution).SagaID },
+}
+
+// sagaStatuses lists every status in the order they are reported
+var sagaStatuses = []SagaStatus{
+	SagaStatusPending,
+	SagaStatusExecuting,
+	SagaStatusCompensating,
+	SagaStatusFailed,
+	SagaStatusCompleted,
+	SagaStatusCompensated,
+}
+
+// SagaExecutionFilter selects saga executions by status; no statuses selects them all
+type SagaExecutionFilter struct {
+	Statuses []SagaStatus
+}
+
+// ParseSagaStatus validates a saga status named by a caller
+func ParseSagaStatus(value string) (SagaStatus, error) {
+	for _, status := range sagaStatuses {
+		if string(status) == value {
+			return status, nil
+		}
+	}
+	return "", domain.NewValidationFieldError("status", fmt.Sprintf("unknown saga status %q", value))
+}
+
+// RunRecovery resumes abandoned sagas until ctx is cancelled. It checks once at start so
+// that sagas interrupted by a restart carry on straight away; sagas left by a crashed
+// instance are picked up on a later check, once that instance's lease has lapsed.
+func (tm *TransactionManager) RunRecovery(ctx context.Context) {
+	ticker := time.NewTicker(tm.recoveryInterval)
+	defer ticker.Stop()
+
+	for {
+		if resumed, err := tm.RecoverSagas(ctx); err != nil {
+			log.Printf("Saga recovery run failed: %v", err)
+		} else if resumed > 0 {
+			log.Printf("Saga recovery resumed %d saga executions", resumed)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RecoverSagas claims every unfinished saga execution that no other manager holds a live
+// lease on and resumes it in the background: pending and executing sagas carry on with
+// their next step, compensating ones with undoing their completed steps. It returns how
+// many executions it resumed; Wait blocks until they settle.
+func (tm *TransactionManager) RecoverSagas(ctx context.Context) (int, error) {
+	ids, err := tm.stateStore.LookupIndexAny(ctx, sagaDomain, sagaEntityType, sagaStatusIndex, []string{
+		string(SagaStatusPending),
+		string(SagaStatusExecuting),
+		string(SagaStatusCompensating),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	resumed := 0
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			return resumed, ctx.Err()
+		}
+
+		run, err := tm.claimSaga(ctx, id)
+		if err != nil {
+			log.Printf("Failed to claim saga %s for recovery: %v", id, err)
+			continue
+		}
+		if run == nil {
+			continue
+		}
+
+		resumed++
+		go tm.runSaga(ctx, run)
+	}
+
+	return resumed, nil
+}
+
+// Wait blocks until every saga this manager is running has stopped
+func (tm *TransactionManager) Wait() {
+	tm.runs.Wait()
+}
+
+// GetSagaExecution retrieves a saga execution by ID
+func (tm *TransactionManager) GetSagaExecution(ctx context.Context, sagaID string) (*SagaExecution, error) {
+	execution, _, err := tm.loadSagaExecution(ctx, sagaID)
+	return execution, err
+}
+
+// ListSagaExecutions returns the executions matching filter, most recently started first
+func (tm *TransactionManager) ListSagaExecutions(ctx context.Context, filter SagaExecutionFilter) ([]*SagaExecution, error) {
+	statuses := filter.Statuses
+	if len(statuses) == 0 {
+		statuses = sagaStatuses
+	}
+	values := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		values = append(values, string(status))
+	}
+
+	ids, err := tm.stateStore.LookupIndexAny(ctx, sagaDomain, sagaEntityType, sagaStatusIndex, values)
+	if err != nil {
+		return nil, err
+	}
+
+	executions := make([]*SagaExecution, 0, len(ids))
+	for _, id := range ids {
+		execution, _, err := tm.loadSagaExecution(ctx, id)
+		if domain.IsNotFoundError(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		executions = append(executions, execution)
+	}
+
+	sort.SliceStable(executions, func(i, j int) bool {
+		return executions[i].StartedAt.After(executions[j].StartedAt)
+	})
+	return executions, nil
+}
+
+// RetrySagaExecution resumes a saga an operator has looked into. A failed saga retries
+// the compensation that stopped it, and a saga whose manager stopped renewing its lease
+// is resumed now rather than on the next recovery check. Retried steps reuse their
+// idempotency keys.
+func (tm *TransactionManager) RetrySagaExecution(ctx context.Context, sagaID string) (*SagaExecution, error) {
+	return tm.intervene(ctx, sagaID, func(execution *SagaExecution) error {
+		switch {
+		case execution.Status == SagaStatusFailed:
+			execution.Status = SagaStatusCompensating
+			if execution.Compensation != nil {
+				execution.Compensation.Status = "running"
+				execution.Compensation.ErrorMessage = ""
+			}
+			execution.CompletedAt = nil
+			return nil
+		case !execution.settled() && !tm.leaseLive(execution):
+			return nil
+		default:
+			return domain.NewConflictError(fmt.Sprintf("saga execution %s is %s and cannot be retried", sagaID, execution.Status))
+		}
+	})
+}
+
+// AbortSagaExecution stops a pending or executing saga and compensates the steps it has
+// completed. A step in flight when the saga is aborted is compensated once its deadline
+// has passed.
+func (tm *TransactionManager) AbortSagaExecution(ctx context.Context, sagaID, reason string) (*SagaExecution, error) {
+	return tm.intervene(ctx, sagaID, func(execution *SagaExecution) error {
+		if execution.Status != SagaStatusPending && execution.Status != SagaStatusExecuting {
+			return domain.NewConflictError(fmt.Sprintf("saga execution %s is %s and cannot be aborted", sagaID, execution.Status))
+		}
+
+		message := "aborted by operator"
+		if reason != "" {
+			message = fmt.Sprintf("%s: %s", message, reason)
+		}
+		tm.beginCompensation(execution, message)
+		return nil
+	})
+}
+
+// intervene applies an operator's change to an execution and resumes it. The change
+// releases the current lease, so a run still driving the saga fails its next save and
+// hands the saga over instead of carrying on.
+func (tm *TransactionManager) intervene(ctx context.Context, sagaID string, change func(*SagaExecution) error) (*SagaExecution, error) {
+	execution, etag, err := tm.loadSagaExecution(ctx, sagaID)
+	if err != nil {
+		return nil, err
+	}
+	if err := change(execution); err != nil {
+		return nil, err
+	}
+
+	execution.LeaseOwner = ""
+	execution.LeaseExpiresAt = nil
+	if _, err := tm.saveSagaExecution(ctx, execution, etag); err != nil {
+		return nil, err
+	}
+
+	// Claim before returning so the run is under way by the time the operator hears back
+	run, err := tm.claimSaga(ctx, sagaID)
+	if err != nil {
+		log.Printf("Failed to claim saga %s, leaving it to recovery: %v", sagaID, err)
+	} else if run != nil {
+		go tm.runSaga(context.Background(), run)
+	}
+	return execution, nil
+}
+
+// resumeSaga claims an execution and drives it, unless it is settled or running elsewhere
+func (tm *TransactionManager) resumeSaga(ctx context.Context, sagaID string) {
+	run, err := tm.claimSaga(ctx, sagaID)
+	if err != nil {
+		log.Printf("Failed to claim saga %s: %v", sagaID, err)
+		return
+	}
+	if run != nil {
+		tm.runSaga(ctx, run)
+	}
+}
+
+// claimSaga takes the lease on an unfinished execution. It returns nil when the saga is
+// settled, already running in this process, or leased by a manager that is still alive.
+func (tm *TransactionManager) claimSaga(ctx context.Context, sagaID string) (*sagaRun, error) {
+	if !tm.markRunning(sagaID) {
+		return nil, nil
+	}
+
+	execution, etag, err := tm.loadSagaExecution(ctx, sagaID)
+	if err != nil {
+		tm.markStopped(sagaID)
+		return nil, err
+	}
+	if execution.settled() || (execution.LeaseOwner != tm.owner && tm.leaseLive(execution)) {
+		tm.markStopped(sagaID)
+		return nil, nil
+	}
+
+	run := &sagaRun{execution: execution, etag: etag}
+	if err := tm.persistRun(ctx, run, tm.leaseDuration); err != nil {
+		tm.markStopped(sagaID)
+		if domain.IsConflictError(err) {
+			// Another manager claimed it first
+			return nil, nil
+		}
+		return nil, err
+	}
+	return run, nil
+}
+
+// leaseLive reports whether some manager holds an unexpired lease on the execution
+func (tm *TransactionManager) leaseLive(execution *SagaExecution) bool {
+	return execution.LeaseOwner != "" && execution.LeaseExpiresAt != nil && tm.now().Before(*execution.LeaseExpiresAt)
+}
+
+// markRunning records that this process drives the saga, reporting false if it already does
+func (tm *TransactionManager) markRunning(sagaID string) bool {
+	tm.sagaMutex.Lock()
+	defer tm.sagaMutex.Unlock()
+
+	if tm.running[sagaID] {
+		return false
+	}
+	tm.running[sagaID] = true
+	tm.runs.Add(1)
+	return true
+}
+
+func (tm *TransactionManager) markStopped(sagaID string) {
+	tm.sagaMutex.Lock()
+	defer tm.sagaMutex.Unlock()
+
+	if tm.running[sagaID] {
+		delete(tm.running, sagaID)
+		tm.runs.Done()
+	}
+}
+
+// persistRun saves a claimed execution and renews the lease on it for leaseFor. A
+// settled execution gives its lease up instead.
+func (tm *TransactionManager) persistRun(ctx context.Context, run *sagaRun, leaseFor time.Duration) error {
+	execution := run.execution
+	if execution.settled() {
+		execution.LeaseOwner = ""
+		execution.LeaseExpiresAt = nil
+	} else {
+		expiresAt := tm.now().Add(leaseFor)
+		execution.LeaseOwner = tm.owner
+		execution.LeaseExpiresAt = &expiresAt
+	}
+
+	etag, err := tm.saveSagaExecution(ctx, execution, run.etag)
+	if err != nil {
+		return err
+	}
+	run.etag = etag
+	return nil
+}
+
+// createSagaExecution stores a new execution, claimed by this manager
+func (tm *TransactionManager) createSagaExecution(ctx context.Context, execution *SagaExecution) (*sagaRun, error) {
+	_, _, err := tm.loadSagaExecution(ctx, execution.SagaID)
+	if err == nil {
+		return nil, domain.NewConflictError(fmt.Sprintf("saga execution %s already exists", execution.SagaID))
+	}
+	if !domain.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	run := &sagaRun{execution: execution}
+	if err := tm.persistRun(ctx, run, tm.leaseDuration); err != nil {
+		return nil, err
+	}
+	return &sagaRun{execution: cloneSagaExecution(execution), etag: run.etag}, nil
+}
+
+// saveSagaExecution writes the execution while the stored copy still carries etag and
+// returns the new ETag. Reading the write back confirms no other save slipped in
+// between, which would leave the returned ETag belonging to someone else's change.
+func (tm *TransactionManager) saveSagaExecution(ctx context.Context, execution *SagaExecution, etag string) (string, error) {
+	execution.Revision++
+	execution.UpdatedAt = tm.now()
+	if err := tm.stateStore.SaveIndexedWithETag(ctx, sagaDomain, sagaEntityType, execution.SagaID, execution, etag); err != nil {
+		execution.Revision--
+		return "", err
+	}
+
+	stored, storedETag, err := tm.loadSagaExecution(ctx, execution.SagaID)
+	if err != nil {
+		return "", err
+	}
+	if stored.Revision != execution.Revision {
+		return "", domain.NewConflictError(fmt.Sprintf("saga execution %s changed while it was being saved", execution.SagaID))
+	}
+	return storedETag, nil
+}
+
+func (tm *TransactionManager) loadSagaExecution(ctx context.Context, sagaID string) (*SagaExecution, string, error) {
+	if sagaID == "" {
+		return nil, "", domain.NewValidationFieldError("saga_id", "saga ID is required")
+	}
+
+	var execution SagaExecution
+	found, etag, err := tm.stateStore.GetWithETag(ctx, tm.stateStore.CreateKey(sagaDomain, sagaEntityType, sagaID), &execution)
+	if err != nil {
+		return nil, "", err
+	}
+	if !found {
+		return nil, "", domain.NewNotFoundError("saga execution", sagaID)
+	}
+	return &execution, etag, nil
+}
+
+// cloneSagaExecution deep-copies an execution through its stored form
+func cloneSagaExecution(execution *SagaExecution) *SagaExecution {
+	data, err := json.Marshal(execution)
+	if err != nil {
+		return execution
+	}
+	var clone SagaExecution
+	if err := json.Unmarshal(data, &clone); err != nil {
+		return execution
+	}
+	return &clone
+}
@@ -0,0 +1,178 @@
+package dapr
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+)
+
+// SecretVersion is one value of a secret. UpdatedAt is zero when the store does not
+// report when the value was written.
+type SecretVersion struct {
+	Key       string
+	Value     string
+	Version   string
+	UpdatedAt time.Time
+}
+
+// SecretBackend reads and writes versioned secrets. Secrets uses the Dapr secret store
+// unless a backend is set; Dapr secret stores are read-only, so secrets kept there are
+// rotated in the store itself and only propagated by the SecretRotator.
+type SecretBackend interface {
+	GetSecretVersion(ctx context.Context, key string) (*SecretVersion, error)
+	PutSecret(ctx context.Context, key, value string) (*SecretVersion, error)
+}
+
+// UseBackend reads and writes secrets through backend instead of the Dapr secret store
+func (s *Secrets) UseBackend(backend SecretBackend) {
+	s.cacheMutex.Lock()
+	defer s.cacheMutex.Unlock()
+	s.backend = backend
+	s.cache = make(map[string]*secretCacheItem)
+}
+
+// GetSecretVersion reads the current value of a secret from the store, bypassing the
+// cache. Values read from Dapr are versioned by fingerprint, so a value changed in the
+// store shows up as a new version.
+func (s *Secrets) GetSecretVersion(ctx context.Context, key string) (*SecretVersion, error) {
+	if s.backend != nil {
+		return s.backend.GetSecretVersion(ctx, key)
+	}
+
+	var value string
+	if s.client.GetClient() == nil {
+		mock, err := s.getMockSecret(key)
+		if err != nil {
+			return nil, err
+		}
+		value = mock
+	} else {
+		secret, err := s.client.GetClient().GetSecret(ctx, s.storeName, key, nil)
+		if err != nil {
+			return nil, domain.NewDependencyError(fmt.Sprintf("failed to get secret %s", key), err)
+		}
+		if len(secret) == 0 {
+			return nil, domain.NewNotFoundError("secret", key)
+		}
+		for _, v := range secret {
+			value = v
+			break
+		}
+	}
+
+	return &SecretVersion{Key: key, Value: value, Version: secretFingerprint(value)}, nil
+}
+
+// PutSecret writes a new value of a secret and drops the cached one
+func (s *Secrets) PutSecret(ctx context.Context, key, value string) (*SecretVersion, error) {
+	if s.backend == nil {
+		return nil, domain.NewDependencyError(fmt.Sprintf("secret store %s is read-only; rotate %s in the store", s.storeName, key), nil)
+	}
+
+	version, err := s.backend.PutSecret(ctx, key, value)
+	if err != nil {
+		return nil, err
+	}
+	s.ClearSecretFromCache(key)
+	return version, nil
+}
+
+// secretFingerprint identifies a secret value without revealing it
+func secretFingerprint(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:6])
+}
+
+// LocalSecretStore is an in-memory secret store standing in for the Dapr secret store
+// in tests and local development. Like the providers behind it, it keeps the previous
+// value of a rotated secret valid until that version is retired.
+type LocalSecretStore struct {
+	mutex    sync.RWMutex
+	versions map[string][]*localSecretVersion
+	now      func() time.Time
+}
+
+type localSecretVersion struct {
+	SecretVersion
+	retired bool
+}
+
+// NewLocalSecretStore creates a local secret store holding values
+func NewLocalSecretStore(values map[string]string) *LocalSecretStore {
+	store := &LocalSecretStore{versions: make(map[string][]*localSecretVersion), now: time.Now}
+	for key, value := range values {
+		store.PutSecret(context.Background(), key, value)
+	}
+	return store
+}
+
+// GetSecretVersion returns the current value of a secret
+func (l *LocalSecretStore) GetSecretVersion(ctx context.Context, key string) (*SecretVersion, error) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	versions := l.versions[key]
+	if len(versions) == 0 {
+		return nil, domain.NewNotFoundError("secret", key)
+	}
+	current := versions[len(versions)-1].SecretVersion
+	return &current, nil
+}
+
+// PutSecret adds a new current value; earlier values stay valid until retired
+func (l *LocalSecretStore) PutSecret(ctx context.Context, key, value string) (*SecretVersion, error) {
+	if value == "" {
+		return nil, domain.NewValidationError(fmt.Sprintf("secret %s cannot be empty", key))
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	version := &localSecretVersion{SecretVersion: SecretVersion{
+		Key:       key,
+		Value:     value,
+		Version:   fmt.Sprintf("%d", len(l.versions[key])+1),
+		UpdatedAt: l.now().UTC(),
+	}}
+	l.versions[key] = append(l.versions[key], version)
+	result := version.SecretVersion
+	return &result, nil
+}
+
+// RetireVersion revokes an earlier value of a secret
+func (l *LocalSecretStore) RetireVersion(ctx context.Context, key, version string) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	versions := l.versions[key]
+	for i, stored := range versions {
+		if stored.Version != version {
+			continue
+		}
+		if i == len(versions)-1 {
+			return domain.NewConflictError(fmt.Sprintf("version %s is the current value of secret %s", version, key))
+		}
+		stored.retired = true
+		return nil
+	}
+	return domain.NewNotFoundError("secret version", key+"/"+version)
+}
+
+// Accepts reports whether value is the current or a not yet retired value of a secret,
+// the way a provider accepts either credential during a rotation grace period
+func (l *LocalSecretStore) Accepts(key, value string) bool {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	for _, stored := range l.versions[key] {
+		if stored.Value == value && !stored.retired {
+			return true
+		}
+	}
+	return false
+}
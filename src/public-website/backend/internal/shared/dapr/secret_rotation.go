@@ -0,0 +1,506 @@
+package dapr
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/google/uuid"
+)
+
+const (
+	secretRotationDomain     = "secrets"
+	secretRotationEntityType = "rotation"
+	secretRotationGraceIndex = "in_grace"
+
+	defaultSecretRotationInterval = time.Minute
+	defaultSecretGracePeriod      = time.Hour
+
+	// secretRotationClaimTimeout bounds how long a crashed instance's claim blocks
+	// other instances from rotating the secret
+	secretRotationClaimTimeout = 5 * time.Minute
+)
+
+// Reasons a secret changed, recorded with each rotation
+const (
+	SecretRotationReasonMaxAge   = "max_age"
+	SecretRotationReasonExternal = "external"
+)
+
+// SecretRotationPolicy decides when a secret is rotated and how. A policy without a
+// MaxAge never rotates the secret itself; changes made in the store are still
+// propagated to consumers.
+type SecretRotationPolicy struct {
+	Key         string
+	MaxAge      time.Duration
+	GracePeriod time.Duration
+
+	// Generate creates the next value; random 48 byte values are used when unset
+	Generate func(ctx context.Context, current string) (string, error)
+
+	// Retire revokes the previous version at the provider once the grace period ends
+	Retire func(ctx context.Context, previousVersion string) error
+}
+
+// SecretCredentials is what a consumer re-initializes with. During the grace period
+// after a rotation both values are valid; Previous is empty once it has been retired.
+type SecretCredentials struct {
+	Key                string
+	Current            string
+	Previous           string
+	PreviousValidUntil time.Time
+}
+
+// SecretConsumer holds a client built from secrets, such as an email provider client,
+// and re-initializes it when one of its secrets changes
+type SecretConsumer struct {
+	Name         string
+	Keys         []string
+	Reinitialize func(ctx context.Context, credentials SecretCredentials) error
+}
+
+// SecretRotationRecord is the shared rotation history of one secret. It never holds
+// secret values.
+type SecretRotationRecord struct {
+	Key             string     `json:"key"`
+	Version         string     `json:"version"`
+	PreviousVersion string     `json:"previous_version,omitempty"`
+	Reason          string     `json:"reason,omitempty"`
+	RotatedAt       time.Time  `json:"rotated_at"`
+	GraceUntil      *time.Time `json:"grace_until,omitempty"`
+	PreviousRetired bool       `json:"previous_retired"`
+	ClaimedBy       string     `json:"claimed_by,omitempty"`
+	ClaimedAt       *time.Time `json:"claimed_at,omitempty"`
+	LastError       string     `json:"last_error,omitempty"`
+	NextRotationAt  *time.Time `json:"next_rotation_at,omitempty"`
+}
+
+// appliedSecret is what this instance's consumers were last given for a secret
+type appliedSecret struct {
+	version            string
+	value              string
+	previous           string
+	previousValidUntil time.Time
+}
+
+// SecretRotator rotates secrets when they reach their maximum age and propagates new
+// values, whether rotated here or in the store, to the registered consumers of every
+// instance. Each instance clears its cached value and re-initializes its consumers with
+// both credentials until the grace period ends; one instance then retires the previous
+// version. Rotations are recorded in the state store and audited through the outbox.
+type SecretRotator struct {
+	secrets     *Secrets
+	stateStore  *StateStore
+	pubsub      *PubSub
+	instanceID  string
+	interval    time.Duration
+	gracePeriod time.Duration
+	now         func() time.Time
+
+	mutex     sync.Mutex
+	policies  map[string]SecretRotationPolicy
+	consumers []SecretConsumer
+	applied   map[string]*appliedSecret
+}
+
+// SecretRotationIndexes keeps the secrets whose previous version is still valid findable
+func SecretRotationIndexes() *IndexedEntityType {
+	return &IndexedEntityType{
+		Domain:     secretRotationDomain,
+		EntityType: secretRotationEntityType,
+		Indexes: []IndexDefinition{
+			{Name: secretRotationGraceIndex, Extract: func(entity interface{}) []string {
+				return []string{IndexBool(!entity.(*SecretRotationRecord).PreviousRetired)}
+			}},
+		},
+		NewEntity: func() interface{} { return &SecretRotationRecord{} },
+		EntityID:  func(entity interface{}) string { return entity.(*SecretRotationRecord).Key },
+	}
+}
+
+// NewSecretRotator creates a rotator for secrets, recording rotations in stateStore and
+// auditing them on the audit topic of pubsub
+func NewSecretRotator(secrets *Secrets, stateStore *StateStore, pubsub *PubSub) *SecretRotator {
+	stateStore.MustRegisterIndexes(SecretRotationIndexes())
+	stateStore.MustRegisterIndexes(OutboxMessageIndexes())
+
+	return &SecretRotator{
+		secrets:     secrets,
+		stateStore:  stateStore,
+		pubsub:      pubsub,
+		instanceID:  uuid.New().String(),
+		interval:    parseDurationEnv("SECRET_ROTATION_INTERVAL", defaultSecretRotationInterval),
+		gracePeriod: parseDurationEnv("SECRET_ROTATION_GRACE_PERIOD", defaultSecretGracePeriod),
+		now:         time.Now,
+		policies:    make(map[string]SecretRotationPolicy),
+		applied:     make(map[string]*appliedSecret),
+	}
+}
+
+// Register adds the rotation policy of a secret. Policies without a grace period use
+// SECRET_ROTATION_GRACE_PERIOD.
+func (r *SecretRotator) Register(policy SecretRotationPolicy) error {
+	if policy.Key == "" || policy.MaxAge < 0 || policy.GracePeriod < 0 {
+		return domain.NewValidationError("secret rotation policy needs a key and non-negative durations")
+	}
+	if policy.GracePeriod == 0 {
+		policy.GracePeriod = r.gracePeriod
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if _, exists := r.policies[policy.Key]; exists {
+		return domain.NewConflictError(fmt.Sprintf("secret %s already has a rotation policy", policy.Key))
+	}
+	r.policies[policy.Key] = policy
+	return nil
+}
+
+// Subscribe adds a consumer to re-initialize when any of its secrets change. Consumers
+// are expected to start with the current values.
+func (r *SecretRotator) Subscribe(consumer SecretConsumer) error {
+	if consumer.Name == "" || len(consumer.Keys) == 0 || consumer.Reinitialize == nil {
+		return domain.NewValidationError("secret consumer needs a name, keys and a reinitialize function")
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for _, key := range consumer.Keys {
+		if _, ok := r.policies[key]; !ok {
+			return domain.NewValidationError(fmt.Sprintf("secret %s has no rotation policy", key))
+		}
+	}
+	r.consumers = append(r.consumers, consumer)
+	return nil
+}
+
+// Run checks every secret until ctx is cancelled
+func (r *SecretRotator) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.CheckSecrets(ctx); err != nil {
+			log.Printf("Secret rotation check failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckSecrets rotates the secrets past their maximum age, propagates changed values
+// and ends expired grace periods. A failure on one secret does not stop the others.
+func (r *SecretRotator) CheckSecrets(ctx context.Context) error {
+	var failed []string
+	for _, policy := range r.sortedPolicies() {
+		if err := r.checkSecret(ctx, policy); err != nil {
+			log.Printf("Secret %s: %v", policy.Key, err)
+			failed = append(failed, policy.Key)
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("secrets not checked: %v", failed)
+	}
+	return nil
+}
+
+// Status returns the rotation record of every secret with a policy
+func (r *SecretRotator) Status(ctx context.Context) ([]*SecretRotationRecord, error) {
+	records := []*SecretRotationRecord{}
+	for _, policy := range r.sortedPolicies() {
+		record, _, err := r.load(ctx, policy.Key)
+		if err != nil {
+			if domain.IsNotFoundError(err) {
+				continue
+			}
+			return nil, err
+		}
+		if policy.MaxAge > 0 {
+			next := record.RotatedAt.Add(policy.MaxAge)
+			record.NextRotationAt = &next
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func (r *SecretRotator) checkSecret(ctx context.Context, policy SecretRotationPolicy) error {
+	current, err := r.secrets.GetSecretVersion(ctx, policy.Key)
+	if err != nil {
+		return err
+	}
+
+	record, etag, err := r.load(ctx, policy.Key)
+	switch {
+	case domain.IsNotFoundError(err):
+		record, err = r.recordFirstSighting(ctx, current)
+	case err != nil:
+		return err
+	case record.Version != current.Version:
+		record, err = r.recordRotation(ctx, record, etag, current, SecretRotationReasonExternal, policy)
+	case policy.MaxAge > 0 && !r.now().Before(record.RotatedAt.Add(policy.MaxAge)):
+		record, current, err = r.rotate(ctx, record, etag, current, policy)
+	case record.GraceUntil != nil && !record.PreviousRetired && !r.now().Before(*record.GraceUntil):
+		record, err = r.retirePrevious(ctx, record, etag, policy)
+	}
+	if err != nil {
+		return err
+	}
+
+	return r.propagate(ctx, policy.Key, current, record)
+}
+
+// recordFirstSighting starts the history of a secret from its current value; nothing
+// is propagated for it
+func (r *SecretRotator) recordFirstSighting(ctx context.Context, current *SecretVersion) (*SecretRotationRecord, error) {
+	rotatedAt := current.UpdatedAt
+	if rotatedAt.IsZero() {
+		rotatedAt = r.now().UTC()
+	}
+
+	record := &SecretRotationRecord{Key: current.Key, Version: current.Version, RotatedAt: rotatedAt, PreviousRetired: true}
+	if err := r.stateStore.SaveIndexed(ctx, secretRotationDomain, secretRotationEntityType, current.Key, record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// rotate claims the secret so that only one instance rotates it, writes a new value
+// and records the rotation
+func (r *SecretRotator) rotate(ctx context.Context, record *SecretRotationRecord, etag string, current *SecretVersion, policy SecretRotationPolicy) (*SecretRotationRecord, *SecretVersion, error) {
+	now := r.now().UTC()
+	if record.ClaimedAt != nil && record.ClaimedBy != r.instanceID && now.Sub(*record.ClaimedAt) < secretRotationClaimTimeout {
+		return record, current, nil
+	}
+
+	record.ClaimedBy, record.ClaimedAt = r.instanceID, &now
+	if err := r.stateStore.SaveIndexedWithETag(ctx, secretRotationDomain, secretRotationEntityType, policy.Key, record, etag); err != nil {
+		if domain.IsConflictError(err) {
+			return record, current, nil
+		}
+		return nil, nil, err
+	}
+	claimETag, err := r.stateStore.GetETag(ctx, r.recordKey(policy.Key))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	value, err := r.generate(ctx, policy, current.Value)
+	if err == nil {
+		err = r.secrets.ValidateSecret(policy.Key, value)
+	}
+	var rotated *SecretVersion
+	if err == nil {
+		rotated, err = r.secrets.PutSecret(ctx, policy.Key, value)
+	}
+	if err != nil {
+		record.ClaimedBy, record.ClaimedAt, record.LastError = "", nil, err.Error()
+		if saveErr := r.stateStore.SaveIndexedWithETag(ctx, secretRotationDomain, secretRotationEntityType, policy.Key, record, claimETag); saveErr != nil {
+			log.Printf("Failed to release rotation claim on secret %s: %v", policy.Key, saveErr)
+		}
+		return nil, nil, fmt.Errorf("rotation failed: %w", err)
+	}
+
+	record, err = r.recordRotation(ctx, record, claimETag, rotated, SecretRotationReasonMaxAge, policy)
+	return record, rotated, err
+}
+
+func (r *SecretRotator) generate(ctx context.Context, policy SecretRotationPolicy, current string) (string, error) {
+	if policy.Generate != nil {
+		return policy.Generate(ctx, current)
+	}
+
+	buffer := make([]byte, 48)
+	if _, err := rand.Read(buffer); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buffer), nil
+}
+
+// recordRotation moves the history of a secret to a new version and opens the grace
+// period of the previous one
+func (r *SecretRotator) recordRotation(ctx context.Context, record *SecretRotationRecord, etag string, rotated *SecretVersion, reason string, policy SecretRotationPolicy) (*SecretRotationRecord, error) {
+	before := *record
+	now := r.now().UTC()
+	graceUntil := now.Add(policy.GracePeriod)
+
+	record.PreviousVersion = record.Version
+	record.Version = rotated.Version
+	record.Reason = reason
+	record.RotatedAt = now
+	record.GraceUntil = &graceUntil
+	record.PreviousRetired = false
+	record.ClaimedBy, record.ClaimedAt, record.LastError = "", nil, ""
+
+	messages, err := r.auditMessages(ctx, record.Key, "rotated", &before, record)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.stateStore.SaveIndexedWithOutbox(ctx, secretRotationDomain, secretRotationEntityType, record.Key, record, etag, messages...); err != nil {
+		if domain.IsConflictError(err) {
+			// Another instance recorded the same change first
+			latest, _, loadErr := r.load(ctx, record.Key)
+			if loadErr != nil {
+				return nil, loadErr
+			}
+			return latest, nil
+		}
+		return nil, err
+	}
+
+	log.Printf("Secret %s rotated to version %s (%s); previous version valid until %s", record.Key, record.Version, reason, graceUntil.Format(time.RFC3339))
+	return record, nil
+}
+
+// retirePrevious ends the grace period of the previous version of a secret
+func (r *SecretRotator) retirePrevious(ctx context.Context, record *SecretRotationRecord, etag string, policy SecretRotationPolicy) (*SecretRotationRecord, error) {
+	before := *record
+	record.PreviousRetired = true
+
+	messages, err := r.auditMessages(ctx, record.Key, "previous_retired", &before, record)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.stateStore.SaveIndexedWithOutbox(ctx, secretRotationDomain, secretRotationEntityType, record.Key, record, etag, messages...); err != nil {
+		if domain.IsConflictError(err) {
+			return &before, nil
+		}
+		return nil, err
+	}
+
+	if policy.Retire != nil && record.PreviousVersion != "" {
+		if err := policy.Retire(ctx, record.PreviousVersion); err != nil {
+			return nil, fmt.Errorf("failed to retire version %s: %w", record.PreviousVersion, err)
+		}
+	}
+	return record, nil
+}
+
+// propagate hands this instance's consumers the credentials they have not seen yet:
+// a new version with the previous one during its grace period, or the current version
+// alone once the grace period is over
+func (r *SecretRotator) propagate(ctx context.Context, key string, current *SecretVersion, record *SecretRotationRecord) error {
+	r.mutex.Lock()
+	applied, known := r.applied[key]
+	if !known {
+		r.applied[key] = &appliedSecret{version: current.Version, value: current.Value}
+		r.mutex.Unlock()
+		return nil
+	}
+
+	var credentials SecretCredentials
+	switch {
+	case applied.version != current.Version:
+		credentials = SecretCredentials{Key: key, Current: current.Value, Previous: applied.value}
+		if record.GraceUntil != nil && !record.PreviousRetired {
+			credentials.PreviousValidUntil = *record.GraceUntil
+		} else {
+			credentials.Previous = ""
+		}
+	case applied.previous != "" && (record.PreviousRetired || !r.now().Before(applied.previousValidUntil)):
+		credentials = SecretCredentials{Key: key, Current: current.Value}
+	default:
+		r.mutex.Unlock()
+		return nil
+	}
+	consumers := r.consumersOf(key)
+	r.mutex.Unlock()
+
+	r.secrets.ClearSecretFromCache(key)
+
+	var failures []string
+	for _, consumer := range consumers {
+		if err := consumer.Reinitialize(ctx, credentials); err != nil {
+			log.Printf("Secret consumer %s failed to re-initialize with %s: %v", consumer.Name, key, err)
+			failures = append(failures, consumer.Name)
+		}
+	}
+	if len(failures) > 0 {
+		// Retried on the next check, since the applied version is left unchanged
+		return fmt.Errorf("consumers failed to re-initialize: %v", failures)
+	}
+
+	r.mutex.Lock()
+	r.applied[key] = &appliedSecret{
+		version:            current.Version,
+		value:              current.Value,
+		previous:           credentials.Previous,
+		previousValidUntil: credentials.PreviousValidUntil,
+	}
+	r.mutex.Unlock()
+	return nil
+}
+
+// consumersOf returns the consumers of key; callers hold the mutex
+func (r *SecretRotator) consumersOf(key string) []SecretConsumer {
+	var consumers []SecretConsumer
+	for _, consumer := range r.consumers {
+		for _, consumerKey := range consumer.Keys {
+			if consumerKey == key {
+				consumers = append(consumers, consumer)
+				break
+			}
+		}
+	}
+	return consumers
+}
+
+func (r *SecretRotator) sortedPolicies() []SecretRotationPolicy {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	policies := make([]SecretRotationPolicy, 0, len(r.policies))
+	for _, policy := range r.policies {
+		policies = append(policies, policy)
+	}
+	sort.Slice(policies, func(i, j int) bool { return policies[i].Key < policies[j].Key })
+	return policies
+}
+
+// auditMessages records a rotation event for compliance; the snapshot carries versions,
+// never values
+func (r *SecretRotator) auditMessages(ctx context.Context, key, event string, before, after *SecretRotationRecord) ([]*OutboxMessage, error) {
+	message, err := r.pubsub.AuditEventMessage(&AuditEvent{
+		AuditID:       uuid.New().String(),
+		EntityType:    string(domain.EntityTypeSecret),
+		EntityID:      key,
+		OperationType: string(domain.AuditEventUpdate),
+		AuditTime:     r.now().UTC(),
+		UserID:        domain.GetUserID(ctx),
+		CorrelationID: domain.GetCorrelationID(ctx),
+		TraceID:       domain.GetTraceID(ctx),
+		DataSnapshot:  map[string]interface{}{"event": event, "before": before, "after": after},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare audit event for secret %s: %w", key, err)
+	}
+
+	return []*OutboxMessage{NewOutboxMessage(ctx, message.Topic, message)}, nil
+}
+
+func (r *SecretRotator) recordKey(key string) string {
+	return r.stateStore.CreateKey(secretRotationDomain, secretRotationEntityType, key)
+}
+
+func (r *SecretRotator) load(ctx context.Context, key string) (*SecretRotationRecord, string, error) {
+	var record SecretRotationRecord
+	found, etag, err := r.stateStore.GetWithETag(ctx, r.recordKey(key), &record)
+	if err != nil {
+		return nil, "", err
+	}
+	if !found {
+		return nil, "", domain.NewNotFoundError("secret rotation", key)
+	}
+	return &record, etag, nil
+}
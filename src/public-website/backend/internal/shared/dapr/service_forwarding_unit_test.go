@@ -0,0 +1,98 @@
+package dapr
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// forwardingSidecar serves the sidecar HTTP invocation API for ForwardService tests
+func forwardingSidecar(t *testing.T, handler http.HandlerFunc) {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	address, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	host, port, err := net.SplitHostPort(address.Host)
+	require.NoError(t, err)
+
+	t.Setenv("DAPR_HOST", host)
+	t.Setenv("DAPR_HTTP_PORT", port)
+}
+
+func TestServiceInvocation_ForwardContentAPI(t *testing.T) {
+	tests := []struct {
+		name             string
+		method           string
+		data             []byte
+		headers          map[string]string
+		upstreamStatus   int
+		upstreamETag     string
+		expectedPath     string
+		expectedType     string
+		expectedIfMatch  string
+		expectedAPIToken string
+	}{
+		{
+			name:            "update guarded by if-match",
+			method:          http.MethodPut,
+			data:            []byte(`{"title":"Updated"}`),
+			headers:         map[string]string{"If-Match": `"7"`, "Content-Type": "application/json"},
+			upstreamStatus:  http.StatusPreconditionFailed,
+			upstreamETag:    `"8"`,
+			expectedPath:    "/v1.0/invoke/content/method/admin/api/v1/events/event-1",
+			expectedType:    "application/json",
+			expectedIfMatch: `"7"`,
+		},
+		{
+			name:             "read with sidecar api token",
+			method:           http.MethodGet,
+			upstreamStatus:   http.StatusOK,
+			upstreamETag:     `"3"`,
+			expectedPath:     "/v1.0/invoke/content/method/admin/api/v1/events/event-1",
+			expectedAPIToken: "sidecar-token",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var received *http.Request
+			var body []byte
+			forwardingSidecar(t, func(w http.ResponseWriter, r *http.Request) {
+				received = r
+				body, _ = io.ReadAll(r.Body)
+				w.Header().Set("ETag", tt.upstreamETag)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.upstreamStatus)
+				_, _ = w.Write([]byte(`{"ok":true}`))
+			})
+			t.Setenv("DAPR_API_TOKEN", tt.expectedAPIToken)
+			invocation := NewServiceInvocation(nil)
+
+			// Act
+			response, err := invocation.ForwardContentAPI(context.Background(), "/admin/api/v1/events/event-1", tt.method, tt.data, tt.headers)
+
+			// Assert
+			require.NoError(t, err)
+			require.NotNil(t, received)
+			assert.Equal(t, tt.method, received.Method)
+			assert.Equal(t, tt.expectedPath, received.URL.Path)
+			assert.Equal(t, tt.expectedIfMatch, received.Header.Get("If-Match"))
+			assert.Equal(t, tt.expectedAPIToken, received.Header.Get("dapr-api-token"))
+			assert.Equal(t, tt.expectedType, received.Header.Get("Content-Type"))
+			assert.Equal(t, string(tt.data), string(body))
+
+			assert.Equal(t, tt.upstreamStatus, response.StatusCode)
+			assert.Equal(t, tt.upstreamETag, response.Headers["Etag"])
+			assert.JSONEq(t, `{"ok":true}`, string(response.Data))
+		})
+	}
+}
@@ -1,8 +1,13 @@
 package dapr
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/dapr/go-sdk/client"
@@ -10,7 +15,8 @@ import (
 
 // ServiceInvocation wraps Dapr service invocation operations
 type ServiceInvocation struct {
-	client *Client
+	client     *Client
+	httpClient *http.Client
 }
 
 // ServiceRequest represents a request to another service
@@ -21,6 +27,8 @@ type ServiceRequest struct {
 	Data        []byte
 	ContentType string
 	Metadata    map[string]string
+	// Headers are sent to the target as HTTP headers by ForwardService
+	Headers map[string]string
 }
 
 // ServiceResponse represents a response from another service
@@ -43,7 +51,8 @@ type ServiceEndpoints struct {
 // NewServiceInvocation creates a new service invocation instance
 func NewServiceInvocation(client *Client) *ServiceInvocation {
 	return &ServiceInvocation{
-		client: client,
+		client:     client,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
 	}
 }
 
@@ -150,6 +159,81 @@ func (s *ServiceInvocation) InvokeNotificationAPI(ctx context.Context, method, h
 	return s.InvokeService(ctx, req)
 }
 
+// ForwardService invokes a method through the sidecar's HTTP invocation API. Unlike
+// InvokeService it carries the request headers to the target and reports the target's
+// status code and response headers, which proxies need for conditional requests. Error
+// statuses are returned as responses rather than errors.
+func (s *ServiceInvocation) ForwardService(ctx context.Context, req *ServiceRequest) (*ServiceResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("service request cannot be nil")
+	}
+	if req.AppID == "" {
+		return nil, fmt.Errorf("service app ID cannot be empty")
+	}
+
+	target := fmt.Sprintf("http://%s:%s/v1.0/invoke/%s/method/%s",
+		getEnv("DAPR_HOST", "localhost"), getEnv("DAPR_HTTP_PORT", "3500"),
+		url.PathEscape(req.AppID), strings.TrimPrefix(req.MethodName, "/"))
+
+	httpRequest, err := http.NewRequestWithContext(ctx, req.HTTPVerb, target, bytes.NewReader(req.Data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request for method %s on service %s: %w", req.MethodName, req.AppID, err)
+	}
+	for name, value := range req.Headers {
+		httpRequest.Header.Set(name, value)
+	}
+	if len(req.Data) > 0 && req.ContentType != "" {
+		httpRequest.Header.Set("Content-Type", req.ContentType)
+	}
+	if token := getEnv("DAPR_API_TOKEN", ""); token != "" {
+		httpRequest.Header.Set("dapr-api-token", token)
+	}
+
+	httpResponse, err := s.httpClient.Do(httpRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke method %s on service %s: %w", req.MethodName, req.AppID, err)
+	}
+	defer httpResponse.Body.Close()
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response of method %s on service %s: %w", req.MethodName, req.AppID, err)
+	}
+
+	headers := make(map[string]string, len(httpResponse.Header))
+	for name := range httpResponse.Header {
+		headers[name] = httpResponse.Header.Get(name)
+	}
+
+	return &ServiceResponse{
+		Data:        data,
+		ContentType: httpResponse.Header.Get("Content-Type"),
+		StatusCode:  httpResponse.StatusCode,
+		Headers:     headers,
+	}, nil
+}
+
+// ForwardContentAPI forwards a request with its headers to the content API service
+func (s *ServiceInvocation) ForwardContentAPI(ctx context.Context, method, httpVerb string, data []byte, headers map[string]string) (*ServiceResponse, error) {
+	endpoints := s.GetServiceEndpoints()
+
+	contentType := headers["Content-Type"]
+	if contentType == "" {
+		contentType = "application/json"
+	}
+
+	req := &ServiceRequest{
+		AppID:       endpoints.ContentAPI,
+		MethodName:  method,
+		HTTPVerb:    httpVerb,
+		Data:        data,
+		ContentType: contentType,
+		Headers:     headers,
+	}
+
+	return s.ForwardService(ctx, req)
+}
+
 // GetContent retrieves content by ID from content API
 func (s *ServiceInvocation) GetContent(ctx context.Context, contentID string) (*ServiceResponse, error) {
 	method := fmt.Sprintf("api/v1/content/%s", contentID)
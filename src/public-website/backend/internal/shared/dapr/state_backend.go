@@ -0,0 +1,134 @@
+package dapr
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/dapr/go-sdk/client"
+)
+
+const (
+	StateBackendDapr   = "dapr"
+	StateBackendMemory = "memory"
+	StateBackendFile   = "file"
+
+	stateWriteUpsert = "upsert"
+	stateWriteDelete = "delete"
+)
+
+// StateBackend is the storage beneath StateStore. Values are raw JSON; the backend
+// owns ETags, so a write carrying an ETag only succeeds while the stored value
+// still has it, and an ETag mismatch is reported as a conflict error.
+type StateBackend interface {
+	// Get returns the stored item, or nil when the key does not exist
+	Get(ctx context.Context, key string) (*StateItem, error)
+	// BulkGet returns the items that exist among keys
+	BulkGet(ctx context.Context, keys []string) ([]*StateItem, error)
+	Set(ctx context.Context, key string, value []byte, options *StateOptions) error
+	// BulkSet saves several items; unlike Transact it need not be atomic
+	BulkSet(ctx context.Context, items []*StateItem, options *StateOptions) error
+	Delete(ctx context.Context, key string, options *StateOptions) error
+	// Query runs a query in the Dapr state query language
+	Query(ctx context.Context, query string) ([]*StateItem, error)
+	// Transact applies every write or none of them
+	Transact(ctx context.Context, writes []StateWrite, metadata map[string]string) error
+	Close() error
+}
+
+// StateItem is a stored value with its ETag
+type StateItem struct {
+	Key   string
+	Value []byte
+	ETag  string
+}
+
+// StateWrite is one operation in a state transaction. An upsert without an ETag and
+// with first-write concurrency only succeeds when the key does not exist yet.
+type StateWrite struct {
+	Operation   string
+	Key         string
+	Value       []byte
+	ETag        string
+	Concurrency client.StateConcurrency
+}
+
+// NewStateBackend opens the backend named by STATE_STORE_BACKEND. Without a name,
+// a client connected to a Dapr sidecar uses Dapr and anything else keeps state
+// in memory, so services run without a sidecar in test mode.
+func NewStateBackend(client *Client, storeName string) (StateBackend, error) {
+	kind := strings.ToLower(strings.TrimSpace(getEnv("STATE_STORE_BACKEND", "")))
+	if kind == "" {
+		kind = StateBackendMemory
+		if client != nil && client.GetClient() != nil {
+			kind = StateBackendDapr
+		}
+	}
+
+	switch kind {
+	case StateBackendDapr:
+		if client == nil || client.GetClient() == nil {
+			return nil, fmt.Errorf("dapr state backend requires a connected Dapr client")
+		}
+		return NewDaprStateBackend(client, storeName), nil
+	case StateBackendMemory:
+		return client.localStateBackend(kind, storeName, func() (StateBackend, error) {
+			return NewMemoryStateBackend(), nil
+		})
+	case StateBackendFile:
+		path := getEnv("STATE_STORE_FILE_PATH", fmt.Sprintf(".state/%s.json", storeName))
+		return client.localStateBackend(kind, path, func() (StateBackend, error) {
+			return NewFileStateBackend(path)
+		})
+	default:
+		return nil, fmt.Errorf("unknown state store backend %q", kind)
+	}
+}
+
+// localStateBackend shares one local backend per store among the state stores
+// built on a client, as they would share a Dapr state store component. Without a
+// client every caller gets its own backend.
+func (c *Client) localStateBackend(kind, name string, open func() (StateBackend, error)) (StateBackend, error) {
+	if c == nil {
+		return open()
+	}
+
+	c.backendsMutex.Lock()
+	defer c.backendsMutex.Unlock()
+
+	cacheKey := kind + ":" + name
+	if backend, exists := c.stateBackends[cacheKey]; exists {
+		return backend, nil
+	}
+
+	backend, err := open()
+	if err != nil {
+		return nil, err
+	}
+	if c.stateBackends == nil {
+		c.stateBackends = make(map[string]StateBackend)
+	}
+	c.stateBackends[cacheKey] = backend
+	log.Printf("state store %s using %s backend", name, kind)
+	return backend, nil
+}
+
+// newETagMismatchError reports a write whose ETag no longer matches the stored value
+func newETagMismatchError(key string) error {
+	return domain.NewConflictError(fmt.Sprintf("etag mismatch for state key %s", key))
+}
+
+// validateStateWrites rejects transactions a backend cannot apply
+func validateStateWrites(writes []StateWrite) error {
+	for _, write := range writes {
+		if write.Key == "" {
+			return fmt.Errorf("state key cannot be empty")
+		}
+		if write.Operation != stateWriteUpsert && write.Operation != stateWriteDelete {
+			return fmt.Errorf("unsupported transaction operation: %s", write.Operation)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package dapr
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/dapr/go-sdk/client"
+)
+
+// DaprStateBackend stores state in a Dapr state store component
+type DaprStateBackend struct {
+	client    *Client
+	storeName string
+}
+
+// NewDaprStateBackend creates a backend for the named Dapr state store component
+func NewDaprStateBackend(client *Client, storeName string) *DaprStateBackend {
+	return &DaprStateBackend{
+		client:    client,
+		storeName: storeName,
+	}
+}
+
+// Get retrieves a single item
+func (b *DaprStateBackend) Get(ctx context.Context, key string) (*StateItem, error) {
+	result, err := b.client.GetClient().GetState(ctx, b.storeName, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil || len(result.Value) == 0 {
+		return nil, nil
+	}
+	return &StateItem{Key: key, Value: result.Value, ETag: result.Etag}, nil
+}
+
+// BulkGet retrieves the items that exist among keys
+func (b *DaprStateBackend) BulkGet(ctx context.Context, keys []string) ([]*StateItem, error) {
+	results, err := b.client.GetClient().GetBulkState(ctx, b.storeName, keys, nil, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*StateItem, 0, len(results))
+	for _, result := range results {
+		if result.Error != "" {
+			return nil, fmt.Errorf("failed to get state for key %s: %s", result.Key, result.Error)
+		}
+		if len(result.Value) == 0 {
+			continue
+		}
+		items = append(items, &StateItem{Key: result.Key, Value: result.Value, ETag: result.Etag})
+	}
+	return items, nil
+}
+
+// Set saves a value, conditionally when options carry an ETag
+func (b *DaprStateBackend) Set(ctx context.Context, key string, value []byte, options *StateOptions) error {
+	metadata := daprStateMetadata(options)
+	if options != nil && options.ETag != "" {
+		return b.client.GetClient().SaveStateWithETag(ctx, b.storeName, key, value, options.ETag, metadata)
+	}
+	return b.client.GetClient().SaveState(ctx, b.storeName, key, value, metadata)
+}
+
+// BulkSet saves several items in one Dapr bulk save
+func (b *DaprStateBackend) BulkSet(ctx context.Context, items []*StateItem, options *StateOptions) error {
+	stateItems := make([]*client.SetStateItem, 0, len(items))
+	for _, item := range items {
+		stateItem := &client.SetStateItem{
+			Key:      item.Key,
+			Value:    item.Value,
+			Metadata: daprStateMetadata(options),
+		}
+		if item.ETag != "" {
+			stateItem.Etag = &client.ETag{Value: item.ETag}
+		}
+		if options != nil {
+			stateItem.Options = &client.StateOptions{
+				Consistency: options.Consistency,
+				Concurrency: options.Concurrency,
+			}
+		}
+		stateItems = append(stateItems, stateItem)
+	}
+	return b.client.GetClient().SaveBulkState(ctx, b.storeName, stateItems...)
+}
+
+// Delete removes a value, conditionally when options carry an ETag
+func (b *DaprStateBackend) Delete(ctx context.Context, key string, options *StateOptions) error {
+	if options != nil && options.ETag != "" {
+		return b.client.GetClient().DeleteStateWithETag(ctx, b.storeName, key, &client.ETag{Value: options.ETag}, nil, &client.StateOptions{
+			Consistency: options.Consistency,
+			Concurrency: options.Concurrency,
+		})
+	}
+
+	var metadata map[string]string
+	if options != nil {
+		metadata = map[string]string{
+			"concurrency": fmt.Sprintf("%d", options.Concurrency),
+		}
+	}
+	return b.client.GetClient().DeleteState(ctx, b.storeName, key, metadata)
+}
+
+// Query runs the query on the state store component
+func (b *DaprStateBackend) Query(ctx context.Context, query string) ([]*StateItem, error) {
+	resp, err := b.client.GetClient().QueryStateAlpha1(ctx, b.storeName, query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*StateItem, 0, len(resp.Results))
+	for _, result := range resp.Results {
+		items = append(items, &StateItem{Key: result.Key, Value: result.Value, ETag: result.Etag})
+	}
+	return items, nil
+}
+
+// Transact applies the writes as one Dapr state transaction
+func (b *DaprStateBackend) Transact(ctx context.Context, writes []StateWrite, metadata map[string]string) error {
+	if err := validateStateWrites(writes); err != nil {
+		return err
+	}
+
+	operations := make([]*client.StateOperation, 0, len(writes))
+	for _, write := range writes {
+		item := &client.SetStateItem{Key: write.Key}
+		if write.ETag != "" {
+			item.Etag = &client.ETag{Value: write.ETag}
+		}
+		if write.Concurrency != client.StateConcurrencyUndefined {
+			item.Options = &client.StateOptions{Concurrency: write.Concurrency}
+		}
+
+		operationType := client.StateOperationTypeDelete
+		if write.Operation == stateWriteUpsert {
+			operationType = client.StateOperationTypeUpsert
+			item.Value = write.Value
+		}
+		operations = append(operations, &client.StateOperation{Type: operationType, Item: item})
+	}
+
+	err := b.client.GetClient().ExecuteStateTransaction(ctx, b.storeName, metadata, operations)
+	if err != nil && strings.Contains(strings.ToLower(err.Error()), "etag") {
+		return domain.NewConflictError(fmt.Sprintf("state transaction rejected: %v", err))
+	}
+	return err
+}
+
+// Close leaves the shared Dapr client open for its other users
+func (b *DaprStateBackend) Close() error {
+	return nil
+}
+
+func daprStateMetadata(options *StateOptions) map[string]string {
+	if options == nil {
+		return nil
+	}
+	metadata := map[string]string{
+		"consistency": fmt.Sprintf("%d", options.Consistency),
+		"concurrency": fmt.Sprintf("%d", options.Concurrency),
+	}
+	if options.TTL > 0 {
+		metadata["ttlInSeconds"] = fmt.Sprintf("%d", int(options.TTL.Seconds()))
+	}
+	for key, value := range options.Metadata {
+		metadata[key] = value
+	}
+	return metadata
+}
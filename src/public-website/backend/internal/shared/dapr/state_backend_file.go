@@ -0,0 +1,103 @@
+package dapr
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// FileStateBackend keeps state in memory and writes it to a JSON file after every
+// change, so local development keeps its data across restarts without a sidecar.
+// The file is replaced atomically and is not meant to be shared between processes.
+type FileStateBackend struct {
+	*MemoryStateBackend
+	path string
+}
+
+// fileStateSnapshot is the on-disk layout of a file backend
+type fileStateSnapshot struct {
+	Version uint64                       `json:"version"`
+	Entries map[string]*memoryStateEntry `json:"entries"`
+}
+
+// NewFileStateBackend opens the backend stored at path, creating it when missing
+func NewFileStateBackend(path string) (*FileStateBackend, error) {
+	if path == "" {
+		return nil, fmt.Errorf("file state backend requires a path")
+	}
+
+	backend := &FileStateBackend{
+		MemoryStateBackend: NewMemoryStateBackend(),
+		path:               path,
+	}
+
+	data, err := os.ReadFile(path)
+	switch {
+	case os.IsNotExist(err):
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create state directory for %s: %w", path, err)
+		}
+	case err != nil:
+		return nil, fmt.Errorf("failed to read state file %s: %w", path, err)
+	default:
+		var snapshot fileStateSnapshot
+		if err := json.Unmarshal(data, &snapshot); err != nil {
+			return nil, fmt.Errorf("failed to decode state file %s: %w", path, err)
+		}
+		if snapshot.Entries != nil {
+			backend.entries = snapshot.Entries
+		}
+		backend.version = snapshot.Version
+	}
+
+	backend.persist = backend.write
+	return backend, nil
+}
+
+// Path returns the file the backend writes to
+func (b *FileStateBackend) Path() string {
+	return b.path
+}
+
+// write replaces the state file with the current entries, leaving expired ones out
+func (b *FileStateBackend) write(entries map[string]*memoryStateEntry, version uint64) error {
+	now := b.now()
+	snapshot := fileStateSnapshot{
+		Version: version,
+		Entries: make(map[string]*memoryStateEntry, len(entries)),
+	}
+	for key, entry := range entries {
+		if entry.expiredAt(now) {
+			continue
+		}
+		snapshot.Entries[key] = entry
+	}
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("failed to encode state file %s: %w", b.path, err)
+	}
+
+	temp, err := os.CreateTemp(filepath.Dir(b.path), filepath.Base(b.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write state file %s: %w", b.path, err)
+	}
+	defer os.Remove(temp.Name())
+
+	if _, err := temp.Write(data); err != nil {
+		temp.Close()
+		return fmt.Errorf("failed to write state file %s: %w", b.path, err)
+	}
+	if err := temp.Sync(); err != nil {
+		temp.Close()
+		return fmt.Errorf("failed to sync state file %s: %w", b.path, err)
+	}
+	if err := temp.Close(); err != nil {
+		return fmt.Errorf("failed to write state file %s: %w", b.path, err)
+	}
+	if err := os.Rename(temp.Name(), b.path); err != nil {
+		return fmt.Errorf("failed to replace state file %s: %w", b.path, err)
+	}
+	return nil
+}
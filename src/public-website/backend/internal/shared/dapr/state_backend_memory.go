@@ -0,0 +1,232 @@
+package dapr
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/dapr/go-sdk/client"
+)
+
+// MemoryStateBackend keeps state in process memory. It is safe for concurrent
+// use and honours ETags, TTLs, queries and transactions like a Dapr state store.
+// A first-write save without an ETag only inserts, as it does on Redis.
+type MemoryStateBackend struct {
+	mutex   sync.RWMutex
+	entries map[string]*memoryStateEntry
+	version uint64
+	now     func() time.Time
+	// persist, when set, durably records the entries after every change; a failed
+	// persist rolls the change back
+	persist func(entries map[string]*memoryStateEntry, version uint64) error
+}
+
+type memoryStateEntry struct {
+	Value     []byte     `json:"value"`
+	ETag      string     `json:"etag"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// NewMemoryStateBackend creates an empty in-memory backend
+func NewMemoryStateBackend() *MemoryStateBackend {
+	return &MemoryStateBackend{
+		entries: make(map[string]*memoryStateEntry),
+		now:     time.Now,
+	}
+}
+
+// Get retrieves a single item
+func (b *MemoryStateBackend) Get(ctx context.Context, key string) (*StateItem, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	entry := b.liveEntry(key)
+	if entry == nil {
+		return nil, nil
+	}
+	return entry.item(key), nil
+}
+
+// BulkGet retrieves the items that exist among keys
+func (b *MemoryStateBackend) BulkGet(ctx context.Context, keys []string) ([]*StateItem, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	items := make([]*StateItem, 0, len(keys))
+	for _, key := range keys {
+		if entry := b.liveEntry(key); entry != nil {
+			items = append(items, entry.item(key))
+		}
+	}
+	return items, nil
+}
+
+// Set saves a value, conditionally when options carry an ETag
+func (b *MemoryStateBackend) Set(ctx context.Context, key string, value []byte, options *StateOptions) error {
+	write := StateWrite{Operation: stateWriteUpsert, Key: key, Value: value}
+	if options != nil {
+		write.ETag = options.ETag
+	}
+	return b.apply(ctx, []StateWrite{write}, options)
+}
+
+// BulkSet saves several items at once, each conditional on its own ETag
+func (b *MemoryStateBackend) BulkSet(ctx context.Context, items []*StateItem, options *StateOptions) error {
+	writes := make([]StateWrite, 0, len(items))
+	for _, item := range items {
+		writes = append(writes, StateWrite{Operation: stateWriteUpsert, Key: item.Key, Value: item.Value, ETag: item.ETag})
+	}
+	return b.apply(ctx, writes, options)
+}
+
+// Delete removes a value, conditionally when options carry an ETag
+func (b *MemoryStateBackend) Delete(ctx context.Context, key string, options *StateOptions) error {
+	write := StateWrite{Operation: stateWriteDelete, Key: key}
+	if options != nil {
+		write.ETag = options.ETag
+	}
+	return b.apply(ctx, []StateWrite{write}, nil)
+}
+
+// Query evaluates the query against every live item
+func (b *MemoryStateBackend) Query(ctx context.Context, query string) ([]*StateItem, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	b.mutex.RLock()
+	items := make([]*StateItem, 0, len(b.entries))
+	for key := range b.entries {
+		if entry := b.liveEntry(key); entry != nil {
+			items = append(items, entry.item(key))
+		}
+	}
+	b.mutex.RUnlock()
+
+	return runStateQuery(items, query)
+}
+
+// Transact applies every write or none of them
+func (b *MemoryStateBackend) Transact(ctx context.Context, writes []StateWrite, metadata map[string]string) error {
+	return b.apply(ctx, writes, nil)
+}
+
+// Close releases nothing; the state lives as long as the backend
+func (b *MemoryStateBackend) Close() error {
+	return nil
+}
+
+// Keys returns the live keys in ascending order
+func (b *MemoryStateBackend) Keys() []string {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	keys := make([]string, 0, len(b.entries))
+	for key := range b.entries {
+		if b.liveEntry(key) != nil {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// apply checks every ETag before changing anything, so a transaction with one
+// stale ETag leaves the state untouched
+func (b *MemoryStateBackend) apply(ctx context.Context, writes []StateWrite, options *StateOptions) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := validateStateWrites(writes); err != nil {
+		return err
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	insertOnly := options != nil && options.Concurrency == client.StateConcurrencyFirstWrite
+	for _, write := range writes {
+		if write.ETag == "" {
+			firstWrite := insertOnly || write.Concurrency == client.StateConcurrencyFirstWrite
+			if firstWrite && write.Operation == stateWriteUpsert && b.liveEntry(write.Key) != nil {
+				return newETagMismatchError(write.Key)
+			}
+			continue
+		}
+		entry := b.liveEntry(write.Key)
+		if entry == nil || entry.ETag != write.ETag {
+			return newETagMismatchError(write.Key)
+		}
+	}
+
+	var expiresAt *time.Time
+	if options != nil && options.TTL > 0 {
+		expiry := b.now().Add(options.TTL)
+		expiresAt = &expiry
+	}
+
+	previous := make(map[string]*memoryStateEntry, len(writes))
+	previousVersion := b.version
+	for _, write := range writes {
+		if _, recorded := previous[write.Key]; !recorded {
+			previous[write.Key] = b.entries[write.Key]
+		}
+		if write.Operation == stateWriteDelete {
+			delete(b.entries, write.Key)
+			continue
+		}
+		b.version++
+		b.entries[write.Key] = &memoryStateEntry{
+			Value:     append([]byte(nil), write.Value...),
+			ETag:      strconv.FormatUint(b.version, 10),
+			ExpiresAt: expiresAt,
+		}
+	}
+
+	if b.persist == nil {
+		return nil
+	}
+	if err := b.persist(b.entries, b.version); err != nil {
+		for key, entry := range previous {
+			if entry == nil {
+				delete(b.entries, key)
+			} else {
+				b.entries[key] = entry
+			}
+		}
+		b.version = previousVersion
+		return err
+	}
+	return nil
+}
+
+// liveEntry returns the entry for key unless it is missing or expired; callers hold the mutex
+func (b *MemoryStateBackend) liveEntry(key string) *memoryStateEntry {
+	entry, exists := b.entries[key]
+	if !exists || entry.expiredAt(b.now()) {
+		return nil
+	}
+	return entry
+}
+
+func (e *memoryStateEntry) expiredAt(t time.Time) bool {
+	return e.ExpiresAt != nil && !t.Before(*e.ExpiresAt)
+}
+
+func (e *memoryStateEntry) item(key string) *StateItem {
+	return &StateItem{
+		Key:   key,
+		Value: append([]byte(nil), e.Value...),
+		ETag:  e.ETag,
+	}
+}
@@ -0,0 +1,354 @@
+package dapr
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/dapr/go-sdk/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// backendTestEntity is saved through a state store running on a local backend
+type backendTestEntity struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// localStateBackends opens each local backend fresh for a conformance test
+func localStateBackends(t *testing.T) map[string]func() StateBackend {
+	return map[string]func() StateBackend{
+		StateBackendMemory: func() StateBackend {
+			return NewMemoryStateBackend()
+		},
+		StateBackendFile: func() StateBackend {
+			backend, err := NewFileStateBackend(filepath.Join(t.TempDir(), "state", "statestore.json"))
+			require.NoError(t, err)
+			return backend
+		},
+	}
+}
+
+func TestLocalStateBackends_ETags(t *testing.T) {
+	for name, open := range localStateBackends(t) {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+			backend := open()
+			require.NoError(t, backend.Set(ctx, "news:news:1", []byte(`{"title":"Draft"}`), nil))
+			item, err := backend.Get(ctx, "news:news:1")
+			require.NoError(t, err)
+			require.NotNil(t, item)
+
+			// Act
+			updateErr := backend.Set(ctx, "news:news:1", []byte(`{"title":"Final"}`), &StateOptions{ETag: item.ETag})
+			staleErr := backend.Set(ctx, "news:news:1", []byte(`{"title":"Stale"}`), &StateOptions{ETag: item.ETag})
+			staleDeleteErr := backend.Delete(ctx, "news:news:1", &StateOptions{ETag: item.ETag})
+
+			// Assert
+			require.NoError(t, updateErr)
+			assert.True(t, domain.IsConflictError(staleErr))
+			assert.True(t, domain.IsConflictError(staleDeleteErr))
+
+			current, err := backend.Get(ctx, "news:news:1")
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"title":"Final"}`, string(current.Value))
+			assert.NotEqual(t, item.ETag, current.ETag)
+
+			missing, err := backend.Get(ctx, "news:news:2")
+			require.NoError(t, err)
+			assert.Nil(t, missing)
+		})
+	}
+}
+
+func TestLocalStateBackends_Transact(t *testing.T) {
+	for name, open := range localStateBackends(t) {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+			backend := open()
+			require.NoError(t, backend.Set(ctx, "a", []byte(`1`), nil))
+			require.NoError(t, backend.Set(ctx, "b", []byte(`2`), nil))
+			a, err := backend.Get(ctx, "a")
+			require.NoError(t, err)
+
+			// Act
+			staleErr := backend.Transact(ctx, []StateWrite{
+				{Operation: stateWriteUpsert, Key: "c", Value: []byte(`3`)},
+				{Operation: stateWriteDelete, Key: "b", ETag: "stale"},
+			}, nil)
+			commitErr := backend.Transact(ctx, []StateWrite{
+				{Operation: stateWriteUpsert, Key: "a", Value: []byte(`10`), ETag: a.ETag},
+				{Operation: stateWriteDelete, Key: "b"},
+				{Operation: stateWriteUpsert, Key: "c", Value: []byte(`3`)},
+			}, nil)
+
+			// Assert
+			assert.True(t, domain.IsConflictError(staleErr))
+			require.NoError(t, commitErr)
+
+			items, err := backend.BulkGet(ctx, []string{"a", "b", "c"})
+			require.NoError(t, err)
+			values := make(map[string]string)
+			for _, item := range items {
+				values[item.Key] = string(item.Value)
+			}
+			assert.Equal(t, map[string]string{"a": "10", "c": "3"}, values)
+		})
+	}
+}
+
+func TestLocalStateBackends_TransactFirstWrite(t *testing.T) {
+	for name, open := range localStateBackends(t) {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+			backend := open()
+			insert := func(key, value string) error {
+				return backend.Transact(ctx, []StateWrite{
+					{Operation: stateWriteUpsert, Key: key, Value: []byte(value), Concurrency: client.StateConcurrencyFirstWrite},
+				}, nil)
+			}
+
+			// Act
+			firstErr := insert("a", `1`)
+			secondErr := insert("a", `2`)
+
+			// Assert
+			require.NoError(t, firstErr)
+			assert.True(t, domain.IsConflictError(secondErr))
+
+			item, err := backend.Get(ctx, "a")
+			require.NoError(t, err)
+			assert.Equal(t, `1`, string(item.Value))
+		})
+	}
+}
+
+func TestLocalStateBackends_Query(t *testing.T) {
+	documents := map[string]string{
+		"news:news:1":  `{"title":"Alpha","status":"published","priority":2,"author":{"name":"Ana"}}`,
+		"news:news:2":  `{"title":"Beta","status":"draft","priority":1,"author":{"name":"Ben"}}`,
+		"news:news:3":  `{"title":"Gamma","status":"published","priority":3,"author":{"name":"Ben"}}`,
+		"news:news:4":  `{"title":"Delta","status":"archived","priority":5}`,
+		"cache:binary": `not json`,
+	}
+
+	tests := []struct {
+		name          string
+		query         string
+		expectedKeys  []string
+		expectedError bool
+	}{
+		{
+			name:         "everything without a filter",
+			query:        `{}`,
+			expectedKeys: []string{"cache:binary", "news:news:1", "news:news:2", "news:news:3", "news:news:4"},
+		},
+		{
+			name:         "equality on nested field",
+			query:        `{"filter": {"EQ": {"author.name": "Ben"}}}`,
+			expectedKeys: []string{"news:news:2", "news:news:3"},
+		},
+		{
+			name:         "membership sorted descending",
+			query:        `{"filter": {"IN": {"status": ["published", "archived"]}}, "sort": [{"key": "priority", "order": "DESC"}]}`,
+			expectedKeys: []string{"news:news:4", "news:news:3", "news:news:1"},
+		},
+		{
+			name:         "and with or",
+			query:        `{"filter": {"AND": [{"EQ": {"status": "published"}}, {"OR": [{"EQ": {"priority": 3}}, {"EQ": {"title": "Beta"}}]}]}}`,
+			expectedKeys: []string{"news:news:3"},
+		},
+		{
+			name:         "second page",
+			query:        `{"filter": {"IN": {"status": ["published", "draft", "archived"]}}, "sort": [{"key": "title"}], "page": {"limit": 2, "token": "2"}}`,
+			expectedKeys: []string{"news:news:4", "news:news:3"},
+		},
+		{
+			name:          "reject unknown operator",
+			query:         `{"filter": {"GT": {"priority": 1}}}`,
+			expectedError: true,
+		},
+	}
+
+	for name, open := range localStateBackends(t) {
+		backend := open()
+		for key, value := range documents {
+			require.NoError(t, backend.Set(context.Background(), key, []byte(value), nil))
+		}
+
+		for _, tt := range tests {
+			t.Run(name+"/"+tt.name, func(t *testing.T) {
+				// Act
+				items, err := backend.Query(context.Background(), tt.query)
+
+				// Assert
+				if tt.expectedError {
+					assert.Error(t, err)
+					return
+				}
+				require.NoError(t, err)
+				keys := make([]string, 0, len(items))
+				for _, item := range items {
+					keys = append(keys, item.Key)
+				}
+				assert.Equal(t, tt.expectedKeys, keys)
+			})
+		}
+	}
+}
+
+func TestMemoryStateBackend_TTL(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	backend := NewMemoryStateBackend()
+	backend.now = func() time.Time { return now }
+	require.NoError(t, backend.Set(ctx, "session:1", []byte(`{"user":"1"}`), &StateOptions{TTL: time.Minute}))
+
+	// Act
+	before, err := backend.Get(ctx, "session:1")
+	require.NoError(t, err)
+	now = now.Add(time.Minute)
+	after, err := backend.Get(ctx, "session:1")
+	require.NoError(t, err)
+
+	// Assert
+	assert.NotNil(t, before)
+	assert.Nil(t, after)
+	assert.Empty(t, backend.Keys())
+}
+
+func TestMemoryStateBackend_ConcurrentETagWrites(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	backend := NewMemoryStateBackend()
+	require.NoError(t, backend.Set(ctx, "counter", []byte(`0`), nil))
+
+	// Act: every writer retries its increment until its ETag is current
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				item, err := backend.Get(ctx, "counter")
+				if err != nil {
+					return
+				}
+				var value int
+				fmt.Sscanf(string(item.Value), "%d", &value)
+				if backend.Set(ctx, "counter", []byte(fmt.Sprintf("%d", value+1)), &StateOptions{ETag: item.ETag}) == nil {
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Assert
+	item, err := backend.Get(ctx, "counter")
+	require.NoError(t, err)
+	assert.Equal(t, "20", string(item.Value))
+}
+
+func TestFileStateBackend_Reopen(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "statestore.json")
+	backend, err := NewFileStateBackend(path)
+	require.NoError(t, err)
+	require.NoError(t, backend.Set(ctx, "news:news:1", []byte(`{"title":"Kept"}`), nil))
+	require.NoError(t, backend.Set(ctx, "news:news:2", []byte(`{"title":"Removed"}`), nil))
+	require.NoError(t, backend.Delete(ctx, "news:news:2", nil))
+	written, err := backend.Get(ctx, "news:news:1")
+	require.NoError(t, err)
+
+	// Act
+	reopened, err := NewFileStateBackend(path)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, []string{"news:news:1"}, reopened.Keys())
+	item, err := reopened.Get(ctx, "news:news:1")
+	require.NoError(t, err)
+	assert.Equal(t, written.ETag, item.ETag)
+
+	require.NoError(t, reopened.Set(ctx, "news:news:3", []byte(`{"title":"New"}`), nil))
+	next, err := reopened.Get(ctx, "news:news:3")
+	require.NoError(t, err)
+	assert.NotEqual(t, written.ETag, next.ETag, "ETags keep increasing after a reopen")
+}
+
+func TestStateStore_WithMemoryBackend(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	store := NewStateStoreWithBackend(NewMemoryStateBackend())
+	key := store.CreateKey("test", "entity", "1")
+	require.NoError(t, store.Save(ctx, key, &backendTestEntity{ID: "1", Name: "First"}, nil))
+
+	var entity backendTestEntity
+	_, etag, err := store.GetWithETag(ctx, key, &entity)
+	require.NoError(t, err)
+
+	// Act
+	staleErr := store.ExecuteTransaction(ctx, &TransactionRequest{Operations: []TransactionOperation{
+		{Operation: "upsert", Key: key, Value: &backendTestEntity{ID: "1", Name: "Second"}, ETag: etag},
+		{Operation: "upsert", Key: key, Value: &backendTestEntity{ID: "1", Name: "Third"}, ETag: "0"},
+	}})
+	saveErr := store.Save(ctx, key, &backendTestEntity{ID: "1", Name: "Second"}, &StateOptions{ETag: etag})
+
+	// Assert
+	assert.True(t, domain.IsConflictError(staleErr))
+	require.NoError(t, saveErr)
+	found, err := store.Get(ctx, key, &entity)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "Second", entity.Name)
+}
+
+func TestNewStateBackend(t *testing.T) {
+	tests := []struct {
+		name          string
+		backend       string
+		expectedType  StateBackend
+		expectedError string
+	}{
+		{name: "memory without a sidecar by default", expectedType: &MemoryStateBackend{}},
+		{name: "file when configured", backend: "file", expectedType: &FileStateBackend{}},
+		{name: "reject dapr without a sidecar", backend: "dapr", expectedError: "requires a connected Dapr client"},
+		{name: "reject unknown backend", backend: "redis", expectedError: "unknown state store backend"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			t.Setenv("STATE_STORE_BACKEND", tt.backend)
+			t.Setenv("STATE_STORE_FILE_PATH", filepath.Join(t.TempDir(), "statestore.json"))
+			client := &Client{}
+
+			// Act
+			backend, err := NewStateBackend(client, "statestore")
+
+			// Assert
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.IsType(t, tt.expectedType, backend)
+
+			shared, err := NewStateBackend(client, "statestore")
+			require.NoError(t, err)
+			assert.Same(t, backend, shared, "state stores on one client share the backend")
+		})
+	}
+}
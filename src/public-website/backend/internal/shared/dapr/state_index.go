@@ -0,0 +1,992 @@
+package dapr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/dapr/go-sdk/client"
+)
+
+const (
+	indexKindEntry    = "entry"
+	indexKindManifest = "manifest"
+	indexManifestName = "_manifest"
+	indexMaxRetries   = 3
+)
+
+// IndexDefinition declares a secondary index maintained alongside an entity
+type IndexDefinition struct {
+	Name    string
+	Extract func(entity interface{}) []string
+}
+
+// IndexedEntityType declares the secondary indexes maintained for one entity type
+type IndexedEntityType struct {
+	Domain     string
+	EntityType string
+	Indexes    []IndexDefinition
+	// NewEntity allocates an empty entity used when rebuilding indexes from stored data
+	NewEntity func() interface{}
+	// EntityID returns the identifier of a decoded entity
+	EntityID func(entity interface{}) string
+	// SortKey optionally returns the listing timestamp of an entity. Index entries of a
+	// type with a sort key record it for every member so LookupIndexPage can select a
+	// page without loading the entities.
+	SortKey func(entity interface{}) time.Time
+}
+
+// IndexCondition selects entities whose index value matches exactly
+type IndexCondition struct {
+	Index string
+	Value string
+}
+
+// IndexEntry is the key set stored for a single index value
+type IndexEntry struct {
+	Kind       string   `json:"index_kind"`
+	Domain     string   `json:"index_domain"`
+	EntityType string   `json:"index_entity_type"`
+	IndexName  string   `json:"index_name"`
+	IndexValue string   `json:"index_value"`
+	IDs        []string `json:"ids"`
+	// SortKeys holds the sort key of each member when the entity type declares one
+	SortKeys  map[string]time.Time `json:"sort_keys,omitempty"`
+	UpdatedAt time.Time            `json:"updated_at"`
+}
+
+// IndexManifest records the index values an entity currently contributes to
+type IndexManifest struct {
+	Kind       string              `json:"index_kind"`
+	Domain     string              `json:"index_domain"`
+	EntityType string              `json:"index_entity_type"`
+	EntityID   string              `json:"entity_id"`
+	Values     map[string][]string `json:"values"`
+	SortKey    *time.Time          `json:"sort_key,omitempty"`
+	UpdatedAt  time.Time           `json:"updated_at"`
+}
+
+// IndexReport summarizes an index rebuild or verification run
+type IndexReport struct {
+	Domain             string   `json:"domain"`
+	EntityType         string   `json:"entity_type"`
+	EntitiesScanned    int      `json:"entities_scanned"`
+	EntriesWritten     int      `json:"entries_written"`
+	EntriesRemoved     int      `json:"entries_removed"`
+	MissingMemberships []string `json:"missing_memberships,omitempty"`
+	StaleMemberships   []string `json:"stale_memberships,omitempty"`
+	StaleSortKeys      []string `json:"stale_sort_keys,omitempty"`
+}
+
+// Consistent reports whether verification found no drift between entities and indexes
+func (r *IndexReport) Consistent() bool {
+	return len(r.MissingMemberships) == 0 && len(r.StaleMemberships) == 0 && len(r.StaleSortKeys) == 0
+}
+
+// indexRegistry holds the declared indexes for a state store instance
+type indexRegistry struct {
+	types map[string]*IndexedEntityType
+	mu    sync.RWMutex
+}
+
+// IndexBool formats a boolean index value
+func IndexBool(value bool) string {
+	return strconv.FormatBool(value)
+}
+
+// IndexMonthBucket formats a timestamp as a monthly date bucket (YYYY-MM) in UTC
+func IndexMonthBucket(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format("2006-01")
+}
+
+// IndexMonthBuckets returns every monthly bucket between from and to inclusive
+func IndexMonthBuckets(from, to time.Time) []string {
+	if from.IsZero() || to.IsZero() || to.Before(from) {
+		return nil
+	}
+
+	var buckets []string
+	current := time.Date(from.UTC().Year(), from.UTC().Month(), 1, 0, 0, 0, 0, time.UTC)
+	for !current.After(to.UTC()) {
+		buckets = append(buckets, current.Format("2006-01"))
+		current = current.AddDate(0, 1, 0)
+	}
+	return buckets
+}
+
+// RegisterIndexes declares the secondary indexes maintained for an entity type
+func (s *StateStore) RegisterIndexes(definition *IndexedEntityType) error {
+	if definition == nil || definition.Domain == "" || definition.EntityType == "" {
+		return domain.NewValidationError("indexed entity type requires domain and entity type")
+	}
+	if len(definition.Indexes) == 0 {
+		return domain.NewValidationError(fmt.Sprintf("indexed entity type %s:%s declares no indexes", definition.Domain, definition.EntityType))
+	}
+
+	seen := make(map[string]bool, len(definition.Indexes))
+	for _, index := range definition.Indexes {
+		if index.Name == "" || index.Extract == nil {
+			return domain.NewValidationError(fmt.Sprintf("index on %s:%s requires a name and extractor", definition.Domain, definition.EntityType))
+		}
+		if index.Name == indexManifestName {
+			return domain.NewValidationError(fmt.Sprintf("index name %s is reserved", indexManifestName))
+		}
+		if seen[index.Name] {
+			return domain.NewValidationError(fmt.Sprintf("index %s declared twice on %s:%s", index.Name, definition.Domain, definition.EntityType))
+		}
+		seen[index.Name] = true
+	}
+
+	s.indexes.mu.Lock()
+	defer s.indexes.mu.Unlock()
+
+	if s.indexes.types == nil {
+		s.indexes.types = make(map[string]*IndexedEntityType)
+	}
+	s.indexes.types[definition.Domain+":"+definition.EntityType] = definition
+
+	return nil
+}
+
+// MustRegisterIndexes registers statically declared indexes and panics on an invalid declaration
+func (s *StateStore) MustRegisterIndexes(definition *IndexedEntityType) {
+	if err := s.RegisterIndexes(definition); err != nil {
+		panic(err)
+	}
+}
+
+// SaveIndexed saves an entity and updates its secondary indexes in one transaction
+func (s *StateStore) SaveIndexed(ctx context.Context, domainName, entityType, id string, value interface{}) error {
+	return s.SaveIndexedWithETag(ctx, domainName, entityType, id, value, "")
+}
+
+// SaveIndexedWithETag saves an entity like SaveIndexed, but only while the stored
+// entity still carries etag. A changed entity is reported as a conflict without
+// retrying, since only the caller can reconcile the concurrent change. An empty
+// etag saves unconditionally.
+func (s *StateStore) SaveIndexedWithETag(ctx context.Context, domainName, entityType, id string, value interface{}, etag string) error {
+	write, err := s.indexedUpsert(domainName, entityType, id, value, etag)
+	if err != nil {
+		return err
+	}
+
+	return s.writeIndexedWithRetry(ctx, write)
+}
+
+// SaveIndexedWithOutbox saves an entity like SaveIndexedWithETag and commits the outbox
+// messages describing the change in the same transaction, so the events are relayed
+// exactly when the write takes effect
+func (s *StateStore) SaveIndexedWithOutbox(ctx context.Context, domainName, entityType, id string, value interface{}, etag string, messages ...*OutboxMessage) error {
+	write, err := s.indexedUpsert(domainName, entityType, id, value, etag)
+	if err != nil {
+		return err
+	}
+
+	writes, err := s.outboxWrites(write, messages)
+	if err != nil {
+		return err
+	}
+
+	return s.writeIndexedWithRetry(ctx, writes...)
+}
+
+// DeleteIndexed removes an entity and its secondary index memberships in one transaction
+func (s *StateStore) DeleteIndexed(ctx context.Context, domainName, entityType, id string) error {
+	return s.DeleteIndexedWithOutbox(ctx, domainName, entityType, id)
+}
+
+// DeleteIndexedWithOutbox removes an entity like DeleteIndexed and commits the outbox
+// messages describing the removal in the same transaction
+func (s *StateStore) DeleteIndexedWithOutbox(ctx context.Context, domainName, entityType, id string, messages ...*OutboxMessage) error {
+	definition, err := s.indexedEntityType(domainName, entityType)
+	if err != nil {
+		return err
+	}
+	if id == "" {
+		return fmt.Errorf("state key cannot be empty")
+	}
+
+	write := indexedWrite{
+		definition: definition,
+		id:         id,
+		op: TransactionOperation{
+			Operation: "delete",
+			Key:       s.CreateKey(domainName, entityType, id),
+		},
+	}
+
+	writes, err := s.outboxWrites(write, messages)
+	if err != nil {
+		return err
+	}
+
+	return s.writeIndexedWithRetry(ctx, writes...)
+}
+
+// LookupIndex returns the IDs of entities matching every condition, sorted ascending
+func (s *StateStore) LookupIndex(ctx context.Context, domainName, entityType string, conditions ...IndexCondition) ([]string, error) {
+	definition, err := s.indexedEntityType(domainName, entityType)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, _, err := s.lookupConditions(ctx, definition, conditions)
+	return ids, err
+}
+
+// LookupIndexPage returns one page of the IDs of entities matching every condition, in
+// listing order: latest sort key first, with the ID breaking ties. The page is chosen from
+// the sort keys recorded in the index entries, so the caller only loads the entities on it.
+func (s *StateStore) LookupIndexPage(ctx context.Context, domainName, entityType string, page domain.PageRequest, conditions ...IndexCondition) ([]string, domain.PageInfo, error) {
+	definition, err := s.indexedEntityType(domainName, entityType)
+	if err != nil {
+		return nil, domain.PageInfo{}, err
+	}
+	if definition.SortKey == nil {
+		return nil, domain.PageInfo{}, fmt.Errorf("indexed entity type %s:%s declares no sort key", domainName, entityType)
+	}
+
+	ids, sortKeys, err := s.lookupConditions(ctx, definition, conditions)
+	if err != nil {
+		return nil, domain.PageInfo{}, err
+	}
+
+	members := make([]domain.PageCursor, len(ids))
+	for i, id := range ids {
+		members[i] = domain.PageCursor{Timestamp: sortKeys[id], ID: id}
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Before(members[j])
+	})
+
+	start, end, info := domain.CursorPage(len(members), func(i int) domain.PageCursor {
+		return members[i]
+	}, page)
+
+	pageIDs := make([]string, 0, end-start)
+	for _, member := range members[start:end] {
+		pageIDs = append(pageIDs, member.ID)
+	}
+	return pageIDs, info, nil
+}
+
+// lookupConditions intersects the index entries selected by the conditions, returning
+// the matching IDs sorted ascending and the sort keys the first entry records for them
+func (s *StateStore) lookupConditions(ctx context.Context, definition *IndexedEntityType, conditions []IndexCondition) ([]string, map[string]time.Time, error) {
+	if len(conditions) == 0 {
+		return nil, nil, domain.NewValidationError("index lookup requires at least one condition")
+	}
+
+	var result []string
+	var sortKeys map[string]time.Time
+	for i, condition := range conditions {
+		if !definition.hasIndex(condition.Index) {
+			return nil, nil, domain.NewValidationError(fmt.Sprintf("index %s is not declared on %s:%s", condition.Index, definition.Domain, definition.EntityType))
+		}
+
+		entry, _, err := s.getIndexEntry(ctx, definition.Domain, definition.EntityType, condition.Index, condition.Value)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if i == 0 {
+			result = append([]string{}, entry.IDs...)
+			sortKeys = entry.SortKeys
+		} else {
+			result = intersectSorted(result, entry.IDs)
+		}
+		if len(result) == 0 {
+			return []string{}, sortKeys, nil
+		}
+	}
+
+	return result, sortKeys, nil
+}
+
+// LookupIndexAny returns the IDs of entities whose index matches any of the values, sorted ascending
+func (s *StateStore) LookupIndexAny(ctx context.Context, domainName, entityType, indexName string, values []string) ([]string, error) {
+	definition, err := s.indexedEntityType(domainName, entityType)
+	if err != nil {
+		return nil, err
+	}
+	if !definition.hasIndex(indexName) {
+		return nil, domain.NewValidationError(fmt.Sprintf("index %s is not declared on %s:%s", indexName, domainName, entityType))
+	}
+
+	seen := make(map[string]bool)
+	for _, value := range values {
+		entry, _, err := s.getIndexEntry(ctx, domainName, entityType, indexName, value)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range entry.IDs {
+			seen[id] = true
+		}
+	}
+
+	return sortedKeys(seen), nil
+}
+
+// RebuildIndexes recomputes every index entry and manifest for an entity type from stored entities.
+// It writes without ETag checks and is intended to run while writes to the entity type are paused.
+func (s *StateStore) RebuildIndexes(ctx context.Context, domainName, entityType string) (*IndexReport, error) {
+	definition, err := s.indexedEntityType(domainName, entityType)
+	if err != nil {
+		return nil, err
+	}
+
+	expected, manifests, scanned, err := s.scanIndexedEntities(ctx, definition)
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := s.scanIndexEntries(ctx, definition)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &IndexReport{Domain: domainName, EntityType: entityType, EntitiesScanned: scanned}
+	now := time.Now().UTC()
+
+	var operations []TransactionOperation
+	for name, valueIDs := range expected {
+		for value, ids := range valueIDs {
+			members := sortedKeys(ids)
+			operations = append(operations, TransactionOperation{
+				Operation: "upsert",
+				Key:       s.CreateIndexKey(domainName, entityType, name, value),
+				Value:     newIndexEntry(definition, name, value, members, memberSortKeys(manifests, members), now),
+			})
+			report.EntriesWritten++
+		}
+	}
+
+	for _, entry := range stored {
+		if _, ok := expected[entry.IndexName][entry.IndexValue]; ok {
+			continue
+		}
+		operations = append(operations, TransactionOperation{
+			Operation: "delete",
+			Key:       s.CreateIndexKey(domainName, entityType, entry.IndexName, entry.IndexValue),
+		})
+		report.EntriesRemoved++
+	}
+
+	for id, manifest := range manifests {
+		manifest.UpdatedAt = now
+		operations = append(operations, TransactionOperation{
+			Operation: "upsert",
+			Key:       s.CreateIndexKey(domainName, entityType, indexManifestName, id),
+			Value:     manifest,
+		})
+	}
+
+	for _, batch := range splitOperations(operations, s.bulkConfig.MaxBatchSize) {
+		if err := s.ExecuteTransaction(ctx, &TransactionRequest{Operations: batch}); err != nil {
+			return report, domain.WrapError(err, fmt.Sprintf("failed to rebuild indexes for %s:%s", domainName, entityType))
+		}
+	}
+
+	return report, nil
+}
+
+// VerifyIndexes compares stored index entries against the indexes derived from stored entities
+func (s *StateStore) VerifyIndexes(ctx context.Context, domainName, entityType string) (*IndexReport, error) {
+	definition, err := s.indexedEntityType(domainName, entityType)
+	if err != nil {
+		return nil, err
+	}
+
+	expected, manifests, scanned, err := s.scanIndexedEntities(ctx, definition)
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := s.scanIndexEntries(ctx, definition)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &IndexReport{Domain: domainName, EntityType: entityType, EntitiesScanned: scanned}
+
+	storedIDs := make(map[string]map[string]map[string]bool)
+	for _, entry := range stored {
+		if storedIDs[entry.IndexName] == nil {
+			storedIDs[entry.IndexName] = make(map[string]map[string]bool)
+		}
+		ids := make(map[string]bool, len(entry.IDs))
+		for _, id := range entry.IDs {
+			ids[id] = true
+			if !expected[entry.IndexName][entry.IndexValue][id] {
+				report.StaleMemberships = append(report.StaleMemberships, formatMembership(entry.IndexName, entry.IndexValue, id))
+				continue
+			}
+			if manifest := manifests[id]; manifest.SortKey != nil {
+				if stored, ok := entry.SortKeys[id]; !ok || !stored.Equal(*manifest.SortKey) {
+					report.StaleSortKeys = append(report.StaleSortKeys, formatMembership(entry.IndexName, entry.IndexValue, id))
+				}
+			}
+		}
+		storedIDs[entry.IndexName][entry.IndexValue] = ids
+	}
+
+	for name, valueIDs := range expected {
+		for value, ids := range valueIDs {
+			for id := range ids {
+				if !storedIDs[name][value][id] {
+					report.MissingMemberships = append(report.MissingMemberships, formatMembership(name, value, id))
+				}
+			}
+		}
+	}
+
+	sort.Strings(report.MissingMemberships)
+	sort.Strings(report.StaleMemberships)
+	sort.Strings(report.StaleSortKeys)
+
+	return report, nil
+}
+
+// Index maintenance helpers
+
+func (s *StateStore) indexedEntityType(domainName, entityType string) (*IndexedEntityType, error) {
+	s.indexes.mu.RLock()
+	defer s.indexes.mu.RUnlock()
+
+	definition, exists := s.indexes.types[domainName+":"+entityType]
+	if !exists {
+		return nil, fmt.Errorf("no indexes registered for %s:%s", domainName, entityType)
+	}
+	return definition, nil
+}
+
+func (d *IndexedEntityType) hasIndex(name string) bool {
+	for _, index := range d.Indexes {
+		if index.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// indexedWrite is one entity operation together with the index values it leaves behind;
+// nil values remove the entity from its indexes. A write without a definition has no
+// indexes and only contributes its operation.
+type indexedWrite struct {
+	definition *IndexedEntityType
+	id         string
+	values     map[string][]string
+	sortKey    *time.Time
+	op         TransactionOperation
+}
+
+// name identifies the written entity in errors
+func (w indexedWrite) name() string {
+	if w.definition == nil {
+		return w.op.Key
+	}
+	return fmt.Sprintf("%s:%s %s", w.definition.Domain, w.definition.EntityType, w.id)
+}
+
+// indexedUpsert prepares saving an entity of a registered type
+func (s *StateStore) indexedUpsert(domainName, entityType, id string, value interface{}, etag string) (indexedWrite, error) {
+	definition, err := s.indexedEntityType(domainName, entityType)
+	if err != nil {
+		return indexedWrite{}, err
+	}
+	if id == "" {
+		return indexedWrite{}, fmt.Errorf("state key cannot be empty")
+	}
+
+	return indexedWrite{
+		definition: definition,
+		id:         id,
+		values:     extractIndexValues(definition, value),
+		sortKey:    extractSortKey(definition, value),
+		op: TransactionOperation{
+			Operation: "upsert",
+			Key:       s.CreateKey(domainName, entityType, id),
+			Value:     value,
+			ETag:      etag,
+		},
+	}, nil
+}
+
+// stagedOperations adds operations that have to be read again on every attempt, such as
+// an append to a log guarded by its ETag. The returned callback runs once they committed.
+type stagedOperations func(ctx context.Context) ([]TransactionOperation, func(), error)
+
+// writeIndexedWithRetry commits the writes and all their index changes in one transaction.
+// The first write names the entity in errors; the rest ride along with it.
+func (s *StateStore) writeIndexedWithRetry(ctx context.Context, writes ...indexedWrite) error {
+	return s.writeIndexedStaged(ctx, nil, writes...)
+}
+
+// writeIndexedStaged commits the writes like writeIndexedWithRetry, together with the
+// operations stage produces for each attempt. A nil stage adds nothing.
+func (s *StateStore) writeIndexedStaged(ctx context.Context, stage stagedOperations, writes ...indexedWrite) error {
+	primary := writes[0]
+
+	var lastErr error
+	for attempt := 0; attempt <= indexMaxRetries; attempt++ {
+		request := &TransactionRequest{}
+		for _, write := range writes {
+			request.Operations = append(request.Operations, write.op)
+		}
+
+		committed := func() {}
+		if stage != nil {
+			operations, onCommit, err := stage(ctx)
+			if err != nil {
+				return err
+			}
+			request.Operations = append(request.Operations, operations...)
+			committed = onCommit
+		}
+
+		// Writes of the same type share index entries, so each builds on the entries
+		// the writes before it staged rather than on the stored ones
+		staged := make(map[string]TransactionOperation)
+		var stagedKeys []string
+		for _, write := range writes {
+			if write.definition == nil {
+				continue
+			}
+			operations, err := s.buildIndexOperations(ctx, write.definition, write.id, write.values, write.sortKey, staged)
+			if err != nil {
+				return err
+			}
+			for _, operation := range operations {
+				if _, exists := staged[operation.Key]; !exists {
+					stagedKeys = append(stagedKeys, operation.Key)
+				}
+				staged[operation.Key] = operation
+			}
+		}
+		for _, key := range stagedKeys {
+			request.Operations = append(request.Operations, staged[key])
+		}
+
+		err := s.ExecuteTransaction(ctx, request)
+		if err == nil {
+			committed()
+			return nil
+		}
+		if !s.isConcurrencyConflict(err) {
+			return domain.WrapError(err, fmt.Sprintf("failed to write indexed %s", primary.name()))
+		}
+		for _, write := range writes {
+			if write.op.ETag == "" || write.definition == nil {
+				continue
+			}
+			if err := s.checkEntityETag(ctx, write.definition, write.id, write.op); err != nil {
+				return err
+			}
+		}
+		lastErr = err
+
+		backoffDuration := time.Duration(25*(1<<uint(attempt))) * time.Millisecond
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoffDuration):
+		}
+	}
+
+	return domain.NewConflictError(fmt.Sprintf("index update for %s kept conflicting: %v", primary.name(), lastErr))
+}
+
+// checkEntityETag reports a conflict when the entity itself changed since the caller
+// read it, as opposed to one of its index entries, which is safe to retry
+func (s *StateStore) checkEntityETag(ctx context.Context, definition *IndexedEntityType, id string, entityOp TransactionOperation) error {
+	etag, err := s.GetETag(ctx, entityOp.Key)
+	if err != nil {
+		return err
+	}
+	if etag != entityOp.ETag {
+		return domain.NewConflictError(fmt.Sprintf("%s:%s %s was modified concurrently", definition.Domain, definition.EntityType, id))
+	}
+	return nil
+}
+
+// buildIndexOperations diffs the entity's manifest against its new index values. A nil
+// values map removes the entity from every index it belongs to. When the sort key
+// changed, the entries the entity stays in are rewritten with the new key as well.
+// Index entries already rewritten earlier in the transaction are taken from staged.
+func (s *StateStore) buildIndexOperations(ctx context.Context, definition *IndexedEntityType, id string, values map[string][]string, sortKey *time.Time, staged map[string]TransactionOperation) ([]TransactionOperation, error) {
+	manifestKey := s.CreateIndexKey(definition.Domain, definition.EntityType, indexManifestName, id)
+
+	var manifest IndexManifest
+	_, manifestETag, err := s.GetWithETag(ctx, manifestKey, &manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	sortKeyChanged := !equalSortKeys(manifest.SortKey, sortKey)
+	var operations []TransactionOperation
+
+	for _, index := range definition.Indexes {
+		added, removed := diffIndexValues(manifest.Values[index.Name], values[index.Name])
+
+		for _, value := range removed {
+			key := s.CreateIndexKey(definition.Domain, definition.EntityType, index.Name, value)
+			entry, etag, err := s.stagedIndexEntry(ctx, staged, key)
+			if err != nil {
+				return nil, err
+			}
+			operations = append(operations, indexEntryOperation(key,
+				newIndexEntry(definition, index.Name, value, removeSorted(entry.IDs, id), withSortKey(entry.SortKeys, id, nil), now), etag))
+		}
+
+		written := added
+		if sortKeyChanged {
+			written = values[index.Name]
+		}
+		for _, value := range written {
+			key := s.CreateIndexKey(definition.Domain, definition.EntityType, index.Name, value)
+			entry, etag, err := s.stagedIndexEntry(ctx, staged, key)
+			if err != nil {
+				return nil, err
+			}
+			operations = append(operations, indexEntryOperation(key,
+				newIndexEntry(definition, index.Name, value, insertSorted(entry.IDs, id), withSortKey(entry.SortKeys, id, sortKey), now), etag))
+		}
+	}
+
+	if values == nil {
+		operations = append(operations, TransactionOperation{Operation: "delete", Key: manifestKey, ETag: manifestETag})
+	} else {
+		operations = append(operations, TransactionOperation{
+			Operation: "upsert",
+			Key:       manifestKey,
+			Value:     newIndexManifest(definition, id, values, sortKey, now),
+			ETag:      manifestETag,
+		})
+	}
+
+	return operations, nil
+}
+
+// indexEntryOperation writes an index entry guarded by the ETag it was read with. An
+// entry that did not exist is created insert-only, so when two writers add the first
+// members of the same entry concurrently one of them conflicts and retries instead of
+// overwriting the other's membership.
+func indexEntryOperation(key string, entry *IndexEntry, etag string) TransactionOperation {
+	if len(entry.IDs) == 0 {
+		return TransactionOperation{Operation: "delete", Key: key, ETag: etag}
+	}
+	operation := TransactionOperation{Operation: "upsert", Key: key, Value: entry, ETag: etag}
+	if etag == "" {
+		operation.Concurrency = client.StateConcurrencyFirstWrite
+	}
+	return operation
+}
+
+// stagedIndexEntry reads an index entry as staged earlier in the transaction, or as stored.
+// A staged entry keeps the ETag of the stored one so the transaction still guards it.
+func (s *StateStore) stagedIndexEntry(ctx context.Context, staged map[string]TransactionOperation, key string) (*IndexEntry, string, error) {
+	if operation, exists := staged[key]; exists {
+		if entry, ok := operation.Value.(*IndexEntry); ok {
+			return entry, operation.ETag, nil
+		}
+		return &IndexEntry{}, operation.ETag, nil
+	}
+
+	var entry IndexEntry
+	_, etag, err := s.GetWithETag(ctx, key, &entry)
+	if err != nil {
+		return nil, "", err
+	}
+	return &entry, etag, nil
+}
+
+func (s *StateStore) getIndexEntry(ctx context.Context, domainName, entityType, indexName, value string) (*IndexEntry, string, error) {
+	var entry IndexEntry
+	_, etag, err := s.GetWithETag(ctx, s.CreateIndexKey(domainName, entityType, indexName, value), &entry)
+	if err != nil {
+		return nil, "", err
+	}
+	return &entry, etag, nil
+}
+
+// scanIndexedEntities derives the expected index contents from every stored entity of a type
+func (s *StateStore) scanIndexedEntities(ctx context.Context, definition *IndexedEntityType) (map[string]map[string]map[string]bool, map[string]*IndexManifest, int, error) {
+	if definition.NewEntity == nil || definition.EntityID == nil {
+		return nil, nil, 0, fmt.Errorf("indexed entity type %s:%s cannot be scanned without NewEntity and EntityID", definition.Domain, definition.EntityType)
+	}
+
+	results, err := s.Query(ctx, `{}`)
+	if err != nil {
+		return nil, nil, 0, domain.WrapError(err, fmt.Sprintf("failed to scan %s:%s entities", definition.Domain, definition.EntityType))
+	}
+
+	expected := make(map[string]map[string]map[string]bool)
+	manifests := make(map[string]*IndexManifest)
+	prefix := s.CreateKey(definition.Domain, definition.EntityType, "")
+	scanned := 0
+
+	for _, result := range results {
+		if !strings.HasPrefix(stripAppKeyPrefix(result.Key), prefix) {
+			continue
+		}
+
+		entity := definition.NewEntity()
+		if err := json.Unmarshal(result.Value, entity); err != nil {
+			continue
+		}
+
+		id := definition.EntityID(entity)
+		if id == "" {
+			continue
+		}
+		scanned++
+
+		values := extractIndexValues(definition, entity)
+		manifests[id] = newIndexManifest(definition, id, values, extractSortKey(definition, entity), time.Time{})
+		for name, indexValues := range values {
+			if expected[name] == nil {
+				expected[name] = make(map[string]map[string]bool)
+			}
+			for _, value := range indexValues {
+				if expected[name][value] == nil {
+					expected[name][value] = make(map[string]bool)
+				}
+				expected[name][value][id] = true
+			}
+		}
+	}
+
+	return expected, manifests, scanned, nil
+}
+
+// scanIndexEntries loads every stored index entry for an entity type
+func (s *StateStore) scanIndexEntries(ctx context.Context, definition *IndexedEntityType) ([]*IndexEntry, error) {
+	query := fmt.Sprintf(`{
+		"filter": {
+			"AND": [
+				{"EQ": {"index_kind": "%s"}},
+				{"EQ": {"index_domain": "%s"}},
+				{"EQ": {"index_entity_type": "%s"}}
+			]
+		}
+	}`, indexKindEntry, definition.Domain, definition.EntityType)
+
+	results, err := s.Query(ctx, query)
+	if err != nil {
+		return nil, domain.WrapError(err, fmt.Sprintf("failed to scan index entries for %s:%s", definition.Domain, definition.EntityType))
+	}
+
+	var entries []*IndexEntry
+	for _, result := range results {
+		var entry IndexEntry
+		if err := json.Unmarshal(result.Value, &entry); err != nil {
+			continue
+		}
+		if entry.Kind == indexKindEntry && entry.Domain == definition.Domain && entry.EntityType == definition.EntityType {
+			entries = append(entries, &entry)
+		}
+	}
+
+	return entries, nil
+}
+
+func newIndexEntry(definition *IndexedEntityType, name, value string, ids []string, sortKeys map[string]time.Time, now time.Time) *IndexEntry {
+	return &IndexEntry{
+		Kind:       indexKindEntry,
+		Domain:     definition.Domain,
+		EntityType: definition.EntityType,
+		IndexName:  name,
+		IndexValue: value,
+		IDs:        ids,
+		SortKeys:   sortKeys,
+		UpdatedAt:  now,
+	}
+}
+
+func newIndexManifest(definition *IndexedEntityType, id string, values map[string][]string, sortKey *time.Time, now time.Time) *IndexManifest {
+	return &IndexManifest{
+		Kind:       indexKindManifest,
+		Domain:     definition.Domain,
+		EntityType: definition.EntityType,
+		EntityID:   id,
+		Values:     values,
+		SortKey:    sortKey,
+		UpdatedAt:  now,
+	}
+}
+
+// extractSortKey evaluates the sort key of an entity type that declares one, in UTC
+func extractSortKey(definition *IndexedEntityType, entity interface{}) *time.Time {
+	if definition.SortKey == nil {
+		return nil
+	}
+	sortKey := definition.SortKey(entity).UTC()
+	return &sortKey
+}
+
+func equalSortKeys(left, right *time.Time) bool {
+	if left == nil || right == nil {
+		return left == right
+	}
+	return left.Equal(*right)
+}
+
+// withSortKey returns a copy of sortKeys with the key of id replaced, or removed when
+// sortKey is nil. Entries may be shared with staged operations, so they are never
+// modified in place.
+func withSortKey(sortKeys map[string]time.Time, id string, sortKey *time.Time) map[string]time.Time {
+	result := make(map[string]time.Time, len(sortKeys)+1)
+	for member, key := range sortKeys {
+		if member != id {
+			result[member] = key
+		}
+	}
+	if sortKey != nil {
+		result[id] = *sortKey
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
+
+// memberSortKeys collects the sort keys of the given members from their manifests
+func memberSortKeys(manifests map[string]*IndexManifest, ids []string) map[string]time.Time {
+	sortKeys := make(map[string]time.Time)
+	for _, id := range ids {
+		if manifest := manifests[id]; manifest != nil && manifest.SortKey != nil {
+			sortKeys[id] = *manifest.SortKey
+		}
+	}
+	if len(sortKeys) == 0 {
+		return nil
+	}
+	return sortKeys
+}
+
+// extractIndexValues evaluates every declared index, dropping empty and duplicate values
+func extractIndexValues(definition *IndexedEntityType, entity interface{}) map[string][]string {
+	values := make(map[string][]string, len(definition.Indexes))
+	for _, index := range definition.Indexes {
+		seen := make(map[string]bool)
+		for _, value := range index.Extract(entity) {
+			if value != "" {
+				seen[value] = true
+			}
+		}
+		if len(seen) > 0 {
+			values[index.Name] = sortedKeys(seen)
+		}
+	}
+	return values
+}
+
+// diffIndexValues returns the values present only in next (added) and only in previous (removed)
+func diffIndexValues(previous, next []string) ([]string, []string) {
+	previousSet := make(map[string]bool, len(previous))
+	for _, value := range previous {
+		previousSet[value] = true
+	}
+	nextSet := make(map[string]bool, len(next))
+	for _, value := range next {
+		nextSet[value] = true
+	}
+
+	var added, removed []string
+	for _, value := range next {
+		if !previousSet[value] {
+			added = append(added, value)
+		}
+	}
+	for _, value := range previous {
+		if !nextSet[value] {
+			removed = append(removed, value)
+		}
+	}
+	return added, removed
+}
+
+func insertSorted(ids []string, id string) []string {
+	position := sort.SearchStrings(ids, id)
+	if position < len(ids) && ids[position] == id {
+		return ids
+	}
+	result := make([]string, 0, len(ids)+1)
+	result = append(result, ids[:position]...)
+	result = append(result, id)
+	return append(result, ids[position:]...)
+}
+
+func removeSorted(ids []string, id string) []string {
+	position := sort.SearchStrings(ids, id)
+	if position >= len(ids) || ids[position] != id {
+		return ids
+	}
+	result := make([]string, 0, len(ids)-1)
+	result = append(result, ids[:position]...)
+	return append(result, ids[position+1:]...)
+}
+
+func intersectSorted(left, right []string) []string {
+	result := []string{}
+	i, j := 0, 0
+	for i < len(left) && j < len(right) {
+		switch {
+		case left[i] == right[j]:
+			result = append(result, left[i])
+			i++
+			j++
+		case left[i] < right[j]:
+			i++
+		default:
+			j++
+		}
+	}
+	return result
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func splitOperations(operations []TransactionOperation, batchSize int) [][]TransactionOperation {
+	if batchSize <= 0 {
+		batchSize = len(operations)
+	}
+	var batches [][]TransactionOperation
+	for start := 0; start < len(operations); start += batchSize {
+		end := start + batchSize
+		if end > len(operations) {
+			end = len(operations)
+		}
+		batches = append(batches, operations[start:end])
+	}
+	return batches
+}
+
+// stripAppKeyPrefix removes the "<app-id>||" prefix Dapr adds to keys in query results
+func stripAppKeyPrefix(key string) string {
+	if position := strings.LastIndex(key, "||"); position >= 0 {
+		return key[position+2:]
+	}
+	return key
+}
+
+func formatMembership(name, value, id string) string {
+	return fmt.Sprintf("%s=%s:%s", name, value, id)
+}
@@ -0,0 +1,445 @@
+package dapr
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/dapr/go-sdk/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type indexedTestEntity struct {
+	ID         string    `json:"id"`
+	CategoryID string    `json:"category_id"`
+	Tags       []string  `json:"tags"`
+	IsDeleted  bool      `json:"is_deleted"`
+	CreatedOn  time.Time `json:"created_on"`
+}
+
+func indexedTestEntityType() *IndexedEntityType {
+	return &IndexedEntityType{
+		Domain:     "test",
+		EntityType: "entity",
+		Indexes: []IndexDefinition{
+			{Name: "category", Extract: func(entity interface{}) []string {
+				return []string{entity.(*indexedTestEntity).CategoryID}
+			}},
+			{Name: "tag", Extract: func(entity interface{}) []string {
+				return entity.(*indexedTestEntity).Tags
+			}},
+			{Name: "is_deleted", Extract: func(entity interface{}) []string {
+				return []string{IndexBool(entity.(*indexedTestEntity).IsDeleted)}
+			}},
+			{Name: "created_month", Extract: func(entity interface{}) []string {
+				return []string{IndexMonthBucket(entity.(*indexedTestEntity).CreatedOn)}
+			}},
+		},
+		NewEntity: func() interface{} { return &indexedTestEntity{} },
+		EntityID:  func(entity interface{}) string { return entity.(*indexedTestEntity).ID },
+	}
+}
+
+func newIndexTestStateStore(t *testing.T) *StateStore {
+	ResetClientForTesting()
+	t.Setenv("DAPR_TEST_MODE", "true")
+	t.Cleanup(ResetClientForTesting)
+
+	client, err := NewClient()
+	require.NoError(t, err)
+
+	return NewStateStore(client)
+}
+
+func TestStateStore_RegisterIndexes(t *testing.T) {
+	tests := []struct {
+		name          string
+		definition    *IndexedEntityType
+		expectedError string
+	}{
+		{
+			name:       "register valid indexes",
+			definition: indexedTestEntityType(),
+		},
+		{
+			name:          "reject nil definition",
+			definition:    nil,
+			expectedError: "requires domain and entity type",
+		},
+		{
+			name:          "reject definition without indexes",
+			definition:    &IndexedEntityType{Domain: "test", EntityType: "entity"},
+			expectedError: "declares no indexes",
+		},
+		{
+			name: "reject reserved index name",
+			definition: &IndexedEntityType{Domain: "test", EntityType: "entity", Indexes: []IndexDefinition{
+				{Name: indexManifestName, Extract: func(interface{}) []string { return nil }},
+			}},
+			expectedError: "reserved",
+		},
+		{
+			name: "reject duplicate index name",
+			definition: &IndexedEntityType{Domain: "test", EntityType: "entity", Indexes: []IndexDefinition{
+				{Name: "category", Extract: func(interface{}) []string { return nil }},
+				{Name: "category", Extract: func(interface{}) []string { return nil }},
+			}},
+			expectedError: "declared twice",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			stateStore := newIndexTestStateStore(t)
+
+			// Act
+			err := stateStore.RegisterIndexes(tt.definition)
+
+			// Assert
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestStateStore_IndexedOperations(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stateStore := newIndexTestStateStore(t)
+	stateStore.MustRegisterIndexes(indexedTestEntityType())
+
+	entity := &indexedTestEntity{ID: "entity-1", CategoryID: "cat-1", Tags: []string{"a", "b", "a"}, CreatedOn: time.Now()}
+
+	t.Run("save indexed entity", func(t *testing.T) {
+		assert.NoError(t, stateStore.SaveIndexed(ctx, "test", "entity", entity.ID, entity))
+	})
+
+	t.Run("save unregistered entity type", func(t *testing.T) {
+		err := stateStore.SaveIndexed(ctx, "test", "unknown", entity.ID, entity)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no indexes registered")
+	})
+
+	t.Run("lookup finds saved entity", func(t *testing.T) {
+		ids, err := stateStore.LookupIndex(ctx, "test", "entity",
+			IndexCondition{Index: "category", Value: "cat-1"},
+			IndexCondition{Index: "is_deleted", Value: IndexBool(false)},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"entity-1"}, ids)
+	})
+
+	t.Run("lookup misses other values", func(t *testing.T) {
+		ids, err := stateStore.LookupIndex(ctx, "test", "entity", IndexCondition{Index: "category", Value: "cat-2"})
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+	})
+
+	t.Run("lookup rejects undeclared index", func(t *testing.T) {
+		_, err := stateStore.LookupIndex(ctx, "test", "entity", IndexCondition{Index: "slug", Value: "x"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not declared")
+	})
+
+	t.Run("lookup requires a condition", func(t *testing.T) {
+		_, err := stateStore.LookupIndex(ctx, "test", "entity")
+		assert.Error(t, err)
+	})
+
+	t.Run("verify reports consistent store", func(t *testing.T) {
+		report, err := stateStore.VerifyIndexes(ctx, "test", "entity")
+		require.NoError(t, err)
+		assert.True(t, report.Consistent())
+		assert.Equal(t, 1, report.EntitiesScanned)
+	})
+}
+
+func TestStateStore_ConcurrentFirstIndexMembers(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	store := NewStateStoreWithBackend(NewMemoryStateBackend())
+	definition := indexedTestEntityType()
+	require.NoError(t, store.RegisterIndexes(definition))
+	first := &indexedTestEntity{ID: "entity-1", CategoryID: "new"}
+	second := &indexedTestEntity{ID: "entity-2", CategoryID: "new"}
+
+	// Both writers read the category entry before either creates it
+	firstOps, err := store.buildIndexOperations(ctx, definition, first.ID, extractIndexValues(definition, first), nil, map[string]TransactionOperation{})
+	require.NoError(t, err)
+	secondOps, err := store.buildIndexOperations(ctx, definition, second.ID, extractIndexValues(definition, second), nil, map[string]TransactionOperation{})
+	require.NoError(t, err)
+
+	// Act
+	firstErr := store.ExecuteTransaction(ctx, &TransactionRequest{Operations: firstOps})
+	secondErr := store.ExecuteTransaction(ctx, &TransactionRequest{Operations: secondOps})
+	retryErr := store.SaveIndexed(ctx, "test", "entity", second.ID, second)
+
+	// Assert
+	require.NoError(t, firstErr)
+	assert.True(t, domain.IsConflictError(secondErr), "the second first write must not overwrite the entry")
+	require.NoError(t, retryErr)
+
+	ids, err := store.LookupIndex(ctx, "test", "entity", IndexCondition{Index: "category", Value: "new"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"entity-1", "entity-2"}, ids)
+}
+
+func TestStateStore_LookupIndexPage(t *testing.T) {
+	base := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		update      *indexedTestEntity
+		page        func(t *testing.T) domain.PageRequest
+		expectedIDs []string
+		hasMore     bool
+	}{
+		{
+			name:        "first page is latest first",
+			page:        func(t *testing.T) domain.PageRequest { return domain.PageRequest{Limit: 2} },
+			expectedIDs: []string{"entity-4", "entity-2"},
+			hasMore:     true,
+		},
+		{
+			name: "next page resumes after cursor",
+			page: func(t *testing.T) domain.PageRequest {
+				return domain.PageRequest{After: &domain.PageCursor{Timestamp: base.Add(2 * time.Hour), ID: "entity-2"}, Limit: 2}
+			},
+			expectedIDs: []string{"entity-3", "entity-1"},
+		},
+		{
+			name:        "changed sort key reorders kept memberships",
+			update:      &indexedTestEntity{ID: "entity-1", CategoryID: "cat-1", CreatedOn: base.Add(10 * time.Hour)},
+			page:        func(t *testing.T) domain.PageRequest { return domain.PageRequest{Limit: 2} },
+			expectedIDs: []string{"entity-1", "entity-4"},
+			hasMore:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+			store := NewStateStoreWithBackend(NewMemoryStateBackend())
+			definition := indexedTestEntityType()
+			definition.SortKey = func(entity interface{}) time.Time { return entity.(*indexedTestEntity).CreatedOn }
+			require.NoError(t, store.RegisterIndexes(definition))
+
+			entities := []*indexedTestEntity{
+				{ID: "entity-1", CategoryID: "cat-1", CreatedOn: base},
+				{ID: "entity-2", CategoryID: "cat-1", CreatedOn: base.Add(2 * time.Hour)},
+				{ID: "entity-3", CategoryID: "cat-1", CreatedOn: base.Add(2 * time.Hour)},
+				{ID: "entity-4", CategoryID: "cat-1", CreatedOn: base.Add(3 * time.Hour)},
+				{ID: "entity-5", CategoryID: "cat-2", CreatedOn: base.Add(4 * time.Hour)},
+			}
+			for _, entity := range entities {
+				require.NoError(t, store.SaveIndexed(ctx, "test", "entity", entity.ID, entity))
+			}
+			if tt.update != nil {
+				require.NoError(t, store.SaveIndexed(ctx, "test", "entity", tt.update.ID, tt.update))
+			}
+
+			// Act
+			ids, info, err := store.LookupIndexPage(ctx, "test", "entity", tt.page(t), IndexCondition{Index: "category", Value: "cat-1"})
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedIDs, ids)
+			assert.Equal(t, tt.hasMore, info.HasMore)
+			assert.Equal(t, 4, info.Total)
+
+			report, err := store.VerifyIndexes(ctx, "test", "entity")
+			require.NoError(t, err)
+			assert.True(t, report.Consistent())
+		})
+	}
+}
+
+func TestStateStore_LookupIndexPageRequiresSortKey(t *testing.T) {
+	// Arrange
+	store := NewStateStoreWithBackend(NewMemoryStateBackend())
+	require.NoError(t, store.RegisterIndexes(indexedTestEntityType()))
+
+	// Act
+	_, _, err := store.LookupIndexPage(context.Background(), "test", "entity", domain.PageRequest{Limit: 2},
+		IndexCondition{Index: "category", Value: "cat-1"})
+
+	// Assert
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "declares no sort key")
+}
+
+func TestExtractIndexValues(t *testing.T) {
+	definition := indexedTestEntityType()
+	entity := &indexedTestEntity{
+		ID:         "entity-1",
+		CategoryID: "",
+		Tags:       []string{"beta", "alpha", "beta", ""},
+		CreatedOn:  time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC),
+	}
+
+	values := extractIndexValues(definition, entity)
+
+	_, hasCategory := values["category"]
+	assert.False(t, hasCategory, "empty values should not be indexed")
+	assert.Equal(t, []string{"alpha", "beta"}, values["tag"])
+	assert.Equal(t, []string{"false"}, values["is_deleted"])
+	assert.Equal(t, []string{"2024-03"}, values["created_month"])
+}
+
+func TestIndexSetHelpers(t *testing.T) {
+	t.Run("diff index values", func(t *testing.T) {
+		added, removed := diffIndexValues([]string{"a", "b"}, []string{"b", "c"})
+		assert.Equal(t, []string{"c"}, added)
+		assert.Equal(t, []string{"a"}, removed)
+	})
+
+	t.Run("insert and remove keep ids sorted and unique", func(t *testing.T) {
+		ids := insertSorted([]string{"a", "c"}, "b")
+		assert.Equal(t, []string{"a", "b", "c"}, ids)
+		assert.Equal(t, ids, insertSorted(ids, "b"))
+		assert.Equal(t, []string{"a", "c"}, removeSorted(ids, "b"))
+		assert.Equal(t, []string{"a", "b", "c"}, removeSorted(ids, "z"))
+	})
+
+	t.Run("intersect sorted sets", func(t *testing.T) {
+		assert.Equal(t, []string{"b", "d"}, intersectSorted([]string{"a", "b", "d"}, []string{"b", "c", "d"}))
+		assert.Empty(t, intersectSorted([]string{"a"}, []string{"b"}))
+	})
+
+	t.Run("month buckets span range", func(t *testing.T) {
+		from := time.Date(2024, 11, 20, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+		assert.Equal(t, []string{"2024-11", "2024-12", "2025-01", "2025-02"}, IndexMonthBuckets(from, to))
+		assert.Nil(t, IndexMonthBuckets(to, from))
+	})
+
+	t.Run("strip app key prefix", func(t *testing.T) {
+		assert.Equal(t, "news:news:1", stripAppKeyPrefix("content-api||news:news:1"))
+		assert.Equal(t, "news:news:1", stripAppKeyPrefix("news:news:1"))
+	})
+}
+
+// etagMockDaprClient is a mock Dapr client holding versioned state that rejects
+// transactions carrying a stale ETag, like a state store with first-write concurrency
+type etagMockDaprClient struct {
+	client.Client
+	mutex   sync.Mutex
+	values  map[string][]byte
+	etags   map[string]int
+	version int
+}
+
+func newETagMockDaprClient() *etagMockDaprClient {
+	return &etagMockDaprClient{values: make(map[string][]byte), etags: make(map[string]int)}
+}
+
+func (m *etagMockDaprClient) GetState(ctx context.Context, storeName, key string, meta map[string]string) (*client.StateItem, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	value, exists := m.values[key]
+	if !exists {
+		return &client.StateItem{Key: key}, nil
+	}
+	return &client.StateItem{Key: key, Value: value, Etag: strconv.Itoa(m.etags[key])}, nil
+}
+
+func (m *etagMockDaprClient) ExecuteStateTransaction(ctx context.Context, storeName string, meta map[string]string, ops []*client.StateOperation) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for _, op := range ops {
+		if op.Item.Etag == nil || op.Item.Etag.Value == "" {
+			continue
+		}
+		if _, exists := m.values[op.Item.Key]; !exists || strconv.Itoa(m.etags[op.Item.Key]) != op.Item.Etag.Value {
+			return fmt.Errorf("possible etag mismatch for key %s", op.Item.Key)
+		}
+	}
+
+	for _, op := range ops {
+		switch op.Type {
+		case client.StateOperationTypeUpsert:
+			m.version++
+			m.values[op.Item.Key] = op.Item.Value
+			m.etags[op.Item.Key] = m.version
+		case client.StateOperationTypeDelete:
+			delete(m.values, op.Item.Key)
+			delete(m.etags, op.Item.Key)
+		}
+	}
+	return nil
+}
+
+func TestStateStore_SaveIndexedWithETag(t *testing.T) {
+	tests := []struct {
+		name             string
+		staleETag        bool
+		expectedCategory string
+		expectedError    bool
+	}{
+		{
+			name:             "save with current etag",
+			expectedCategory: "beta",
+		},
+		{
+			name:             "reject stale etag",
+			staleETag:        true,
+			expectedCategory: "gamma",
+			expectedError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+			store := NewStateStore(&Client{client: newETagMockDaprClient()})
+			require.NoError(t, store.RegisterIndexes(indexedTestEntityType()))
+			key := store.CreateKey("test", "entity", "entity-1")
+
+			require.NoError(t, store.SaveIndexed(ctx, "test", "entity", "entity-1", &indexedTestEntity{ID: "entity-1", CategoryID: "alpha"}))
+			var entity indexedTestEntity
+			_, etag, err := store.GetWithETag(ctx, key, &entity)
+			require.NoError(t, err)
+			require.NotEmpty(t, etag)
+
+			if tt.staleETag {
+				require.NoError(t, store.SaveIndexed(ctx, "test", "entity", "entity-1", &indexedTestEntity{ID: "entity-1", CategoryID: "gamma"}))
+			}
+
+			// Act
+			err = store.SaveIndexedWithETag(ctx, "test", "entity", "entity-1", &indexedTestEntity{ID: "entity-1", CategoryID: "beta"}, etag)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.True(t, domain.IsConflictError(err))
+			} else {
+				require.NoError(t, err)
+			}
+
+			var stored indexedTestEntity
+			_, currentETag, err := store.GetWithETag(ctx, key, &stored)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedCategory, stored.CategoryID)
+			assert.NotEqual(t, etag, currentETag)
+
+			ids, err := store.LookupIndex(ctx, "test", "entity", IndexCondition{Index: "category", Value: tt.expectedCategory})
+			require.NoError(t, err)
+			assert.Equal(t, []string{"entity-1"}, ids)
+		})
+	}
+}
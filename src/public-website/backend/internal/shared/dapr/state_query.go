@@ -0,0 +1,237 @@
+package dapr
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// stateQuery is a query in the Dapr state query language, evaluated by the local
+// backends the way a Dapr state store would evaluate it
+type stateQuery struct {
+	Filter map[string]json.RawMessage `json:"filter"`
+	Sort   []stateQuerySort           `json:"sort"`
+	Page   stateQueryPage             `json:"page"`
+}
+
+type stateQuerySort struct {
+	Key   string `json:"key"`
+	Order string `json:"order"`
+}
+
+type stateQueryPage struct {
+	Limit int    `json:"limit"`
+	Token string `json:"token"`
+}
+
+// stateFilter reports whether a decoded JSON document matches a query filter
+type stateFilter func(document interface{}) bool
+
+// runStateQuery filters, sorts and pages items. Items whose value is not a JSON
+// document only match a query without a filter.
+func runStateQuery(items []*StateItem, query string) ([]*StateItem, error) {
+	var parsed stateQuery
+	if err := json.Unmarshal([]byte(query), &parsed); err != nil {
+		return nil, fmt.Errorf("invalid state query: %w", err)
+	}
+
+	filter, err := compileStateFilter(parsed.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	type match struct {
+		item     *StateItem
+		document interface{}
+	}
+	var matches []match
+	for _, item := range items {
+		var document interface{}
+		if err := json.Unmarshal(item.Value, &document); err != nil && filter != nil {
+			continue
+		}
+		if filter == nil || filter(document) {
+			matches = append(matches, match{item: item, document: document})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		for _, order := range parsed.Sort {
+			comparison := compareStateValues(lookupStatePath(matches[i].document, order.Key), lookupStatePath(matches[j].document, order.Key))
+			if comparison == 0 {
+				continue
+			}
+			if strings.EqualFold(order.Order, "DESC") {
+				return comparison > 0
+			}
+			return comparison < 0
+		}
+		return matches[i].item.Key < matches[j].item.Key
+	})
+
+	start := 0
+	if parsed.Page.Token != "" {
+		start, err = strconv.Atoi(parsed.Page.Token)
+		if err != nil || start < 0 {
+			return nil, fmt.Errorf("invalid state query page token %q", parsed.Page.Token)
+		}
+	}
+	if start > len(matches) {
+		start = len(matches)
+	}
+	end := len(matches)
+	if parsed.Page.Limit > 0 && start+parsed.Page.Limit < end {
+		end = start + parsed.Page.Limit
+	}
+
+	results := make([]*StateItem, 0, end-start)
+	for _, m := range matches[start:end] {
+		results = append(results, m.item)
+	}
+	return results, nil
+}
+
+// compileStateFilter builds the filter for one EQ, IN, AND or OR clause. A missing
+// filter matches everything and is returned as nil.
+func compileStateFilter(clause map[string]json.RawMessage) (stateFilter, error) {
+	if len(clause) == 0 {
+		return nil, nil
+	}
+	if len(clause) != 1 {
+		return nil, fmt.Errorf("state query filter must have exactly one operator")
+	}
+
+	for operator, raw := range clause {
+		switch strings.ToUpper(operator) {
+		case "EQ":
+			path, value, err := decodeStateCondition(raw)
+			if err != nil {
+				return nil, err
+			}
+			return func(document interface{}) bool {
+				return stateValuesEqual(lookupStatePath(document, path), value)
+			}, nil
+
+		case "IN":
+			path, value, err := decodeStateCondition(raw)
+			if err != nil {
+				return nil, err
+			}
+			candidates, ok := value.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("state query IN requires an array of values")
+			}
+			return func(document interface{}) bool {
+				actual := lookupStatePath(document, path)
+				for _, candidate := range candidates {
+					if stateValuesEqual(actual, candidate) {
+						return true
+					}
+				}
+				return false
+			}, nil
+
+		case "AND", "OR":
+			var clauses []map[string]json.RawMessage
+			if err := json.Unmarshal(raw, &clauses); err != nil || len(clauses) == 0 {
+				return nil, fmt.Errorf("state query %s requires a list of filters", operator)
+			}
+			filters := make([]stateFilter, 0, len(clauses))
+			for _, nested := range clauses {
+				filter, err := compileStateFilter(nested)
+				if err != nil {
+					return nil, err
+				}
+				if filter != nil {
+					filters = append(filters, filter)
+				}
+			}
+			all := strings.EqualFold(operator, "AND")
+			return func(document interface{}) bool {
+				for _, filter := range filters {
+					if filter(document) != all {
+						return !all
+					}
+				}
+				return all
+			}, nil
+
+		default:
+			return nil, fmt.Errorf("unsupported state query operator %s", operator)
+		}
+	}
+	return nil, nil
+}
+
+func decodeStateCondition(raw json.RawMessage) (string, interface{}, error) {
+	var condition map[string]interface{}
+	if err := json.Unmarshal(raw, &condition); err != nil || len(condition) != 1 {
+		return "", nil, fmt.Errorf("state query condition must name exactly one key")
+	}
+	for path, value := range condition {
+		return path, value, nil
+	}
+	return "", nil, nil
+}
+
+// lookupStatePath follows a dotted path such as "author.name" into a document
+func lookupStatePath(document interface{}, path string) interface{} {
+	current := document
+	for _, segment := range strings.Split(path, ".") {
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		current = object[segment]
+	}
+	return current
+}
+
+func stateValuesEqual(actual, expected interface{}) bool {
+	return actual != nil && reflect.DeepEqual(actual, expected)
+}
+
+// compareStateValues orders numbers and strings naturally; missing values sort last
+func compareStateValues(left, right interface{}) int {
+	if left == nil || right == nil {
+		switch {
+		case left == nil && right == nil:
+			return 0
+		case left == nil:
+			return 1
+		default:
+			return -1
+		}
+	}
+
+	switch l := left.(type) {
+	case float64:
+		if r, ok := right.(float64); ok {
+			switch {
+			case l < r:
+				return -1
+			case l > r:
+				return 1
+			}
+			return 0
+		}
+	case string:
+		if r, ok := right.(string); ok {
+			return strings.Compare(l, r)
+		}
+	case bool:
+		if r, ok := right.(bool); ok {
+			switch {
+			case l == r:
+				return 0
+			case !l:
+				return -1
+			}
+			return 1
+		}
+	}
+	return strings.Compare(fmt.Sprint(left), fmt.Sprint(right))
+}
@@ -0,0 +1,171 @@
+package dapr
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/dapr/go-sdk/client"
+)
+
+const revisionMaxRetries = 3
+
+// RevisionLog is the per-entity record of every revision appended for it. It keeps
+// revision summaries so that listing history takes a single read; each revision's
+// data snapshot is stored under its own key and never rewritten.
+type RevisionLog struct {
+	Domain     string             `json:"domain"`
+	EntityType string             `json:"entity_type"`
+	EntityID   string             `json:"entity_id"`
+	Latest     int                `json:"latest"`
+	Revisions  []*domain.Revision `json:"revisions"`
+	UpdatedAt  time.Time          `json:"updated_at"`
+}
+
+// AppendRevision numbers a revision after the latest one recorded for the entity and
+// stores it together with the updated revision log in one transaction. Concurrent
+// appends for the same entity are serialised through the log's ETag and retried.
+func (s *StateStore) AppendRevision(ctx context.Context, domainName, entityType string, revision *domain.Revision) error {
+	if revision == nil || revision.EntityID == "" {
+		return fmt.Errorf("revision requires an entity ID")
+	}
+
+	stage := s.revisionOperations(domainName, entityType, revision)
+
+	var lastErr error
+	for attempt := 0; attempt <= revisionMaxRetries; attempt++ {
+		operations, committed, err := stage(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = s.ExecuteTransaction(ctx, &TransactionRequest{Operations: operations})
+		if err == nil {
+			committed()
+			return nil
+		}
+		if !s.isConcurrencyConflict(err) {
+			return domain.WrapError(err, fmt.Sprintf("failed to append revision for %s:%s %s", domainName, entityType, revision.EntityID))
+		}
+		lastErr = err
+
+		backoffDuration := time.Duration(25*(1<<uint(attempt))) * time.Millisecond
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoffDuration):
+		}
+	}
+
+	return domain.NewConflictError(fmt.Sprintf("revision append for %s:%s %s kept conflicting: %v", domainName, entityType, revision.EntityID, lastErr))
+}
+
+// SaveIndexedWithRevision saves an entity like SaveIndexedWithOutbox and appends revision
+// to the entity's revision log in the same transaction, so a committed change is never
+// missing from its history and a failed one never shows up there. A nil revision saves
+// without recording one.
+func (s *StateStore) SaveIndexedWithRevision(ctx context.Context, domainName, entityType, id string, value interface{}, etag string, revision *domain.Revision, messages ...*OutboxMessage) error {
+	if revision != nil && revision.EntityID != id {
+		return fmt.Errorf("revision of %q cannot be saved with %s:%s %s", revision.EntityID, domainName, entityType, id)
+	}
+
+	write, err := s.indexedUpsert(domainName, entityType, id, value, etag)
+	if err != nil {
+		return err
+	}
+
+	writes, err := s.outboxWrites(write, messages)
+	if err != nil {
+		return err
+	}
+
+	if revision == nil {
+		return s.writeIndexedWithRetry(ctx, writes...)
+	}
+	return s.writeIndexedStaged(ctx, s.revisionOperations(domainName, entityType, revision), writes...)
+}
+
+// revisionOperations stages appending revision to the revision log as read at the time,
+// numbering it after the latest revision. A log that does not exist yet is created
+// first-write, so of two concurrent first revisions one conflicts and is renumbered.
+func (s *StateStore) revisionOperations(domainName, entityType string, revision *domain.Revision) stagedOperations {
+	logKey := s.createRevisionLogKey(domainName, entityType, revision.EntityID)
+
+	return func(ctx context.Context) ([]TransactionOperation, func(), error) {
+		log, etag, err := s.getRevisionLog(ctx, domainName, entityType, revision.EntityID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		numbered := *revision
+		numbered.Number = log.Latest + 1
+		log.Latest = numbered.Number
+		log.Revisions = append(log.Revisions, numbered.Summary())
+		log.UpdatedAt = time.Now().UTC()
+
+		logOperation := TransactionOperation{Operation: "upsert", Key: logKey, Value: log, ETag: etag}
+		if etag == "" {
+			logOperation.Concurrency = client.StateConcurrencyFirstWrite
+		}
+
+		operations := []TransactionOperation{
+			{Operation: "upsert", Key: s.createRevisionKey(domainName, entityType, revision.EntityID, numbered.Number), Value: &numbered},
+			logOperation,
+		}
+		return operations, func() { revision.Number = numbered.Number }, nil
+	}
+}
+
+// ListRevisions returns the summaries of every revision recorded for an entity,
+// newest first. An entity without history has no revisions.
+func (s *StateStore) ListRevisions(ctx context.Context, domainName, entityType, entityID string) ([]*domain.Revision, error) {
+	log, _, err := s.getRevisionLog(ctx, domainName, entityType, entityID)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make([]*domain.Revision, 0, len(log.Revisions))
+	for i := len(log.Revisions) - 1; i >= 0; i-- {
+		revisions = append(revisions, log.Revisions[i])
+	}
+	return revisions, nil
+}
+
+// GetRevision returns one revision of an entity including its data snapshot
+func (s *StateStore) GetRevision(ctx context.Context, domainName, entityType, entityID string, number int) (*domain.Revision, error) {
+	if number < 1 {
+		return nil, domain.NewValidationFieldError("revision", "revision must be a positive number")
+	}
+
+	var revision domain.Revision
+	found, err := s.Get(ctx, s.createRevisionKey(domainName, entityType, entityID, number), &revision)
+	if err != nil {
+		return nil, domain.WrapError(err, fmt.Sprintf("failed to get revision %d of %s:%s %s", number, domainName, entityType, entityID))
+	}
+	if !found {
+		return nil, domain.NewNotFoundError("revision", fmt.Sprintf("%s@%d", entityID, number))
+	}
+	return &revision, nil
+}
+
+func (s *StateStore) getRevisionLog(ctx context.Context, domainName, entityType, entityID string) (*RevisionLog, string, error) {
+	log := &RevisionLog{}
+	found, etag, err := s.GetWithETag(ctx, s.createRevisionLogKey(domainName, entityType, entityID), log)
+	if err != nil {
+		return nil, "", domain.WrapError(err, fmt.Sprintf("failed to get revision log of %s:%s %s", domainName, entityType, entityID))
+	}
+	if !found {
+		log = &RevisionLog{Domain: domainName, EntityType: entityType, EntityID: entityID}
+	}
+	return log, etag, nil
+}
+
+func (s *StateStore) createRevisionLogKey(domainName, entityType, entityID string) string {
+	return fmt.Sprintf("rev:%s:%s:%s", domainName, entityType, entityID)
+}
+
+func (s *StateStore) createRevisionKey(domainName, entityType, entityID string, number int) string {
+	return fmt.Sprintf("rev:%s:%s:%s:%s", domainName, entityType, entityID, strconv.Itoa(number))
+}
@@ -0,0 +1,143 @@
+package dapr
+
+import (
+	"context"
+	"testing"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRevision(entityID string, operationType domain.AuditEventType, before, after interface{}) *domain.Revision {
+	event := domain.NewAuditEvent(domain.EntityTypeNews, entityID, operationType, "admin-1")
+	event.SetDataSnapshot(before, after)
+	return domain.NewRevision(event)
+}
+
+func TestStateStore_AppendRevision(t *testing.T) {
+	ctx := context.Background()
+	store := NewStateStore(&Client{client: newETagMockDaprClient()})
+
+	first := newTestRevision("news-1", domain.AuditEventInsert, nil, map[string]interface{}{"title": "Draft"})
+	second := newTestRevision("news-1", domain.AuditEventUpdate, map[string]interface{}{"title": "Draft"}, map[string]interface{}{"title": "Final"})
+	other := newTestRevision("news-2", domain.AuditEventInsert, nil, map[string]interface{}{"title": "Other"})
+
+	require.NoError(t, store.AppendRevision(ctx, "news", "news", first))
+	require.NoError(t, store.AppendRevision(ctx, "news", "news", second))
+	require.NoError(t, store.AppendRevision(ctx, "news", "news", other))
+
+	t.Run("number revisions per entity", func(t *testing.T) {
+		assert.Equal(t, 1, first.Number)
+		assert.Equal(t, 2, second.Number)
+		assert.Equal(t, 1, other.Number)
+	})
+
+	t.Run("list summaries newest first", func(t *testing.T) {
+		revisions, err := store.ListRevisions(ctx, "news", "news", "news-1")
+		require.NoError(t, err)
+		require.Len(t, revisions, 2)
+		assert.Equal(t, 2, revisions[0].Number)
+		assert.Equal(t, domain.AuditEventUpdate, revisions[0].OperationType)
+		assert.Equal(t, 1, revisions[1].Number)
+		assert.Nil(t, revisions[0].DataSnapshot)
+	})
+
+	t.Run("list entity without history", func(t *testing.T) {
+		revisions, err := store.ListRevisions(ctx, "news", "news", "news-3")
+		require.NoError(t, err)
+		assert.Empty(t, revisions)
+	})
+
+	t.Run("get revision with snapshot", func(t *testing.T) {
+		revision, err := store.GetRevision(ctx, "news", "news", "news-1", 2)
+		require.NoError(t, err)
+		assert.Equal(t, second.RevisionID, revision.RevisionID)
+		require.True(t, revision.HasContent())
+		assert.Equal(t, map[string]interface{}{"title": "Final"}, revision.DataSnapshot.After)
+		assert.Equal(t, map[string]interface{}{"title": "Draft"}, revision.DataSnapshot.Before)
+	})
+
+	t.Run("get unknown revision", func(t *testing.T) {
+		_, err := store.GetRevision(ctx, "news", "news", "news-1", 3)
+		require.Error(t, err)
+		assert.True(t, domain.IsNotFoundError(err))
+	})
+
+	t.Run("reject invalid revision number", func(t *testing.T) {
+		_, err := store.GetRevision(ctx, "news", "news", "news-1", 0)
+		require.Error(t, err)
+		assert.True(t, domain.IsValidationError(err))
+	})
+
+	t.Run("reject revision without entity", func(t *testing.T) {
+		assert.Error(t, store.AppendRevision(ctx, "news", "news", &domain.Revision{}))
+	})
+}
+
+func TestStateStore_SaveIndexedWithRevision(t *testing.T) {
+	tests := []struct {
+		name              string
+		priorRevisions    int
+		revisionEntityID  string
+		withoutRevision   bool
+		staleETag         bool
+		expectedError     bool
+		expectedRevisions int
+		expectedNumber    int
+		expectedPending   int
+	}{
+		{name: "commit entity with its first revision", expectedRevisions: 1, expectedNumber: 1, expectedPending: 1},
+		{name: "number after existing history", priorRevisions: 2, expectedRevisions: 3, expectedNumber: 3, expectedPending: 1},
+		{name: "commit entity without a revision", withoutRevision: true, expectedPending: 1},
+		{name: "stale entity records no revision", staleETag: true, expectedError: true},
+		{name: "reject revision of another entity", revisionEntityID: "entity-2", expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+			stateStore := newOutboxTestStateStore()
+			entity := &indexedTestEntity{ID: "entity-1", CategoryID: "news"}
+			require.NoError(t, stateStore.SaveIndexed(ctx, "test", "entity", entity.ID, entity))
+			etag, err := stateStore.GetETag(ctx, stateStore.CreateKey("test", "entity", entity.ID))
+			require.NoError(t, err)
+			if tt.staleETag {
+				require.NoError(t, stateStore.SaveIndexed(ctx, "test", "entity", entity.ID, entity))
+			}
+			for i := 0; i < tt.priorRevisions; i++ {
+				require.NoError(t, stateStore.AppendRevision(ctx, "test", "entity", newTestRevision("entity-1", domain.AuditEventUpdate, nil, nil)))
+			}
+
+			revisionEntityID := "entity-1"
+			if tt.revisionEntityID != "" {
+				revisionEntityID = tt.revisionEntityID
+			}
+			revision := newTestRevision(revisionEntityID, domain.AuditEventUpdate, map[string]interface{}{"category": "news"}, map[string]interface{}{"category": "research"})
+			if tt.withoutRevision {
+				revision = nil
+			}
+
+			// Act
+			updated := &indexedTestEntity{ID: "entity-1", CategoryID: "research"}
+			err = stateStore.SaveIndexedWithRevision(ctx, "test", "entity", updated.ID, updated, etag, revision, testOutboxMessage("audit.event"))
+
+			// Assert
+			revisions, listErr := stateStore.ListRevisions(ctx, "test", "entity", "entity-1")
+			require.NoError(t, listErr)
+			assert.Len(t, pendingOutboxIDs(t, stateStore), tt.expectedPending)
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Len(t, revisions, tt.priorRevisions)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, revisions, tt.expectedRevisions)
+			if revision != nil {
+				assert.Equal(t, tt.expectedNumber, revision.Number)
+				assert.Equal(t, tt.expectedNumber, revisions[0].Number)
+			}
+		})
+	}
+}
@@ -3,7 +3,10 @@ package dapr
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log"
+	"reflect"
 	"strings"
 	"sync"
 	"time"
@@ -20,6 +23,8 @@ type StateStore struct {
 	metrics      *StateMetrics
 	bulkConfig   *BulkConfig
 	perfConfig   *PerformanceConfig
+	indexes      indexRegistry
+	backend      StateBackend
 }
 
 // StateOptions contains options for state operations
@@ -102,10 +107,11 @@ type TransactionRequest struct {
 
 // TransactionOperation represents a single operation in a transaction
 type TransactionOperation struct {
-	Operation string      `json:"operation"` // "upsert", "delete"
-	Key       string      `json:"key"`
-	Value     interface{} `json:"value,omitempty"`
-	ETag      string      `json:"etag,omitempty"`
+	Operation   string                  `json:"operation"` // "upsert", "delete"
+	Key         string                  `json:"key"`
+	Value       interface{}             `json:"value,omitempty"`
+	ETag        string                  `json:"etag,omitempty"`
+	Concurrency client.StateConcurrency `json:"concurrency,omitempty"`
 }
 
 // ConflictResolutionStrategy defines how to handle conflicts
@@ -118,10 +124,27 @@ const (
 	ConflictResolutionReject     ConflictResolutionStrategy = "reject"
 )
 
-// NewStateStore creates a new state store instance with performance optimizations
+// NewStateStore creates a new state store instance with performance optimizations.
+// The backend follows STATE_STORE_BACKEND; see NewStateBackend.
 func NewStateStore(client *Client) *StateStore {
 	storeName := getEnv("DAPR_STATE_STORE_NAME", "statestore")
-	
+
+	backend, err := NewStateBackend(client, storeName)
+	if err != nil {
+		log.Printf("Warning: state store %s falling back to memory: %v", storeName, err)
+		backend = NewMemoryStateBackend()
+	}
+
+	return newStateStore(client, storeName, backend)
+}
+
+// NewStateStoreWithBackend creates a state store over an explicit backend, such as
+// a MemoryStateBackend in integration tests
+func NewStateStoreWithBackend(backend StateBackend) *StateStore {
+	return newStateStore(nil, getEnv("DAPR_STATE_STORE_NAME", "statestore"), backend)
+}
+
+func newStateStore(client *Client, storeName string, backend StateBackend) *StateStore {
 	// Initialize performance configuration
 	perfConfig := &PerformanceConfig{
 		CacheEnabled:           getEnv("STATE_STORE_CACHE_ENABLED", "true") == "true",
@@ -173,6 +196,25 @@ func NewStateStore(client *Client) *StateStore {
 		metrics:    metrics,
 		bulkConfig: bulkConfig,
 		perfConfig: perfConfig,
+		backend:    backend,
+	}
+}
+
+// Backend returns the storage the state store reads and writes
+func (s *StateStore) Backend() StateBackend {
+	return s.backend
+}
+
+// backendError classifies a backend failure. Cancellation and ETag conflicts keep
+// their meaning, a missed deadline is a timeout and anything else is a dependency error.
+func (s *StateStore) backendError(ctx context.Context, err error, operation, key string) error {
+	switch {
+	case domain.IsConflictError(err), errors.Is(err, context.Canceled):
+		return err
+	case errors.Is(err, context.DeadlineExceeded) || ctx.Err() == context.DeadlineExceeded:
+		return domain.NewTimeoutError(fmt.Sprintf("state store %s operation for key %s", operation, key))
+	default:
+		return domain.NewDependencyError("state store", domain.WrapError(err, fmt.Sprintf("failed to %s state for key %s", operation, key)))
 	}
 }
 
@@ -191,32 +233,8 @@ func (s *StateStore) Save(ctx context.Context, key string, value interface{}, op
 		return domain.WrapError(err, fmt.Sprintf("failed to marshal value for state store key %s", key))
 	}
 
-	// In test mode, mock successful save
-	if s.client.GetClient() == nil {
-		// Check if context is cancelled even in test mode
-		if timeoutCtx.Err() == context.Canceled {
-			return timeoutCtx.Err()
-		}
-		if timeoutCtx.Err() == context.DeadlineExceeded {
-			return domain.NewTimeoutError(fmt.Sprintf("state store save operation for key %s", key))
-		}
-		return nil
-	}
-
-	var metadata map[string]string
-	if options != nil {
-		metadata = map[string]string{
-			"consistency": fmt.Sprintf("%d", options.Consistency),
-			"concurrency": fmt.Sprintf("%d", options.Concurrency),
-		}
-	}
-
-	err = s.client.GetClient().SaveState(timeoutCtx, s.storeName, key, data, metadata)
-	if err != nil {
-		if timeoutCtx.Err() == context.DeadlineExceeded {
-			return domain.NewTimeoutError(fmt.Sprintf("state store save operation for key %s", key))
-		}
-		return domain.NewDependencyError("state store", domain.WrapError(err, fmt.Sprintf("failed to save state for key %s", key)))
+	if err := s.backend.Set(timeoutCtx, key, data, options); err != nil {
+		return s.backendError(timeoutCtx, err, "save", key)
 	}
 
 	return nil
@@ -224,48 +242,67 @@ func (s *StateStore) Save(ctx context.Context, key string, value interface{}, op
 
 // Get retrieves an entity from the state store
 func (s *StateStore) Get(ctx context.Context, key string, target interface{}) (bool, error) {
-	if key == "" {
-		return false, fmt.Errorf("state key cannot be empty")
-	}
+	found, _, err := s.GetWithETag(ctx, key, target)
+	return found, err
+}
 
-	// In test mode, return mock data
-	if s.client.GetClient() == nil {
-		// Add operation-specific timeout for test mode consistency
-		timeoutCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
-		defer cancel()
-		
-		// Check if context is cancelled even in test mode
-		if timeoutCtx.Err() == context.Canceled {
-			return false, timeoutCtx.Err()
-		}
-		if timeoutCtx.Err() == context.DeadlineExceeded {
-			return false, domain.NewTimeoutError(fmt.Sprintf("state store get operation for key %s", key))
-		}
-		return s.getMockState(key, target)
+// GetWithETag retrieves an entity along with the ETag the state store holds for
+// it. The ETag is empty when the key does not exist.
+func (s *StateStore) GetWithETag(ctx context.Context, key string, target interface{}) (bool, string, error) {
+	if key == "" {
+		return false, "", fmt.Errorf("state key cannot be empty")
 	}
 
 	// Add operation-specific timeout
 	timeoutCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	result, err := s.client.GetClient().GetState(timeoutCtx, s.storeName, key, nil)
+	item, err := s.backend.Get(timeoutCtx, key)
 	if err != nil {
-		if timeoutCtx.Err() == context.DeadlineExceeded {
-			return false, domain.NewTimeoutError(fmt.Sprintf("state store get operation for key %s", key))
-		}
-		return false, domain.NewDependencyError("state store", domain.WrapError(err, fmt.Sprintf("failed to get state for key %s", key)))
+		return false, "", s.backendError(timeoutCtx, err, "get", key)
+	}
+
+	if item == nil || len(item.Value) == 0 {
+		return false, "", nil
 	}
 
-	if result.Value == nil || len(result.Value) == 0 {
-		return false, nil
+	if err := json.Unmarshal(item.Value, target); err != nil {
+		return false, "", domain.WrapError(err, fmt.Sprintf("failed to unmarshal state for key %s", key))
+	}
+
+	return true, item.ETag, nil
+}
+
+// GetETag returns the ETag the state store currently holds for key, or an empty
+// ETag when the key does not exist
+func (s *StateStore) GetETag(ctx context.Context, key string) (string, error) {
+	var value json.RawMessage
+	_, etag, err := s.GetWithETag(ctx, key, &value)
+	return etag, err
+}
+
+// WrittenETag returns the ETag of key while the stored value is still the one the caller
+// wrote. Once another writer has replaced it the ETag is empty, because handing out the
+// other writer's version would let the caller's next conditional write overwrite a
+// change it never saw.
+func (s *StateStore) WrittenETag(ctx context.Context, key string, written interface{}) (string, error) {
+	var stored json.RawMessage
+	found, etag, err := s.GetWithETag(ctx, key, &stored)
+	if err != nil || !found {
+		return "", err
 	}
 
-	err = json.Unmarshal(result.Value, target)
+	data, err := json.Marshal(written)
 	if err != nil {
-		return false, domain.WrapError(err, fmt.Sprintf("failed to unmarshal state for key %s", key))
+		return "", domain.WrapError(err, fmt.Sprintf("failed to marshal value for state store key %s", key))
 	}
 
-	return true, nil
+	var storedValue, writtenValue interface{}
+	if json.Unmarshal(stored, &storedValue) != nil || json.Unmarshal(data, &writtenValue) != nil ||
+		!reflect.DeepEqual(storedValue, writtenValue) {
+		return "", nil
+	}
+	return etag, nil
 }
 
 // Delete removes an entity from the state store
@@ -274,19 +311,7 @@ func (s *StateStore) Delete(ctx context.Context, key string, options *StateOptio
 		return fmt.Errorf("state key cannot be empty")
 	}
 
-	// In test mode, mock successful deletion
-	if s.client.GetClient() == nil {
-		return nil
-	}
-
-	var metadata map[string]string
-	if options != nil {
-		metadata = map[string]string{
-			"concurrency": fmt.Sprintf("%d", options.Concurrency),
-		}
-	}
-
-	err := s.client.GetClient().DeleteState(ctx, s.storeName, key, metadata)
+	err := s.backend.Delete(ctx, key, options)
 	if err != nil {
 		return fmt.Errorf("failed to delete state for key %s: %w", key, err)
 	}
@@ -294,39 +319,23 @@ func (s *StateStore) Delete(ctx context.Context, key string, options *StateOptio
 	return nil
 }
 
-// GetBulk retrieves multiple entities from the state store
+// GetBulk retrieves multiple entities from the state store. Keys that do not
+// exist leave their targets untouched.
 func (s *StateStore) GetBulk(ctx context.Context, keys []string, targets map[string]interface{}) error {
 	if len(keys) == 0 {
 		return nil
 	}
 
-	// In test mode, use getMockState for each key
-	if s.client.GetClient() == nil {
-		for _, key := range keys {
-			if target, exists := targets[key]; exists {
-				found, err := s.getMockState(key, target)
-				if err != nil {
-					return fmt.Errorf("failed to get mock state for key %s: %w", key, err)
-				}
-				// If not found, just skip (like real bulk operations do)
-				_ = found
-			}
-		}
-		return nil
-	}
-
-	results, err := s.client.GetClient().GetBulkState(ctx, s.storeName, keys, nil, 100)
+	items, err := s.backend.BulkGet(ctx, keys)
 	if err != nil {
 		return fmt.Errorf("failed to get bulk state: %w", err)
 	}
 
-	for _, result := range results {
-		if result.Value != nil && len(result.Value) > 0 {
-			if target, exists := targets[result.Key]; exists {
-				err = json.Unmarshal(result.Value, target)
-				if err != nil {
-					return fmt.Errorf("failed to unmarshal state for key %s: %w", result.Key, err)
-				}
+	for _, item := range items {
+		if target, exists := targets[item.Key]; exists {
+			err = json.Unmarshal(item.Value, target)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal state for key %s: %w", item.Key, err)
 			}
 		}
 	}
@@ -340,19 +349,7 @@ func (s *StateStore) SaveBulk(ctx context.Context, items map[string]interface{},
 		return nil
 	}
 
-	// In test mode, use individual Save calls
-	if s.client.GetClient() == nil {
-		for key, value := range items {
-			err := s.Save(ctx, key, value, options)
-			if err != nil {
-				return fmt.Errorf("failed to save bulk item %s: %w", key, err)
-			}
-		}
-		return nil
-	}
-
-	// Production Dapr SDK integration for bulk operations
-	var stateItems []*client.SetStateItem
+	stateItems := make([]*StateItem, 0, len(items))
 	for key, value := range items {
 		if key == "" {
 			return fmt.Errorf("state key cannot be empty")
@@ -363,22 +360,10 @@ func (s *StateStore) SaveBulk(ctx context.Context, items map[string]interface{},
 			return fmt.Errorf("failed to marshal value for key %s: %w", key, err)
 		}
 
-		stateItem := &client.SetStateItem{
-			Key:   key,
-			Value: data,
-		}
-
-		if options != nil {
-			stateItem.Options = &client.StateOptions{
-				Consistency: options.Consistency,
-				Concurrency: options.Concurrency,
-			}
-		}
-
-		stateItems = append(stateItems, stateItem)
+		stateItems = append(stateItems, &StateItem{Key: key, Value: data})
 	}
 
-	err := s.client.GetClient().SaveBulkState(ctx, s.storeName, stateItems...)
+	err := s.backend.BulkSet(ctx, stateItems, options)
 	if err != nil {
 		return fmt.Errorf("failed to save bulk state to store %s: %w", s.storeName, err)
 	}
@@ -388,56 +373,52 @@ func (s *StateStore) SaveBulk(ctx context.Context, items map[string]interface{},
 
 // Query executes a query against the state store
 func (s *StateStore) Query(ctx context.Context, query string) ([]client.BulkStateItem, error) {
-	// In test mode, return empty results
-	if s.client.GetClient() == nil {
-		return []client.BulkStateItem{}, nil
-	}
-
-	// For production, empty query is not allowed
 	if query == "" {
 		return nil, fmt.Errorf("query cannot be empty")
 	}
 
-	// Production Dapr SDK integration for state queries
-	resp, err := s.client.GetClient().QueryStateAlpha1(ctx, s.storeName, query, nil)
+	items, err := s.backend.Query(ctx, query)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query state store %s: %w", s.storeName, err)
 	}
 
-	var results []client.BulkStateItem
-	for _, item := range resp.Results {
+	results := make([]client.BulkStateItem, 0, len(items))
+	for _, item := range items {
 		results = append(results, client.BulkStateItem{
 			Key:   item.Key,
 			Value: item.Value,
-			Etag:  item.Etag,
+			Etag:  item.ETag,
 		})
 	}
 
 	return results, nil
 }
 
-// Transaction executes multiple state operations as a transaction  
+// Transaction executes multiple Dapr SDK state operations as a transaction
 func (s *StateStore) Transaction(ctx context.Context, operations []interface{}) error {
-	// In test mode, transactions are not supported
-	if s.client.GetClient() == nil {
-		return fmt.Errorf("transactions not implemented")
-	}
-
 	if len(operations) == 0 {
 		return nil
 	}
 
-	// Production Dapr SDK integration for state transactions
-	var transactionOps []*client.StateOperation
+	writes := make([]StateWrite, 0, len(operations))
 	for _, op := range operations {
 		stateOp, ok := op.(*client.StateOperation)
-		if !ok {
+		if !ok || stateOp.Item == nil {
 			return fmt.Errorf("invalid transaction operation type")
 		}
-		transactionOps = append(transactionOps, stateOp)
+
+		write := StateWrite{Operation: stateWriteDelete, Key: stateOp.Item.Key}
+		if stateOp.Type == client.StateOperationTypeUpsert {
+			write.Operation = stateWriteUpsert
+			write.Value = stateOp.Item.Value
+		}
+		if stateOp.Item.Etag != nil {
+			write.ETag = stateOp.Item.Etag.Value
+		}
+		writes = append(writes, write)
 	}
 
-	err := s.client.GetClient().ExecuteStateTransaction(ctx, s.storeName, nil, transactionOps)
+	err := s.backend.Transact(ctx, writes, nil)
 	if err != nil {
 		return fmt.Errorf("failed to execute state transaction on store %s: %w", s.storeName, err)
 	}
@@ -836,35 +817,6 @@ func parseInt(str string) int {
 	return val
 }
 
-// getMockState returns mock state data for testing
-func (s *StateStore) getMockState(key string, target interface{}) (bool, error) {
-	// Define mock data for known test keys
-	mockData := map[string]string{
-		"test:user:123":        `{"id":"123","name":"Mock User","email":"mock@test.com"}`,
-		"test:order:456":       `{"id":"456","total":99.99,"status":"pending"}`,
-		"test:product:789":     `{"id":"789","name":"Mock Product","price":29.99}`,
-		"idx:test:user:email:mock@test.com": `{"userId":"123","email":"mock@test.com"}`,
-		"session:abc123":       `{"sessionId":"abc123","userId":"123","expires":"2024-12-31T23:59:59Z"}`,
-		"cache:test-key":       `{"value":"mock-cached-value","timestamp":"2024-01-01T00:00:00Z"}`,
-		"test:simple":          `"simple-string-value"`,
-		"test:number":          `42`,
-		"test:boolean":         `true`,
-		"test:array":           `["item1","item2","item3"]`,
-	}
-
-	// Check if we have mock data for this key
-	if mockJSON, exists := mockData[key]; exists {
-		err := json.Unmarshal([]byte(mockJSON), target)
-		if err != nil {
-			return false, fmt.Errorf("failed to unmarshal mock state for key %s: %w", key, err)
-		}
-		return true, nil
-	}
-
-	// For unknown keys, return not found
-	return false, nil
-}
-
 // CreateKey creates a standardized key for the given domain and entity
 func (s *StateStore) CreateKey(domain, entityType, id string) string {
 	return fmt.Sprintf("%s:%s:%s", domain, entityType, id)
@@ -881,52 +833,34 @@ func (s *StateStore) ExecuteTransaction(ctx context.Context, request *Transactio
 		return nil
 	}
 
-	// In test mode, simulate transaction execution
-	if s.client.GetClient() == nil {
-		return s.simulateTransaction(ctx, request)
-	}
-
 	// Add operation-specific timeout for transactions
 	timeoutCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
-	// Convert our transaction operations to Dapr state operations
-	var daprOps []*client.StateOperation
+	writes := make([]StateWrite, 0, len(request.Operations))
 	for _, op := range request.Operations {
+		write := StateWrite{Operation: op.Operation, Key: op.Key, ETag: op.ETag, Concurrency: op.Concurrency}
 		switch op.Operation {
-		case "upsert":
+		case stateWriteUpsert:
 			data, err := json.Marshal(op.Value)
 			if err != nil {
 				return domain.WrapError(err, fmt.Sprintf("failed to marshal value for transaction key %s", op.Key))
 			}
-			
-			stateOp := &client.StateOperation{
-				Type: client.StateOperationTypeUpsert,
-				Item: &client.SetStateItem{
-					Key:   op.Key,
-					Value: data,
-					Etag:  &client.ETag{Value: op.ETag},
-				},
-			}
-			daprOps = append(daprOps, stateOp)
-			
-		case "delete":
-			stateOp := &client.StateOperation{
-				Type: client.StateOperationTypeDelete,
-				Item: &client.SetStateItem{
-					Key:  op.Key,
-					Etag: &client.ETag{Value: op.ETag},
-				},
-			}
-			daprOps = append(daprOps, stateOp)
-			
+			write.Value = data
+
+		case stateWriteDelete:
+
 		default:
 			return fmt.Errorf("unsupported transaction operation: %s", op.Operation)
 		}
+		writes = append(writes, write)
 	}
 
-	err := s.client.GetClient().ExecuteStateTransaction(timeoutCtx, s.storeName, request.Metadata, daprOps)
+	err := s.backend.Transact(timeoutCtx, writes, request.Metadata)
 	if err != nil {
+		if domain.IsConflictError(err) {
+			return err
+		}
 		if timeoutCtx.Err() == context.DeadlineExceeded {
 			return domain.NewTimeoutError("state store transaction execution")
 		}
@@ -1091,45 +1025,17 @@ func (s *StateStore) saveRejectConflicts(ctx context.Context, key string, value
 }
 
 func (s *StateStore) isConcurrencyConflict(err error) bool {
-	if err == nil {
-		return false
-	}
-	
-	// Check for Dapr concurrency conflict indicators
-	errStr := strings.ToLower(err.Error())
-	return strings.Contains(errStr, "etag") || 
-		   strings.Contains(errStr, "concurrency") || 
-		   strings.Contains(errStr, "conflict") ||
-		   strings.Contains(errStr, "version")
-}
-
-func (s *StateStore) simulateTransaction(ctx context.Context, request *TransactionRequest) error {
-	// Simulate transaction delays and potential failures for testing
-	select {
-	case <-ctx.Done():
-		return ctx.Err()
-	case <-time.After(10 * time.Millisecond):
-		// Simulate processing time
-	}
-	
-	// Execute each operation individually in test mode
-	for _, op := range request.Operations {
-		switch op.Operation {
-		case "upsert":
-			err := s.Save(ctx, op.Key, op.Value, nil)
-			if err != nil {
-				return fmt.Errorf("transaction simulation failed on upsert for key %s: %w", op.Key, err)
-			}
-		case "delete":
-			err := s.Delete(ctx, op.Key, nil)
-			if err != nil {
-				return fmt.Errorf("transaction simulation failed on delete for key %s: %w", op.Key, err)
-			}
-		default:
-			return fmt.Errorf("unsupported transaction operation in simulation: %s", op.Operation)
+	// Check for Dapr concurrency conflict indicators anywhere in the error chain,
+	// since dependency errors wrap the underlying Dapr error without repeating it
+	for ; err != nil; err = errors.Unwrap(err) {
+		errStr := strings.ToLower(err.Error())
+		if strings.Contains(errStr, "etag") ||
+			strings.Contains(errStr, "concurrency") ||
+			strings.Contains(errStr, "conflict") ||
+			strings.Contains(errStr, "version") {
+			return true
 		}
 	}
-	
-	return nil
+	return false
 }
 
@@ -0,0 +1,56 @@
+package dapr
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateStore_WrittenETag(t *testing.T) {
+	type article struct {
+		Title string `json:"title"`
+		Body  string `json:"body"`
+	}
+
+	tests := []struct {
+		name          string
+		written       *article
+		overwrittenBy *article
+		expectedETag  bool
+	}{
+		{name: "value is still the one written", written: &article{Title: "Draft", Body: "Text"}, expectedETag: true},
+		{name: "another writer replaced the value", written: &article{Title: "Draft", Body: "Text"}, overwrittenBy: &article{Title: "Other", Body: "Text"}},
+		{name: "value was never written"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+			stateStore := NewStateStoreWithBackend(NewMemoryStateBackend())
+			key := stateStore.CreateKey("news", "news", "news-1")
+			if tt.written != nil {
+				require.NoError(t, stateStore.Save(ctx, key, tt.written, nil))
+			}
+			if tt.overwrittenBy != nil {
+				require.NoError(t, stateStore.Save(ctx, key, tt.overwrittenBy, nil))
+			}
+			current, err := stateStore.GetETag(ctx, key)
+			require.NoError(t, err)
+
+			// Act
+			etag, err := stateStore.WrittenETag(ctx, key, tt.written)
+
+			// Assert
+			require.NoError(t, err)
+			if tt.expectedETag {
+				assert.NotEmpty(t, etag)
+				assert.Equal(t, current, etag)
+			} else {
+				assert.Empty(t, etag)
+			}
+		})
+	}
+}
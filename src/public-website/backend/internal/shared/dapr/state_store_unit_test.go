@@ -626,8 +626,9 @@ func TestStateStore_Query(t *testing.T) {
 				return sharedtesting.CreateUnitTestContext()
 			},
 			validateResult: func(t *testing.T, items []client.BulkStateItem) {
-				// Query results may vary based on implementation
-				assert.IsType(t, []client.BulkStateItem{}, items)
+				require.Len(t, items, 2)
+				assert.Equal(t, "query-key-1", items[0].Key)
+				assert.Equal(t, "query-key-3", items[1].Key)
 			},
 		},
 		{
@@ -652,6 +653,7 @@ func TestStateStore_Query(t *testing.T) {
 			setupContext: func() (context.Context, context.CancelFunc) {
 				return sharedtesting.CreateUnitTestContext()
 			},
+			expectedError: "query cannot be empty",
 		},
 	}
 
@@ -712,7 +714,7 @@ func TestStateStore_Transaction(t *testing.T) {
 			setupContext: func() (context.Context, context.CancelFunc) {
 				return sharedtesting.CreateUnitTestContext()
 			},
-			expectedError: "transactions not implemented", // Expected in simplified mode
+			expectedError: "invalid transaction operation type",
 		},
 		{
 			name:       "transaction with empty operations",
@@ -720,7 +722,6 @@ func TestStateStore_Transaction(t *testing.T) {
 			setupContext: func() (context.Context, context.CancelFunc) {
 				return sharedtesting.CreateUnitTestContext()
 			},
-			expectedError: "transactions not implemented",
 		},
 	}
 
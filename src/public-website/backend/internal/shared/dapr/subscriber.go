@@ -0,0 +1,214 @@
+package dapr
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/gorilla/mux"
+)
+
+// Delivery statuses understood by the Dapr sidecar
+const (
+	subscriptionStatusSuccess = "SUCCESS"
+	subscriptionStatusRetry   = "RETRY"
+	subscriptionStatusDrop    = "DROP"
+)
+
+// Subscription is a programmatic topic subscription advertised to the Dapr sidecar
+type Subscription struct {
+	PubsubName string `json:"pubsubname"`
+	Topic      string `json:"topic"`
+	Route      string `json:"route"`
+}
+
+// TopicEvent is the CloudEvents envelope Dapr delivers to a subscription route
+type TopicEvent struct {
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	Topic           string          `json:"topic"`
+	PubsubName      string          `json:"pubsubname"`
+	DataContentType string          `json:"datacontenttype"`
+	Data            json.RawMessage `json:"data"`
+}
+
+// EventMessage decodes the event payload as an EventMessage published through PubSub
+func (e *TopicEvent) EventMessage() (*EventMessage, error) {
+	var message EventMessage
+	if err := json.Unmarshal(e.Data, &message); err != nil {
+		return nil, domain.NewValidationError("event payload is not a valid event message")
+	}
+	return &message, nil
+}
+
+// CrossServiceEvent decodes a message published through PublishCrossServiceEvent. It
+// reports false for messages published any other way.
+func (m *EventMessage) CrossServiceEvent() (*CrossServiceEvent, bool) {
+	field := func(key string) string {
+		value, _ := m.Data[key].(string)
+		return value
+	}
+	if field("event_type") == "" || m.SchemaVersion == "" {
+		return nil, false
+	}
+
+	payload, _ := m.Data["payload"].(map[string]interface{})
+	return &CrossServiceEvent{
+		EventID:       field("event_id"),
+		EventType:     field("event_type"),
+		SourceService: field("source_service"),
+		TargetService: field("target_service"),
+		EntityType:    field("entity_type"),
+		EntityID:      field("entity_id"),
+		OperationType: field("operation_type"),
+		Payload:       payload,
+		CorrelationID: m.CorrelationID,
+		UserID:        field("user_id"),
+		SchemaVersion: m.SchemaVersion,
+		Priority:      field("priority"),
+	}, true
+}
+
+// TopicEventHandler processes one delivered event. Returning an error asks the
+// sidecar to redeliver, except for validation errors, which redelivery cannot fix.
+type TopicEventHandler func(ctx context.Context, event *TopicEvent) error
+
+// appAPITokenHeader carries the token the sidecar authenticates itself to the app with
+const appAPITokenHeader = "dapr-api-token"
+
+// Subscriber collects the topic subscriptions of an application and serves them
+// over HTTP: the subscription list on /dapr/subscribe and one route per topic.
+// When the sidecar is given an app API token, only requests presenting it are served.
+type Subscriber struct {
+	pubsubName    string
+	appAPIToken   string
+	subscriptions []Subscription
+	handlers      map[string]TopicEventHandler
+	deduplicator  *EventDeduplicator
+	schemas       *EventSchemaRegistry
+}
+
+// NewSubscriber creates a subscriber for the pub/sub component used by NewPubSub
+func NewSubscriber() *Subscriber {
+	return &Subscriber{
+		pubsubName:  getEnv("DAPR_PUBSUB_NAME", "pubsub"),
+		appAPIToken: getEnv("APP_API_TOKEN", ""),
+		handlers:    make(map[string]TopicEventHandler),
+	}
+}
+
+// Subscribe registers a handler for events published to topic, delivered to route
+func (s *Subscriber) Subscribe(topic, route string, handler TopicEventHandler) {
+	s.subscriptions = append(s.subscriptions, Subscription{
+		PubsubName: s.pubsubName,
+		Topic:      topic,
+		Route:      route,
+	})
+	s.handlers[route] = handler
+}
+
+// UseDeduplication makes every route process a given event at most once, however
+// often the sidecar delivers it. Each route counts as a separate consumer.
+func (s *Subscriber) UseDeduplication(deduplicator *EventDeduplicator) {
+	s.deduplicator = deduplicator
+}
+
+// UseSchemaValidation drops cross-service events whose payload does not match the
+// schema version they declare before any route sees them. Other events pass unchecked.
+func (s *Subscriber) UseSchemaValidation(schemas *EventSchemaRegistry) {
+	s.schemas = schemas
+}
+
+// RequiresAppAPIToken reports whether deliveries are checked for the app API token,
+// which is the case when APP_API_TOKEN is set for the app and its sidecar
+func (s *Subscriber) RequiresAppAPIToken() bool {
+	return s.appAPIToken != ""
+}
+
+// Subscriptions returns the subscriptions advertised to the sidecar
+func (s *Subscriber) Subscriptions() []Subscription {
+	return append([]Subscription(nil), s.subscriptions...)
+}
+
+// RegisterRoutes registers the subscription discovery and delivery routes
+func (s *Subscriber) RegisterRoutes(router *mux.Router) {
+	router.HandleFunc("/dapr/subscribe", s.fromSidecar(s.serveSubscriptions)).Methods("GET")
+	for _, subscription := range s.subscriptions {
+		handler := s.handlers[subscription.Route]
+		if s.deduplicator != nil {
+			handler = s.deduplicator.Wrap(subscription.Route, handler)
+		}
+		if s.schemas != nil {
+			handler = validateConsumedSchema(s.schemas, handler)
+		}
+		router.HandleFunc(subscription.Route, s.fromSidecar(s.serveEvent(handler))).Methods("POST")
+	}
+}
+
+// fromSidecar rejects requests without the app API token, so nobody but the sidecar
+// can deliver events or read the subscriptions
+func (s *Subscriber) fromSidecar(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if s.appAPIToken != "" &&
+			subtle.ConstantTimeCompare([]byte(r.Header.Get(appAPITokenHeader)), []byte(s.appAPIToken)) != 1 {
+			log.Printf("Rejecting request to %s without the app API token", r.URL.Path)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		next(w, r)
+	}
+}
+
+func (s *Subscriber) serveSubscriptions(w http.ResponseWriter, r *http.Request) {
+	writeSubscriptionResponse(w, s.Subscriptions())
+}
+
+func (s *Subscriber) serveEvent(handler TopicEventHandler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var event TopicEvent
+		if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+			log.Printf("Dropping undecodable event delivered to %s: %v", r.URL.Path, err)
+			writeSubscriptionResponse(w, map[string]string{"status": subscriptionStatusDrop})
+			return
+		}
+
+		ctx := r.Context()
+		if err := handler(ctx, &event); err != nil {
+			status := subscriptionStatusRetry
+			if domain.IsValidationError(err) {
+				status = subscriptionStatusDrop
+			}
+			log.Printf("Event %s on topic %s not processed (%s): %v", event.ID, event.Topic, status, err)
+			writeSubscriptionResponse(w, map[string]string{"status": status})
+			return
+		}
+
+		writeSubscriptionResponse(w, map[string]string{"status": subscriptionStatusSuccess})
+	}
+}
+
+// validateConsumedSchema checks cross-service events against the registry ahead of
+// handler, so an invalid event is dropped without claiming it for deduplication
+func validateConsumedSchema(schemas *EventSchemaRegistry, handler TopicEventHandler) TopicEventHandler {
+	return func(ctx context.Context, event *TopicEvent) error {
+		if message, err := event.EventMessage(); err == nil {
+			if crossServiceEvent, ok := message.CrossServiceEvent(); ok {
+				if err := schemas.ValidateConsumed(crossServiceEvent); err != nil {
+					return err
+				}
+			}
+		}
+		return handler(ctx, event)
+	}
+}
+
+func writeSubscriptionResponse(w http.ResponseWriter, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,231 @@
+package dapr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscriber_Subscriptions(t *testing.T) {
+	// Arrange
+	t.Setenv("DAPR_PUBSUB_NAME", "custom-pubsub")
+	subscriber := NewSubscriber()
+	subscriber.Subscribe("audit-events-dev", "/events/audit", func(ctx context.Context, event *TopicEvent) error { return nil })
+
+	router := mux.NewRouter()
+	subscriber.RegisterRoutes(router)
+
+	// Act
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/dapr/subscribe", nil))
+
+	// Assert
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var subscriptions []Subscription
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &subscriptions))
+	assert.Equal(t, []Subscription{{PubsubName: "custom-pubsub", Topic: "audit-events-dev", Route: "/events/audit"}}, subscriptions)
+}
+
+func TestSubscriber_DeliverEvent(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		handlerErr error
+		wantStatus string
+		wantCalled bool
+	}{
+		{
+			name:       "acknowledge processed event",
+			body:       `{"id":"event-1","topic":"audit-events-dev","data":{"data":{"entity_id":"news-1"}}}`,
+			wantStatus: subscriptionStatusSuccess,
+			wantCalled: true,
+		},
+		{
+			name:       "retry after transient failure",
+			body:       `{"id":"event-1","topic":"audit-events-dev","data":{}}`,
+			handlerErr: errors.New("state store unavailable"),
+			wantStatus: subscriptionStatusRetry,
+			wantCalled: true,
+		},
+		{
+			name:       "drop invalid event",
+			body:       `{"id":"event-1","topic":"audit-events-dev","data":{}}`,
+			handlerErr: domain.NewValidationError("audit event has no entity ID"),
+			wantStatus: subscriptionStatusDrop,
+			wantCalled: true,
+		},
+		{
+			name:       "drop undecodable body",
+			body:       `not json`,
+			wantStatus: subscriptionStatusDrop,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			called := false
+			subscriber := NewSubscriber()
+			subscriber.Subscribe("audit-events-dev", "/events/audit", func(ctx context.Context, event *TopicEvent) error {
+				called = true
+				message, err := event.EventMessage()
+				require.NoError(t, err)
+				assert.NotNil(t, message)
+				return tt.handlerErr
+			})
+
+			router := mux.NewRouter()
+			subscriber.RegisterRoutes(router)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/events/audit", strings.NewReader(tt.body)))
+
+			// Assert
+			require.Equal(t, http.StatusOK, recorder.Code)
+			var response map[string]string
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+			assert.Equal(t, tt.wantStatus, response["status"])
+			assert.Equal(t, tt.wantCalled, called)
+		})
+	}
+}
+
+func TestSubscriber_AppAPIToken(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		path         string
+		token        string
+		expectedCode int
+		wantCalled   bool
+	}{
+		{name: "delivery with the token", method: http.MethodPost, path: "/events/audit", token: "sidecar-secret", expectedCode: http.StatusOK, wantCalled: true},
+		{name: "delivery without a token", method: http.MethodPost, path: "/events/audit", expectedCode: http.StatusUnauthorized},
+		{name: "delivery with another token", method: http.MethodPost, path: "/events/audit", token: "guess", expectedCode: http.StatusUnauthorized},
+		{name: "subscription list without a token", method: http.MethodGet, path: "/dapr/subscribe", expectedCode: http.StatusUnauthorized},
+		{name: "subscription list with the token", method: http.MethodGet, path: "/dapr/subscribe", token: "sidecar-secret", expectedCode: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			t.Setenv("APP_API_TOKEN", "sidecar-secret")
+			called := false
+			subscriber := NewSubscriber()
+			subscriber.Subscribe("audit-events-dev", "/events/audit", func(ctx context.Context, event *TopicEvent) error {
+				called = true
+				return nil
+			})
+
+			router := mux.NewRouter()
+			subscriber.RegisterRoutes(router)
+
+			request := httptest.NewRequest(tt.method, tt.path, strings.NewReader(`{"id":"event-1","topic":"audit-events-dev","data":{}}`))
+			if tt.token != "" {
+				request.Header.Set("dapr-api-token", tt.token)
+			}
+
+			// Act
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, request)
+
+			// Assert
+			assert.True(t, subscriber.RequiresAppAPIToken())
+			assert.Equal(t, tt.expectedCode, recorder.Code)
+			assert.Equal(t, tt.wantCalled, called)
+		})
+	}
+}
+
+func TestSubscriber_UseDeduplication(t *testing.T) {
+	// Arrange
+	calls := 0
+	subscriber := NewSubscriber()
+	subscriber.UseDeduplication(newTestDeduplicator())
+	subscriber.Subscribe("audit-events-dev", "/events/audit", func(ctx context.Context, event *TopicEvent) error {
+		calls++
+		return nil
+	})
+
+	router := mux.NewRouter()
+	subscriber.RegisterRoutes(router)
+
+	// Act
+	var statuses []string
+	for _, cloudEventID := range []string{"delivery-1", "delivery-2"} {
+		body := `{"id":"` + cloudEventID + `","topic":"audit-events-dev","data":{"id":"message-1","type":"audit.event"}}`
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/events/audit", strings.NewReader(body)))
+		var response map[string]string
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+		statuses = append(statuses, response["status"])
+	}
+
+	// Assert
+	assert.Equal(t, []string{subscriptionStatusSuccess, subscriptionStatusSuccess}, statuses)
+	assert.Equal(t, 1, calls, "a republished event is acknowledged without running the handler again")
+}
+
+func TestSubscriber_UseSchemaValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       string
+		wantStatus string
+		wantCalled bool
+	}{
+		{
+			name:       "deliver event matching its schema",
+			data:       `{"schema_version":"1.0","data":{"event_type":"content.review_requested","payload":{"content_id":"news-1","recipients":["user-1"]}}}`,
+			wantStatus: subscriptionStatusSuccess,
+			wantCalled: true,
+		},
+		{
+			name:       "drop event violating its schema",
+			data:       `{"schema_version":"1.0","data":{"event_type":"content.review_requested","payload":{"content_id":"news-1"}}}`,
+			wantStatus: subscriptionStatusDrop,
+		},
+		{
+			name:       "pass events that are not cross-service events",
+			data:       `{"type":"audit.event","data":{"entity_id":"news-1"}}`,
+			wantStatus: subscriptionStatusSuccess,
+			wantCalled: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			called := false
+			subscriber := NewSubscriber()
+			subscriber.UseSchemaValidation(loadTestEventSchemas(t, map[string]string{"content.review_requested/1.0": testReviewSchema}))
+			subscriber.Subscribe("content-events", "/events/content", func(ctx context.Context, event *TopicEvent) error {
+				called = true
+				return nil
+			})
+
+			router := mux.NewRouter()
+			subscriber.RegisterRoutes(router)
+			body := `{"id":"event-1","topic":"content-events","data":` + tt.data + `}`
+
+			// Act
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/events/content", strings.NewReader(body)))
+
+			// Assert
+			var response map[string]string
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+			assert.Equal(t, tt.wantStatus, response["status"])
+			assert.Equal(t, tt.wantCalled, called)
+		})
+	}
+}
@@ -2,8 +2,8 @@ package dapr
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"log"
 	"sync"
 	"time"
 
@@ -11,13 +11,34 @@ import (
 	"github.com/google/uuid"
 )
 
-// TransactionManager handles distributed transactions and data consistency across services
+const (
+	// defaultSagaLeaseDuration is how long a manager's claim on a saga execution lasts
+	// without being renewed; an execution whose manager crashed is resumed elsewhere
+	// once the claim lapses
+	defaultSagaLeaseDuration = time.Minute
+	// defaultSagaStepTimeout bounds a step that declares no timeout of its own
+	defaultSagaStepTimeout = 30 * time.Second
+	// defaultSagaRecoveryInterval is how often RunRecovery looks for abandoned sagas
+	defaultSagaRecoveryInterval = 30 * time.Second
+)
+
+// TransactionManager handles distributed transactions and data consistency across services.
+// Saga executions are persisted after every step, so a saga interrupted by a restart is
+// resumed or compensated by RecoverSagas rather than lost with the process.
 type TransactionManager struct {
-	stateStore      *StateStore
-	pubsub          *PubSub
-	client          *Client
-	sagaStore       map[string]*SagaExecution
-	sagaMutex       sync.RWMutex
+	stateStore       *StateStore
+	pubsub           *PubSub
+	client           *Client
+	executor         SagaStepExecutor
+	owner            string
+	leaseDuration    time.Duration
+	recoveryInterval time.Duration
+	now              func() time.Time
+	running          map[string]bool
+	runs             sync.WaitGroup
+	sagaMutex        sync.Mutex
+	metrics          *TransactionMetrics
+	metricsMutex     sync.RWMutex
 	consistencyLevel ConsistencyLevel
 }
 
@@ -32,10 +53,10 @@ const (
 
 // TransactionOptions configures transaction behavior
 type TransactionOptions struct {
-	ConsistencyLevel ConsistencyLevel      `json:"consistency_level"`
-	Timeout         time.Duration         `json:"timeout"`
-	RetryPolicy     *RetryPolicy          `json:"retry_policy,omitempty"`
-	CompensationTimeout time.Duration     `json:"compensation_timeout"`
+	ConsistencyLevel    ConsistencyLevel `json:"consistency_level"`
+	Timeout             time.Duration    `json:"timeout"`
+	RetryPolicy         *RetryPolicy     `json:"retry_policy,omitempty"`
+	CompensationTimeout time.Duration    `json:"compensation_timeout"`
 }
 
 // RetryPolicy defines retry behavior for failed operations
@@ -48,39 +69,49 @@ type RetryPolicy struct {
 
 // SagaDefinition defines a saga transaction pattern
 type SagaDefinition struct {
-	SagaID      string                `json:"saga_id"`
-	Name        string                `json:"name"`
-	Steps       []*SagaStep          `json:"steps"`
-	Timeout     time.Duration        `json:"timeout"`
-	CreatedAt   time.Time            `json:"created_at"`
-	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+	SagaID    string                 `json:"saga_id"`
+	Name      string                 `json:"name"`
+	Steps     []*SagaStep            `json:"steps"`
+	Timeout   time.Duration          `json:"timeout"`
+	CreatedAt time.Time              `json:"created_at"`
+	Metadata  map[string]interface{} `json:"metadata,omitempty"`
 }
 
 // SagaStep represents a step in a saga transaction
 type SagaStep struct {
-	StepID          string                 `json:"step_id"`
-	Name            string                 `json:"name"`
-	ServiceName     string                 `json:"service_name"`
-	Operation       string                 `json:"operation"`
-	Data            map[string]interface{} `json:"data"`
-	CompensationOp  string                 `json:"compensation_operation,omitempty"`
+	StepID           string                 `json:"step_id"`
+	Name             string                 `json:"name"`
+	ServiceName      string                 `json:"service_name"`
+	Operation        string                 `json:"operation"`
+	Data             map[string]interface{} `json:"data"`
+	CompensationOp   string                 `json:"compensation_operation,omitempty"`
 	CompensationData map[string]interface{} `json:"compensation_data,omitempty"`
-	Timeout         time.Duration          `json:"timeout"`
-	RetryPolicy     *RetryPolicy           `json:"retry_policy,omitempty"`
+	Timeout          time.Duration          `json:"timeout"`
+	RetryPolicy      *RetryPolicy           `json:"retry_policy,omitempty"`
 }
 
 // SagaExecution tracks the execution state of a saga
 type SagaExecution struct {
-	SagaID          string                 `json:"saga_id"`
-	Definition      *SagaDefinition        `json:"definition"`
-	Status          SagaStatus             `json:"status"`
-	CurrentStep     int                    `json:"current_step"`
-	CompletedSteps  []string               `json:"completed_steps"`
-	FailedStep      *SagaStepResult        `json:"failed_step,omitempty"`
-	StartedAt       time.Time              `json:"started_at"`
-	CompletedAt     *time.Time             `json:"completed_at,omitempty"`
-	ErrorMessage    string                 `json:"error_message,omitempty"`
-	Compensation    *CompensationExecution `json:"compensation,omitempty"`
+	SagaID         string                    `json:"saga_id"`
+	Definition     *SagaDefinition           `json:"definition"`
+	Options        *TransactionOptions       `json:"options,omitempty"`
+	Status         SagaStatus                `json:"status"`
+	CurrentStep    int                       `json:"current_step"`
+	CompletedSteps []string                  `json:"completed_steps"`
+	Steps          map[string]*SagaStepState `json:"steps,omitempty"`
+	FailedStep     *SagaStepResult           `json:"failed_step,omitempty"`
+	StartedAt      time.Time                 `json:"started_at"`
+	UpdatedAt      time.Time                 `json:"updated_at"`
+	CompletedAt    *time.Time                `json:"completed_at,omitempty"`
+	ErrorMessage   string                    `json:"error_message,omitempty"`
+	Compensation   *CompensationExecution    `json:"compensation,omitempty"`
+	// LeaseOwner is the manager currently driving the execution. Its claim lapses at
+	// LeaseExpiresAt unless renewed, after which any manager may resume the saga.
+	LeaseOwner     string     `json:"lease_owner,omitempty"`
+	LeaseExpiresAt *time.Time `json:"lease_expires_at,omitempty"`
+	// Revision counts the saves of the execution, letting a manager tell its own
+	// write apart from a concurrent one
+	Revision int `json:"revision"`
 }
 
 // SagaStatus represents the status of a saga execution
@@ -95,47 +126,118 @@ const (
 	SagaStatusCompensated  SagaStatus = "compensated"
 )
 
+// SagaStepState is the persisted progress of one saga step. Its idempotency keys stay
+// the same however often the step is retried, resumed or compensated.
+type SagaStepState struct {
+	StepID          string     `json:"step_id"`
+	Status          string     `json:"status"`
+	IdempotencyKey  string     `json:"idempotency_key"`
+	CompensationKey string     `json:"compensation_key"`
+	Attempts        int        `json:"attempts"`
+	StartedAt       *time.Time `json:"started_at,omitempty"`
+	Deadline        *time.Time `json:"deadline,omitempty"`
+	CompletedAt     *time.Time `json:"completed_at,omitempty"`
+	CompensatedAt   *time.Time `json:"compensated_at,omitempty"`
+	Error           string     `json:"error,omitempty"`
+}
+
+const (
+	SagaStepPending     = "pending"
+	SagaStepRunning     = "running"
+	SagaStepCompleted   = "completed"
+	SagaStepFailed      = "failed"
+	SagaStepCompensated = "compensated"
+)
+
 // SagaStepResult represents the result of executing a saga step
 type SagaStepResult struct {
-	StepID      string                 `json:"step_id"`
-	Status      string                 `json:"status"`
-	Result      map[string]interface{} `json:"result,omitempty"`
-	Error       string                 `json:"error,omitempty"`
-	ExecutedAt  time.Time              `json:"executed_at"`
-	Duration    time.Duration          `json:"duration"`
+	StepID     string                 `json:"step_id"`
+	Status     string                 `json:"status"`
+	Result     map[string]interface{} `json:"result,omitempty"`
+	Error      string                 `json:"error,omitempty"`
+	ExecutedAt time.Time              `json:"executed_at"`
+	Duration   time.Duration          `json:"duration"`
 }
 
 // CompensationExecution tracks compensation action execution
 type CompensationExecution struct {
-	StartedAt       time.Time            `json:"started_at"`
-	CompletedAt     *time.Time           `json:"completed_at,omitempty"`
-	CompensatedSteps []string            `json:"compensated_steps"`
-	Status          string               `json:"status"`
-	ErrorMessage    string               `json:"error_message,omitempty"`
+	StartedAt        time.Time  `json:"started_at"`
+	CompletedAt      *time.Time `json:"completed_at,omitempty"`
+	CompensatedSteps []string   `json:"compensated_steps"`
+	Status           string     `json:"status"`
+	ErrorMessage     string     `json:"error_message,omitempty"`
+}
+
+// SagaStepExecutor carries out saga steps and their compensations. The idempotency key
+// passed with a step is the same on every retry, after a restart and on a manual retry,
+// so an executor that remembers the keys it has handled applies each step at most once.
+type SagaStepExecutor interface {
+	ExecuteStep(ctx context.Context, step *SagaStep, idempotencyKey string) error
+	CompensateStep(ctx context.Context, step *SagaStep, idempotencyKey string) error
+}
+
+// eventSagaStepExecutor asks each step's service to run it over pub/sub. The idempotency
+// key doubles as the event ID, so consumers can discard redelivered steps.
+type eventSagaStepExecutor struct {
+	pubsub *PubSub
+	appID  string
+}
+
+// sagaRun is an execution claimed by this manager together with the ETag of its last save
+type sagaRun struct {
+	execution *SagaExecution
+	etag      string
 }
 
 // NewTransactionManager creates a new transaction manager
 func NewTransactionManager(client *Client) *TransactionManager {
+	pubsub := NewPubSub(client)
+	executor := &eventSagaStepExecutor{pubsub: pubsub, appID: client.GetAppID()}
+	return newTransactionManager(client, NewStateStore(client), pubsub, executor)
+}
+
+func newTransactionManager(client *Client, stateStore *StateStore, pubsub *PubSub, executor SagaStepExecutor) *TransactionManager {
+	stateStore.MustRegisterIndexes(sagaExecutionIndexes)
+
 	return &TransactionManager{
-		stateStore:       NewStateStore(client),
-		pubsub:           NewPubSub(client),
+		stateStore:       stateStore,
+		pubsub:           pubsub,
 		client:           client,
-		sagaStore:        make(map[string]*SagaExecution),
-		sagaMutex:        sync.RWMutex{},
+		executor:         executor,
+		owner:            fmt.Sprintf("%s-%s", client.GetAppID(), uuid.New().String()),
+		leaseDuration:    parseDurationEnv("SAGA_LEASE_DURATION", defaultSagaLeaseDuration),
+		recoveryInterval: parseDurationEnv("SAGA_RECOVERY_INTERVAL", defaultSagaRecoveryInterval),
+		now:              time.Now,
+		running:          make(map[string]bool),
+		metrics:          &TransactionMetrics{OperationLatencies: make(map[string]int64)},
 		consistencyLevel: ConsistencyEventual,
 	}
 }
 
-// ExecuteDistributedTransaction executes a distributed transaction using the saga pattern
+// UseStepExecutor replaces the executor that carries out saga steps, which by default
+// publishes them to the owning services
+func (tm *TransactionManager) UseStepExecutor(executor SagaStepExecutor) {
+	tm.executor = executor
+}
+
+// ExecuteDistributedTransaction executes a distributed transaction using the saga pattern.
+// It returns once the execution is persisted and runs the steps in the background; should
+// this process stop first, RecoverSagas on any instance carries the saga on.
 func (tm *TransactionManager) ExecuteDistributedTransaction(ctx context.Context, saga *SagaDefinition, options *TransactionOptions) (*SagaExecution, error) {
 	if saga == nil {
 		return nil, fmt.Errorf("saga definition cannot be nil")
 	}
+	if err := validateSagaDefinition(saga); err != nil {
+		return nil, err
+	}
+	if saga.SagaID == "" {
+		saga.SagaID = uuid.New().String()
+	}
 
 	if options == nil {
 		options = &TransactionOptions{
 			ConsistencyLevel:    ConsistencyEventual,
-			Timeout:            30 * time.Second,
+			Timeout:             30 * time.Second,
 			CompensationTimeout: 60 * time.Second,
 		}
 	}
@@ -144,76 +246,172 @@ func (tm *TransactionManager) ExecuteDistributedTransaction(ctx context.Context,
 	execution := &SagaExecution{
 		SagaID:         saga.SagaID,
 		Definition:     saga,
+		Options:        options,
 		Status:         SagaStatusPending,
 		CurrentStep:    0,
 		CompletedSteps: []string{},
-		StartedAt:      time.Now(),
+		Steps:          make(map[string]*SagaStepState, len(saga.Steps)),
+		StartedAt:      tm.now(),
+	}
+	for _, step := range saga.Steps {
+		execution.Steps[step.StepID] = &SagaStepState{
+			StepID:          step.StepID,
+			Status:          SagaStepPending,
+			IdempotencyKey:  fmt.Sprintf("%s:%s:execute", saga.SagaID, step.StepID),
+			CompensationKey: fmt.Sprintf("%s:%s:compensate", saga.SagaID, step.StepID),
+		}
+	}
+
+	if !tm.markRunning(execution.SagaID) {
+		return nil, domain.NewConflictError(fmt.Sprintf("saga execution %s is already running", execution.SagaID))
 	}
 
 	// Store saga execution state
-	if err := tm.storeSagaExecution(ctx, execution); err != nil {
+	run, err := tm.createSagaExecution(ctx, execution)
+	if err != nil {
+		tm.markStopped(execution.SagaID)
 		return nil, fmt.Errorf("failed to store saga execution: %w", err)
 	}
+	tm.recordSagaStarted()
 
-	// Execute saga asynchronously
-	go tm.executeSagaAsync(context.Background(), execution, options)
+	// Execute saga asynchronously; the run works on its own copy of the execution
+	go tm.runSaga(context.Background(), run)
 
 	return execution, nil
 }
 
-// executeSagaAsync executes the saga steps asynchronously
-func (tm *TransactionManager) executeSagaAsync(ctx context.Context, execution *SagaExecution, options *TransactionOptions) {
-	execution.Status = SagaStatusExecuting
-	tm.updateSagaExecution(ctx, execution)
+// runSaga drives a claimed execution until it settles. A conflict while saving means
+// another manager or an operator changed the execution, so the run stops and tries to
+// claim it afresh; any other failure leaves the saga to the recovery loop.
+func (tm *TransactionManager) runSaga(ctx context.Context, run *sagaRun) {
+	sagaID := run.execution.SagaID
+	err := tm.driveSaga(ctx, run)
+	tm.markStopped(sagaID)
+
+	switch {
+	case err == nil:
+	case domain.IsConflictError(err):
+		tm.recordTransactionConflict()
+		log.Printf("Saga %s changed under its run, claiming it again: %v", sagaID, err)
+		tm.resumeSaga(ctx, sagaID)
+	default:
+		log.Printf("Saga %s stopped before settling and is left to recovery: %v", sagaID, err)
+	}
+}
 
-	// Execute each step
-	for i, step := range execution.Definition.Steps {
-		execution.CurrentStep = i
+// driveSaga moves the execution through its statuses until it settles
+func (tm *TransactionManager) driveSaga(ctx context.Context, run *sagaRun) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 
-		stepResult, err := tm.executeStep(ctx, step, options)
+		var err error
+		switch run.execution.Status {
+		case SagaStatusPending:
+			run.execution.Status = SagaStatusExecuting
+			err = tm.persistRun(ctx, run, tm.leaseDuration)
+		case SagaStatusExecuting:
+			err = tm.executeNextStep(ctx, run)
+		case SagaStatusCompensating:
+			err = tm.compensateNextStep(ctx, run)
+		default:
+			return nil
+		}
 		if err != nil {
-			// Step failed, initiate compensation
-			execution.Status = SagaStatusFailed
-			execution.FailedStep = stepResult
-			execution.ErrorMessage = err.Error()
-			tm.updateSagaExecution(ctx, execution)
-
-			// Start compensation
-			tm.compensateSaga(ctx, execution, options)
-			return
+			return err
+		}
+	}
+}
+
+// executeNextStep runs the first step that has not completed, or completes the saga
+// when every step has. The step is recorded as running before it starts, so a restart
+// part-way through repeats it with the same idempotency key.
+func (tm *TransactionManager) executeNextStep(ctx context.Context, run *sagaRun) error {
+	execution := run.execution
+	now := tm.now()
+
+	var remaining time.Duration
+	if execution.Definition.Timeout > 0 {
+		remaining = execution.StartedAt.Add(execution.Definition.Timeout).Sub(now)
+		if remaining <= 0 {
+			tm.beginCompensation(execution, fmt.Sprintf("saga timed out after %s", execution.Definition.Timeout))
+			return tm.persistRun(ctx, run, tm.leaseDuration)
+		}
+	}
+
+	index, step := execution.nextStep()
+	if step == nil {
+		// All steps completed successfully
+		execution.Status = SagaStatusCompleted
+		execution.CompletedAt = &now
+		if err := tm.persistRun(ctx, run, 0); err != nil {
+			return err
 		}
+		tm.recordSagaSettled(execution)
+		tm.publishSagaEvent(ctx, execution, "saga.completed")
+		return nil
+	}
 
-		// Step succeeded
-		execution.CompletedSteps = append(execution.CompletedSteps, step.StepID)
-		tm.updateSagaExecution(ctx, execution)
+	timeout := step.Timeout
+	if timeout <= 0 {
+		timeout = defaultSagaStepTimeout
+	}
+	if remaining > 0 && remaining < timeout {
+		timeout = remaining
 	}
 
-	// All steps completed successfully
-	execution.Status = SagaStatusCompleted
-	now := time.Now()
-	execution.CompletedAt = &now
-	tm.updateSagaExecution(ctx, execution)
+	state := execution.stepState(step)
+	deadline := now.Add(timeout)
+	state.Status = SagaStepRunning
+	state.Attempts++
+	state.StartedAt = &now
+	state.Deadline = &deadline
+	state.Error = ""
+	execution.CurrentStep = index
 
-	// Publish saga completion event
-	tm.publishSagaEvent(ctx, execution, "saga.completed")
+	// The lease has to outlast the step so nobody resumes the saga while it is in flight
+	if err := tm.persistRun(ctx, run, timeout+tm.leaseDuration); err != nil {
+		return err
+	}
+
+	stepResult, err := tm.executeStep(ctx, step, state.IdempotencyKey, timeout)
+	if err != nil {
+		if ctx.Err() != nil {
+			// Shutting down; the step stays running and is repeated on recovery
+			return ctx.Err()
+		}
+
+		// Step failed, initiate compensation
+		state.Status = SagaStepFailed
+		state.Error = err.Error()
+		execution.FailedStep = stepResult
+		tm.beginCompensation(execution, err.Error())
+		return tm.persistRun(ctx, run, tm.leaseDuration)
+	}
+
+	// Step succeeded
+	completedAt := tm.now()
+	state.Status = SagaStepCompleted
+	state.CompletedAt = &completedAt
+	execution.CompletedSteps = append(execution.CompletedSteps, step.StepID)
+	execution.CurrentStep = index + 1
+	return tm.persistRun(ctx, run, tm.leaseDuration)
 }
 
-// executeStep executes a single saga step
-func (tm *TransactionManager) executeStep(ctx context.Context, step *SagaStep, options *TransactionOptions) (*SagaStepResult, error) {
-	startTime := time.Now()
-	
+// executeStep executes a single saga step, retrying it with the same idempotency key
+// until it succeeds, the retries run out or the step times out
+func (tm *TransactionManager) executeStep(ctx context.Context, step *SagaStep, idempotencyKey string, timeout time.Duration) (*SagaStepResult, error) {
+	startTime := tm.now()
+
 	result := &SagaStepResult{
 		StepID:     step.StepID,
 		ExecutedAt: startTime,
 	}
 
 	// Create step timeout context
-	stepCtx := ctx
-	if step.Timeout > 0 {
-		var cancel context.CancelFunc
-		stepCtx, cancel = context.WithTimeout(ctx, step.Timeout)
-		defer cancel()
-	}
+	stepCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
 	// Execute step with retry logic
 	var err error
@@ -229,109 +427,171 @@ func (tm *TransactionManager) executeStep(ctx context.Context, step *SagaStep, o
 
 	for attempt := 0; attempt <= retryPolicy.MaxRetries; attempt++ {
 		if attempt > 0 {
-			delay := time.Duration(float64(retryPolicy.InitialDelay) * 
+			delay := time.Duration(float64(retryPolicy.InitialDelay) *
 				float64(attempt) * retryPolicy.BackoffFactor)
 			if delay > retryPolicy.MaxDelay {
 				delay = retryPolicy.MaxDelay
 			}
-			time.Sleep(delay)
+			select {
+			case <-stepCtx.Done():
+			case <-time.After(delay):
+			}
+		}
+
+		// Don't retry once the step has timed out or the run is cancelled
+		if stepCtx.Err() != nil {
+			if err == nil {
+				err = stepCtx.Err()
+			}
+			break
 		}
 
-		err = tm.executeStepOperation(stepCtx, step)
+		err = tm.executor.ExecuteStep(stepCtx, step, idempotencyKey)
 		if err == nil {
-			result.Status = "completed"
-			result.Duration = time.Since(startTime)
+			result.Status = SagaStepCompleted
+			result.Duration = tm.now().Sub(startTime)
 			return result, nil
 		}
+	}
 
-		// Don't retry on context cancellation
-		if stepCtx.Err() != nil {
-			break
-		}
+	if stepCtx.Err() == context.DeadlineExceeded && ctx.Err() == nil {
+		err = domain.NewTimeoutError(fmt.Sprintf("saga step %s after %s", step.StepID, timeout))
 	}
 
-	result.Status = "failed"
+	result.Status = SagaStepFailed
 	result.Error = err.Error()
-	result.Duration = time.Since(startTime)
+	result.Duration = tm.now().Sub(startTime)
 	return result, err
 }
 
-// executeStepOperation executes the actual step operation
-func (tm *TransactionManager) executeStepOperation(ctx context.Context, step *SagaStep) error {
-	// Publish step execution event to the target service
-	event := &CrossServiceEvent{
-		EventID:       uuid.New().String(),
-		EventType:     "saga.step.execute",
-		SourceService: tm.client.GetAppID(),
-		TargetService: step.ServiceName,
-		EntityType:    "saga_step",
-		EntityID:      step.StepID,
-		OperationType: step.Operation,
-		Payload: map[string]interface{}{
-			"step_id":   step.StepID,
-			"operation": step.Operation,
-			"data":      step.Data,
-			"timeout":   step.Timeout.String(),
-		},
-		Timestamp: time.Now(),
-	}
-
-	return tm.pubsub.PublishCrossServiceEvent(ctx, event)
-}
-
-// compensateSaga executes compensation actions for failed saga
-func (tm *TransactionManager) compensateSaga(ctx context.Context, execution *SagaExecution, options *TransactionOptions) {
+// beginCompensation switches the execution to undoing its completed steps
+func (tm *TransactionManager) beginCompensation(execution *SagaExecution, reason string) {
 	execution.Status = SagaStatusCompensating
+	execution.ErrorMessage = reason
 	execution.Compensation = &CompensationExecution{
-		StartedAt:        time.Now(),
+		StartedAt:        tm.now(),
 		CompensatedSteps: []string{},
-		Status:          "running",
+		Status:           "running",
 	}
+}
 
-	// Execute compensation in reverse order
-	for i := len(execution.CompletedSteps) - 1; i >= 0; i-- {
-		stepID := execution.CompletedSteps[i]
-		step := tm.findStepByID(execution.Definition, stepID)
-		if step == nil || step.CompensationOp == "" {
-			continue
+// compensateNextStep undoes the latest step that may have taken effect, or marks the
+// saga compensated when nothing is left to undo. A step still marked running, as when
+// a saga is aborted mid-step, is compensated too once its deadline has passed.
+func (tm *TransactionManager) compensateNextStep(ctx context.Context, run *sagaRun) error {
+	execution := run.execution
+	if execution.Compensation == nil {
+		tm.beginCompensation(execution, execution.ErrorMessage)
+	}
+
+	step := execution.nextCompensation()
+	if step == nil {
+		// Compensation completed
+		now := tm.now()
+		execution.Status = SagaStatusCompensated
+		execution.Compensation.Status = "completed"
+		execution.Compensation.CompletedAt = &now
+		execution.CompletedAt = &now
+		if err := tm.persistRun(ctx, run, 0); err != nil {
+			return err
 		}
+		tm.recordSagaSettled(execution)
+		tm.publishSagaEvent(ctx, execution, "saga.compensated")
+		return nil
+	}
 
-		if err := tm.executeCompensationStep(ctx, step, options); err != nil {
-			execution.Compensation.Status = "failed"
-			execution.Compensation.ErrorMessage = err.Error()
-			tm.updateSagaExecution(ctx, execution)
-			return
+	state := execution.stepState(step)
+	if state.Status == SagaStepRunning && state.Deadline != nil {
+		if wait := state.Deadline.Sub(tm.now()); wait > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(wait):
+			}
 		}
+	}
 
-		execution.Compensation.CompensatedSteps = append(execution.Compensation.CompensatedSteps, stepID)
+	timeout := execution.compensationTimeout()
+	if err := tm.persistRun(ctx, run, timeout+tm.leaseDuration); err != nil {
+		return err
 	}
 
-	// Compensation completed
-	execution.Status = SagaStatusCompensated
-	execution.Compensation.Status = "completed"
-	now := time.Now()
-	execution.Compensation.CompletedAt = &now
-	tm.updateSagaExecution(ctx, execution)
+	if err := tm.executeCompensationStep(ctx, step, state.CompensationKey, timeout); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		// Compensation could not undo the step; the saga waits for an operator to retry it
+		state.Error = err.Error()
+		execution.Status = SagaStatusFailed
+		execution.Compensation.Status = "failed"
+		execution.Compensation.ErrorMessage = err.Error()
+		if err := tm.persistRun(ctx, run, 0); err != nil {
+			return err
+		}
+		tm.recordSagaSettled(execution)
+		tm.publishSagaEvent(ctx, execution, "saga.failed")
+		return nil
+	}
 
-	// Publish saga compensation event
-	tm.publishSagaEvent(ctx, execution, "saga.compensated")
+	compensatedAt := tm.now()
+	state.Status = SagaStepCompensated
+	state.CompensatedAt = &compensatedAt
+	state.Error = ""
+	execution.Compensation.CompensatedSteps = append(execution.Compensation.CompensatedSteps, step.StepID)
+	tm.recordCompensationEvent()
+	return tm.persistRun(ctx, run, tm.leaseDuration)
 }
 
 // executeCompensationStep executes a compensation step
-func (tm *TransactionManager) executeCompensationStep(ctx context.Context, step *SagaStep, options *TransactionOptions) error {
+func (tm *TransactionManager) executeCompensationStep(ctx context.Context, step *SagaStep, idempotencyKey string, timeout time.Duration) error {
 	// Create compensation timeout context
-	compensationCtx := ctx
-	if options.CompensationTimeout > 0 {
-		var cancel context.CancelFunc
-		compensationCtx, cancel = context.WithTimeout(ctx, options.CompensationTimeout)
-		defer cancel()
+	compensationCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := tm.executor.CompensateStep(compensationCtx, step, idempotencyKey)
+	if err != nil && compensationCtx.Err() == context.DeadlineExceeded && ctx.Err() == nil {
+		return domain.NewTimeoutError(fmt.Sprintf("saga step %s compensation after %s", step.StepID, timeout))
+	}
+	return err
+}
+
+// ExecuteStep publishes the step to its target service
+func (e *eventSagaStepExecutor) ExecuteStep(ctx context.Context, step *SagaStep, idempotencyKey string) error {
+	timeout := step.Timeout
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
 	}
 
+	// Publish step execution event to the target service
+	event := &CrossServiceEvent{
+		EventID:       idempotencyKey,
+		EventType:     "saga.step.execute",
+		SourceService: e.appID,
+		TargetService: step.ServiceName,
+		EntityType:    "saga_step",
+		EntityID:      step.StepID,
+		OperationType: step.Operation,
+		Payload: map[string]interface{}{
+			"step_id":         step.StepID,
+			"operation":       step.Operation,
+			"data":            step.Data,
+			"timeout":         timeout.String(),
+			"idempotency_key": idempotencyKey,
+		},
+		Timestamp: time.Now(),
+	}
+
+	return e.pubsub.PublishCrossServiceEvent(ctx, event)
+}
+
+// CompensateStep publishes the step's compensation to its target service
+func (e *eventSagaStepExecutor) CompensateStep(ctx context.Context, step *SagaStep, idempotencyKey string) error {
 	// Publish compensation event to the target service
 	event := &CrossServiceEvent{
-		EventID:       uuid.New().String(),
+		EventID:       idempotencyKey,
 		EventType:     "saga.step.compensate",
-		SourceService: tm.client.GetAppID(),
+		SourceService: e.appID,
 		TargetService: step.ServiceName,
 		EntityType:    "saga_step",
 		EntityID:      step.StepID,
@@ -341,11 +601,12 @@ func (tm *TransactionManager) executeCompensationStep(ctx context.Context, step
 			"operation":         step.CompensationOp,
 			"compensation_data": step.CompensationData,
 			"original_data":     step.Data,
+			"idempotency_key":   idempotencyKey,
 		},
 		Timestamp: time.Now(),
 	}
 
-	return tm.pubsub.PublishCrossServiceEvent(compensationCtx, event)
+	return e.pubsub.PublishCrossServiceEvent(ctx, event)
 }
 
 // HandleEventualConsistency manages eventual consistency through event sourcing
@@ -355,21 +616,14 @@ func (tm *TransactionManager) HandleEventualConsistency(ctx context.Context, ent
 	versionKey := fmt.Sprintf("version:%s:%s", entityType, entityID)
 
 	// Check current version
-	currentVersionStr, err := tm.stateStore.Get(ctx, versionKey, nil)
-	if err != nil && !domain.IsNotFoundError(err) {
-		return fmt.Errorf("failed to get current version: %w", err)
-	}
-
 	var currentVersion int
-	if currentVersionStr != nil {
-		if v, ok := currentVersionStr.(float64); ok {
-			currentVersion = int(v)
-		}
+	if _, err := tm.stateStore.Get(ctx, versionKey, &currentVersion); err != nil && !domain.IsNotFoundError(err) {
+		return fmt.Errorf("failed to get current version: %w", err)
 	}
 
 	// Version conflict check
 	if currentVersion != version {
-		return domain.NewConflictError(fmt.Sprintf("version conflict for %s %s: expected %d, got %d", 
+		return domain.NewConflictError(fmt.Sprintf("version conflict for %s %s: expected %d, got %d",
 			entityType, entityID, version, currentVersion))
 	}
 
@@ -380,7 +634,7 @@ func (tm *TransactionManager) HandleEventualConsistency(ctx context.Context, ent
 		"ttl":       30, // 30 seconds TTL
 	}
 
-	err = tm.stateStore.Save(ctx, lockKey, lockData, &StateOptions{
+	err := tm.stateStore.Save(ctx, lockKey, lockData, &StateOptions{
 		Concurrency: 1, // Optimistic concurrency
 	})
 	if err != nil {
@@ -456,28 +710,91 @@ func (tm *TransactionManager) validateSingleConsistency(ctx context.Context, val
 
 // Utility functions
 
-func (tm *TransactionManager) storeSagaExecution(ctx context.Context, execution *SagaExecution) error {
-	key := fmt.Sprintf("saga:execution:%s", execution.SagaID)
-	return tm.stateStore.Save(ctx, key, execution, nil)
-}
+// validateSagaDefinition rejects sagas whose steps cannot be tracked individually
+func validateSagaDefinition(saga *SagaDefinition) error {
+	if len(saga.Steps) == 0 {
+		return domain.NewValidationFieldError("steps", "saga requires at least one step")
+	}
 
-func (tm *TransactionManager) updateSagaExecution(ctx context.Context, execution *SagaExecution) {
-	tm.sagaMutex.Lock()
-	tm.sagaStore[execution.SagaID] = execution
-	tm.sagaMutex.Unlock()
+	seen := make(map[string]bool, len(saga.Steps))
+	for _, step := range saga.Steps {
+		if step == nil || step.StepID == "" {
+			return domain.NewValidationFieldError("steps", "every saga step requires a step ID")
+		}
+		if seen[step.StepID] {
+			return domain.NewValidationFieldError("steps", fmt.Sprintf("saga step %s is declared twice", step.StepID))
+		}
+		seen[step.StepID] = true
+	}
+	return nil
+}
 
-	tm.storeSagaExecution(ctx, execution)
+// nextStep returns the first step that has not completed, with its index
+func (e *SagaExecution) nextStep() (int, *SagaStep) {
+	for i, step := range e.Definition.Steps {
+		if e.stepState(step).Status != SagaStepCompleted {
+			return i, step
+		}
+	}
+	return len(e.Definition.Steps), nil
 }
 
-func (tm *TransactionManager) findStepByID(saga *SagaDefinition, stepID string) *SagaStep {
-	for _, step := range saga.Steps {
-		if step.StepID == stepID {
+// nextCompensation returns the latest step that may have taken effect and has a
+// compensation still to run
+func (e *SagaExecution) nextCompensation() *SagaStep {
+	for i := len(e.Definition.Steps) - 1; i >= 0; i-- {
+		step := e.Definition.Steps[i]
+		if step.CompensationOp == "" {
+			continue
+		}
+		switch e.stepState(step).Status {
+		case SagaStepCompleted, SagaStepRunning:
 			return step
 		}
 	}
 	return nil
 }
 
+// stepState returns the recorded progress of a step, creating it for executions
+// stored before steps were tracked individually
+func (e *SagaExecution) stepState(step *SagaStep) *SagaStepState {
+	if e.Steps == nil {
+		e.Steps = make(map[string]*SagaStepState)
+	}
+	state, exists := e.Steps[step.StepID]
+	if !exists {
+		state = &SagaStepState{
+			StepID:          step.StepID,
+			Status:          SagaStepPending,
+			IdempotencyKey:  fmt.Sprintf("%s:%s:execute", e.SagaID, step.StepID),
+			CompensationKey: fmt.Sprintf("%s:%s:compensate", e.SagaID, step.StepID),
+		}
+		for _, completed := range e.CompletedSteps {
+			if completed == step.StepID {
+				state.Status = SagaStepCompleted
+			}
+		}
+		e.Steps[step.StepID] = state
+	}
+	return state
+}
+
+func (e *SagaExecution) compensationTimeout() time.Duration {
+	if e.Options != nil && e.Options.CompensationTimeout > 0 {
+		return e.Options.CompensationTimeout
+	}
+	return defaultSagaStepTimeout
+}
+
+// settled reports whether the execution needs no further work unless an operator retries it
+func (e *SagaExecution) settled() bool {
+	switch e.Status {
+	case SagaStatusCompleted, SagaStatusCompensated, SagaStatusFailed:
+		return true
+	}
+	return false
+}
+
 func (tm *TransactionManager) publishSagaEvent(ctx context.Context, execution *SagaExecution, eventType string) {
 	event := &CrossServiceEvent{
 		EventID:       uuid.New().String(),
@@ -496,28 +813,38 @@ func (tm *TransactionManager) publishSagaEvent(ctx context.Context, execution *S
 	tm.pubsub.PublishCrossServiceEvent(ctx, event)
 }
 
-// GetSagaExecution retrieves a saga execution by ID
-func (tm *TransactionManager) GetSagaExecution(ctx context.Context, sagaID string) (*SagaExecution, error) {
-	tm.sagaMutex.RLock()
-	execution, exists := tm.sagaStore[sagaID]
-	tm.sagaMutex.RUnlock()
+func (tm *TransactionManager) recordSagaStarted() {
+	tm.metricsMutex.Lock()
+	defer tm.metricsMutex.Unlock()
+	tm.metrics.SagasStarted++
+}
 
-	if exists {
-		return execution, nil
-	}
+func (tm *TransactionManager) recordSagaSettled(execution *SagaExecution) {
+	tm.metricsMutex.Lock()
+	defer tm.metricsMutex.Unlock()
 
-	// Try to load from state store
-	key := fmt.Sprintf("saga:execution:%s", sagaID)
-	var storedExecution SagaExecution
-	found, err := tm.stateStore.Get(ctx, key, &storedExecution)
-	if err != nil {
-		return nil, err
-	}
-	if !found {
-		return nil, domain.NewNotFoundError("saga execution", sagaID)
+	switch execution.Status {
+	case SagaStatusCompleted:
+		tm.metrics.SagasCompleted++
+		duration := tm.now().Sub(execution.StartedAt)
+		tm.metrics.AvgSagaDuration += (duration - tm.metrics.AvgSagaDuration) / time.Duration(tm.metrics.SagasCompleted)
+	case SagaStatusCompensated:
+		tm.metrics.SagasCompensated++
+	case SagaStatusFailed:
+		tm.metrics.SagasFailed++
 	}
+}
+
+func (tm *TransactionManager) recordCompensationEvent() {
+	tm.metricsMutex.Lock()
+	defer tm.metricsMutex.Unlock()
+	tm.metrics.CompensationEvents++
+}
 
-	return &storedExecution, nil
+func (tm *TransactionManager) recordTransactionConflict() {
+	tm.metricsMutex.Lock()
+	defer tm.metricsMutex.Unlock()
+	tm.metrics.TransactionConflicts++
 }
 
 // GetMetrics returns current transaction manager metrics
@@ -533,12 +860,12 @@ func (tm *TransactionManager) GetMetrics() *TransactionMetrics {
 	metricsCopy := &TransactionMetrics{
 		SagasStarted:         tm.metrics.SagasStarted,
 		SagasCompleted:       tm.metrics.SagasCompleted,
-		SagasFailed:         tm.metrics.SagasFailed,
-		SagasCompensated:    tm.metrics.SagasCompensated,
-		AvgSagaDuration:     tm.metrics.AvgSagaDuration,
+		SagasFailed:          tm.metrics.SagasFailed,
+		SagasCompensated:     tm.metrics.SagasCompensated,
+		AvgSagaDuration:      tm.metrics.AvgSagaDuration,
 		TransactionConflicts: tm.metrics.TransactionConflicts,
-		CompensationEvents:  tm.metrics.CompensationEvents,
-		OperationLatencies:  make(map[string]int64),
+		CompensationEvents:   tm.metrics.CompensationEvents,
+		OperationLatencies:   make(map[string]int64),
 	}
 
 	for k, v := range tm.metrics.OperationLatencies {
@@ -546,4 +873,4 @@ func (tm *TransactionManager) GetMetrics() *TransactionMetrics {
 	}
 
 	return metricsCopy
-}
\ No newline at end of file
+}
@@ -0,0 +1,319 @@
+package dapr
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingStepExecutor records the idempotency keys it is handed and fails the steps
+// and compensations configured to fail
+type recordingStepExecutor struct {
+	mu                sync.Mutex
+	executed          []string
+	compensated       []string
+	failSteps         map[string]error
+	failCompensations map[string]error
+	blockSteps        map[string]bool
+}
+
+func (e *recordingStepExecutor) ExecuteStep(ctx context.Context, step *SagaStep, idempotencyKey string) error {
+	e.mu.Lock()
+	e.executed = append(e.executed, idempotencyKey)
+	err, block := e.failSteps[step.StepID], e.blockSteps[step.StepID]
+	e.mu.Unlock()
+
+	if block {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	return err
+}
+
+func (e *recordingStepExecutor) CompensateStep(ctx context.Context, step *SagaStep, idempotencyKey string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if err := e.failCompensations[step.StepID]; err != nil {
+		return err
+	}
+	e.compensated = append(e.compensated, idempotencyKey)
+	return nil
+}
+
+func (e *recordingStepExecutor) keys() ([]string, []string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]string(nil), e.executed...), append([]string(nil), e.compensated...)
+}
+
+func newTestTransactionManager(executor SagaStepExecutor) *TransactionManager {
+	client := &Client{appID: "content-api"}
+	return newTransactionManager(client, NewStateStoreWithBackend(NewMemoryStateBackend()), NewPubSub(client), executor)
+}
+
+// testSaga reserves, charges and confirms; confirming has nothing to undo
+func testSaga(sagaID string) *SagaDefinition {
+	noRetry := &RetryPolicy{MaxRetries: 0}
+	return &SagaDefinition{
+		SagaID: sagaID,
+		Name:   "publish-event",
+		Steps: []*SagaStep{
+			{StepID: "reserve", ServiceName: "content-api", Operation: "reserve", CompensationOp: "release", RetryPolicy: noRetry},
+			{StepID: "charge", ServiceName: "billing-api", Operation: "charge", CompensationOp: "refund", RetryPolicy: noRetry},
+			{StepID: "confirm", ServiceName: "notification-api", Operation: "confirm", RetryPolicy: noRetry},
+		},
+	}
+}
+
+// seedSagaExecution stores an execution as a manager that has since stopped left it
+func seedSagaExecution(t *testing.T, tm *TransactionManager, execution *SagaExecution) {
+	t.Helper()
+	_, err := tm.saveSagaExecution(context.Background(), execution, "")
+	require.NoError(t, err)
+}
+
+func TestTransactionManager_ExecuteDistributedTransaction(t *testing.T) {
+	tests := []struct {
+		name                string
+		failSteps           map[string]error
+		failCompensations   map[string]error
+		expectedStatus      SagaStatus
+		expectedExecuted    []string
+		expectedCompensated []string
+	}{
+		{
+			name:             "every step completes",
+			expectedStatus:   SagaStatusCompleted,
+			expectedExecuted: []string{"saga-1:reserve:execute", "saga-1:charge:execute", "saga-1:confirm:execute"},
+		},
+		{
+			name:                "failed step compensates completed steps",
+			failSteps:           map[string]error{"confirm": errors.New("mail server down")},
+			expectedStatus:      SagaStatusCompensated,
+			expectedExecuted:    []string{"saga-1:reserve:execute", "saga-1:charge:execute", "saga-1:confirm:execute"},
+			expectedCompensated: []string{"saga-1:charge:compensate", "saga-1:reserve:compensate"},
+		},
+		{
+			name:                "failed compensation leaves the saga failed",
+			failSteps:           map[string]error{"charge": errors.New("card declined")},
+			failCompensations:   map[string]error{"reserve": errors.New("inventory offline")},
+			expectedStatus:      SagaStatusFailed,
+			expectedExecuted:    []string{"saga-1:reserve:execute", "saga-1:charge:execute"},
+			expectedCompensated: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+			executor := &recordingStepExecutor{failSteps: tt.failSteps, failCompensations: tt.failCompensations}
+			tm := newTestTransactionManager(executor)
+
+			// Act
+			started, err := tm.ExecuteDistributedTransaction(ctx, testSaga("saga-1"), nil)
+			require.NoError(t, err)
+			tm.Wait()
+
+			// Assert
+			assert.Equal(t, SagaStatusPending, started.Status)
+			execution, err := tm.GetSagaExecution(ctx, "saga-1")
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, execution.Status)
+			assert.Empty(t, execution.LeaseOwner, "settled sagas release their lease")
+
+			executed, compensated := executor.keys()
+			assert.Equal(t, tt.expectedExecuted, executed)
+			assert.Equal(t, tt.expectedCompensated, compensated)
+		})
+	}
+}
+
+func TestTransactionManager_ExecuteDistributedTransactionValidation(t *testing.T) {
+	// Arrange
+	tm := newTestTransactionManager(&recordingStepExecutor{})
+	duplicate := testSaga("saga-1")
+	duplicate.Steps[1].StepID = "reserve"
+
+	// Act
+	_, emptyErr := tm.ExecuteDistributedTransaction(context.Background(), &SagaDefinition{SagaID: "saga-0"}, nil)
+	_, duplicateErr := tm.ExecuteDistributedTransaction(context.Background(), duplicate, nil)
+
+	// Assert
+	assert.True(t, domain.IsValidationError(emptyErr))
+	assert.True(t, domain.IsValidationError(duplicateErr))
+}
+
+func TestTransactionManager_StepTimeout(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	executor := &recordingStepExecutor{blockSteps: map[string]bool{"charge": true}}
+	tm := newTestTransactionManager(executor)
+	saga := testSaga("saga-1")
+	saga.Steps[1].Timeout = 20 * time.Millisecond
+
+	// Act
+	_, err := tm.ExecuteDistributedTransaction(ctx, saga, nil)
+	require.NoError(t, err)
+	tm.Wait()
+
+	// Assert
+	execution, err := tm.GetSagaExecution(ctx, "saga-1")
+	require.NoError(t, err)
+	assert.Equal(t, SagaStatusCompensated, execution.Status)
+	assert.Equal(t, SagaStepFailed, execution.Steps["charge"].Status)
+	assert.Contains(t, execution.ErrorMessage, "timeout")
+	_, compensated := executor.keys()
+	assert.Equal(t, []string{"saga-1:reserve:compensate"}, compensated)
+}
+
+func TestTransactionManager_RecoverSagas(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	executor := &recordingStepExecutor{}
+	tm := newTestTransactionManager(executor)
+	lapsed := time.Now().Add(-time.Minute)
+	live := time.Now().Add(time.Hour)
+
+	interrupted := &SagaExecution{SagaID: "interrupted", Definition: testSaga("interrupted"), Status: SagaStatusExecuting,
+		CompletedSteps: []string{"reserve"}, StartedAt: time.Now(), LeaseOwner: "crashed-manager", LeaseExpiresAt: &lapsed}
+	inFlight := interrupted.stepState(interrupted.Definition.Steps[1])
+	inFlight.Status = SagaStepRunning
+	inFlight.Attempts = 1
+
+	compensating := &SagaExecution{SagaID: "compensating", Definition: testSaga("compensating"), Status: SagaStatusCompensating,
+		CompletedSteps: []string{"reserve", "charge"}, StartedAt: time.Now(), ErrorMessage: "confirm failed"}
+
+	elsewhere := &SagaExecution{SagaID: "elsewhere", Definition: testSaga("elsewhere"), Status: SagaStatusExecuting,
+		StartedAt: time.Now(), LeaseOwner: "live-manager", LeaseExpiresAt: &live}
+
+	done := &SagaExecution{SagaID: "done", Definition: testSaga("done"), Status: SagaStatusCompleted, StartedAt: time.Now()}
+
+	for _, execution := range []*SagaExecution{interrupted, compensating, elsewhere, done} {
+		seedSagaExecution(t, tm, execution)
+	}
+
+	// Act
+	resumed, err := tm.RecoverSagas(ctx)
+	require.NoError(t, err)
+	tm.Wait()
+
+	// Assert
+	assert.Equal(t, 2, resumed)
+	executed, compensated := executor.keys()
+	assert.Equal(t, []string{"interrupted:charge:execute", "interrupted:confirm:execute"}, executed,
+		"the step in flight at the crash is repeated with its idempotency key")
+	assert.Equal(t, []string{"compensating:charge:compensate", "compensating:reserve:compensate"}, compensated)
+
+	expected := map[string]SagaStatus{
+		"interrupted":  SagaStatusCompleted,
+		"compensating": SagaStatusCompensated,
+		"elsewhere":    SagaStatusExecuting,
+		"done":         SagaStatusCompleted,
+	}
+	for sagaID, status := range expected {
+		execution, err := tm.GetSagaExecution(ctx, sagaID)
+		require.NoError(t, err)
+		assert.Equal(t, status, execution.Status, sagaID)
+	}
+	recovered, err := tm.GetSagaExecution(ctx, "interrupted")
+	require.NoError(t, err)
+	assert.Equal(t, 2, recovered.Steps["charge"].Attempts)
+}
+
+func TestTransactionManager_RetrySagaExecution(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	executor := &recordingStepExecutor{
+		failSteps:         map[string]error{"charge": errors.New("card declined")},
+		failCompensations: map[string]error{"reserve": errors.New("inventory offline")},
+	}
+	tm := newTestTransactionManager(executor)
+	_, err := tm.ExecuteDistributedTransaction(ctx, testSaga("saga-1"), nil)
+	require.NoError(t, err)
+	tm.Wait()
+
+	executor.mu.Lock()
+	executor.failCompensations = nil
+	executor.mu.Unlock()
+
+	// Act
+	retried, err := tm.RetrySagaExecution(ctx, "saga-1")
+	require.NoError(t, err)
+	tm.Wait()
+	_, settledErr := tm.RetrySagaExecution(ctx, "saga-1")
+	_, missingErr := tm.RetrySagaExecution(ctx, "saga-2")
+
+	// Assert
+	assert.Equal(t, SagaStatusCompensating, retried.Status)
+	execution, err := tm.GetSagaExecution(ctx, "saga-1")
+	require.NoError(t, err)
+	assert.Equal(t, SagaStatusCompensated, execution.Status)
+	_, compensated := executor.keys()
+	assert.Equal(t, []string{"saga-1:reserve:compensate"}, compensated)
+	assert.True(t, domain.IsConflictError(settledErr))
+	assert.True(t, domain.IsNotFoundError(missingErr))
+}
+
+func TestTransactionManager_AbortSagaExecution(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	executor := &recordingStepExecutor{}
+	tm := newTestTransactionManager(executor)
+	live := time.Now().Add(time.Hour)
+	execution := &SagaExecution{SagaID: "saga-1", Definition: testSaga("saga-1"), Status: SagaStatusExecuting,
+		CompletedSteps: []string{"reserve"}, StartedAt: time.Now(), LeaseOwner: "other-manager", LeaseExpiresAt: &live}
+	seedSagaExecution(t, tm, execution)
+
+	// Act
+	aborted, err := tm.AbortSagaExecution(ctx, "saga-1", "duplicate order")
+	require.NoError(t, err)
+	tm.Wait()
+	_, againErr := tm.AbortSagaExecution(ctx, "saga-1", "")
+
+	// Assert
+	assert.Equal(t, SagaStatusCompensating, aborted.Status)
+	assert.Equal(t, "aborted by operator: duplicate order", aborted.ErrorMessage)
+	stored, err := tm.GetSagaExecution(ctx, "saga-1")
+	require.NoError(t, err)
+	assert.Equal(t, SagaStatusCompensated, stored.Status)
+	_, compensated := executor.keys()
+	assert.Equal(t, []string{"saga-1:reserve:compensate"}, compensated)
+	assert.True(t, domain.IsConflictError(againErr))
+}
+
+func TestTransactionManager_ConcurrentClaims(t *testing.T) {
+	// Arrange: two managers share a store, as two instances would
+	ctx := context.Background()
+	store := NewStateStoreWithBackend(NewMemoryStateBackend())
+	client := &Client{appID: "content-api"}
+	executor := &recordingStepExecutor{}
+	first := newTransactionManager(client, store, NewPubSub(client), executor)
+	second := newTransactionManager(client, store, NewPubSub(client), executor)
+	lapsed := time.Now().Add(-time.Minute)
+	seedSagaExecution(t, first, &SagaExecution{SagaID: "saga-1", Definition: testSaga("saga-1"), Status: SagaStatusExecuting,
+		StartedAt: time.Now(), LeaseOwner: "crashed-manager", LeaseExpiresAt: &lapsed})
+
+	// Act
+	var wg sync.WaitGroup
+	for _, tm := range []*TransactionManager{first, second} {
+		wg.Add(1)
+		go func(tm *TransactionManager) {
+			defer wg.Done()
+			tm.RecoverSagas(ctx)
+			tm.Wait()
+		}(tm)
+	}
+	wg.Wait()
+
+	// Assert
+	executed, _ := executor.keys()
+	assert.Equal(t, []string{"saga-1:reserve:execute", "saga-1:charge:execute", "saga-1:confirm:execute"}, executed,
+		"only one manager runs the saga")
+}
@@ -18,6 +18,7 @@ const (
 	AuditEventPublish AuditEventType = "PUBLISH"
 	AuditEventArchive AuditEventType = "ARCHIVE"
 	AuditEventAccess AuditEventType = "ACCESS"
+	AuditEventRollback AuditEventType = "ROLLBACK"
 )
 
 // EntityType represents the type of entity being audited
@@ -45,6 +46,9 @@ const (
 	EntityTypeFeaturedEvent EntityType = "featured_event"
 	EntityTypeEventRegistration EntityType = "event_registration"
 	
+	// Media Library
+	EntityTypeMediaAsset EntityType = "media_asset"
+	
 	// Inquiry Domains
 	EntityTypeBusinessInquiry EntityType = "business_inquiry"
 	EntityTypeDonationsInquiry EntityType = "donations_inquiry"
@@ -54,6 +58,9 @@ const (
 	// System Entities
 	EntityTypeUser     EntityType = "user"
 	EntityTypeMigration EntityType = "migration"
+	EntityTypeDeadLetter EntityType = "dead_letter"
+	EntityTypeFeatureFlag EntityType = "feature_flag"
+	EntityTypeSecret EntityType = "secret"
 )
 
 // AuditEvent represents a complete audit event for regulatory compliance and security monitoring.
@@ -99,6 +106,13 @@ func NewAuditEvent(entityType EntityType, entityID string, operationType AuditEv
 	}
 }
 
+// NewAuditChange creates an audit event recording an entity as it was before and after an operation
+func NewAuditChange(entityType EntityType, entityID string, operationType AuditEventType, userID string, before, after interface{}) *AuditEvent {
+	event := NewAuditEvent(entityType, entityID, operationType, userID)
+	event.SetDataSnapshot(before, after)
+	return event
+}
+
 // SetTraceContext sets tracing information for the audit event
 func (a *AuditEvent) SetTraceContext(correlationID, traceID string) {
 	a.CorrelationID = correlationID
@@ -189,7 +203,7 @@ func IsValidEntityType(entityType EntityType) bool {
 // IsValidOperationType checks if the operation type is valid
 func IsValidOperationType(operationType AuditEventType) bool {
 	switch operationType {
-	case AuditEventInsert, AuditEventUpdate, AuditEventDelete, AuditEventPublish, AuditEventArchive, AuditEventAccess:
+	case AuditEventInsert, AuditEventUpdate, AuditEventDelete, AuditEventPublish, AuditEventArchive, AuditEventAccess, AuditEventRollback:
 		return true
 	default:
 		return false
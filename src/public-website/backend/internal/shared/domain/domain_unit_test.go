@@ -2207,6 +2207,7 @@ func TestIsValidOperationType(t *testing.T) {
 		{name: "valid AuditEventPublish", operationType: AuditEventPublish, want: true},
 		{name: "valid AuditEventArchive", operationType: AuditEventArchive, want: true},
 		{name: "valid AuditEventAccess", operationType: AuditEventAccess, want: true},
+		{name: "valid AuditEventRollback", operationType: AuditEventRollback, want: true},
 		{name: "invalid empty operation type", operationType: AuditEventType(""), want: false},
 		{name: "invalid unknown operation type", operationType: AuditEventType("UNKNOWN"), want: false},
 		{name: "invalid mixed case operation type", operationType: AuditEventType("Insert"), want: false},
@@ -0,0 +1,382 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WorkflowStatus is a stage of the editorial workflow shared by all content types.
+// Each content package stores it in its own publishing status.
+type WorkflowStatus string
+
+const (
+	WorkflowStatusDraft     WorkflowStatus = "draft"
+	WorkflowStatusInReview  WorkflowStatus = "in_review"
+	WorkflowStatusApproved  WorkflowStatus = "approved"
+	WorkflowStatusPublished WorkflowStatus = "published"
+	WorkflowStatusArchived  WorkflowStatus = "archived"
+)
+
+// maxReviewCommentLength bounds a single review comment or rejection reason
+const maxReviewCommentLength = 2000
+
+// EditorialWorkflow is the review policy content passes through before publishing.
+// With RequireReview set, content moves draft → in_review → approved → published
+// and only approved content can be published; without it any admin may publish
+// a draft directly.
+type EditorialWorkflow struct {
+	RequireReview     bool
+	RequiredApprovals int
+}
+
+// NewEditorialWorkflow creates a workflow; fewer than one required approval means one
+func NewEditorialWorkflow(requireReview bool, requiredApprovals int) *EditorialWorkflow {
+	if requiredApprovals < 1 {
+		requiredApprovals = 1
+	}
+	return &EditorialWorkflow{
+		RequireReview:     requireReview,
+		RequiredApprovals: requiredApprovals,
+	}
+}
+
+// DirectPublishWorkflow lets content be published without review
+func DirectPublishWorkflow() *EditorialWorkflow {
+	return NewEditorialWorkflow(false, 1)
+}
+
+// CanPublish reports whether content in the given status may be published
+func (w *EditorialWorkflow) CanPublish(status WorkflowStatus) error {
+	if !w.RequireReview {
+		return nil
+	}
+	if status != WorkflowStatusApproved {
+		return NewConflictError(fmt.Sprintf("content must be approved before it is published; it is %s", status))
+	}
+	return nil
+}
+
+// StatusAfterEdit returns the status content takes when editorID edits it. Under
+// review the status only moves through the workflow, and changes to approved content
+// send it back to its reviewers. Without review the requested status is kept. The
+// editor is recorded as an author either way, so nobody reviews their own changes.
+func (w *EditorialWorkflow) StatusAfterEdit(current, requested WorkflowStatus, review *EditorialReview, editorID string) WorkflowStatus {
+	if !w.RequireReview {
+		if review != nil {
+			review.recordAuthor(editorID)
+		}
+		return requested
+	}
+	if review == nil {
+		return current
+	}
+	return review.ContentChanged(current, editorID)
+}
+
+// ReviewComment is a remark left on content while it is reviewed
+type ReviewComment struct {
+	CommentID string    `json:"comment_id"`
+	AuthorID  string    `json:"author_id"`
+	Body      string    `json:"body"`
+	CreatedOn time.Time `json:"created_on"`
+}
+
+// ReviewApproval records one reviewer signing off on content
+type ReviewApproval struct {
+	ReviewerID string    `json:"reviewer_id"`
+	ApprovedOn time.Time `json:"approved_on"`
+}
+
+// EditorialReview is the review record kept on a content entity. Authors are
+// everyone who wrote or submitted the content; none of them may review it.
+type EditorialReview struct {
+	SubmittedBy     string           `json:"submitted_by,omitempty"`
+	SubmittedOn     *time.Time       `json:"submitted_on,omitempty"`
+	Authors         []string         `json:"authors,omitempty"`
+	Reviewers       []string         `json:"reviewers,omitempty"`
+	Approvals       []ReviewApproval `json:"approvals,omitempty"`
+	Comments        []ReviewComment  `json:"comments,omitempty"`
+	RejectedBy      string           `json:"rejected_by,omitempty"`
+	RejectedOn      *time.Time       `json:"rejected_on,omitempty"`
+	RejectionReason string           `json:"rejection_reason,omitempty"`
+}
+
+// Clone returns a deep copy, used to snapshot the review for audit events
+func (r *EditorialReview) Clone() *EditorialReview {
+	if r == nil {
+		return nil
+	}
+	clone := *r
+	clone.Authors = append([]string(nil), r.Authors...)
+	clone.Reviewers = append([]string(nil), r.Reviewers...)
+	clone.Approvals = append([]ReviewApproval(nil), r.Approvals...)
+	clone.Comments = append([]ReviewComment(nil), r.Comments...)
+	return &clone
+}
+
+// Submit sends draft content to review. Authors are the content's writers; they
+// and the submitter join the editors already recorded on the review. Any earlier approvals and rejection are cleared.
+func (r *EditorialReview) Submit(status WorkflowStatus, userID string, authors []string, reviewers []string, now time.Time) (WorkflowStatus, error) {
+	if userID == "" {
+		return status, NewUnauthorizedError("user ID is required to submit content for review")
+	}
+	if status != WorkflowStatusDraft {
+		return status, NewConflictError(fmt.Sprintf("only draft content can be submitted for review; it is %s", status))
+	}
+
+	r.Authors = uniqueIDs(append(append(append([]string{}, r.Authors...), authors...), userID))
+	reviewers = uniqueIDs(reviewers)
+	if err := r.checkReviewers(reviewers); err != nil {
+		return status, err
+	}
+
+	submitted := now.UTC()
+	r.SubmittedBy = userID
+	r.SubmittedOn = &submitted
+	r.Reviewers = reviewers
+	r.Approvals = nil
+	r.RejectedBy = ""
+	r.RejectedOn = nil
+	r.RejectionReason = ""
+
+	return WorkflowStatusInReview, nil
+}
+
+// AssignReviewers adds reviewers to content under review and returns those not
+// already assigned
+func (r *EditorialReview) AssignReviewers(status WorkflowStatus, reviewers []string) ([]string, error) {
+	if status != WorkflowStatusInReview {
+		return nil, NewConflictError(fmt.Sprintf("reviewers can only be assigned while content is in review; it is %s", status))
+	}
+
+	reviewers = uniqueIDs(reviewers)
+	if len(reviewers) == 0 {
+		return nil, NewValidationFieldError("reviewer_ids", "at least one reviewer is required")
+	}
+	if err := r.checkReviewers(reviewers); err != nil {
+		return nil, err
+	}
+
+	var added []string
+	for _, reviewer := range reviewers {
+		if !containsID(r.Reviewers, reviewer) {
+			r.Reviewers = append(r.Reviewers, reviewer)
+			added = append(added, reviewer)
+		}
+	}
+	return added, nil
+}
+
+// AddComment records a review comment. Content can be discussed until it is published.
+func (r *EditorialReview) AddComment(status WorkflowStatus, userID string, body string, now time.Time) (*ReviewComment, error) {
+	if userID == "" {
+		return nil, NewUnauthorizedError("user ID is required to comment")
+	}
+	switch status {
+	case WorkflowStatusDraft, WorkflowStatusInReview, WorkflowStatusApproved:
+	default:
+		return nil, NewConflictError(fmt.Sprintf("%s content cannot be commented on", status))
+	}
+
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return nil, NewValidationFieldError("body", "comment body is required")
+	}
+	if len(body) > maxReviewCommentLength {
+		return nil, NewValidationFieldError("body", fmt.Sprintf("comment body must be at most %d characters", maxReviewCommentLength))
+	}
+
+	comment := ReviewComment{
+		CommentID: uuid.New().String(),
+		AuthorID:  userID,
+		Body:      body,
+		CreatedOn: now.UTC(),
+	}
+	r.Comments = append(r.Comments, comment)
+	return &comment, nil
+}
+
+// Approve records a reviewer's approval. The content is approved once the workflow's
+// required number of reviewers have signed off and stays in review until then.
+func (r *EditorialReview) Approve(status WorkflowStatus, userID string, requiredApprovals int, now time.Time) (WorkflowStatus, error) {
+	if err := r.checkDecision(status, userID, "approve"); err != nil {
+		return status, err
+	}
+	for _, approval := range r.Approvals {
+		if approval.ReviewerID == userID {
+			return status, NewConflictError("you have already approved this content")
+		}
+	}
+
+	r.Approvals = append(r.Approvals, ReviewApproval{ReviewerID: userID, ApprovedOn: now.UTC()})
+	if len(r.Approvals) < requiredApprovals {
+		return WorkflowStatusInReview, nil
+	}
+	return WorkflowStatusApproved, nil
+}
+
+// Reject returns content to its authors as a draft. A reason is required so the
+// authors know what to change.
+func (r *EditorialReview) Reject(status WorkflowStatus, userID string, reason string, now time.Time) (WorkflowStatus, error) {
+	if err := r.checkDecision(status, userID, "reject"); err != nil {
+		return status, err
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return status, NewValidationFieldError("reason", "a rejection reason is required")
+	}
+	if len(reason) > maxReviewCommentLength {
+		return status, NewValidationFieldError("reason", fmt.Sprintf("rejection reason must be at most %d characters", maxReviewCommentLength))
+	}
+
+	rejected := now.UTC()
+	r.RejectedBy = userID
+	r.RejectedOn = &rejected
+	r.RejectionReason = reason
+	r.Approvals = nil
+
+	return WorkflowStatusDraft, nil
+}
+
+// ContentChanged records editorID as an author and discards approvals after the
+// content has been edited, so that reviewers sign off on what is actually published
+// and never on their own edits. Approved content goes back to review.
+func (r *EditorialReview) ContentChanged(status WorkflowStatus, editorID string) WorkflowStatus {
+	r.recordAuthor(editorID)
+	switch status {
+	case WorkflowStatusInReview, WorkflowStatusApproved:
+		r.Approvals = nil
+		return WorkflowStatusInReview
+	default:
+		return status
+	}
+}
+
+// IsAuthor reports whether userID wrote or submitted the content
+func (r *EditorialReview) IsAuthor(userID string) bool {
+	return containsID(r.Authors, userID)
+}
+
+// Participants returns the authors and reviewers of the content except userID
+func (r *EditorialReview) Participants(userID string) []string {
+	var participants []string
+	for _, id := range uniqueIDs(append(append([]string{}, r.Authors...), r.Reviewers...)) {
+		if id != userID {
+			participants = append(participants, id)
+		}
+	}
+	return participants
+}
+
+func (r *EditorialReview) recordAuthor(userID string) {
+	r.Authors = uniqueIDs(append(append([]string{}, r.Authors...), userID))
+}
+
+func (r *EditorialReview) checkReviewers(reviewers []string) error {
+	for _, reviewer := range reviewers {
+		if r.IsAuthor(reviewer) {
+			return NewForbiddenError(fmt.Sprintf("user %s authored this content and cannot review it", reviewer))
+		}
+	}
+	return nil
+}
+
+func (r *EditorialReview) checkDecision(status WorkflowStatus, userID string, decision string) error {
+	if userID == "" {
+		return NewUnauthorizedError(fmt.Sprintf("user ID is required to %s content", decision))
+	}
+	if status != WorkflowStatusInReview && !(decision == "reject" && status == WorkflowStatusApproved) {
+		return NewConflictError(fmt.Sprintf("content that is %s is not awaiting review", status))
+	}
+	if r.IsAuthor(userID) {
+		return NewForbiddenError(fmt.Sprintf("authors cannot %s their own content", decision))
+	}
+	if len(r.Reviewers) > 0 && !containsID(r.Reviewers, userID) {
+		return NewForbiddenError(fmt.Sprintf("only assigned reviewers can %s this content", decision))
+	}
+	return nil
+}
+
+// ContentReview is the review state of one content entity as shown to admins
+type ContentReview struct {
+	EntityType EntityType       `json:"entity_type"`
+	EntityID   string           `json:"entity_id"`
+	Title      string           `json:"title"`
+	Status     WorkflowStatus   `json:"status"`
+	Review     *EditorialReview `json:"review"`
+}
+
+// NewContentReview describes the review state of a content entity
+func NewContentReview(entityType EntityType, entityID, title string, status WorkflowStatus, review *EditorialReview) *ContentReview {
+	if review == nil {
+		review = &EditorialReview{}
+	}
+	return &ContentReview{
+		EntityType: entityType,
+		EntityID:   entityID,
+		Title:      title,
+		Status:     status,
+		Review:     review,
+	}
+}
+
+// ReviewEventType names a notification raised by the editorial workflow
+type ReviewEventType string
+
+const (
+	ReviewEventRequested ReviewEventType = "review_requested"
+	ReviewEventCommented ReviewEventType = "review_commented"
+	ReviewEventApproved  ReviewEventType = "review_approved"
+	ReviewEventRejected  ReviewEventType = "review_rejected"
+)
+
+// ReviewEvent notifies the people involved in a review that it has moved on.
+// Review requests go to the reviewers, decisions go back to the authors.
+type ReviewEvent struct {
+	EventType  ReviewEventType `json:"event_type"`
+	EntityType EntityType      `json:"entity_type"`
+	EntityID   string          `json:"entity_id"`
+	Title      string          `json:"title"`
+	ActorID    string          `json:"actor_id"`
+	Recipients []string        `json:"recipients"`
+	Message    string          `json:"message,omitempty"`
+	OccurredOn time.Time       `json:"occurred_on"`
+}
+
+// NewReviewEvent creates a notification about the given content review
+func NewReviewEvent(eventType ReviewEventType, review *ContentReview, actorID string, recipients []string, message string) *ReviewEvent {
+	return &ReviewEvent{
+		EventType:  eventType,
+		EntityType: review.EntityType,
+		EntityID:   review.EntityID,
+		Title:      review.Title,
+		ActorID:    actorID,
+		Recipients: uniqueIDs(recipients),
+		Message:    message,
+		OccurredOn: time.Now().UTC(),
+	}
+}
+
+func uniqueIDs(ids []string) []string {
+	var unique []string
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		if id != "" && !containsID(unique, id) {
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
+func containsID(ids []string, id string) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
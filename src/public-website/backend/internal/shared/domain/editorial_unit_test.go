@@ -0,0 +1,252 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEditorialWorkflow_CanPublish(t *testing.T) {
+	tests := []struct {
+		name     string
+		workflow *EditorialWorkflow
+		status   WorkflowStatus
+		wantErr  bool
+	}{
+		{name: "direct publish of draft", workflow: DirectPublishWorkflow(), status: WorkflowStatusDraft},
+		{name: "review required and approved", workflow: NewEditorialWorkflow(true, 1), status: WorkflowStatusApproved},
+		{name: "review required but draft", workflow: NewEditorialWorkflow(true, 1), status: WorkflowStatusDraft, wantErr: true},
+		{name: "review required but still in review", workflow: NewEditorialWorkflow(true, 1), status: WorkflowStatusInReview, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			err := tt.workflow.CanPublish(tt.status)
+
+			// Assert
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.True(t, IsConflictError(err))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestEditorialWorkflow_StatusAfterEdit(t *testing.T) {
+	t.Run("direct publishing keeps the requested status", func(t *testing.T) {
+		status := DirectPublishWorkflow().StatusAfterEdit(WorkflowStatusDraft, WorkflowStatusPublished, nil, "editor-1")
+
+		assert.Equal(t, WorkflowStatusPublished, status)
+	})
+
+	t.Run("edits cannot skip review", func(t *testing.T) {
+		status := NewEditorialWorkflow(true, 1).StatusAfterEdit(WorkflowStatusDraft, WorkflowStatusPublished, nil, "editor-1")
+
+		assert.Equal(t, WorkflowStatusDraft, status)
+	})
+
+	t.Run("editing approved content sends it back to review", func(t *testing.T) {
+		review := &EditorialReview{Approvals: []ReviewApproval{{ReviewerID: "reviewer-1"}}}
+
+		status := NewEditorialWorkflow(true, 1).StatusAfterEdit(WorkflowStatusApproved, WorkflowStatusApproved, review, "editor-1")
+
+		assert.Equal(t, WorkflowStatusInReview, status)
+		assert.Empty(t, review.Approvals)
+	})
+
+	t.Run("editors are recorded as authors", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			workflow *EditorialWorkflow
+			status   WorkflowStatus
+		}{
+			{name: "draft under review workflow", workflow: NewEditorialWorkflow(true, 1), status: WorkflowStatusDraft},
+			{name: "content in review", workflow: NewEditorialWorkflow(true, 1), status: WorkflowStatusInReview},
+			{name: "direct publishing", workflow: DirectPublishWorkflow(), status: WorkflowStatusPublished},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				// Arrange
+				review := &EditorialReview{Authors: []string{"author-1"}, Reviewers: []string{"editor-1"}}
+
+				// Act
+				tt.workflow.StatusAfterEdit(tt.status, tt.status, review, "editor-1")
+
+				// Assert
+				assert.Equal(t, []string{"author-1", "editor-1"}, review.Authors)
+				assert.True(t, review.IsAuthor("editor-1"))
+			})
+		}
+	})
+
+	t.Run("an editing reviewer can no longer approve", func(t *testing.T) {
+		// Arrange
+		review := &EditorialReview{Authors: []string{"author-1"}, Reviewers: []string{"reviewer-1"}}
+		status := NewEditorialWorkflow(true, 1).StatusAfterEdit(WorkflowStatusInReview, WorkflowStatusInReview, review, "reviewer-1")
+
+		// Act
+		_, err := review.Approve(status, "reviewer-1", 1, time.Now())
+
+		// Assert
+		assert.True(t, IsForbiddenError(err))
+	})
+}
+
+func TestEditorialReview_Submit(t *testing.T) {
+	now := time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		status    WorkflowStatus
+		userID    string
+		reviewers []string
+		editors   []string
+		wantErr   func(error) bool
+	}{
+		{name: "submit draft with reviewers", status: WorkflowStatusDraft, userID: "author-1", reviewers: []string{"reviewer-1", "reviewer-1", "reviewer-2"}},
+		{name: "submit draft without reviewers", status: WorkflowStatusDraft, userID: "author-1"},
+		{name: "already in review", status: WorkflowStatusInReview, userID: "author-1", wantErr: IsConflictError},
+		{name: "author assigned as reviewer", status: WorkflowStatusDraft, userID: "author-1", reviewers: []string{"writer-1"}, wantErr: IsForbiddenError},
+		{name: "submitter assigned as reviewer", status: WorkflowStatusDraft, userID: "author-1", reviewers: []string{"author-1"}, wantErr: IsForbiddenError},
+		{name: "earlier editor assigned as reviewer", status: WorkflowStatusDraft, userID: "author-1", editors: []string{"editor-1"}, reviewers: []string{"editor-1"}, wantErr: IsForbiddenError},
+		{name: "missing user", status: WorkflowStatusDraft, wantErr: IsUnauthorizedError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			review := &EditorialReview{Authors: tt.editors, RejectionReason: "needs sources", Approvals: []ReviewApproval{{ReviewerID: "reviewer-9"}}}
+
+			// Act
+			status, err := review.Submit(tt.status, tt.userID, []string{"writer-1"}, tt.reviewers, now)
+
+			// Assert
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.True(t, tt.wantErr(err))
+				assert.Equal(t, tt.status, status)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, WorkflowStatusInReview, status)
+			assert.Equal(t, []string{"writer-1", "author-1"}, review.Authors)
+			assert.Equal(t, tt.userID, review.SubmittedBy)
+			assert.Empty(t, review.RejectionReason)
+			assert.Empty(t, review.Approvals)
+			if len(tt.reviewers) > 0 {
+				assert.Equal(t, []string{"reviewer-1", "reviewer-2"}, review.Reviewers)
+			}
+		})
+	}
+}
+
+func TestEditorialReview_Decisions(t *testing.T) {
+	now := time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+
+	submitted := func(t *testing.T, reviewers ...string) *EditorialReview {
+		review := &EditorialReview{}
+		_, err := review.Submit(WorkflowStatusDraft, "author-1", nil, reviewers, now)
+		require.NoError(t, err)
+		return review
+	}
+
+	t.Run("author cannot approve their own content", func(t *testing.T) {
+		review := submitted(t)
+
+		_, err := review.Approve(WorkflowStatusInReview, "author-1", 1, now)
+
+		require.Error(t, err)
+		assert.True(t, IsForbiddenError(err))
+		assert.Empty(t, review.Approvals)
+	})
+
+	t.Run("only assigned reviewers decide", func(t *testing.T) {
+		review := submitted(t, "reviewer-1")
+
+		_, err := review.Approve(WorkflowStatusInReview, "reviewer-2", 1, now)
+
+		require.Error(t, err)
+		assert.True(t, IsForbiddenError(err))
+	})
+
+	t.Run("approved once enough reviewers sign off", func(t *testing.T) {
+		review := submitted(t, "reviewer-1", "reviewer-2")
+
+		status, err := review.Approve(WorkflowStatusInReview, "reviewer-1", 2, now)
+		require.NoError(t, err)
+		assert.Equal(t, WorkflowStatusInReview, status)
+
+		_, err = review.Approve(status, "reviewer-1", 2, now)
+		require.Error(t, err)
+		assert.True(t, IsConflictError(err))
+
+		status, err = review.Approve(status, "reviewer-2", 2, now)
+		require.NoError(t, err)
+		assert.Equal(t, WorkflowStatusApproved, status)
+		assert.Len(t, review.Approvals, 2)
+	})
+
+	t.Run("reject requires a reason", func(t *testing.T) {
+		review := submitted(t, "reviewer-1")
+
+		_, err := review.Reject(WorkflowStatusInReview, "reviewer-1", "  ", now)
+
+		require.Error(t, err)
+		assert.True(t, IsValidationError(err))
+	})
+
+	t.Run("reject returns approved content to draft", func(t *testing.T) {
+		review := submitted(t, "reviewer-1")
+		status, err := review.Approve(WorkflowStatusInReview, "reviewer-1", 1, now)
+		require.NoError(t, err)
+
+		status, err = review.Reject(status, "reviewer-1", "figures are out of date", now)
+
+		require.NoError(t, err)
+		assert.Equal(t, WorkflowStatusDraft, status)
+		assert.Equal(t, "figures are out of date", review.RejectionReason)
+		assert.Equal(t, "reviewer-1", review.RejectedBy)
+		assert.Empty(t, review.Approvals)
+	})
+
+	t.Run("draft content is not awaiting a decision", func(t *testing.T) {
+		review := &EditorialReview{}
+
+		_, err := review.Approve(WorkflowStatusDraft, "reviewer-1", 1, now)
+
+		require.Error(t, err)
+		assert.True(t, IsConflictError(err))
+	})
+}
+
+func TestEditorialReview_AssignReviewersAndComments(t *testing.T) {
+	now := time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+	review := &EditorialReview{}
+	_, err := review.Submit(WorkflowStatusDraft, "author-1", nil, []string{"reviewer-1"}, now)
+	require.NoError(t, err)
+
+	added, err := review.AssignReviewers(WorkflowStatusInReview, []string{"reviewer-1", "reviewer-2"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"reviewer-2"}, added)
+	assert.Equal(t, []string{"reviewer-1", "reviewer-2"}, review.Reviewers)
+
+	_, err = review.AssignReviewers(WorkflowStatusInReview, []string{"author-1"})
+	require.Error(t, err)
+	assert.True(t, IsForbiddenError(err))
+
+	comment, err := review.AddComment(WorkflowStatusInReview, "reviewer-1", " Please cite the study. ", now)
+	require.NoError(t, err)
+	assert.Equal(t, "Please cite the study.", comment.Body)
+	assert.Len(t, review.Comments, 1)
+	assert.Equal(t, []string{"author-1", "reviewer-2"}, review.Participants("reviewer-1"))
+
+	_, err = review.AddComment(WorkflowStatusPublished, "reviewer-1", "too late", now)
+	require.Error(t, err)
+	assert.True(t, IsConflictError(err))
+}
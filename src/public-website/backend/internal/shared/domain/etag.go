@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ETagAny is the If-Match value that matches any current version of an entity
+const ETagAny = "*"
+
+// FormatETag renders a state store ETag as a strong HTTP entity tag. An empty
+// ETag renders as an empty header value so callers can skip setting it.
+func FormatETag(etag string) string {
+	if etag == "" {
+		return ""
+	}
+	return `"` + etag + `"`
+}
+
+// ParseIfMatch extracts the expected ETag from an If-Match header value. Quotes
+// and the weak validator prefix are stripped; an absent header yields "".
+// Only the first tag of a list is honoured since an update targets one version.
+func ParseIfMatch(header string) string {
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return ""
+	}
+	if comma := strings.Index(header, ","); comma >= 0 {
+		header = strings.TrimSpace(header[:comma])
+	}
+	header = strings.TrimPrefix(header, "W/")
+	return strings.Trim(header, `"`)
+}
+
+// CheckETag verifies that the version a caller expects to modify is still the
+// current one. An empty or wildcard expectation always passes.
+func CheckETag(entityType, entityID, expected, current string) error {
+	if expected == "" || expected == ETagAny || expected == current {
+		return nil
+	}
+	return NewConflictError(fmt.Sprintf("%s %s was modified by another request; reload it and retry", entityType, entityID))
+}
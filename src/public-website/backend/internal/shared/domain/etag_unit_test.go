@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatETag(t *testing.T) {
+	assert.Equal(t, `"7"`, FormatETag("7"))
+	assert.Equal(t, "", FormatETag(""))
+}
+
+func TestParseIfMatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		expected string
+	}{
+		{name: "absent header", header: "", expected: ""},
+		{name: "strong tag", header: `"7"`, expected: "7"},
+		{name: "weak tag", header: `W/"7"`, expected: "7"},
+		{name: "unquoted tag", header: "7", expected: "7"},
+		{name: "first tag of a list", header: `"7", "8"`, expected: "7"},
+		{name: "wildcard", header: "*", expected: ETagAny},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ParseIfMatch(tt.header))
+		})
+	}
+}
+
+func TestCheckETag(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+		current  string
+		conflict bool
+	}{
+		{name: "no expectation", expected: "", current: "3"},
+		{name: "wildcard", expected: ETagAny, current: "3"},
+		{name: "matching version", expected: "3", current: "3"},
+		{name: "stale version", expected: "2", current: "3", conflict: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			err := CheckETag("news article", "news-1", tt.expected, tt.current)
+
+			// Assert
+			if !tt.conflict {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.True(t, IsConflictError(err))
+			assert.Contains(t, err.Error(), "news article news-1 was modified")
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultPageLimit is applied when a caller does not request a page size
+	DefaultPageLimit = 20
+	// MaxPageLimit caps the page size a caller may request
+	MaxPageLimit = 100
+)
+
+// PageCursor identifies a position in a listing ordered by a timestamp sort key
+// (newest first) with the entity ID as a tiebreaker. Cursors are handed to clients
+// as opaque strings produced by EncodeCursor; the fields are never exposed directly.
+type PageCursor struct {
+	Timestamp time.Time `json:"t"`  // Sort key of the last item on the previous page
+	ID        string    `json:"id"` // Entity ID of the last item on the previous page
+}
+
+// Before reports whether the cursor sorts ahead of other in listing order,
+// i.e. it has a later timestamp, or the same timestamp and a smaller ID.
+func (c PageCursor) Before(other PageCursor) bool {
+	if !c.Timestamp.Equal(other.Timestamp) {
+		return c.Timestamp.After(other.Timestamp)
+	}
+	return c.ID < other.ID
+}
+
+// PageRequest describes the page a caller is asking for. A nil After starts
+// from the beginning of the listing.
+type PageRequest struct {
+	After *PageCursor
+	Limit int
+}
+
+// PageInfo describes the page returned to a caller. NextCursor is empty when
+// there are no further items; Total counts every item matching the listing.
+type PageInfo struct {
+	NextCursor string `json:"next_cursor,omitempty"`
+	HasMore    bool   `json:"has_more"`
+	Limit      int    `json:"limit"`
+	Count      int    `json:"count"`
+	Total      int    `json:"total"`
+}
+
+// NewPageRequest builds a PageRequest from raw request input, decoding the
+// cursor and normalising the limit to the allowed range.
+func NewPageRequest(cursor string, limit int) (PageRequest, error) {
+	if limit < 0 {
+		return PageRequest{}, NewValidationFieldError("limit", "limit must not be negative")
+	}
+	if limit == 0 {
+		limit = DefaultPageLimit
+	}
+	if limit > MaxPageLimit {
+		limit = MaxPageLimit
+	}
+
+	page := PageRequest{Limit: limit}
+	if strings.TrimSpace(cursor) == "" {
+		return page, nil
+	}
+
+	after, err := DecodeCursor(cursor)
+	if err != nil {
+		return PageRequest{}, err
+	}
+	page.After = after
+
+	return page, nil
+}
+
+// EncodeCursor converts a cursor into the opaque string handed to clients
+func EncodeCursor(cursor PageCursor) string {
+	payload, err := json.Marshal(struct {
+		Timestamp string `json:"t"`
+		ID        string `json:"id"`
+	}{
+		Timestamp: cursor.Timestamp.UTC().Format(time.RFC3339Nano),
+		ID:        cursor.ID,
+	})
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(payload)
+}
+
+// DecodeCursor parses an opaque cursor string produced by EncodeCursor
+func DecodeCursor(encoded string) (*PageCursor, error) {
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, NewValidationFieldError("cursor", "cursor is malformed")
+	}
+
+	var raw struct {
+		Timestamp string `json:"t"`
+		ID        string `json:"id"`
+	}
+	if err := json.Unmarshal(payload, &raw); err != nil {
+		return nil, NewValidationFieldError("cursor", "cursor is malformed")
+	}
+
+	timestamp, err := time.Parse(time.RFC3339Nano, raw.Timestamp)
+	if err != nil || raw.ID == "" {
+		return nil, NewValidationFieldError("cursor", "cursor is malformed")
+	}
+
+	return &PageCursor{Timestamp: timestamp, ID: raw.ID}, nil
+}
+
+// CursorPage selects the window of an already sorted listing that follows the
+// requested cursor. It returns the half-open range [start, end) to slice from
+// the listing together with the PageInfo describing it.
+func CursorPage(count int, keyAt func(i int) PageCursor, page PageRequest) (int, int, PageInfo) {
+	limit := page.Limit
+	if limit <= 0 {
+		limit = DefaultPageLimit
+	}
+
+	start := 0
+	if page.After != nil {
+		after := *page.After
+		start = sort.Search(count, func(i int) bool {
+			return after.Before(keyAt(i))
+		})
+	}
+
+	end := start + limit
+	if end > count {
+		end = count
+	}
+
+	info := PageInfo{
+		HasMore: end < count,
+		Limit:   limit,
+		Count:   end - start,
+		Total:   count,
+	}
+	if info.HasMore {
+		info.NextCursor = EncodeCursor(keyAt(end - 1))
+	}
+
+	return start, end, info
+}
@@ -0,0 +1,116 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursorEncoding(t *testing.T) {
+	t.Run("round trip preserves timestamp and id", func(t *testing.T) {
+		// Arrange
+		cursor := PageCursor{Timestamp: time.Date(2024, 5, 1, 12, 30, 0, 123, time.UTC), ID: "news-42"}
+
+		// Act
+		decoded, err := DecodeCursor(EncodeCursor(cursor))
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, cursor.Timestamp.Equal(decoded.Timestamp))
+		assert.Equal(t, cursor.ID, decoded.ID)
+	})
+
+	t.Run("reject malformed cursors", func(t *testing.T) {
+		for _, encoded := range []string{"not base64!", "bm90LWpzb24", "e30"} {
+			_, err := DecodeCursor(encoded)
+			require.Error(t, err)
+			assert.True(t, IsValidationError(err))
+		}
+	})
+}
+
+func TestNewPageRequest(t *testing.T) {
+	validCursor := EncodeCursor(PageCursor{Timestamp: time.Now(), ID: "id-1"})
+
+	tests := []struct {
+		name          string
+		cursor        string
+		limit         int
+		expectedLimit int
+		expectAfter   bool
+		expectError   bool
+	}{
+		{name: "default limit", limit: 0, expectedLimit: DefaultPageLimit},
+		{name: "explicit limit", limit: 5, expectedLimit: 5},
+		{name: "limit capped", limit: MaxPageLimit + 50, expectedLimit: MaxPageLimit},
+		{name: "negative limit", limit: -1, expectError: true},
+		{name: "with cursor", cursor: validCursor, limit: 10, expectedLimit: 10, expectAfter: true},
+		{name: "invalid cursor", cursor: "%%%", limit: 10, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			page, err := NewPageRequest(tt.cursor, tt.limit)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedLimit, page.Limit)
+			assert.Equal(t, tt.expectAfter, page.After != nil)
+		})
+	}
+}
+
+func TestCursorPage(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	keys := []PageCursor{
+		{Timestamp: base.Add(3 * time.Hour), ID: "a"},
+		{Timestamp: base.Add(2 * time.Hour), ID: "b"},
+		{Timestamp: base.Add(2 * time.Hour), ID: "c"},
+		{Timestamp: base.Add(time.Hour), ID: "d"},
+		{Timestamp: base, ID: "e"},
+	}
+	keyAt := func(i int) PageCursor { return keys[i] }
+
+	t.Run("walk all pages without gaps or duplicates", func(t *testing.T) {
+		var seen []string
+		page := PageRequest{Limit: 2}
+
+		for {
+			start, end, info := CursorPage(len(keys), keyAt, page)
+			for i := start; i < end; i++ {
+				seen = append(seen, keys[i].ID)
+			}
+			if !info.HasMore {
+				assert.Empty(t, info.NextCursor)
+				break
+			}
+			after, err := DecodeCursor(info.NextCursor)
+			require.NoError(t, err)
+			page.After = after
+		}
+
+		assert.Equal(t, []string{"a", "b", "c", "d", "e"}, seen)
+	})
+
+	t.Run("cursor past the end returns empty page", func(t *testing.T) {
+		after := PageCursor{Timestamp: base.Add(-time.Hour), ID: "z"}
+		start, end, info := CursorPage(len(keys), keyAt, PageRequest{After: &after, Limit: 2})
+		assert.Equal(t, start, end)
+		assert.False(t, info.HasMore)
+		assert.Equal(t, 0, info.Count)
+		assert.Equal(t, len(keys), info.Total)
+	})
+
+	t.Run("cursor for deleted item resumes at next position", func(t *testing.T) {
+		after := PageCursor{Timestamp: base.Add(2 * time.Hour), ID: "bb"}
+		start, _, _ := CursorPage(len(keys), keyAt, PageRequest{After: &after, Limit: 2})
+		assert.Equal(t, "c", keys[start].ID)
+	})
+}
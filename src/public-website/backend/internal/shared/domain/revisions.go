@@ -0,0 +1,170 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Revision is an immutable version of a content entity, recorded each time the
+// entity is saved. The snapshot holds the entity before and after the change
+// that produced the revision, exactly as it was audited.
+type Revision struct {
+	RevisionID    string             `json:"revision_id"`
+	EntityType    EntityType         `json:"entity_type"`
+	EntityID      string             `json:"entity_id"`
+	Number        int                `json:"revision"`
+	OperationType AuditEventType     `json:"operation_type"`
+	UserID        string             `json:"user_id"`
+	CorrelationID string             `json:"correlation_id"`
+	CreatedOn     time.Time          `json:"created_on"`
+	DataSnapshot  *AuditDataSnapshot `json:"data_snapshot,omitempty"`
+}
+
+// FieldChange describes one top-level field that differs between two revisions
+type FieldChange struct {
+	Field  string      `json:"field"`
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// RevisionDiff is the field-level difference between two revisions of an entity
+type RevisionDiff struct {
+	EntityType   EntityType    `json:"entity_type"`
+	EntityID     string        `json:"entity_id"`
+	FromRevision int           `json:"from_revision"`
+	ToRevision   int           `json:"to_revision"`
+	Changes      []FieldChange `json:"changes"`
+}
+
+// NewRevision records the outcome of an audited change as an unnumbered revision.
+// The revision store assigns the number when the revision is appended.
+func NewRevision(event *AuditEvent) *Revision {
+	revision := &Revision{
+		RevisionID:    uuid.New().String(),
+		EntityType:    event.EntityType,
+		EntityID:      event.EntityID,
+		OperationType: event.OperationType,
+		UserID:        event.UserID,
+		CorrelationID: event.CorrelationID,
+		CreatedOn:     event.AuditTime,
+	}
+	if event.DataSnapshot != nil {
+		revision.DataSnapshot = &AuditDataSnapshot{
+			Before: event.DataSnapshot.Before,
+			After:  event.DataSnapshot.After,
+		}
+	}
+	return revision
+}
+
+// ParseRevisionNumber parses a revision number taken from a request, reporting
+// anything other than a positive integer as a validation error on field
+func ParseRevisionNumber(field, raw string) (int, error) {
+	if raw == "" {
+		return 0, NewValidationFieldError(field, field+" is required")
+	}
+	number, err := strconv.Atoi(raw)
+	if err != nil || number < 1 {
+		return 0, NewValidationFieldError(field, field+" must be a positive number")
+	}
+	return number, nil
+}
+
+// IsRevisionedOperation reports whether an audited operation changes the stored
+// entity and therefore produces a revision. Reads are audited but not versioned.
+func IsRevisionedOperation(operationType AuditEventType) bool {
+	return operationType != AuditEventAccess
+}
+
+// Summary returns the revision without its data snapshot, for listings
+func (r *Revision) Summary() *Revision {
+	summary := *r
+	summary.DataSnapshot = nil
+	return &summary
+}
+
+// HasContent reports whether the revision captured the entity after the change.
+// Deletions leave nothing to restore or compare against.
+func (r *Revision) HasContent() bool {
+	return r.DataSnapshot != nil && r.DataSnapshot.After != nil
+}
+
+// DecodeContent decodes the entity as it was after this revision into target
+func (r *Revision) DecodeContent(target interface{}) error {
+	if !r.HasContent() {
+		return NewValidationError(fmt.Sprintf("revision %d of %s %s has no content", r.Number, r.EntityType, r.EntityID))
+	}
+
+	data, err := json.Marshal(r.DataSnapshot.After)
+	if err != nil {
+		return WrapError(err, fmt.Sprintf("failed to encode revision %d of %s %s", r.Number, r.EntityType, r.EntityID))
+	}
+	if err := json.Unmarshal(data, target); err != nil {
+		return WrapError(err, fmt.Sprintf("failed to decode revision %d of %s %s", r.Number, r.EntityType, r.EntityID))
+	}
+	return nil
+}
+
+// DiffRevisions compares the content of two revisions of the same entity field by
+// field, using the JSON field names. A field missing on one side is reported with
+// a nil value on that side. Changes are sorted by field name.
+func DiffRevisions(from, to *Revision) (*RevisionDiff, error) {
+	if from.EntityType != to.EntityType || from.EntityID != to.EntityID {
+		return nil, NewValidationError("revisions belong to different entities")
+	}
+
+	fromFields, err := revisionFields(from)
+	if err != nil {
+		return nil, err
+	}
+	toFields, err := revisionFields(to)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]bool, len(fromFields)+len(toFields))
+	for name := range fromFields {
+		names[name] = true
+	}
+	for name := range toFields {
+		names[name] = true
+	}
+
+	changes := []FieldChange{}
+	for name := range names {
+		before, after := fromFields[name], toFields[name]
+		if !reflect.DeepEqual(before, after) {
+			changes = append(changes, FieldChange{Field: name, Before: before, After: after})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+
+	return &RevisionDiff{
+		EntityType:   to.EntityType,
+		EntityID:     to.EntityID,
+		FromRevision: from.Number,
+		ToRevision:   to.Number,
+		Changes:      changes,
+	}, nil
+}
+
+// revisionFields flattens a revision's content into its top-level JSON fields.
+// A revision without content, such as a deletion, has no fields.
+func revisionFields(revision *Revision) (map[string]interface{}, error) {
+	fields := map[string]interface{}{}
+	if !revision.HasContent() {
+		return fields, nil
+	}
+	if err := revision.DecodeContent(&fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
@@ -0,0 +1,153 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type revisionTestEntity struct {
+	ID    string   `json:"id"`
+	Title string   `json:"title"`
+	Tags  []string `json:"tags,omitempty"`
+}
+
+func newRevisionForTest(number int, operationType AuditEventType, before, after interface{}) *Revision {
+	event := NewAuditEvent(EntityTypeNews, "news-1", operationType, "admin-1")
+	event.SetDataSnapshot(before, after)
+	revision := NewRevision(event)
+	revision.Number = number
+	return revision
+}
+
+func TestNewRevision(t *testing.T) {
+	event := NewAuditEvent(EntityTypeResearch, "research-1", AuditEventUpdate, "admin-1")
+	event.SetDataSnapshot("before", "after")
+
+	revision := NewRevision(event)
+
+	assert.NotEmpty(t, revision.RevisionID)
+	assert.Equal(t, EntityTypeResearch, revision.EntityType)
+	assert.Equal(t, "research-1", revision.EntityID)
+	assert.Equal(t, AuditEventUpdate, revision.OperationType)
+	assert.Equal(t, event.AuditTime, revision.CreatedOn)
+	assert.Equal(t, &AuditDataSnapshot{Before: "before", After: "after"}, revision.DataSnapshot)
+	assert.Nil(t, revision.Summary().DataSnapshot)
+	assert.NotNil(t, revision.DataSnapshot)
+}
+
+func TestParseRevisionNumber(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		expected int
+		wantErr  bool
+	}{
+		{name: "positive number", raw: "3", expected: 3},
+		{name: "missing", raw: "", wantErr: true},
+		{name: "zero", raw: "0", wantErr: true},
+		{name: "not a number", raw: "latest", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			number, err := ParseRevisionNumber("from", tt.raw)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.True(t, IsValidationError(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, number)
+		})
+	}
+}
+
+func TestRevision_DecodeContent(t *testing.T) {
+	t.Run("decode entity after change", func(t *testing.T) {
+		revision := newRevisionForTest(2, AuditEventUpdate, nil, &revisionTestEntity{ID: "news-1", Title: "Final"})
+
+		var entity revisionTestEntity
+		require.NoError(t, revision.DecodeContent(&entity))
+		assert.Equal(t, revisionTestEntity{ID: "news-1", Title: "Final"}, entity)
+	})
+
+	t.Run("reject deletion", func(t *testing.T) {
+		revision := newRevisionForTest(3, AuditEventDelete, &revisionTestEntity{ID: "news-1"}, nil)
+
+		var entity revisionTestEntity
+		err := revision.DecodeContent(&entity)
+		require.Error(t, err)
+		assert.True(t, IsValidationError(err))
+	})
+}
+
+func TestDiffRevisions(t *testing.T) {
+	draft := &revisionTestEntity{ID: "news-1", Title: "Draft"}
+	final := &revisionTestEntity{ID: "news-1", Title: "Final", Tags: []string{"clinic"}}
+
+	tests := []struct {
+		name     string
+		from     *Revision
+		to       *Revision
+		expected []FieldChange
+	}{
+		{
+			name: "changed and added fields",
+			from: newRevisionForTest(1, AuditEventInsert, nil, draft),
+			to:   newRevisionForTest(2, AuditEventUpdate, draft, final),
+			expected: []FieldChange{
+				{Field: "tags", Before: nil, After: []interface{}{"clinic"}},
+				{Field: "title", Before: "Draft", After: "Final"},
+			},
+		},
+		{
+			name: "removed fields",
+			from: newRevisionForTest(2, AuditEventUpdate, draft, final),
+			to:   newRevisionForTest(1, AuditEventInsert, nil, draft),
+			expected: []FieldChange{
+				{Field: "tags", Before: []interface{}{"clinic"}, After: nil},
+				{Field: "title", Before: "Final", After: "Draft"},
+			},
+		},
+		{
+			name:     "identical content",
+			from:     newRevisionForTest(1, AuditEventInsert, nil, draft),
+			to:       newRevisionForTest(2, AuditEventPublish, draft, draft),
+			expected: []FieldChange{},
+		},
+		{
+			name: "deletion has no fields",
+			from: newRevisionForTest(1, AuditEventInsert, nil, draft),
+			to:   newRevisionForTest(2, AuditEventDelete, draft, nil),
+			expected: []FieldChange{
+				{Field: "id", Before: "news-1", After: nil},
+				{Field: "title", Before: "Draft", After: nil},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			diff, err := DiffRevisions(tt.from, tt.to)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.from.Number, diff.FromRevision)
+			assert.Equal(t, tt.to.Number, diff.ToRevision)
+			assert.Equal(t, tt.expected, diff.Changes)
+		})
+	}
+
+	t.Run("reject revisions of different entities", func(t *testing.T) {
+		other := newRevisionForTest(1, AuditEventInsert, nil, draft)
+		other.EntityID = "news-2"
+
+		_, err := DiffRevisions(newRevisionForTest(1, AuditEventInsert, nil, draft), other)
+		require.Error(t, err)
+		assert.True(t, IsValidationError(err))
+	})
+}
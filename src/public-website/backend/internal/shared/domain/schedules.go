@@ -0,0 +1,176 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ScheduleAction is the publishing transition a schedule applies to its content
+type ScheduleAction string
+
+const (
+	ScheduleActionPublish   ScheduleAction = "publish"
+	ScheduleActionUnpublish ScheduleAction = "unpublish"
+)
+
+// ScheduleStatus tracks a schedule from creation until its transition has fired
+type ScheduleStatus string
+
+const (
+	ScheduleStatusPending   ScheduleStatus = "pending"
+	ScheduleStatusRunning   ScheduleStatus = "running"
+	ScheduleStatusCompleted ScheduleStatus = "completed"
+	ScheduleStatusCancelled ScheduleStatus = "cancelled"
+	ScheduleStatusFailed    ScheduleStatus = "failed"
+)
+
+const (
+	// ScheduleMaxAttempts is how often a transition is tried before the schedule fails
+	ScheduleMaxAttempts = 5
+	// scheduleRetryBackoff is the delay before the first retry; it doubles per attempt
+	scheduleRetryBackoff = time.Minute
+)
+
+// PublicationSchedule is a publishing transition of one content entity planned for a
+// future time. An embargo schedule additionally keeps the content from being
+// published by hand before RunAt.
+type PublicationSchedule struct {
+	ScheduleID     string         `json:"schedule_id"`
+	EntityType     EntityType     `json:"entity_type"`
+	EntityID       string         `json:"entity_id"`
+	Action         ScheduleAction `json:"action"`
+	RunAt          time.Time      `json:"run_at"`
+	Embargo        bool           `json:"embargo"`
+	Status         ScheduleStatus `json:"status"`
+	Attempts       int            `json:"attempts"`
+	NextAttemptAt  time.Time      `json:"next_attempt_at"`
+	LeaseExpiresAt *time.Time     `json:"lease_expires_at,omitempty"`
+	LastError      string         `json:"last_error,omitempty"`
+	CreatedOn      time.Time      `json:"created_on"`
+	CreatedBy      string         `json:"created_by"`
+	CompletedOn    *time.Time     `json:"completed_on,omitempty"`
+	CancelledOn    *time.Time     `json:"cancelled_on,omitempty"`
+	CancelledBy    string         `json:"cancelled_by,omitempty"`
+
+	// Optimistic concurrency version from the state store
+	ETag string `json:"-"`
+}
+
+// IsValid reports whether the action is a supported publishing transition
+func (a ScheduleAction) IsValid() bool {
+	switch a {
+	case ScheduleActionPublish, ScheduleActionUnpublish:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsValid reports whether the status is a known schedule status
+func (s ScheduleStatus) IsValid() bool {
+	switch s {
+	case ScheduleStatusPending, ScheduleStatusRunning, ScheduleStatusCompleted, ScheduleStatusCancelled, ScheduleStatusFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// NewPublicationSchedule plans action on an entity at runAt, which must lie after now
+func NewPublicationSchedule(entityType EntityType, entityID string, action ScheduleAction, runAt time.Time, embargo bool, userID string, now time.Time) (*PublicationSchedule, error) {
+	if entityType == "" {
+		return nil, NewValidationFieldError("entity_type", "entity_type is required")
+	}
+	if entityID == "" {
+		return nil, NewValidationFieldError("entity_id", "entity_id is required")
+	}
+	if !action.IsValid() {
+		return nil, NewValidationFieldError("action", fmt.Sprintf("action %q is not supported", action))
+	}
+	if runAt.IsZero() {
+		return nil, NewValidationFieldError("run_at", "run_at is required")
+	}
+	if !runAt.After(now) {
+		return nil, NewValidationFieldError("run_at", "run_at must be in the future")
+	}
+	if embargo && action != ScheduleActionPublish {
+		return nil, NewValidationFieldError("embargo", "only publish schedules can embargo content")
+	}
+
+	runAt = runAt.UTC()
+	return &PublicationSchedule{
+		ScheduleID:    uuid.New().String(),
+		EntityType:    entityType,
+		EntityID:      entityID,
+		Action:        action,
+		RunAt:         runAt,
+		Embargo:       embargo,
+		Status:        ScheduleStatusPending,
+		NextAttemptAt: runAt,
+		CreatedOn:     now.UTC(),
+		CreatedBy:     userID,
+	}, nil
+}
+
+// IsActive reports whether the schedule's transition has yet to fire
+func (s *PublicationSchedule) IsActive() bool {
+	return s.Status == ScheduleStatusPending || s.Status == ScheduleStatusRunning
+}
+
+// IsDue reports whether the transition should be fired at now. A running schedule
+// is due again once its lease expires, which happens when the worker that claimed
+// it stopped before recording the outcome.
+func (s *PublicationSchedule) IsDue(now time.Time) bool {
+	switch s.Status {
+	case ScheduleStatusPending:
+		return !now.Before(s.NextAttemptAt)
+	case ScheduleStatusRunning:
+		return s.LeaseExpiresAt != nil && !now.Before(*s.LeaseExpiresAt)
+	default:
+		return false
+	}
+}
+
+// Claim marks the schedule as being fired by a worker until the lease expires
+func (s *PublicationSchedule) Claim(now time.Time, lease time.Duration) {
+	expires := now.Add(lease).UTC()
+	s.Status = ScheduleStatusRunning
+	s.Attempts++
+	s.LeaseExpiresAt = &expires
+}
+
+// Complete records that the transition fired
+func (s *PublicationSchedule) Complete(now time.Time) {
+	completed := now.UTC()
+	s.Status = ScheduleStatusCompleted
+	s.CompletedOn = &completed
+	s.LeaseExpiresAt = nil
+	s.LastError = ""
+}
+
+// Fail records a failed attempt. The schedule is retried with exponential backoff
+// until ScheduleMaxAttempts attempts have been made, after which it fails for good.
+func (s *PublicationSchedule) Fail(cause error, now time.Time) {
+	s.LeaseExpiresAt = nil
+	s.LastError = cause.Error()
+	if s.Attempts >= ScheduleMaxAttempts {
+		s.Status = ScheduleStatusFailed
+		return
+	}
+	s.Status = ScheduleStatusPending
+	s.NextAttemptAt = now.Add(scheduleRetryBackoff * time.Duration(1<<uint(s.Attempts-1))).UTC()
+}
+
+// Cancel withdraws a schedule that has not started firing
+func (s *PublicationSchedule) Cancel(userID string, now time.Time) error {
+	if s.Status != ScheduleStatusPending {
+		return NewConflictError(fmt.Sprintf("schedule %s is %s and can no longer be cancelled", s.ScheduleID, s.Status))
+	}
+	cancelled := now.UTC()
+	s.Status = ScheduleStatusCancelled
+	s.CancelledOn = &cancelled
+	s.CancelledBy = userID
+	return nil
+}
@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPublicationSchedule(t *testing.T) {
+	now := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	later := now.Add(2 * time.Hour)
+
+	tests := []struct {
+		name       string
+		entityType EntityType
+		entityID   string
+		action     ScheduleAction
+		runAt      time.Time
+		embargo    bool
+		wantField  string
+	}{
+		{name: "publish in the future", entityType: EntityTypeNews, entityID: "news-1", action: ScheduleActionPublish, runAt: later},
+		{name: "embargoed publish", entityType: EntityTypeResearch, entityID: "research-1", action: ScheduleActionPublish, runAt: later, embargo: true},
+		{name: "unpublish in the future", entityType: EntityTypeEvent, entityID: "event-1", action: ScheduleActionUnpublish, runAt: later},
+		{name: "missing entity", entityType: EntityTypeNews, action: ScheduleActionPublish, runAt: later, wantField: "entity_id"},
+		{name: "unknown action", entityType: EntityTypeNews, entityID: "news-1", action: "feature", runAt: later, wantField: "action"},
+		{name: "missing run time", entityType: EntityTypeNews, entityID: "news-1", action: ScheduleActionPublish, wantField: "run_at"},
+		{name: "run time in the past", entityType: EntityTypeNews, entityID: "news-1", action: ScheduleActionPublish, runAt: now.Add(-time.Minute), wantField: "run_at"},
+		{name: "embargoed unpublish", entityType: EntityTypeNews, entityID: "news-1", action: ScheduleActionUnpublish, runAt: later, embargo: true, wantField: "embargo"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			schedule, err := NewPublicationSchedule(tt.entityType, tt.entityID, tt.action, tt.runAt, tt.embargo, "admin-1", now)
+
+			// Assert
+			if tt.wantField != "" {
+				require.Error(t, err)
+				assert.True(t, IsValidationError(err))
+				assert.Contains(t, err.Error(), tt.wantField)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEmpty(t, schedule.ScheduleID)
+			assert.Equal(t, ScheduleStatusPending, schedule.Status)
+			assert.Equal(t, tt.runAt, schedule.NextAttemptAt)
+			assert.Equal(t, tt.embargo, schedule.Embargo)
+			assert.Equal(t, "admin-1", schedule.CreatedBy)
+		})
+	}
+}
+
+func TestPublicationSchedule_Lifecycle(t *testing.T) {
+	now := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	runAt := now.Add(time.Hour)
+
+	newSchedule := func(t *testing.T) *PublicationSchedule {
+		schedule, err := NewPublicationSchedule(EntityTypeNews, "news-1", ScheduleActionPublish, runAt, false, "admin-1", now)
+		require.NoError(t, err)
+		return schedule
+	}
+
+	t.Run("due once the run time is reached", func(t *testing.T) {
+		schedule := newSchedule(t)
+
+		assert.False(t, schedule.IsDue(runAt.Add(-time.Second)))
+		assert.True(t, schedule.IsDue(runAt))
+	})
+
+	t.Run("claimed schedule is due again after its lease expires", func(t *testing.T) {
+		schedule := newSchedule(t)
+		schedule.Claim(runAt, 2*time.Minute)
+
+		assert.Equal(t, ScheduleStatusRunning, schedule.Status)
+		assert.Equal(t, 1, schedule.Attempts)
+		assert.False(t, schedule.IsDue(runAt.Add(time.Minute)))
+		assert.True(t, schedule.IsDue(runAt.Add(2*time.Minute)))
+	})
+
+	t.Run("failed attempt is retried with backoff", func(t *testing.T) {
+		schedule := newSchedule(t)
+		schedule.Claim(runAt, time.Minute)
+		schedule.Fail(errors.New("state store unavailable"), runAt)
+		schedule.Claim(runAt.Add(time.Minute), time.Minute)
+		schedule.Fail(errors.New("state store unavailable"), runAt.Add(time.Minute))
+
+		assert.Equal(t, ScheduleStatusPending, schedule.Status)
+		assert.Equal(t, "state store unavailable", schedule.LastError)
+		assert.Equal(t, runAt.Add(3*time.Minute), schedule.NextAttemptAt)
+		assert.Nil(t, schedule.LeaseExpiresAt)
+	})
+
+	t.Run("fails for good after the last attempt", func(t *testing.T) {
+		schedule := newSchedule(t)
+		for attempt := 0; attempt < ScheduleMaxAttempts; attempt++ {
+			schedule.Claim(schedule.NextAttemptAt, time.Minute)
+			schedule.Fail(errors.New("news not found"), schedule.NextAttemptAt)
+		}
+
+		assert.Equal(t, ScheduleStatusFailed, schedule.Status)
+		assert.False(t, schedule.IsActive())
+		assert.False(t, schedule.IsDue(runAt.Add(24*time.Hour)))
+	})
+
+	t.Run("complete clears the lease", func(t *testing.T) {
+		schedule := newSchedule(t)
+		schedule.Claim(runAt, time.Minute)
+		schedule.Complete(runAt.Add(time.Second))
+
+		assert.Equal(t, ScheduleStatusCompleted, schedule.Status)
+		require.NotNil(t, schedule.CompletedOn)
+		assert.Nil(t, schedule.LeaseExpiresAt)
+		assert.False(t, schedule.IsActive())
+	})
+
+	t.Run("cancel pending schedule", func(t *testing.T) {
+		schedule := newSchedule(t)
+
+		require.NoError(t, schedule.Cancel("admin-2", now))
+		assert.Equal(t, ScheduleStatusCancelled, schedule.Status)
+		assert.Equal(t, "admin-2", schedule.CancelledBy)
+		assert.False(t, schedule.IsDue(runAt))
+	})
+
+	t.Run("reject cancelling a schedule that has started firing", func(t *testing.T) {
+		schedule := newSchedule(t)
+		schedule.Claim(runAt, time.Minute)
+
+		err := schedule.Cancel("admin-2", runAt)
+		require.Error(t, err)
+		assert.True(t, IsConflictError(err))
+	})
+}
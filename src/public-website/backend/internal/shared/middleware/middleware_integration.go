@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/ratelimit"
 	"github.com/gorilla/mux"
 )
 
@@ -91,17 +92,16 @@ func SecurityHeadersMiddleware() mux.MiddlewareFunc {
 	})
 }
 
-// RateLimitingMiddleware creates a simple rate limiting middleware
+// RateLimitingMiddleware limits each client address to requestsPerMinute. Counters are
+// kept in process; the gateways share theirs between replicas through the state store.
 func RateLimitingMiddleware(requestsPerMinute int) mux.MiddlewareFunc {
-	// In production, use a proper rate limiting implementation
-	// This is a simplified example
-	return mux.MiddlewareFunc(func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			// TODO: Implement actual rate limiting logic
-			// For now, just pass through
-			next.ServeHTTP(w, r)
-		})
-	})
+	limiter := ratelimit.NewLimiter(ratelimit.NewMemoryStore())
+	rule := ratelimit.Rule{
+		Name:  "api",
+		Limit: ratelimit.PerMinute(requestsPerMinute, 0),
+		Key:   ratelimit.ClientIPKey(nil),
+	}
+	return mux.MiddlewareFunc(limiter.Middleware(ratelimit.FixedRule(rule), nil))
 }
 
 // LoggingMiddleware creates structured logging middleware
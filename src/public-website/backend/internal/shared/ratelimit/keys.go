@@ -0,0 +1,191 @@
+package ratelimit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/auth"
+)
+
+// KeyFunc derives the key a request is counted under. It returns false when the request
+// carries nothing the function keys on.
+type KeyFunc func(r *http.Request) (string, bool)
+
+// TrustedProxies lists the proxies whose X-Forwarded-For header is believed
+type TrustedProxies struct {
+	networks []*net.IPNet
+}
+
+// ParseTrustedProxies parses proxy addresses given as CIDR ranges or single IPs
+func ParseTrustedProxies(proxies []string) (*TrustedProxies, error) {
+	trusted := &TrustedProxies{}
+	for _, proxy := range proxies {
+		proxy = strings.TrimSpace(proxy)
+		if proxy == "" {
+			continue
+		}
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy address: %s", proxy)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			trusted.networks = append(trusted.networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, network, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy range %s: %w", proxy, err)
+		}
+		trusted.networks = append(trusted.networks, network)
+	}
+	return trusted, nil
+}
+
+// Contains reports whether ip belongs to a trusted proxy
+func (p *TrustedProxies) Contains(ip net.IP) bool {
+	if p == nil || ip == nil {
+		return false
+	}
+	for _, network := range p.networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientIP returns the address of the client that sent the request. X-Forwarded-For is
+// only read when the connection comes from a trusted proxy, and then from the right,
+// skipping further trusted proxies, because anything left of them was written by the
+// client and can be forged.
+func (p *TrustedProxies) ClientIP(r *http.Request) net.IP {
+	peer := parseHostIP(r.RemoteAddr)
+	if !p.Contains(peer) {
+		return peer
+	}
+
+	hops := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	client := peer
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := parseHostIP(strings.TrimSpace(hops[i]))
+		if hop == nil {
+			break
+		}
+		client = hop
+		if !p.Contains(hop) {
+			break
+		}
+	}
+	return client
+}
+
+// parseHostIP parses an address with or without a port
+func parseHostIP(address string) net.IP {
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		address = host
+	}
+	return net.ParseIP(strings.Trim(address, "[]"))
+}
+
+// ClientIPKey keys requests on the client address, never including the port, so every
+// connection from one client shares a counter
+func ClientIPKey(trusted *TrustedProxies) KeyFunc {
+	return func(r *http.Request) (string, bool) {
+		if ip := trusted.ClientIP(r); ip != nil {
+			return "ip:" + ip.String(), true
+		}
+		if r.RemoteAddr == "" {
+			return "", false
+		}
+		return "ip:" + r.RemoteAddr, true
+	}
+}
+
+// UserKey keys requests on the authenticated principal. It relies on the policy
+// middleware having run, so it never trusts a user ID sent by the client.
+func UserKey() KeyFunc {
+	return func(r *http.Request) (string, bool) {
+		principal, ok := auth.PrincipalFromContext(r.Context())
+		if !ok || principal.UserID == "" {
+			return "", false
+		}
+		return "user:" + principal.UserID, true
+	}
+}
+
+// APIKeys holds the SHA-256 digests of the API keys that have been issued, so the keys
+// themselves never appear in configuration
+type APIKeys struct {
+	digests map[[sha256.Size]byte]bool
+}
+
+// ParseAPIKeyDigests parses hex encoded SHA-256 digests of issued API keys
+func ParseAPIKeyDigests(digests []string) (*APIKeys, error) {
+	keys := &APIKeys{digests: make(map[[sha256.Size]byte]bool, len(digests))}
+	for _, encoded := range digests {
+		encoded = strings.TrimSpace(encoded)
+		if encoded == "" {
+			continue
+		}
+		decoded, err := hex.DecodeString(encoded)
+		if err != nil || len(decoded) != sha256.Size {
+			return nil, fmt.Errorf("invalid API key digest: %q is not a hex encoded SHA-256 digest", encoded)
+		}
+		var digest [sha256.Size]byte
+		copy(digest[:], decoded)
+		keys.digests[digest] = true
+	}
+	return keys, nil
+}
+
+// Len returns the number of issued keys
+func (k *APIKeys) Len() int {
+	if k == nil {
+		return 0
+	}
+	return len(k.digests)
+}
+
+// contains reports whether digest belongs to an issued key
+func (k *APIKeys) contains(digest [sha256.Size]byte) bool {
+	return k != nil && k.digests[digest]
+}
+
+// APIKeyKey keys requests on the API key in header when it is one of the issued keys.
+// Anyone can send a header, so an unknown key would let a client pick a fresh counter
+// per request; those requests are left to the next key function instead. Only a digest
+// of the key is used, so keys never reach the counter store.
+func APIKeyKey(header string, issued *APIKeys) KeyFunc {
+	return func(r *http.Request) (string, bool) {
+		apiKey := strings.TrimSpace(r.Header.Get(header))
+		if apiKey == "" {
+			return "", false
+		}
+		digest := sha256.Sum256([]byte(apiKey))
+		if !issued.contains(digest) {
+			return "", false
+		}
+		return "key:" + hex.EncodeToString(digest[:16]), true
+	}
+}
+
+// FirstKey uses the first of keys that applies to the request, e.g. the user with the
+// client address as a fallback for anonymous requests
+func FirstKey(keys ...KeyFunc) KeyFunc {
+	return func(r *http.Request) (string, bool) {
+		for _, key := range keys {
+			if value, ok := key(r); ok {
+				return value, true
+			}
+		}
+		return "", false
+	}
+}
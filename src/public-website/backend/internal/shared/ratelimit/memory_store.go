@@ -0,0 +1,89 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+)
+
+// MemoryStore keeps counters in process. Replicas using it limit independently, so it
+// suits a single instance, tests and the fallback when no state store is configured.
+type MemoryStore struct {
+	mutex   sync.Mutex
+	entries map[string]memoryEntry
+	version uint64
+	now     func() time.Time
+}
+
+type memoryEntry struct {
+	state     State
+	version   string
+	expiresAt time.Time
+}
+
+// NewMemoryStore creates an empty in-process counter store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		entries: make(map[string]memoryEntry),
+		now:     time.Now,
+	}
+}
+
+// Load returns the live counter for key
+func (s *MemoryStore) Load(ctx context.Context, key string) (*State, string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	entry, ok := s.live(key)
+	if !ok {
+		return nil, "", nil
+	}
+	state := entry.state
+	return &state, entry.version, nil
+}
+
+// Save writes the counter for key when version matches the one held
+func (s *MemoryStore) Save(ctx context.Context, key string, state *State, version string, ttl time.Duration) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	entry, ok := s.live(key)
+	if (ok && entry.version != version) || (!ok && version != "") {
+		return domain.NewConflictError(fmt.Sprintf("rate limit counter %s was changed concurrently", key))
+	}
+
+	s.version++
+	s.entries[key] = memoryEntry{
+		state:     *state,
+		version:   strconv.FormatUint(s.version, 10),
+		expiresAt: s.now().Add(ttl),
+	}
+	s.sweep()
+	return nil
+}
+
+// live returns the entry for key unless it has expired
+func (s *MemoryStore) live(key string) (memoryEntry, bool) {
+	entry, ok := s.entries[key]
+	if !ok || !s.now().Before(entry.expiresAt) {
+		return memoryEntry{}, false
+	}
+	return entry, true
+}
+
+// sweep drops expired counters now and then, so clients seen once do not accumulate
+func (s *MemoryStore) sweep() {
+	if s.version%1024 != 0 {
+		return
+	}
+	now := s.now()
+	for key, entry := range s.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(s.entries, key)
+		}
+	}
+}
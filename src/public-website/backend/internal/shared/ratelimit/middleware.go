@@ -0,0 +1,105 @@
+package ratelimit
+
+import (
+	"encoding/json"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+)
+
+// Rule applies a limit to requests, counting them per key. Name keeps the counters of
+// rules with different limits apart.
+type Rule struct {
+	Name  string
+	Limit Limit
+	Key   KeyFunc
+}
+
+// Resolver picks the rule for a request, returning false to leave it unlimited
+type Resolver func(r *http.Request) (Rule, bool)
+
+// LimitedHandler writes the response for a request that exceeded its limit. The rate
+// limit headers are already set when it runs.
+type LimitedHandler func(w http.ResponseWriter, r *http.Request, decision Decision)
+
+// FixedRule resolves every request to rule
+func FixedRule(rule Rule) Resolver {
+	return func(r *http.Request) (Rule, bool) {
+		return rule, true
+	}
+}
+
+// Middleware enforces the rule resolved for each request and sets the RateLimit-Limit,
+// RateLimit-Remaining, RateLimit-Reset and RateLimit-Policy headers, plus Retry-After
+// on rejected requests. When the counter store fails the request is let through: an
+// outage of the store should not take the gateway down with it. A nil onLimited
+// writes a JSON RATE_LIMIT_EXCEEDED error.
+func (l *Limiter) Middleware(resolve Resolver, onLimited LimitedHandler) func(http.Handler) http.Handler {
+	if onLimited == nil {
+		onLimited = WriteLimitedResponse
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rule, ok := resolve(r)
+			if !ok || rule.Key == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			key, ok := rule.Key(r)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			decision, err := l.Allow(r.Context(), rule.Name+"|"+key, rule.Limit)
+			if err != nil {
+				log.Printf("Rate limiting skipped for %s %s: %v", r.Method, r.URL.Path, err)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			SetHeaders(w.Header(), rule.Limit, decision)
+			if !decision.Allowed {
+				onLimited(w, r, decision)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// SetHeaders sets the standard rate limit headers describing decision
+func SetHeaders(header http.Header, limit Limit, decision Decision) {
+	header.Set("RateLimit-Limit", strconv.Itoa(decision.Limit))
+	header.Set("RateLimit-Remaining", strconv.Itoa(decision.Remaining))
+	header.Set("RateLimit-Reset", strconv.Itoa(wholeSeconds(decision.Reset)))
+	header.Set("RateLimit-Policy", limit.Policy())
+	if !decision.Allowed {
+		// Never ask a client to retry immediately, it would only be rejected again
+		header.Set("Retry-After", strconv.Itoa(max(1, wholeSeconds(decision.RetryAfter))))
+	}
+}
+
+// WriteLimitedResponse writes a 429 response with a RATE_LIMIT_EXCEEDED error
+func WriteLimitedResponse(w http.ResponseWriter, r *http.Request, decision Decision) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusTooManyRequests)
+
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":           "RATE_LIMIT_EXCEEDED",
+			"message":        "Rate limit exceeded, retry after " + w.Header().Get("Retry-After") + " seconds",
+			"correlation_id": domain.GetCorrelationID(r.Context()),
+		},
+	})
+}
+
+// wholeSeconds rounds a duration up to whole seconds, as the headers carry
+func wholeSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,243 @@
+// Package ratelimit limits how often a client may call the gateways. Counters live in
+// a Store so that every replica of a gateway enforces the same limit.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+)
+
+// Algorithm selects how requests are counted against a limit
+type Algorithm string
+
+const (
+	// TokenBucket refills a bucket of Burst tokens at Requests per Window, allowing
+	// short bursts above the steady rate
+	TokenBucket Algorithm = "token_bucket"
+	// SlidingWindow weights the previous fixed window by how much of it still overlaps
+	// the last Window, smoothing the edge between windows
+	SlidingWindow Algorithm = "sliding_window"
+)
+
+// maxUpdateAttempts bounds the retries when replicas race to update the same counter
+const maxUpdateAttempts = 5
+
+// Limit is the number of requests a key may make per window
+type Limit struct {
+	Requests  int
+	Window    time.Duration
+	Burst     int
+	Algorithm Algorithm
+}
+
+// PerMinute returns a token bucket limit of requests per minute with the given burst
+func PerMinute(requests, burst int) Limit {
+	return Limit{Requests: requests, Window: time.Minute, Burst: burst, Algorithm: TokenBucket}
+}
+
+// Validate checks that the limit can be enforced
+func (l Limit) Validate() error {
+	if l.Requests <= 0 {
+		return fmt.Errorf("invalid requests per window: %d", l.Requests)
+	}
+	if l.Window <= 0 {
+		return fmt.Errorf("invalid window: %s", l.Window)
+	}
+	if l.Burst < 0 {
+		return fmt.Errorf("invalid burst size: %d", l.Burst)
+	}
+	switch l.Algorithm {
+	case "", TokenBucket, SlidingWindow:
+		return nil
+	default:
+		return fmt.Errorf("invalid rate limit algorithm: %s", l.Algorithm)
+	}
+}
+
+// Policy describes the limit in the RateLimit-Policy header format, e.g. "100;w=60;burst=20"
+func (l Limit) Policy() string {
+	policy := strconv.Itoa(l.Requests) + ";w=" + strconv.Itoa(int(math.Ceil(l.Window.Seconds())))
+	if l.algorithm() == TokenBucket {
+		policy += ";burst=" + strconv.Itoa(l.capacity())
+	}
+	return policy
+}
+
+func (l Limit) algorithm() Algorithm {
+	if l.Algorithm == "" {
+		return TokenBucket
+	}
+	return l.Algorithm
+}
+
+// capacity is the size of the token bucket, which is the steady rate when no burst is set
+func (l Limit) capacity() int {
+	if l.Burst > 0 {
+		return l.Burst
+	}
+	return l.Requests
+}
+
+// refillRate is the number of tokens added to the bucket per second
+func (l Limit) refillRate() float64 {
+	return float64(l.Requests) / l.Window.Seconds()
+}
+
+// retention is how long a counter must outlive its last update. A bucket left alone
+// that long is full again and a window that old no longer counts, so expiring it
+// changes nothing.
+func (l Limit) retention() time.Duration {
+	if l.algorithm() == SlidingWindow {
+		return 2 * l.Window
+	}
+	fill := time.Duration(float64(l.capacity()) / l.refillRate() * float64(time.Second))
+	return fill + time.Second
+}
+
+// Decision is the outcome of counting one request
+type Decision struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	Reset      time.Duration
+	RetryAfter time.Duration
+}
+
+// State is the counter kept for a key. Token buckets use Tokens, sliding windows use
+// the window fields.
+type State struct {
+	Tokens        float64   `json:"tokens,omitempty"`
+	WindowStart   time.Time `json:"window_start,omitempty"`
+	Count         int       `json:"count,omitempty"`
+	PreviousCount int       `json:"previous_count,omitempty"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// Store keeps counters shared by every limiter using it
+type Store interface {
+	// Load returns the state held for key with the version to save it back with, or
+	// nil and an empty version when there is none
+	Load(ctx context.Context, key string) (*State, string, error)
+	// Save writes state for key provided its version still matches, creating the key
+	// when version is empty. A lost race returns a domain conflict error.
+	Save(ctx context.Context, key string, state *State, version string, ttl time.Duration) error
+}
+
+// Limiter counts requests against limits
+type Limiter struct {
+	store Store
+	now   func() time.Time
+}
+
+// NewLimiter creates a limiter that keeps its counters in store
+func NewLimiter(store Store) *Limiter {
+	return &Limiter{store: store, now: time.Now}
+}
+
+// Allow counts a request by key against limit. Only allowed requests are recorded, so
+// a client that keeps retrying while limited does not push its own reset further out.
+func (l *Limiter) Allow(ctx context.Context, key string, limit Limit) (Decision, error) {
+	if err := limit.Validate(); err != nil {
+		return Decision{}, domain.NewValidationError(err.Error())
+	}
+
+	var lastErr error
+	for attempt := 0; attempt < maxUpdateAttempts; attempt++ {
+		state, version, err := l.store.Load(ctx, key)
+		if err != nil {
+			return Decision{}, fmt.Errorf("failed to load rate limit counter: %w", err)
+		}
+
+		now := l.now().UTC()
+		var next *State
+		var decision Decision
+		if limit.algorithm() == SlidingWindow {
+			next, decision = slideWindow(state, limit, now)
+		} else {
+			next, decision = takeToken(state, limit, now)
+		}
+		if !decision.Allowed {
+			return decision, nil
+		}
+
+		err = l.store.Save(ctx, key, next, version, limit.retention())
+		if err == nil {
+			return decision, nil
+		}
+		if !domain.IsConflictError(err) {
+			return Decision{}, fmt.Errorf("failed to save rate limit counter: %w", err)
+		}
+		lastErr = err
+	}
+
+	return Decision{}, fmt.Errorf("rate limit counter for %s kept changing: %w", key, lastErr)
+}
+
+// takeToken refills the bucket for the time since it was last used and takes a token
+func takeToken(state *State, limit Limit, now time.Time) (*State, Decision) {
+	capacity := float64(limit.capacity())
+	rate := limit.refillRate()
+
+	tokens := capacity
+	if state != nil && !state.UpdatedAt.IsZero() {
+		elapsed := math.Max(0, now.Sub(state.UpdatedAt).Seconds())
+		tokens = math.Min(capacity, state.Tokens+elapsed*rate)
+	}
+
+	decision := Decision{Limit: limit.capacity()}
+	if tokens >= 1 {
+		tokens--
+		decision.Allowed = true
+	} else {
+		decision.RetryAfter = seconds((1 - tokens) / rate)
+	}
+	decision.Remaining = int(math.Floor(tokens))
+	decision.Reset = seconds((capacity - tokens) / rate)
+
+	return &State{Tokens: tokens, UpdatedAt: now}, decision
+}
+
+// slideWindow estimates the requests made in the last window from the current and
+// previous fixed windows and counts the request when it fits
+func slideWindow(state *State, limit Limit, now time.Time) (*State, Decision) {
+	windowStart := now.Truncate(limit.Window)
+
+	count, previous := 0, 0
+	if state != nil {
+		switch {
+		case state.WindowStart.Equal(windowStart):
+			count, previous = state.Count, state.PreviousCount
+		case state.WindowStart.Equal(windowStart.Add(-limit.Window)):
+			previous = state.Count
+		}
+	}
+
+	elapsed := now.Sub(windowStart)
+	overlap := 1 - elapsed.Seconds()/limit.Window.Seconds()
+	estimate := float64(previous)*overlap + float64(count)
+
+	decision := Decision{Limit: limit.Requests, Reset: limit.Window - elapsed}
+	if estimate+1 <= float64(limit.Requests) {
+		count++
+		estimate++
+		decision.Allowed = true
+	} else if count < limit.Requests && previous > 0 {
+		// Wait until enough of the previous window has slid out for one more request
+		needed := limit.Window.Seconds() * (1 - float64(limit.Requests-count-1)/float64(previous))
+		decision.RetryAfter = seconds(needed - elapsed.Seconds())
+	} else {
+		decision.RetryAfter = decision.Reset
+	}
+	decision.Remaining = max(0, limit.Requests-int(math.Ceil(estimate)))
+
+	return &State{WindowStart: windowStart, Count: count, PreviousCount: previous, UpdatedAt: now}, decision
+}
+
+func seconds(value float64) time.Duration {
+	return time.Duration(math.Max(0, value) * float64(time.Second))
+}
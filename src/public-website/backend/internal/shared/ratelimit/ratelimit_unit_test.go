@@ -0,0 +1,391 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/auth"
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testClock struct{ now time.Time }
+
+func (c *testClock) Now() time.Time                 { return c.now }
+func (c *testClock) Advance(duration time.Duration) { c.now = c.now.Add(duration) }
+
+// newTestLimiters returns two limiters sharing one store, standing in for two replicas
+func newTestLimiters() (*Limiter, *Limiter, *testClock) {
+	clock := &testClock{now: time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)}
+	store := NewMemoryStore()
+	store.now = clock.Now
+
+	first, second := NewLimiter(store), NewLimiter(store)
+	first.now, second.now = clock.Now, clock.Now
+	return first, second, clock
+}
+
+// racingStore loses the first saves to a concurrent writer
+type racingStore struct {
+	*MemoryStore
+	conflicts int
+}
+
+func (s *racingStore) Save(ctx context.Context, key string, state *State, version string, ttl time.Duration) error {
+	if s.conflicts > 0 {
+		s.conflicts--
+		return domain.NewConflictError("counter changed")
+	}
+	return s.MemoryStore.Save(ctx, key, state, version, ttl)
+}
+
+func TestLimitValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		limit   Limit
+		wantErr bool
+	}{
+		{name: "token bucket", limit: PerMinute(100, 20)},
+		{name: "sliding window", limit: Limit{Requests: 10, Window: time.Second, Algorithm: SlidingWindow}},
+		{name: "no requests", limit: PerMinute(0, 20), wantErr: true},
+		{name: "no window", limit: Limit{Requests: 10}, wantErr: true},
+		{name: "negative burst", limit: PerMinute(10, -1), wantErr: true},
+		{name: "unknown algorithm", limit: Limit{Requests: 10, Window: time.Minute, Algorithm: "leaky"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			err := tt.limit.Validate()
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTokenBucket(t *testing.T) {
+	ctx := context.Background()
+	limit := PerMinute(60, 3)
+
+	t.Run("allows a burst then refills at the steady rate", func(t *testing.T) {
+		// Arrange
+		limiter, _, clock := newTestLimiters()
+
+		// Act
+		var burst []Decision
+		for i := 0; i < 4; i++ {
+			decision, err := limiter.Allow(ctx, "ip:192.0.2.1", limit)
+			require.NoError(t, err)
+			burst = append(burst, decision)
+		}
+		clock.Advance(time.Second)
+		refilled, err := limiter.Allow(ctx, "ip:192.0.2.1", limit)
+		require.NoError(t, err)
+
+		// Assert
+		assert.True(t, burst[0].Allowed)
+		assert.Equal(t, 3, burst[0].Limit)
+		assert.Equal(t, 2, burst[0].Remaining)
+		assert.True(t, burst[2].Allowed)
+		assert.Equal(t, 0, burst[2].Remaining)
+		assert.False(t, burst[3].Allowed)
+		assert.Equal(t, time.Second, burst[3].RetryAfter)
+		assert.Equal(t, 3*time.Second, burst[3].Reset)
+		assert.True(t, refilled.Allowed)
+	})
+
+	t.Run("replicas sharing a store share the bucket", func(t *testing.T) {
+		// Arrange
+		first, second, _ := newTestLimiters()
+
+		// Act
+		for i := 0; i < 3; i++ {
+			_, err := first.Allow(ctx, "user:1", limit)
+			require.NoError(t, err)
+		}
+		decision, err := second.Allow(ctx, "user:1", limit)
+		require.NoError(t, err)
+		other, err := second.Allow(ctx, "user:2", limit)
+		require.NoError(t, err)
+
+		// Assert
+		assert.False(t, decision.Allowed)
+		assert.True(t, other.Allowed)
+	})
+
+	t.Run("retries when another replica updated the counter", func(t *testing.T) {
+		// Arrange
+		store := &racingStore{MemoryStore: NewMemoryStore(), conflicts: 2}
+		limiter := NewLimiter(store)
+
+		// Act
+		decision, err := limiter.Allow(ctx, "ip:192.0.2.1", limit)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, decision.Allowed)
+	})
+
+	t.Run("gives up when the counter keeps changing", func(t *testing.T) {
+		// Arrange
+		store := &racingStore{MemoryStore: NewMemoryStore(), conflicts: maxUpdateAttempts}
+		limiter := NewLimiter(store)
+
+		// Act
+		_, err := limiter.Allow(ctx, "ip:192.0.2.1", limit)
+
+		// Assert
+		assert.True(t, domain.IsConflictError(err))
+	})
+}
+
+func TestSlidingWindow(t *testing.T) {
+	ctx := context.Background()
+	limit := Limit{Requests: 4, Window: time.Minute, Algorithm: SlidingWindow}
+
+	// Arrange
+	limiter, _, clock := newTestLimiters()
+	for i := 0; i < 4; i++ {
+		decision, err := limiter.Allow(ctx, "ip:192.0.2.1", limit)
+		require.NoError(t, err)
+		require.True(t, decision.Allowed)
+	}
+
+	// Act
+	limited, err := limiter.Allow(ctx, "ip:192.0.2.1", limit)
+	require.NoError(t, err)
+	clock.Advance(time.Minute + 5*time.Second)
+	stillLimited, err := limiter.Allow(ctx, "ip:192.0.2.1", limit)
+	require.NoError(t, err)
+	clock.Advance(stillLimited.RetryAfter)
+	allowed, err := limiter.Allow(ctx, "ip:192.0.2.1", limit)
+	require.NoError(t, err)
+
+	// Assert
+	assert.False(t, limited.Allowed)
+	assert.Equal(t, time.Minute, limited.RetryAfter)
+	assert.False(t, stillLimited.Allowed, "most of the previous window still counts")
+	assert.Equal(t, 10*time.Second, stillLimited.RetryAfter)
+	assert.True(t, allowed.Allowed)
+	assert.Equal(t, 0, allowed.Remaining)
+}
+
+func TestClientIPKey(t *testing.T) {
+	trusted, err := ParseTrustedProxies([]string{"10.0.0.0/8", "192.0.2.10"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		forwarded  []string
+		expected   string
+	}{
+		{name: "direct client ignores the port", remoteAddr: "203.0.113.7:51234", expected: "ip:203.0.113.7"},
+		{name: "forwarded header from an untrusted peer is ignored", remoteAddr: "203.0.113.7:51234", forwarded: []string{"198.51.100.1"}, expected: "ip:203.0.113.7"},
+		{name: "trusted proxy", remoteAddr: "10.1.2.3:443", forwarded: []string{"198.51.100.1"}, expected: "ip:198.51.100.1"},
+		{name: "forged entries left of the client are ignored", remoteAddr: "10.1.2.3:443", forwarded: []string{"1.1.1.1, 198.51.100.1, 192.0.2.10"}, expected: "ip:198.51.100.1"},
+		{name: "repeated headers are read as one list", remoteAddr: "10.1.2.3:443", forwarded: []string{"198.51.100.1", "10.9.9.9"}, expected: "ip:198.51.100.1"},
+		{name: "only proxies", remoteAddr: "10.1.2.3:443", forwarded: []string{"10.4.4.4"}, expected: "ip:10.4.4.4"},
+		{name: "malformed hop stops the walk", remoteAddr: "10.1.2.3:443", forwarded: []string{"198.51.100.1, bogus"}, expected: "ip:10.1.2.3"},
+		{name: "ipv6 client", remoteAddr: "[2001:db8::1]:443", expected: "ip:2001:db8::1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/news", nil)
+			req.RemoteAddr = tt.remoteAddr
+			for _, value := range tt.forwarded {
+				req.Header.Add("X-Forwarded-For", value)
+			}
+
+			// Act
+			key, ok := ClientIPKey(trusted)(req)
+
+			// Assert
+			assert.True(t, ok)
+			assert.Equal(t, tt.expected, key)
+		})
+	}
+}
+
+func TestParseTrustedProxiesRejectsInvalidAddresses(t *testing.T) {
+	for _, proxy := range []string{"not-an-ip", "10.0.0.0/33"} {
+		t.Run(proxy, func(t *testing.T) {
+			// Act
+			_, err := ParseTrustedProxies([]string{proxy})
+
+			// Assert
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestFirstKey(t *testing.T) {
+	issued, err := ParseAPIKeyDigests([]string{"2bb80d537b1da3e38bd30361aa855686bde0eacd7162fef6a25fe97bf527a25b"})
+	require.NoError(t, err)
+	key := FirstKey(UserKey(), APIKeyKey("X-API-Key", issued), ClientIPKey(nil))
+	principal := auth.NewPrincipal(&auth.User{UserID: "user-1"}, nil)
+
+	tests := []struct {
+		name     string
+		prepare  func(r *http.Request) *http.Request
+		expected string
+	}{
+		{
+			name: "authenticated user",
+			prepare: func(r *http.Request) *http.Request {
+				r.Header.Set("X-API-Key", "secret")
+				return r.WithContext(auth.WithPrincipal(r.Context(), principal))
+			},
+			expected: "user:user-1",
+		},
+		{
+			name: "api key is hashed",
+			prepare: func(r *http.Request) *http.Request {
+				r.Header.Set("X-API-Key", "secret")
+				return r
+			},
+			expected: "key:2bb80d537b1da3e38bd30361aa855686",
+		},
+		{
+			name: "unknown api key falls back to the client address",
+			prepare: func(r *http.Request) *http.Request {
+				r.Header.Set("X-API-Key", "made-up")
+				return r
+			},
+			expected: "ip:192.0.2.1",
+		},
+		{
+			name: "client supplied user header is not trusted",
+			prepare: func(r *http.Request) *http.Request {
+				r.Header.Set("X-User-ID", "someone-else")
+				return r
+			},
+			expected: "ip:192.0.2.1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			req := httptest.NewRequest(http.MethodGet, "/admin/api/v1/users", nil)
+			req.RemoteAddr = "192.0.2.1:1234"
+
+			// Act
+			value, ok := key(tt.prepare(req))
+
+			// Assert
+			assert.True(t, ok)
+			assert.Equal(t, tt.expected, value)
+		})
+	}
+}
+
+func TestParseAPIKeyDigestsRejectsInvalidDigests(t *testing.T) {
+	for _, digest := range []string{"secret", "2bb80d537b1da3e38bd30361aa855686"} {
+		t.Run(digest, func(t *testing.T) {
+			// Act
+			_, err := ParseAPIKeyDigests([]string{digest})
+
+			// Assert
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	newHandler := func(limiter *Limiter, resolve Resolver) http.Handler {
+		return limiter.Middleware(resolve, nil)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}))
+	}
+	serve := func(handler http.Handler) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/news", nil)
+		req.RemoteAddr = "192.0.2.1:1234"
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("sets headers and rejects once the limit is spent", func(t *testing.T) {
+		// Arrange
+		limiter, _, _ := newTestLimiters()
+		handler := newHandler(limiter, FixedRule(Rule{Name: "default", Limit: PerMinute(60, 1), Key: ClientIPKey(nil)}))
+
+		// Act
+		allowed := serve(handler)
+		limited := serve(handler)
+
+		// Assert
+		assert.Equal(t, http.StatusNoContent, allowed.Code)
+		assert.Equal(t, "1", allowed.Header().Get("RateLimit-Limit"))
+		assert.Equal(t, "0", allowed.Header().Get("RateLimit-Remaining"))
+		assert.Equal(t, "1", allowed.Header().Get("RateLimit-Reset"))
+		assert.Equal(t, "60;w=60;burst=1", allowed.Header().Get("RateLimit-Policy"))
+		assert.Empty(t, allowed.Header().Get("Retry-After"))
+
+		assert.Equal(t, http.StatusTooManyRequests, limited.Code)
+		assert.Equal(t, "1", limited.Header().Get("Retry-After"))
+		assert.Contains(t, limited.Body.String(), "RATE_LIMIT_EXCEEDED")
+	})
+
+	t.Run("rules keep separate counters", func(t *testing.T) {
+		// Arrange
+		limiter, _, _ := newTestLimiters()
+		login := Rule{Name: "login", Limit: PerMinute(60, 1), Key: ClientIPKey(nil)}
+		handler := newHandler(limiter, FixedRule(login))
+		other := newHandler(limiter, FixedRule(Rule{Name: "default", Limit: PerMinute(60, 1), Key: ClientIPKey(nil)}))
+
+		// Act
+		serve(handler)
+		rec := serve(other)
+
+		// Assert
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("unresolved requests are not limited", func(t *testing.T) {
+		// Arrange
+		limiter, _, _ := newTestLimiters()
+		handler := newHandler(limiter, func(r *http.Request) (Rule, bool) { return Rule{}, false })
+
+		// Act
+		rec := serve(handler)
+
+		// Assert
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Empty(t, rec.Header().Get("RateLimit-Limit"))
+	})
+
+	t.Run("store failures let requests through", func(t *testing.T) {
+		// Arrange
+		limiter := NewLimiter(failingStore{})
+		handler := newHandler(limiter, FixedRule(Rule{Name: "default", Limit: PerMinute(60, 1), Key: ClientIPKey(nil)}))
+
+		// Act
+		rec := serve(handler)
+
+		// Assert
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+}
+
+type failingStore struct{}
+
+func (failingStore) Load(ctx context.Context, key string) (*State, string, error) {
+	return nil, "", domain.NewDependencyError("state store", context.DeadlineExceeded)
+}
+
+func (failingStore) Save(ctx context.Context, key string, state *State, version string, ttl time.Duration) error {
+	return domain.NewDependencyError("state store", context.DeadlineExceeded)
+}
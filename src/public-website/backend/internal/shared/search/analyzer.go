@@ -0,0 +1,144 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// token is a single analyzed term together with the byte range it occupies
+// in the original text, which highlighting uses to mark matches in place
+type token struct {
+	term  string
+	start int
+	end   int
+}
+
+// stopWords are common English words that carry no relevance signal and are
+// dropped from both documents and queries
+var stopWords = map[string]bool{
+	"a": true, "about": true, "all": true, "also": true, "an": true, "and": true,
+	"are": true, "as": true, "at": true, "be": true, "been": true, "but": true,
+	"by": true, "can": true, "for": true, "from": true, "had": true, "has": true,
+	"have": true, "how": true, "if": true, "in": true, "into": true, "is": true,
+	"it": true, "its": true, "no": true, "not": true, "of": true, "on": true,
+	"or": true, "our": true, "such": true, "that": true, "the": true, "their": true,
+	"then": true, "there": true, "these": true, "they": true, "this": true, "to": true,
+	"was": true, "we": true, "were": true, "what": true, "when": true, "where": true,
+	"which": true, "who": true, "will": true, "with": true,
+}
+
+// Analyze splits text into the normalised terms stored in the index. Text is
+// split on anything that is not a letter or digit, lowercased, stripped of
+// stop words and stemmed, so "Publishing" and "published" share a term.
+func Analyze(text string) []string {
+	tokens := tokenize(text)
+	terms := make([]string, len(tokens))
+	for i, tok := range tokens {
+		terms[i] = tok.term
+	}
+	return terms
+}
+
+// tokenize performs the analysis behind Analyze while keeping byte offsets
+func tokenize(text string) []token {
+	var tokens []token
+	start := -1
+
+	emit := func(end int) {
+		word := strings.ToLower(text[start:end])
+		if !stopWords[word] {
+			tokens = append(tokens, token{term: stem(word), start: start, end: end})
+		}
+		start = -1
+	}
+
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if start < 0 {
+				start = i
+			}
+		} else if start >= 0 {
+			emit(i)
+		}
+		i += size
+	}
+	if start >= 0 {
+		emit(len(text))
+	}
+
+	return tokens
+}
+
+// stem reduces an English word to a stem by stripping inflectional suffixes.
+// It is a deliberately light stemmer: it only has to map related word forms
+// onto the same term consistently, not produce dictionary words.
+func stem(word string) string {
+	if len(word) <= 3 {
+		return word
+	}
+
+	// Plurals and third person forms
+	switch {
+	case strings.HasSuffix(word, "sses"):
+		word = word[:len(word)-2]
+	case strings.HasSuffix(word, "ies"):
+		word = word[:len(word)-3] + "y"
+	case strings.HasSuffix(word, "ss"), strings.HasSuffix(word, "us"), strings.HasSuffix(word, "is"):
+	case strings.HasSuffix(word, "s"):
+		word = word[:len(word)-1]
+	}
+
+	// Verb and adverb endings, kept only when a plausible stem remains
+	for _, suffix := range []string{"ingly", "edly", "ing", "ed", "ly"} {
+		if !strings.HasSuffix(word, suffix) {
+			continue
+		}
+		remainder := word[:len(word)-len(suffix)]
+		if len(remainder) >= 3 && containsVowel(remainder) {
+			word = undouble(remainder)
+		}
+		break
+	}
+
+	// Normalise the final letter so "study"/"studies" and "care"/"caring" meet
+	if len(word) > 3 && strings.HasSuffix(word, "y") && !isVowel(word[len(word)-2]) {
+		word = word[:len(word)-1] + "i"
+	} else if len(word) > 3 && strings.HasSuffix(word, "e") {
+		word = word[:len(word)-1]
+	}
+
+	return word
+}
+
+// undouble collapses a doubled final consonant left behind by suffix removal
+// ("running" -> "run"), keeping the doubles English spells on purpose
+func undouble(word string) string {
+	n := len(word)
+	if n < 2 || word[n-1] >= utf8.RuneSelf || word[n-1] != word[n-2] || isVowel(word[n-1]) {
+		return word
+	}
+	switch word[n-1] {
+	case 'l', 's', 'z':
+		return word
+	}
+	return word[:n-1]
+}
+
+func containsVowel(word string) bool {
+	for i := 0; i < len(word); i++ {
+		if isVowel(word[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+func isVowel(c byte) bool {
+	switch c {
+	case 'a', 'e', 'i', 'o', 'u', 'y':
+		return true
+	}
+	return false
+}
@@ -0,0 +1,89 @@
+package search
+
+import (
+	"html"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// snippetLength is the approximate number of bytes of source text in a snippet
+	snippetLength = 160
+	// snippetLead is the number of bytes kept ahead of the first match
+	snippetLead = 40
+
+	highlightOpen  = "<mark>"
+	highlightClose = "</mark>"
+	ellipsis       = "…"
+)
+
+// highlight returns a snippet of text around its first match with every
+// matching term wrapped in <mark> tags, or "" when no term matches. The
+// surrounding text is HTML-escaped so snippets can be rendered as markup.
+func highlight(text string, terms map[string]bool) string {
+	tokens := tokenize(text)
+
+	first := -1
+	for i, tok := range tokens {
+		if terms[tok.term] {
+			first = i
+			break
+		}
+	}
+	if first < 0 {
+		return ""
+	}
+	match := tokens[first]
+
+	// Start a little ahead of the match, on a word boundary
+	start := match.start - snippetLead
+	if start <= 0 {
+		start = 0
+	} else if space := strings.IndexAny(text[start:match.start], " \t\n"); space >= 0 {
+		start += space + 1
+	} else {
+		start = match.start
+	}
+
+	// End after roughly snippetLength bytes, on a word boundary
+	end := start + snippetLength
+	if end < match.end {
+		end = match.end
+	}
+	if end >= len(text) {
+		end = len(text)
+	} else if space := strings.LastIndexAny(text[match.end:end], " \t\n"); space >= 0 {
+		end = match.end + space
+	} else {
+		for end > match.end && !utf8.RuneStart(text[end]) {
+			end--
+		}
+	}
+
+	var snippet strings.Builder
+	if start > 0 {
+		snippet.WriteString(ellipsis)
+	}
+
+	position := start
+	for _, tok := range tokens[first:] {
+		if tok.end > end {
+			break
+		}
+		if !terms[tok.term] {
+			continue
+		}
+		snippet.WriteString(html.EscapeString(text[position:tok.start]))
+		snippet.WriteString(highlightOpen)
+		snippet.WriteString(html.EscapeString(text[tok.start:tok.end]))
+		snippet.WriteString(highlightClose)
+		position = tok.end
+	}
+	snippet.WriteString(html.EscapeString(text[position:end]))
+
+	if end < len(text) {
+		snippet.WriteString(ellipsis)
+	}
+
+	return strings.Join(strings.Fields(snippet.String()), " ")
+}
@@ -0,0 +1,483 @@
+package search
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+)
+
+const (
+	// DefaultLimit is applied when a query does not request a result count
+	DefaultLimit = 20
+	// MaxLimit caps the number of hits a single query may return
+	MaxLimit = 100
+	// MaxQueryLength bounds the free text accepted in a query
+	MaxQueryLength = 256
+
+	// BM25 term frequency saturation and document length normalisation
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// Field declares a searchable text field and the boost applied to its matches
+type Field struct {
+	Name  string
+	Boost float64
+}
+
+// Document is the searchable representation of a single content entity
+type Document struct {
+	ID     string
+	Fields map[string]string   // Searchable text keyed by field name
+	Facets map[string][]string // Facet values keyed by facet name, also usable as filters
+	Source interface{}         // Entity handed back with each hit
+}
+
+// Query describes a search request against an Index
+type Query struct {
+	Text    string            // Free text; every analyzed term must match somewhere in the document
+	Filters map[string]string // Facet values a hit must carry, compared case-insensitively
+	Facets  []string          // Facets to count across every matching document
+	Limit   int
+	Offset  int
+}
+
+// Validate checks the query limits and text length
+func (q Query) Validate() error {
+	if len(q.Text) > MaxQueryLength {
+		return domain.NewValidationFieldError("q", "search query is too long")
+	}
+	if q.Limit < 0 {
+		return domain.NewValidationFieldError("limit", "limit must not be negative")
+	}
+	if q.Offset < 0 {
+		return domain.NewValidationFieldError("offset", "offset must not be negative")
+	}
+	return nil
+}
+
+// Hit is a single ranked search result
+type Hit struct {
+	ID         string            `json:"id"`
+	Score      float64           `json:"score"`
+	Highlights map[string]string `json:"highlights,omitempty"`
+	Source     interface{}       `json:"-"`
+}
+
+// FacetCount is the number of matching documents carrying one facet value
+type FacetCount struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+// Results is one window of ranked hits plus facet counts over every match
+type Results struct {
+	Hits   []Hit                   `json:"hits"`
+	Total  int                     `json:"total"`
+	Limit  int                     `json:"limit"`
+	Offset int                     `json:"offset"`
+	Facets map[string][]FacetCount `json:"facets,omitempty"`
+}
+
+// Loader returns every document that belongs in an index
+type Loader func(ctx context.Context) ([]Document, error)
+
+// Index is an in-memory inverted index over one type of content. Documents are
+// analyzed per field on write; queries are ranked with BM25, weighted by the
+// boost of the field each term matched in. An Index is safe for concurrent use.
+type Index struct {
+	name   string
+	fields []Field
+	boosts map[string]float64
+
+	mu          sync.RWMutex
+	documents   map[string]*indexedDocument
+	postings    map[string]map[string]map[string]int // term -> document ID -> field -> frequency
+	fieldTokens map[string]int                       // total analyzed tokens per field across all documents
+
+	loadMu sync.Mutex
+	loaded bool
+}
+
+// indexedDocument is a stored document with the statistics needed for scoring
+type indexedDocument struct {
+	document Document
+	lengths  map[string]int // analyzed tokens per field
+	terms    []string       // distinct terms, used to unlink postings on removal
+}
+
+// NewIndex creates an empty index searching the given fields. Fields without
+// a positive boost count with a boost of 1.
+func NewIndex(name string, fields ...Field) *Index {
+	declared := make([]Field, len(fields))
+	boosts := make(map[string]float64, len(fields))
+	for i, field := range fields {
+		if field.Boost <= 0 {
+			field.Boost = 1
+		}
+		declared[i] = field
+		boosts[field.Name] = field.Boost
+	}
+
+	return &Index{
+		name:        name,
+		fields:      declared,
+		boosts:      boosts,
+		documents:   make(map[string]*indexedDocument),
+		postings:    make(map[string]map[string]map[string]int),
+		fieldTokens: make(map[string]int),
+	}
+}
+
+// Name returns the name the index was created with
+func (idx *Index) Name() string {
+	return idx.name
+}
+
+// Len returns the number of documents in the index
+func (idx *Index) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return len(idx.documents)
+}
+
+// Upsert adds a document, replacing any previous version with the same ID
+func (idx *Index) Upsert(document Document) {
+	if document.ID == "" {
+		return
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.removeLocked(document.ID)
+	idx.addLocked(document)
+}
+
+// Remove deletes a document, reporting whether it was present
+func (idx *Index) Remove(id string) bool {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	return idx.removeLocked(id)
+}
+
+// Replace swaps the whole content of the index for the given documents
+func (idx *Index) Replace(documents []Document) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.documents = make(map[string]*indexedDocument, len(documents))
+	idx.postings = make(map[string]map[string]map[string]int)
+	idx.fieldTokens = make(map[string]int)
+	for _, document := range documents {
+		if document.ID != "" {
+			idx.removeLocked(document.ID)
+			idx.addLocked(document)
+		}
+	}
+}
+
+// Rebuild reloads every document from the loader and replaces the index content
+func (idx *Index) Rebuild(ctx context.Context, load Loader) (int, error) {
+	idx.loadMu.Lock()
+	defer idx.loadMu.Unlock()
+
+	return idx.rebuildLocked(ctx, load)
+}
+
+// EnsureLoaded builds the index from the loader the first time it is called.
+// Later calls return immediately; incremental updates keep the index current.
+func (idx *Index) EnsureLoaded(ctx context.Context, load Loader) error {
+	idx.loadMu.Lock()
+	defer idx.loadMu.Unlock()
+
+	if idx.loaded {
+		return nil
+	}
+	_, err := idx.rebuildLocked(ctx, load)
+	return err
+}
+
+func (idx *Index) rebuildLocked(ctx context.Context, load Loader) (int, error) {
+	documents, err := load(ctx)
+	if err != nil {
+		return 0, domain.WrapError(err, "failed to load documents for search index "+idx.name)
+	}
+
+	idx.Replace(documents)
+	idx.loaded = true
+
+	return len(documents), nil
+}
+
+func (idx *Index) addLocked(document Document) {
+	stored := &indexedDocument{
+		document: document,
+		lengths:  make(map[string]int, len(idx.fields)),
+	}
+
+	seen := make(map[string]bool)
+	for _, field := range idx.fields {
+		terms := Analyze(document.Fields[field.Name])
+		stored.lengths[field.Name] = len(terms)
+		idx.fieldTokens[field.Name] += len(terms)
+
+		for _, term := range terms {
+			documentPostings, exists := idx.postings[term]
+			if !exists {
+				documentPostings = make(map[string]map[string]int)
+				idx.postings[term] = documentPostings
+			}
+			frequencies, exists := documentPostings[document.ID]
+			if !exists {
+				frequencies = make(map[string]int)
+				documentPostings[document.ID] = frequencies
+			}
+			frequencies[field.Name]++
+
+			if !seen[term] {
+				seen[term] = true
+				stored.terms = append(stored.terms, term)
+			}
+		}
+	}
+
+	idx.documents[document.ID] = stored
+}
+
+func (idx *Index) removeLocked(id string) bool {
+	stored, exists := idx.documents[id]
+	if !exists {
+		return false
+	}
+
+	for _, term := range stored.terms {
+		documentPostings := idx.postings[term]
+		delete(documentPostings, id)
+		if len(documentPostings) == 0 {
+			delete(idx.postings, term)
+		}
+	}
+	for field, length := range stored.lengths {
+		idx.fieldTokens[field] -= length
+	}
+	delete(idx.documents, id)
+
+	return true
+}
+
+// Search runs a query and returns the requested window of ranked hits. Empty
+// query text matches every document, which allows browsing by facet filters;
+// text made up only of stop words matches nothing.
+func (idx *Index) Search(query Query) *Results {
+	limit := query.Limit
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	if limit > MaxLimit {
+		limit = MaxLimit
+	}
+	offset := query.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	terms := distinct(Analyze(query.Text))
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	results := &Results{Hits: []Hit{}, Limit: limit, Offset: offset}
+
+	var candidates []string
+	switch {
+	case len(terms) > 0:
+		candidates = idx.matchAllTerms(terms)
+	case strings.TrimSpace(query.Text) == "":
+		candidates = make([]string, 0, len(idx.documents))
+		for id := range idx.documents {
+			candidates = append(candidates, id)
+		}
+	}
+
+	matches := candidates[:0]
+	for _, id := range candidates {
+		if idx.documents[id].matchesFilters(query.Filters) {
+			matches = append(matches, id)
+		}
+	}
+
+	if len(query.Facets) > 0 {
+		results.Facets = idx.countFacets(matches, query.Facets)
+	}
+
+	scores := make(map[string]float64, len(matches))
+	for _, id := range matches {
+		scores[id] = idx.score(id, terms)
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if scores[matches[i]] != scores[matches[j]] {
+			return scores[matches[i]] > scores[matches[j]]
+		}
+		return matches[i] < matches[j]
+	})
+
+	results.Total = len(matches)
+	if offset >= len(matches) {
+		return results
+	}
+	end := offset + limit
+	if end > len(matches) {
+		end = len(matches)
+	}
+
+	termSet := make(map[string]bool, len(terms))
+	for _, term := range terms {
+		termSet[term] = true
+	}
+
+	for _, id := range matches[offset:end] {
+		stored := idx.documents[id]
+		hit := Hit{
+			ID:     id,
+			Score:  math.Round(scores[id]*10000) / 10000,
+			Source: stored.document.Source,
+		}
+		if len(termSet) > 0 {
+			for _, field := range idx.fields {
+				if snippet := highlight(stored.document.Fields[field.Name], termSet); snippet != "" {
+					if hit.Highlights == nil {
+						hit.Highlights = make(map[string]string)
+					}
+					hit.Highlights[field.Name] = snippet
+				}
+			}
+		}
+		results.Hits = append(results.Hits, hit)
+	}
+
+	return results
+}
+
+// matchAllTerms returns the IDs of documents containing every term, walking
+// the rarest term's postings first to keep intersections small
+func (idx *Index) matchAllTerms(terms []string) []string {
+	ordered := append([]string(nil), terms...)
+	sort.Slice(ordered, func(i, j int) bool {
+		return len(idx.postings[ordered[i]]) < len(idx.postings[ordered[j]])
+	})
+
+	var matches []string
+	for id := range idx.postings[ordered[0]] {
+		matched := true
+		for _, term := range ordered[1:] {
+			if _, exists := idx.postings[term][id]; !exists {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			matches = append(matches, id)
+		}
+	}
+
+	return matches
+}
+
+// score computes the boosted BM25 relevance of one document for the terms
+func (idx *Index) score(id string, terms []string) float64 {
+	total := float64(len(idx.documents))
+	stored := idx.documents[id]
+
+	var score float64
+	for _, term := range terms {
+		documentPostings := idx.postings[term]
+		frequency := float64(len(documentPostings))
+		idf := math.Log(1 + (total-frequency+0.5)/(frequency+0.5))
+
+		for field, tf := range documentPostings[id] {
+			norm := 1.0
+			if average := float64(idx.fieldTokens[field]) / total; average > 0 {
+				norm = 1 - bm25B + bm25B*float64(stored.lengths[field])/average
+			}
+			weight := float64(tf) * (bm25K1 + 1) / (float64(tf) + bm25K1*norm)
+			score += idx.boosts[field] * idf * weight
+		}
+	}
+
+	return score
+}
+
+// countFacets counts facet values across the matching documents, most common first
+func (idx *Index) countFacets(ids []string, facets []string) map[string][]FacetCount {
+	counts := make(map[string]map[string]int, len(facets))
+	for _, facet := range facets {
+		counts[facet] = make(map[string]int)
+	}
+
+	for _, id := range ids {
+		document := idx.documents[id].document
+		for _, facet := range facets {
+			for _, value := range distinct(document.Facets[facet]) {
+				if value != "" {
+					counts[facet][value]++
+				}
+			}
+		}
+	}
+
+	result := make(map[string][]FacetCount, len(facets))
+	for facet, values := range counts {
+		facetCounts := make([]FacetCount, 0, len(values))
+		for value, count := range values {
+			facetCounts = append(facetCounts, FacetCount{Value: value, Count: count})
+		}
+		sort.Slice(facetCounts, func(i, j int) bool {
+			if facetCounts[i].Count != facetCounts[j].Count {
+				return facetCounts[i].Count > facetCounts[j].Count
+			}
+			return facetCounts[i].Value < facetCounts[j].Value
+		})
+		result[facet] = facetCounts
+	}
+
+	return result
+}
+
+// matchesFilters reports whether the document carries every filtered facet value
+func (d *indexedDocument) matchesFilters(filters map[string]string) bool {
+	for facet, want := range filters {
+		if want == "" {
+			continue
+		}
+		found := false
+		for _, value := range d.document.Facets[facet] {
+			if strings.EqualFold(value, want) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// distinct returns values without duplicates, keeping first-seen order
+func distinct(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			result = append(result, value)
+		}
+	}
+	return result
+}
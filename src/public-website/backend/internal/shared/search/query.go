@@ -0,0 +1,60 @@
+package search
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+)
+
+// ParseQuery builds a Query from request parameters: q holds the text, limit
+// and offset select the window, and each named facet may be passed as a filter
+// parameter of the same name. Every named facet is also counted in the results.
+func ParseQuery(values url.Values, facets []string) (Query, error) {
+	query := Query{
+		Text:   strings.TrimSpace(values.Get("q")),
+		Facets: facets,
+	}
+
+	if raw := values.Get("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil {
+			return Query{}, domain.NewValidationFieldError("limit", "limit must be a number")
+		}
+		if limit > MaxLimit {
+			limit = MaxLimit
+		}
+		query.Limit = limit
+	}
+
+	if raw := values.Get("offset"); raw != "" {
+		offset, err := strconv.Atoi(raw)
+		if err != nil {
+			return Query{}, domain.NewValidationFieldError("offset", "offset must be a number")
+		}
+		query.Offset = offset
+	}
+
+	for _, facet := range facets {
+		if value := strings.TrimSpace(values.Get(facet)); value != "" {
+			if query.Filters == nil {
+				query.Filters = make(map[string]string)
+			}
+			query.Filters[facet] = value
+		}
+	}
+
+	return query, query.Validate()
+}
+
+// WithFilter returns a copy of the query that also requires the facet value
+func (q Query) WithFilter(facet, value string) Query {
+	filters := make(map[string]string, len(q.Filters)+1)
+	for name, existing := range q.Filters {
+		filters[name] = existing
+	}
+	filters[facet] = value
+	q.Filters = filters
+	return q
+}
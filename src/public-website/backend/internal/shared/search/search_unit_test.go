@@ -0,0 +1,268 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/axiom-software-co/international-center/src/backend/internal/shared/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestIndex() *Index {
+	index := NewIndex("test",
+		Field{Name: "title", Boost: 3},
+		Field{Name: "summary", Boost: 2},
+		Field{Name: "content", Boost: 1},
+	)
+	index.Replace([]Document{
+		{
+			ID:     "doc-1",
+			Fields: map[string]string{"title": "Community health clinic opens", "summary": "A new clinic for the neighbourhood", "content": "The clinic offers vaccinations and screenings."},
+			Facets: map[string][]string{"tags": {"health", "community"}, "status": {"published"}},
+			Source: "source-1",
+		},
+		{
+			ID:     "doc-2",
+			Fields: map[string]string{"title": "Annual report published", "summary": "Highlights from the year", "content": "Our clinic network grew across three regions."},
+			Facets: map[string][]string{"tags": {"reports"}, "status": {"published"}},
+		},
+		{
+			ID:     "doc-3",
+			Fields: map[string]string{"title": "Volunteer studies", "summary": "Studying volunteer outcomes in community clinics", "content": ""},
+			Facets: map[string][]string{"tags": {"research", "community"}, "status": {"draft"}},
+		},
+	})
+	return index
+}
+
+func hitIDs(results *Results) []string {
+	ids := make([]string, len(results.Hits))
+	for i, hit := range results.Hits {
+		ids[i] = hit.ID
+	}
+	return ids
+}
+
+func TestAnalyze(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		terms []string
+	}{
+		{name: "lowercase and split on punctuation", text: "Health-Care, NOW!", terms: []string{"health", "car", "now"}},
+		{name: "drop stop words", text: "the state of the art", terms: []string{"stat", "art"}},
+		{name: "stem plural forms", text: "studies study clinics clinic", terms: []string{"studi", "studi", "clinic", "clinic"}},
+		{name: "stem verb forms", text: "published publishing publishes", terms: []string{"publish", "publish", "publish"}},
+		{name: "undouble consonants", text: "running stopped", terms: []string{"run", "stop"}},
+		{name: "keep digits and unicode letters", text: "Año 2024", terms: []string{"año", "2024"}},
+		{name: "empty text", text: "  ", terms: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.terms, Analyze(tt.text))
+		})
+	}
+}
+
+func TestIndex_Search(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     Query
+		wantIDs   []string
+		wantTotal int
+	}{
+		{
+			name:      "title matches outrank content matches",
+			query:     Query{Text: "clinic"},
+			wantIDs:   []string{"doc-1", "doc-3", "doc-2"},
+			wantTotal: 3,
+		},
+		{
+			name:      "every term must match",
+			query:     Query{Text: "community clinic"},
+			wantIDs:   []string{"doc-1", "doc-3"},
+			wantTotal: 2,
+		},
+		{
+			name:      "stemmed query matches other word forms",
+			query:     Query{Text: "study"},
+			wantIDs:   []string{"doc-3"},
+			wantTotal: 1,
+		},
+		{
+			name:      "facet filter restricts matches",
+			query:     Query{Text: "clinic", Filters: map[string]string{"status": "Published"}},
+			wantIDs:   []string{"doc-1", "doc-2"},
+			wantTotal: 2,
+		},
+		{
+			name:      "empty text browses by filter",
+			query:     Query{Filters: map[string]string{"tags": "community"}},
+			wantIDs:   []string{"doc-1", "doc-3"},
+			wantTotal: 2,
+		},
+		{
+			name:      "stop words alone match nothing",
+			query:     Query{Text: "the and of"},
+			wantIDs:   []string{},
+			wantTotal: 0,
+		},
+		{
+			name:      "limit and offset window the ranking",
+			query:     Query{Text: "clinic", Limit: 1, Offset: 1},
+			wantIDs:   []string{"doc-3"},
+			wantTotal: 3,
+		},
+		{
+			name:      "offset past the end",
+			query:     Query{Text: "clinic", Offset: 10},
+			wantIDs:   []string{},
+			wantTotal: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			index := newTestIndex()
+
+			// Act
+			results := index.Search(tt.query)
+
+			// Assert
+			assert.Equal(t, tt.wantIDs, hitIDs(results))
+			assert.Equal(t, tt.wantTotal, results.Total)
+		})
+	}
+}
+
+func TestIndex_SearchFacetsAndHighlights(t *testing.T) {
+	index := newTestIndex()
+
+	results := index.Search(Query{Text: "clinics", Facets: []string{"tags"}})
+
+	require.Len(t, results.Hits, 3)
+	assert.Equal(t, []FacetCount{
+		{Value: "community", Count: 2},
+		{Value: "health", Count: 1},
+		{Value: "reports", Count: 1},
+		{Value: "research", Count: 1},
+	}, results.Facets["tags"])
+
+	top := results.Hits[0]
+	assert.Equal(t, "source-1", top.Source)
+	assert.Greater(t, top.Score, results.Hits[1].Score)
+	assert.Equal(t, "Community health <mark>clinic</mark> opens", top.Highlights["title"])
+	assert.Equal(t, "The <mark>clinic</mark> offers vaccinations and screenings.", top.Highlights["content"])
+}
+
+func TestIndex_UpsertAndRemove(t *testing.T) {
+	index := newTestIndex()
+
+	t.Run("upsert replaces previous terms", func(t *testing.T) {
+		index.Upsert(Document{ID: "doc-2", Fields: map[string]string{"title": "Annual budget"}})
+
+		assert.Equal(t, 3, index.Len())
+		assert.Equal(t, []string{"doc-1", "doc-3"}, hitIDs(index.Search(Query{Text: "clinic"})))
+		assert.Equal(t, []string{"doc-2"}, hitIDs(index.Search(Query{Text: "budget"})))
+	})
+
+	t.Run("remove unlinks document", func(t *testing.T) {
+		assert.True(t, index.Remove("doc-1"))
+		assert.False(t, index.Remove("doc-1"))
+
+		assert.Equal(t, 2, index.Len())
+		assert.Equal(t, []string{"doc-3"}, hitIDs(index.Search(Query{Text: "clinic"})))
+	})
+
+	t.Run("ignore documents without id", func(t *testing.T) {
+		index.Upsert(Document{Fields: map[string]string{"title": "orphan"}})
+		assert.Equal(t, 2, index.Len())
+	})
+}
+
+func TestIndex_EnsureLoaded(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("load once", func(t *testing.T) {
+		index := NewIndex("test", Field{Name: "title"})
+		calls := 0
+		load := func(ctx context.Context) ([]Document, error) {
+			calls++
+			return []Document{{ID: "doc-1", Fields: map[string]string{"title": "Loaded"}}}, nil
+		}
+
+		require.NoError(t, index.EnsureLoaded(ctx, load))
+		require.NoError(t, index.EnsureLoaded(ctx, load))
+
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, 1, index.Len())
+	})
+
+	t.Run("retry after failed load", func(t *testing.T) {
+		index := NewIndex("test", Field{Name: "title"})
+		failing := func(ctx context.Context) ([]Document, error) {
+			return nil, errors.New("state store unavailable")
+		}
+
+		err := index.EnsureLoaded(ctx, failing)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "search index test")
+
+		count, err := index.Rebuild(ctx, func(ctx context.Context) ([]Document, error) { return nil, nil })
+		require.NoError(t, err)
+		assert.Equal(t, 0, count)
+	})
+}
+
+func TestQuery_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		query Query
+		valid bool
+	}{
+		{name: "valid query", query: Query{Text: "clinic", Limit: 10}, valid: true},
+		{name: "query too long", query: Query{Text: strings.Repeat("a", MaxQueryLength+1)}},
+		{name: "negative limit", query: Query{Limit: -1}},
+		{name: "negative offset", query: Query{Offset: -1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.query.Validate()
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.True(t, domain.IsValidationError(err))
+			}
+		})
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	terms := map[string]bool{"clinic": true}
+
+	t.Run("no match", func(t *testing.T) {
+		assert.Equal(t, "", highlight("Nothing relevant here", terms))
+	})
+
+	t.Run("escape surrounding markup", func(t *testing.T) {
+		assert.Equal(t, "&lt;b&gt; &amp; <mark>Clinics</mark>", highlight("<b> & Clinics", terms))
+	})
+
+	t.Run("trim long text around the match", func(t *testing.T) {
+		text := strings.Repeat("lorem ipsum ", 20) + "the clinic opened " + strings.Repeat("dolor sit ", 30)
+
+		snippet := highlight(text, terms)
+
+		assert.True(t, strings.HasPrefix(snippet, ellipsis))
+		assert.True(t, strings.HasSuffix(snippet, ellipsis))
+		assert.Contains(t, snippet, "the <mark>clinic</mark> opened")
+		assert.Less(t, len(snippet), snippetLength+len(highlightOpen)+len(highlightClose)+2*len(ellipsis))
+	})
+}
@@ -0,0 +1,28 @@
+// Package testing holds helpers shared by the backend unit tests
+package testing
+
+import (
+	"context"
+	"os"
+	"time"
+)
+
+const (
+	// UnitTestTimeout bounds a unit test that needs no external services
+	UnitTestTimeout = 5 * time.Second
+
+	// DaprTestTimeout bounds a test that calls the Dapr client in test mode
+	DaprTestTimeout = 15 * time.Second
+)
+
+// CreateUnitTestContext returns a context that expires after UnitTestTimeout
+func CreateUnitTestContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), UnitTestTimeout)
+}
+
+// SetupDaprTest switches the Dapr client to test mode, so that no sidecar is needed,
+// and returns a context that expires after DaprTestTimeout
+func SetupDaprTest() (context.Context, context.CancelFunc) {
+	os.Setenv("DAPR_TEST_MODE", "true")
+	return context.WithTimeout(context.Background(), DaprTestTimeout)
+}
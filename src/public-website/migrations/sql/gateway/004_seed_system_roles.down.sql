@@ -0,0 +1,3 @@
+-- Remove the seeded system roles and their assignments
+DELETE FROM user_roles WHERE role_id IN (SELECT role_id FROM roles WHERE is_system_role = TRUE);
+DELETE FROM roles WHERE is_system_role = TRUE;
\ No newline at end of file
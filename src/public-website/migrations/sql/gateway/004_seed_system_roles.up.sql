@@ -0,0 +1,68 @@
+-- Seed the system roles used by the admin gateway policy; permissions must match auth.SystemRoles
+INSERT INTO roles (
+    role_name,
+    description,
+    is_system_role,
+    permissions,
+    created_by
+) VALUES
+(
+    'admin',
+    'Full access to every admin resource',
+    TRUE,
+    ARRAY[
+        'news.read', 'news.write', 'news.publish',
+        'events.read', 'events.write', 'events.publish',
+        'research.read', 'research.write', 'research.publish',
+        'services.read', 'services.write', 'services.publish',
+        'content.review', 'content.schedule',
+        'media.read', 'media.manage',
+        'inquiries.read', 'inquiries.manage',
+        'subscribers.read', 'subscribers.manage',
+        'notifications.manage',
+        'users.read', 'users.manage',
+        'analytics.read',
+        'settings.read', 'settings.manage',
+        'sagas.manage'
+    ],
+    'system'
+),
+(
+    'editor',
+    'Writes, reviews and publishes content',
+    TRUE,
+    ARRAY[
+        'news.read', 'news.write', 'news.publish',
+        'events.read', 'events.write', 'events.publish',
+        'research.read', 'research.write', 'research.publish',
+        'services.read', 'services.write', 'services.publish',
+        'content.review', 'content.schedule',
+        'media.read', 'media.manage',
+        'analytics.read'
+    ],
+    'system'
+),
+(
+    'moderator',
+    'Handles inquiries and subscribers and reviews content',
+    TRUE,
+    ARRAY[
+        'news.read', 'events.read', 'research.read', 'services.read',
+        'content.review', 'media.read',
+        'inquiries.read', 'inquiries.manage',
+        'subscribers.read', 'subscribers.manage',
+        'analytics.read'
+    ],
+    'system'
+),
+(
+    'viewer',
+    'Read-only access',
+    TRUE,
+    ARRAY[
+        'news.read', 'events.read', 'research.read', 'services.read',
+        'media.read', 'inquiries.read', 'subscribers.read',
+        'users.read', 'analytics.read', 'settings.read'
+    ],
+    'system'
+);
\ No newline at end of file
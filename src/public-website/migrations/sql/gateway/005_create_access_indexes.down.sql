@@ -0,0 +1,3 @@
+-- Drop access control indexes
+DROP INDEX IF EXISTS idx_user_roles_user_active;
+DROP INDEX IF EXISTS idx_users_email_active;
\ No newline at end of file
@@ -0,0 +1,3 @@
+-- Indexes for resolving a user's roles on every admin request
+CREATE INDEX idx_users_email_active ON users(email) WHERE is_deleted = FALSE;
+CREATE INDEX idx_user_roles_user_active ON user_roles(user_id) WHERE is_deleted = FALSE;
\ No newline at end of file